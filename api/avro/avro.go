@@ -0,0 +1,25 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package avro embeds the Avro schemas of the events the service publishes.
+package avro
+
+import _ "embed"
+
+// OrderEventSchema is the Avro schema registered with the schema registry
+// for the avro event format. messaging encodes events against it by hand, so
+// a change here needs the matching change there.
+//
+//go:embed order_event.avsc
+var OrderEventSchema string
@@ -0,0 +1,26 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package openapi embeds the OpenAPI 3.0 description of the HTTP API.
+//
+// openapi.json is maintained by hand alongside the handlers; the package test
+// fails if a route registered on the router is missing from it.
+package openapi
+
+import _ "embed"
+
+// Spec is the OpenAPI document served at /api/v1/openapi.json.
+//
+//go:embed openapi.json
+var Spec []byte
@@ -0,0 +1,123 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	httpHandler "github.com/sridharn-code-sandbox/go-ordersvc/internal/handler/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type document struct {
+	OpenAPI string                                `json:"openapi"`
+	Paths   map[string]map[string]json.RawMessage `json:"paths"`
+}
+
+// undocumented lists routes that describe the API rather than belong to it.
+var undocumented = map[string]bool{
+	"GET /api/v1/openapi.json": true,
+	"GET /docs":                true,
+}
+
+// routes walks a router wired like the server's and returns "METHOD /path" keys.
+func routes(t *testing.T) map[string]bool {
+	t.Helper()
+	router := httpHandler.NewRouter(
+		httpHandler.NewOrderHandler(nil, nil, 0),
+		httpHandler.NewHealthHandler("test", nil, nil),
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		nil,
+		httpHandler.NewOrderHistoryHandler(nil),
+		httpHandler.NewOrderNoteHandler(nil),
+		httpHandler.NewOrderSearchHandler(nil),
+		httpHandler.NewCustomerDataHandler(nil),
+		httpHandler.NewReportHandler(nil),
+		httpHandler.NewStatsHandler(nil, 0),
+		httpHandler.NewSubscriptionHandler(nil),
+		httpHandler.NewOrderStreamHandler(nil, 0),
+		httpHandler.NewPaymentHandler(nil),
+		httpHandler.NewPaymentWebhookHandler(nil),
+		httpHandler.NewOpenAPIHandler(Spec),
+		httpHandler.NewAdminRoutes("key", nil,
+			httpHandler.NewAdminHandler(nil),
+			httpHandler.NewRetentionHandler(nil),
+			httpHandler.NewTotalCheckHandler(nil),
+			httpHandler.NewDeadLetterHandler(nil),
+			httpHandler.NewJobHandler(nil),
+			httpHandler.NewLogLevelHandler(nil),
+			httpHandler.NewEventReplayHandler(nil),
+			httpHandler.NewQuotaHandler(nil),
+		),
+	)
+
+	found := make(map[string]bool)
+	err := chi.Walk(router, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		if route != "/" {
+			route = strings.TrimSuffix(route, "/")
+		}
+		found[method+" "+route] = true
+		return nil
+	})
+	require.NoError(t, err)
+	return found
+}
+
+func TestSpec_DocumentsEveryRoute(t *testing.T) {
+	var doc document
+	require.NoError(t, json.Unmarshal(Spec, &doc))
+	assert.Equal(t, "3.0.3", doc.OpenAPI)
+
+	documented := make(map[string]bool)
+	for path, item := range doc.Paths {
+		for method := range item {
+			if method != "parameters" {
+				documented[strings.ToUpper(method)+" "+path] = true
+			}
+		}
+	}
+
+	registered := routes(t)
+	for route := range registered {
+		if !undocumented[route] {
+			assert.True(t, documented[route], "route %s is missing from openapi.json", route)
+		}
+	}
+	for route := range documented {
+		assert.True(t, registered[route], "openapi.json documents %s, which is not routed", route)
+	}
+}
+
+func TestSpec_ReferencesResolve(t *testing.T) {
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal(Spec, &raw))
+	components := raw["components"].(map[string]any)
+
+	refs := regexp.MustCompile(`"\$ref":\s*"#/components/(\w+)/(\w+)"`).FindAllStringSubmatch(string(Spec), -1)
+	require.NotEmpty(t, refs)
+	for _, ref := range refs {
+		section, ok := components[ref[1]].(map[string]any)
+		require.True(t, ok, "unknown components section %s", ref[1])
+		assert.Contains(t, section, ref[2], "unresolved $ref %s", ref[0])
+	}
+}
@@ -0,0 +1,351 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.4
+// source: api/proto/events/v1/order_event.proto
+
+package eventsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OrderEvent is an order domain event as published with the protobuf event
+// format. It carries the same fields as the JSON payload. Fields may be
+// added but never renumbered, retyped or removed, so the schema registry
+// accepts every version as backward compatible.
+type OrderEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifies the change this event reports; redelivered events keep it.
+	EventId    string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType  string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	OrderId    string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId string                 `protobuf:"bytes,4,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Status     string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	OldStatus  string                 `protobuf:"bytes,6,opt,name=old_status,json=oldStatus,proto3" json:"old_status,omitempty"`
+	NewStatus  string                 `protobuf:"bytes,7,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	Total      float64                `protobuf:"fixed64,8,opt,name=total,proto3" json:"total,omitempty"`
+	Version    int32                  `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Set on customer-scoped events such as customer.data_erased.
+	OrderCount          int32                  `protobuf:"varint,11,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+	CorrelationId       string                 `protobuf:"bytes,12,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	HoldReason          string                 `protobuf:"bytes,13,opt,name=hold_reason,json=holdReason,proto3" json:"hold_reason,omitempty"`
+	HoldReleaseAt       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=hold_release_at,json=holdReleaseAt,proto3" json:"hold_release_at,omitempty"`
+	Metadata            map[string]string      `protobuf:"bytes,15,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Tags                []string               `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
+	EstimatedDeliveryAt *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=estimated_delivery_at,json=estimatedDeliveryAt,proto3" json:"estimated_delivery_at,omitempty"`
+	SlaBreachedAt       *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=sla_breached_at,json=slaBreachedAt,proto3" json:"sla_breached_at,omitempty"`
+	Replayed            bool                   `protobuf:"varint,19,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	// Empty in a deployment without tenants.
+	TenantId string `protobuf:"bytes,20,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Who made a status change (user, api_key, system or anonymous) and how
+	// it reached the service (http, grpc or worker). Set on
+	// order.status_changed events.
+	Actor         string `protobuf:"bytes,21,opt,name=actor,proto3" json:"actor,omitempty"`
+	ActorType     string `protobuf:"bytes,22,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
+	Channel       string `protobuf:"bytes,23,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderEvent) Reset() {
+	*x = OrderEvent{}
+	mi := &file_api_proto_events_v1_order_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderEvent) ProtoMessage() {}
+
+func (x *OrderEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_events_v1_order_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderEvent.ProtoReflect.Descriptor instead.
+func (*OrderEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_events_v1_order_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OrderEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetOldStatus() string {
+	if x != nil {
+		return x.OldStatus
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetNewStatus() string {
+	if x != nil {
+		return x.NewStatus
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *OrderEvent) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *OrderEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetOrderCount() int32 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *OrderEvent) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetHoldReason() string {
+	if x != nil {
+		return x.HoldReason
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetHoldReleaseAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HoldReleaseAt
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetEstimatedDeliveryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EstimatedDeliveryAt
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetSlaBreachedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SlaBreachedAt
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+func (x *OrderEvent) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetActorType() string {
+	if x != nil {
+		return x.ActorType
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+var File_api_proto_events_v1_order_event_proto protoreflect.FileDescriptor
+
+const file_api_proto_events_v1_order_event_proto_rawDesc = "" +
+	"\n" +
+	"%api/proto/events/v1/order_event.proto\x12\x12ordersvc.events.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa9\a\n" +
+	"\n" +
+	"OrderEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x12\x1f\n" +
+	"\vcustomer_id\x18\x04 \x01(\tR\n" +
+	"customerId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"old_status\x18\x06 \x01(\tR\toldStatus\x12\x1d\n" +
+	"\n" +
+	"new_status\x18\a \x01(\tR\tnewStatus\x12\x14\n" +
+	"\x05total\x18\b \x01(\x01R\x05total\x12\x18\n" +
+	"\aversion\x18\t \x01(\x05R\aversion\x12;\n" +
+	"\voccurred_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x1f\n" +
+	"\vorder_count\x18\v \x01(\x05R\n" +
+	"orderCount\x12%\n" +
+	"\x0ecorrelation_id\x18\f \x01(\tR\rcorrelationId\x12\x1f\n" +
+	"\vhold_reason\x18\r \x01(\tR\n" +
+	"holdReason\x12B\n" +
+	"\x0fhold_release_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\rholdReleaseAt\x12H\n" +
+	"\bmetadata\x18\x0f \x03(\v2,.ordersvc.events.v1.OrderEvent.MetadataEntryR\bmetadata\x12\x12\n" +
+	"\x04tags\x18\x10 \x03(\tR\x04tags\x12N\n" +
+	"\x15estimated_delivery_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\x13estimatedDeliveryAt\x12B\n" +
+	"\x0fsla_breached_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\rslaBreachedAt\x12\x1a\n" +
+	"\breplayed\x18\x13 \x01(\bR\breplayed\x12\x1b\n" +
+	"\ttenant_id\x18\x14 \x01(\tR\btenantId\x12\x14\n" +
+	"\x05actor\x18\x15 \x01(\tR\x05actor\x12\x1d\n" +
+	"\n" +
+	"actor_type\x18\x16 \x01(\tR\tactorType\x12\x18\n" +
+	"\achannel\x18\x17 \x01(\tR\achannel\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01BKZIgithub.com/sridharn-code-sandbox/go-ordersvc/api/proto/events/v1;eventsv1b\x06proto3"
+
+var (
+	file_api_proto_events_v1_order_event_proto_rawDescOnce sync.Once
+	file_api_proto_events_v1_order_event_proto_rawDescData []byte
+)
+
+func file_api_proto_events_v1_order_event_proto_rawDescGZIP() []byte {
+	file_api_proto_events_v1_order_event_proto_rawDescOnce.Do(func() {
+		file_api_proto_events_v1_order_event_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_events_v1_order_event_proto_rawDesc), len(file_api_proto_events_v1_order_event_proto_rawDesc)))
+	})
+	return file_api_proto_events_v1_order_event_proto_rawDescData
+}
+
+var file_api_proto_events_v1_order_event_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_api_proto_events_v1_order_event_proto_goTypes = []any{
+	(*OrderEvent)(nil),            // 0: ordersvc.events.v1.OrderEvent
+	nil,                           // 1: ordersvc.events.v1.OrderEvent.MetadataEntry
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_api_proto_events_v1_order_event_proto_depIdxs = []int32{
+	2, // 0: ordersvc.events.v1.OrderEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	2, // 1: ordersvc.events.v1.OrderEvent.hold_release_at:type_name -> google.protobuf.Timestamp
+	1, // 2: ordersvc.events.v1.OrderEvent.metadata:type_name -> ordersvc.events.v1.OrderEvent.MetadataEntry
+	2, // 3: ordersvc.events.v1.OrderEvent.estimated_delivery_at:type_name -> google.protobuf.Timestamp
+	2, // 4: ordersvc.events.v1.OrderEvent.sla_breached_at:type_name -> google.protobuf.Timestamp
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_events_v1_order_event_proto_init() }
+func file_api_proto_events_v1_order_event_proto_init() {
+	if File_api_proto_events_v1_order_event_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_events_v1_order_event_proto_rawDesc), len(file_api_proto_events_v1_order_event_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_proto_events_v1_order_event_proto_goTypes,
+		DependencyIndexes: file_api_proto_events_v1_order_event_proto_depIdxs,
+		MessageInfos:      file_api_proto_events_v1_order_event_proto_msgTypes,
+	}.Build()
+	File_api_proto_events_v1_order_event_proto = out.File
+	file_api_proto_events_v1_order_event_proto_goTypes = nil
+	file_api_proto_events_v1_order_event_proto_depIdxs = nil
+}
@@ -111,11 +111,13 @@ func (x *GetOrderResponse) GetOrder() *Order {
 }
 
 type ListOrdersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	CustomerId    string                 `protobuf:"bytes,4,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Page       int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize   int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Status     string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	CustomerId string                 `protobuf:"bytes,4,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	// Only return orders containing an item with this product ID.
+	ProductId     string `protobuf:"bytes,5,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -178,6 +180,13 @@ func (x *ListOrdersRequest) GetCustomerId() string {
 	return ""
 }
 
+func (x *ListOrdersRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
 type ListOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
@@ -255,8 +264,13 @@ func (x *ListOrdersResponse) GetTotalPages() int32 {
 }
 
 type WatchOrdersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Statuses      []string               `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Statuses []string               `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	// Only stream events of these types, e.g. "order.deleted". Empty streams all.
+	EventTypes []string `protobuf:"bytes,2,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// Continue after the last event a previous stream delivered, replaying
+	// what was missed while disconnected. Take it from OrderEvent.resume_token.
+	ResumeToken   string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -298,16 +312,40 @@ func (x *WatchOrdersRequest) GetStatuses() []string {
 	return nil
 }
 
+func (x *WatchOrdersRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WatchOrdersRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
 type Order struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Items         []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	Total         float64                `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`
-	Version       int32                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Items      []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Status     string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Total      float64                `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`
+	Version    int32                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Unset when the order has no shipping address.
+	ShippingAddress *Address `protobuf:"bytes,9,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	// Unset when the order has no billing address.
+	BillingAddress *Address `protobuf:"bytes,10,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address,omitempty"`
+	// Empty for orders created before shipping methods existed.
+	ShippingMethod string `protobuf:"bytes,11,opt,name=shipping_method,json=shippingMethod,proto3" json:"shipping_method,omitempty"`
+	// Set when the order is confirmed.
+	EstimatedDeliveryAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=estimated_delivery_at,json=estimatedDeliveryAt,proto3" json:"estimated_delivery_at,omitempty"`
+	// Set once the order is flagged as not delivered by estimated_delivery_at.
+	SlaBreachedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=sla_breached_at,json=slaBreachedAt,proto3" json:"sla_breached_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -398,6 +436,41 @@ func (x *Order) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Order) GetShippingAddress() *Address {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return nil
+}
+
+func (x *Order) GetBillingAddress() *Address {
+	if x != nil {
+		return x.BillingAddress
+	}
+	return nil
+}
+
+func (x *Order) GetShippingMethod() string {
+	if x != nil {
+		return x.ShippingMethod
+	}
+	return ""
+}
+
+func (x *Order) GetEstimatedDeliveryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EstimatedDeliveryAt
+	}
+	return nil
+}
+
+func (x *Order) GetSlaBreachedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SlaBreachedAt
+	}
+	return nil
+}
+
 type OrderItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -483,16 +556,22 @@ func (x *OrderItem) GetSubtotal() float64 {
 }
 
 type OrderEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventType     string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
-	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	CustomerId    string                 `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	OldStatus     string                 `protobuf:"bytes,5,opt,name=old_status,json=oldStatus,proto3" json:"old_status,omitempty"`
-	NewStatus     string                 `protobuf:"bytes,6,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
-	Total         float64                `protobuf:"fixed64,7,opt,name=total,proto3" json:"total,omitempty"`
-	Version       int32                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
-	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EventType  string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	OrderId    string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId string                 `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Status     string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	OldStatus  string                 `protobuf:"bytes,5,opt,name=old_status,json=oldStatus,proto3" json:"old_status,omitempty"`
+	NewStatus  string                 `protobuf:"bytes,6,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	Total      float64                `protobuf:"fixed64,7,opt,name=total,proto3" json:"total,omitempty"`
+	Version    int32                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Opaque position of the stream after this event, for
+	// WatchOrdersRequest.resume_token.
+	ResumeToken string `protobuf:"bytes,10,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// Identifies the change this event reports; redelivered events keep it.
+	// With version it lets clients drop duplicates and spot missed changes.
+	EventId       string `protobuf:"bytes,11,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -590,6 +669,410 @@ func (x *OrderEvent) GetOccurredAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *OrderEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+// CreateOrderRequest is an order to create, as the HTTP API's create order
+// request.
+type CreateOrderRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId      string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Items           []*CreateOrderItem     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Metadata        map[string]string      `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Tags            []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	ShippingAddress *Address               `protobuf:"bytes,5,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	BillingAddress  *Address               `protobuf:"bytes,6,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address,omitempty"`
+	// Empty uses the default shipping method.
+	ShippingMethod string `protobuf:"bytes,7,opt,name=shipping_method,json=shippingMethod,proto3" json:"shipping_method,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateOrderRequest) Reset() {
+	*x = CreateOrderRequest{}
+	mi := &file_api_proto_order_v1_order_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderRequest) ProtoMessage() {}
+
+func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateOrderRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetItems() []*CreateOrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetShippingAddress() *Address {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetBillingAddress() *Address {
+	if x != nil {
+		return x.BillingAddress
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetShippingMethod() string {
+	if x != nil {
+		return x.ShippingMethod
+	}
+	return ""
+}
+
+type CreateOrderItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity  int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Zero leaves the price to the pricing service.
+	Price         float64 `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderItem) Reset() {
+	*x = CreateOrderItem{}
+	mi := &file_api_proto_order_v1_order_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderItem) ProtoMessage() {}
+
+func (x *CreateOrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderItem.ProtoReflect.Descriptor instead.
+func (*CreateOrderItem) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateOrderItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateOrderItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateOrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreateOrderItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type ImportOrdersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Orders received, and of those the ones created and the ones rejected.
+	Received int32 `protobuf:"varint,1,opt,name=received,proto3" json:"received,omitempty"`
+	Imported int32 `protobuf:"varint,2,opt,name=imported,proto3" json:"imported,omitempty"`
+	Failed   int32 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	// The first 1000 rejected orders, in the order they were received.
+	Errors        []*ImportError `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOrdersResponse) Reset() {
+	*x = ImportOrdersResponse{}
+	mi := &file_api_proto_order_v1_order_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOrdersResponse) ProtoMessage() {}
+
+func (x *ImportOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ImportOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ImportOrdersResponse) GetReceived() int32 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *ImportOrdersResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportOrdersResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportOrdersResponse) GetErrors() []*ImportError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// ImportError is an order ImportOrders rejected.
+type ImportError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position of the order in the request stream, from 0.
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Error code, as in the HTTP API's error responses.
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportError) Reset() {
+	*x = ImportError{}
+	mi := &file_api_proto_order_v1_order_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportError) ProtoMessage() {}
+
+func (x *ImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportError.ProtoReflect.Descriptor instead.
+func (*ImportError) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ImportError) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ImportError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ImportError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Address is a postal address. country is an ISO 3166-1 alpha-2 code.
+type Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Line1         string                 `protobuf:"bytes,2,opt,name=line1,proto3" json:"line1,omitempty"`
+	Line2         string                 `protobuf:"bytes,3,opt,name=line2,proto3" json:"line2,omitempty"`
+	City          string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	Region        string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,6,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Country       string                 `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_api_proto_order_v1_order_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_v1_order_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_v1_order_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Address) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Address) GetLine1() string {
+	if x != nil {
+		return x.Line1
+	}
+	return ""
+}
+
+func (x *Address) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
 var File_api_proto_order_v1_order_service_proto protoreflect.FileDescriptor
 
 const file_api_proto_order_v1_order_service_proto_rawDesc = "" +
@@ -598,13 +1081,15 @@ const file_api_proto_order_v1_order_service_proto_rawDesc = "" +
 	"\x0fGetOrderRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\"9\n" +
 	"\x10GetOrderResponse\x12%\n" +
-	"\x05order\x18\x01 \x01(\v2\x0f.order.v1.OrderR\x05order\"}\n" +
+	"\x05order\x18\x01 \x01(\v2\x0f.order.v1.OrderR\x05order\"\x9c\x01\n" +
 	"\x11ListOrdersRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
 	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1f\n" +
 	"\vcustomer_id\x18\x04 \x01(\tR\n" +
-	"customerId\"\xb0\x01\n" +
+	"customerId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x05 \x01(\tR\tproductId\"\xb0\x01\n" +
 	"\x12ListOrdersResponse\x12'\n" +
 	"\x06orders\x18\x01 \x03(\v2\x0f.order.v1.OrderR\x06orders\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
@@ -612,9 +1097,12 @@ const file_api_proto_order_v1_order_service_proto_rawDesc = "" +
 	"\vtotal_count\x18\x04 \x01(\x03R\n" +
 	"totalCount\x12\x1f\n" +
 	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
-	"totalPages\"0\n" +
+	"totalPages\"t\n" +
 	"\x12WatchOrdersRequest\x12\x1a\n" +
-	"\bstatuses\x18\x01 \x03(\tR\bstatuses\"\xa1\x02\n" +
+	"\bstatuses\x18\x01 \x03(\tR\bstatuses\x12\x1f\n" +
+	"\vevent_types\x18\x02 \x03(\tR\n" +
+	"eventTypes\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\"\xd8\x04\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vcustomer_id\x18\x02 \x01(\tR\n" +
@@ -626,7 +1114,13 @@ const file_api_proto_order_v1_order_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9c\x01\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12<\n" +
+	"\x10shipping_address\x18\t \x01(\v2\x11.order.v1.AddressR\x0fshippingAddress\x12:\n" +
+	"\x0fbilling_address\x18\n" +
+	" \x01(\v2\x11.order.v1.AddressR\x0ebillingAddress\x12'\n" +
+	"\x0fshipping_method\x18\v \x01(\tR\x0eshippingMethod\x12N\n" +
+	"\x15estimated_delivery_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x13estimatedDeliveryAt\x12B\n" +
+	"\x0fsla_breached_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\rslaBreachedAt\"\x9c\x01\n" +
 	"\tOrderItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -634,7 +1128,7 @@ const file_api_proto_order_v1_order_service_proto_rawDesc = "" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
 	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x14\n" +
 	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1a\n" +
-	"\bsubtotal\x18\x06 \x01(\x01R\bsubtotal\"\xaa\x02\n" +
+	"\bsubtotal\x18\x06 \x01(\x01R\bsubtotal\"\xe8\x02\n" +
 	"\n" +
 	"OrderEvent\x12\x1d\n" +
 	"\n" +
@@ -650,12 +1144,52 @@ const file_api_proto_order_v1_order_service_proto_rawDesc = "" +
 	"\x05total\x18\a \x01(\x01R\x05total\x12\x18\n" +
 	"\aversion\x18\b \x01(\x05R\aversion\x12;\n" +
 	"\voccurred_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"occurredAt2\xdf\x01\n" +
+	"occurredAt\x12!\n" +
+	"\fresume_token\x18\n" +
+	" \x01(\tR\vresumeToken\x12\x19\n" +
+	"\bevent_id\x18\v \x01(\tR\aeventId\"\xa2\x03\n" +
+	"\x12CreateOrderRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12/\n" +
+	"\x05items\x18\x02 \x03(\v2\x19.order.v1.CreateOrderItemR\x05items\x12F\n" +
+	"\bmetadata\x18\x03 \x03(\v2*.order.v1.CreateOrderRequest.MetadataEntryR\bmetadata\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12<\n" +
+	"\x10shipping_address\x18\x05 \x01(\v2\x11.order.v1.AddressR\x0fshippingAddress\x12:\n" +
+	"\x0fbilling_address\x18\x06 \x01(\v2\x11.order.v1.AddressR\x0ebillingAddress\x12'\n" +
+	"\x0fshipping_method\x18\a \x01(\tR\x0eshippingMethod\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"v\n" +
+	"\x0fCreateOrderItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\"\x95\x01\n" +
+	"\x14ImportOrdersResponse\x12\x1a\n" +
+	"\breceived\x18\x01 \x01(\x05R\breceived\x12\x1a\n" +
+	"\bimported\x18\x02 \x01(\x05R\bimported\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x12-\n" +
+	"\x06errors\x18\x04 \x03(\v2\x15.order.v1.ImportErrorR\x06errors\"Q\n" +
+	"\vImportError\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xb0\x01\n" +
+	"\aAddress\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05line1\x18\x02 \x01(\tR\x05line1\x12\x14\n" +
+	"\x05line2\x18\x03 \x01(\tR\x05line2\x12\x12\n" +
+	"\x04city\x18\x04 \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\x05 \x01(\tR\x06region\x12\x1f\n" +
+	"\vpostal_code\x18\x06 \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\a \x01(\tR\acountry2\xaf\x02\n" +
 	"\fOrderService\x12A\n" +
 	"\bGetOrder\x12\x19.order.v1.GetOrderRequest\x1a\x1a.order.v1.GetOrderResponse\x12G\n" +
 	"\n" +
 	"ListOrders\x12\x1b.order.v1.ListOrdersRequest\x1a\x1c.order.v1.ListOrdersResponse\x12C\n" +
-	"\vWatchOrders\x12\x1c.order.v1.WatchOrdersRequest\x1a\x14.order.v1.OrderEvent0\x01BIZGgithub.com/sridharn-code-sandbox/go-ordersvc/api/proto/order/v1;orderv1b\x06proto3"
+	"\vWatchOrders\x12\x1c.order.v1.WatchOrdersRequest\x1a\x14.order.v1.OrderEvent0\x01\x12N\n" +
+	"\fImportOrders\x12\x1c.order.v1.CreateOrderRequest\x1a\x1e.order.v1.ImportOrdersResponse(\x01BIZGgithub.com/sridharn-code-sandbox/go-ordersvc/api/proto/order/v1;orderv1b\x06proto3"
 
 var (
 	file_api_proto_order_v1_order_service_proto_rawDescOnce sync.Once
@@ -669,7 +1203,7 @@ func file_api_proto_order_v1_order_service_proto_rawDescGZIP() []byte {
 	return file_api_proto_order_v1_order_service_proto_rawDescData
 }
 
-var file_api_proto_order_v1_order_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_proto_order_v1_order_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_api_proto_order_v1_order_service_proto_goTypes = []any{
 	(*GetOrderRequest)(nil),       // 0: order.v1.GetOrderRequest
 	(*GetOrderResponse)(nil),      // 1: order.v1.GetOrderResponse
@@ -679,26 +1213,43 @@ var file_api_proto_order_v1_order_service_proto_goTypes = []any{
 	(*Order)(nil),                 // 5: order.v1.Order
 	(*OrderItem)(nil),             // 6: order.v1.OrderItem
 	(*OrderEvent)(nil),            // 7: order.v1.OrderEvent
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*CreateOrderRequest)(nil),    // 8: order.v1.CreateOrderRequest
+	(*CreateOrderItem)(nil),       // 9: order.v1.CreateOrderItem
+	(*ImportOrdersResponse)(nil),  // 10: order.v1.ImportOrdersResponse
+	(*ImportError)(nil),           // 11: order.v1.ImportError
+	(*Address)(nil),               // 12: order.v1.Address
+	nil,                           // 13: order.v1.CreateOrderRequest.MetadataEntry
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
 }
 var file_api_proto_order_v1_order_service_proto_depIdxs = []int32{
-	5, // 0: order.v1.GetOrderResponse.order:type_name -> order.v1.Order
-	5, // 1: order.v1.ListOrdersResponse.orders:type_name -> order.v1.Order
-	6, // 2: order.v1.Order.items:type_name -> order.v1.OrderItem
-	8, // 3: order.v1.Order.created_at:type_name -> google.protobuf.Timestamp
-	8, // 4: order.v1.Order.updated_at:type_name -> google.protobuf.Timestamp
-	8, // 5: order.v1.OrderEvent.occurred_at:type_name -> google.protobuf.Timestamp
-	0, // 6: order.v1.OrderService.GetOrder:input_type -> order.v1.GetOrderRequest
-	2, // 7: order.v1.OrderService.ListOrders:input_type -> order.v1.ListOrdersRequest
-	4, // 8: order.v1.OrderService.WatchOrders:input_type -> order.v1.WatchOrdersRequest
-	1, // 9: order.v1.OrderService.GetOrder:output_type -> order.v1.GetOrderResponse
-	3, // 10: order.v1.OrderService.ListOrders:output_type -> order.v1.ListOrdersResponse
-	7, // 11: order.v1.OrderService.WatchOrders:output_type -> order.v1.OrderEvent
-	9, // [9:12] is the sub-list for method output_type
-	6, // [6:9] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	5,  // 0: order.v1.GetOrderResponse.order:type_name -> order.v1.Order
+	5,  // 1: order.v1.ListOrdersResponse.orders:type_name -> order.v1.Order
+	6,  // 2: order.v1.Order.items:type_name -> order.v1.OrderItem
+	14, // 3: order.v1.Order.created_at:type_name -> google.protobuf.Timestamp
+	14, // 4: order.v1.Order.updated_at:type_name -> google.protobuf.Timestamp
+	12, // 5: order.v1.Order.shipping_address:type_name -> order.v1.Address
+	12, // 6: order.v1.Order.billing_address:type_name -> order.v1.Address
+	14, // 7: order.v1.Order.estimated_delivery_at:type_name -> google.protobuf.Timestamp
+	14, // 8: order.v1.Order.sla_breached_at:type_name -> google.protobuf.Timestamp
+	14, // 9: order.v1.OrderEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	9,  // 10: order.v1.CreateOrderRequest.items:type_name -> order.v1.CreateOrderItem
+	13, // 11: order.v1.CreateOrderRequest.metadata:type_name -> order.v1.CreateOrderRequest.MetadataEntry
+	12, // 12: order.v1.CreateOrderRequest.shipping_address:type_name -> order.v1.Address
+	12, // 13: order.v1.CreateOrderRequest.billing_address:type_name -> order.v1.Address
+	11, // 14: order.v1.ImportOrdersResponse.errors:type_name -> order.v1.ImportError
+	0,  // 15: order.v1.OrderService.GetOrder:input_type -> order.v1.GetOrderRequest
+	2,  // 16: order.v1.OrderService.ListOrders:input_type -> order.v1.ListOrdersRequest
+	4,  // 17: order.v1.OrderService.WatchOrders:input_type -> order.v1.WatchOrdersRequest
+	8,  // 18: order.v1.OrderService.ImportOrders:input_type -> order.v1.CreateOrderRequest
+	1,  // 19: order.v1.OrderService.GetOrder:output_type -> order.v1.GetOrderResponse
+	3,  // 20: order.v1.OrderService.ListOrders:output_type -> order.v1.ListOrdersResponse
+	7,  // 21: order.v1.OrderService.WatchOrders:output_type -> order.v1.OrderEvent
+	10, // 22: order.v1.OrderService.ImportOrders:output_type -> order.v1.ImportOrdersResponse
+	19, // [19:23] is the sub-list for method output_type
+	15, // [15:19] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_order_v1_order_service_proto_init() }
@@ -712,7 +1263,7 @@ func file_api_proto_order_v1_order_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_order_v1_order_service_proto_rawDesc), len(file_api_proto_order_v1_order_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
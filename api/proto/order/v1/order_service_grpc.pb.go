@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrderService_GetOrder_FullMethodName    = "/order.v1.OrderService/GetOrder"
-	OrderService_ListOrders_FullMethodName  = "/order.v1.OrderService/ListOrders"
-	OrderService_WatchOrders_FullMethodName = "/order.v1.OrderService/WatchOrders"
+	OrderService_GetOrder_FullMethodName     = "/order.v1.OrderService/GetOrder"
+	OrderService_ListOrders_FullMethodName   = "/order.v1.OrderService/ListOrders"
+	OrderService_WatchOrders_FullMethodName  = "/order.v1.OrderService/WatchOrders"
+	OrderService_ImportOrders_FullMethodName = "/order.v1.OrderService/ImportOrders"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -36,6 +37,11 @@ type OrderServiceClient interface {
 	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
 	// WatchOrders streams new order events to the client.
 	WatchOrders(ctx context.Context, in *WatchOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderEvent], error)
+	// ImportOrders creates the orders the client streams, as for migrating
+	// orders from another system. Orders are validated and inserted in
+	// batches; an invalid order is reported in the response without stopping
+	// the import.
+	ImportOrders(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CreateOrderRequest, ImportOrdersResponse], error)
 }
 
 type orderServiceClient struct {
@@ -85,6 +91,19 @@ func (c *orderServiceClient) WatchOrders(ctx context.Context, in *WatchOrdersReq
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type OrderService_WatchOrdersClient = grpc.ServerStreamingClient[OrderEvent]
 
+func (c *orderServiceClient) ImportOrders(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CreateOrderRequest, ImportOrdersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrderService_ServiceDesc.Streams[1], OrderService_ImportOrders_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CreateOrderRequest, ImportOrdersResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderService_ImportOrdersClient = grpc.ClientStreamingClient[CreateOrderRequest, ImportOrdersResponse]
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility.
@@ -97,6 +116,11 @@ type OrderServiceServer interface {
 	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
 	// WatchOrders streams new order events to the client.
 	WatchOrders(*WatchOrdersRequest, grpc.ServerStreamingServer[OrderEvent]) error
+	// ImportOrders creates the orders the client streams, as for migrating
+	// orders from another system. Orders are validated and inserted in
+	// batches; an invalid order is reported in the response without stopping
+	// the import.
+	ImportOrders(grpc.ClientStreamingServer[CreateOrderRequest, ImportOrdersResponse]) error
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -116,6 +140,9 @@ func (UnimplementedOrderServiceServer) ListOrders(context.Context, *ListOrdersRe
 func (UnimplementedOrderServiceServer) WatchOrders(*WatchOrdersRequest, grpc.ServerStreamingServer[OrderEvent]) error {
 	return status.Error(codes.Unimplemented, "method WatchOrders not implemented")
 }
+func (UnimplementedOrderServiceServer) ImportOrders(grpc.ClientStreamingServer[CreateOrderRequest, ImportOrdersResponse]) error {
+	return status.Error(codes.Unimplemented, "method ImportOrders not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
 
@@ -184,6 +211,13 @@ func _OrderService_WatchOrders_Handler(srv interface{}, stream grpc.ServerStream
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type OrderService_WatchOrdersServer = grpc.ServerStreamingServer[OrderEvent]
 
+func _OrderService_ImportOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OrderServiceServer).ImportOrders(&grpc.GenericServerStream[CreateOrderRequest, ImportOrdersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderService_ImportOrdersServer = grpc.ClientStreamingServer[CreateOrderRequest, ImportOrdersResponse]
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -206,6 +240,11 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _OrderService_WatchOrders_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ImportOrders",
+			Handler:       _OrderService_ImportOrders_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "api/proto/order/v1/order_service.proto",
 }
@@ -16,17 +16,61 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/app"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/config"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/seed"
 )
 
 var version = "dev"
 
+const usage = `Usage: ordersvc [flags] [mode]
+
+Modes:
+  serve    Serve the HTTP and gRPC APIs (default)
+  worker   Run background jobs from the job queue
+  seed     Insert generated orders into the database, for demos and
+           performance testing; see ordersvc seed -h
+
+Flags:
+`
+
+// modeSeed runs a one-off seed instead of the server
+const modeSeed = "seed"
+
 func main() {
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "YAML config file; environment variables override its values (env CONFIG_FILE)")
+	migrate := flag.Bool("migrate", false, "apply pending database migrations on startup (same as DATABASE_AUTO_MIGRATE=true)")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	mode := app.ModeServe
+	if flag.NArg() > 0 {
+		mode = app.Mode(flag.Arg(0))
+	}
+	var seedOpts seed.Options
+	switch {
+	case mode == modeSeed:
+		seedOpts = parseSeedFlags(flag.Args()[1:])
+	case (mode != app.ModeServe && mode != app.ModeWorker) || flag.NArg() > 1:
+		fmt.Fprintf(flag.CommandLine.Output(), "unknown mode %q\n\n", strings.Join(flag.Args(), " "))
+		flag.Usage()
+		os.Exit(2)
+	}
+
 	// Load configuration
-	cfg, err := config.LoadFromEnv()
+	cfg, err := config.Load(*configPath)
 	if err != nil {
 		fmt.Printf("Failed to load configuration: %v\n", err)
 		os.Exit(1)
@@ -35,9 +79,54 @@ func main() {
 	// Set version
 	cfg.App.Version = version
 
-	// Run server
-	if err := Run(cfg); err != nil {
+	if *migrate {
+		cfg.Database.AutoMigrate = true
+	}
+
+	// Fail fast on settings that would only break once serving
+	if err := cfg.Validate(); err != nil {
+		fmt.Printf("%v\n", err)
+		os.Exit(1)
+	}
+
+	if mode == modeSeed {
+		ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		defer stop()
+		if err := app.Seed(ctx, cfg, seedOpts); err != nil {
+			fmt.Printf("Seed failed: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Run server; SIGHUP reloads the settings that can change while serving
+	if err := app.Run(config.NewProvider(*configPath, cfg), mode); err != nil {
 		fmt.Printf("Server failed: %v\n", err)
 		os.Exit(1)
 	}
 }
+
+// parseSeedFlags parses the flags after the seed mode, exiting on bad input
+func parseSeedFlags(args []string) seed.Options {
+	fs := flag.NewFlagSet(modeSeed, flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: ordersvc [flags] seed [seed flags]\n\nSeed flags:")
+		fs.PrintDefaults()
+	}
+	opts := seed.Options{}
+	fs.IntVar(&opts.Orders, "orders", 10000, "number of orders to create")
+	fs.IntVar(&opts.Customers, "customers", 500, "number of customers the orders are spread across")
+	fs.DurationVar(&opts.Span, "span", 180*24*time.Hour, "how far back order creation dates reach")
+	fs.IntVar(&opts.BatchSize, "batch-size", 1000, "orders inserted per batch")
+	fs.Uint64Var(&opts.Seed, "seed", 0, "random seed; runs with the same seed and flags generate the same orders (default a new seed per run)")
+	_ = fs.Parse(args) // ExitOnError
+	if opts.Seed == 0 {
+		opts.Seed = uint64(time.Now().UnixNano())
+	}
+	if fs.NArg() > 0 || opts.Orders < 0 || opts.Customers < 1 || opts.BatchSize < 1 || opts.Span < 0 {
+		fmt.Fprintf(fs.Output(), "invalid seed flags %q\n\n", strings.Join(args, " "))
+		fs.Usage()
+		os.Exit(2)
+	}
+	return opts
+}
@@ -0,0 +1,366 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/segmentio/kafka-go"
+	"github.com/sridharn-code-sandbox/go-ordersvc/db/migrations"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/audit"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/config"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+	kafkapub "github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging/kafka"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging/schemaregistry"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository/postgres"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/secrets"
+)
+
+func runEvents(ctx context.Context, c *cli, args []string) error {
+	cfg, err := config.Load(os.Getenv("CONFIG_FILE"))
+	if err != nil {
+		return err
+	}
+
+	var brokers, eventType, orderID string
+	var fromBeginning bool
+	fs := c.flags("events", "")
+	fs.StringVar(&brokers, "brokers", strings.Join(cfg.Kafka.Brokers, ","), "comma-separated Kafka brokers (env KAFKA_BROKERS)")
+	fs.StringVar(&cfg.Kafka.Topic, "topic", cfg.Kafka.Topic, "event topic (env KAFKA_TOPIC)")
+	fs.StringVar(&eventType, "type", "", "only events of this type, e.g. order.status_changed")
+	fs.StringVar(&orderID, "order", "", "only events for this order ID")
+	fs.BoolVar(&fromBeginning, "from-beginning", false, "replay the topic from the oldest retained event")
+	if err := parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	codec, err := eventCodec(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	routing, err := messaging.ParseTopicRouting(cfg.Kafka.TopicRouting)
+	if err != nil {
+		return err
+	}
+	router, err := messaging.NewTopicRouter(cfg.Kafka.Topic, routing, cfg.Kafka.EventTopics)
+	if err != nil {
+		return err
+	}
+	// With routing, an event type has a topic to itself or shares the main
+	// one; either way its topic alone carries it
+	topics := router.Topics()
+	if eventType != "" && router.Routed() {
+		topics = []string{router.Topic(eventType)}
+	}
+
+	start := kafka.LastOffset
+	if fromBeginning {
+		start = kafka.FirstOffset
+	}
+	// A throwaway consumer group reads every partition; offsets are never
+	// committed, so tailing does not disturb the service's own groups.
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     strings.Split(brokers, ","),
+		GroupTopics: topics,
+		GroupID:     "ordersvcctl-" + uuid.NewString(),
+		StartOffset: start,
+	})
+	defer reader.Close()
+
+	for {
+		msg, err := reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		// Messages name their event type in a header, so others are
+		// skipped without decoding them
+		if t := messageHeader(msg, kafkapub.EventTypeHeader); eventType != "" && t != "" && t != eventType {
+			continue
+		}
+		evt, err := codec.Decode(ctx, msg.Value)
+		if err != nil {
+			fmt.Fprintf(c.stderr, "skipping undecodable message at %s partition %d offset %d: %v\n", msg.Topic, msg.Partition, msg.Offset, err)
+			continue
+		}
+		if (eventType != "" && evt.EventType != eventType) || (orderID != "" && evt.OrderID != orderID) {
+			continue
+		}
+
+		if c.json {
+			err = c.printJSON(evt)
+		} else {
+			err = printEvent(c, evt)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// messageHeader returns the value of the header of msg named key, or "".
+func messageHeader(msg kafka.Message, key string) string {
+	for _, h := range msg.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func printEvent(c *cli, evt messaging.OrderEvent) error {
+	detail := evt.Status
+	if evt.OldStatus != "" {
+		detail = evt.OldStatus + " -> " + evt.NewStatus
+	}
+	if evt.EventType == messaging.EventCustomerDataErased {
+		detail = fmt.Sprintf("%d orders", evt.OrderCount)
+	}
+	_, err := fmt.Fprintf(c.stdout, "%s  %-22s  %-36s  %s  v%d\n",
+		evt.OccurredAt.Format(time.RFC3339), evt.EventType, evt.Key(), detail, evt.Version)
+	return err
+}
+
+func runMigrate(ctx context.Context, c *cli, args []string) error {
+	flags := c.flags("migrate", "[up|version]")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	action := "up"
+	switch flags.NArg() {
+	case 0:
+	case 1:
+		action = flags.Arg(0)
+	default:
+		flags.Usage()
+		return errUsage
+	}
+	if action != "up" && action != "version" {
+		flags.Usage()
+		return errUsage
+	}
+
+	cfg, pool, err := openDatabase(ctx)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	migrationsFS := fs.FS(migrations.FS)
+	if cfg.Database.MigrationsPath != "" {
+		migrationsFS = os.DirFS(cfg.Database.MigrationsPath)
+	}
+	migrator, err := postgres.NewMigrator(pool, migrationsFS)
+	if err != nil {
+		return err
+	}
+
+	if action == "up" {
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(c.stdout, "applied %d migrations\n", applied)
+	}
+
+	current, dirty, err := migrator.Version(ctx)
+	if err != nil {
+		return err
+	}
+	state := "clean"
+	if dirty {
+		state = "dirty"
+	}
+	_, err = fmt.Fprintf(c.stdout, "schema version %d (%s), latest %d\n", current, state, migrator.Latest())
+	return err
+}
+
+// eventCodec returns the codec that decodes avro and protobuf events through
+// the schema registry, or nil when the configured event format is JSON.
+func eventCodec(ctx context.Context, cfg *config.Config) (*messaging.SchemaCodec, error) {
+	format, err := messaging.ParseEventFormat(cfg.Kafka.EventFormat)
+	if err != nil || !format.UsesSchemaRegistry() {
+		return nil, err
+	}
+	strategy, err := messaging.ParseSubjectStrategy(cfg.Kafka.SchemaSubjectStrategy)
+	if err != nil {
+		return nil, err
+	}
+	secretManager, err := secrets.Setup(ctx, secrets.Config{
+		Refresh: cfg.Secrets.RefreshInterval,
+		Vault: secrets.VaultConfig{
+			Addr:      cfg.Secrets.VaultAddr,
+			Token:     cfg.Secrets.VaultToken,
+			Namespace: cfg.Secrets.VaultNamespace,
+		},
+		AWSEndpoint: cfg.Secrets.AWSEndpoint,
+	}, cfg.Kafka.SchemaRegistryPassword)
+	if err != nil {
+		return nil, err
+	}
+	if err := secretManager.ResolveAll(ctx, &cfg.Kafka.SchemaRegistryPassword); err != nil {
+		return nil, err
+	}
+	return messaging.NewSchemaCodec(messaging.SchemaCodecConfig{
+		Registry: schemaregistry.NewClient(schemaregistry.Config{
+			URL:      cfg.Kafka.SchemaRegistryURL,
+			Username: cfg.Kafka.SchemaRegistryUsername,
+			Password: cfg.Kafka.SchemaRegistryPassword,
+		}),
+		Format:   format,
+		Topic:    cfg.Kafka.Topic,
+		Strategy: strategy,
+	})
+}
+
+// openDatabase loads the service configuration from CONFIG_FILE and the
+// environment and connects to its database.
+func openDatabase(ctx context.Context) (*config.Config, *pgxpool.Pool, error) {
+	cfg, err := config.Load(os.Getenv("CONFIG_FILE"))
+	if err != nil {
+		return nil, nil, err
+	}
+	secretManager, err := secrets.Setup(ctx, secrets.Config{
+		Refresh: cfg.Secrets.RefreshInterval,
+		Vault: secrets.VaultConfig{
+			Addr:      cfg.Secrets.VaultAddr,
+			Token:     cfg.Secrets.VaultToken,
+			Namespace: cfg.Secrets.VaultNamespace,
+		},
+		AWSEndpoint: cfg.Secrets.AWSEndpoint,
+	}, cfg.Database.Password)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := secretManager.ResolveAll(ctx, &cfg.Database.Password); err != nil {
+		return nil, nil, err
+	}
+	pool, err := pgxpool.New(ctx, cfg.Database.DSN())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	return cfg, pool, nil
+}
+
+func runPartitionOrders(ctx context.Context, c *cli, args []string) error {
+	var monthsAhead int
+	fs := c.flags("partition-orders", "")
+	fs.IntVar(&monthsAhead, "months-ahead", 0, "months of partitions to create beyond the current one (default partitions.months_ahead)")
+	if err := parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	cfg, pool, err := openDatabase(ctx)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+	if monthsAhead < 1 {
+		monthsAhead = cfg.Partitions.MonthsAhead
+	}
+
+	created, err := postgres.NewPartitionRepository(pool).PartitionOrders(ctx, monthsAhead)
+	if err != nil {
+		return err
+	}
+	if created == 0 {
+		_, err = fmt.Fprintln(c.stdout, "orders table is already partitioned")
+		return err
+	}
+	_, err = fmt.Fprintf(c.stdout, "partitioned orders table into %d monthly partitions\n", created)
+	return err
+}
+
+func runHealth(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags("health", "")
+	if err := parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	health, err := c.client().Ready(ctx)
+	if health == nil {
+		return err
+	}
+	if c.json {
+		if perr := c.printJSON(health); perr != nil {
+			return perr
+		}
+		return err
+	}
+
+	names := make([]string, 0, len(health.Checks))
+	for name := range health.Checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tw := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "status\t%s\n", health.Status)
+	fmt.Fprintf(tw, "version\t%s\n", health.Version)
+	for _, name := range names {
+		fmt.Fprintf(tw, "%s\t%s\n", name, health.Checks[name])
+	}
+	if ferr := tw.Flush(); ferr != nil {
+		return ferr
+	}
+	return err
+}
+
+// runVerifyAudit checks the hash chain of an audit log file
+func runVerifyAudit(_ context.Context, c *cli, args []string) error {
+	fs := c.flags("verify-audit", "<file>")
+	if err := parse(fs, args, 1); err != nil {
+		return err
+	}
+
+	f, err := os.Open(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	result, err := audit.Verify(f)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJSON(result)
+	}
+	if result.Entries == 0 {
+		_, err = fmt.Fprintln(c.stdout, "no entries")
+		return err
+	}
+	last := result.FirstSeq + int64(result.Entries) - 1
+	if _, err := fmt.Fprintf(c.stdout, "verified %d entries (%d-%d), last hash %s\n", result.Entries, result.FirstSeq, last, result.LastHash); err != nil {
+		return err
+	}
+	if result.FirstSeq != 1 {
+		// Rotated logs: this must match the last hash of the previous file
+		_, err = fmt.Fprintf(c.stdout, "continues a log whose last hash is %s\n", result.PrevHash)
+	}
+	return err
+}
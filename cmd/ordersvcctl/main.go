@@ -0,0 +1,182 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package main is ordersvcctl, the operator CLI for ordersvc.
+//
+// Order commands talk to the HTTP API through pkg/client; events reads the
+// Kafka topic directly, verify-audit reads an audit log file, and migrate,
+// export and restore connect to PostgreSQL using the same CONFIG_FILE and
+// environment variables as the service.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/pkg/client"
+)
+
+var version = "dev"
+
+const usage = `Usage: ordersvcctl [global flags] <command> [flags] [args]
+
+Commands:
+  get <id>                  Show an order
+  list                      List orders
+  create                    Create an order
+  status <id> <status>      Transition an order's status
+  events                    Tail the Kafka order event stream
+  migrate [up|version]      Apply or show database migrations
+  partition-orders          Partition the orders table by month of creation
+  export -to <url>          Export all orders to newline-delimited JSON
+  restore -from <url>       Restore orders from an export
+  verify-audit <file>       Check the hash chain of an audit log
+  health                    Check service readiness
+  version                   Print the CLI version
+
+Global flags:
+`
+
+// errUsage is returned for bad arguments; main exits with status 2 for it.
+var errUsage = errors.New("invalid usage")
+
+// cli holds global flags and the writers commands print to.
+type cli struct {
+	addr   string
+	actor  string
+	token  string
+	json   bool
+	stdout io.Writer
+	stderr io.Writer
+}
+
+type command func(ctx context.Context, c *cli, args []string) error
+
+var commands = map[string]command{
+	"get":              runGet,
+	"list":             runList,
+	"create":           runCreate,
+	"status":           runStatus,
+	"events":           runEvents,
+	"migrate":          runMigrate,
+	"partition-orders": runPartitionOrders,
+	"export":           runExport,
+	"restore":          runRestore,
+	"verify-audit":     runVerifyAudit,
+	"health":           runHealth,
+	"version": func(_ context.Context, c *cli, _ []string) error {
+		_, err := fmt.Fprintln(c.stdout, version)
+		return err
+	},
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	c := &cli{stdout: stdout, stderr: stderr}
+
+	fs := flag.NewFlagSet("ordersvcctl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&c.addr, "addr", envOr("ORDERSVC_URL", "http://localhost:8080"), "ordersvc HTTP address (env ORDERSVC_URL)")
+	fs.StringVar(&c.actor, "actor", os.Getenv("ORDERSVC_ACTOR"), "actor recorded in order history (env ORDERSVC_ACTOR)")
+	fs.StringVar(&c.token, "token", os.Getenv("ORDERSVC_TOKEN"), "bearer token for services that authenticate callers (env ORDERSVC_TOKEN)")
+	fs.BoolVar(&c.json, "json", false, "print JSON instead of tables")
+	fs.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	cmd, ok := commands[fs.Arg(0)]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown command %q\n\n", fs.Arg(0))
+		fs.Usage()
+		return 2
+	}
+
+	if err := cmd(ctx, c, fs.Args()[1:]); err != nil {
+		if errors.Is(err, errUsage) || errors.Is(err, flag.ErrHelp) {
+			return 2
+		}
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// client returns an API client for the global -addr, -actor and -token flags.
+func (c *cli) client() *client.Client {
+	opts := []client.Option{client.WithUserAgent("ordersvcctl/" + version)}
+	if c.actor != "" {
+		opts = append(opts, client.WithActor(c.actor))
+	}
+	if c.token != "" {
+		opts = append(opts, client.WithBearerToken(c.token))
+	}
+	return client.New(c.addr, opts...)
+}
+
+// flags returns a flag set for a subcommand that reports errors to stderr.
+func (c *cli) flags(name, args string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(c.stderr, "Usage: ordersvcctl %s [flags] %s\n", name, args)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parse parses args and checks the number of positional arguments.
+func parse(fs *flag.FlagSet, args []string, positional int) error {
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != positional {
+		fs.Usage()
+		return errUsage
+	}
+	return nil
+}
+
+func (c *cli) printJSON(v any) error {
+	enc := json.NewEncoder(c.stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
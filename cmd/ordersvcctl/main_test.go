@@ -0,0 +1,119 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseItem(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    client.ItemInput
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			input: "p-1:Widget:2:10.50",
+			want:  client.ItemInput{ProductID: "p-1", Name: "Widget", Quantity: 2, Price: 10.50},
+		},
+		{
+			name:  "name with colon",
+			input: "p-2:Cable 2m: USB-C:1:5",
+			want:  client.ItemInput{ProductID: "p-2", Name: "Cable 2m: USB-C", Quantity: 1, Price: 5},
+		},
+		{name: "missing fields", input: "p-1:Widget", wantErr: true},
+		{name: "bad quantity", input: "p-1:Widget:two:1", wantErr: true},
+		{name: "bad price", input: "p-1:Widget:2:free", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseItem(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRun_UnknownCommand_ExitsWithUsage(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	code := run(context.Background(), []string{"frobnicate"}, &stdout, &stderr)
+
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr.String(), `unknown command "frobnicate"`)
+}
+
+func TestRun_Create_SendsItemsAndActor(t *testing.T) {
+	var got client.CreateOrderRequest
+	var actor string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actor = r.Header.Get(client.ActorHeader)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(client.Order{ID: "o-1"})
+	}))
+	defer srv.Close()
+	var stdout, stderr bytes.Buffer
+
+	code := run(context.Background(), []string{
+		"-addr", srv.URL, "-actor", "ops",
+		"create", "-customer", "cust-1", "-item", "p-1:Widget:2:10", "-item", "p-2:Gadget:1:5",
+	}, &stdout, &stderr)
+
+	require.Equal(t, 0, code, stderr.String())
+	assert.Equal(t, "o-1\n", stdout.String())
+	assert.Equal(t, "ops", actor)
+	assert.Equal(t, "cust-1", got.CustomerID)
+	assert.Len(t, got.Items, 2)
+}
+
+func TestRun_Status_APIError_ExitsNonZero(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid status transition", "code": "INVALID_TRANSITION"})
+	}))
+	defer srv.Close()
+	var stdout, stderr bytes.Buffer
+
+	code := run(context.Background(), []string{"-addr", srv.URL, "status", "o-1", "delivered"}, &stdout, &stderr)
+
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr.String(), "INVALID_TRANSITION")
+}
+
+func TestRun_Export_WithoutDestination_ExitsWithUsage(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	code := run(context.Background(), []string{"export"}, &stdout, &stderr)
+
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr.String(), "Usage: ordersvcctl export")
+}
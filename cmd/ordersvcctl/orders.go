@@ -0,0 +1,230 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/pkg/client"
+)
+
+func runGet(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags("get", "<id>")
+	if err := parse(fs, args, 1); err != nil {
+		return err
+	}
+
+	order, err := c.client().GetOrder(ctx, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJSON(order)
+	}
+
+	tw := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "ID:\t%s\n", order.ID)
+	fmt.Fprintf(tw, "Customer:\t%s\n", order.CustomerID)
+	fmt.Fprintf(tw, "Status:\t%s\n", order.Status)
+	fmt.Fprintf(tw, "Total:\t%.2f\n", order.Total)
+	fmt.Fprintf(tw, "Version:\t%d\n", order.Version)
+	fmt.Fprintf(tw, "Created:\t%s\n", order.CreatedAt.Format(time.RFC3339))
+	fmt.Fprintf(tw, "Updated:\t%s\n", order.UpdatedAt.Format(time.RFC3339))
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "PRODUCT\tNAME\tQTY\tPRICE\tSUBTOTAL")
+	for _, item := range order.Items {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%.2f\t%.2f\n", item.ProductID, item.Name, item.Quantity, item.Price, item.Subtotal)
+	}
+	return tw.Flush()
+}
+
+func runList(ctx context.Context, c *cli, args []string) error {
+	var opts client.ListOrdersOptions
+	var status string
+	var all bool
+	fs := c.flags("list", "")
+	fs.StringVar(&status, "status", "", "only orders in this status")
+	fs.StringVar(&opts.CustomerID, "customer", "", "only orders of this customer")
+	fs.StringVar(&opts.ProductID, "product", "", "only orders containing this product")
+	fs.IntVar(&opts.Limit, "limit", 20, "page size (max 100)")
+	fs.IntVar(&opts.Offset, "offset", 0, "number of orders to skip")
+	fs.BoolVar(&all, "all", false, "follow pages until every matching order is listed")
+	if err := parse(fs, args, 0); err != nil {
+		return err
+	}
+	opts.Status = client.OrderStatus(status)
+
+	if all {
+		var orders []client.Order
+		for order, err := range c.client().Orders(ctx, opts) {
+			if err != nil {
+				return err
+			}
+			orders = append(orders, *order)
+		}
+		if c.json {
+			return c.printJSON(orders)
+		}
+		return writeOrderTable(c.stdout, orders)
+	}
+
+	page, err := c.client().ListOrders(ctx, opts)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJSON(page)
+	}
+	if err := writeOrderTable(c.stdout, page.Orders); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stderr, "%d of %d orders from offset %d\n", len(page.Orders), page.Total, page.Offset)
+	return nil
+}
+
+func writeOrderTable(w io.Writer, orders []client.Order) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tCUSTOMER\tSTATUS\tTOTAL\tITEMS\tVERSION\tUPDATED")
+	for _, o := range orders {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%.2f\t%d\t%d\t%s\n",
+			o.ID, o.CustomerID, o.Status, o.Total, len(o.Items), o.Version, o.UpdatedAt.Format(time.RFC3339))
+	}
+	return tw.Flush()
+}
+
+// itemsFlag collects repeated -item product_id:name:quantity:price values.
+type itemsFlag []client.ItemInput
+
+func (f *itemsFlag) String() string {
+	return fmt.Sprintf("%d items", len(*f))
+}
+
+func (f *itemsFlag) Set(v string) error {
+	item, err := parseItem(v)
+	if err != nil {
+		return err
+	}
+	*f = append(*f, item)
+	return nil
+}
+
+// parseItem parses product_id:name:quantity:price. The name may contain colons.
+func parseItem(v string) (client.ItemInput, error) {
+	first := strings.Index(v, ":")
+	last := strings.LastIndex(v, ":")
+	if first < 0 || first == last {
+		return client.ItemInput{}, fmt.Errorf("item %q: want product_id:name:quantity:price", v)
+	}
+	productID, rest, price := v[:first], v[first+1:last], v[last+1:]
+
+	sep := strings.LastIndex(rest, ":")
+	if sep < 0 {
+		return client.ItemInput{}, fmt.Errorf("item %q: want product_id:name:quantity:price", v)
+	}
+	name, qty := rest[:sep], rest[sep+1:]
+
+	quantity, err := strconv.Atoi(qty)
+	if err != nil {
+		return client.ItemInput{}, fmt.Errorf("item %q: invalid quantity: %w", v, err)
+	}
+	p, err := strconv.ParseFloat(price, 64)
+	if err != nil {
+		return client.ItemInput{}, fmt.Errorf("item %q: invalid price: %w", v, err)
+	}
+	return client.ItemInput{ProductID: productID, Name: name, Quantity: quantity, Price: p}, nil
+}
+
+func runCreate(ctx context.Context, c *cli, args []string) error {
+	var req client.CreateOrderRequest
+	var items itemsFlag
+	var file, key string
+	fs := c.flags("create", "")
+	fs.StringVar(&req.CustomerID, "customer", "", "customer ID")
+	fs.Var(&items, "item", "item as product_id:name:quantity:price (repeatable)")
+	fs.StringVar(&file, "f", "", `read the order as JSON from a file ("-" for stdin) instead of flags`)
+	fs.StringVar(&key, "idempotency-key", "", "Idempotency-Key to send; rerunning with the same key creates the order once")
+	if err := parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	if file != "" {
+		if err := readJSON(file, &req); err != nil {
+			return err
+		}
+	} else {
+		req.Items = items
+	}
+
+	var opts []client.CallOption
+	if key != "" {
+		opts = append(opts, client.WithIdempotencyKey(key))
+	}
+	order, err := c.client().CreateOrder(ctx, req, opts...)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJSON(order)
+	}
+	_, err = fmt.Fprintln(c.stdout, order.ID)
+	return err
+}
+
+func readJSON(path string, v any) error {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path) // #nosec G304 -- operator-supplied input file
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+	if err := json.NewDecoder(r).Decode(v); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return nil
+}
+
+func runStatus(ctx context.Context, c *cli, args []string) error {
+	var expected int
+	fs := c.flags("status", "<id> <status>")
+	fs.IntVar(&expected, "version", 0, "fail unless the order is at this version")
+	if err := parse(fs, args, 2); err != nil {
+		return err
+	}
+
+	var opts []client.CallOption
+	if expected > 0 {
+		opts = append(opts, client.WithExpectedVersion(expected))
+	}
+	order, err := c.client().UpdateStatus(ctx, fs.Arg(0), client.OrderStatus(fs.Arg(1)), opts...)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJSON(order)
+	}
+	_, err = fmt.Fprintf(c.stdout, "%s: %s (version %d)\n", order.ID, order.Status, order.Version)
+	return err
+}
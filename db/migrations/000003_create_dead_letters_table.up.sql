@@ -0,0 +1,19 @@
+-- Dead-letter queue for events the Kafka publisher could not deliver (ADR-0006).
+-- Rows are redelivered by a background retrier and deleted on success.
+CREATE TABLE IF NOT EXISTS dead_letters (
+    id UUID PRIMARY KEY,
+    topic VARCHAR(255) NOT NULL,
+    key VARCHAR(255) NOT NULL,
+    payload BYTEA NOT NULL,
+    headers JSONB NOT NULL DEFAULT '{}',
+    event_type VARCHAR(100) NOT NULL,
+    order_id VARCHAR(255) NOT NULL,
+    last_error TEXT NOT NULL DEFAULT '',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Covers the retrier poll: WHERE next_attempt_at <= $1 AND attempts < $2 ORDER BY next_attempt_at
+CREATE INDEX IF NOT EXISTS idx_dead_letters_next_attempt ON dead_letters(next_attempt_at, attempts);
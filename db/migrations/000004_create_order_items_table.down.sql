@@ -0,0 +1,20 @@
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS items JSONB NOT NULL DEFAULT '[]';
+
+UPDATE orders o
+SET items = (
+    SELECT COALESCE(jsonb_agg(jsonb_build_object(
+               'ID', i.id,
+               'ProductID', i.product_id,
+               'Name', i.name,
+               'Quantity', i.quantity,
+               'Price', i.price,
+               'Subtotal', i.subtotal
+           ) ORDER BY i.position), '[]')
+    FROM order_items i
+    WHERE i.order_id = o.id
+);
+
+ALTER TABLE orders ALTER COLUMN items DROP DEFAULT;
+CREATE INDEX IF NOT EXISTS idx_orders_items ON orders USING GIN(items);
+
+DROP TABLE IF EXISTS order_items;
@@ -0,0 +1,35 @@
+-- Normalize order items out of the orders.items JSONB column so items can be
+-- queried (e.g. orders containing a product) and indexed.
+CREATE TABLE IF NOT EXISTS order_items (
+    id UUID PRIMARY KEY,
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    product_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL,
+    price DECIMAL(10, 2) NOT NULL,
+    subtotal DECIMAL(10, 2) NOT NULL,
+
+    CONSTRAINT order_items_position_unique UNIQUE (order_id, position),
+    CONSTRAINT positive_quantity CHECK (quantity > 0)
+);
+
+-- Covers: WHERE EXISTS (... order_items WHERE product_id = $1 AND order_id = orders.id)
+CREATE INDEX IF NOT EXISTS idx_order_items_product_order ON order_items(product_id, order_id);
+
+-- Backfill from the JSONB column (keys are the Go field names of domain.OrderItem)
+INSERT INTO order_items (id, order_id, position, product_id, name, quantity, price, subtotal)
+SELECT COALESCE(NULLIF(e.item->>'ID', '00000000-0000-0000-0000-000000000000')::uuid, gen_random_uuid()),
+       o.id,
+       e.pos - 1,
+       e.item->>'ProductID',
+       e.item->>'Name',
+       (e.item->>'Quantity')::integer,
+       (e.item->>'Price')::numeric,
+       (e.item->>'Subtotal')::numeric
+FROM orders o
+CROSS JOIN LATERAL jsonb_array_elements(o.items) WITH ORDINALITY AS e(item, pos)
+ON CONFLICT DO NOTHING;
+
+DROP INDEX IF EXISTS idx_orders_items;
+ALTER TABLE orders DROP COLUMN IF EXISTS items;
@@ -0,0 +1,14 @@
+-- Audit trail of order mutations, written in the same transaction as the change.
+-- old_state is NULL for creation; both snapshots use the orderSnapshot JSON shape.
+CREATE TABLE IF NOT EXISTS order_history (
+    id UUID PRIMARY KEY,
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    action VARCHAR(50) NOT NULL,
+    actor VARCHAR(255) NOT NULL,
+    old_state JSONB,
+    new_state JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Covers: WHERE order_id = $1 ORDER BY created_at DESC
+CREATE INDEX IF NOT EXISTS idx_order_history_order_created ON order_history(order_id, created_at DESC);
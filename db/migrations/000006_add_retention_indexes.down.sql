@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_orders_status_updated;
+DROP INDEX IF EXISTS idx_orders_deleted_at;
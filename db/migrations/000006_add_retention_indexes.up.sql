@@ -0,0 +1,7 @@
+-- Indexes for the retention purge and the admin deleted-orders listing.
+
+-- Covers: WHERE deleted_at < $1 and WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC
+CREATE INDEX IF NOT EXISTS idx_orders_deleted_at ON orders(deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Covers: WHERE deleted_at IS NULL AND status = ANY($1) AND updated_at < $2
+CREATE INDEX IF NOT EXISTS idx_orders_status_updated ON orders(status, updated_at) WHERE deleted_at IS NULL;
@@ -0,0 +1,11 @@
+-- Audit log of customer data erasures. The customer ID itself is not kept;
+-- customer_hash is its SHA-256 so an erasure can be confirmed on request.
+CREATE TABLE IF NOT EXISTS customer_erasures (
+    id UUID PRIMARY KEY,
+    customer_hash CHAR(64) NOT NULL,
+    order_count INTEGER NOT NULL,
+    actor VARCHAR(255) NOT NULL,
+    erased_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_customer_erasures_customer_hash ON customer_erasures(customer_hash);
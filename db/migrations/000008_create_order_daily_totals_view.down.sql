@@ -0,0 +1 @@
+DROP MATERIALIZED VIEW IF EXISTS order_daily_totals;
@@ -0,0 +1,13 @@
+-- Daily order counts and revenue per status, backing the order reports when
+-- REPORTS_USE_MATERIALIZED_VIEWS is enabled. Refreshed by the report refresh job.
+CREATE MATERIALIZED VIEW IF NOT EXISTS order_daily_totals AS
+SELECT (created_at AT TIME ZONE 'UTC')::date AS day,
+       status,
+       COUNT(*) AS order_count,
+       COALESCE(SUM(total), 0) AS revenue
+FROM orders
+WHERE deleted_at IS NULL
+GROUP BY 1, 2;
+
+-- Required by REFRESH MATERIALIZED VIEW CONCURRENTLY
+CREATE UNIQUE INDEX IF NOT EXISTS idx_order_daily_totals_day_status ON order_daily_totals(day, status);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_order_items_name_trgm;
+DROP INDEX IF EXISTS idx_order_items_name_fts;
+DROP INDEX IF EXISTS idx_orders_customer_id_trgm;
+DROP INDEX IF EXISTS idx_orders_id_prefix;
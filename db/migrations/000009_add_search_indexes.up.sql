@@ -0,0 +1,14 @@
+-- Indexes for GET /api/v1/orders/search.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Covers: WHERE id::text LIKE 'prefix%'
+CREATE INDEX IF NOT EXISTS idx_orders_id_prefix ON orders((id::text) text_pattern_ops) WHERE deleted_at IS NULL;
+
+-- Covers: WHERE customer_id ILIKE '%q%' and WHERE customer_id % q
+CREATE INDEX IF NOT EXISTS idx_orders_customer_id_trgm ON orders USING GIN(customer_id gin_trgm_ops) WHERE deleted_at IS NULL;
+
+-- Covers: WHERE to_tsvector('simple', name) @@ plainto_tsquery('simple', q)
+CREATE INDEX IF NOT EXISTS idx_order_items_name_fts ON order_items USING GIN(to_tsvector('simple', name));
+
+-- Covers: WHERE q <% name
+CREATE INDEX IF NOT EXISTS idx_order_items_name_trgm ON order_items USING GIN(name gin_trgm_ops);
@@ -0,0 +1,3 @@
+-- An already partitioned orders table is left as is
+DROP FUNCTION IF EXISTS partition_orders_table(integer);
+DROP FUNCTION IF EXISTS create_orders_partition(date);
@@ -0,0 +1,99 @@
+-- Opt-in support for a created_at range-partitioned orders table with monthly
+-- partitions named orders_pYYYYMM. Nothing changes until partition_orders_table()
+-- is called (ordersvcctl partition-orders); the partition maintenance job then
+-- keeps future partitions created ahead of time.
+
+-- Creates the partition for the UTC month containing for_month if orders is
+-- partitioned and the partition does not exist. Returns its name, or NULL.
+CREATE OR REPLACE FUNCTION create_orders_partition(for_month date)
+RETURNS text AS $$
+DECLARE
+    start_month date := date_trunc('month', for_month)::date;
+    partition_name text := 'orders_p' || to_char(start_month, 'YYYYMM');
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_partitioned_table WHERE partrelid = 'orders'::regclass)
+       OR to_regclass(partition_name) IS NOT NULL THEN
+        RETURN NULL;
+    END IF;
+
+    EXECUTE format('CREATE TABLE %I PARTITION OF orders FOR VALUES FROM (%L) TO (%L)',
+                   partition_name,
+                   start_month::timestamp AT TIME ZONE 'UTC',
+                   (start_month + interval '1 month')::timestamp AT TIME ZONE 'UTC');
+    RETURN partition_name;
+END;
+$$ language 'plpgsql';
+
+-- Rewrites orders as a table partitioned by created_at, with partitions from
+-- the oldest order's month through months_ahead months from now. Takes an
+-- ACCESS EXCLUSIVE lock for the copy. Returns the number of partitions created,
+-- or 0 if orders is already partitioned.
+--
+-- The primary key becomes (id, created_at), so foreign keys from order_items
+-- and order_history are dropped; the repository deletes child rows itself.
+CREATE OR REPLACE FUNCTION partition_orders_table(months_ahead integer)
+RETURNS integer AS $$
+DECLARE
+    first_month date;
+    last_month date;
+    next_month date;
+    created integer := 0;
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_partitioned_table WHERE partrelid = 'orders'::regclass) THEN
+        RETURN 0;
+    END IF;
+
+    LOCK TABLE orders IN ACCESS EXCLUSIVE MODE;
+
+    ALTER TABLE order_items DROP CONSTRAINT IF EXISTS order_items_order_id_fkey;
+    ALTER TABLE order_history DROP CONSTRAINT IF EXISTS order_history_order_id_fkey;
+    DROP MATERIALIZED VIEW IF EXISTS order_daily_totals;
+
+    ALTER TABLE orders RENAME TO orders_unpartitioned;
+    CREATE TABLE orders (LIKE orders_unpartitioned INCLUDING DEFAULTS INCLUDING CONSTRAINTS)
+        PARTITION BY RANGE (created_at);
+
+    SELECT date_trunc('month', COALESCE(MIN(created_at), NOW()) AT TIME ZONE 'UTC')::date,
+           GREATEST(date_trunc('month', COALESCE(MAX(created_at), NOW()) AT TIME ZONE 'UTC'),
+                    date_trunc('month', NOW() AT TIME ZONE 'UTC') + make_interval(months => months_ahead))::date
+    INTO first_month, last_month
+    FROM orders_unpartitioned;
+
+    next_month := first_month;
+    WHILE next_month <= last_month LOOP
+        IF create_orders_partition(next_month) IS NOT NULL THEN
+            created := created + 1;
+        END IF;
+        next_month := (next_month + interval '1 month')::date;
+    END LOOP;
+
+    INSERT INTO orders SELECT * FROM orders_unpartitioned;
+    DROP TABLE orders_unpartitioned;
+
+    -- Indexes are built after the copy; the names match the unpartitioned schema
+    ALTER TABLE orders ADD CONSTRAINT orders_pkey PRIMARY KEY (id, created_at);
+    CREATE INDEX idx_orders_created_at ON orders(created_at DESC) WHERE deleted_at IS NULL;
+    CREATE INDEX idx_orders_status_created ON orders(status, created_at DESC) WHERE deleted_at IS NULL;
+    CREATE INDEX idx_orders_customer_created ON orders(customer_id, created_at DESC) WHERE deleted_at IS NULL;
+    CREATE INDEX idx_orders_customer_status_created ON orders(customer_id, status, created_at DESC) WHERE deleted_at IS NULL;
+    CREATE INDEX idx_orders_deleted_at ON orders(deleted_at) WHERE deleted_at IS NOT NULL;
+    CREATE INDEX idx_orders_status_updated ON orders(status, updated_at) WHERE deleted_at IS NULL;
+    CREATE INDEX idx_orders_id_prefix ON orders((id::text) text_pattern_ops) WHERE deleted_at IS NULL;
+    CREATE INDEX idx_orders_customer_id_trgm ON orders USING GIN(customer_id gin_trgm_ops) WHERE deleted_at IS NULL;
+
+    CREATE TRIGGER update_orders_updated_at BEFORE UPDATE ON orders
+        FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+    CREATE MATERIALIZED VIEW order_daily_totals AS
+    SELECT (created_at AT TIME ZONE 'UTC')::date AS day,
+           status,
+           COUNT(*) AS order_count,
+           COALESCE(SUM(total), 0) AS revenue
+    FROM orders
+    WHERE deleted_at IS NULL
+    GROUP BY 1, 2;
+    CREATE UNIQUE INDEX idx_order_daily_totals_day_status ON order_daily_totals(day, status);
+
+    RETURN created;
+END;
+$$ language 'plpgsql';
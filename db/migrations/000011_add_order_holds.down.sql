@@ -0,0 +1,12 @@
+-- Return held orders to the status they were held from before on_hold becomes invalid
+UPDATE orders SET status = held_from_status WHERE status = 'on_hold';
+
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS valid_status;
+ALTER TABLE orders ADD CONSTRAINT valid_status
+    CHECK (status IN ('pending', 'confirmed', 'processing', 'shipped', 'delivered', 'cancelled'));
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS hold_until,
+    DROP COLUMN IF EXISTS held_at,
+    DROP COLUMN IF EXISTS held_from_status,
+    DROP COLUMN IF EXISTS hold_reason;
@@ -0,0 +1,14 @@
+-- Order holds: POST /api/v1/orders/{id}/hold moves an order to on_hold and
+-- /release returns it to held_from_status. hold_until, when set, is when the
+-- hold release job releases the order on its own.
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS hold_reason TEXT,
+    ADD COLUMN IF NOT EXISTS held_from_status VARCHAR(50),
+    ADD COLUMN IF NOT EXISTS held_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS hold_until TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS valid_status;
+ALTER TABLE orders ADD CONSTRAINT valid_status
+    CHECK (status IN ('pending', 'confirmed', 'processing', 'on_hold', 'shipped', 'delivered', 'cancelled'));
+
+-- The release job finds due holds through idx_orders_status_created (status = 'on_hold')
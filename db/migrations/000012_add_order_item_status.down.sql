@@ -0,0 +1,2 @@
+ALTER TABLE order_items DROP CONSTRAINT IF EXISTS valid_item_status;
+ALTER TABLE order_items DROP COLUMN IF EXISTS status;
@@ -0,0 +1,16 @@
+-- Per-item fulfillment: each item moves pending -> picked -> shipped ->
+-- delivered (or returned) on its own, and the order status follows its items.
+ALTER TABLE order_items
+    ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'pending';
+
+ALTER TABLE order_items DROP CONSTRAINT IF EXISTS valid_item_status;
+ALTER TABLE order_items ADD CONSTRAINT valid_item_status
+    CHECK (status IN ('pending', 'picked', 'shipped', 'delivered', 'returned'));
+
+-- Orders shipped or delivered before item tracking shipped every item with them
+UPDATE order_items oi
+SET status = o.status
+FROM orders o
+WHERE oi.order_id = o.id
+  AND oi.status = 'pending'
+  AND o.status IN ('shipped', 'delivered');
@@ -0,0 +1,15 @@
+-- Free-text notes on an order, visible to its customer or internal only.
+-- There is no foreign key to orders because a partitioned orders table cannot
+-- be referenced by id alone; purges and erasures delete notes explicitly.
+CREATE TABLE IF NOT EXISTS order_notes (
+    id UUID PRIMARY KEY,
+    order_id UUID NOT NULL,
+    author VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    visibility VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT valid_note_visibility CHECK (visibility IN ('customer', 'internal'))
+);
+
+-- Covers: WHERE order_id = ANY($1) ORDER BY created_at
+CREATE INDEX IF NOT EXISTS idx_order_notes_order_created ON order_notes(order_id, created_at);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_orders_tags;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS tags,
+    DROP COLUMN IF EXISTS metadata;
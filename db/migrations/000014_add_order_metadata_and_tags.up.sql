@@ -0,0 +1,9 @@
+-- Free-form metadata and filterable tags on orders. Tags are a JSON array of
+-- lowercase strings; ListOrders filters with tags @> '["tag"]'.
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS metadata JSONB NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS tags JSONB NOT NULL DEFAULT '[]';
+
+-- Covers: WHERE tags @> $1 AND deleted_at IS NULL. partition_orders_table()
+-- does not know this index; PartitionOrders recreates it after the rewrite.
+CREATE INDEX IF NOT EXISTS idx_orders_tags ON orders USING GIN(tags jsonb_path_ops) WHERE deleted_at IS NULL;
@@ -0,0 +1,5 @@
+-- Optional shipping and billing addresses, each a JSON object with name,
+-- line1, line2, city, region, postal_code and country. NULL when not given.
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS shipping_address JSONB,
+    ADD COLUMN IF NOT EXISTS billing_address JSONB;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_orders_sla_due;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS sla_breached_at,
+    DROP COLUMN IF EXISTS estimated_delivery_at,
+    DROP COLUMN IF EXISTS shipping_method;
@@ -0,0 +1,12 @@
+-- Shipping method and delivery estimate, set when an order is confirmed, and
+-- the time the SLA job flagged the order as overdue.
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS shipping_method VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS estimated_delivery_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS sla_breached_at TIMESTAMP WITH TIME ZONE;
+
+-- Covers: WHERE estimated_delivery_at <= $1 AND sla_breached_at IS NULL AND
+-- status NOT IN ('delivered', 'cancelled') AND deleted_at IS NULL ORDER BY
+-- estimated_delivery_at. PartitionOrders recreates it after the rewrite.
+CREATE INDEX IF NOT EXISTS idx_orders_sla_due ON orders(estimated_delivery_at)
+    WHERE sla_breached_at IS NULL AND status NOT IN ('delivered', 'cancelled') AND deleted_at IS NULL;
@@ -0,0 +1,25 @@
+-- Recurring order templates. items is a JSON array of product_id, name,
+-- quantity and price; the scheduler places an order from it at next_run_at
+-- while the subscription is active.
+CREATE TABLE IF NOT EXISTS subscriptions (
+    id UUID PRIMARY KEY,
+    customer_id VARCHAR(255) NOT NULL,
+    items JSONB NOT NULL,
+    cadence VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    next_run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    shipping_method VARCHAR(64),
+    shipping_address JSONB,
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT valid_subscription_cadence CHECK (cadence IN ('daily', 'weekly', 'biweekly', 'monthly')),
+    CONSTRAINT valid_subscription_status CHECK (status IN ('active', 'paused')),
+    CONSTRAINT positive_subscription_version CHECK (version > 0)
+);
+
+-- Covers: WHERE customer_id = $1 ORDER BY created_at
+CREATE INDEX IF NOT EXISTS idx_subscriptions_customer_created ON subscriptions(customer_id, created_at);
+
+-- Covers: WHERE status = 'active' AND next_run_at <= $1 ORDER BY next_run_at
+CREATE INDEX IF NOT EXISTS idx_subscriptions_due ON subscriptions(next_run_at) WHERE status = 'active';
@@ -0,0 +1,29 @@
+-- Asynchronous jobs run by `ordersvc worker`. run_at is when a queued job
+-- becomes due, or when a running job's lease expires and it may be claimed
+-- again. unique_key allows one queued or running job per key, e.g. one
+-- pending run of each periodic job.
+CREATE TABLE IF NOT EXISTS jobs (
+    id UUID PRIMARY KEY,
+    kind VARCHAR(64) NOT NULL,
+    payload JSONB,
+    unique_key VARCHAR(255),
+    status VARCHAR(20) NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    max_attempts INTEGER NOT NULL,
+    run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT valid_job_status CHECK (status IN ('queued', 'running', 'succeeded', 'failed')),
+    CONSTRAINT positive_job_max_attempts CHECK (max_attempts > 0)
+);
+
+-- Covers: WHERE status IN ('queued', 'running') AND run_at <= $1 ORDER BY run_at
+CREATE INDEX IF NOT EXISTS idx_jobs_due ON jobs(run_at) WHERE status IN ('queued', 'running');
+
+-- Enforces one pending job per unique_key; finished jobs release the key
+CREATE UNIQUE INDEX IF NOT EXISTS idx_jobs_unique_pending ON jobs(unique_key) WHERE status IN ('queued', 'running');
+
+-- Covers: WHERE status = $1 ORDER BY created_at DESC
+CREATE INDEX IF NOT EXISTS idx_jobs_status_created ON jobs(status, created_at);
@@ -0,0 +1,3 @@
+-- Event replays page through history across orders by time.
+-- Covers: WHERE created_at >= $1 AND (created_at, id) > ($2, $3) ORDER BY created_at, id
+CREATE INDEX IF NOT EXISTS idx_order_history_created ON order_history(created_at, id);
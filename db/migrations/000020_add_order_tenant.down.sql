@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_orders_tenant_created;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS tenant_id;
@@ -0,0 +1,9 @@
+-- Tenant of each order. Orders created before tenancy was enabled, and every
+-- order of a single-tenant deployment, belong to the empty tenant.
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT '';
+
+-- Covers: WHERE tenant_id = $1 AND deleted_at IS NULL ORDER BY created_at DESC.
+-- partition_orders_table() does not know this index; PartitionOrders
+-- recreates it after the rewrite.
+CREATE INDEX IF NOT EXISTS idx_orders_tenant_created ON orders(tenant_id, created_at DESC) WHERE deleted_at IS NULL;
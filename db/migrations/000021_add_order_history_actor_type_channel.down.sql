@@ -0,0 +1,3 @@
+ALTER TABLE order_history
+    DROP COLUMN IF EXISTS channel,
+    DROP COLUMN IF EXISTS actor_type;
@@ -0,0 +1,6 @@
+-- What kind of caller made each change (user, api_key, system, anonymous)
+-- and how it reached the service (http, grpc, worker). Entries recorded
+-- before these columns existed, and seeded orders' channel, are empty.
+ALTER TABLE order_history
+    ADD COLUMN IF NOT EXISTS actor_type VARCHAR(20) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS channel VARCHAR(20) NOT NULL DEFAULT '';
@@ -0,0 +1,9 @@
+-- Where each archived order was written. The archive job moves delivered and
+-- cancelled orders into objects of up to 100 orders each and deletes them
+-- from orders; GET by ID falls back to this table and reads the object.
+CREATE TABLE IF NOT EXISTS archived_orders (
+    id UUID PRIMARY KEY,
+    tenant_id TEXT NOT NULL DEFAULT '',
+    object_key TEXT NOT NULL,
+    archived_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
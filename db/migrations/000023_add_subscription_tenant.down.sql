@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_subscriptions_tenant_customer_created;
+
+ALTER TABLE subscriptions
+    DROP COLUMN IF EXISTS tenant_id;
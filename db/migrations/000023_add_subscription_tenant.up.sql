@@ -0,0 +1,8 @@
+-- Tenant of each subscription; the orders it places belong to the same
+-- tenant. Subscriptions created before tenancy was enabled, and every
+-- subscription of a single-tenant deployment, belong to the empty tenant.
+ALTER TABLE subscriptions
+    ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT '';
+
+-- Covers: WHERE tenant_id = $1 AND customer_id = $2 ORDER BY created_at.
+CREATE INDEX IF NOT EXISTS idx_subscriptions_tenant_customer_created ON subscriptions(tenant_id, customer_id, created_at);
@@ -4,15 +4,29 @@
 CREATE TABLE IF NOT EXISTS orders (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     customer_id VARCHAR(255) NOT NULL,
-    items JSONB NOT NULL,
     status VARCHAR(50) NOT NULL,
     total DECIMAL(10, 2) NOT NULL,
     version INTEGER NOT NULL DEFAULT 1,  -- Optimistic locking version (ADR-0003)
     created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
     deleted_at TIMESTAMP WITH TIME ZONE,
+    -- Set while the order is on hold (see db/migrations/000011)
+    hold_reason TEXT,
+    held_from_status VARCHAR(50),
+    held_at TIMESTAMP WITH TIME ZONE,
+    hold_until TIMESTAMP WITH TIME ZONE,
+    -- Free-form metadata and filterable tags (see db/migrations/000014)
+    metadata JSONB NOT NULL DEFAULT '{}',
+    tags JSONB NOT NULL DEFAULT '[]',
+    -- Optional addresses (see db/migrations/000015)
+    shipping_address JSONB,
+    billing_address JSONB,
+    -- Delivery estimate and SLA breach (see db/migrations/000016)
+    shipping_method VARCHAR(64),
+    estimated_delivery_at TIMESTAMP WITH TIME ZONE,
+    sla_breached_at TIMESTAMP WITH TIME ZONE,
 
-    CONSTRAINT valid_status CHECK (status IN ('pending', 'confirmed', 'processing', 'shipped', 'delivered', 'cancelled')),
+    CONSTRAINT valid_status CHECK (status IN ('pending', 'confirmed', 'processing', 'on_hold', 'shipped', 'delivered', 'cancelled')),
     CONSTRAINT positive_version CHECK (version > 0)
 );
 
@@ -22,8 +36,12 @@ CREATE INDEX IF NOT EXISTS idx_orders_status_created ON orders(status, created_a
 CREATE INDEX IF NOT EXISTS idx_orders_customer_created ON orders(customer_id, created_at DESC) WHERE deleted_at IS NULL;
 CREATE INDEX IF NOT EXISTS idx_orders_customer_status_created ON orders(customer_id, status, created_at DESC) WHERE deleted_at IS NULL;
 
--- JSONB GIN index for items array queries
-CREATE INDEX IF NOT EXISTS idx_orders_items ON orders USING GIN(items);
+-- Covers: WHERE tags @> '["tag"]' for the ListOrders tag filter
+CREATE INDEX IF NOT EXISTS idx_orders_tags ON orders USING GIN(tags jsonb_path_ops) WHERE deleted_at IS NULL;
+
+-- Covers: the SLA job's scan for overdue orders not yet flagged
+CREATE INDEX IF NOT EXISTS idx_orders_sla_due ON orders(estimated_delivery_at)
+    WHERE sla_breached_at IS NULL AND status NOT IN ('delivered', 'cancelled') AND deleted_at IS NULL;
 
 -- Function to automatically update updated_at timestamp
 CREATE OR REPLACE FUNCTION update_updated_at_column()
@@ -41,3 +59,185 @@ CREATE TRIGGER update_orders_updated_at BEFORE UPDATE ON orders
 
 -- Grant permissions
 GRANT ALL PRIVILEGES ON TABLE orders TO postgres;
+
+-- Dead-letter queue for undeliverable Kafka events (ADR-0006)
+CREATE TABLE IF NOT EXISTS dead_letters (
+    id UUID PRIMARY KEY,
+    topic VARCHAR(255) NOT NULL,
+    key VARCHAR(255) NOT NULL,
+    payload BYTEA NOT NULL,
+    headers JSONB NOT NULL DEFAULT '{}',
+    event_type VARCHAR(100) NOT NULL,
+    order_id VARCHAR(255) NOT NULL,
+    last_error TEXT NOT NULL DEFAULT '',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_dead_letters_next_attempt ON dead_letters(next_attempt_at, attempts);
+GRANT ALL PRIVILEGES ON TABLE dead_letters TO postgres;
+
+-- Record the schema version matching db/migrations, so /readyz and the
+-- migration runner (DATABASE_AUTO_MIGRATE) treat this schema as current.
+CREATE TABLE IF NOT EXISTS schema_migrations (version BIGINT PRIMARY KEY, dirty BOOLEAN NOT NULL);
+INSERT INTO schema_migrations (version, dirty) VALUES (9, false) ON CONFLICT DO NOTHING;
+
+-- Order line items, normalized out of orders (see db/migrations/000004)
+CREATE TABLE IF NOT EXISTS order_items (
+    id UUID PRIMARY KEY,
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    product_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL,
+    price DECIMAL(10, 2) NOT NULL,
+    subtotal DECIMAL(10, 2) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+
+    CONSTRAINT order_items_position_unique UNIQUE (order_id, position),
+    CONSTRAINT positive_quantity CHECK (quantity > 0),
+    CONSTRAINT valid_item_status CHECK (status IN ('pending', 'picked', 'shipped', 'delivered', 'returned'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_items_product_order ON order_items(product_id, order_id);
+
+GRANT ALL PRIVILEGES ON TABLE order_items TO postgres;
+
+-- Audit trail of order mutations, written in the same transaction as the change.
+-- old_state is NULL for creation; both snapshots use the orderSnapshot JSON shape.
+CREATE TABLE IF NOT EXISTS order_history (
+    id UUID PRIMARY KEY,
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    action VARCHAR(50) NOT NULL,
+    actor VARCHAR(255) NOT NULL,
+    old_state JSONB,
+    new_state JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Covers: WHERE order_id = $1 ORDER BY created_at DESC
+CREATE INDEX IF NOT EXISTS idx_order_history_order_created ON order_history(order_id, created_at DESC);
+
+-- Covers: event replays paging through history by time (see db/migrations/000019)
+CREATE INDEX IF NOT EXISTS idx_order_history_created ON order_history(created_at, id);
+
+GRANT ALL PRIVILEGES ON TABLE order_history TO postgres;
+
+-- Customer-visible and internal notes on an order. No foreign key: a
+-- partitioned orders table cannot be referenced by id alone.
+CREATE TABLE IF NOT EXISTS order_notes (
+    id UUID PRIMARY KEY,
+    order_id UUID NOT NULL,
+    author VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    visibility VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT valid_note_visibility CHECK (visibility IN ('customer', 'internal'))
+);
+
+-- Covers: WHERE order_id = ANY($1) ORDER BY created_at
+CREATE INDEX IF NOT EXISTS idx_order_notes_order_created ON order_notes(order_id, created_at);
+
+GRANT ALL PRIVILEGES ON TABLE order_notes TO postgres;
+
+-- Retention purge and deleted-orders listing
+CREATE INDEX IF NOT EXISTS idx_orders_deleted_at ON orders(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_orders_status_updated ON orders(status, updated_at) WHERE deleted_at IS NULL;
+
+-- Audit log of customer data erasures (customer_hash is SHA-256 of the customer ID)
+CREATE TABLE IF NOT EXISTS customer_erasures (
+    id UUID PRIMARY KEY,
+    customer_hash CHAR(64) NOT NULL,
+    order_count INTEGER NOT NULL,
+    actor VARCHAR(255) NOT NULL,
+    erased_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_customer_erasures_customer_hash ON customer_erasures(customer_hash);
+
+GRANT ALL PRIVILEGES ON TABLE customer_erasures TO postgres;
+
+-- Daily order counts and revenue per status, backing the order reports when
+-- REPORTS_USE_MATERIALIZED_VIEWS is enabled. Refreshed by the report refresh job.
+CREATE MATERIALIZED VIEW IF NOT EXISTS order_daily_totals AS
+SELECT (created_at AT TIME ZONE 'UTC')::date AS day,
+       status,
+       COUNT(*) AS order_count,
+       COALESCE(SUM(total), 0) AS revenue
+FROM orders
+WHERE deleted_at IS NULL
+GROUP BY 1, 2;
+
+-- Required by REFRESH MATERIALIZED VIEW CONCURRENTLY
+CREATE UNIQUE INDEX IF NOT EXISTS idx_order_daily_totals_day_status ON order_daily_totals(day, status);
+
+-- Indexes for GET /api/v1/orders/search.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Covers: WHERE id::text LIKE 'prefix%'
+CREATE INDEX IF NOT EXISTS idx_orders_id_prefix ON orders((id::text) text_pattern_ops) WHERE deleted_at IS NULL;
+
+-- Covers: WHERE customer_id ILIKE '%q%' and WHERE customer_id % q
+CREATE INDEX IF NOT EXISTS idx_orders_customer_id_trgm ON orders USING GIN(customer_id gin_trgm_ops) WHERE deleted_at IS NULL;
+
+-- Covers: WHERE to_tsvector('simple', name) @@ plainto_tsquery('simple', q)
+CREATE INDEX IF NOT EXISTS idx_order_items_name_fts ON order_items USING GIN(to_tsvector('simple', name));
+
+-- Covers: WHERE q <% name
+CREATE INDEX IF NOT EXISTS idx_order_items_name_trgm ON order_items USING GIN(name gin_trgm_ops);
+
+-- Recurring order templates (see db/migrations/000017)
+CREATE TABLE IF NOT EXISTS subscriptions (
+    id UUID PRIMARY KEY,
+    customer_id VARCHAR(255) NOT NULL,
+    items JSONB NOT NULL,
+    cadence VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    next_run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    shipping_method VARCHAR(64),
+    shipping_address JSONB,
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT valid_subscription_cadence CHECK (cadence IN ('daily', 'weekly', 'biweekly', 'monthly')),
+    CONSTRAINT valid_subscription_status CHECK (status IN ('active', 'paused')),
+    CONSTRAINT positive_subscription_version CHECK (version > 0)
+);
+
+-- Covers: WHERE customer_id = $1 ORDER BY created_at
+CREATE INDEX IF NOT EXISTS idx_subscriptions_customer_created ON subscriptions(customer_id, created_at);
+
+-- Covers: the scheduler's scan for due active subscriptions
+CREATE INDEX IF NOT EXISTS idx_subscriptions_due ON subscriptions(next_run_at) WHERE status = 'active';
+
+GRANT ALL PRIVILEGES ON TABLE subscriptions TO postgres;
+
+-- Asynchronous jobs run by ordersvc worker (see db/migrations/000018)
+CREATE TABLE IF NOT EXISTS jobs (
+    id UUID PRIMARY KEY,
+    kind VARCHAR(64) NOT NULL,
+    payload JSONB,
+    unique_key VARCHAR(255),
+    status VARCHAR(20) NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    max_attempts INTEGER NOT NULL,
+    run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT valid_job_status CHECK (status IN ('queued', 'running', 'succeeded', 'failed')),
+    CONSTRAINT positive_job_max_attempts CHECK (max_attempts > 0)
+);
+
+-- Covers: the worker's scan for due jobs
+CREATE INDEX IF NOT EXISTS idx_jobs_due ON jobs(run_at) WHERE status IN ('queued', 'running');
+
+-- Enforces one pending job per unique_key
+CREATE UNIQUE INDEX IF NOT EXISTS idx_jobs_unique_pending ON jobs(unique_key) WHERE status IN ('queued', 'running');
+
+-- Covers: the admin job list filtered by status
+CREATE INDEX IF NOT EXISTS idx_jobs_status_created ON jobs(status, created_at);
+
+GRANT ALL PRIVILEGES ON TABLE jobs TO postgres;
@@ -0,0 +1,100 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sridharn-code-sandbox/go-ordersvc/db/migrations"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/config"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository/postgres"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/retry"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/seed"
+)
+
+// seedActor is recorded as the actor of the seeded orders' history entries
+const seedActor = "seed"
+
+// Seed fills the configured database with generated orders. Migrations are
+// applied first if DATABASE_AUTO_MIGRATE is set.
+func Seed(ctx context.Context, cfg *config.Config, opts seed.Options) error {
+	logger := newLogger(cfg.App.LogFormat, slog.LevelInfo)
+
+	secretManager, err := newSecretManager(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize secret sources: %w", err)
+	}
+	dbCfg := cfg.Database
+	if err := secretManager.ResolveAll(ctx, &dbCfg.Password); err != nil {
+		return fmt.Errorf("failed to resolve secrets: %w", err)
+	}
+
+	pool, err := pgxpool.New(ctx, dbCfg.DSN())
+	if err != nil {
+		return fmt.Errorf("failed to create database pool: %w", err)
+	}
+	defer pool.Close()
+	retryPolicy := retry.Policy{
+		Timeout:        cfg.Startup.RetryTimeout,
+		InitialBackoff: cfg.Startup.InitialBackoff,
+		MaxBackoff:     cfg.Startup.MaxBackoff,
+	}
+	if err := waitFor(logger, retryPolicy, "postgres", pool.Ping); err != nil {
+		return fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	if dbCfg.AutoMigrate {
+		migrationsFS := fs.FS(migrations.FS)
+		if dbCfg.MigrationsPath != "" {
+			migrationsFS = os.DirFS(dbCfg.MigrationsPath)
+		}
+		migrator, err := postgres.NewMigrator(pool, migrationsFS)
+		if err != nil {
+			return fmt.Errorf("failed to load migrations: %w", err)
+		}
+		if _, err := migrator.Up(ctx); err != nil {
+			return fmt.Errorf("failed to apply migrations: %w", err)
+		}
+	}
+
+	// A batch copy takes longer than a single-order query
+	repo := postgres.NewOrderRepository(pool, nil, max(dbCfg.QueryTimeout, time.Minute))
+	start := time.Now()
+	logger.Info("seeding orders",
+		slog.Int("orders", opts.Orders),
+		slog.Int("customers", opts.Customers),
+		slog.Duration("span", opts.Span),
+		slog.Uint64("seed", opts.Seed),
+	)
+	nextReport := 0
+	inserted, err := seed.Run(domain.WithActorType(domain.WithActor(ctx, seedActor), domain.ActorTypeSystem), repo, opts, start, func(inserted int) {
+		if inserted >= nextReport || inserted == opts.Orders {
+			nextReport = inserted + max(opts.Orders/20, opts.BatchSize)
+			logger.Info("seed progress", slog.Int("inserted", inserted), slog.Int("orders", opts.Orders))
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("seeding stopped after %d orders: %w", inserted, err)
+	}
+	logger.Info("seeding complete", slog.Int("inserted", inserted), slog.Duration("elapsed", time.Since(start)))
+	return nil
+}
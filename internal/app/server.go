@@ -0,0 +1,1280 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package app wires the service together from its configuration and runs
+// it. cmd/ordersvc runs it as a process and the integration tests start it
+// in-process.
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/segmentio/kafka-go"
+	"github.com/sridharn-code-sandbox/go-ordersvc/api/openapi"
+	"github.com/sridharn-code-sandbox/go-ordersvc/db/migrations"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/audit"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/auth"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/cache"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/cache/redis"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/chaos"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/config"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/correlation"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	grpcHandler "github.com/sridharn-code-sandbox/go-ordersvc/internal/handler/grpc"
+	httpHandler "github.com/sridharn-code-sandbox/go-ordersvc/internal/handler/http"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/httpclient"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+	kafkapub "github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging/kafka"
+	natspub "github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging/nats"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging/noop"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging/schemaregistry"
+	snspub "github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging/sns"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging/webhook"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/middleware"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/objectstore"
+	paymentmock "github.com/sridharn-code-sandbox/go-ordersvc/internal/payment/mock"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/payment/stripe"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/pricing/catalog"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/ratelimit"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository/postgres"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/retry"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/search"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/search/opensearch"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/secrets"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/tenancy"
+	"google.golang.org/grpc"
+)
+
+// dependencyPingTimeout bounds a single connection attempt during startup
+const dependencyPingTimeout = 5 * time.Second
+
+// Mode selects what the process runs
+type Mode string
+
+// Run modes, given as the first argument after the flags.
+const (
+	// ModeServe serves the HTTP and gRPC APIs and, if JOBS_RUN_IN_SERVER is
+	// set, runs the background jobs on their own loops
+	ModeServe Mode = "serve"
+	// ModeWorker runs the background jobs from the job queue, serving only
+	// health checks and metrics
+	ModeWorker Mode = "worker"
+)
+
+// pgHealthChecker adapts pgxpool.Pool to the HealthChecker interface
+type pgHealthChecker struct {
+	pool *pgxpool.Pool
+}
+
+func (h *pgHealthChecker) Ping(ctx context.Context) error {
+	return h.pool.Ping(ctx)
+}
+
+// serviceConfig adapts the reloadable configuration to the service ConfigProvider
+type serviceConfig struct {
+	provider *config.Provider
+}
+
+func (c serviceConfig) Settings() service.Settings {
+	cfg := c.provider.Current()
+	return service.Settings{
+		OrderCacheTTL:      cfg.Cache.DefaultTTL,
+		OrderListCacheTTL:  cfg.Cache.ListTTL,
+		MaxPageSize:        cfg.Pagination.MaxPageSize,
+		EstimateListTotals: cfg.Pagination.EstimateTotals,
+		HoldReleaseAfter:   cfg.Holds.ReleaseAfter,
+
+		DeliveryTransitTimes:  cfg.Delivery.TransitTimes,
+		DefaultShippingMethod: cfg.Delivery.DefaultMethod,
+		RequireServerPricing:  cfg.Pricing.RequireServerSide,
+		RequirePayment:        cfg.Payments.Provider != config.PaymentsProviderNone,
+
+		OrderLimits: domain.OrderLimits{
+			MaxItems:        cfg.OrderLimits.MaxItems,
+			MaxItemQuantity: cfg.OrderLimits.MaxItemQuantity,
+			MaxTotal:        domain.MoneyFromFloat(cfg.OrderLimits.MaxTotal),
+		},
+	}
+}
+
+// Server holds the HTTP server and its dependencies
+type Server struct {
+	httpServer      *http.Server
+	debugServer     *http.Server
+	grpcServer      *grpc.Server
+	cfg             *config.Config
+	provider        *config.Provider
+	logger          *slog.Logger
+	dbPool          *pgxpool.Pool
+	replica         *postgres.Replica
+	redisCloser     func() error
+	auditCloser     io.Closer
+	publisherCloser func(ctx context.Context) error
+	// events feeds the gRPC WatchOrders streams; nil without Kafka
+	events *messaging.Broker
+
+	// jobs are background loops started with the server and stopped on shutdown
+	jobs     []func(ctx context.Context)
+	stopJobs context.CancelFunc
+	jobsDone sync.WaitGroup
+}
+
+// NewServer creates a new server instance for the given mode
+func NewServer(provider *config.Provider, mode Mode) *Server {
+	cfg := provider.Current()
+
+	// Setup structured logger. The level can change at runtime through the
+	// admin API, and follows config reloads that change APP_LOG_LEVEL.
+	logLevel := new(slog.LevelVar)
+	_ = logLevel.UnmarshalText([]byte(cfg.App.LogLevel)) // validated at startup and on reload
+	configuredLevel := cfg.App.LogLevel
+	provider.OnReload(func(c *config.Config) {
+		if c.App.LogLevel != configuredLevel {
+			configuredLevel = c.App.LogLevel
+			_ = logLevel.UnmarshalText([]byte(c.App.LogLevel))
+		}
+	})
+	logger := newLogger(cfg.App.LogFormat, logLevel)
+	slog.SetDefault(logger)
+
+	// Resolve credentials that reference a secret store. The provider keeps
+	// the references; the database and Redis passwords are fetched again for
+	// new connections so rotations apply without a restart.
+	secretManager, err := newSecretManager(cfg)
+	if err != nil {
+		logger.Error("failed to initialize secret sources", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	dbPasswordRef, redisPasswordRef := cfg.Database.Password, cfg.Redis.Password
+	resolved := *cfg
+	if err := secretManager.ResolveAll(context.Background(),
+		&resolved.Database.Password, &resolved.Database.ReplicaDSN, &resolved.Redis.Password,
+		&resolved.Admin.APIKey, &resolved.Auth.JWTSecret, &resolved.Search.OpenSearchPassword,
+		&resolved.Kafka.SchemaRegistryPassword, &resolved.Payments.StripeSecretKey, &resolved.Payments.StripeWebhookSecret,
+	); err != nil {
+		logger.Error("failed to resolve secrets", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	cfg = &resolved
+
+	// Dependencies may still be starting, e.g. during a rollout, so their
+	// connections are retried with backoff before startup gives up
+	retryPolicy := retry.Policy{
+		Timeout:        cfg.Startup.RetryTimeout,
+		InitialBackoff: cfg.Startup.InitialBackoff,
+		MaxBackoff:     cfg.Startup.MaxBackoff,
+	}
+
+	// Initialize PostgreSQL connection pool
+	poolCfg, err := pgxpool.ParseConfig(cfg.Database.DSN())
+	if err != nil {
+		logger.Error("failed to parse database config", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	if secrets.IsReference(dbPasswordRef) {
+		poolCfg.BeforeConnect = func(ctx context.Context, connCfg *pgx.ConnConfig) error {
+			password, err := secretManager.Get(ctx, dbPasswordRef)
+			if err != nil {
+				return err
+			}
+			connCfg.Password = password
+			return nil
+		}
+	}
+	poolCfg.MaxConns = safeInt32(cfg.Database.MaxOpenConns)
+	poolCfg.MinConns = safeInt32(cfg.Database.MaxIdleConns)
+	poolCfg.MaxConnLifetime = cfg.Database.ConnMaxLifetime
+	poolCfg.MaxConnIdleTime = cfg.Database.ConnMaxIdleTime
+	postgres.SetStatementTimeout(poolCfg, cfg.Database.QueryTimeout)
+	if cfg.Database.SlowQueryThreshold > 0 {
+		poolCfg.ConnConfig.Tracer = postgres.NewSlowQueryTracer(cfg.Database.SlowQueryThreshold, logger)
+	}
+
+	dbPool, err := pgxpool.NewWithConfig(context.Background(), poolCfg)
+	if err != nil {
+		logger.Error("failed to create database pool", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	if err := waitFor(logger, retryPolicy, "postgres", dbPool.Ping); err != nil {
+		logger.Error("failed to ping database", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	logger.Info("connected to PostgreSQL", slog.String("host", cfg.Database.Host), slog.Int("port", cfg.Database.Port))
+	prometheus.MustRegister(postgres.NewPoolCollector(dbPool, "primary"))
+
+	// Order lookups and lists read from the replica if one is configured. It
+	// may be down at startup: reads use the primary until it is reachable.
+	var replica *postgres.Replica
+	if cfg.Database.ReplicaDSN != "" {
+		replicaCfg, err := pgxpool.ParseConfig(cfg.Database.ReplicaDSN)
+		if err != nil {
+			logger.Error("failed to parse read replica DSN", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		replicaCfg.MaxConns = poolCfg.MaxConns
+		replicaCfg.MinConns = poolCfg.MinConns
+		replicaCfg.MaxConnLifetime = poolCfg.MaxConnLifetime
+		replicaCfg.MaxConnIdleTime = poolCfg.MaxConnIdleTime
+		postgres.SetStatementTimeout(replicaCfg, cfg.Database.QueryTimeout)
+		replicaCfg.ConnConfig.Tracer = poolCfg.ConnConfig.Tracer
+
+		replicaPool, err := pgxpool.NewWithConfig(context.Background(), replicaCfg)
+		if err != nil {
+			logger.Error("failed to create read replica pool", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		replica = postgres.NewReplica(replicaPool)
+		prometheus.MustRegister(postgres.NewPoolCollector(replicaPool, "replica"))
+		logger.Info("reading orders from replica", slog.String("host", replicaCfg.ConnConfig.Host))
+	}
+
+	// Load schema migrations and apply them if requested
+	migrationsFS := fs.FS(migrations.FS)
+	if cfg.Database.MigrationsPath != "" {
+		migrationsFS = os.DirFS(cfg.Database.MigrationsPath)
+	}
+	migrator, err := postgres.NewMigrator(dbPool, migrationsFS)
+	if err != nil {
+		logger.Error("failed to load migrations", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	if cfg.Database.AutoMigrate {
+		applied, err := migrator.Up(context.Background())
+		if err != nil {
+			logger.Error("failed to apply migrations", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		logger.Info("database migrations applied", slog.Int("applied", applied), slog.Uint64("version", uint64(migrator.Latest())))
+	}
+
+	// Initialize Redis client
+	redisCfg := redis.Config{
+		Host:     cfg.Redis.Host,
+		Port:     cfg.Redis.Port,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+
+		MaxRetries:  cfg.Redis.MaxRetries,
+		PoolSize:    cfg.Redis.PoolSize,
+		PoolTimeout: cfg.Redis.PoolTimeout,
+	}
+	if secrets.IsReference(redisPasswordRef) {
+		redisCfg.PasswordFunc = func(ctx context.Context) (string, error) {
+			return secretManager.Get(ctx, redisPasswordRef)
+		}
+	}
+	redisClient := redis.Open(redisCfg)
+	err = waitFor(logger, retryPolicy, "redis", func(ctx context.Context) error {
+		return redisClient.Ping(ctx).Err()
+	})
+	switch {
+	case err == nil:
+		logger.Info("connected to Redis", slog.String("host", cfg.Redis.Host), slog.Int("port", cfg.Redis.Port))
+	case cfg.Redis.Required:
+		logger.Error("failed to connect to Redis", slog.String("error", err.Error()))
+		os.Exit(1)
+	default:
+		// The client reconnects on use. Until then cache reads fall through
+		// to PostgreSQL, and idempotency and rate limiting fail open.
+		logger.Warn("Redis unreachable, starting without it", slog.String("error", err.Error()))
+	}
+
+	// Background jobs. The API server runs each on its own loop (jobs). The
+	// worker runs those with a definition (jobDefinitions) from the job
+	// queue, so each pass runs once across replicas and failures are
+	// retried, and the rest on their own loop (workerJobs).
+	var (
+		jobs, workerJobs []func(ctx context.Context)
+		jobDefinitions   []service.JobDefinition
+	)
+
+	// KAFKA_TOPIC_ROUTING may spread event types over topics of their own
+	topics, err := newTopicRouter(cfg)
+	if err != nil {
+		logger.Error("failed to initialize event topic routing", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	// Avro and protobuf events go through the schema registry; nil for JSON
+	codec, err := newSchemaCodec(cfg, logger, topics)
+	if err != nil {
+		logger.Error("failed to initialize event schema", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	// Initialize event publisher
+	deadLetters := postgres.NewDeadLetterRepository(dbPool)
+	resilience := messaging.NewResilience(messaging.ResilienceConfig{
+		MaxAttempts:      cfg.Resilience.MaxAttempts,
+		InitialBackoff:   cfg.Resilience.InitialBackoff,
+		MaxBackoff:       cfg.Resilience.MaxBackoff,
+		FailureThreshold: cfg.Resilience.BreakerFailures,
+		Cooldown:         cfg.Resilience.BreakerCooldown,
+	}, messaging.NewResilienceMetrics(prometheus.DefaultRegisterer))
+	publisher, redeliverer, publisherCloser, err := connectEventPublisher(cfg, logger, topics, codec, deadLetters, resilience, retryPolicy)
+	if err != nil {
+		logger.Error("failed to initialize event publisher", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	if redeliverer != nil {
+		retrier := messaging.NewDeadLetterRetrier(deadLetters, redeliverer, cfg.Kafka.DeadLetterRetryInterval, cfg.Kafka.DeadLetterMaxAttempts)
+		jobs = append(jobs, retrier.Run)
+		jobDefinitions = append(jobDefinitions,
+			periodicJob(domain.JobKindDeadLetterRelay, cfg.Kafka.DeadLetterRetryInterval, retrier.RetryDue))
+	}
+
+	// Create repository and cache
+	repo := postgres.NewOrderRepository(dbPool, replica, cfg.Database.QueryTimeout)
+	// Fault injection for testing clients, refused in production by Validate
+	var injector *chaos.Injector
+	// Replays are asked for explicitly, so none of their events are dropped
+	replayPublisher := publisher
+	if cfg.Chaos.Enabled {
+		injector = chaos.NewInjector(chaos.Config{
+			Latency:             cfg.Chaos.Latency,
+			LatencyRate:         cfg.Chaos.LatencyRate,
+			ErrorRate:           cfg.Chaos.ErrorRate,
+			RepositoryErrorRate: cfg.Chaos.RepositoryErrorRate,
+			PublishDropRate:     cfg.Chaos.PublishDropRate,
+		}, prometheus.DefaultRegisterer)
+		repo = chaos.NewOrderRepository(repo, injector)
+		publisher = chaos.NewEventPublisher(publisher, injector)
+		logger.Warn("CHAOS_ENABLED is set, faults are injected into requests, repository calls and event publishes",
+			slog.Float64("latency_rate", cfg.Chaos.LatencyRate),
+			slog.Float64("error_rate", cfg.Chaos.ErrorRate),
+			slog.Float64("repository_error_rate", cfg.Chaos.RepositoryErrorRate),
+			slog.Float64("publish_drop_rate", cfg.Chaos.PublishDropRate))
+	}
+	// Outside chaos so dropped events are still counted; replays are not
+	publisher = service.NewMetricsPublisher(publisher, service.NewOrderMetrics(prometheus.DefaultRegisterer))
+
+	// The worker serves no API, so has no calls to audit
+	var auditLog *audit.Logger
+	var auditCloser io.Closer
+	if cfg.Audit.Path != "" && mode == ModeServe {
+		auditLog, auditCloser, err = audit.Open(cfg.Audit.Path)
+		if err != nil {
+			logger.Error("failed to open audit log", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		// Records the orders each audited call changes
+		publisher = service.NewAuditPublisher(publisher)
+	}
+	// The breaker stops a Redis outage from adding an error and a timeout to
+	// every request; reads fall through to PostgreSQL until it recovers
+	orderCache := cache.NewCircuitBreaker(redis.NewOrderCache(redisClient), cache.BreakerConfig{
+		FailureThreshold: cfg.Cache.BreakerFailures,
+		Cooldown:         cfg.Cache.BreakerCooldown,
+	}, cache.NewBreakerMetrics(prometheus.DefaultRegisterer))
+	// Counts the order service's lookups for GET /api/v1/stats
+	cacheHits := cache.NewHitCounter(orderCache)
+
+	// Create service
+	settings := serviceConfig{provider: provider}
+	outboundMetrics := httpclient.NewMetrics(prometheus.DefaultRegisterer)
+	pricing := newPricingService(cfg, outboundMetrics)
+	orderService := service.NewOrderService(repo, postgres.NewUnitOfWork(dbPool), cacheHits, publisher, pricing, settings)
+
+	searcher, indexerJob, err := newOrderSearcher(cfg, logger, topics, codec, repo)
+	if err != nil {
+		logger.Error("failed to initialize search backend", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	if indexerJob != nil {
+		jobs = append(jobs, indexerJob)
+		workerJobs = append(workerJobs, indexerJob)
+	}
+	searchService := service.NewOrderSearchService(searcher, settings)
+
+	deadLetterService := service.NewDeadLetterService(deadLetters)
+	historyRepo := postgres.NewOrderHistoryRepository(dbPool)
+	historyService := service.NewOrderHistoryService(historyRepo, repo)
+	noteService := service.NewOrderNoteService(postgres.NewOrderNoteRepository(dbPool), repo)
+	subscriptionRepo := postgres.NewSubscriptionRepository(dbPool)
+	subscriptionService := service.NewSubscriptionService(subscriptionRepo, pricing, settings)
+	adminService := service.NewAdminService(repo, orderCache, publisher)
+	eventReplayService, err := newEventReplayService(cfg, historyRepo, replayPublisher, outboundMetrics)
+	if err != nil {
+		logger.Error("failed to initialize event replay", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	customerDataService := service.NewCustomerDataService(postgres.NewCustomerDataRepository(dbPool), orderCache, publisher)
+	retentionPolicy := service.RetentionPolicy{
+		DeletedOrders:   cfg.Retention.DeletedOrders,
+		CompletedOrders: cfg.Retention.CompletedOrders,
+	}
+	retentionService := service.NewRetentionService(repo, retentionPolicy)
+	if retentionPolicy.Enabled() {
+		jobs = append(jobs, func(ctx context.Context) {
+			retentionService.Run(ctx, cfg.Retention.Interval)
+		})
+		jobDefinitions = append(jobDefinitions,
+			periodicJob(domain.JobKindRetentionPurge, cfg.Retention.Interval, retentionService.ApplyRetention))
+	}
+
+	// Archived orders stay readable by ID; everything else about them is gone
+	if cfg.Archive.URL != "" {
+		archiveStore, err := objectstore.Open(context.Background(), cfg.Archive.URL, objectstore.Config{
+			Endpoint:  cfg.ObjectStore.Endpoint,
+			PathStyle: cfg.ObjectStore.PathStyle,
+		})
+		if err != nil {
+			logger.Error("failed to open order archive", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		archiveService := service.NewArchiveService(postgres.NewArchiveRepository(dbPool), archiveStore, cfg.Archive.After)
+		orderService = service.NewArchiveReadThrough(orderService, archiveService)
+		jobs = append(jobs, func(ctx context.Context) {
+			archiveService.Run(ctx, cfg.Archive.Interval)
+		})
+		jobDefinitions = append(jobDefinitions,
+			periodicJob(domain.JobKindOrderArchive, cfg.Archive.Interval, archiveService.ArchiveOrders))
+	}
+
+	reportService := service.NewReportService(postgres.NewReportRepository(dbPool, cfg.Reports.UseMaterializedViews))
+	// Stats read the orders table, as the views lag behind today's orders
+	statsService := service.NewStatsService(postgres.NewReportRepository(dbPool, false), cacheHits.Stats, cfg.Reports.StatsTTL)
+	if cfg.Reports.UseMaterializedViews {
+		refresh := func(ctx context.Context) {
+			reportService.Run(ctx, cfg.Reports.RefreshInterval)
+		}
+		jobs = append(jobs, refresh)
+		workerJobs = append(workerJobs, refresh)
+	}
+
+	if cfg.Partitions.Maintain {
+		partitionService := service.NewPartitionService(postgres.NewPartitionRepository(dbPool), cfg.Partitions.MonthsAhead)
+		maintain := func(ctx context.Context) {
+			partitionService.Run(ctx, cfg.Partitions.Interval)
+		}
+		jobs = append(jobs, maintain)
+		workerJobs = append(workerJobs, maintain)
+	}
+
+	holdReleaseService := service.NewHoldReleaseService(repo, orderService)
+	jobs = append(jobs, func(ctx context.Context) {
+		holdReleaseService.Run(ctx, cfg.Holds.ReleaseInterval)
+	})
+	jobDefinitions = append(jobDefinitions,
+		periodicJob(domain.JobKindHoldRelease, cfg.Holds.ReleaseInterval, holdReleaseService.ReleaseDueHolds))
+
+	if cfg.PendingOrders.ExpireAfter > 0 {
+		expiryService := service.NewPendingExpiryService(repo, orderService, cfg.PendingOrders.ExpireAfter,
+			service.NewPendingExpiryMetrics(prometheus.DefaultRegisterer))
+		jobs = append(jobs, func(ctx context.Context) {
+			expiryService.Run(ctx, cfg.PendingOrders.ExpiryInterval)
+		})
+		jobDefinitions = append(jobDefinitions,
+			periodicJob(domain.JobKindPendingExpiry, cfg.PendingOrders.ExpiryInterval, expiryService.ExpireStaleOrders))
+	}
+
+	totalCheckService := service.NewTotalCheckService(repo, orderService,
+		service.NewTotalCheckMetrics(prometheus.DefaultRegisterer))
+	if cfg.TotalCheck.Interval > 0 {
+		jobs = append(jobs, func(ctx context.Context) {
+			totalCheckService.Run(ctx, cfg.TotalCheck.Interval, cfg.TotalCheck.Repair)
+		})
+		jobDefinitions = append(jobDefinitions,
+			periodicJob(domain.JobKindTotalCheck, cfg.TotalCheck.Interval, func(ctx context.Context) (*domain.TotalCheckReport, error) {
+				return totalCheckService.CheckTotals(ctx, cfg.TotalCheck.Repair)
+			}))
+	}
+
+	slaService := service.NewSLAService(repo, orderService)
+	jobs = append(jobs, func(ctx context.Context) {
+		slaService.Run(ctx, cfg.Delivery.SLACheckInterval)
+	})
+	jobDefinitions = append(jobDefinitions,
+		periodicJob(domain.JobKindSLACheck, cfg.Delivery.SLACheckInterval, slaService.FlagOverdueOrders))
+
+	subscriptionScheduler := service.NewSubscriptionScheduler(subscriptionRepo, orderService)
+	jobs = append(jobs, func(ctx context.Context) {
+		subscriptionScheduler.Run(ctx, cfg.Subscriptions.Interval)
+	})
+	jobDefinitions = append(jobDefinitions,
+		periodicJob(domain.JobKindSubscriptionRun, cfg.Subscriptions.Interval, subscriptionScheduler.PlaceDueOrders))
+
+	jobQueue := postgres.NewJobQueue(dbPool)
+	if cfg.Jobs.Backend == config.JobsBackendRedis {
+		jobQueue = redis.NewJobQueue(redisClient)
+	}
+	jobService := service.NewJobService(jobQueue)
+	switch {
+	case mode == ModeWorker:
+		worker := service.NewJobWorker(jobQueue, service.JobWorkerConfig{
+			Lease:        cfg.Jobs.Lease,
+			MaxAttempts:  cfg.Jobs.MaxAttempts,
+			RetryBackoff: cfg.Jobs.RetryBackoff,
+			Retention:    cfg.Jobs.Retention,
+		}, jobDefinitions...)
+		jobs = append(workerJobs, func(ctx context.Context) {
+			worker.Run(ctx, cfg.Jobs.PollInterval)
+		})
+		logger.Info("running background jobs from the job queue", slog.String("backend", cfg.Jobs.Backend))
+	case !cfg.Jobs.RunInServer:
+		jobs = nil
+		logger.Info("JOBS_RUN_IN_SERVER is false, background jobs are left to ordersvc worker")
+	}
+
+	// gRPC WatchOrders and /ws/orders streams share one Kafka consumer per
+	// process; the worker serves neither
+	var (
+		events   *messaging.Broker
+		replayer messaging.Replayer
+	)
+	if mode == ModeServe && len(cfg.Kafka.Brokers) > 0 && cfg.Kafka.Brokers[0] != "" {
+		events = messaging.NewBroker(kafkapub.NewEventSource(cfg.Kafka.Brokers, topics, cfg.Kafka.GroupID, codec), cfg.Kafka.WatchBuffer)
+		replayer = kafkapub.NewReplayer(cfg.Kafka.Brokers, cfg.Kafka.Topic, codec)
+		jobs = append(jobs, events.Run)
+	}
+
+	// Create HTTP handlers
+	orderHandler := httpHandler.NewOrderHandler(orderService, noteService, cfg.Server.CacheMaxAge)
+	healthHandler := httpHandler.NewHealthHandler(cfg.App.Version, &pgHealthChecker{pool: dbPool}, migrator)
+	deadLetterHandler := httpHandler.NewDeadLetterHandler(deadLetterService)
+	historyHandler := httpHandler.NewOrderHistoryHandler(historyService)
+	noteHandler := httpHandler.NewOrderNoteHandler(noteService)
+	subscriptionHandler := httpHandler.NewSubscriptionHandler(subscriptionService)
+	customerDataHandler := httpHandler.NewCustomerDataHandler(customerDataService)
+	reportHandler := httpHandler.NewReportHandler(reportService)
+	statsHandler := httpHandler.NewStatsHandler(statsService, cfg.Reports.StatsTTL)
+	paymentService := service.NewPaymentService(newPaymentProvider(cfg, outboundMetrics), orderService, cfg.Payments.Currency)
+	searchHandler := httpHandler.NewOrderSearchHandler(searchService)
+	streamHandler := httpHandler.NewOrderStreamHandler(events, cfg.Server.WebSocketHeartbeat)
+	openAPIHandler := httpHandler.NewOpenAPIHandler(openapi.Spec)
+	metricsHandler := httpHandler.NewMetricsHandler(promhttp.Handler())
+	// Limits are read per request so a config reload changes them while serving
+	limiter := ratelimit.NewLimiter(redis.NewRateLimiter(redisClient), func() ratelimit.Policies {
+		return rateLimitPolicies(provider.Current().RateLimit)
+	})
+	adminRoutes := httpHandler.NewAdminRoutes(cfg.Admin.APIKey, middleware.AuditAll(auditLog),
+		httpHandler.NewAdminHandler(adminService),
+		httpHandler.NewRetentionHandler(retentionService),
+		httpHandler.NewTotalCheckHandler(totalCheckService),
+		deadLetterHandler,
+		httpHandler.NewLogLevelHandler(logLevel),
+		httpHandler.NewJobHandler(jobService),
+		httpHandler.NewEventReplayHandler(eventReplayService),
+		httpHandler.NewQuotaHandler(service.NewQuotaService(limiter)),
+	)
+	if cfg.Admin.APIKey == "" {
+		logger.Warn("ADMIN_API_KEY not set, admin API is disabled")
+	}
+
+	// Order API callers authenticate with a bearer token once a signing key is set
+	var verifier *auth.Verifier
+	if cfg.Auth.JWTSecret != "" {
+		verifier = auth.NewVerifier(cfg.Auth.JWTSecret, cfg.Auth.JWTIssuer)
+	} else {
+		logger.Warn("AUTH_JWT_SECRET not set, order API does not authenticate callers")
+	}
+	// The tenant is resolved once the caller is known, as it may come from the token
+	tenants := tenancy.NewResolver(cfg.Tenancy.Mode, cfg.Tenancy.Header, cfg.Tenancy.BaseDomain)
+	authenticate, scopeTenant, limitCaller := middleware.Authenticate(verifier), middleware.Tenant(tenants), middleware.CallerRateLimit(limiter)
+	// Audited once the caller and tenant are known
+	auditCalls := middleware.Audit(auditLog)
+	orderRoutes := httpHandler.NewAuthenticatedRoutes(func(next http.Handler) http.Handler {
+		return authenticate(scopeTenant(limitCaller(auditCalls(next))))
+	},
+		orderHandler, historyHandler, noteHandler, searchHandler, customerDataHandler, reportHandler, statsHandler, subscriptionHandler, streamHandler,
+		httpHandler.NewPaymentHandler(paymentService))
+
+	// Create router with logger
+	rateLimit := middleware.RateLimit(limiter)
+	idempotency := middleware.Idempotency(redis.NewIdempotencyStore(redisClient), cfg.Cache.IdempotencyTTL)
+	timeout := middleware.Timeout(cfg.Server.RequestTimeout, cfg.Server.RouteTimeouts)
+	mw := []func(http.Handler) http.Handler{timeout}
+	if injector != nil {
+		// After the timeout, so injected latency counts against it
+		mw = append(mw, middleware.Chaos(injector))
+	}
+	mw = append(mw, rateLimit, idempotency)
+	if cfg.Server.ProblemJSON {
+		// First, so errors from the other middleware are problems too
+		mw = append([]func(http.Handler) http.Handler{middleware.ProblemJSON()}, mw...)
+	}
+	// Payment webhooks are authenticated by the provider's signature
+	paymentWebhookHandler := httpHandler.NewPaymentWebhookHandler(paymentService)
+	router := httpHandler.NewRouter(orderRoutes, healthHandler, logger, mw, openAPIHandler, metricsHandler, adminRoutes, paymentWebhookHandler)
+	if mode == ModeWorker {
+		router = httpHandler.NewWorkerRouter(healthHandler, logger, metricsHandler)
+	}
+
+	// Create HTTP server
+	httpServer := &http.Server{
+		Addr:         fmt.Sprintf(":%d", cfg.Server.HTTPPort),
+		Handler:      router,
+		ReadTimeout:  cfg.Server.ReadTimeout,
+		WriteTimeout: cfg.Server.WriteTimeout,
+	}
+
+	// Profiling endpoints get their own listener so they are never reachable
+	// through the API port or its ingress
+	var debugServer *http.Server
+	if cfg.Server.EnablePprof {
+		debugServer = &http.Server{
+			Addr:              cfg.Server.PprofAddr,
+			Handler:           httpHandler.NewDebugRouter(),
+			ReadHeaderTimeout: cfg.Server.ReadTimeout,
+		}
+	}
+
+	// Create gRPC server; the worker serves no API
+	var grpcSrv *grpc.Server
+	if mode == ModeServe {
+		grpcSrv = grpc.NewServer(grpcHandler.ServerOptions(logger, grpcHandler.NewMetrics(prometheus.DefaultRegisterer), verifier, tenants, auditLog)...)
+		grpcHandler.RegisterOrderServer(grpcSrv, orderService, events, replayer)
+	}
+
+	return &Server{
+		httpServer:      httpServer,
+		debugServer:     debugServer,
+		grpcServer:      grpcSrv,
+		cfg:             cfg,
+		provider:        provider,
+		logger:          logger,
+		dbPool:          dbPool,
+		replica:         replica,
+		redisCloser:     redisClient.Close,
+		auditCloser:     auditCloser,
+		publisherCloser: publisherCloser,
+		events:          events,
+		jobs:            jobs,
+	}
+}
+
+// StartJobs launches background jobs; they run until Shutdown.
+func (s *Server) StartJobs() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopJobs = cancel
+	for _, job := range s.jobs {
+		s.jobsDone.Add(1)
+		go func() {
+			defer s.jobsDone.Done()
+			job(ctx)
+		}()
+	}
+}
+
+// Start starts the HTTP and, unless running as the worker, gRPC servers
+func (s *Server) Start() error {
+	// Start gRPC server in background
+	go func() {
+		if s.grpcServer == nil {
+			return
+		}
+		lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.cfg.Server.GRPCPort))
+		if err != nil {
+			s.logger.Error("failed to listen for gRPC", slog.String("error", err.Error()))
+			return
+		}
+		s.logger.Info("starting gRPC server", slog.Int("port", s.cfg.Server.GRPCPort))
+		if err := s.grpcServer.Serve(lis); err != nil {
+			s.logger.Error("gRPC server error", slog.String("error", err.Error()))
+		}
+	}()
+
+	if s.debugServer != nil {
+		go func() {
+			s.logger.Info("starting debug server", slog.String("addr", s.debugServer.Addr))
+			if err := s.debugServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				s.logger.Error("debug server error", slog.String("error", err.Error()))
+			}
+		}()
+	}
+
+	s.logger.Info("starting HTTP server", slog.Int("port", s.cfg.Server.HTTPPort))
+	if err := s.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("failed to start HTTP server: %w", err)
+	}
+	return nil
+}
+
+// Shutdown gracefully shuts down the server and closes connections. It
+// drains in dependency order, each step bounded by ctx: streams end and no
+// new ones start, the servers finish their calls, background jobs finish
+// their pass, and in-flight event publishes complete and queued events are
+// flushed while the dead-letter store is still open. Only then are the
+// database and Redis closed.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.logger.Info("shutting down server")
+
+	// End the WatchOrders and WebSocket streams and refuse new ones; they
+	// never finish on their own and are not tracked by a graceful HTTP
+	// shutdown
+	if s.events != nil {
+		s.events.Close()
+	}
+
+	if s.grpcServer != nil {
+		s.logger.Info("stopping gRPC server")
+		if !waitUntil(ctx, s.grpcServer.GracefulStop) {
+			s.logger.Warn("gRPC calls still running at the shutdown deadline, cancelling them")
+			s.grpcServer.Stop()
+		}
+	}
+
+	err := s.httpServer.Shutdown(ctx)
+
+	// Close rather than drain: an in-flight CPU profile would otherwise hold
+	// shutdown for its whole duration
+	if s.debugServer != nil {
+		if debugErr := s.debugServer.Close(); debugErr != nil {
+			s.logger.Error("failed to close debug server", slog.String("error", debugErr.Error()))
+		}
+	}
+
+	if s.stopJobs != nil {
+		s.logger.Info("stopping background jobs")
+		s.stopJobs()
+		if !waitUntil(ctx, s.jobsDone.Wait) {
+			s.logger.Warn("background jobs still running at the shutdown deadline")
+		}
+	}
+
+	// Before the stores close: a publish that fails now is dead-lettered
+	if s.publisherCloser != nil {
+		s.logger.Info("draining event publisher")
+		if pubErr := s.publisherCloser(ctx); pubErr != nil {
+			s.logger.Error("failed to drain event publisher", slog.String("error", pubErr.Error()))
+		}
+	}
+
+	if s.dbPool != nil {
+		s.logger.Info("closing database connection pool")
+		s.dbPool.Close()
+	}
+
+	if s.replica != nil {
+		s.logger.Info("closing read replica connection pool")
+		s.replica.Close()
+	}
+
+	if s.redisCloser != nil {
+		s.logger.Info("closing Redis connection")
+		if redisErr := s.redisCloser(); redisErr != nil {
+			s.logger.Error("failed to close Redis", slog.String("error", redisErr.Error()))
+		}
+	}
+
+	// Last, as the calls it records have all finished
+	if s.auditCloser != nil {
+		if auditErr := s.auditCloser.Close(); auditErr != nil {
+			s.logger.Error("failed to close audit log", slog.String("error", auditErr.Error()))
+		}
+	}
+
+	return err
+}
+
+// waitUntil runs wait and reports whether it returned before ctx ended. On
+// false, wait keeps running in the background.
+func waitUntil(ctx context.Context, wait func()) bool {
+	done := make(chan struct{})
+	go func() {
+		wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Reload re-reads the configuration and applies the settings that can
+// change without a restart, logging what changed.
+func (s *Server) Reload() {
+	applied, ignored, err := s.provider.Reload()
+	if err != nil {
+		s.logger.Error("config reload failed, keeping current settings", slog.String("error", err.Error()))
+		return
+	}
+	if len(ignored) > 0 {
+		s.logger.Warn("config changes need a restart to apply", slog.Any("keys", ignored))
+	}
+	s.logger.Info("config reloaded", slog.Any("applied", applied))
+}
+
+// Run starts the server in the given mode, reloads tunables on SIGHUP and
+// handles graceful shutdown
+func Run(provider *config.Provider, mode Mode) error {
+	server := NewServer(provider, mode)
+	server.StartJobs()
+
+	// Start server in a goroutine
+	go func() {
+		if err := server.Start(); err != nil {
+			server.logger.Error("server error", slog.String("error", err.Error()))
+		}
+	}()
+
+	// Wait for interrupt signal, reloading the config on SIGHUP
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+	go func() {
+		for range reload {
+			server.Reload()
+		}
+	}()
+	<-quit
+
+	// Graceful shutdown
+	ctx, cancel := context.WithTimeout(context.Background(), server.cfg.Server.ShutdownTimeout)
+	defer cancel()
+
+	return server.Shutdown(ctx)
+}
+
+// newLogger writes to stdout as JSON, or as text when format is "text".
+// Records logged with a request context carry its request_id.
+func newLogger(format string, level slog.Leveler) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: level}
+	var h slog.Handler = slog.NewJSONHandler(os.Stdout, opts)
+	if format == "text" {
+		h = slog.NewTextHandler(os.Stdout, opts)
+	}
+	return slog.New(correlation.NewHandler(h))
+}
+
+// newSecretManager sets up the secret sources the credential settings may reference
+func newSecretManager(cfg *config.Config) (*secrets.Manager, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return secrets.Setup(ctx, secrets.Config{
+		Refresh: cfg.Secrets.RefreshInterval,
+		Vault: secrets.VaultConfig{
+			Addr:      cfg.Secrets.VaultAddr,
+			Token:     cfg.Secrets.VaultToken,
+			Namespace: cfg.Secrets.VaultNamespace,
+		},
+		AWSEndpoint: cfg.Secrets.AWSEndpoint,
+	}, cfg.Database.Password, cfg.Database.ReplicaDSN, cfg.Redis.Password, cfg.Admin.APIKey, cfg.Auth.JWTSecret, cfg.Search.OpenSearchPassword,
+		cfg.Kafka.SchemaRegistryPassword, cfg.Payments.StripeSecretKey, cfg.Payments.StripeWebhookSecret)
+}
+
+// connectEventPublisher creates the event publisher once its broker is
+// reachable. Unless MESSAGING_REQUIRED is set, a broker that stays
+// unreachable does not stop startup: Kafka events are dead-lettered until
+// the broker is back, while NATS and SNS fall back to the no-op publisher.
+func connectEventPublisher(cfg *config.Config, logger *slog.Logger, router *messaging.TopicRouter, codec *messaging.SchemaCodec, deadLetters messaging.DeadLetterStore, resilience *messaging.Resilience, policy retry.Policy) (service.EventPublisher, messaging.Redeliverer, func(ctx context.Context) error, error) {
+	if cfg.Messaging.Backend == config.MessagingBackendKafka && len(cfg.Kafka.Brokers) > 0 && cfg.Kafka.Brokers[0] != "" {
+		err := waitFor(logger, policy, "kafka", func(ctx context.Context) error {
+			return kafkapub.Ping(ctx, cfg.Kafka.Brokers)
+		})
+		if err != nil {
+			if cfg.Messaging.Required {
+				return nil, nil, nil, err
+			}
+			logger.Warn("Kafka unreachable, starting anyway; events are dead-lettered until it is back", slog.String("error", err.Error()))
+		}
+		return newEventPublisher(cfg, logger, router, codec, deadLetters, resilience)
+	}
+
+	var (
+		publisher   service.EventPublisher
+		redeliverer messaging.Redeliverer
+		closer      func(ctx context.Context) error
+	)
+	err := waitFor(logger, policy, cfg.Messaging.Backend, func(context.Context) error {
+		var err error
+		publisher, redeliverer, closer, err = newEventPublisher(cfg, logger, router, codec, deadLetters, resilience)
+		return err
+	})
+	if err != nil {
+		if cfg.Messaging.Required {
+			return nil, nil, nil, err
+		}
+		logger.Warn("event broker unreachable, starting without it; events are not published",
+			slog.String("backend", cfg.Messaging.Backend),
+			slog.String("error", err.Error()),
+		)
+		return noop.Publisher{}, nil, nil, nil
+	}
+	return publisher, redeliverer, closer, nil
+}
+
+// periodicJob adapts one pass of a background job to a periodic job
+// definition run every interval
+func periodicJob[T any](kind domain.JobKind, every time.Duration, pass func(ctx context.Context) (T, error)) service.JobDefinition {
+	return service.JobDefinition{
+		Kind:  kind,
+		Every: every,
+		Run: func(ctx context.Context, _ *domain.Job) error {
+			_, err := pass(ctx)
+			return err
+		},
+	}
+}
+
+// waitFor retries ping under policy, bounding each attempt and logging each
+// failure, until the named dependency answers or the policy gives up.
+func waitFor(logger *slog.Logger, policy retry.Policy, name string, ping func(ctx context.Context) error) error {
+	return retry.Do(context.Background(), policy, func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, dependencyPingTimeout)
+		defer cancel()
+		return ping(ctx)
+	}, func(attempt int, err error, delay time.Duration) {
+		logger.Warn("dependency not ready, retrying",
+			slog.String("dependency", name),
+			slog.Int("attempt", attempt),
+			slog.Duration("backoff", delay),
+			slog.String("error", err.Error()),
+		)
+	})
+}
+
+// newEventPublisher builds the publisher selected by MESSAGING_BACKEND. The
+// returned Redeliverer is nil when events are not sent anywhere (no-op backend).
+// Every backend runs its writes through resilience. router picks the Kafka
+// topic of each event, and codec, if set, encodes Kafka events for the
+// schema registry.
+func newEventPublisher(cfg *config.Config, logger *slog.Logger, router *messaging.TopicRouter, codec *messaging.SchemaCodec, deadLetters messaging.DeadLetterStore, resilience *messaging.Resilience) (service.EventPublisher, messaging.Redeliverer, func(ctx context.Context) error, error) {
+	format, err := messaging.ParseEventFormat(cfg.Kafka.EventFormat)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	source := "/" + cfg.App.Name
+
+	switch cfg.Messaging.Backend {
+	case config.MessagingBackendNATS:
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		np, err := natspub.NewPublisher(ctx, natspub.Config{
+			URL:           cfg.NATS.URL,
+			Stream:        cfg.NATS.Stream,
+			SubjectPrefix: cfg.NATS.SubjectPrefix,
+			Format:        format,
+			Source:        source,
+			DeadLetters:   deadLetters,
+			Resilience:    resilience,
+		})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		logger.Info("NATS JetStream publisher initialized",
+			slog.String("url", cfg.NATS.URL),
+			slog.String("stream", cfg.NATS.Stream),
+			slog.String("subject_prefix", cfg.NATS.SubjectPrefix),
+			slog.String("event_format", string(format)),
+		)
+		return np, np, func(context.Context) error { return np.Close() }, nil
+
+	case config.MessagingBackendSNS:
+		if cfg.SNS.TopicARN == "" {
+			return nil, nil, nil, fmt.Errorf("SNS_TOPIC_ARN is required when MESSAGING_BACKEND=sns")
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		sp, err := snspub.NewPublisher(ctx, snspub.Config{
+			TopicARN:    cfg.SNS.TopicARN,
+			Endpoint:    cfg.SNS.Endpoint,
+			Format:      format,
+			Source:      source,
+			DeadLetters: deadLetters,
+			Resilience:  resilience,
+		})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		logger.Info("SNS publisher initialized",
+			slog.String("topic_arn", cfg.SNS.TopicARN),
+			slog.Bool("fifo", snspub.IsFIFOTopic(cfg.SNS.TopicARN)),
+			slog.String("event_format", string(format)),
+		)
+		return sp, sp, nil, nil
+
+	case config.MessagingBackendKafka:
+		if len(cfg.Kafka.Brokers) == 0 || cfg.Kafka.Brokers[0] == "" {
+			logger.Info("Kafka not configured, using no-op publisher")
+			return noop.Publisher{}, nil, nil, nil
+		}
+		acks, err := kafkapub.ParseRequiredAcks(cfg.Kafka.RequiredAcks)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		compression, err := kafkapub.ParseCompression(cfg.Kafka.Compression)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		kp := kafkapub.NewPublisher(kafkapub.Config{
+			Brokers:      cfg.Kafka.Brokers,
+			Topic:        cfg.Kafka.Topic,
+			Router:       router,
+			Format:       format,
+			Source:       source,
+			Codec:        codec,
+			DeadLetters:  deadLetters,
+			Resilience:   resilience,
+			RequiredAcks: acks,
+			BatchSize:    cfg.Kafka.BatchSize,
+			BatchBytes:   int64(cfg.Kafka.BatchBytes),
+			BatchTimeout: cfg.Kafka.BatchTimeout,
+			Compression:  compression,
+			MaxAttempts:  cfg.Kafka.MaxAttempts,
+			Async:        cfg.Kafka.PublishMode == "async",
+		})
+		logger.Info("Kafka publisher initialized",
+			slog.Any("brokers", cfg.Kafka.Brokers),
+			slog.String("topic", cfg.Kafka.Topic),
+			slog.String("topic_routing", cfg.Kafka.TopicRouting),
+			slog.Any("topics", router.Topics()),
+			slog.String("event_format", string(format)),
+			slog.String("required_acks", cfg.Kafka.RequiredAcks),
+			slog.String("compression", cfg.Kafka.Compression),
+			slog.String("publish_mode", cfg.Kafka.PublishMode),
+			slog.Bool("idempotent", cfg.Kafka.Idempotent),
+		)
+		return kp, kp, kp.Shutdown, nil
+
+	case config.MessagingBackendNone:
+		logger.Info("messaging disabled, using no-op publisher")
+		return noop.Publisher{}, nil, nil, nil
+
+	default:
+		return nil, nil, nil, fmt.Errorf("unknown messaging backend %q", cfg.Messaging.Backend)
+	}
+}
+
+// newTopicRouter builds the router that picks the Kafka topic of each event
+// according to KAFKA_TOPIC_ROUTING.
+func newTopicRouter(cfg *config.Config) (*messaging.TopicRouter, error) {
+	routing, err := messaging.ParseTopicRouting(cfg.Kafka.TopicRouting)
+	if err != nil {
+		return nil, err
+	}
+	return messaging.NewTopicRouter(cfg.Kafka.Topic, routing, cfg.Kafka.EventTopics)
+}
+
+// rateLimitPolicies converts the rate limit configuration to the limiter's policies
+func rateLimitPolicies(cfg config.RateLimitConfig) ratelimit.Policies {
+	policies := ratelimit.Policies{
+		Client: ratelimit.Policy{
+			RequestsPerMinute:      cfg.RequestsPerMinute,
+			WriteRequestsPerMinute: cfg.WriteRequestsPerMinute,
+			Burst:                  cfg.Burst,
+		},
+	}
+	for _, p := range cfg.Policies {
+		policies.Rules = append(policies.Rules, ratelimit.Rule{
+			Tenant:     p.Tenant,
+			CustomerID: p.CustomerID,
+			Policy: ratelimit.Policy{
+				RequestsPerMinute:      p.RequestsPerMinute,
+				WriteRequestsPerMinute: p.WriteRequestsPerMinute,
+				Burst:                  p.Burst,
+			},
+		})
+	}
+	return policies
+}
+
+// newSchemaCodec builds the codec of the avro and protobuf event formats and
+// registers the event schema under the subject of every topic router
+// publishes to, or returns nil for the JSON formats. A schema
+// the registry rejects as incompatible always stops startup; an unreachable
+// registry does so only with MESSAGING_REQUIRED, as the codec retries on the
+// next publish.
+func newSchemaCodec(cfg *config.Config, logger *slog.Logger, router *messaging.TopicRouter) (*messaging.SchemaCodec, error) {
+	format, err := messaging.ParseEventFormat(cfg.Kafka.EventFormat)
+	if err != nil || !format.UsesSchemaRegistry() {
+		return nil, err
+	}
+	strategy, err := messaging.ParseSubjectStrategy(cfg.Kafka.SchemaSubjectStrategy)
+	if err != nil {
+		return nil, err
+	}
+	codec, err := messaging.NewSchemaCodec(messaging.SchemaCodecConfig{
+		Registry: schemaregistry.NewClient(schemaregistry.Config{
+			URL:      cfg.Kafka.SchemaRegistryURL,
+			Username: cfg.Kafka.SchemaRegistryUsername,
+			Password: cfg.Kafka.SchemaRegistryPassword,
+		}),
+		Format:       format,
+		Topic:        cfg.Kafka.Topic,
+		Topics:       router.Topics()[1:],
+		Strategy:     strategy,
+		AutoRegister: cfg.Kafka.SchemaAutoRegister,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dependencyPingTimeout)
+	defer cancel()
+	id, err := codec.Prepare(ctx)
+	switch {
+	case err == nil:
+		logger.Info("event schema registered",
+			slog.String("event_format", string(format)),
+			slog.String("subject", codec.Subject()),
+			slog.Int("schema_id", id),
+		)
+	case errors.Is(err, messaging.ErrIncompatibleSchema) || cfg.Messaging.Required:
+		return nil, err
+	default:
+		logger.Warn("schema registry unreachable, starting anyway; events fail to publish until it is back",
+			slog.String("error", err.Error()))
+	}
+	return codec, nil
+}
+
+// newEventReplayService builds the event replay service. Replays to the
+// broker go through publisher when its backend can send events, so the no-op
+// publisher leaves only webhook replays.
+func newEventReplayService(cfg *config.Config, history repository.OrderHistoryRepository, publisher service.EventPublisher, outboundMetrics *httpclient.Metrics) (service.EventReplayService, error) {
+	format, err := messaging.ParseEventFormat(cfg.Kafka.EventFormat)
+	if err != nil {
+		return nil, err
+	}
+	if format.UsesSchemaRegistry() {
+		// Webhook receivers have no schema registry to decode against
+		format = messaging.EventFormatCloudEvents
+	}
+	broker, _ := publisher.(messaging.EventSender)
+	webhooks := webhook.NewSender(newOutboundClient(cfg, "webhook", outboundMetrics), format, "/"+cfg.App.Name)
+	return service.NewEventReplayService(history, broker, webhooks), nil
+}
+
+// newOutboundClient builds the HTTP client for calls to the integration
+// named name, tuned by OUTBOUND_*. Every client shares metrics.
+func newOutboundClient(cfg *config.Config, name string, metrics *httpclient.Metrics) *httpclient.Client {
+	return httpclient.New(httpclient.Config{
+		Name:            name,
+		Timeout:         cfg.Outbound.Timeout,
+		MaxAttempts:     cfg.Outbound.MaxAttempts,
+		InitialBackoff:  cfg.Outbound.InitialBackoff,
+		MaxBackoff:      cfg.Outbound.MaxBackoff,
+		BreakerFailures: cfg.Outbound.BreakerFailures,
+		BreakerCooldown: cfg.Outbound.BreakerCooldown,
+	}, metrics)
+}
+
+// newPricingService builds the pricing backed by the product catalog at
+// PRICING_CATALOG_URL, or passthrough pricing when none is configured
+func newPricingService(cfg *config.Config, outboundMetrics *httpclient.Metrics) service.PricingService {
+	if cfg.Pricing.CatalogURL == "" {
+		return service.PassthroughPricing{}
+	}
+	return catalog.New(newOutboundClient(cfg, catalog.Name, outboundMetrics), cfg.Pricing.CatalogURL)
+}
+
+// newPaymentProvider builds the provider selected by PAYMENTS_PROVIDER, or
+// returns nil when payments are disabled
+func newPaymentProvider(cfg *config.Config, outboundMetrics *httpclient.Metrics) service.PaymentProvider {
+	switch cfg.Payments.Provider {
+	case config.PaymentsProviderStripe:
+		return stripe.New(newOutboundClient(cfg, stripe.Name, outboundMetrics), stripe.Config{
+			URL:           cfg.Payments.StripeURL,
+			SecretKey:     cfg.Payments.StripeSecretKey,
+			WebhookSecret: cfg.Payments.StripeWebhookSecret,
+		})
+	case config.PaymentsProviderMock:
+		return paymentmock.New(cfg.Payments.MockWebhookSecret)
+	default:
+		return nil
+	}
+}
+
+// newOrderSearcher builds the searcher selected by SEARCH_BACKEND. For
+// opensearch it also returns a job that keeps the index in sync with the
+// order events on the Kafka topic, populating a newly created index first.
+func newOrderSearcher(cfg *config.Config, logger *slog.Logger, router *messaging.TopicRouter, codec *messaging.SchemaCodec, repo repository.OrderRepository) (repository.OrderSearcher, func(ctx context.Context), error) {
+	switch cfg.Search.Backend {
+	case config.SearchBackendPostgres:
+		return repo, nil, nil
+
+	case config.SearchBackendOpenSearch:
+		index := opensearch.NewIndex(opensearch.Config{
+			URL:      cfg.Search.OpenSearchURL,
+			Index:    cfg.Search.OpenSearchIndex,
+			Username: cfg.Search.OpenSearchUsername,
+			Password: cfg.Search.OpenSearchPassword,
+		})
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		created, err := index.EnsureIndex(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		logger.Info("OpenSearch search backend initialized",
+			slog.String("url", cfg.Search.OpenSearchURL),
+			slog.String("index", cfg.Search.OpenSearchIndex),
+			slog.Bool("created", created),
+		)
+
+		if cfg.Messaging.Backend != config.MessagingBackendKafka || len(cfg.Kafka.Brokers) == 0 || cfg.Kafka.Brokers[0] == "" {
+			logger.Warn("search index is fed by Kafka order events; without Kafka it will not be updated")
+			return index, nil, nil
+		}
+
+		// A new consumer group starts at the end of the topics: orders that
+		// already exist are copied in by Reindex when the index is created.
+		reader := kafka.NewReader(kafka.ReaderConfig{
+			Brokers:     cfg.Kafka.Brokers,
+			GroupTopics: router.Topics(),
+			GroupID:     cfg.Kafka.GroupID + "-search-indexer",
+			StartOffset: kafka.LastOffset,
+		})
+		indexer := search.NewIndexer(reader, codec, repo, index)
+		return index, func(ctx context.Context) {
+			if created {
+				indexed, err := indexer.Reindex(ctx)
+				if err != nil {
+					logger.Warn("failed to populate search index", slog.String("error", err.Error()))
+				} else {
+					logger.Info("search index populated", slog.Int("orders", indexed))
+				}
+			}
+			indexer.Run(ctx)
+		}, nil
+
+	default:
+		return nil, nil, fmt.Errorf("unknown search backend %q", cfg.Search.Backend)
+	}
+}
+
+// safeInt32 converts int to int32 with clamping to prevent overflow.
+func safeInt32(v int) int32 {
+	const maxInt32 = 1<<31 - 1
+	if v > maxInt32 {
+		return maxInt32
+	}
+	if v < 0 {
+		return 0
+	}
+	return int32(v) // #nosec G115 -- bounds checked above
+}
@@ -0,0 +1,225 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package audit records the service's admin and mutating API calls in a
+// dedicated, tamper-evident log. Each entry carries the SHA-256 of the one
+// before it, so editing, removing or reordering entries breaks the chain;
+// see Verify.
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/correlation"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// Entry is one audited call
+type Entry struct {
+	// Seq numbers the entries of a log from 1
+	Seq  int64     `json:"seq"`
+	Time time.Time `json:"time"`
+	// Channel is http or grpc
+	Channel string `json:"channel"`
+	// Method is the HTTP method or the full gRPC method name
+	Method string `json:"method"`
+	// Path is the request path; Route is the route pattern it matched
+	Path  string `json:"path,omitempty"`
+	Route string `json:"route,omitempty"`
+	// Status is the HTTP status, Code the gRPC status code
+	Status    int    `json:"status,omitempty"`
+	Code      string `json:"code,omitempty"`
+	Actor     string `json:"actor,omitempty"`
+	ActorType string `json:"actor_type"`
+	TenantID  string `json:"tenant_id,omitempty"`
+	IP        string `json:"ip,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+	// Orders are the orders the call changed
+	Orders []OrderVersion `json:"orders,omitempty"`
+}
+
+// OrderVersion is an order's version before and after a call changed it.
+// BeforeVersion is 0 for an order the call created.
+type OrderVersion struct {
+	OrderID       uuid.UUID `json:"order_id"`
+	BeforeVersion int       `json:"before_version,omitempty"`
+	AfterVersion  int       `json:"after_version"`
+}
+
+// NewEntry returns an entry for the call in ctx, naming its caller, tenant
+// and request ID, with the orders rec collected
+func NewEntry(ctx context.Context, rec *Recorder) Entry {
+	return Entry{
+		Time:      time.Now().UTC(),
+		Channel:   string(domain.ChannelFromContext(ctx)),
+		Actor:     domain.ActorFromContext(ctx),
+		ActorType: string(domain.ActorTypeFromContext(ctx)),
+		TenantID:  domain.TenantID(ctx),
+		RequestID: correlation.ID(ctx),
+		Orders:    rec.Orders(),
+	}
+}
+
+// Logger writes entries as JSON lines of the form
+//
+//	{"msg":"audit","entry":{...},"prev_hash":"...","hash":"..."}
+//
+// where hash is the hex SHA-256 of prev_hash followed by the entry's JSON.
+// It is safe for concurrent use.
+type Logger struct {
+	handler slog.Handler
+
+	mu       sync.Mutex
+	seq      int64
+	lastHash string
+}
+
+// NewLogger returns a logger writing a new chain to w
+func NewLogger(w io.Writer) *Logger {
+	return &Logger{
+		handler: slog.NewJSONHandler(w, &slog.HandlerOptions{
+			// The entry carries its own time, covered by the hash
+			ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+				if len(groups) == 0 && a.Key == slog.LevelKey {
+					return slog.Attr{}
+				}
+				return a
+			},
+		}),
+	}
+}
+
+// Open returns a logger appending to the file at path, continuing the chain
+// of its last entry, or writing a new chain to stdout if path is "-". Close
+// the returned closer on shutdown.
+func Open(path string) (*Logger, io.Closer, error) {
+	if path == "-" {
+		return NewLogger(os.Stdout), io.NopCloser(nil), nil
+	}
+
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open audit log: %w", err)
+	}
+	l := NewLogger(f)
+	last, err := lastLine(f)
+	if err == nil && last != nil {
+		var ln *line
+		if ln, err = parseLine(last); err == nil {
+			l.seq, l.lastHash = ln.seq, ln.Hash
+		}
+	}
+	if err != nil {
+		_ = f.Close()
+		return nil, nil, fmt.Errorf("read last entry of audit log %s: %w", path, err)
+	}
+	return l, f, nil
+}
+
+// Log numbers e, chains it to the previous entry and writes it. An entry
+// that fails to write does not become part of the chain.
+func (l *Logger) Log(ctx context.Context, e Entry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	e.Seq = l.seq + 1
+	body, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("encode audit entry: %w", err)
+	}
+	hash := chainHash(l.lastHash, body)
+
+	// A zero time leaves slog's own timestamp out
+	r := slog.NewRecord(time.Time{}, slog.LevelInfo, "audit", 0)
+	r.AddAttrs(
+		slog.Any("entry", json.RawMessage(body)),
+		slog.String("prev_hash", l.lastHash),
+		slog.String("hash", hash),
+	)
+	if err := l.handler.Handle(ctx, r); err != nil {
+		return fmt.Errorf("write audit entry: %w", err)
+	}
+	l.seq, l.lastHash = e.Seq, hash
+	return nil
+}
+
+// chainHash is the hash of an entry following the one hashed prevHash
+func chainHash(prevHash string, entry []byte) string {
+	h := sha256.New()
+	h.Write([]byte(prevHash))
+	h.Write(entry)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// line is a written entry
+type line struct {
+	Entry    json.RawMessage `json:"entry"`
+	PrevHash string          `json:"prev_hash"`
+	Hash     string          `json:"hash"`
+	seq      int64
+}
+
+func parseLine(b []byte) (*line, error) {
+	var ln line
+	if err := json.Unmarshal(b, &ln); err != nil {
+		return nil, err
+	}
+	var e struct {
+		Seq int64 `json:"seq"`
+	}
+	if err := json.Unmarshal(ln.Entry, &e); err != nil {
+		return nil, fmt.Errorf("entry: %w", err)
+	}
+	ln.seq = e.Seq
+	return &ln, nil
+}
+
+// lastLine returns the last non-empty line of f, or nil if it has none. It
+// reads back from the end in growing chunks, as the log may be large.
+func lastLine(f *os.File) ([]byte, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := info.Size()
+	for chunk := int64(64 << 10); ; chunk *= 2 {
+		off := max(size-chunk, 0)
+		buf := make([]byte, size-off)
+		if _, err := f.ReadAt(buf, off); err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		buf = bytes.TrimRight(buf, "\n")
+		if i := bytes.LastIndexByte(buf, '\n'); i >= 0 {
+			return buf[i+1:], nil
+		}
+		if off == 0 {
+			if len(buf) == 0 {
+				return nil, nil
+			}
+			return buf, nil
+		}
+	}
+}
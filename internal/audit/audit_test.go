@@ -0,0 +1,138 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogger_Log_WritesVerifiableChain(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger(&buf)
+	orderID := uuid.New()
+
+	require.NoError(t, logger.Log(context.Background(), Entry{Method: "POST", Path: "/api/v1/orders", Status: 201,
+		Orders: []OrderVersion{{OrderID: orderID, AfterVersion: 1}}}))
+	require.NoError(t, logger.Log(context.Background(), Entry{Method: "PATCH", Path: "/api/v1/orders/<id>", Status: 200}))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	var first struct {
+		Msg      string `json:"msg"`
+		Entry    Entry  `json:"entry"`
+		PrevHash string `json:"prev_hash"`
+		Hash     string `json:"hash"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+	assert.Equal(t, "audit", first.Msg)
+	assert.Equal(t, int64(1), first.Entry.Seq)
+	assert.Equal(t, []OrderVersion{{OrderID: orderID, AfterVersion: 1}}, first.Entry.Orders)
+	assert.Empty(t, first.PrevHash)
+	assert.Contains(t, lines[1], `"prev_hash":"`+first.Hash+`"`)
+	assert.Contains(t, lines[0], `"path":"/api/v1/orders"`)
+	assert.Contains(t, lines[1], `\u003cid\u003e`, "written as hashed, HTML characters escaped")
+
+	result, err := Verify(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Entries)
+	assert.Equal(t, int64(1), result.FirstSeq)
+	assert.Empty(t, result.PrevHash)
+}
+
+func TestVerify_BrokenChain_ReturnsErrTampered(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger(&buf)
+	for _, status := range []int{200, 403, 200} {
+		require.NoError(t, logger.Log(context.Background(), Entry{Method: "DELETE", Status: status}))
+	}
+	tests := []struct {
+		name    string
+		tamper  func(lines []string) []string
+		wantErr string
+	}{
+		{
+			name: "edited",
+			tamper: func(lines []string) []string {
+				lines[1] = strings.Replace(lines[1], `"status":403`, `"status":200`, 1)
+				return lines
+			},
+			wantErr: "line 2: audit log chain is broken: entry 2 does not match its hash",
+		},
+		{
+			name:    "removed",
+			tamper:  func(lines []string) []string { return append(lines[:1], lines[2:]...) },
+			wantErr: "line 2: audit log chain is broken: entry 3 does not follow entry 1",
+		},
+		{
+			name:    "reordered",
+			tamper:  func(lines []string) []string { return []string{lines[0], lines[2], lines[1]} },
+			wantErr: "line 2: audit log chain is broken: entry 3 does not follow entry 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := tt.tamper(strings.Split(strings.TrimSpace(buf.String()), "\n"))
+
+			_, err := Verify(strings.NewReader(strings.Join(lines, "\n")))
+
+			require.ErrorIs(t, err, ErrTampered)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestOpen_ExistingFile_ContinuesChain(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	for range 2 {
+		logger, closer, err := Open(path)
+		require.NoError(t, err)
+		require.NoError(t, logger.Log(context.Background(), Entry{Time: time.Now().UTC(), Method: "POST"}))
+		require.NoError(t, closer.Close())
+	}
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	result, err := Verify(f)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Entries)
+	assert.Equal(t, int64(1), result.FirstSeq)
+}
+
+func TestRecordOrder_MergesChangesToOneOrder(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+	RecordOrder(context.Background(), first, 1, 2) // outside an audited call
+
+	ctx, rec := WithRecorder(context.Background())
+	RecordOrder(ctx, first, 3, 4)
+	RecordOrder(ctx, second, 0, 1)
+	RecordOrder(ctx, first, 4, 5)
+
+	assert.Equal(t, []OrderVersion{
+		{OrderID: first, BeforeVersion: 3, AfterVersion: 5},
+		{OrderID: second, AfterVersion: 1},
+	}, rec.Orders())
+}
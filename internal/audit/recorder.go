@@ -0,0 +1,63 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Recorder collects the orders changed while serving one call
+type Recorder struct {
+	mu     sync.Mutex
+	orders []OrderVersion
+}
+
+type recorderKey struct{}
+
+// WithRecorder returns a context whose order changes are collected by the
+// returned recorder
+func WithRecorder(ctx context.Context) (context.Context, *Recorder) {
+	rec := &Recorder{}
+	return context.WithValue(ctx, recorderKey{}, rec), rec
+}
+
+// RecordOrder notes that the call in ctx moved order id from version before
+// to after; before is 0 for a new order. It does nothing outside an audited
+// call. An order changed more than once keeps its first before version.
+func RecordOrder(ctx context.Context, id uuid.UUID, before, after int) {
+	rec, ok := ctx.Value(recorderKey{}).(*Recorder)
+	if !ok {
+		return
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	for i := range rec.orders {
+		if rec.orders[i].OrderID == id {
+			rec.orders[i].AfterVersion = max(rec.orders[i].AfterVersion, after)
+			return
+		}
+	}
+	rec.orders = append(rec.orders, OrderVersion{OrderID: id, BeforeVersion: before, AfterVersion: after})
+}
+
+// Orders returns the recorded changes in the order they were first made
+func (r *Recorder) Orders() []OrderVersion {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]OrderVersion(nil), r.orders...)
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrTampered is returned by Verify for a log whose chain is broken
+var ErrTampered = errors.New("audit log chain is broken")
+
+// VerifyResult describes a log whose chain is intact
+type VerifyResult struct {
+	// Entries is the number of entries read
+	Entries int
+	// FirstSeq and PrevHash identify where the log starts: a log written
+	// from the beginning has FirstSeq 1 and an empty PrevHash
+	FirstSeq int64
+	PrevHash string
+	// LastHash is the hash of the final entry
+	LastHash string
+}
+
+// Verify reads a log written by Logger and checks that every entry hashes
+// to its recorded hash and follows the one before it. A break returns an
+// error wrapping ErrTampered that names the first line at fault.
+func Verify(r io.Reader) (*VerifyResult, error) {
+	br := bufio.NewReader(r)
+	result := &VerifyResult{}
+	for n := 1; ; n++ {
+		b, err := br.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		if b = bytes.TrimSpace(b); len(b) > 0 {
+			if err := result.next(b); err != nil {
+				return nil, fmt.Errorf("line %d: %w", n, err)
+			}
+		}
+		if err != nil {
+			return result, nil
+		}
+	}
+}
+
+// next checks b follows the entries read so far
+func (v *VerifyResult) next(b []byte) error {
+	ln, err := parseLine(b)
+	if err != nil {
+		return fmt.Errorf("%w: not an audit entry: %v", ErrTampered, err)
+	}
+	if chainHash(ln.PrevHash, ln.Entry) != ln.Hash {
+		return fmt.Errorf("%w: entry %d does not match its hash", ErrTampered, ln.seq)
+	}
+	if v.Entries == 0 {
+		v.FirstSeq, v.PrevHash = ln.seq, ln.PrevHash
+	} else if ln.PrevHash != v.LastHash || ln.seq != v.FirstSeq+int64(v.Entries) {
+		return fmt.Errorf("%w: entry %d does not follow entry %d", ErrTampered, ln.seq, v.FirstSeq+int64(v.Entries)-1)
+	}
+	v.Entries++
+	v.LastHash = ln.Hash
+	return nil
+}
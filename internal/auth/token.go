@@ -0,0 +1,144 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auth verifies the bearer tokens that identify order API callers.
+// Tokens are JWTs signed with HS256 using a secret shared with the issuer.
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// ErrInvalidToken is returned for tokens that are malformed, wrongly signed,
+// expired or missing required claims
+var ErrInvalidToken = errors.New("invalid token")
+
+// Claims are the JWT claims the service reads
+type Claims struct {
+	Subject    string `json:"sub"`
+	Issuer     string `json:"iss,omitempty"`
+	ExpiresAt  int64  `json:"exp,omitempty"`
+	NotBefore  int64  `json:"nbf,omitempty"`
+	Role       string `json:"role"`
+	CustomerID string `json:"customer_id,omitempty"`
+	TenantID   string `json:"tenant_id,omitempty"`
+}
+
+// Verifier checks HS256 JWTs and maps their claims to a domain.Principal
+type Verifier struct {
+	secret []byte
+	issuer string
+	leeway time.Duration
+	now    func() time.Time
+}
+
+// NewVerifier creates a Verifier for tokens signed with secret. If issuer
+// is set, tokens must carry it as their iss claim.
+func NewVerifier(secret, issuer string) *Verifier {
+	return &Verifier{
+		secret: []byte(secret),
+		issuer: issuer,
+		leeway: 30 * time.Second,
+		now:    time.Now,
+	}
+}
+
+// Verify checks the token's signature and time claims and returns its
+// principal. Customer tokens must name the customer they act for.
+func (v *Verifier) Verify(token string) (*domain.Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed", ErrInvalidToken)
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil || header.Alg != "HS256" {
+		return nil, fmt.Errorf("%w: unsupported header", ErrInvalidToken)
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(sig, v.sign(parts[0]+"."+parts[1])) {
+		return nil, fmt.Errorf("%w: bad signature", ErrInvalidToken)
+	}
+
+	var claims Claims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("%w: malformed claims", ErrInvalidToken)
+	}
+
+	now := v.now()
+	switch {
+	case claims.ExpiresAt != 0 && now.After(time.Unix(claims.ExpiresAt, 0).Add(v.leeway)):
+		return nil, fmt.Errorf("%w: expired", ErrInvalidToken)
+	case claims.NotBefore != 0 && now.Add(v.leeway).Before(time.Unix(claims.NotBefore, 0)):
+		return nil, fmt.Errorf("%w: not yet valid", ErrInvalidToken)
+	case v.issuer != "" && claims.Issuer != v.issuer:
+		return nil, fmt.Errorf("%w: unexpected issuer", ErrInvalidToken)
+	}
+
+	role := domain.Role(claims.Role)
+	if !role.IsValid() {
+		return nil, fmt.Errorf("%w: unknown role %q", ErrInvalidToken, claims.Role)
+	}
+	if role == domain.RoleCustomer && claims.CustomerID == "" {
+		return nil, fmt.Errorf("%w: customer token without customer_id", ErrInvalidToken)
+	}
+	if claims.TenantID != "" && !domain.ValidTenantID(claims.TenantID) {
+		return nil, fmt.Errorf("%w: malformed tenant_id", ErrInvalidToken)
+	}
+
+	return &domain.Principal{
+		Subject:    claims.Subject,
+		Role:       role,
+		CustomerID: claims.CustomerID,
+		TenantID:   claims.TenantID,
+	}, nil
+}
+
+// Sign returns an HS256 token for claims. The service only verifies tokens;
+// Sign exists for tests and tooling that mint them with the shared secret.
+func (v *Verifier) Sign(claims Claims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`)) +
+		"." + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(v.sign(unsigned)), nil
+}
+
+func (v *Verifier) sign(unsigned string) []byte {
+	mac := hmac.New(sha256.New, v.secret)
+	mac.Write([]byte(unsigned))
+	return mac.Sum(nil)
+}
+
+func decodeSegment(seg string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestVerifier(now time.Time) *Verifier {
+	v := NewVerifier("test-secret", "issuer.example")
+	v.now = func() time.Time { return now }
+	return v
+}
+
+func TestVerifier_Verify_ValidToken_ReturnsPrincipal(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	v := newTestVerifier(now)
+	token, err := v.Sign(Claims{
+		Subject:    "user-1",
+		Issuer:     "issuer.example",
+		ExpiresAt:  now.Add(time.Hour).Unix(),
+		Role:       "customer",
+		CustomerID: "cust-1",
+		TenantID:   "acme",
+	})
+	require.NoError(t, err)
+
+	p, err := v.Verify(token)
+
+	require.NoError(t, err)
+	assert.Equal(t, &domain.Principal{Subject: "user-1", Role: domain.RoleCustomer, CustomerID: "cust-1", TenantID: "acme"}, p)
+}
+
+func TestVerifier_Verify_InvalidToken_ReturnsError(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	v := newTestVerifier(now)
+	valid := Claims{Subject: "svc", Issuer: "issuer.example", ExpiresAt: now.Add(time.Hour).Unix(), Role: "service"}
+	sign := func(c Claims) string {
+		token, err := v.Sign(c)
+		require.NoError(t, err)
+		return token
+	}
+	withClaims := func(edit func(c *Claims)) string {
+		c := valid
+		edit(&c)
+		return sign(c)
+	}
+	otherKey, err := NewVerifier("other-secret", "").Sign(valid)
+	require.NoError(t, err)
+	parts := strings.Split(sign(valid), ".")
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "malformed", token: "not-a-token"},
+		{name: "wrong key", token: otherKey},
+		{name: "tampered payload", token: parts[0] + "." + strings.Split(withClaims(func(c *Claims) { c.Role = "admin" }), ".")[1] + "." + parts[2]},
+		{name: "alg none", token: "eyJhbGciOiJub25lIn0." + parts[1] + "."},
+		{name: "expired", token: withClaims(func(c *Claims) { c.ExpiresAt = now.Add(-time.Minute).Unix() })},
+		{name: "not yet valid", token: withClaims(func(c *Claims) { c.NotBefore = now.Add(time.Minute).Unix() })},
+		{name: "wrong issuer", token: withClaims(func(c *Claims) { c.Issuer = "elsewhere" })},
+		{name: "unknown role", token: withClaims(func(c *Claims) { c.Role = "admin" })},
+		{name: "customer without customer_id", token: withClaims(func(c *Claims) { c.Role = "customer" })},
+		{name: "malformed tenant_id", token: withClaims(func(c *Claims) { c.TenantID = "Acme Corp" })},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := v.Verify(tt.token)
+
+			assert.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package breaker implements a consecutive-failure circuit breaker. Callers
+// ask Allow before each call to a dependency and report its outcome, so an
+// unhealthy dependency is skipped for a cooldown instead of being called,
+// and timing out, on every request.
+package breaker
+
+import (
+	"sync"
+	"time"
+)
+
+// State is the state of a Breaker.
+type State int
+
+// Breaker states. The values are what state gauges export.
+const (
+	// Closed lets every call through.
+	Closed State = iota
+	// Open rejects calls until the cooldown has passed.
+	Open
+	// HalfOpen lets a single trial call through to probe the dependency.
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half_open"
+	default:
+		return "closed"
+	}
+}
+
+// Config tunes a Breaker.
+type Config struct {
+	// FailureThreshold is how many consecutive failures open the breaker
+	FailureThreshold int
+	// Cooldown is how long the breaker stays open before a trial call
+	Cooldown time.Duration
+	// OnStateChange, if set, is called with each state entered, e.g. to
+	// update a metric. It runs with the breaker locked and must not call it.
+	OnStateChange func(State)
+	// Now defaults to time.Now
+	Now func() time.Time
+}
+
+// Breaker tracks consecutive failures of a dependency. After
+// FailureThreshold of them it opens and rejects calls. Once Cooldown has
+// passed one trial call is let through; success closes the breaker and
+// failure reopens it for another cooldown.
+type Breaker struct {
+	cfg Config
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+}
+
+// New creates a closed Breaker.
+func New(cfg Config) *Breaker {
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	return &Breaker{cfg: cfg}
+}
+
+// State returns the breaker's current state.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Allow reports whether a call may go ahead. An open breaker whose cooldown
+// has passed turns half-open and allows the caller to make the trial call.
+func (b *Breaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case Closed:
+		return true
+	case Open:
+		if b.cfg.Now().Sub(b.openedAt) >= b.cfg.Cooldown {
+			b.setState(HalfOpen)
+			return true
+		}
+	}
+	// Half-open: a trial call is already in flight
+	return false
+}
+
+// Success records a successful call. It reports whether this closed the breaker.
+func (b *Breaker) Success() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+	if b.state == Closed {
+		return false
+	}
+	b.setState(Closed)
+	return true
+}
+
+// Failure records a failed call. It reports whether this opened the breaker.
+func (b *Breaker) Failure() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if b.state == HalfOpen || (b.state == Closed && b.failures >= b.cfg.FailureThreshold) {
+		b.openedAt = b.cfg.Now()
+		b.setState(Open)
+		return true
+	}
+	return false
+}
+
+// Failures returns the current number of consecutive failures.
+func (b *Breaker) Failures() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.failures
+}
+
+// Abandon records a call whose outcome says nothing about the dependency,
+// such as one canceled by its caller. A trial call abandoned this way is
+// not counted, and the next call probes again.
+func (b *Breaker) Abandon() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == HalfOpen {
+		b.setState(Open)
+	}
+}
+
+func (b *Breaker) setState(s State) {
+	b.state = s
+	if b.cfg.OnStateChange != nil {
+		b.cfg.OnStateChange(s)
+	}
+}
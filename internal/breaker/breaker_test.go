@@ -0,0 +1,97 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package breaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestBreaker returns a breaker with threshold 2 and a 10s cooldown whose
+// clock the test controls, and the states it has entered
+func newTestBreaker(now *time.Time) (*Breaker, *[]State) {
+	var entered []State
+	b := New(Config{
+		FailureThreshold: 2,
+		Cooldown:         10 * time.Second,
+		OnStateChange:    func(s State) { entered = append(entered, s) },
+		Now:              func() time.Time { return *now },
+	})
+	return b, &entered
+}
+
+func TestBreaker_OpensAfterConsecutiveFailures(t *testing.T) {
+	now := time.Now()
+	b, entered := newTestBreaker(&now)
+
+	assert.False(t, b.Failure())
+	assert.True(t, b.Failure())
+
+	assert.Equal(t, Open, b.State())
+	assert.False(t, b.Allow())
+	assert.Equal(t, []State{Open}, *entered)
+}
+
+func TestBreaker_SuccessResetsFailures(t *testing.T) {
+	now := time.Now()
+	b, _ := newTestBreaker(&now)
+
+	b.Failure()
+	assert.False(t, b.Success(), "a closed breaker stays closed")
+	b.Failure()
+
+	assert.Equal(t, Closed, b.State())
+	assert.Equal(t, 1, b.Failures())
+}
+
+func TestBreaker_HalfOpen(t *testing.T) {
+	tests := []struct {
+		name      string
+		outcome   func(b *Breaker)
+		wantState State
+		wantAllow bool
+	}{
+		{"trial succeeds", func(b *Breaker) { b.Success() }, Closed, true},
+		{"trial fails", func(b *Breaker) { b.Failure() }, Open, false},
+		{"trial abandoned", func(b *Breaker) { b.Abandon() }, Open, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Now()
+			b, _ := newTestBreaker(&now)
+			b.Failure()
+			b.Failure()
+
+			now = now.Add(10 * time.Second)
+			assert.True(t, b.Allow(), "the first call after the cooldown is the trial")
+			assert.Equal(t, HalfOpen, b.State())
+			assert.False(t, b.Allow(), "only one trial call at a time")
+
+			tt.outcome(b)
+
+			assert.Equal(t, tt.wantState, b.State())
+			assert.Equal(t, tt.wantAllow, b.Allow())
+		})
+	}
+}
+
+func TestState_String(t *testing.T) {
+	assert.Equal(t, "closed", Closed.String())
+	assert.Equal(t, "open", Open.String())
+	assert.Equal(t, "half_open", HalfOpen.String())
+}
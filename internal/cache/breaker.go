@@ -0,0 +1,202 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/breaker"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// BreakerConfig tunes a CircuitBreaker.
+type BreakerConfig struct {
+	// FailureThreshold is how many consecutive errors open the breaker
+	FailureThreshold int
+	// Cooldown is how long an open breaker skips the cache before probing it
+	Cooldown time.Duration
+}
+
+// BreakerMetrics records circuit breaker state and skipped calls.
+type BreakerMetrics struct {
+	state       prometheus.Gauge
+	transitions *prometheus.CounterVec
+	skipped     *prometheus.CounterVec
+}
+
+// NewBreakerMetrics registers the order cache circuit breaker metrics with reg.
+func NewBreakerMetrics(reg prometheus.Registerer) *BreakerMetrics {
+	m := &BreakerMetrics{
+		state: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "order_cache_breaker_state",
+			Help: "State of the order cache circuit breaker: 0 closed, 1 open, 2 half-open.",
+		}),
+		transitions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "order_cache_breaker_transitions_total",
+			Help: "Order cache circuit breaker state changes by the state entered.",
+		}, []string{"state"}),
+		skipped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "order_cache_breaker_skipped_total",
+			Help: "Order cache calls skipped while the circuit breaker was open, by operation.",
+		}, []string{"operation"}),
+	}
+	reg.MustRegister(m.state, m.transitions, m.skipped)
+	return m
+}
+
+func (m *BreakerMetrics) setState(s breaker.State) {
+	if m == nil {
+		return
+	}
+	m.state.Set(float64(s))
+	m.transitions.WithLabelValues(s.String()).Inc()
+}
+
+func (m *BreakerMetrics) skip(op string) {
+	if m == nil {
+		return
+	}
+	m.skipped.WithLabelValues(op).Inc()
+}
+
+// CircuitBreaker is an OrderCache that stops calling an unhealthy cache.
+// After FailureThreshold consecutive errors it opens. While open, reads
+// report a miss and writes and deletes succeed without touching the cache,
+// so callers fall through to the database without an error per request.
+// Once the cooldown has passed a single call probes the cache, closing the
+// breaker on success and reopening it on failure.
+//
+// Deletes skipped while open are not replayed, so an entry written before
+// the outage may be served until its TTL expires.
+type CircuitBreaker struct {
+	next     OrderCache
+	cooldown time.Duration
+	metrics  *BreakerMetrics
+	breaker  *breaker.Breaker
+	now      func() time.Time
+}
+
+// NewCircuitBreaker wraps next in a circuit breaker. metrics may be nil.
+func NewCircuitBreaker(next OrderCache, cfg BreakerConfig, metrics *BreakerMetrics) *CircuitBreaker {
+	b := &CircuitBreaker{
+		next:     next,
+		cooldown: cfg.Cooldown,
+		metrics:  metrics,
+		now:      time.Now,
+	}
+	b.breaker = breaker.New(breaker.Config{
+		FailureThreshold: cfg.FailureThreshold,
+		Cooldown:         cfg.Cooldown,
+		OnStateChange:    metrics.setState,
+		Now:              func() time.Time { return b.now() },
+	})
+	return b
+}
+
+// State returns the breaker's current state.
+func (b *CircuitBreaker) State() breaker.State {
+	return b.breaker.State()
+}
+
+// Get returns a miss without calling the cache while the breaker is open.
+func (b *CircuitBreaker) Get(ctx context.Context, tenantID, id string) (*domain.Order, error) {
+	if !b.allow("get") {
+		return nil, nil
+	}
+	order, err := b.next.Get(ctx, tenantID, id)
+	b.record(ctx, err)
+	return order, err
+}
+
+// Set is skipped while the breaker is open.
+func (b *CircuitBreaker) Set(ctx context.Context, order *domain.Order, ttl time.Duration) error {
+	if !b.allow("set") {
+		return nil
+	}
+	err := b.next.Set(ctx, order, ttl)
+	b.record(ctx, err)
+	return err
+}
+
+// Delete is skipped while the breaker is open.
+func (b *CircuitBreaker) Delete(ctx context.Context, tenantID, id string) error {
+	if !b.allow("delete") {
+		return nil
+	}
+	err := b.next.Delete(ctx, tenantID, id)
+	b.record(ctx, err)
+	return err
+}
+
+// DeletePattern is skipped while the breaker is open.
+func (b *CircuitBreaker) DeletePattern(ctx context.Context, pattern string) error {
+	if !b.allow("delete_pattern") {
+		return nil
+	}
+	err := b.next.DeletePattern(ctx, pattern)
+	b.record(ctx, err)
+	return err
+}
+
+// GetList returns a miss without calling the cache while the breaker is open.
+func (b *CircuitBreaker) GetList(ctx context.Context, key string) (*domain.PaginatedOrders, error) {
+	if !b.allow("get_list") {
+		return nil, nil
+	}
+	page, err := b.next.GetList(ctx, key)
+	b.record(ctx, err)
+	return page, err
+}
+
+// SetList is skipped while the breaker is open.
+func (b *CircuitBreaker) SetList(ctx context.Context, key string, page *domain.PaginatedOrders, ttl time.Duration) error {
+	if !b.allow("set_list") {
+		return nil
+	}
+	err := b.next.SetList(ctx, key, page, ttl)
+	b.record(ctx, err)
+	return err
+}
+
+func (b *CircuitBreaker) allow(op string) bool {
+	if b.breaker.Allow() {
+		return true
+	}
+	b.metrics.skip(op)
+	return false
+}
+
+// record reports a call's outcome to the breaker, logging state changes.
+// Errors caused by the caller's own context ending say nothing about the
+// cache and are not counted.
+func (b *CircuitBreaker) record(ctx context.Context, err error) {
+	switch {
+	case err == nil:
+		if b.breaker.Success() {
+			slog.InfoContext(ctx, "order cache recovered, circuit breaker closed")
+		}
+	case ctx.Err() != nil:
+		b.breaker.Abandon()
+	case b.breaker.Failure():
+		slog.WarnContext(ctx, "order cache failing, circuit breaker open",
+			slog.Duration("cooldown", b.cooldown),
+			slog.String("error", err.Error()),
+		)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/breaker"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+)
+
+var errCacheDown = errors.New("dial tcp: connection refused")
+
+// flakyCache counts calls that reach it and fails them while down is set
+type flakyCache struct {
+	mocks.OrderCacheMock
+	down  bool
+	calls int
+}
+
+func newFlakyCache() *flakyCache {
+	c := &flakyCache{}
+	fail := func() error {
+		c.calls++
+		if c.down {
+			return errCacheDown
+		}
+		return nil
+	}
+	c.GetFunc = func(context.Context, string, string) (*domain.Order, error) { return nil, fail() }
+	c.SetFunc = func(context.Context, *domain.Order, time.Duration) error { return fail() }
+	c.DeleteFunc = func(context.Context, string, string) error { return fail() }
+	c.DeletePatternFunc = func(context.Context, string) error { return fail() }
+	return c
+}
+
+// newTestBreaker returns a breaker over next whose clock the test controls
+func newTestBreaker(next OrderCache, now *time.Time) (*CircuitBreaker, *BreakerMetrics) {
+	metrics := NewBreakerMetrics(prometheus.NewRegistry())
+	b := NewCircuitBreaker(next, BreakerConfig{FailureThreshold: 3, Cooldown: 30 * time.Second}, metrics)
+	b.now = func() time.Time { return *now }
+	return b, metrics
+}
+
+func TestCircuitBreaker_OpensAfterThreshold_SkipsCache(t *testing.T) {
+	next := newFlakyCache()
+	next.down = true
+	now := time.Now()
+	b, metrics := newTestBreaker(next, &now)
+	ctx := context.Background()
+
+	for range 3 {
+		_, err := b.Get(ctx, "", "o-1")
+		require.ErrorIs(t, err, errCacheDown)
+	}
+	assert.Equal(t, breaker.Open, b.State())
+
+	order, err := b.Get(ctx, "", "o-1")
+	require.NoError(t, err, "an open breaker reports a miss")
+	assert.Nil(t, order)
+	assert.NoError(t, b.Set(ctx, &domain.Order{}, time.Minute))
+	assert.NoError(t, b.Delete(ctx, "", "o-1"))
+	assert.NoError(t, b.DeletePattern(ctx, "order:*"))
+
+	assert.Equal(t, 3, next.calls, "no calls reach the cache while open")
+	assert.Equal(t, float64(breaker.Open), testutil.ToFloat64(metrics.state))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.skipped.WithLabelValues("get")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.skipped.WithLabelValues("delete")))
+}
+
+func TestCircuitBreaker_SuccessResetsFailureCount(t *testing.T) {
+	next := newFlakyCache()
+	now := time.Now()
+	b, _ := newTestBreaker(next, &now)
+	ctx := context.Background()
+
+	next.down = true
+	_, _ = b.Get(ctx, "", "o-1")
+	_, _ = b.Get(ctx, "", "o-1")
+	next.down = false
+	_, _ = b.Get(ctx, "", "o-1")
+	next.down = true
+	_, _ = b.Get(ctx, "", "o-1")
+	_, _ = b.Get(ctx, "", "o-1")
+
+	assert.Equal(t, breaker.Closed, b.State())
+}
+
+func TestCircuitBreaker_AfterCooldown_ProbeSucceeds_Closes(t *testing.T) {
+	next := newFlakyCache()
+	next.down = true
+	now := time.Now()
+	b, metrics := newTestBreaker(next, &now)
+	ctx := context.Background()
+	for range 3 {
+		_, _ = b.Get(ctx, "", "o-1")
+	}
+
+	now = now.Add(30 * time.Second)
+	next.down = false
+	_, err := b.Get(ctx, "", "o-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, breaker.Closed, b.State())
+	assert.Equal(t, 4, next.calls)
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.transitions.WithLabelValues("half_open")))
+	assert.Equal(t, float64(breaker.Closed), testutil.ToFloat64(metrics.state))
+}
+
+func TestCircuitBreaker_AfterCooldown_ProbeFails_Reopens(t *testing.T) {
+	next := newFlakyCache()
+	next.down = true
+	now := time.Now()
+	b, _ := newTestBreaker(next, &now)
+	ctx := context.Background()
+	for range 3 {
+		_, _ = b.Get(ctx, "", "o-1")
+	}
+
+	now = now.Add(30 * time.Second)
+	err := b.Set(ctx, &domain.Order{}, time.Minute)
+
+	require.ErrorIs(t, err, errCacheDown)
+	assert.Equal(t, breaker.Open, b.State())
+
+	now = now.Add(10 * time.Second)
+	_, err = b.Get(ctx, "", "o-1")
+	require.NoError(t, err, "the cooldown starts again from the failed probe")
+	assert.Equal(t, 4, next.calls)
+}
+
+func TestCircuitBreaker_CanceledContext_NotCounted(t *testing.T) {
+	next := newFlakyCache()
+	next.down = true
+	now := time.Now()
+	b, _ := newTestBreaker(next, &now)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for range 5 {
+		_, _ = b.Get(ctx, "", "o-1")
+	}
+
+	assert.Equal(t, breaker.Closed, b.State())
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// HitCounter is an OrderCache that counts hits and misses of order and list
+// lookups. Lookups that fail are not counted.
+type HitCounter struct {
+	OrderCache
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+// NewHitCounter wraps next, counting its lookups.
+func NewHitCounter(next OrderCache) *HitCounter {
+	return &HitCounter{OrderCache: next}
+}
+
+// Get counts a hit or a miss.
+func (c *HitCounter) Get(ctx context.Context, tenantID, id string) (*domain.Order, error) {
+	order, err := c.OrderCache.Get(ctx, tenantID, id)
+	if err == nil {
+		c.count(order != nil)
+	}
+	return order, err
+}
+
+// GetList counts a hit or a miss.
+func (c *HitCounter) GetList(ctx context.Context, key string) (*domain.PaginatedOrders, error) {
+	page, err := c.OrderCache.GetList(ctx, key)
+	if err == nil {
+		c.count(page != nil)
+	}
+	return page, err
+}
+
+func (c *HitCounter) count(hit bool) {
+	if hit {
+		c.hits.Add(1)
+	} else {
+		c.misses.Add(1)
+	}
+}
+
+// Stats returns the lookups counted so far.
+func (c *HitCounter) Stats() domain.CacheStats {
+	return domain.CacheStats{Hits: c.hits.Load(), Misses: c.misses.Load()}
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+)
+
+func TestHitCounter_CountsHitsAndMisses(t *testing.T) {
+	cached := &domain.Order{}
+	counter := NewHitCounter(&mocks.OrderCacheMock{
+		GetFunc: func(_ context.Context, _, id string) (*domain.Order, error) {
+			switch id {
+			case "cached":
+				return cached, nil
+			case "broken":
+				return nil, errCacheDown
+			}
+			return nil, nil
+		},
+		GetListFunc: func(_ context.Context, key string) (*domain.PaginatedOrders, error) {
+			if key == "cached" {
+				return &domain.PaginatedOrders{}, nil
+			}
+			return nil, nil
+		},
+	})
+	ctx := context.Background()
+
+	_, _ = counter.Get(ctx, "", "cached")
+	_, _ = counter.Get(ctx, "", "cached")
+	_, _ = counter.Get(ctx, "", "missing")
+	_, err := counter.Get(ctx, "", "broken")
+	_, _ = counter.GetList(ctx, "cached")
+	_, _ = counter.GetList(ctx, "missing")
+
+	assert.ErrorIs(t, err, errCacheDown)
+	stats := counter.Stats()
+	assert.Equal(t, domain.CacheStats{Hits: 3, Misses: 2}, stats, "failed lookups are not counted")
+	rate, ok := stats.HitRate()
+	assert.True(t, ok)
+	assert.InDelta(t, 0.6, rate, 1e-9)
+}
+
+func TestCacheStats_HitRate_NoLookups(t *testing.T) {
+	_, ok := domain.CacheStats{}.HitRate()
+
+	assert.False(t, ok)
+}
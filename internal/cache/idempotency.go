@@ -0,0 +1,44 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// IdempotencyRecord is the state of an idempotency key: reserved by an
+// in-flight request, or holding the response that request produced.
+type IdempotencyRecord struct {
+	// Fingerprint identifies the request the key was first used with
+	Fingerprint string              `json:"fingerprint"`
+	Pending     bool                `json:"pending,omitempty"`
+	StatusCode  int                 `json:"status_code,omitempty"`
+	Header      map[string][]string `json:"header,omitempty"`
+	Body        []byte              `json:"body,omitempty"`
+}
+
+// IdempotencyStore records responses to requests sent with an Idempotency-Key
+type IdempotencyStore interface {
+	// Reserve claims key for a request with the given fingerprint for ttl.
+	// It returns nil if the key was free, otherwise the existing record.
+	Reserve(ctx context.Context, key, fingerprint string, ttl time.Duration) (*IdempotencyRecord, error)
+
+	// Complete stores the response for a reserved key, keeping it for ttl
+	Complete(ctx context.Context, key string, record *IdempotencyRecord, ttl time.Duration) error
+
+	// Release drops a reservation so the request can be retried
+	Release(ctx context.Context, key string) error
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package memory implements the order cache in memory, for running the
+// service in tests without Redis.
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/cache"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// entry is a cached value and when it expires; a zero expiry never does
+type entry struct {
+	data    []byte
+	expires time.Time
+}
+
+// orderCacheMemory implements OrderCache with a map of JSON values, encoded
+// as the Redis cache encodes them so reads return the same copies
+type orderCacheMemory struct {
+	mu      sync.Mutex
+	entries map[string]entry
+}
+
+// NewOrderCache creates an empty in-memory order cache
+func NewOrderCache() cache.OrderCache {
+	return &orderCacheMemory{
+		entries: make(map[string]entry),
+	}
+}
+
+func (c *orderCacheMemory) Get(ctx context.Context, tenantID, id string) (*domain.Order, error) {
+	key := cache.OrderKey(tenantID, id)
+	var order domain.Order
+	ok, err := c.get(key, &order)
+	if !ok || err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (c *orderCacheMemory) Set(ctx context.Context, order *domain.Order, ttl time.Duration) error {
+	return c.set(cache.OrderKey(order.TenantID, order.ID.String()), order, ttl)
+}
+
+func (c *orderCacheMemory) Delete(ctx context.Context, tenantID, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, cache.OrderKey(tenantID, id))
+	return nil
+}
+
+func (c *orderCacheMemory) DeletePattern(ctx context.Context, pattern string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.entries {
+		matched, err := path.Match(pattern, key)
+		if err != nil {
+			return fmt.Errorf("cache del pattern %s: %w", pattern, err)
+		}
+		if matched {
+			delete(c.entries, key)
+		}
+	}
+	return nil
+}
+
+func (c *orderCacheMemory) GetList(ctx context.Context, key string) (*domain.PaginatedOrders, error) {
+	var page domain.PaginatedOrders
+	ok, err := c.get(key, &page)
+	if !ok || err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+func (c *orderCacheMemory) SetList(ctx context.Context, key string, page *domain.PaginatedOrders, ttl time.Duration) error {
+	return c.set(key, page, ttl)
+}
+
+// get decodes the value under key into v, reporting false on a miss
+func (c *orderCacheMemory) get(key string, v any) (bool, error) {
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	if ok && !e.expires.IsZero() && !time.Now().Before(e.expires) {
+		delete(c.entries, key)
+		ok = false
+	}
+	c.mu.Unlock()
+
+	if !ok {
+		return false, nil
+	}
+	if err := json.Unmarshal(e.data, v); err != nil {
+		return false, fmt.Errorf("cache unmarshal %s: %w", key, err)
+	}
+	return true, nil
+}
+
+// set stores v under key for ttl, or without expiry if ttl is not positive
+func (c *orderCacheMemory) set(key string, v any, ttl time.Duration) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("cache marshal %s: %w", key, err)
+	}
+
+	e := entry{data: data}
+	if ttl > 0 {
+		e.expires = time.Now().Add(ttl)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = e
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/cache"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+func TestOrderCache_SetGetDelete(t *testing.T) {
+	c := NewOrderCache()
+	ctx := context.Background()
+	order := &domain.Order{ID: uuid.New(), TenantID: "acme", CustomerID: "cust-1", Status: domain.OrderStatusPending, Version: 1}
+
+	require.NoError(t, c.Set(ctx, order, time.Minute))
+	got, err := c.Get(ctx, "acme", order.ID.String())
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, order.CustomerID, got.CustomerID)
+
+	miss, err := c.Get(ctx, "globex", order.ID.String())
+	require.NoError(t, err)
+	assert.Nil(t, miss)
+
+	require.NoError(t, c.Delete(ctx, "acme", order.ID.String()))
+	got, err = c.Get(ctx, "acme", order.ID.String())
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestOrderCache_Expires(t *testing.T) {
+	c := NewOrderCache()
+	ctx := context.Background()
+	order := &domain.Order{ID: uuid.New(), CustomerID: "cust-1"}
+
+	require.NoError(t, c.Set(ctx, order, time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+
+	got, err := c.Get(ctx, "", order.ID.String())
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestOrderCache_DeletePattern(t *testing.T) {
+	c := NewOrderCache()
+	ctx := context.Background()
+	page := &domain.PaginatedOrders{TotalCount: 1}
+	first := cache.CustomerListKey("", "cust-1", nil, nil, nil, 1, 20)
+	second := cache.CustomerListKey("", "cust-1", nil, nil, nil, 2, 20)
+	other := cache.CustomerListKey("", "cust-2", nil, nil, nil, 1, 20)
+	for _, key := range []string{first, second, other} {
+		require.NoError(t, c.SetList(ctx, key, page, time.Minute))
+	}
+
+	require.NoError(t, c.DeletePattern(ctx, cache.CustomerListPattern("", "cust-1")))
+
+	for key, want := range map[string]bool{first: false, second: false, other: true} {
+		got, err := c.GetList(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, want, got != nil, key)
+	}
+}
@@ -17,6 +17,10 @@ package cache
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
@@ -24,17 +28,69 @@ import (
 
 // OrderCache defines caching operations for orders
 type OrderCache interface {
-	// Get retrieves an order from cache
-	Get(ctx context.Context, id string) (*domain.Order, error)
+	// Get retrieves an order of the tenant from cache
+	Get(ctx context.Context, tenantID, id string) (*domain.Order, error)
 
-	// Set stores an order in cache with TTL
+	// Set stores an order in cache with TTL, keyed by its tenant
 	Set(ctx context.Context, order *domain.Order, ttl time.Duration) error
 
-	// Delete removes an order from cache
-	Delete(ctx context.Context, id string) error
+	// Delete removes an order of the tenant from cache
+	Delete(ctx context.Context, tenantID, id string) error
 
 	// DeletePattern removes all keys matching pattern (e.g., "order:customer:123:*")
 	DeletePattern(ctx context.Context, pattern string) error
+
+	// GetList retrieves a page of orders stored under key, or nil on a miss
+	GetList(ctx context.Context, key string) (*domain.PaginatedOrders, error)
+
+	// SetList stores a page of orders under key with TTL
+	SetList(ctx context.Context, key string, page *domain.PaginatedOrders, ttl time.Duration) error
+}
+
+// OrderKey is the cache key of an order. Orders of a tenant are keyed under
+// it, so one tenant can never be served another's cached order.
+func OrderKey(tenantID, id string) string {
+	return tenantKeyPrefix(tenantID) + id
+}
+
+// CustomerListKey is the cache key of one page of a tenant customer's orders
+// with the given filters. All of the customer's pages match
+// CustomerListPattern. Tag order does not matter.
+func CustomerListKey(tenantID, customerID string, status *domain.OrderStatus, productID *string, tags []string, page, pageSize int) string {
+	var s, p string
+	if status != nil {
+		s = string(*status)
+	}
+	if productID != nil {
+		p = url.QueryEscape(*productID)
+	}
+	t := make([]string, len(tags))
+	for i, tag := range tags {
+		t[i] = url.QueryEscape(tag)
+	}
+	sort.Strings(t)
+	return fmt.Sprintf("%slist:%s:%s:%s:%d:%d", customerKeyPrefix(tenantID, customerID), s, p, strings.Join(t, ","), page, pageSize)
+}
+
+// CustomerListPattern matches every cached list page of the tenant's
+// customer, for DeletePattern after any change to one of their orders.
+func CustomerListPattern(tenantID, customerID string) string {
+	return customerKeyPrefix(tenantID, customerID) + "*"
+}
+
+// customerKeyPrefix escapes the customer ID so it cannot contain the key
+// separator or glob characters.
+func customerKeyPrefix(tenantID, customerID string) string {
+	return tenantKeyPrefix(tenantID) + "customer:" + url.QueryEscape(customerID) + ":"
+}
+
+// tenantKeyPrefix starts every key of the tenant. Keys without a tenant keep
+// the layout they had before tenancy, so enabling it needs no cache flush.
+func tenantKeyPrefix(tenantID string) string {
+	if tenantID == "" {
+		return "order:"
+	}
+	return "order:tenant:" + url.QueryEscape(tenantID) + ":"
 }
 
 // RateLimiter defines rate limiting operations
@@ -44,4 +100,8 @@ type RateLimiter interface {
 
 	// Reset clears rate limit counter for a key
 	Reset(ctx context.Context, key string) error
+
+	// Count returns how many requests of key the last window holds, without
+	// counting a new one
+	Count(ctx context.Context, key string, window time.Duration) (int, error)
 }
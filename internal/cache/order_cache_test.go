@@ -0,0 +1,95 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+func TestCustomerListKey_DistinctPerFilterAndPage(t *testing.T) {
+	pending := domain.OrderStatusPending
+	product := "sku-1"
+
+	keys := []string{
+		CustomerListKey("", "c-1", nil, nil, nil, 1, 20),
+		CustomerListKey("", "c-1", nil, nil, nil, 2, 20),
+		CustomerListKey("", "c-1", nil, nil, nil, 1, 50),
+		CustomerListKey("", "c-1", &pending, nil, nil, 1, 20),
+		CustomerListKey("", "c-1", nil, &product, nil, 1, 20),
+		CustomerListKey("", "c-2", nil, nil, nil, 1, 20),
+		CustomerListKey("", "c-1", nil, nil, []string{"vip"}, 1, 20),
+		CustomerListKey("", "c-1", nil, nil, []string{"vip", "gift"}, 1, 20),
+	}
+
+	seen := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		assert.False(t, seen[k], "duplicate key %q", k)
+		seen[k] = true
+	}
+}
+
+func TestCustomerListKey_TagOrderIgnored(t *testing.T) {
+	assert.Equal(t,
+		CustomerListKey("", "c-1", nil, nil, []string{"vip", "gift"}, 1, 20),
+		CustomerListKey("", "c-1", nil, nil, []string{"gift", "vip"}, 1, 20))
+}
+
+func TestCustomerListPattern_MatchesOnlyThatCustomer(t *testing.T) {
+	tests := []struct {
+		name       string
+		customerID string
+		key        string
+		match      bool
+	}{
+		{name: "own page", customerID: "c-1", key: CustomerListKey("", "c-1", nil, nil, nil, 3, 20), match: true},
+		{name: "other customer", customerID: "c-1", key: CustomerListKey("", "c-10", nil, nil, nil, 1, 20), match: false},
+		{name: "glob characters escaped", customerID: "c*", key: CustomerListKey("", "c-1", nil, nil, nil, 1, 20), match: false},
+		{name: "separator escaped", customerID: "c", key: CustomerListKey("", "c:x", nil, nil, nil, 1, 20), match: false},
+		{name: "same customer in a tenant", customerID: "c-1", key: CustomerListKey("acme", "c-1", nil, nil, nil, 1, 20), match: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// path.Match follows the same glob rules as Redis SCAN MATCH for these keys
+			matched, err := path.Match(CustomerListPattern("", tt.customerID), tt.key)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.match, matched)
+		})
+	}
+}
+
+func TestOrderKey_ScopedByTenant(t *testing.T) {
+	assert.Equal(t, "order:o-1", OrderKey("", "o-1"))
+	assert.Equal(t, "order:tenant:acme:o-1", OrderKey("acme", "o-1"))
+	assert.NotEqual(t, OrderKey("acme", "o-1"), OrderKey("globex", "o-1"))
+}
+
+func TestCustomerListPattern_MatchesOnlyThatTenant(t *testing.T) {
+	pattern := CustomerListPattern("acme", "c-1")
+
+	own, err := path.Match(pattern, CustomerListKey("acme", "c-1", nil, nil, nil, 1, 20))
+	assert.NoError(t, err)
+	assert.True(t, own)
+
+	other, err := path.Match(pattern, CustomerListKey("globex", "c-1", nil, nil, nil, 1, 20))
+	assert.NoError(t, err)
+	assert.False(t, other)
+}
@@ -29,22 +29,47 @@ type Config struct {
 	Port     int
 	Password string `json:"-"` // #nosec G117 -- config field, not serialized
 	DB       int
+	// MaxRetries, PoolSize and PoolTimeout use the go-redis defaults when zero
+	MaxRetries  int
+	PoolSize    int
+	PoolTimeout time.Duration
+	// PasswordFunc, if set, supplies the password for each new connection in
+	// place of Password, so a rotated password is used without a restart
+	PasswordFunc func(ctx context.Context) (string, error)
 }
 
-// NewClient creates a new Redis client
+// NewClient creates a new Redis client and checks that Redis is reachable
 func NewClient(cfg Config) (*redis.Client, error) {
-	client := redis.NewClient(&redis.Options{
-		Addr:     fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
-		Password: cfg.Password,
-		DB:       cfg.DB,
-	})
+	client := Open(cfg)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
 		return nil, fmt.Errorf("failed to ping redis: %w", err)
 	}
 
 	return client, nil
 }
+
+// Open creates a Redis client without connecting. Connections are made on
+// first use, so the client works once Redis becomes reachable.
+func Open(cfg Config) *redis.Client {
+	opts := &redis.Options{
+		Addr:     fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
+		Password: cfg.Password,
+		DB:       cfg.DB,
+
+		MaxRetries:  cfg.MaxRetries,
+		PoolSize:    cfg.PoolSize,
+		PoolTimeout: cfg.PoolTimeout,
+	}
+	if cfg.PasswordFunc != nil {
+		opts.CredentialsProviderContext = func(ctx context.Context) (string, string, error) {
+			password, err := cfg.PasswordFunc(ctx)
+			return "", password, err
+		}
+	}
+	return redis.NewClient(opts)
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/cache"
+)
+
+// idempotencyStoreRedis implements IdempotencyStore using Redis
+type idempotencyStoreRedis struct {
+	client *redis.Client
+}
+
+// NewIdempotencyStore creates a new Redis idempotency store
+func NewIdempotencyStore(client *redis.Client) cache.IdempotencyStore {
+	return &idempotencyStoreRedis{
+		client: client,
+	}
+}
+
+func (s *idempotencyStoreRedis) Reserve(ctx context.Context, key, fingerprint string, ttl time.Duration) (*cache.IdempotencyRecord, error) {
+	redisKey := idempotencyKey(key)
+	pending, err := json.Marshal(cache.IdempotencyRecord{Fingerprint: fingerprint, Pending: true})
+	if err != nil {
+		return nil, err
+	}
+
+	// The existing record can expire between SETNX and GET; try once more then.
+	for attempt := 0; attempt < 2; attempt++ {
+		reserved, err := s.client.SetNX(ctx, redisKey, pending, ttl).Result()
+		if err != nil {
+			return nil, fmt.Errorf("idempotency reserve %s: %w", redisKey, err)
+		}
+		if reserved {
+			return nil, nil
+		}
+
+		data, err := s.client.Get(ctx, redisKey).Bytes()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("idempotency get %s: %w", redisKey, err)
+		}
+
+		var record cache.IdempotencyRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			return nil, fmt.Errorf("idempotency unmarshal %s: %w", redisKey, err)
+		}
+		return &record, nil
+	}
+	return nil, fmt.Errorf("idempotency reserve %s: key changed concurrently", redisKey)
+}
+
+func (s *idempotencyStoreRedis) Complete(ctx context.Context, key string, record *cache.IdempotencyRecord, ttl time.Duration) error {
+	redisKey := idempotencyKey(key)
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if err := s.client.Set(ctx, redisKey, data, ttl).Err(); err != nil {
+		return fmt.Errorf("idempotency complete %s: %w", redisKey, err)
+	}
+	return nil
+}
+
+func (s *idempotencyStoreRedis) Release(ctx context.Context, key string) error {
+	redisKey := idempotencyKey(key)
+	if err := s.client.Del(ctx, redisKey).Err(); err != nil {
+		return fmt.Errorf("idempotency release %s: %w", redisKey, err)
+	}
+	return nil
+}
+
+func idempotencyKey(key string) string {
+	return "idempotency:" + key
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyStore_ReserveCompleteReplay(t *testing.T) {
+	_, client := setupMiniredis(t)
+	store := NewIdempotencyStore(client)
+	ctx := context.Background()
+
+	existing, err := store.Reserve(ctx, "key-1", "fp-1", time.Minute)
+	require.NoError(t, err)
+	assert.Nil(t, existing, "first reservation claims the key")
+
+	existing, err = store.Reserve(ctx, "key-1", "fp-1", time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.True(t, existing.Pending)
+
+	record := &cache.IdempotencyRecord{Fingerprint: "fp-1", StatusCode: 201, Body: []byte(`{"id":"x"}`)}
+	require.NoError(t, store.Complete(ctx, "key-1", record, time.Hour))
+
+	existing, err = store.Reserve(ctx, "key-1", "fp-1", time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.False(t, existing.Pending)
+	assert.Equal(t, 201, existing.StatusCode)
+	assert.Equal(t, `{"id":"x"}`, string(existing.Body))
+}
+
+func TestIdempotencyStore_Release_FreesKey(t *testing.T) {
+	mr, client := setupMiniredis(t)
+	store := NewIdempotencyStore(client)
+	ctx := context.Background()
+
+	_, err := store.Reserve(ctx, "key-1", "fp-1", time.Minute)
+	require.NoError(t, err)
+	require.NoError(t, store.Release(ctx, "key-1"))
+	assert.False(t, mr.Exists("idempotency:key-1"))
+
+	existing, err := store.Reserve(ctx, "key-1", "fp-1", time.Minute)
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+}
@@ -0,0 +1,334 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// Jobs are hashes under jobKey. jobsDueKey scores queued and running jobs by
+// RunAt (ms) for Claim; jobsIndexKey scores every job by creation (ms) for
+// List and PurgeFinished; jobUniqueKey holds the ID of the pending job with
+// that UniqueKey.
+const (
+	jobKeyPrefix       = "job:"
+	jobsDueKey         = "jobs:due"
+	jobsIndexKey       = "jobs:index"
+	jobUniqueKeyPrefix = "jobs:unique:"
+)
+
+// enqueueJobScript adds a job unless its unique key is held.
+// KEYS = job, due, index, unique key; ARGV = id, run_at (ms), created_at
+// (ms), "1" if the job has a unique key, then the hash fields and values.
+var enqueueJobScript = redis.NewScript(`
+if ARGV[4] == '1' and not redis.call('SET', KEYS[4], ARGV[1], 'NX') then
+	return 0
+end
+redis.call('HSET', KEYS[1], unpack(ARGV, 5))
+redis.call('ZADD', KEYS[2], ARGV[2], ARGV[1])
+redis.call('ZADD', KEYS[3], ARGV[3], ARGV[1])
+return 1
+`)
+
+// claimJobScript leases the earliest due job and returns its ID, or nil.
+// KEYS = due; ARGV = now (ms), lease until (ms), job key prefix.
+var claimJobScript = redis.NewScript(`
+local ids = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, 1)
+if #ids == 0 then
+	return false
+end
+local key = ARGV[3] .. ids[1]
+redis.call('ZADD', KEYS[1], ARGV[2], ids[1])
+redis.call('HSET', key, 'status', 'running', 'run_at', ARGV[2], 'updated_at', ARGV[1])
+redis.call('HINCRBY', key, 'attempts', 1)
+return ids[1]
+`)
+
+// finishJobScript writes the outcome of the claim identified by its attempt
+// count, releasing the unique key once the job is finished.
+// KEYS = job, due; ARGV = id, attempts, status, run_at, last_error,
+// updated_at, finished_at, unique key prefix.
+var finishJobScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'status') ~= 'running' or redis.call('HGET', KEYS[1], 'attempts') ~= ARGV[2] then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'status', ARGV[3], 'run_at', ARGV[4], 'last_error', ARGV[5], 'updated_at', ARGV[6], 'finished_at', ARGV[7])
+if ARGV[3] == 'queued' then
+	redis.call('ZADD', KEYS[2], ARGV[4], ARGV[1])
+	return 1
+end
+redis.call('ZREM', KEYS[2], ARGV[1])
+local unique = redis.call('HGET', KEYS[1], 'unique_key')
+if unique and unique ~= '' and redis.call('GET', ARGV[8] .. unique) == ARGV[1] then
+	redis.call('DEL', ARGV[8] .. unique)
+end
+return 1
+`)
+
+// jobQueueRedis implements JobQueue using Redis
+type jobQueueRedis struct {
+	client *redis.Client
+}
+
+// NewJobQueue creates a new Redis job queue. Jobs are kept until
+// PurgeFinished removes them, so Redis should not evict keys.
+func NewJobQueue(client *redis.Client) repository.JobQueue {
+	return &jobQueueRedis{
+		client: client,
+	}
+}
+
+func (q *jobQueueRedis) Enqueue(ctx context.Context, job *domain.Job) (bool, error) {
+	id := job.ID.String()
+	unique := "0"
+	if job.UniqueKey != "" {
+		unique = "1"
+	}
+	args := []any{id, job.RunAt.UnixMilli(), job.CreatedAt.UnixMilli(), unique}
+	args = append(args,
+		"kind", string(job.Kind),
+		"payload", string(job.Payload),
+		"unique_key", job.UniqueKey,
+		"status", string(job.Status),
+		"attempts", job.Attempts,
+		"max_attempts", job.MaxAttempts,
+		"run_at", job.RunAt.UnixMilli(),
+		"last_error", job.LastError,
+		"created_at", job.CreatedAt.UnixMilli(),
+		"updated_at", job.UpdatedAt.UnixMilli(),
+		"finished_at", millis(job.FinishedAt),
+	)
+
+	added, err := enqueueJobScript.Run(ctx, q.client,
+		[]string{jobKey(id), jobsDueKey, jobsIndexKey, jobUniqueKeyPrefix + job.UniqueKey}, args...).Int()
+	if err != nil {
+		return false, fmt.Errorf("job enqueue %s: %w", id, err)
+	}
+	return added == 1, nil
+}
+
+func (q *jobQueueRedis) Claim(ctx context.Context, now, leaseUntil time.Time) (*domain.Job, error) {
+	id, err := claimJobScript.Run(ctx, q.client, []string{jobsDueKey},
+		now.UnixMilli(), leaseUntil.UnixMilli(), jobKeyPrefix).Text()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("job claim: %w", err)
+	}
+	return q.FindByID(ctx, id)
+}
+
+func (q *jobQueueRedis) Finish(ctx context.Context, job *domain.Job) error {
+	id := job.ID.String()
+	written, err := finishJobScript.Run(ctx, q.client, []string{jobKey(id), jobsDueKey},
+		id,
+		job.Attempts,
+		string(job.Status),
+		job.RunAt.UnixMilli(),
+		job.LastError,
+		job.UpdatedAt.UnixMilli(),
+		millis(job.FinishedAt),
+		jobUniqueKeyPrefix,
+	).Int()
+	if err != nil {
+		return fmt.Errorf("job finish %s: %w", id, err)
+	}
+	if written == 0 {
+		return domain.ErrJobLeaseLost
+	}
+	return nil
+}
+
+func (q *jobQueueRedis) FindByID(ctx context.Context, id string) (*domain.Job, error) {
+	fields, err := q.client.HGetAll(ctx, jobKey(id)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("job get %s: %w", id, err)
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return parseJob(id, fields)
+}
+
+func (q *jobQueueRedis) List(ctx context.Context, status domain.JobStatus, limit, offset int) ([]*domain.Job, int64, error) {
+	if status == "" {
+		total, err := q.client.ZCard(ctx, jobsIndexKey).Result()
+		if err != nil {
+			return nil, 0, fmt.Errorf("job list: %w", err)
+		}
+		ids, err := q.client.ZRevRange(ctx, jobsIndexKey, int64(offset), int64(offset+limit-1)).Result()
+		if err != nil {
+			return nil, 0, fmt.Errorf("job list: %w", err)
+		}
+		jobs, err := q.load(ctx, ids)
+		if err != nil {
+			return nil, 0, err
+		}
+		return jobs, total, nil
+	}
+
+	// There is no index by status: scan every job, which PurgeFinished bounds
+	ids, err := q.client.ZRevRange(ctx, jobsIndexKey, 0, -1).Result()
+	if err != nil {
+		return nil, 0, fmt.Errorf("job list: %w", err)
+	}
+	all, err := q.load(ctx, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	jobs := []*domain.Job{}
+	var total int64
+	for _, job := range all {
+		if job.Status != status {
+			continue
+		}
+		if total >= int64(offset) && len(jobs) < limit {
+			jobs = append(jobs, job)
+		}
+		total++
+	}
+	return jobs, total, nil
+}
+
+func (q *jobQueueRedis) PurgeFinished(ctx context.Context, before time.Time) (int64, error) {
+	ids, err := q.client.ZRange(ctx, jobsIndexKey, 0, -1).Result()
+	if err != nil {
+		return 0, fmt.Errorf("job purge: %w", err)
+	}
+
+	pipe := q.client.Pipeline()
+	cmds := make([]*redis.SliceCmd, len(ids))
+	for i, id := range ids {
+		cmds[i] = pipe.HMGet(ctx, jobKey(id), "status", "finished_at")
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("job purge: %w", err)
+	}
+
+	var purged int64
+	cutoff := before.UnixMilli()
+	pipe = q.client.Pipeline()
+	for i, id := range ids {
+		values := cmds[i].Val()
+		status, _ := values[0].(string)
+		finished, _ := values[1].(string)
+		finishedAt, _ := strconv.ParseInt(finished, 10, 64)
+		missing := values[0] == nil
+		if !missing && (!domain.JobStatus(status).IsFinished() || finishedAt == 0 || finishedAt >= cutoff) {
+			continue
+		}
+		pipe.Del(ctx, jobKey(id))
+		pipe.ZRem(ctx, jobsIndexKey, id)
+		if !missing {
+			purged++
+		}
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("job purge: %w", err)
+	}
+	return purged, nil
+}
+
+// load fetches the jobs with the given IDs in order, skipping purged ones
+func (q *jobQueueRedis) load(ctx context.Context, ids []string) ([]*domain.Job, error) {
+	pipe := q.client.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, len(ids))
+	for i, id := range ids {
+		cmds[i] = pipe.HGetAll(ctx, jobKey(id))
+	}
+	if len(ids) > 0 {
+		if _, err := pipe.Exec(ctx); err != nil {
+			return nil, fmt.Errorf("job load: %w", err)
+		}
+	}
+
+	jobs := make([]*domain.Job, 0, len(ids))
+	for i, id := range ids {
+		fields := cmds[i].Val()
+		if len(fields) == 0 {
+			continue
+		}
+		job, err := parseJob(id, fields)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// parseJob builds a job from the fields of its hash
+func parseJob(id string, fields map[string]string) (*domain.Job, error) {
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("job %s: %w", id, err)
+	}
+
+	ints := map[string]int64{}
+	for _, name := range []string{"attempts", "max_attempts", "run_at", "created_at", "updated_at", "finished_at"} {
+		if fields[name] == "" {
+			continue
+		}
+		v, err := strconv.ParseInt(fields[name], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("job %s: field %s: %w", id, name, err)
+		}
+		ints[name] = v
+	}
+
+	job := &domain.Job{
+		ID:          parsedID,
+		Kind:        domain.JobKind(fields["kind"]),
+		UniqueKey:   fields["unique_key"],
+		Status:      domain.JobStatus(fields["status"]),
+		Attempts:    int(ints["attempts"]),
+		MaxAttempts: int(ints["max_attempts"]),
+		RunAt:       time.UnixMilli(ints["run_at"]),
+		LastError:   fields["last_error"],
+		CreatedAt:   time.UnixMilli(ints["created_at"]),
+		UpdatedAt:   time.UnixMilli(ints["updated_at"]),
+	}
+	if fields["payload"] != "" {
+		job.Payload = []byte(fields["payload"])
+	}
+	if ints["finished_at"] > 0 {
+		finishedAt := time.UnixMilli(ints["finished_at"])
+		job.FinishedAt = &finishedAt
+	}
+	return job, nil
+}
+
+// millis is t in Unix milliseconds, or 0 if t is nil
+func millis(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
+func jobKey(id string) string {
+	return jobKeyPrefix + id
+}
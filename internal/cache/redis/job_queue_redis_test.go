@@ -0,0 +1,149 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobQueue_EnqueueClaimFinish(t *testing.T) {
+	_, client := setupMiniredis(t)
+	queue := NewJobQueue(client)
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Millisecond)
+
+	job := domain.NewJob(domain.JobKindSLACheck, []byte(`{"batch":1}`), now, 3)
+	added, err := queue.Enqueue(ctx, job)
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	claimed, err := queue.Claim(ctx, now, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.NotNil(t, claimed)
+	assert.Equal(t, job.ID, claimed.ID)
+	assert.Equal(t, domain.JobStatusRunning, claimed.Status)
+	assert.Equal(t, 1, claimed.Attempts)
+	assert.Equal(t, `{"batch":1}`, string(claimed.Payload))
+
+	again, err := queue.Claim(ctx, now, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Nil(t, again, "a leased job is not claimed twice")
+
+	claimed.Succeed(now)
+	require.NoError(t, queue.Finish(ctx, claimed))
+
+	stored, err := queue.FindByID(ctx, job.ID.String())
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, domain.JobStatusSucceeded, stored.Status)
+	require.NotNil(t, stored.FinishedAt)
+	assert.True(t, stored.FinishedAt.Equal(now))
+}
+
+func TestJobQueue_Enqueue_UniqueKeyHeldUntilFinished(t *testing.T) {
+	_, client := setupMiniredis(t)
+	queue := NewJobQueue(client)
+	ctx := context.Background()
+	now := time.Now()
+
+	first := domain.NewJob(domain.JobKindHoldRelease, nil, now, 1)
+	first.UniqueKey = "hold_release"
+	added, err := queue.Enqueue(ctx, first)
+	require.NoError(t, err)
+	require.True(t, added)
+
+	second := domain.NewJob(domain.JobKindHoldRelease, nil, now, 1)
+	second.UniqueKey = "hold_release"
+	added, err = queue.Enqueue(ctx, second)
+	require.NoError(t, err)
+	assert.False(t, added, "key is held by the queued job")
+
+	claimed, err := queue.Claim(ctx, now, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.NotNil(t, claimed)
+	claimed.Fail("boom", now, now)
+	require.Equal(t, domain.JobStatusFailed, claimed.Status)
+	require.NoError(t, queue.Finish(ctx, claimed))
+
+	added, err = queue.Enqueue(ctx, second)
+	require.NoError(t, err)
+	assert.True(t, added, "key is released once the job fails for good")
+}
+
+func TestJobQueue_Claim_ExpiredLease(t *testing.T) {
+	_, client := setupMiniredis(t)
+	queue := NewJobQueue(client)
+	ctx := context.Background()
+	now := time.Now()
+
+	job := domain.NewJob(domain.JobKindRetentionPurge, nil, now, 3)
+	_, err := queue.Enqueue(ctx, job)
+	require.NoError(t, err)
+
+	stale, err := queue.Claim(ctx, now, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.NotNil(t, stale)
+
+	reclaimed, err := queue.Claim(ctx, now.Add(2*time.Minute), now.Add(3*time.Minute))
+	require.NoError(t, err)
+	require.NotNil(t, reclaimed)
+	assert.Equal(t, 2, reclaimed.Attempts)
+
+	stale.Succeed(now)
+	assert.ErrorIs(t, queue.Finish(ctx, stale), domain.ErrJobLeaseLost)
+}
+
+func TestJobQueue_ListAndPurgeFinished(t *testing.T) {
+	mr, client := setupMiniredis(t)
+	queue := NewJobQueue(client)
+	ctx := context.Background()
+	now := time.Now()
+
+	done := domain.NewJob(domain.JobKindSLACheck, nil, now, 1)
+	pending := domain.NewJob(domain.JobKindSLACheck, nil, now.Add(time.Hour), 1)
+	pending.CreatedAt = done.CreatedAt.Add(time.Second)
+	for _, job := range []*domain.Job{done, pending} {
+		_, err := queue.Enqueue(ctx, job)
+		require.NoError(t, err)
+	}
+	claimed, err := queue.Claim(ctx, now, now.Add(time.Minute))
+	require.NoError(t, err)
+	claimed.Succeed(now)
+	require.NoError(t, queue.Finish(ctx, claimed))
+
+	all, total, err := queue.List(ctx, "", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, all, 2)
+	assert.Equal(t, pending.ID, all[0].ID, "newest first")
+
+	succeeded, total, err := queue.List(ctx, domain.JobStatusSucceeded, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, succeeded, 1)
+	assert.Equal(t, done.ID, succeeded[0].ID)
+
+	purged, err := queue.PurgeFinished(ctx, now.Add(time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+	assert.False(t, mr.Exists(jobKey(done.ID.String())))
+	assert.True(t, mr.Exists(jobKey(pending.ID.String())))
+}
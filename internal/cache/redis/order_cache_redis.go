@@ -37,8 +37,8 @@ func NewOrderCache(client *redis.Client) cache.OrderCache {
 	}
 }
 
-func (c *orderCacheRedis) Get(ctx context.Context, id string) (*domain.Order, error) {
-	key := orderKey(id)
+func (c *orderCacheRedis) Get(ctx context.Context, tenantID, id string) (*domain.Order, error) {
+	key := cache.OrderKey(tenantID, id)
 	data, err := c.client.Get(ctx, key).Bytes()
 	if err == redis.Nil {
 		return nil, nil
@@ -55,7 +55,7 @@ func (c *orderCacheRedis) Get(ctx context.Context, id string) (*domain.Order, er
 }
 
 func (c *orderCacheRedis) Set(ctx context.Context, order *domain.Order, ttl time.Duration) error {
-	key := orderKey(order.ID.String())
+	key := cache.OrderKey(order.TenantID, order.ID.String())
 	data, err := json.Marshal(order)
 	if err != nil {
 		return fmt.Errorf("cache marshal %s: %w", key, err)
@@ -67,8 +67,8 @@ func (c *orderCacheRedis) Set(ctx context.Context, order *domain.Order, ttl time
 	return nil
 }
 
-func (c *orderCacheRedis) Delete(ctx context.Context, id string) error {
-	key := orderKey(id)
+func (c *orderCacheRedis) Delete(ctx context.Context, tenantID, id string) error {
+	key := cache.OrderKey(tenantID, id)
 	if err := c.client.Del(ctx, key).Err(); err != nil {
 		return fmt.Errorf("cache del %s: %w", key, err)
 	}
@@ -95,6 +95,30 @@ func (c *orderCacheRedis) DeletePattern(ctx context.Context, pattern string) err
 	return nil
 }
 
-func orderKey(id string) string {
-	return "order:" + id
+func (c *orderCacheRedis) GetList(ctx context.Context, key string) (*domain.PaginatedOrders, error) {
+	data, err := c.client.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cache get %s: %w", key, err)
+	}
+
+	var page domain.PaginatedOrders
+	if err := json.Unmarshal(data, &page); err != nil {
+		return nil, fmt.Errorf("cache unmarshal %s: %w", key, err)
+	}
+	return &page, nil
+}
+
+func (c *orderCacheRedis) SetList(ctx context.Context, key string, page *domain.PaginatedOrders, ttl time.Duration) error {
+	data, err := json.Marshal(page)
+	if err != nil {
+		return fmt.Errorf("cache marshal %s: %w", key, err)
+	}
+
+	if err := c.client.Set(ctx, key, data, ttl).Err(); err != nil {
+		return fmt.Errorf("cache set %s: %w", key, err)
+	}
+	return nil
 }
@@ -21,6 +21,7 @@ import (
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/cache"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
 	goredis "github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
@@ -67,7 +68,7 @@ func TestOrderCacheRedis_SetThenGet_ReturnsOrder(t *testing.T) {
 	err := cache.Set(ctx, order, 5*time.Minute)
 	require.NoError(t, err)
 
-	got, err := cache.Get(ctx, order.ID.String())
+	got, err := cache.Get(ctx, "", order.ID.String())
 	require.NoError(t, err)
 	require.NotNil(t, got)
 
@@ -83,7 +84,7 @@ func TestOrderCacheRedis_Get_CacheMiss_ReturnsNil(t *testing.T) {
 	cache := NewOrderCache(client)
 	ctx := context.Background()
 
-	got, err := cache.Get(ctx, uuid.New().String())
+	got, err := cache.Get(ctx, "", uuid.New().String())
 
 	assert.NoError(t, err)
 	assert.Nil(t, got)
@@ -99,7 +100,7 @@ func TestOrderCacheRedis_Set_WithTTL_Expires(t *testing.T) {
 	require.NoError(t, err)
 
 	// Verify it exists
-	got, err := cache.Get(ctx, order.ID.String())
+	got, err := cache.Get(ctx, "", order.ID.String())
 	require.NoError(t, err)
 	assert.NotNil(t, got)
 
@@ -107,7 +108,7 @@ func TestOrderCacheRedis_Set_WithTTL_Expires(t *testing.T) {
 	mr.FastForward(2 * time.Second)
 
 	// Should be expired
-	got, err = cache.Get(ctx, order.ID.String())
+	got, err = cache.Get(ctx, "", order.ID.String())
 	assert.NoError(t, err)
 	assert.Nil(t, got)
 }
@@ -121,10 +122,10 @@ func TestOrderCacheRedis_Delete_RemovesFromCache(t *testing.T) {
 	err := cache.Set(ctx, order, 5*time.Minute)
 	require.NoError(t, err)
 
-	err = cache.Delete(ctx, order.ID.String())
+	err = cache.Delete(ctx, "", order.ID.String())
 	require.NoError(t, err)
 
-	got, err := cache.Get(ctx, order.ID.String())
+	got, err := cache.Get(ctx, "", order.ID.String())
 	assert.NoError(t, err)
 	assert.Nil(t, got)
 }
@@ -139,9 +140,33 @@ func TestOrderCacheRedis_Get_InvalidJSON_ReturnsError(t *testing.T) {
 	key := "order:" + id
 	client.Set(ctx, key, "not-valid-json", 5*time.Minute)
 
-	got, err := cache.Get(ctx, id)
+	got, err := cache.Get(ctx, "", id)
 
 	assert.Error(t, err)
 	assert.Nil(t, got)
 	assert.Contains(t, err.Error(), "cache unmarshal")
 }
+
+func TestOrderCacheRedis_SetListThenGetList_DeletePatternEvicts(t *testing.T) {
+	_, client := setupMiniredis(t)
+	c := NewOrderCache(client)
+	ctx := context.Background()
+	order := newTestOrder()
+	page := &domain.PaginatedOrders{Data: []*domain.Order{order}, Page: 1, PageSize: 20, TotalCount: 1, TotalPages: 1}
+	key := cache.CustomerListKey("", order.CustomerID, nil, nil, nil, 1, 20)
+
+	require.NoError(t, c.SetList(ctx, key, page, time.Minute))
+
+	got, err := c.GetList(ctx, key)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, int64(1), got.TotalCount)
+	require.Len(t, got.Data, 1)
+	assert.Equal(t, order.ID, got.Data[0].ID)
+
+	require.NoError(t, c.DeletePattern(ctx, cache.CustomerListPattern("", order.CustomerID)))
+
+	got, err = c.GetList(ctx, key)
+	require.NoError(t, err)
+	assert.Nil(t, got, "customer pages should be evicted")
+}
@@ -16,13 +16,32 @@ package redis
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"time"
 
-	"github.com/sridharn-code-sandbox/go-ordersvc/internal/cache"
+	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/cache"
 )
 
-// rateLimiterRedis implements RateLimiter using Redis
+// slidingWindowScript counts the requests of the last window in a sorted set
+// scored by arrival time, admitting a new one only while under the limit.
+// KEYS[1] = counter key, ARGV = now (ms), window (ms), limit, unique member.
+var slidingWindowScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+if redis.call('ZCARD', KEYS[1]) >= tonumber(ARGV[3]) then
+	return 0
+end
+redis.call('ZADD', KEYS[1], now, ARGV[4])
+redis.call('PEXPIRE', KEYS[1], window)
+return 1
+`)
+
+// rateLimiterRedis implements RateLimiter using Redis sliding windows, so the
+// limit is shared by every replica
 type rateLimiterRedis struct {
 	client *redis.Client
 }
@@ -34,12 +53,34 @@ func NewRateLimiter(client *redis.Client) cache.RateLimiter {
 	}
 }
 
-func (r *rateLimiterRedis) Allow(_ context.Context, _ string, _ int, _ time.Duration) (bool, error) {
-	// TODO: implement
-	return true, nil
+func (r *rateLimiterRedis) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	redisKey := rateLimitKey(key)
+	allowed, err := slidingWindowScript.Run(ctx, r.client, []string{redisKey},
+		time.Now().UnixMilli(), window.Milliseconds(), limit, uuid.NewString()).Int()
+	if err != nil {
+		return false, fmt.Errorf("rate limit %s: %w", redisKey, err)
+	}
+	return allowed == 1, nil
 }
 
-func (r *rateLimiterRedis) Reset(_ context.Context, _ string) error {
-	// TODO: implement
+func (r *rateLimiterRedis) Reset(ctx context.Context, key string) error {
+	redisKey := rateLimitKey(key)
+	if err := r.client.Del(ctx, redisKey).Err(); err != nil {
+		return fmt.Errorf("rate limit reset %s: %w", redisKey, err)
+	}
 	return nil
 }
+
+func (r *rateLimiterRedis) Count(ctx context.Context, key string, window time.Duration) (int, error) {
+	redisKey := rateLimitKey(key)
+	since := time.Now().Add(-window).UnixMilli()
+	n, err := r.client.ZCount(ctx, redisKey, "("+strconv.FormatInt(since, 10), "+inf").Result()
+	if err != nil {
+		return 0, fmt.Errorf("rate limit count %s: %w", redisKey, err)
+	}
+	return int(n), nil
+}
+
+func rateLimitKey(key string) string {
+	return "ratelimit:" + key
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_Allow_DeniesOverLimit(t *testing.T) {
+	_, client := setupMiniredis(t)
+	limiter := NewRateLimiter(client)
+	ctx := context.Background()
+
+	for i := range 3 {
+		allowed, err := limiter.Allow(ctx, "client-1", 3, time.Minute)
+		require.NoError(t, err)
+		assert.True(t, allowed, "request %d is within the limit", i+1)
+	}
+
+	allowed, err := limiter.Allow(ctx, "client-1", 3, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	allowed, err = limiter.Allow(ctx, "client-2", 3, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, allowed, "clients have separate counters")
+}
+
+func TestRateLimiter_Allow_WindowSlides(t *testing.T) {
+	_, client := setupMiniredis(t)
+	limiter := NewRateLimiter(client)
+	ctx := context.Background()
+
+	allowed, err := limiter.Allow(ctx, "client-1", 1, 50*time.Millisecond)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	allowed, err = limiter.Allow(ctx, "client-1", 1, 50*time.Millisecond)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	time.Sleep(60 * time.Millisecond)
+
+	allowed, err = limiter.Allow(ctx, "client-1", 1, 50*time.Millisecond)
+	require.NoError(t, err)
+	assert.True(t, allowed, "requests older than the window no longer count")
+}
+
+func TestRateLimiter_Reset_ClearsCounter(t *testing.T) {
+	mr, client := setupMiniredis(t)
+	limiter := NewRateLimiter(client)
+	ctx := context.Background()
+
+	_, err := limiter.Allow(ctx, "client-1", 1, time.Minute)
+	require.NoError(t, err)
+	require.NoError(t, limiter.Reset(ctx, "client-1"))
+	assert.False(t, mr.Exists("ratelimit:client-1"))
+
+	allowed, err := limiter.Allow(ctx, "client-1", 1, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+}
+
+func TestRateLimiter_Count_DoesNotCountItself(t *testing.T) {
+	_, client := setupMiniredis(t)
+	limiter := NewRateLimiter(client)
+	ctx := context.Background()
+
+	for range 2 {
+		_, err := limiter.Allow(ctx, "client-1", 5, time.Minute)
+		require.NoError(t, err)
+	}
+
+	for range 2 {
+		n, err := limiter.Count(ctx, "client-1", time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+	}
+
+	n, err := limiter.Count(ctx, "client-2", time.Minute)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
+
+func TestRateLimiter_Allow_RedisDown_ReturnsError(t *testing.T) {
+	mr, client := setupMiniredis(t)
+	limiter := NewRateLimiter(client)
+	mr.Close()
+
+	_, err := limiter.Allow(context.Background(), "client-1", 1, time.Minute)
+
+	assert.Error(t, err)
+}
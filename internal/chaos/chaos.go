@@ -0,0 +1,105 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chaos injects faults for resilience testing: latency and errors on
+// API requests and order repository calls, and dropped event publishes. It is
+// enabled with CHAOS_ENABLED outside production, so API and event consumers
+// can exercise their retry logic against the service.
+package chaos
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Config sets the faults an Injector injects. Each rate is the fraction, from
+// 0 to 1, of calls that get the fault; zero injects none.
+type Config struct {
+	// Latency is added to a LatencyRate share of API requests and of
+	// repository calls
+	Latency     time.Duration
+	LatencyRate float64
+	// ErrorRate fails API requests before they are handled
+	ErrorRate float64
+	// RepositoryErrorRate fails order repository calls
+	RepositoryErrorRate float64
+	// PublishDropRate drops events as if they were published
+	PublishDropRate float64
+}
+
+// Fault names an injected fault in the chaos_faults_injected_total metric
+type Fault string
+
+const (
+	FaultLatency         Fault = "latency"
+	FaultError           Fault = "error"
+	FaultRepositoryError Fault = "repository_error"
+	FaultPublishDrop     Fault = "publish_drop"
+)
+
+// Injector decides which calls get a fault. It is safe for concurrent use.
+type Injector struct {
+	cfg      Config
+	injected *prometheus.CounterVec
+	// roll returns a number in [0, 1); a call gets a fault when it is below
+	// the fault's rate
+	roll func() float64
+}
+
+// NewInjector returns an injector for cfg and registers its metric with reg
+func NewInjector(cfg Config, reg prometheus.Registerer) *Injector {
+	injected := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "chaos_faults_injected_total",
+		Help: "Faults injected for resilience testing, by fault.",
+	}, []string{"fault"})
+	reg.MustRegister(injected)
+	return &Injector{cfg: cfg, injected: injected, roll: rand.Float64}
+}
+
+// Delay waits Latency before a LatencyRate share of calls. It returns the
+// context's error if the context ends first.
+func (i *Injector) Delay(ctx context.Context) error {
+	if i.cfg.Latency <= 0 || !i.hit(i.cfg.LatencyRate, FaultLatency) {
+		return nil
+	}
+	t := time.NewTimer(i.cfg.Latency)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// FailRequest reports whether an API request is to be failed
+func (i *Injector) FailRequest() bool {
+	return i.hit(i.cfg.ErrorRate, FaultError)
+}
+
+// DropPublish reports whether an event is to be dropped
+func (i *Injector) DropPublish() bool {
+	return i.hit(i.cfg.PublishDropRate, FaultPublishDrop)
+}
+
+func (i *Injector) hit(rate float64, fault Fault) bool {
+	if rate <= 0 || i.roll() >= rate {
+		return false
+	}
+	i.injected.WithLabelValues(string(fault)).Inc()
+	return true
+}
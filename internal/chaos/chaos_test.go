@@ -0,0 +1,127 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaos
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+)
+
+// newTestInjector returns an injector for cfg whose rolls are always roll
+func newTestInjector(cfg Config, roll float64) *Injector {
+	i := NewInjector(cfg, prometheus.NewRegistry())
+	i.roll = func() float64 { return roll }
+	return i
+}
+
+func TestInjector_FailRequest_FollowsRate(t *testing.T) {
+	assert.True(t, newTestInjector(Config{ErrorRate: 0.5}, 0.49).FailRequest())
+	assert.False(t, newTestInjector(Config{ErrorRate: 0.5}, 0.5).FailRequest())
+	assert.False(t, newTestInjector(Config{}, 0).FailRequest())
+	assert.True(t, newTestInjector(Config{ErrorRate: 1}, 0.99).FailRequest())
+}
+
+func TestInjector_FailRequest_CountsFaults(t *testing.T) {
+	i := newTestInjector(Config{ErrorRate: 1}, 0)
+
+	i.FailRequest()
+	i.FailRequest()
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(i.injected.WithLabelValues(string(FaultError))))
+}
+
+func TestInjector_Delay(t *testing.T) {
+	i := newTestInjector(Config{Latency: 20 * time.Millisecond, LatencyRate: 1}, 0)
+
+	start := time.Now()
+	require.NoError(t, i.Delay(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+}
+
+func TestInjector_Delay_ContextCancelled(t *testing.T) {
+	i := newTestInjector(Config{Latency: time.Minute, LatencyRate: 1}, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.ErrorIs(t, i.Delay(ctx), context.Canceled)
+}
+
+func TestInjector_Delay_NotRolled(t *testing.T) {
+	i := newTestInjector(Config{Latency: time.Minute, LatencyRate: 0.1}, 0.5)
+
+	assert.NoError(t, i.Delay(context.Background()))
+}
+
+func TestOrderRepository_InjectedError_SkipsNext(t *testing.T) {
+	called := false
+	next := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(context.Context, string) (*domain.Order, error) {
+			called = true
+			return &domain.Order{}, nil
+		},
+	}
+	repo := NewOrderRepository(next, newTestInjector(Config{RepositoryErrorRate: 1}, 0))
+
+	order, err := repo.FindByID(context.Background(), "order-1")
+
+	assert.ErrorIs(t, err, domain.ErrInjectedFault)
+	assert.Nil(t, order)
+	assert.False(t, called)
+}
+
+func TestOrderRepository_NoFault_CallsNext(t *testing.T) {
+	want := &domain.Order{CustomerID: "customer-1"}
+	next := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, id string) (*domain.Order, error) {
+			assert.Equal(t, "order-1", id)
+			return want, nil
+		},
+	}
+	repo := NewOrderRepository(next, newTestInjector(Config{RepositoryErrorRate: 0.5}, 0.9))
+
+	order, err := repo.FindByID(context.Background(), "order-1")
+
+	require.NoError(t, err)
+	assert.Same(t, want, order)
+}
+
+func TestEventPublisher_Dropped(t *testing.T) {
+	published := 0
+	next := &mocks.EventPublisherMock{
+		PublishOrderCreatedFunc: func(context.Context, *domain.Order) error {
+			published++
+			return nil
+		},
+	}
+
+	err := NewEventPublisher(next, newTestInjector(Config{PublishDropRate: 1}, 0)).
+		PublishOrderCreated(context.Background(), &domain.Order{})
+	require.NoError(t, err)
+	assert.Zero(t, published)
+
+	err = NewEventPublisher(next, newTestInjector(Config{PublishDropRate: 0.5}, 0.5)).
+		PublishOrderCreated(context.Background(), &domain.Order{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, published)
+}
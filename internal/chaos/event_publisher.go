@@ -0,0 +1,99 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaos
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+)
+
+// eventPublisher is an EventPublisher that drops a share of events
+type eventPublisher struct {
+	next     service.EventPublisher
+	injector *Injector
+}
+
+// NewEventPublisher wraps next so a PublishDropRate share of events is
+// dropped: the publish reports success, but next never sees the event.
+func NewEventPublisher(next service.EventPublisher, injector *Injector) service.EventPublisher {
+	return &eventPublisher{next: next, injector: injector}
+}
+
+// drop reports whether the event is dropped, logging the dropped ones.
+// order is nil for events that are not about an order.
+func (p *eventPublisher) drop(ctx context.Context, eventType string, order *domain.Order) bool {
+	if !p.injector.DropPublish() {
+		return false
+	}
+	attrs := []any{slog.String("event_type", eventType)}
+	if order != nil {
+		attrs = append(attrs, slog.String("order_id", order.ID.String()))
+	}
+	slog.WarnContext(ctx, "event dropped by fault injection", attrs...)
+	return true
+}
+
+func (p *eventPublisher) PublishOrderCreated(ctx context.Context, order *domain.Order) error {
+	if p.drop(ctx, messaging.EventOrderCreated, order) {
+		return nil
+	}
+	return p.next.PublishOrderCreated(ctx, order)
+}
+
+func (p *eventPublisher) PublishOrderUpdated(ctx context.Context, order *domain.Order) error {
+	if p.drop(ctx, messaging.EventOrderUpdated, order) {
+		return nil
+	}
+	return p.next.PublishOrderUpdated(ctx, order)
+}
+
+func (p *eventPublisher) PublishOrderStatusChanged(ctx context.Context, order *domain.Order, oldStatus, newStatus domain.OrderStatus) error {
+	if p.drop(ctx, messaging.EventOrderStatusChanged, order) {
+		return nil
+	}
+	return p.next.PublishOrderStatusChanged(ctx, order, oldStatus, newStatus)
+}
+
+func (p *eventPublisher) PublishOrderRestored(ctx context.Context, order *domain.Order) error {
+	if p.drop(ctx, messaging.EventOrderRestored, order) {
+		return nil
+	}
+	return p.next.PublishOrderRestored(ctx, order)
+}
+
+func (p *eventPublisher) PublishOrderDeleted(ctx context.Context, order *domain.Order) error {
+	if p.drop(ctx, messaging.EventOrderDeleted, order) {
+		return nil
+	}
+	return p.next.PublishOrderDeleted(ctx, order)
+}
+
+func (p *eventPublisher) PublishCustomerDataErased(ctx context.Context, erasure *domain.CustomerErasure) error {
+	if p.drop(ctx, messaging.EventCustomerDataErased, nil) {
+		return nil
+	}
+	return p.next.PublishCustomerDataErased(ctx, erasure)
+}
+
+func (p *eventPublisher) PublishOrderSLABreached(ctx context.Context, order *domain.Order) error {
+	if p.drop(ctx, messaging.EventOrderSLABreached, order) {
+		return nil
+	}
+	return p.next.PublishOrderSLABreached(ctx, order)
+}
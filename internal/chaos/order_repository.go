@@ -0,0 +1,174 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaos
+
+import (
+	"context"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// orderRepository is an OrderRepository that delays and fails a share of
+// calls before passing them to the next repository
+type orderRepository struct {
+	next     repository.OrderRepository
+	injector *Injector
+}
+
+// NewOrderRepository wraps next so its calls get the latency and repository
+// errors of injector. Failed calls return domain.ErrInjectedFault without
+// reaching next.
+func NewOrderRepository(next repository.OrderRepository, injector *Injector) repository.OrderRepository {
+	return &orderRepository{next: next, injector: injector}
+}
+
+// fault delays a call and reports whether it is to fail
+func (r *orderRepository) fault(ctx context.Context) error {
+	if err := r.injector.Delay(ctx); err != nil {
+		return err
+	}
+	if r.injector.hit(r.injector.cfg.RepositoryErrorRate, FaultRepositoryError) {
+		return domain.ErrInjectedFault
+	}
+	return nil
+}
+
+func (r *orderRepository) Create(ctx context.Context, order *domain.Order) error {
+	if err := r.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Create(ctx, order)
+}
+
+func (r *orderRepository) CreateBatch(ctx context.Context, orders []*domain.Order) ([]error, error) {
+	if err := r.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.CreateBatch(ctx, orders)
+}
+
+func (r *orderRepository) FindByID(ctx context.Context, id string) (*domain.Order, error) {
+	if err := r.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.FindByID(ctx, id)
+}
+
+func (r *orderRepository) FindByIDIncludingDeleted(ctx context.Context, id string) (*domain.Order, error) {
+	if err := r.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.FindByIDIncludingDeleted(ctx, id)
+}
+
+func (r *orderRepository) Update(ctx context.Context, order *domain.Order) error {
+	if err := r.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Update(ctx, order)
+}
+
+func (r *orderRepository) Delete(ctx context.Context, id string) error {
+	if err := r.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Delete(ctx, id)
+}
+
+func (r *orderRepository) List(ctx context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+	if err := r.fault(ctx); err != nil {
+		return nil, 0, err
+	}
+	return r.next.List(ctx, opts)
+}
+
+func (r *orderRepository) FindByCustomerID(ctx context.Context, customerID string, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+	if err := r.fault(ctx); err != nil {
+		return nil, 0, err
+	}
+	return r.next.FindByCustomerID(ctx, customerID, opts)
+}
+
+func (r *orderRepository) EstimateTotal(ctx context.Context) (int64, error) {
+	if err := r.fault(ctx); err != nil {
+		return 0, err
+	}
+	return r.next.EstimateTotal(ctx)
+}
+
+func (r *orderRepository) Search(ctx context.Context, query string, opts repository.SearchOptions) ([]*domain.Order, int64, error) {
+	if err := r.fault(ctx); err != nil {
+		return nil, 0, err
+	}
+	return r.next.Search(ctx, query, opts)
+}
+
+func (r *orderRepository) ListDeleted(ctx context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+	if err := r.fault(ctx); err != nil {
+		return nil, 0, err
+	}
+	return r.next.ListDeleted(ctx, opts)
+}
+
+func (r *orderRepository) Restore(ctx context.Context, id string, expectedVersion *int) (*domain.Order, error) {
+	if err := r.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.Restore(ctx, id, expectedVersion)
+}
+
+func (r *orderRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	if err := r.fault(ctx); err != nil {
+		return 0, err
+	}
+	return r.next.Purge(ctx, deletedBefore)
+}
+
+func (r *orderRepository) PurgeCompleted(ctx context.Context, statuses []domain.OrderStatus, updatedBefore time.Time) (int64, error) {
+	if err := r.fault(ctx); err != nil {
+		return 0, err
+	}
+	return r.next.PurgeCompleted(ctx, statuses, updatedBefore)
+}
+
+func (r *orderRepository) ListDueHolds(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	if err := r.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.ListDueHolds(ctx, now, limit)
+}
+
+func (r *orderRepository) ListOverdueDeliveries(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	if err := r.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.ListOverdueDeliveries(ctx, now, limit)
+}
+
+func (r *orderRepository) ListStalePending(ctx context.Context, createdBefore time.Time, limit int) ([]string, error) {
+	if err := r.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.ListStalePending(ctx, createdBefore, limit)
+}
+
+func (r *orderRepository) ListOrderAmounts(ctx context.Context, afterID string, limit int) ([]domain.OrderAmounts, error) {
+	if err := r.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.ListOrderAmounts(ctx, afterID, limit)
+}
@@ -12,145 +12,1192 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package config provides application configuration loaded from environment variables.
+// Package config provides application configuration loaded from a YAML file and environment variables.
 package config
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
 	"os"
 	"strconv"
+	"strings"
 	"time"
+
+	"gopkg.in/yaml.v3"
 )
 
 // Config holds all application configuration
 type Config struct {
-	App      AppConfig
-	Server   ServerConfig
-	Database DatabaseConfig
-	Redis    RedisConfig
-	Kafka    KafkaConfig
-	Cache    CacheConfig
+	App           AppConfig           `yaml:"app"`
+	Server        ServerConfig        `yaml:"server"`
+	Database      DatabaseConfig      `yaml:"database"`
+	Redis         RedisConfig         `yaml:"redis"`
+	Kafka         KafkaConfig         `yaml:"kafka"`
+	Messaging     MessagingConfig     `yaml:"messaging"`
+	NATS          NATSConfig          `yaml:"nats"`
+	SNS           SNSConfig           `yaml:"sns"`
+	Cache         CacheConfig         `yaml:"cache"`
+	Admin         AdminConfig         `yaml:"admin"`
+	Auth          AuthConfig          `yaml:"auth"`
+	Audit         AuditConfig         `yaml:"audit"`
+	Tenancy       TenancyConfig       `yaml:"tenancy"`
+	Retention     RetentionConfig     `yaml:"retention"`
+	Archive       ArchiveConfig       `yaml:"archive"`
+	Reports       ReportsConfig       `yaml:"reports"`
+	Partitions    PartitionsConfig    `yaml:"partitions"`
+	Holds         HoldsConfig         `yaml:"holds"`
+	PendingOrders PendingOrdersConfig `yaml:"pending_orders"`
+	TotalCheck    TotalCheckConfig    `yaml:"total_check"`
+	Delivery      DeliveryConfig      `yaml:"delivery"`
+	Pricing       PricingConfig       `yaml:"pricing"`
+	OrderLimits   OrderLimitsConfig   `yaml:"order_limits"`
+	Subscriptions SubscriptionsConfig `yaml:"subscriptions"`
+	Jobs          JobsConfig          `yaml:"jobs"`
+	Search        SearchConfig        `yaml:"search"`
+	ObjectStore   ObjectStoreConfig   `yaml:"object_store"`
+	Payments      PaymentsConfig      `yaml:"payments"`
+
+	RateLimit  RateLimitConfig  `yaml:"rate_limit"`
+	Pagination PaginationConfig `yaml:"pagination"`
+	Secrets    SecretsConfig    `yaml:"secrets"`
+	Startup    StartupConfig    `yaml:"startup"`
+	Resilience ResilienceConfig `yaml:"resilience"`
+	Outbound   OutboundConfig   `yaml:"outbound"`
+	Chaos      ChaosConfig      `yaml:"chaos"`
 }
 
 // AppConfig holds application-level configuration
 type AppConfig struct {
-	Name        string
-	Version     string
-	Environment string
-	LogLevel    string
+	Name        string `yaml:"name"`
+	Version     string `yaml:"version"`
+	Environment string `yaml:"environment"`
+	LogLevel    string `yaml:"log_level"`
+	// LogFormat is "json" (default) or "text" for human-readable local logs
+	LogFormat string `yaml:"log_format"`
 }
 
 // ServerConfig holds server configuration
 type ServerConfig struct {
-	HTTPPort        int
-	GRPCPort        int
-	ReadTimeout     time.Duration
-	WriteTimeout    time.Duration
-	ShutdownTimeout time.Duration
-	EnablePprof     bool
+	HTTPPort        int           `yaml:"http_port"`
+	GRPCPort        int           `yaml:"grpc_port"`
+	ReadTimeout     time.Duration `yaml:"read_timeout"`
+	WriteTimeout    time.Duration `yaml:"write_timeout"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	EnablePprof     bool          `yaml:"enable_pprof"`
+	// PprofAddr is the listen address of the debug server that serves
+	// /debug/pprof and /debug/vars when EnablePprof is set. It defaults to
+	// loopback so profiles are only reachable through a port-forward.
+	PprofAddr string `yaml:"pprof_addr"`
+	// ProblemJSON writes every HTTP error as an RFC 7807
+	// application/problem+json document. When false, only clients whose
+	// Accept header asks for one get it.
+	ProblemJSON bool `yaml:"problem_json"`
+	// WebSocketHeartbeat is the time between heartbeat messages on a
+	// /ws/orders stream
+	WebSocketHeartbeat time.Duration `yaml:"websocket_heartbeat"`
+	// RequestTimeout is the deadline of an API request, after which it gets
+	// 504 GATEWAY_TIMEOUT; zero leaves requests bounded only by WriteTimeout
+	RequestTimeout time.Duration `yaml:"request_timeout"`
+	// RouteTimeouts overrides RequestTimeout for paths starting with a
+	// prefix, the longest prefix winning; zero disables the deadline there
+	RouteTimeouts map[string]time.Duration `yaml:"route_timeouts"`
+	// CacheMaxAge is how long clients may reuse an order read without
+	// revalidating it; zero makes them revalidate every time
+	CacheMaxAge time.Duration `yaml:"cache_max_age"`
 }
 
 // DatabaseConfig holds database configuration
 type DatabaseConfig struct {
-	Host            string
-	Port            int
-	User            string
-	Password        string `json:"-"` // #nosec G117 -- config field, not serialized
-	Database        string
-	SSLMode         string
-	MaxOpenConns    int
-	MaxIdleConns    int
-	ConnMaxLifetime time.Duration
-	ConnMaxIdleTime time.Duration
-	MigrationsPath  string
+	Host            string        `yaml:"host"`
+	Port            int           `yaml:"port"`
+	User            string        `yaml:"user"`
+	Password        string        `json:"-" yaml:"password"` // #nosec G117 -- config field, not serialized
+	Database        string        `yaml:"name"`
+	SSLMode         string        `yaml:"ssl_mode"`
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time"`
+	// QueryTimeout cancels an order repository call, and makes the server
+	// cancel any statement, that runs longer. Zero disables both.
+	QueryTimeout time.Duration `yaml:"query_timeout"`
+	// SlowQueryThreshold logs queries that take at least this long. Zero
+	// disables the log.
+	SlowQueryThreshold time.Duration `yaml:"slow_query_threshold"`
+	// MigrationsPath overrides the embedded migrations with a directory on disk.
+	MigrationsPath string `yaml:"migrations_path"`
+	// AutoMigrate applies pending migrations on startup.
+	AutoMigrate bool `yaml:"auto_migrate"`
+	// ReplicaDSN is the connection string of a read replica that serves
+	// order lookups and lists. Empty sends every query to the primary. It
+	// uses the primary's pool limits.
+	ReplicaDSN string `json:"-" yaml:"replica_dsn"` // #nosec G117 -- config field, not serialized
+}
+
+// DSN returns the PostgreSQL connection string for the database. The user and
+// password are escaped, so generated or rotated passwords may contain any character.
+func (c DatabaseConfig) DSN() string {
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(c.User, c.Password),
+		Host:     net.JoinHostPort(c.Host, strconv.Itoa(c.Port)),
+		Path:     "/" + c.Database,
+		RawQuery: url.Values{"sslmode": {c.SSLMode}}.Encode(),
+	}
+	return dsn.String()
 }
 
 // RedisConfig holds Redis configuration
 type RedisConfig struct {
-	Host        string
-	Port        int
-	Password    string `json:"-"` // #nosec G117 -- config field, not serialized
-	DB          int
-	MaxRetries  int
-	PoolSize    int
-	PoolTimeout time.Duration
+	Host        string        `yaml:"host"`
+	Port        int           `yaml:"port"`
+	Password    string        `json:"-" yaml:"password"` // #nosec G117 -- config field, not serialized
+	DB          int           `yaml:"db"`
+	MaxRetries  int           `yaml:"max_retries"`
+	PoolSize    int           `yaml:"pool_size"`
+	PoolTimeout time.Duration `yaml:"pool_timeout"`
+	// Required makes an unreachable Redis fatal at startup. Otherwise the
+	// service starts without it and caching, idempotency and rate limiting
+	// degrade until Redis comes back.
+	Required bool `yaml:"required"`
 }
 
 // KafkaConfig holds Kafka configuration
 type KafkaConfig struct {
-	Brokers []string
-	Topic   string
-	GroupID string
+	Brokers []string `yaml:"brokers"`
+	Topic   string   `yaml:"topic"`
+	GroupID string   `yaml:"group_id"`
+	// EventFormat is "cloudevents" (default), "legacy" for bare OrderEvent
+	// JSON, or "avro" or "protobuf" through the schema registry
+	EventFormat string `yaml:"event_format"`
+	// SchemaRegistryURL is the Confluent Schema Registry of the avro and protobuf formats
+	SchemaRegistryURL      string `yaml:"schema_registry_url"`
+	SchemaRegistryUsername string `yaml:"schema_registry_username"`
+	SchemaRegistryPassword string `json:"-" yaml:"schema_registry_password"` // #nosec G117 -- config field, not serialized
+	// SchemaSubjectStrategy is "topic_name" (default), "record_name" or "topic_record_name"
+	SchemaSubjectStrategy string `yaml:"schema_subject_strategy"`
+	// SchemaAutoRegister registers the event schema at startup. Otherwise
+	// it must already be registered under the subject.
+	SchemaAutoRegister bool `yaml:"schema_auto_register"`
+	// DeadLetterRetryInterval is the base delay between dead-letter redelivery passes
+	DeadLetterRetryInterval time.Duration `yaml:"dead_letter_retry_interval"`
+	// DeadLetterMaxAttempts is how many redeliveries are tried before an event stays dead
+	DeadLetterMaxAttempts int `yaml:"dead_letter_max_attempts"`
+	// WatchBuffer is how many events a gRPC WatchOrders stream may fall
+	// behind the shared consumer before it is disconnected
+	WatchBuffer int `yaml:"watch_buffer"`
+
+	// RequiredAcks is "none", "one" (default) or "all" replicas acknowledging a write
+	RequiredAcks string `yaml:"required_acks"`
+	// BatchSize, BatchBytes and BatchTimeout bound a producer batch
+	BatchSize    int           `yaml:"batch_size"`
+	BatchBytes   int           `yaml:"batch_bytes"`
+	BatchTimeout time.Duration `yaml:"batch_timeout"`
+	// Compression is "none" (default), "gzip", "snappy", "lz4" or "zstd"
+	Compression string `yaml:"compression"`
+	// Idempotent asks for the strongest delivery the producer offers and is
+	// rejected unless acks are "all" and publishing is synchronous
+	Idempotent bool `yaml:"idempotent"`
+	// MaxAttempts is how often the producer tries a batch. In sync mode
+	// RESILIENCE_MAX_ATTEMPTS retries on top of it.
+	MaxAttempts int `yaml:"max_attempts"`
+	// PublishMode is "sync" (default), where a publish waits for the broker,
+	// or "async", where it returns once the event is queued
+	PublishMode string `yaml:"publish_mode"`
+
+	// TopicRouting is "single" (default), where every event goes to Topic,
+	// "event_type", where EventTopics maps event types to their own topics,
+	// or "prefix", where each event type goes to "<Topic>.<event type>"
+	TopicRouting string            `yaml:"topic_routing"`
+	EventTopics  map[string]string `yaml:"event_topics"`
+}
+
+// Messaging backends selectable via MESSAGING_BACKEND
+const (
+	MessagingBackendKafka = "kafka"
+	MessagingBackendNATS  = "nats"
+	MessagingBackendSNS   = "sns"
+	MessagingBackendNone  = "none"
+)
+
+// MessagingConfig selects the event publishing backend
+type MessagingConfig struct {
+	Backend string `yaml:"backend"`
+	// Required makes an unreachable broker fatal at startup. Otherwise the
+	// service starts without it: Kafka events go to the dead-letter queue
+	// until the broker is back, and NATS or SNS events are dropped.
+	Required bool `yaml:"required"`
+}
+
+// NATSConfig holds NATS JetStream configuration
+type NATSConfig struct {
+	URL           string `yaml:"url"`
+	Stream        string `yaml:"stream"`
+	SubjectPrefix string `yaml:"subject_prefix"`
+}
+
+// SNSConfig holds Amazon SNS configuration. Region and credentials come from
+// the default AWS chain (AWS_REGION, AWS_PROFILE, ...).
+type SNSConfig struct {
+	TopicARN string `yaml:"topic_arn"`
+	Endpoint string `yaml:"endpoint"`
 }
 
 // CacheConfig holds cache configuration
 type CacheConfig struct {
-	DefaultTTL time.Duration
-	HotTTL     time.Duration
+	DefaultTTL time.Duration `yaml:"default_ttl"`
+	HotTTL     time.Duration `yaml:"hot_ttl"`
+	// IdempotencyTTL is how long responses to requests with an Idempotency-Key are kept
+	IdempotencyTTL time.Duration `yaml:"idempotency_ttl"`
+	// BreakerFailures is how many consecutive order cache errors open the
+	// circuit breaker; BreakerCooldown is how long it then skips the cache
+	BreakerFailures int           `yaml:"breaker_failures"`
+	BreakerCooldown time.Duration `yaml:"breaker_cooldown"`
+	// ListTTL is how long a page of a customer's orders stays cached; zero
+	// disables list caching
+	ListTTL time.Duration `yaml:"list_ttl"`
+}
+
+// RateLimitConfig holds the per-client request limits (ADR-0005).
+// Both limits apply; a zero RequestsPerMinute disables rate limiting.
+type RateLimitConfig struct {
+	// RequestsPerMinute is the sustained number of requests a client may send
+	RequestsPerMinute int `yaml:"requests_per_minute"`
+	// WriteRequestsPerMinute gives POST, PUT, PATCH and DELETE requests a
+	// limit of their own; zero counts them with the reads
+	WriteRequestsPerMinute int `yaml:"write_requests_per_minute"`
+	// Burst is the most requests a client may send within one second
+	Burst int `yaml:"burst"`
+	// Policies limit the callers of a tenant or customer on top of the
+	// client limit
+	Policies []RateLimitPolicyConfig `yaml:"policies"`
+}
+
+// RateLimitPolicyConfig limits the callers of one tenant or one customer.
+// Exactly one of Tenant and CustomerID is set; "*" gives every tenant or
+// customer a limit of its own.
+type RateLimitPolicyConfig struct {
+	Tenant                 string `yaml:"tenant,omitempty"`
+	CustomerID             string `yaml:"customer_id,omitempty"`
+	RequestsPerMinute      int    `yaml:"requests_per_minute"`
+	WriteRequestsPerMinute int    `yaml:"write_requests_per_minute"`
+	Burst                  int    `yaml:"burst"`
+}
+
+// PaginationConfig bounds the page size of list and search results
+type PaginationConfig struct {
+	// MaxPageSize caps the page size a client may request
+	MaxPageSize int `yaml:"max_page_size"`
+	// EstimateTotals reports the total of unfiltered order lists from
+	// table statistics instead of counting every order. Clients can ask for
+	// an exact count with exact=true.
+	EstimateTotals bool `yaml:"estimate_totals"`
+}
+
+// SecretsConfig holds the secret backends that credential settings
+// (database.password, database.replica_dsn, redis.password, admin.api_key,
+// search.opensearch_password, payments.stripe_secret_key,
+// payments.stripe_webhook_secret) may reference as file:<path>,
+// vault:<path>#<field> or awssm:<id>[#<field>].
+type SecretsConfig struct {
+	// RefreshInterval is how long a fetched secret is used before it is fetched again
+	RefreshInterval time.Duration `yaml:"refresh_interval"`
+	// VaultAddr enables vault: references; VaultToken authenticates them
+	VaultAddr      string `yaml:"vault_addr"`
+	VaultToken     string `json:"-" yaml:"vault_token"` // #nosec G117 -- config field, not serialized
+	VaultNamespace string `yaml:"vault_namespace"`
+	// AWSEndpoint overrides the Secrets Manager endpoint, e.g. for LocalStack
+	AWSEndpoint string `yaml:"aws_endpoint"`
+}
+
+// StartupConfig controls how long startup waits for its dependencies.
+// Connections are retried with exponential backoff from InitialBackoff,
+// doubling up to MaxBackoff, until RetryTimeout has passed.
+type StartupConfig struct {
+	RetryTimeout   time.Duration `yaml:"retry_timeout"`
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+}
+
+// ResilienceConfig bounds how hard event publishing tries the broker.
+// A failed write is retried up to MaxAttempts times with exponential backoff
+// from InitialBackoff to MaxBackoff. After BreakerFailures consecutive
+// publishes fail, no writes are attempted for BreakerCooldown and events go
+// straight to the dead-letter queue.
+type ResilienceConfig struct {
+	MaxAttempts     int           `yaml:"max_attempts"`
+	InitialBackoff  time.Duration `yaml:"initial_backoff"`
+	MaxBackoff      time.Duration `yaml:"max_backoff"`
+	BreakerFailures int           `yaml:"breaker_failures"`
+	BreakerCooldown time.Duration `yaml:"breaker_cooldown"`
+}
+
+// OutboundConfig tunes the HTTP client shared by calls to other systems,
+// such as webhook receivers. Each attempt is bounded by Timeout. Failed
+// idempotent requests are retried up to MaxAttempts times with jittered
+// exponential backoff from InitialBackoff to MaxBackoff. After
+// BreakerFailures consecutive failures of a host, calls to it fail fast for
+// BreakerCooldown.
+type OutboundConfig struct {
+	Timeout         time.Duration `yaml:"timeout"`
+	MaxAttempts     int           `yaml:"max_attempts"`
+	InitialBackoff  time.Duration `yaml:"initial_backoff"`
+	MaxBackoff      time.Duration `yaml:"max_backoff"`
+	BreakerFailures int           `yaml:"breaker_failures"`
+	BreakerCooldown time.Duration `yaml:"breaker_cooldown"`
+}
+
+// ChaosConfig injects faults so API and event consumers can test their retry
+// logic against the service. Each rate is the fraction, from 0 to 1, of
+// requests, repository calls or publishes that get the fault. It is refused
+// in production.
+type ChaosConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Latency is added to a LatencyRate share of API requests and of
+	// repository calls
+	Latency     time.Duration `yaml:"latency"`
+	LatencyRate float64       `yaml:"latency_rate"`
+	// ErrorRate answers API requests with 503 INJECTED_FAULT before they
+	// are handled
+	ErrorRate float64 `yaml:"error_rate"`
+	// RepositoryErrorRate fails order repository calls with INJECTED_FAULT
+	RepositoryErrorRate float64 `yaml:"repository_error_rate"`
+	// PublishDropRate drops order events as if they were published
+	PublishDropRate float64 `yaml:"publish_drop_rate"`
+}
+
+// AdminConfig holds settings for the /api/v1/admin route group
+type AdminConfig struct {
+	// APIKey is the bearer token admin requests must present; empty disables the admin API
+	APIKey string `json:"-" yaml:"api_key"` // #nosec G117 -- config field, not serialized
+}
+
+// AuthConfig holds the bearer token settings for order API callers
+type AuthConfig struct {
+	// JWTSecret is the HS256 key caller tokens are signed with; empty disables authentication
+	JWTSecret string `json:"-" yaml:"jwt_secret"` // #nosec G117 -- config field, not serialized
+	// JWTIssuer, if set, must match the iss claim of every token
+	JWTIssuer string `yaml:"jwt_issuer"`
+}
+
+// AuditConfig holds the audit log settings
+type AuditConfig struct {
+	// Path is the file admin and mutating API calls are appended to, or "-"
+	// for stdout; empty disables the audit log
+	Path string `yaml:"path"`
+}
+
+// Tenant resolution modes selectable via TENANCY_MODE
+const (
+	TenancyModeNone      = "none"
+	TenancyModeHeader    = "header"
+	TenancyModeSubdomain = "subdomain"
+	TenancyModeClaim     = "claim"
+)
+
+// TenancyConfig selects how each request's tenant is resolved
+type TenancyConfig struct {
+	// Mode is none (single tenant), header, subdomain or claim
+	Mode string `yaml:"mode"`
+	// Header carries the tenant ID in header mode
+	Header string `yaml:"header"`
+	// BaseDomain is stripped from the host in subdomain mode, leaving the tenant label
+	BaseDomain string `yaml:"base_domain"`
+}
+
+// Search backends selectable via SEARCH_BACKEND
+const (
+	SearchBackendPostgres   = "postgres"
+	SearchBackendOpenSearch = "opensearch"
+)
+
+// SearchConfig selects the order search backend
+type SearchConfig struct {
+	Backend string `yaml:"backend"`
+	// OpenSearchURL is the cluster endpoint used when Backend is opensearch
+	OpenSearchURL      string `yaml:"opensearch_url"`
+	OpenSearchIndex    string `yaml:"opensearch_index"`
+	OpenSearchUsername string `yaml:"opensearch_username"`
+	OpenSearchPassword string `json:"-" yaml:"opensearch_password"` // #nosec G117 -- config field, not serialized
+}
+
+// ObjectStoreConfig holds the S3 client settings used by the order archive
+// and the export and restore commands. Credentials come from the standard AWS environment;
+// gs:// URLs use GCS HMAC keys.
+type ObjectStoreConfig struct {
+	// Endpoint overrides the S3 (or GCS) endpoint, e.g. for MinIO
+	Endpoint string `yaml:"endpoint"`
+	// PathStyle addresses buckets as endpoint/bucket rather than by host
+	PathStyle bool `yaml:"path_style"`
+}
+
+// Payment providers selectable via PAYMENTS_PROVIDER
+const (
+	PaymentsProviderNone   = ""
+	PaymentsProviderMock   = "mock"
+	PaymentsProviderStripe = "stripe"
+)
+
+// PaymentsConfig selects the provider orders are paid through
+type PaymentsConfig struct {
+	// Provider is empty (payments disabled), mock or stripe
+	Provider string `yaml:"provider"`
+	// Currency is the lowercase ISO 4217 code order totals are charged in.
+	// Totals are in hundredths, so it must be a two-decimal currency.
+	Currency string `yaml:"currency"`
+	// StripeURL overrides the Stripe API endpoint, e.g. for stripe-mock
+	StripeURL           string `yaml:"stripe_url"`
+	StripeSecretKey     string `json:"-" yaml:"stripe_secret_key"`     // #nosec G117 -- config field, not serialized
+	StripeWebhookSecret string `json:"-" yaml:"stripe_webhook_secret"` // #nosec G117 -- config field, not serialized
+	// MockWebhookSecret signs mock provider webhooks; empty accepts them unsigned
+	MockWebhookSecret string `json:"-" yaml:"mock_webhook_secret"` // #nosec G117 -- config field, not serialized
+}
+
+// ReportsConfig holds the order report settings
+type ReportsConfig struct {
+	// UseMaterializedViews serves period and status reports from the
+	// order_daily_totals view instead of aggregating the orders table
+	UseMaterializedViews bool `yaml:"use_materialized_views"`
+	// RefreshInterval is the time between view refreshes
+	RefreshInterval time.Duration `yaml:"refresh_interval"`
+	// StatsTTL is how long GET /api/v1/stats reuses its last answer
+	StatsTTL time.Duration `yaml:"stats_ttl"`
 }
 
-// LoadFromEnv loads configuration from environment variables
+// RetentionConfig holds the order retention purge settings.
+// A zero period disables that rule; the job runs only if a rule is enabled.
+type RetentionConfig struct {
+	// DeletedOrders is how long soft-deleted orders are kept
+	DeletedOrders time.Duration `yaml:"deleted_orders"`
+	// CompletedOrders is how long delivered and cancelled orders are kept after their last update
+	CompletedOrders time.Duration `yaml:"completed_orders"`
+	// Interval is the time between retention passes
+	Interval time.Duration `yaml:"interval"`
+}
+
+// ArchiveConfig holds the order archive settings. The job runs only when
+// URL is set, and GET by ID then also finds archived orders.
+type ArchiveConfig struct {
+	// URL is where archived orders are written: s3://bucket/prefix,
+	// gs://bucket/prefix or a directory
+	URL string `yaml:"url"`
+	// After is how long delivered and cancelled orders are kept in the
+	// database after their last update
+	After time.Duration `yaml:"after"`
+	// Interval is the time between archive passes
+	Interval time.Duration `yaml:"interval"`
+}
+
+// PartitionsConfig holds the order partition maintenance settings. The job
+// only creates partitions once the orders table has been partitioned with
+// ordersvcctl partition-orders.
+type PartitionsConfig struct {
+	// Maintain runs the partition maintenance job
+	Maintain bool `yaml:"maintain"`
+	// MonthsAhead is how many months of partitions are kept created beyond the current month
+	MonthsAhead int `yaml:"months_ahead"`
+	// Interval is the time between maintenance passes
+	Interval time.Duration `yaml:"interval"`
+}
+
+// HoldsConfig holds the order hold settings. The release job always runs so
+// holds placed under an earlier release_after are still released on time.
+type HoldsConfig struct {
+	// ReleaseAfter is how long an order stays on hold before it is released
+	// automatically; zero keeps holds until released explicitly
+	ReleaseAfter time.Duration `yaml:"release_after"`
+	// ReleaseInterval is the time between passes of the release job
+	ReleaseInterval time.Duration `yaml:"release_interval"`
+}
+
+// PendingOrdersConfig holds the settings of the job that cancels pending
+// orders nobody confirmed in time, such as orders that were never paid
+type PendingOrdersConfig struct {
+	// ExpireAfter is how long an order may stay pending before it is
+	// cancelled; zero disables the expiry job
+	ExpireAfter time.Duration `yaml:"expire_after"`
+	// ExpiryInterval is the time between passes of the expiry job
+	ExpiryInterval time.Duration `yaml:"expiry_interval"`
+}
+
+// TotalCheckConfig holds the settings of the job that recomputes order
+// totals from their items and reports the orders that disagree
+type TotalCheckConfig struct {
+	// Interval is the time between checks; zero disables the job, leaving
+	// checks to the admin endpoint
+	Interval time.Duration `yaml:"interval"`
+	// Repair corrects the mismatched orders found by the job instead of
+	// only reporting them
+	Repair bool `yaml:"repair"`
+}
+
+// DeliveryConfig holds the delivery estimate and SLA settings. An order's
+// estimated delivery time is set when it is confirmed, from the transit
+// time of its shipping method.
+type DeliveryConfig struct {
+	// TransitTimes maps each accepted shipping method to how long after
+	// confirmation its orders are expected to be delivered. A file or
+	// environment value replaces the default methods rather than adding to them.
+	TransitTimes map[string]time.Duration `yaml:"transit_times"`
+	// DefaultMethod is used for orders created without a shipping method
+	DefaultMethod string `yaml:"default_method"`
+	// SLACheckInterval is the time between passes of the job that flags
+	// overdue orders
+	SLACheckInterval time.Duration `yaml:"sla_check_interval"`
+}
+
+// PricingConfig holds the order pricing settings. Unit prices come from the
+// pricing service when it knows the product and from the client otherwise.
+type PricingConfig struct {
+	// RequireServerSide rejects items the pricing service cannot price
+	// instead of accepting the client's price
+	RequireServerSide bool `yaml:"require_server_side"`
+	// CatalogURL is the base URL of the product catalog the unit prices are
+	// read from. Empty leaves pricing to the client.
+	CatalogURL string `yaml:"catalog_url"`
+}
+
+// OrderLimitsConfig caps the size of an order. A zero value sets no limit.
+type OrderLimitsConfig struct {
+	// MaxItems caps the number of items in an order
+	MaxItems int `yaml:"max_items"`
+	// MaxItemQuantity caps the quantity of each item
+	MaxItemQuantity int `yaml:"max_item_quantity"`
+	// MaxTotal caps the order total
+	MaxTotal float64 `yaml:"max_total"`
+}
+
+// SubscriptionsConfig holds the recurring order scheduler settings
+type SubscriptionsConfig struct {
+	// Interval is the time between passes of the job that places orders for
+	// due subscriptions, so an order may be placed up to Interval late
+	Interval time.Duration `yaml:"interval"`
+}
+
+// Job queue backends selectable via JOBS_BACKEND
+const (
+	JobsBackendPostgres = "postgres"
+	JobsBackendRedis    = "redis"
+)
+
+// JobsConfig holds the job queue settings of `ordersvc worker`
+type JobsConfig struct {
+	// Backend stores the job queue in PostgreSQL or Redis
+	Backend string `yaml:"backend"`
+	// RunInServer runs the background jobs inside the API server, each on
+	// its own loop. Set it to false when ordersvc worker runs them instead.
+	RunInServer bool `yaml:"run_in_server"`
+	// PollInterval is the time between checks for due jobs
+	PollInterval time.Duration `yaml:"poll_interval"`
+	// Lease is how long a job may run before another worker may claim it again
+	Lease time.Duration `yaml:"lease"`
+	// MaxAttempts is how often a job is tried before it fails for good
+	MaxAttempts int `yaml:"max_attempts"`
+	// RetryBackoff is the delay before a failed job is retried, doubled per attempt
+	RetryBackoff time.Duration `yaml:"retry_backoff"`
+	// Retention is how long finished jobs are kept; zero keeps them
+	Retention time.Duration `yaml:"retention"`
+}
+
+// LoadFromEnv loads configuration from defaults and environment variables
 func LoadFromEnv() (*Config, error) {
+	return Load("")
+}
+
+// Load builds the configuration in three layers: built-in defaults, then the
+// YAML file at path (skipped if path is empty), then environment variables.
+// Unknown file keys and unparseable values are reported together, naming the
+// file line or environment variable at fault.
+func Load(path string) (*Config, error) {
+	cfg := defaults()
+
+	if path != "" {
+		if err := loadFile(path, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	env := &envLoader{}
+	env.apply(cfg)
+	if len(env.errs) > 0 {
+		return nil, fmt.Errorf("invalid environment: %w", errors.Join(env.errs...))
+	}
+	return cfg, nil
+}
+
+// loadFile decodes the YAML file at path over cfg; keys it omits keep their value.
+func loadFile(path string, cfg *Config) error {
+	f, err := os.Open(path) // #nosec G304 -- operator-supplied config path
+	if err != nil {
+		return fmt.Errorf("failed to open config file: %w", err)
+	}
+	defer f.Close()
+
+	// YAML decodes a map into the existing one, which would keep default
+	// shipping methods the file leaves out
+	transitTimes := cfg.Delivery.TransitTimes
+	cfg.Delivery.TransitTimes = nil
+
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+
+	if cfg.Delivery.TransitTimes == nil {
+		cfg.Delivery.TransitTimes = transitTimes
+	}
+	return nil
+}
+
+// defaults returns the configuration used when neither file nor environment set a value
+func defaults() *Config {
 	return &Config{
 		App: AppConfig{
-			Name:        getEnv("APP_NAME", "ordersvc"),
-			Version:     getEnv("APP_VERSION", "dev"),
-			Environment: getEnv("APP_ENVIRONMENT", "development"),
-			LogLevel:    getEnv("APP_LOG_LEVEL", "info"),
+			Name:        "ordersvc",
+			Version:     "dev",
+			Environment: "development",
+			LogLevel:    "info",
+			LogFormat:   "json",
 		},
 		Server: ServerConfig{
-			HTTPPort:        getEnvAsInt("HTTP_PORT", 8080),
-			GRPCPort:        getEnvAsInt("GRPC_PORT", 9090),
-			ReadTimeout:     10 * time.Second,
-			WriteTimeout:    10 * time.Second,
-			ShutdownTimeout: 30 * time.Second,
-			EnablePprof:     false,
+			HTTPPort:           8080,
+			GRPCPort:           9090,
+			ReadTimeout:        10 * time.Second,
+			WriteTimeout:       10 * time.Second,
+			ShutdownTimeout:    30 * time.Second,
+			EnablePprof:        false,
+			PprofAddr:          "localhost:6060",
+			ProblemJSON:        false,
+			WebSocketHeartbeat: 30 * time.Second,
+			RequestTimeout:     9 * time.Second,
+			// Purges and replays run until they are done
+			RouteTimeouts: map[string]time.Duration{"/api/v1/admin": 0},
 		},
 		Database: DatabaseConfig{
-			Host:            getEnv("DATABASE_HOST", "localhost"),
-			Port:            getEnvAsInt("DATABASE_PORT", 5432),
-			User:            getEnv("DATABASE_USER", "postgres"),
-			Password:        getEnv("DATABASE_PASSWORD", "postgres"),
-			Database:        getEnv("DATABASE_NAME", "ordersvc"),
-			SSLMode:         getEnv("DATABASE_SSL_MODE", "disable"),
-			MaxOpenConns:    25,
-			MaxIdleConns:    5,
-			ConnMaxLifetime: 5 * time.Minute,
-			ConnMaxIdleTime: 10 * time.Minute,
-			MigrationsPath:  "file://db/migrations",
+			Host:               "localhost",
+			Port:               5432,
+			User:               "postgres",
+			Password:           "postgres",
+			Database:           "ordersvc",
+			SSLMode:            "disable",
+			MaxOpenConns:       25,
+			MaxIdleConns:       5,
+			ConnMaxLifetime:    5 * time.Minute,
+			ConnMaxIdleTime:    10 * time.Minute,
+			QueryTimeout:       5 * time.Second,
+			SlowQueryThreshold: 500 * time.Millisecond,
 		},
 		Redis: RedisConfig{
-			Host:        getEnv("REDIS_HOST", "localhost"),
-			Port:        getEnvAsInt("REDIS_PORT", 6379),
-			Password:    getEnv("REDIS_PASSWORD", ""),
-			DB:          getEnvAsInt("REDIS_DB", 0),
+			Host:        "localhost",
+			Port:        6379,
 			MaxRetries:  3,
 			PoolSize:    10,
 			PoolTimeout: 4 * time.Second,
 		},
 		Kafka: KafkaConfig{
-			Brokers: []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
-			Topic:   getEnv("KAFKA_TOPIC", "order-events"),
-			GroupID: getEnv("KAFKA_GROUP_ID", "ordersvc"),
+			Brokers:     []string{"localhost:9092"},
+			Topic:       "order-events",
+			GroupID:     "ordersvc",
+			EventFormat: "cloudevents",
+
+			SchemaSubjectStrategy: "topic_name",
+			SchemaAutoRegister:    true,
+
+			DeadLetterRetryInterval: 30 * time.Second,
+			DeadLetterMaxAttempts:   10,
+			WatchBuffer:             256,
+
+			RequiredAcks: "one",
+			BatchSize:    100,
+			BatchBytes:   1 << 20,
+			BatchTimeout: 10 * time.Millisecond,
+			Compression:  "none",
+			MaxAttempts:  1,
+			PublishMode:  "sync",
+			TopicRouting: "single",
+		},
+		Messaging: MessagingConfig{
+			Backend: MessagingBackendKafka,
+		},
+		NATS: NATSConfig{
+			URL:           "nats://localhost:4222",
+			Stream:        "ORDERS",
+			SubjectPrefix: "orders",
 		},
 		Cache: CacheConfig{
-			DefaultTTL: 5 * time.Minute,
-			HotTTL:     1 * time.Hour,
+			DefaultTTL:      5 * time.Minute,
+			HotTTL:          1 * time.Hour,
+			IdempotencyTTL:  24 * time.Hour,
+			BreakerFailures: 5,
+			BreakerCooldown: 30 * time.Second,
+			ListTTL:         30 * time.Second,
+		},
+		RateLimit: RateLimitConfig{
+			RequestsPerMinute: 1000,
+			Burst:             50,
+		},
+		Pagination: PaginationConfig{
+			MaxPageSize: 100,
+		},
+		Secrets: SecretsConfig{
+			RefreshInterval: 5 * time.Minute,
+		},
+		Startup: StartupConfig{
+			RetryTimeout:   time.Minute,
+			InitialBackoff: 500 * time.Millisecond,
+			MaxBackoff:     10 * time.Second,
+		},
+		Resilience: ResilienceConfig{
+			MaxAttempts:     3,
+			InitialBackoff:  100 * time.Millisecond,
+			MaxBackoff:      time.Second,
+			BreakerFailures: 5,
+			BreakerCooldown: 30 * time.Second,
+		},
+		Outbound: OutboundConfig{
+			Timeout:         10 * time.Second,
+			MaxAttempts:     3,
+			InitialBackoff:  200 * time.Millisecond,
+			MaxBackoff:      5 * time.Second,
+			BreakerFailures: 5,
+			BreakerCooldown: 30 * time.Second,
+		},
+		Chaos: ChaosConfig{
+			Latency: 500 * time.Millisecond,
+		},
+		Retention: RetentionConfig{
+			Interval: time.Hour,
+		},
+		Archive: ArchiveConfig{
+			After:    90 * 24 * time.Hour,
+			Interval: time.Hour,
+		},
+		Tenancy: TenancyConfig{
+			Mode:   TenancyModeNone,
+			Header: "X-Tenant-ID",
+		},
+		Search: SearchConfig{
+			Backend:         SearchBackendPostgres,
+			OpenSearchURL:   "http://localhost:9200",
+			OpenSearchIndex: "orders",
+		},
+		Payments: PaymentsConfig{
+			Currency:  "usd",
+			StripeURL: "https://api.stripe.com",
 		},
-	}, nil
+		Reports: ReportsConfig{
+			RefreshInterval: 15 * time.Minute,
+			StatsTTL:        10 * time.Second,
+		},
+		Partitions: PartitionsConfig{
+			MonthsAhead: 3,
+			Interval:    24 * time.Hour,
+		},
+		Holds: HoldsConfig{
+			ReleaseInterval: time.Minute,
+		},
+		PendingOrders: PendingOrdersConfig{
+			ExpiryInterval: 5 * time.Minute,
+		},
+		TotalCheck: TotalCheckConfig{
+			Interval: 24 * time.Hour,
+		},
+		Delivery: DeliveryConfig{
+			TransitTimes: map[string]time.Duration{
+				"standard": 5 * 24 * time.Hour,
+				"express":  2 * 24 * time.Hour,
+			},
+			DefaultMethod:    "standard",
+			SLACheckInterval: 5 * time.Minute,
+		},
+		OrderLimits: OrderLimitsConfig{
+			MaxItems:        100,
+			MaxItemQuantity: 10000,
+			MaxTotal:        1000000,
+		},
+		Subscriptions: SubscriptionsConfig{
+			Interval: time.Minute,
+		},
+		Jobs: JobsConfig{
+			Backend:      JobsBackendPostgres,
+			RunInServer:  true,
+			PollInterval: time.Second,
+			Lease:        5 * time.Minute,
+			MaxAttempts:  5,
+			RetryBackoff: 10 * time.Second,
+			Retention:    7 * 24 * time.Hour,
+		},
+	}
 }
 
-func getEnv(key, defaultValue string) string {
+// apply overrides cfg with every environment variable that is set
+func (e *envLoader) apply(cfg *Config) {
+	e.str(&cfg.App.Name, "APP_NAME")
+	e.str(&cfg.App.Version, "APP_VERSION")
+	e.str(&cfg.App.Environment, "APP_ENVIRONMENT")
+	e.str(&cfg.App.LogLevel, "APP_LOG_LEVEL")
+	e.str(&cfg.App.LogFormat, "APP_LOG_FORMAT")
+
+	e.int(&cfg.Server.HTTPPort, "HTTP_PORT")
+	e.int(&cfg.Server.GRPCPort, "GRPC_PORT")
+	e.duration(&cfg.Server.ReadTimeout, "HTTP_READ_TIMEOUT")
+	e.duration(&cfg.Server.WriteTimeout, "HTTP_WRITE_TIMEOUT")
+	e.duration(&cfg.Server.ShutdownTimeout, "SHUTDOWN_TIMEOUT")
+	e.bool(&cfg.Server.EnablePprof, "ENABLE_PPROF")
+	e.str(&cfg.Server.PprofAddr, "PPROF_ADDR")
+	e.bool(&cfg.Server.ProblemJSON, "HTTP_PROBLEM_JSON")
+	e.duration(&cfg.Server.WebSocketHeartbeat, "HTTP_WEBSOCKET_HEARTBEAT")
+	e.duration(&cfg.Server.RequestTimeout, "HTTP_REQUEST_TIMEOUT")
+	e.durations(&cfg.Server.RouteTimeouts, "HTTP_ROUTE_TIMEOUTS")
+	e.duration(&cfg.Server.CacheMaxAge, "HTTP_CACHE_MAX_AGE")
+
+	e.str(&cfg.Database.Host, "DATABASE_HOST")
+	e.int(&cfg.Database.Port, "DATABASE_PORT")
+	e.str(&cfg.Database.User, "DATABASE_USER")
+	e.str(&cfg.Database.Password, "DATABASE_PASSWORD")
+	e.str(&cfg.Database.Database, "DATABASE_NAME")
+	e.str(&cfg.Database.SSLMode, "DATABASE_SSL_MODE")
+	e.int(&cfg.Database.MaxOpenConns, "DATABASE_MAX_OPEN_CONNS")
+	e.int(&cfg.Database.MaxIdleConns, "DATABASE_MAX_IDLE_CONNS")
+	e.duration(&cfg.Database.ConnMaxLifetime, "DATABASE_CONN_MAX_LIFETIME")
+	e.duration(&cfg.Database.ConnMaxIdleTime, "DATABASE_CONN_MAX_IDLE_TIME")
+	e.duration(&cfg.Database.QueryTimeout, "DATABASE_QUERY_TIMEOUT")
+	e.duration(&cfg.Database.SlowQueryThreshold, "DATABASE_SLOW_QUERY_THRESHOLD")
+	e.str(&cfg.Database.MigrationsPath, "DATABASE_MIGRATIONS_PATH")
+	e.bool(&cfg.Database.AutoMigrate, "DATABASE_AUTO_MIGRATE")
+	e.str(&cfg.Database.ReplicaDSN, "DATABASE_REPLICA_DSN")
+
+	e.str(&cfg.Redis.Host, "REDIS_HOST")
+	e.int(&cfg.Redis.Port, "REDIS_PORT")
+	e.str(&cfg.Redis.Password, "REDIS_PASSWORD")
+	e.int(&cfg.Redis.DB, "REDIS_DB")
+	e.int(&cfg.Redis.MaxRetries, "REDIS_MAX_RETRIES")
+	e.int(&cfg.Redis.PoolSize, "REDIS_POOL_SIZE")
+	e.duration(&cfg.Redis.PoolTimeout, "REDIS_POOL_TIMEOUT")
+	e.bool(&cfg.Redis.Required, "REDIS_REQUIRED")
+
+	e.list(&cfg.Kafka.Brokers, "KAFKA_BROKERS")
+	e.str(&cfg.Kafka.Topic, "KAFKA_TOPIC")
+	e.str(&cfg.Kafka.GroupID, "KAFKA_GROUP_ID")
+	e.str(&cfg.Kafka.EventFormat, "KAFKA_EVENT_FORMAT")
+	e.str(&cfg.Kafka.SchemaRegistryURL, "KAFKA_SCHEMA_REGISTRY_URL")
+	e.str(&cfg.Kafka.SchemaRegistryUsername, "KAFKA_SCHEMA_REGISTRY_USERNAME")
+	e.str(&cfg.Kafka.SchemaRegistryPassword, "KAFKA_SCHEMA_REGISTRY_PASSWORD")
+	e.str(&cfg.Kafka.SchemaSubjectStrategy, "KAFKA_SCHEMA_SUBJECT_STRATEGY")
+	e.bool(&cfg.Kafka.SchemaAutoRegister, "KAFKA_SCHEMA_AUTO_REGISTER")
+	e.duration(&cfg.Kafka.DeadLetterRetryInterval, "KAFKA_DLQ_RETRY_INTERVAL")
+	e.int(&cfg.Kafka.DeadLetterMaxAttempts, "KAFKA_DLQ_MAX_ATTEMPTS")
+	e.int(&cfg.Kafka.WatchBuffer, "KAFKA_WATCH_BUFFER")
+	e.str(&cfg.Kafka.RequiredAcks, "KAFKA_REQUIRED_ACKS")
+	e.int(&cfg.Kafka.BatchSize, "KAFKA_BATCH_SIZE")
+	e.int(&cfg.Kafka.BatchBytes, "KAFKA_BATCH_BYTES")
+	e.duration(&cfg.Kafka.BatchTimeout, "KAFKA_BATCH_TIMEOUT")
+	e.str(&cfg.Kafka.Compression, "KAFKA_COMPRESSION")
+	e.bool(&cfg.Kafka.Idempotent, "KAFKA_IDEMPOTENT")
+	e.int(&cfg.Kafka.MaxAttempts, "KAFKA_MAX_ATTEMPTS")
+	e.str(&cfg.Kafka.PublishMode, "KAFKA_PUBLISH_MODE")
+	e.str(&cfg.Kafka.TopicRouting, "KAFKA_TOPIC_ROUTING")
+	e.pairs(&cfg.Kafka.EventTopics, "KAFKA_EVENT_TOPICS")
+
+	e.str(&cfg.Messaging.Backend, "MESSAGING_BACKEND")
+	e.bool(&cfg.Messaging.Required, "MESSAGING_REQUIRED")
+
+	e.str(&cfg.NATS.URL, "NATS_URL")
+	e.str(&cfg.NATS.Stream, "NATS_STREAM")
+	e.str(&cfg.NATS.SubjectPrefix, "NATS_SUBJECT_PREFIX")
+
+	e.str(&cfg.SNS.TopicARN, "SNS_TOPIC_ARN")
+	e.str(&cfg.SNS.Endpoint, "SNS_ENDPOINT")
+
+	e.seconds(&cfg.Cache.DefaultTTL, "CACHE_TTL_SECONDS")
+	e.duration(&cfg.Cache.DefaultTTL, "CACHE_DEFAULT_TTL")
+	e.duration(&cfg.Cache.HotTTL, "CACHE_HOT_TTL")
+	e.duration(&cfg.Cache.IdempotencyTTL, "IDEMPOTENCY_TTL")
+	e.int(&cfg.Cache.BreakerFailures, "CACHE_BREAKER_FAILURES")
+	e.duration(&cfg.Cache.BreakerCooldown, "CACHE_BREAKER_COOLDOWN")
+	e.duration(&cfg.Cache.ListTTL, "CACHE_LIST_TTL")
+
+	e.int(&cfg.RateLimit.RequestsPerMinute, "RATE_LIMIT_RPM")
+	e.int(&cfg.RateLimit.WriteRequestsPerMinute, "RATE_LIMIT_WRITE_RPM")
+	e.int(&cfg.RateLimit.Burst, "RATE_LIMIT_BURST")
+	e.rateLimitPolicies(&cfg.RateLimit.Policies, "RATE_LIMIT_POLICIES")
+
+	e.int(&cfg.Pagination.MaxPageSize, "PAGINATION_MAX_PAGE_SIZE")
+	e.bool(&cfg.Pagination.EstimateTotals, "PAGINATION_ESTIMATE_TOTALS")
+
+	e.duration(&cfg.Secrets.RefreshInterval, "SECRETS_REFRESH_INTERVAL")
+	e.str(&cfg.Secrets.VaultAddr, "VAULT_ADDR")
+	e.str(&cfg.Secrets.VaultToken, "VAULT_TOKEN")
+	e.str(&cfg.Secrets.VaultNamespace, "VAULT_NAMESPACE")
+	e.str(&cfg.Secrets.AWSEndpoint, "SECRETS_AWS_ENDPOINT")
+
+	e.duration(&cfg.Startup.RetryTimeout, "STARTUP_RETRY_TIMEOUT")
+	e.duration(&cfg.Startup.InitialBackoff, "STARTUP_INITIAL_BACKOFF")
+	e.duration(&cfg.Startup.MaxBackoff, "STARTUP_MAX_BACKOFF")
+
+	e.int(&cfg.Resilience.MaxAttempts, "RESILIENCE_MAX_ATTEMPTS")
+	e.duration(&cfg.Resilience.InitialBackoff, "RESILIENCE_INITIAL_BACKOFF")
+	e.duration(&cfg.Resilience.MaxBackoff, "RESILIENCE_MAX_BACKOFF")
+	e.int(&cfg.Resilience.BreakerFailures, "RESILIENCE_BREAKER_FAILURES")
+	e.duration(&cfg.Resilience.BreakerCooldown, "RESILIENCE_BREAKER_COOLDOWN")
+
+	e.duration(&cfg.Outbound.Timeout, "OUTBOUND_TIMEOUT")
+	e.int(&cfg.Outbound.MaxAttempts, "OUTBOUND_MAX_ATTEMPTS")
+	e.duration(&cfg.Outbound.InitialBackoff, "OUTBOUND_INITIAL_BACKOFF")
+	e.duration(&cfg.Outbound.MaxBackoff, "OUTBOUND_MAX_BACKOFF")
+	e.int(&cfg.Outbound.BreakerFailures, "OUTBOUND_BREAKER_FAILURES")
+	e.duration(&cfg.Outbound.BreakerCooldown, "OUTBOUND_BREAKER_COOLDOWN")
+
+	e.bool(&cfg.Chaos.Enabled, "CHAOS_ENABLED")
+	e.duration(&cfg.Chaos.Latency, "CHAOS_LATENCY")
+	e.float(&cfg.Chaos.LatencyRate, "CHAOS_LATENCY_RATE")
+	e.float(&cfg.Chaos.ErrorRate, "CHAOS_ERROR_RATE")
+	e.float(&cfg.Chaos.RepositoryErrorRate, "CHAOS_REPOSITORY_ERROR_RATE")
+	e.float(&cfg.Chaos.PublishDropRate, "CHAOS_PUBLISH_DROP_RATE")
+
+	e.str(&cfg.Admin.APIKey, "ADMIN_API_KEY")
+	e.str(&cfg.Auth.JWTSecret, "AUTH_JWT_SECRET")
+	e.str(&cfg.Auth.JWTIssuer, "AUTH_JWT_ISSUER")
+	e.str(&cfg.Audit.Path, "AUDIT_LOG_PATH")
+	e.str(&cfg.Tenancy.Mode, "TENANCY_MODE")
+	e.str(&cfg.Tenancy.Header, "TENANCY_HEADER")
+	e.str(&cfg.Tenancy.BaseDomain, "TENANCY_BASE_DOMAIN")
+
+	e.duration(&cfg.Retention.DeletedOrders, "RETENTION_DELETED_ORDERS")
+	e.duration(&cfg.Retention.CompletedOrders, "RETENTION_COMPLETED_ORDERS")
+	e.duration(&cfg.Retention.Interval, "RETENTION_INTERVAL")
+	e.str(&cfg.Archive.URL, "ARCHIVE_URL")
+	e.duration(&cfg.Archive.After, "ARCHIVE_AFTER")
+	e.duration(&cfg.Archive.Interval, "ARCHIVE_INTERVAL")
+
+	e.str(&cfg.Search.Backend, "SEARCH_BACKEND")
+	e.str(&cfg.Search.OpenSearchURL, "OPENSEARCH_URL")
+	e.str(&cfg.Search.OpenSearchIndex, "OPENSEARCH_INDEX")
+	e.str(&cfg.Search.OpenSearchUsername, "OPENSEARCH_USERNAME")
+	e.str(&cfg.Search.OpenSearchPassword, "OPENSEARCH_PASSWORD")
+	e.str(&cfg.ObjectStore.Endpoint, "OBJECT_STORE_ENDPOINT")
+	e.bool(&cfg.ObjectStore.PathStyle, "OBJECT_STORE_PATH_STYLE")
+
+	e.str(&cfg.Payments.Provider, "PAYMENTS_PROVIDER")
+	e.str(&cfg.Payments.Currency, "PAYMENTS_CURRENCY")
+	e.str(&cfg.Payments.StripeURL, "STRIPE_URL")
+	e.str(&cfg.Payments.StripeSecretKey, "STRIPE_SECRET_KEY")
+	e.str(&cfg.Payments.StripeWebhookSecret, "STRIPE_WEBHOOK_SECRET")
+	e.str(&cfg.Payments.MockWebhookSecret, "PAYMENTS_MOCK_WEBHOOK_SECRET")
+
+	e.bool(&cfg.Reports.UseMaterializedViews, "REPORTS_USE_MATERIALIZED_VIEWS")
+	e.duration(&cfg.Reports.RefreshInterval, "REPORTS_REFRESH_INTERVAL")
+	e.duration(&cfg.Reports.StatsTTL, "REPORTS_STATS_TTL")
+	e.bool(&cfg.Partitions.Maintain, "PARTITIONS_MAINTAIN")
+	e.int(&cfg.Partitions.MonthsAhead, "PARTITIONS_MONTHS_AHEAD")
+	e.duration(&cfg.Partitions.Interval, "PARTITIONS_INTERVAL")
+	e.duration(&cfg.Holds.ReleaseAfter, "HOLDS_RELEASE_AFTER")
+	e.duration(&cfg.Holds.ReleaseInterval, "HOLDS_RELEASE_INTERVAL")
+	e.duration(&cfg.PendingOrders.ExpireAfter, "PENDING_ORDERS_EXPIRE_AFTER")
+	e.duration(&cfg.PendingOrders.ExpiryInterval, "PENDING_ORDERS_EXPIRY_INTERVAL")
+
+	e.duration(&cfg.TotalCheck.Interval, "TOTAL_CHECK_INTERVAL")
+	e.bool(&cfg.TotalCheck.Repair, "TOTAL_CHECK_REPAIR")
+	e.durations(&cfg.Delivery.TransitTimes, "DELIVERY_TRANSIT_TIMES")
+	e.str(&cfg.Delivery.DefaultMethod, "DELIVERY_DEFAULT_METHOD")
+	e.duration(&cfg.Delivery.SLACheckInterval, "DELIVERY_SLA_CHECK_INTERVAL")
+	e.bool(&cfg.Pricing.RequireServerSide, "PRICING_REQUIRE_SERVER_SIDE")
+	e.str(&cfg.Pricing.CatalogURL, "PRICING_CATALOG_URL")
+	e.int(&cfg.OrderLimits.MaxItems, "ORDER_LIMITS_MAX_ITEMS")
+	e.int(&cfg.OrderLimits.MaxItemQuantity, "ORDER_LIMITS_MAX_ITEM_QUANTITY")
+	e.float(&cfg.OrderLimits.MaxTotal, "ORDER_LIMITS_MAX_TOTAL")
+	e.duration(&cfg.Subscriptions.Interval, "SUBSCRIPTIONS_INTERVAL")
+	e.str(&cfg.Jobs.Backend, "JOBS_BACKEND")
+	e.bool(&cfg.Jobs.RunInServer, "JOBS_RUN_IN_SERVER")
+	e.duration(&cfg.Jobs.PollInterval, "JOBS_POLL_INTERVAL")
+	e.duration(&cfg.Jobs.Lease, "JOBS_LEASE")
+	e.int(&cfg.Jobs.MaxAttempts, "JOBS_MAX_ATTEMPTS")
+	e.duration(&cfg.Jobs.RetryBackoff, "JOBS_RETRY_BACKOFF")
+	e.duration(&cfg.Jobs.Retention, "JOBS_RETENTION")
+}
+
+// envLoader overrides config fields from set environment variables and
+// collects the variables whose values cannot be parsed
+type envLoader struct {
+	errs []error
+}
+
+func (e *envLoader) fail(key, value, kind string, err error) {
+	e.errs = append(e.errs, fmt.Errorf("%s=%q is not a valid %s: %w", key, value, kind, err))
+}
+
+func (e *envLoader) str(dst *string, key string) {
+	if value := os.Getenv(key); value != "" {
+		*dst = value
+	}
+}
+
+// list splits a comma-separated variable, e.g. KAFKA_BROKERS=b1:9092,b2:9092
+func (e *envLoader) list(dst *[]string, key string) {
+	value := os.Getenv(key)
+	if value == "" {
+		return
+	}
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	*dst = items
+}
+
+func (e *envLoader) int(dst *int, key string) {
+	if value := os.Getenv(key); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			e.fail(key, value, "integer", err)
+			return
+		}
+		*dst = n
+	}
+}
+
+func (e *envLoader) bool(dst *bool, key string) {
+	if value := os.Getenv(key); value != "" {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			e.fail(key, value, "boolean", err)
+			return
+		}
+		*dst = b
+	}
+}
+
+func (e *envLoader) float(dst *float64, key string) {
+	if value := os.Getenv(key); value != "" {
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			e.fail(key, value, "number", err)
+			return
+		}
+		*dst = f
+	}
+}
+
+func (e *envLoader) duration(dst *time.Duration, key string) {
 	if value := os.Getenv(key); value != "" {
-		return value
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			e.fail(key, value, "duration", err)
+			return
+		}
+		*dst = d
+	}
+}
+
+// durations reads comma-delimited name=duration pairs, e.g.
+// DELIVERY_TRANSIT_TIMES=standard=120h,express=48h
+func (e *envLoader) durations(dst *map[string]time.Duration, key string) {
+	value := os.Getenv(key)
+	if value == "" {
+		return
+	}
+	m := make(map[string]time.Duration)
+	for _, pair := range strings.Split(value, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		name, raw, ok := strings.Cut(pair, "=")
+		if !ok {
+			e.fail(key, value, "list of name=duration pairs", fmt.Errorf("%q has no '='", pair))
+			return
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(raw))
+		if err != nil {
+			e.fail(key, value, "list of name=duration pairs", err)
+			return
+		}
+		m[strings.TrimSpace(name)] = d
+	}
+	*dst = m
+}
+
+// pairs reads comma-delimited name=value pairs, e.g.
+// KAFKA_EVENT_TOPICS=order.created=orders-created,order.deleted=orders-deleted
+func (e *envLoader) pairs(dst *map[string]string, key string) {
+	value := os.Getenv(key)
+	if value == "" {
+		return
+	}
+	m := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		name, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			e.fail(key, value, "list of name=value pairs", fmt.Errorf("%q has no '='", pair))
+			return
+		}
+		m[strings.TrimSpace(name)] = strings.TrimSpace(v)
+	}
+	*dst = m
+}
+
+// rateLimitPolicies reads comma-delimited subject=rpm/write_rpm/burst
+// policies, e.g. RATE_LIMIT_POLICIES=tenant:acme=600/100/20,customer:*=120/0/10
+func (e *envLoader) rateLimitPolicies(dst *[]RateLimitPolicyConfig, key string) {
+	value := os.Getenv(key)
+	if value == "" {
+		return
+	}
+	const kind = "list of tenant:<id>=rpm/write_rpm/burst or customer:<id>=rpm/write_rpm/burst policies"
+	var policies []RateLimitPolicyConfig
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		subject, limits, ok := strings.Cut(entry, "=")
+		if !ok {
+			e.fail(key, value, kind, fmt.Errorf("%q has no '='", entry))
+			return
+		}
+		var p RateLimitPolicyConfig
+		switch field, id, _ := strings.Cut(strings.TrimSpace(subject), ":"); field {
+		case "tenant":
+			p.Tenant = id
+		case "customer":
+			p.CustomerID = id
+		default:
+			e.fail(key, value, kind, fmt.Errorf("%q names neither a tenant nor a customer", entry))
+			return
+		}
+		numbers := strings.Split(limits, "/")
+		if len(numbers) != 3 {
+			e.fail(key, value, kind, fmt.Errorf("%q does not have three limits", entry))
+			return
+		}
+		for i, dst := range []*int{&p.RequestsPerMinute, &p.WriteRequestsPerMinute, &p.Burst} {
+			n, err := strconv.Atoi(strings.TrimSpace(numbers[i]))
+			if err != nil {
+				e.fail(key, value, kind, err)
+				return
+			}
+			*dst = n
+		}
+		policies = append(policies, p)
 	}
-	return defaultValue
+	*dst = policies
 }
 
-func getEnvAsInt(key string, defaultValue int) int {
+// seconds reads a whole number of seconds, e.g. CACHE_TTL_SECONDS=300
+func (e *envLoader) seconds(dst *time.Duration, key string) {
 	if value := os.Getenv(key); value != "" {
-		if intValue, err := strconv.Atoi(value); err == nil {
-			return intValue
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			e.fail(key, value, "number of seconds", err)
+			return
 		}
+		*dst = time.Duration(n) * time.Second
 	}
-	return defaultValue
 }
@@ -0,0 +1,201 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoad_NoFile_UsesDefaults(t *testing.T) {
+	cfg, err := Load("")
+
+	require.NoError(t, err)
+	assert.Equal(t, defaults(), cfg)
+}
+
+func TestLoad_ExampleFile_MatchesDefaults(t *testing.T) {
+	cfg, err := Load("../../config.example.yaml")
+
+	require.NoError(t, err)
+	assert.Equal(t, defaults(), cfg, "config.example.yaml should document the built-in defaults")
+}
+
+func TestLoad_FileThenEnv_EnvWins(t *testing.T) {
+	path := writeConfigFile(t, `
+server:
+  http_port: 8081
+  read_timeout: 3s
+database:
+  host: db.internal
+kafka:
+  brokers: [k1:9092, k2:9092]
+`)
+	t.Setenv("DATABASE_HOST", "db.override")
+	t.Setenv("REDIS_POOL_SIZE", "50")
+
+	cfg, err := Load(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, 8081, cfg.Server.HTTPPort)
+	assert.Equal(t, 3*time.Second, cfg.Server.ReadTimeout)
+	assert.Equal(t, 10*time.Second, cfg.Server.WriteTimeout, "keys missing from the file keep their default")
+	assert.Equal(t, "db.override", cfg.Database.Host)
+	assert.Equal(t, 50, cfg.Redis.PoolSize)
+	assert.Equal(t, []string{"k1:9092", "k2:9092"}, cfg.Kafka.Brokers)
+}
+
+func TestLoad_KafkaBrokersEnv_SplitsOnComma(t *testing.T) {
+	t.Setenv("KAFKA_BROKERS", "k1:9092, k2:9092")
+
+	cfg, err := Load("")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"k1:9092", "k2:9092"}, cfg.Kafka.Brokers)
+}
+
+func TestLoad_DeliveryTransitTimesEnv_ParsesPairs(t *testing.T) {
+	t.Setenv("DELIVERY_TRANSIT_TIMES", "overnight=24h, freight=240h")
+
+	cfg, err := Load("")
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]time.Duration{"overnight": 24 * time.Hour, "freight": 240 * time.Hour}, cfg.Delivery.TransitTimes)
+}
+
+func TestLoad_KafkaEventTopicsEnv_ParsesPairs(t *testing.T) {
+	t.Setenv("KAFKA_TOPIC_ROUTING", "event_type")
+	t.Setenv("KAFKA_EVENT_TOPICS", "order.created=orders-created, order.deleted=orders-deleted")
+
+	cfg, err := Load("")
+
+	require.NoError(t, err)
+	assert.Equal(t, "event_type", cfg.Kafka.TopicRouting)
+	assert.Equal(t, map[string]string{"order.created": "orders-created", "order.deleted": "orders-deleted"}, cfg.Kafka.EventTopics)
+}
+
+func TestLoad_RateLimitPoliciesEnv_ParsesPolicies(t *testing.T) {
+	t.Setenv("RATE_LIMIT_POLICIES", "tenant:acme=600/100/20, customer:*=120/0/10")
+
+	cfg, err := Load("")
+
+	require.NoError(t, err)
+	assert.Equal(t, []RateLimitPolicyConfig{
+		{Tenant: "acme", RequestsPerMinute: 600, WriteRequestsPerMinute: 100, Burst: 20},
+		{CustomerID: "*", RequestsPerMinute: 120, Burst: 10},
+	}, cfg.RateLimit.Policies)
+}
+
+func TestLoad_RateLimitPoliciesEnv_RejectsUnknownSubject(t *testing.T) {
+	t.Setenv("RATE_LIMIT_POLICIES", "apikey:abc=600/100/20")
+
+	_, err := Load("")
+
+	assert.ErrorContains(t, err, "RATE_LIMIT_POLICIES")
+}
+
+func TestLoad_FileTransitTimes_ReplaceDefaults(t *testing.T) {
+	path := writeConfigFile(t, `
+delivery:
+  transit_times:
+    overnight: 24h
+  default_method: overnight
+`)
+
+	cfg, err := Load(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]time.Duration{"overnight": 24 * time.Hour}, cfg.Delivery.TransitTimes)
+	assert.Equal(t, 5*time.Minute, cfg.Delivery.SLACheckInterval)
+}
+
+func TestLoad_CacheTTLSeconds_SetsDefaultTTL(t *testing.T) {
+	t.Setenv("CACHE_TTL_SECONDS", "30")
+
+	cfg, err := Load("")
+
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, cfg.Cache.DefaultTTL)
+}
+
+func TestDatabaseConfig_DSN_EscapesCredentials(t *testing.T) {
+	db := defaults().Database
+	db.Password = "p@ss/w:rd#1"
+
+	dsn, err := url.Parse(db.DSN())
+
+	require.NoError(t, err)
+	password, _ := dsn.User.Password()
+	assert.Equal(t, "p@ss/w:rd#1", password)
+	assert.Equal(t, "localhost:5432", dsn.Host)
+	assert.Equal(t, "/ordersvc", dsn.Path)
+	assert.Equal(t, "disable", dsn.Query().Get("sslmode"))
+}
+
+func TestLoad_InvalidFile_ReturnsError(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "unknown key", content: "server:\n  http_prot: 8081\n", wantErr: "field http_prot not found"},
+		{name: "wrong type", content: "server:\n  http_port: eighty\n", wantErr: "line 2"},
+		{name: "bad duration", content: "cache:\n  hot_ttl: soon\n", wantErr: "line 2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(writeConfigFile(t, tt.content))
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "invalid config file")
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestLoad_MissingFile_ReturnsError(t *testing.T) {
+	_, err := Load(filepath.Join(t.TempDir(), "missing.yaml"))
+
+	assert.ErrorContains(t, err, "failed to open config file")
+}
+
+func TestLoad_InvalidEnv_ReportsEveryVariable(t *testing.T) {
+	t.Setenv("HTTP_PORT", "http")
+	t.Setenv("DATABASE_AUTO_MIGRATE", "maybe")
+	t.Setenv("IDEMPOTENCY_TTL", "1day")
+	t.Setenv("DELIVERY_TRANSIT_TIMES", "standard")
+
+	_, err := Load("")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `HTTP_PORT="http" is not a valid integer`)
+	assert.Contains(t, err.Error(), `DATABASE_AUTO_MIGRATE="maybe" is not a valid boolean`)
+	assert.Contains(t, err.Error(), `IDEMPOTENCY_TTL="1day" is not a valid duration`)
+	assert.Contains(t, err.Error(), `DELIVERY_TRANSIT_TIMES="standard" is not a valid list of name=duration pairs`)
+}
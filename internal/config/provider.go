@@ -0,0 +1,123 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Provider holds the running configuration. Reload re-reads the config file
+// and environment and applies the settings that are safe to change while
+// serving: the log level, cache TTLs, rate limits and the page size cap.
+// Every other setting keeps its startup value until the process restarts.
+type Provider struct {
+	path    string
+	current atomic.Pointer[Config]
+
+	// mu serializes reloads and listener registration
+	mu        sync.Mutex
+	listeners []func(*Config)
+}
+
+// NewProvider returns a Provider serving cfg, reloading from the file at
+// path (or from the environment alone if path is empty).
+func NewProvider(path string, cfg *Config) *Provider {
+	p := &Provider{path: path}
+	p.current.Store(cfg)
+	return p
+}
+
+// Current returns the configuration in effect. Callers must not modify it;
+// a reload replaces it rather than changing it in place.
+func (p *Provider) Current() *Config {
+	return p.current.Load()
+}
+
+// OnReload registers fn to be called with the new configuration after each
+// reload that changes a setting.
+func (p *Provider) OnReload(fn func(*Config)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.listeners = append(p.listeners, fn)
+}
+
+// Reload loads the configuration again and swaps in its reloadable settings.
+// It returns the keys it applied and the changed keys it ignored because they
+// need a restart. If the file or environment cannot be loaded, or the
+// reloadable settings are invalid, the running configuration is kept.
+func (p *Provider) Reload() (applied, ignored []string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	fresh, err := Load(p.path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cur := p.current.Load()
+	next := *cur
+	next.App.LogLevel = fresh.App.LogLevel
+	next.Cache.DefaultTTL = fresh.Cache.DefaultTTL
+	next.Cache.HotTTL = fresh.Cache.HotTTL
+	next.Cache.ListTTL = fresh.Cache.ListTTL
+	next.RateLimit = fresh.RateLimit
+	next.Pagination = fresh.Pagination
+	next.Holds.ReleaseAfter = fresh.Holds.ReleaseAfter
+	next.Delivery.TransitTimes = fresh.Delivery.TransitTimes
+	next.Delivery.DefaultMethod = fresh.Delivery.DefaultMethod
+	next.Pricing.RequireServerSide = fresh.Pricing.RequireServerSide
+	next.OrderLimits = fresh.OrderLimits
+	if err := next.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("reload rejected: %w", err)
+	}
+
+	// The version and auto-migrate flag are set at startup, not read from the file
+	fresh.App.Version = cur.App.Version
+	fresh.Database.AutoMigrate = cur.Database.AutoMigrate
+
+	applied = changedKeys(reflect.ValueOf(*cur), reflect.ValueOf(next), "")
+	ignored = changedKeys(reflect.ValueOf(next), reflect.ValueOf(*fresh), "")
+	if len(applied) == 0 {
+		return nil, ignored, nil
+	}
+
+	p.current.Store(&next)
+	for _, fn := range p.listeners {
+		fn(&next)
+	}
+	return applied, ignored, nil
+}
+
+// changedKeys lists the YAML keys, e.g. "cache.default_ttl", whose values
+// differ between the config structs a and b.
+func changedKeys(a, b reflect.Value, prefix string) []string {
+	var keys []string
+	for i := range a.NumField() {
+		field := a.Type().Field(i)
+		key := prefix + strings.Split(field.Tag.Get("yaml"), ",")[0]
+		if field.Type.Kind() == reflect.Struct {
+			keys = append(keys, changedKeys(a.Field(i), b.Field(i), key+".")...)
+			continue
+		}
+		if !reflect.DeepEqual(a.Field(i).Interface(), b.Field(i).Interface()) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
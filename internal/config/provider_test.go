@@ -0,0 +1,105 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvider_Reload_AppliesTunablesAndIgnoresTheRest(t *testing.T) {
+	path := writeConfigFile(t, "app:\n  log_level: info\n")
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	cfg.App.Version = "1.2.3"
+	p := NewProvider(path, cfg)
+
+	var notified *Config
+	p.OnReload(func(c *Config) { notified = c })
+
+	require.NoError(t, os.WriteFile(path, []byte(`
+app:
+  log_level: debug
+server:
+  http_port: 8081
+cache:
+  default_ttl: 1m
+pagination:
+  max_page_size: 50
+`), 0o600))
+
+	applied, ignored, err := p.Reload()
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"app.log_level", "cache.default_ttl", "pagination.max_page_size"}, applied)
+	assert.Equal(t, []string{"server.http_port"}, ignored)
+
+	current := p.Current()
+	assert.Equal(t, "debug", current.App.LogLevel)
+	assert.Equal(t, time.Minute, current.Cache.DefaultTTL)
+	assert.Equal(t, 50, current.Pagination.MaxPageSize)
+	assert.Equal(t, 8080, current.Server.HTTPPort, "the port needs a restart")
+	assert.Equal(t, "1.2.3", current.App.Version)
+	assert.Same(t, current, notified)
+	assert.Equal(t, "info", cfg.App.LogLevel, "the startup config is not modified")
+}
+
+func TestProvider_Reload_InvalidTunable_KeepsRunningConfig(t *testing.T) {
+	path := writeConfigFile(t, "")
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	p := NewProvider(path, cfg)
+	p.OnReload(func(*Config) { t.Fatal("listener must not run for a rejected reload") })
+
+	require.NoError(t, os.WriteFile(path, []byte("pagination:\n  max_page_size: 0\n"), 0o600))
+
+	_, _, err = p.Reload()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pagination.max_page_size")
+	assert.Same(t, cfg, p.Current())
+}
+
+func TestProvider_Reload_BrokenFile_KeepsRunningConfig(t *testing.T) {
+	path := writeConfigFile(t, "")
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	p := NewProvider(path, cfg)
+
+	require.NoError(t, os.WriteFile(path, []byte("cache: [\n"), 0o600))
+
+	_, _, err = p.Reload()
+
+	assert.ErrorContains(t, err, "invalid config file")
+	assert.Same(t, cfg, p.Current())
+}
+
+func TestProvider_Reload_Unchanged_DoesNotNotify(t *testing.T) {
+	cfg, err := Load("")
+	require.NoError(t, err)
+	p := NewProvider("", cfg)
+	p.OnReload(func(*Config) { t.Fatal("listener must not run when nothing changed") })
+
+	applied, ignored, err := p.Reload()
+
+	require.NoError(t, err)
+	assert.Empty(t, applied)
+	assert.Empty(t, ignored)
+	assert.Same(t, cfg, p.Current())
+}
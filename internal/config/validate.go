@@ -0,0 +1,432 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// kafkaTopicRe matches the characters Kafka accepts in topic names
+var kafkaTopicRe = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+// currencyRe matches lowercase ISO 4217 currency codes, as payment providers take them
+var currencyRe = regexp.MustCompile(`^[a-z]{3}$`)
+
+// maxKafkaTopicLength is Kafka's limit on topic name length
+const maxKafkaTopicLength = 249
+
+// maxPageSize is the largest page the list and search APIs return
+const maxPageSize = 100
+
+// EnvironmentProduction is the AppConfig.Environment that requires real credentials
+const EnvironmentProduction = "production"
+
+// Validate checks the configuration for values that would fail, or misbehave,
+// only once the service is running. It reports every problem at once, each
+// naming the config file key and environment variable to fix.
+func (c *Config) Validate() error {
+	v := &validator{}
+
+	v.check(slices.Contains([]string{"debug", "info", "warn", "error"}, c.App.LogLevel),
+		"app.log_level", "APP_LOG_LEVEL", "must be debug, info, warn or error, got %q", c.App.LogLevel)
+	v.check(c.App.LogFormat == "json" || c.App.LogFormat == "text",
+		"app.log_format", "APP_LOG_FORMAT", "must be json or text, got %q", c.App.LogFormat)
+
+	v.port(c.Server.HTTPPort, "server.http_port", "HTTP_PORT")
+	v.port(c.Server.GRPCPort, "server.grpc_port", "GRPC_PORT")
+	v.check(c.Server.HTTPPort != c.Server.GRPCPort,
+		"server.grpc_port", "GRPC_PORT", "must differ from the HTTP port %d", c.Server.HTTPPort)
+	v.positive(c.Server.ReadTimeout, "server.read_timeout", "HTTP_READ_TIMEOUT")
+	v.positive(c.Server.WriteTimeout, "server.write_timeout", "HTTP_WRITE_TIMEOUT")
+	v.positive(c.Server.ShutdownTimeout, "server.shutdown_timeout", "SHUTDOWN_TIMEOUT")
+	v.positive(c.Server.WebSocketHeartbeat, "server.websocket_heartbeat", "HTTP_WEBSOCKET_HEARTBEAT")
+	v.check(c.Server.RequestTimeout >= 0 && c.Server.RequestTimeout < c.Server.WriteTimeout,
+		"server.request_timeout", "HTTP_REQUEST_TIMEOUT", "must be between 0 and write_timeout (%s), got %s",
+		c.Server.WriteTimeout, c.Server.RequestTimeout)
+	for _, prefix := range slices.Sorted(maps.Keys(c.Server.RouteTimeouts)) {
+		d := c.Server.RouteTimeouts[prefix]
+		v.check(strings.HasPrefix(prefix, "/"),
+			"server.route_timeouts", "HTTP_ROUTE_TIMEOUTS", "path prefix %q must start with /", prefix)
+		v.check(d >= 0 && d < c.Server.WriteTimeout,
+			"server.route_timeouts", "HTTP_ROUTE_TIMEOUTS", "timeout of %s must be between 0 and write_timeout (%s), got %s",
+			prefix, c.Server.WriteTimeout, d)
+	}
+	v.check(c.Server.CacheMaxAge >= 0,
+		"server.cache_max_age", "HTTP_CACHE_MAX_AGE", "must not be negative, got %s", c.Server.CacheMaxAge)
+	if c.Server.EnablePprof {
+		v.pprofAddr(c.Server)
+	}
+
+	v.required(c.Database.Host, "database.host", "DATABASE_HOST")
+	v.port(c.Database.Port, "database.port", "DATABASE_PORT")
+	v.required(c.Database.User, "database.user", "DATABASE_USER")
+	v.required(c.Database.Database, "database.name", "DATABASE_NAME")
+	if c.App.Environment == EnvironmentProduction {
+		v.required(c.Database.Password, "database.password", "DATABASE_PASSWORD")
+	}
+	v.check(c.Database.MaxOpenConns >= 1,
+		"database.max_open_conns", "DATABASE_MAX_OPEN_CONNS", "must be at least 1, got %d", c.Database.MaxOpenConns)
+	v.check(c.Database.MaxIdleConns >= 0 && c.Database.MaxIdleConns <= c.Database.MaxOpenConns,
+		"database.max_idle_conns", "DATABASE_MAX_IDLE_CONNS", "must be between 0 and max_open_conns (%d), got %d",
+		c.Database.MaxOpenConns, c.Database.MaxIdleConns)
+	v.check(c.Database.QueryTimeout >= 0 && c.Database.QueryTimeout < c.Server.WriteTimeout,
+		"database.query_timeout", "DATABASE_QUERY_TIMEOUT", "must be between 0 and write_timeout (%s), got %s",
+		c.Server.WriteTimeout, c.Database.QueryTimeout)
+	v.check(c.Database.SlowQueryThreshold >= 0,
+		"database.slow_query_threshold", "DATABASE_SLOW_QUERY_THRESHOLD", "must not be negative, got %s", c.Database.SlowQueryThreshold)
+
+	v.required(c.Redis.Host, "redis.host", "REDIS_HOST")
+	v.port(c.Redis.Port, "redis.port", "REDIS_PORT")
+	v.check(c.Redis.DB >= 0, "redis.db", "REDIS_DB", "must not be negative, got %d", c.Redis.DB)
+
+	v.positive(c.Cache.DefaultTTL, "cache.default_ttl", "CACHE_DEFAULT_TTL")
+	v.positive(c.Cache.HotTTL, "cache.hot_ttl", "CACHE_HOT_TTL")
+	v.check(c.Cache.HotTTL >= c.Cache.DefaultTTL,
+		"cache.hot_ttl", "CACHE_HOT_TTL", "must not be shorter than the default TTL %s", c.Cache.DefaultTTL)
+	v.check(c.Cache.IdempotencyTTL >= time.Minute,
+		"cache.idempotency_ttl", "IDEMPOTENCY_TTL", "must be at least 1m so retries can be replayed, got %s", c.Cache.IdempotencyTTL)
+	v.check(c.Cache.BreakerFailures >= 1,
+		"cache.breaker_failures", "CACHE_BREAKER_FAILURES", "must be at least 1, got %d", c.Cache.BreakerFailures)
+	v.positive(c.Cache.BreakerCooldown, "cache.breaker_cooldown", "CACHE_BREAKER_COOLDOWN")
+	v.check(c.Cache.ListTTL >= 0,
+		"cache.list_ttl", "CACHE_LIST_TTL", "must not be negative, got %s", c.Cache.ListTTL)
+
+	v.check(c.RateLimit.RequestsPerMinute >= 0,
+		"rate_limit.requests_per_minute", "RATE_LIMIT_RPM", "must not be negative, got %d", c.RateLimit.RequestsPerMinute)
+	if c.RateLimit.RequestsPerMinute > 0 {
+		v.check(c.RateLimit.Burst >= 1,
+			"rate_limit.burst", "RATE_LIMIT_BURST", "must be at least 1, got %d", c.RateLimit.Burst)
+	}
+	v.check(c.RateLimit.WriteRequestsPerMinute >= 0,
+		"rate_limit.write_requests_per_minute", "RATE_LIMIT_WRITE_RPM", "must not be negative, got %d", c.RateLimit.WriteRequestsPerMinute)
+	for i, p := range c.RateLimit.Policies {
+		v.check((p.Tenant == "") != (p.CustomerID == ""),
+			"rate_limit.policies", "RATE_LIMIT_POLICIES", "policy %d must name either a tenant or a customer", i+1)
+		v.check(p.RequestsPerMinute >= 1 && p.WriteRequestsPerMinute >= 0 && p.Burst >= 1,
+			"rate_limit.policies", "RATE_LIMIT_POLICIES", "policy %d needs requests per minute and burst of at least 1, got %d/%d/%d",
+			i+1, p.RequestsPerMinute, p.WriteRequestsPerMinute, p.Burst)
+	}
+	v.check(c.Pagination.MaxPageSize >= 1 && c.Pagination.MaxPageSize <= maxPageSize,
+		"pagination.max_page_size", "PAGINATION_MAX_PAGE_SIZE", "must be between 1 and %d, got %d", maxPageSize, c.Pagination.MaxPageSize)
+
+	v.positive(c.Secrets.RefreshInterval, "secrets.refresh_interval", "SECRETS_REFRESH_INTERVAL")
+	if c.Secrets.VaultAddr != "" {
+		v.required(c.Secrets.VaultToken, "secrets.vault_token", "VAULT_TOKEN")
+	}
+
+	v.check(c.Startup.RetryTimeout >= 0,
+		"startup.retry_timeout", "STARTUP_RETRY_TIMEOUT", "must not be negative, got %s", c.Startup.RetryTimeout)
+	v.positive(c.Startup.InitialBackoff, "startup.initial_backoff", "STARTUP_INITIAL_BACKOFF")
+	v.check(c.Startup.MaxBackoff >= c.Startup.InitialBackoff,
+		"startup.max_backoff", "STARTUP_MAX_BACKOFF", "must not be below the initial backoff %s, got %s", c.Startup.InitialBackoff, c.Startup.MaxBackoff)
+
+	v.check(c.Resilience.MaxAttempts >= 1,
+		"resilience.max_attempts", "RESILIENCE_MAX_ATTEMPTS", "must be at least 1, got %d", c.Resilience.MaxAttempts)
+	v.positive(c.Resilience.InitialBackoff, "resilience.initial_backoff", "RESILIENCE_INITIAL_BACKOFF")
+	v.check(c.Resilience.MaxBackoff >= c.Resilience.InitialBackoff,
+		"resilience.max_backoff", "RESILIENCE_MAX_BACKOFF", "must not be below the initial backoff %s, got %s", c.Resilience.InitialBackoff, c.Resilience.MaxBackoff)
+	v.check(c.Resilience.BreakerFailures >= 1,
+		"resilience.breaker_failures", "RESILIENCE_BREAKER_FAILURES", "must be at least 1, got %d", c.Resilience.BreakerFailures)
+	v.positive(c.Resilience.BreakerCooldown, "resilience.breaker_cooldown", "RESILIENCE_BREAKER_COOLDOWN")
+
+	v.positive(c.Outbound.Timeout, "outbound.timeout", "OUTBOUND_TIMEOUT")
+	v.check(c.Outbound.MaxAttempts >= 1,
+		"outbound.max_attempts", "OUTBOUND_MAX_ATTEMPTS", "must be at least 1, got %d", c.Outbound.MaxAttempts)
+	v.positive(c.Outbound.InitialBackoff, "outbound.initial_backoff", "OUTBOUND_INITIAL_BACKOFF")
+	v.check(c.Outbound.MaxBackoff >= c.Outbound.InitialBackoff,
+		"outbound.max_backoff", "OUTBOUND_MAX_BACKOFF", "must not be below the initial backoff %s, got %s", c.Outbound.InitialBackoff, c.Outbound.MaxBackoff)
+	v.check(c.Outbound.BreakerFailures >= 1,
+		"outbound.breaker_failures", "OUTBOUND_BREAKER_FAILURES", "must be at least 1, got %d", c.Outbound.BreakerFailures)
+	v.positive(c.Outbound.BreakerCooldown, "outbound.breaker_cooldown", "OUTBOUND_BREAKER_COOLDOWN")
+
+	v.check(c.Kafka.WatchBuffer >= 1,
+		"kafka.watch_buffer", "KAFKA_WATCH_BUFFER", "must be at least 1, got %d", c.Kafka.WatchBuffer)
+
+	v.check(slices.Contains([]string{MessagingBackendKafka, MessagingBackendNATS, MessagingBackendSNS, MessagingBackendNone}, c.Messaging.Backend),
+		"messaging.backend", "MESSAGING_BACKEND", "must be kafka, nats, sns or none, got %q", c.Messaging.Backend)
+	if c.Messaging.Backend != MessagingBackendNone {
+		// The event format and dead-letter settings apply to every publisher
+		v.check(slices.Contains([]string{"cloudevents", "legacy", "avro", "protobuf"}, c.Kafka.EventFormat),
+			"kafka.event_format", "KAFKA_EVENT_FORMAT", "must be cloudevents, legacy, avro or protobuf, got %q", c.Kafka.EventFormat)
+		if c.Kafka.EventFormat == "avro" || c.Kafka.EventFormat == "protobuf" {
+			// The schema registry formats are framed for Kafka consumers
+			v.check(c.Messaging.Backend == MessagingBackendKafka,
+				"kafka.event_format", "KAFKA_EVENT_FORMAT", "%s needs the kafka messaging backend, got %q", c.Kafka.EventFormat, c.Messaging.Backend)
+			v.required(c.Kafka.SchemaRegistryURL, "kafka.schema_registry_url", "KAFKA_SCHEMA_REGISTRY_URL")
+			v.check(slices.Contains([]string{"topic_name", "record_name", "topic_record_name"}, c.Kafka.SchemaSubjectStrategy),
+				"kafka.schema_subject_strategy", "KAFKA_SCHEMA_SUBJECT_STRATEGY", "must be topic_name, record_name or topic_record_name, got %q", c.Kafka.SchemaSubjectStrategy)
+		}
+		v.positive(c.Kafka.DeadLetterRetryInterval, "kafka.dead_letter_retry_interval", "KAFKA_DLQ_RETRY_INTERVAL")
+		v.check(c.Kafka.DeadLetterMaxAttempts >= 1,
+			"kafka.dead_letter_max_attempts", "KAFKA_DLQ_MAX_ATTEMPTS", "must be at least 1, got %d", c.Kafka.DeadLetterMaxAttempts)
+	}
+	switch c.Messaging.Backend {
+	case MessagingBackendKafka:
+		v.kafka(c.Kafka)
+	case MessagingBackendNATS:
+		v.required(c.NATS.URL, "nats.url", "NATS_URL")
+		v.required(c.NATS.Stream, "nats.stream", "NATS_STREAM")
+		v.required(c.NATS.SubjectPrefix, "nats.subject_prefix", "NATS_SUBJECT_PREFIX")
+	case MessagingBackendSNS:
+		v.required(c.SNS.TopicARN, "sns.topic_arn", "SNS_TOPIC_ARN")
+	}
+
+	v.check(c.Retention.DeletedOrders >= 0,
+		"retention.deleted_orders", "RETENTION_DELETED_ORDERS", "must not be negative, got %s", c.Retention.DeletedOrders)
+	v.check(c.Retention.CompletedOrders >= 0,
+		"retention.completed_orders", "RETENTION_COMPLETED_ORDERS", "must not be negative, got %s", c.Retention.CompletedOrders)
+	if c.Retention.DeletedOrders > 0 || c.Retention.CompletedOrders > 0 {
+		v.positive(c.Retention.Interval, "retention.interval", "RETENTION_INTERVAL")
+	}
+
+	if c.Archive.URL != "" {
+		v.positive(c.Archive.After, "archive.after", "ARCHIVE_AFTER")
+		v.positive(c.Archive.Interval, "archive.interval", "ARCHIVE_INTERVAL")
+		// Otherwise the purge deletes finished orders before they are archived
+		v.check(c.Retention.CompletedOrders == 0 || c.Retention.CompletedOrders > c.Archive.After,
+			"retention.completed_orders", "RETENTION_COMPLETED_ORDERS", "must be longer than archive.after (%s) when archiving, got %s",
+			c.Archive.After, c.Retention.CompletedOrders)
+	}
+
+	v.positive(c.Reports.StatsTTL, "reports.stats_ttl", "REPORTS_STATS_TTL")
+	if c.Reports.UseMaterializedViews {
+		v.positive(c.Reports.RefreshInterval, "reports.refresh_interval", "REPORTS_REFRESH_INTERVAL")
+	}
+
+	if c.Partitions.Maintain {
+		v.check(c.Partitions.MonthsAhead >= 1,
+			"partitions.months_ahead", "PARTITIONS_MONTHS_AHEAD", "must be at least 1, got %d", c.Partitions.MonthsAhead)
+		v.positive(c.Partitions.Interval, "partitions.interval", "PARTITIONS_INTERVAL")
+	}
+
+	v.check(c.Holds.ReleaseAfter >= 0,
+		"holds.release_after", "HOLDS_RELEASE_AFTER", "must not be negative, got %s", c.Holds.ReleaseAfter)
+	v.positive(c.Holds.ReleaseInterval, "holds.release_interval", "HOLDS_RELEASE_INTERVAL")
+
+	v.check(c.PendingOrders.ExpireAfter >= 0,
+		"pending_orders.expire_after", "PENDING_ORDERS_EXPIRE_AFTER", "must not be negative, got %s", c.PendingOrders.ExpireAfter)
+	if c.PendingOrders.ExpireAfter > 0 {
+		v.positive(c.PendingOrders.ExpiryInterval, "pending_orders.expiry_interval", "PENDING_ORDERS_EXPIRY_INTERVAL")
+	}
+
+	v.check(c.TotalCheck.Interval >= 0,
+		"total_check.interval", "TOTAL_CHECK_INTERVAL", "must not be negative, got %s", c.TotalCheck.Interval)
+
+	v.check(len(c.Delivery.TransitTimes) > 0,
+		"delivery.transit_times", "DELIVERY_TRANSIT_TIMES", "must list at least one shipping method")
+	for method, transit := range c.Delivery.TransitTimes {
+		v.check(method != "" && transit > 0,
+			"delivery.transit_times", "DELIVERY_TRANSIT_TIMES", "must map non-empty method names to positive durations, got %q=%s", method, transit)
+	}
+	_, known := c.Delivery.TransitTimes[c.Delivery.DefaultMethod]
+	v.check(known,
+		"delivery.default_method", "DELIVERY_DEFAULT_METHOD", "must be one of delivery.transit_times, got %q", c.Delivery.DefaultMethod)
+	v.positive(c.Delivery.SLACheckInterval, "delivery.sla_check_interval", "DELIVERY_SLA_CHECK_INTERVAL")
+
+	v.check(c.OrderLimits.MaxItems >= 0,
+		"order_limits.max_items", "ORDER_LIMITS_MAX_ITEMS", "must not be negative, got %d", c.OrderLimits.MaxItems)
+	v.check(c.OrderLimits.MaxItemQuantity >= 0,
+		"order_limits.max_item_quantity", "ORDER_LIMITS_MAX_ITEM_QUANTITY", "must not be negative, got %d", c.OrderLimits.MaxItemQuantity)
+	v.check(c.OrderLimits.MaxTotal >= 0,
+		"order_limits.max_total", "ORDER_LIMITS_MAX_TOTAL", "must not be negative, got %g", c.OrderLimits.MaxTotal)
+
+	// Without a catalog pricing is passthrough and knows no products, so
+	// requiring server-side prices would reject every order item
+	v.check(!c.Pricing.RequireServerSide || c.Pricing.CatalogURL != "",
+		"pricing.require_server_side", "PRICING_REQUIRE_SERVER_SIDE", "needs a product catalog (PRICING_CATALOG_URL)")
+
+	v.positive(c.Subscriptions.Interval, "subscriptions.interval", "SUBSCRIPTIONS_INTERVAL")
+
+	v.check(c.Jobs.Backend == JobsBackendPostgres || c.Jobs.Backend == JobsBackendRedis,
+		"jobs.backend", "JOBS_BACKEND", "must be postgres or redis, got %q", c.Jobs.Backend)
+	v.positive(c.Jobs.PollInterval, "jobs.poll_interval", "JOBS_POLL_INTERVAL")
+	v.positive(c.Jobs.Lease, "jobs.lease", "JOBS_LEASE")
+	v.check(c.Jobs.MaxAttempts >= 1,
+		"jobs.max_attempts", "JOBS_MAX_ATTEMPTS", "must be at least 1, got %d", c.Jobs.MaxAttempts)
+	v.positive(c.Jobs.RetryBackoff, "jobs.retry_backoff", "JOBS_RETRY_BACKOFF")
+	v.check(c.Jobs.Retention >= 0,
+		"jobs.retention", "JOBS_RETENTION", "must not be negative, got %s", c.Jobs.Retention)
+
+	switch c.Tenancy.Mode {
+	case TenancyModeNone, TenancyModeClaim:
+	case TenancyModeHeader:
+		v.required(c.Tenancy.Header, "tenancy.header", "TENANCY_HEADER")
+	case TenancyModeSubdomain:
+		v.required(c.Tenancy.BaseDomain, "tenancy.base_domain", "TENANCY_BASE_DOMAIN")
+	default:
+		v.check(false, "tenancy.mode", "TENANCY_MODE",
+			"must be none, header, subdomain or claim, got %q", c.Tenancy.Mode)
+	}
+	if c.Tenancy.Mode == TenancyModeClaim {
+		v.check(c.Auth.JWTSecret != "", "auth.jwt_secret", "AUTH_JWT_SECRET",
+			"is required when tenancy.mode is claim")
+	}
+
+	v.check(c.Search.Backend == SearchBackendPostgres || c.Search.Backend == SearchBackendOpenSearch,
+		"search.backend", "SEARCH_BACKEND", "must be postgres or opensearch, got %q", c.Search.Backend)
+	if c.Search.Backend == SearchBackendOpenSearch {
+		v.required(c.Search.OpenSearchURL, "search.opensearch_url", "OPENSEARCH_URL")
+		v.required(c.Search.OpenSearchIndex, "search.opensearch_index", "OPENSEARCH_INDEX")
+		if c.Search.OpenSearchUsername != "" {
+			v.required(c.Search.OpenSearchPassword, "search.opensearch_password", "OPENSEARCH_PASSWORD")
+		}
+		// The indexer consumes order events from Kafka whatever the publisher
+		if c.Messaging.Backend != MessagingBackendKafka {
+			v.kafka(c.Kafka)
+		}
+	}
+
+	switch c.Payments.Provider {
+	case PaymentsProviderNone:
+	case PaymentsProviderMock:
+		v.check(c.App.Environment != EnvironmentProduction,
+			"payments.provider", "PAYMENTS_PROVIDER", "must not be mock in production")
+	case PaymentsProviderStripe:
+		v.required(c.Payments.StripeURL, "payments.stripe_url", "STRIPE_URL")
+		v.required(c.Payments.StripeSecretKey, "payments.stripe_secret_key", "STRIPE_SECRET_KEY")
+		v.required(c.Payments.StripeWebhookSecret, "payments.stripe_webhook_secret", "STRIPE_WEBHOOK_SECRET")
+	default:
+		v.check(false, "payments.provider", "PAYMENTS_PROVIDER", "must be empty, mock or stripe, got %q", c.Payments.Provider)
+	}
+	if c.Payments.Provider != PaymentsProviderNone {
+		v.check(currencyRe.MatchString(c.Payments.Currency),
+			"payments.currency", "PAYMENTS_CURRENCY", "must be a lowercase three-letter ISO 4217 code, got %q", c.Payments.Currency)
+	}
+
+	if c.Chaos.Enabled {
+		v.check(c.App.Environment != EnvironmentProduction,
+			"chaos.enabled", "CHAOS_ENABLED", "must be false in production")
+		v.check(c.Chaos.Latency >= 0,
+			"chaos.latency", "CHAOS_LATENCY", "must not be negative, got %s", c.Chaos.Latency)
+		v.rate(c.Chaos.LatencyRate, "chaos.latency_rate", "CHAOS_LATENCY_RATE")
+		v.rate(c.Chaos.ErrorRate, "chaos.error_rate", "CHAOS_ERROR_RATE")
+		v.rate(c.Chaos.RepositoryErrorRate, "chaos.repository_error_rate", "CHAOS_REPOSITORY_ERROR_RATE")
+		v.rate(c.Chaos.PublishDropRate, "chaos.publish_drop_rate", "CHAOS_PUBLISH_DROP_RATE")
+	}
+
+	if len(v.errs) > 0 {
+		return fmt.Errorf("invalid configuration: %w", errors.Join(v.errs...))
+	}
+	return nil
+}
+
+// validator collects configuration problems
+type validator struct {
+	errs []error
+}
+
+func (v *validator) check(ok bool, key, env, format string, args ...any) {
+	if !ok {
+		v.errs = append(v.errs, fmt.Errorf("%s (%s): %s", key, env, fmt.Sprintf(format, args...)))
+	}
+}
+
+func (v *validator) required(value, key, env string) {
+	v.check(value != "", key, env, "is required")
+}
+
+func (v *validator) port(port int, key, env string) {
+	v.check(port >= 1 && port <= 65535, key, env, "must be between 1 and 65535, got %d", port)
+}
+
+func (v *validator) rate(r float64, key, env string) {
+	v.check(r >= 0 && r <= 1, key, env, "must be between 0 and 1, got %g", r)
+}
+
+func (v *validator) positive(d time.Duration, key, env string) {
+	v.check(d > 0, key, env, "must be positive, got %s", d)
+}
+
+func (v *validator) pprofAddr(s ServerConfig) {
+	_, portStr, err := net.SplitHostPort(s.PprofAddr)
+	if err != nil {
+		v.check(false, "server.pprof_addr", "PPROF_ADDR", "must be host:port, got %q", s.PprofAddr)
+		return
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		v.check(false, "server.pprof_addr", "PPROF_ADDR", "port must be a number, got %q", portStr)
+		return
+	}
+	v.port(port, "server.pprof_addr", "PPROF_ADDR")
+	v.check(port != s.HTTPPort && port != s.GRPCPort,
+		"server.pprof_addr", "PPROF_ADDR", "must not reuse the HTTP or gRPC port, got %d", port)
+}
+
+func (v *validator) kafka(k KafkaConfig) {
+	v.check(len(k.Brokers) > 0, "kafka.brokers", "KAFKA_BROKERS", "at least one broker is required")
+	for _, b := range k.Brokers {
+		v.check(b != "", "kafka.brokers", "KAFKA_BROKERS", "must not contain empty entries")
+	}
+	v.check(validKafkaTopic(k.Topic), "kafka.topic", "KAFKA_TOPIC",
+		"must be 1-%d characters of letters, digits, '.', '_' or '-' and not '.' or '..', got %q", maxKafkaTopicLength, k.Topic)
+	v.required(k.GroupID, "kafka.group_id", "KAFKA_GROUP_ID")
+
+	v.check(slices.Contains([]string{"none", "one", "all"}, k.RequiredAcks),
+		"kafka.required_acks", "KAFKA_REQUIRED_ACKS", "must be none, one or all, got %q", k.RequiredAcks)
+	v.check(k.BatchSize >= 1, "kafka.batch_size", "KAFKA_BATCH_SIZE", "must be at least 1, got %d", k.BatchSize)
+	v.check(k.BatchBytes >= 1, "kafka.batch_bytes", "KAFKA_BATCH_BYTES", "must be at least 1, got %d", k.BatchBytes)
+	v.positive(k.BatchTimeout, "kafka.batch_timeout", "KAFKA_BATCH_TIMEOUT")
+	v.check(slices.Contains([]string{"none", "gzip", "snappy", "lz4", "zstd"}, k.Compression),
+		"kafka.compression", "KAFKA_COMPRESSION", "must be none, gzip, snappy, lz4 or zstd, got %q", k.Compression)
+	v.check(k.MaxAttempts >= 1, "kafka.max_attempts", "KAFKA_MAX_ATTEMPTS", "must be at least 1, got %d", k.MaxAttempts)
+	v.check(k.PublishMode == "sync" || k.PublishMode == "async",
+		"kafka.publish_mode", "KAFKA_PUBLISH_MODE", "must be sync or async, got %q", k.PublishMode)
+	if k.Idempotent {
+		v.check(k.RequiredAcks == "all",
+			"kafka.required_acks", "KAFKA_REQUIRED_ACKS", "must be all with KAFKA_IDEMPOTENT, got %q", k.RequiredAcks)
+		v.check(k.PublishMode == "sync",
+			"kafka.publish_mode", "KAFKA_PUBLISH_MODE", "must be sync with KAFKA_IDEMPOTENT, got %q", k.PublishMode)
+	}
+
+	v.check(slices.Contains([]string{"single", "event_type", "prefix"}, k.TopicRouting),
+		"kafka.topic_routing", "KAFKA_TOPIC_ROUTING", "must be single, event_type or prefix, got %q", k.TopicRouting)
+	switch k.TopicRouting {
+	case "event_type":
+		v.check(len(k.EventTopics) > 0, "kafka.event_topics", "KAFKA_EVENT_TOPICS",
+			"must map at least one event type to a topic with event_type routing")
+		for _, eventType := range slices.Sorted(maps.Keys(k.EventTopics)) {
+			topic := k.EventTopics[eventType]
+			v.check(slices.Contains(eventTypes, eventType), "kafka.event_topics", "KAFKA_EVENT_TOPICS",
+				"unknown event type %q", eventType)
+			v.check(validKafkaTopic(topic), "kafka.event_topics", "KAFKA_EVENT_TOPICS",
+				"topic of %s must be 1-%d characters of letters, digits, '.', '_' or '-', got %q", eventType, maxKafkaTopicLength, topic)
+		}
+	case "prefix":
+		for _, eventType := range eventTypes {
+			v.check(validKafkaTopic(k.Topic+"."+eventType), "kafka.topic", "KAFKA_TOPIC",
+				"must leave room for the event type suffix of prefix routing; %q is too long", k.Topic+"."+eventType)
+		}
+	}
+}
+
+// eventTypes are the event types the service publishes, as listed by
+// messaging.EventTypes.
+var eventTypes = []string{
+	"order.created", "order.updated", "order.status_changed", "order.restored", "order.deleted",
+	"order.sla_breached", "customer.data_erased",
+}
+
+func validKafkaTopic(topic string) bool {
+	return len(topic) <= maxKafkaTopicLength && topic != "." && topic != ".." && kafkaTopicRe.MatchString(topic)
+}
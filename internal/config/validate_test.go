@@ -0,0 +1,408 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_Validate_Defaults_Valid(t *testing.T) {
+	assert.NoError(t, defaults().Validate())
+}
+
+func TestConfig_Validate_TopicRouting_Valid(t *testing.T) {
+	cfg := defaults()
+	cfg.Kafka.TopicRouting = "prefix"
+	require.NoError(t, cfg.Validate())
+
+	cfg.Kafka.TopicRouting = "event_type"
+	cfg.Kafka.EventTopics = map[string]string{"order.created": "orders-created"}
+	require.NoError(t, cfg.Validate())
+}
+
+func TestConfig_Validate_ServerSidePricing_WithCatalog_Valid(t *testing.T) {
+	cfg := defaults()
+	cfg.Pricing.RequireServerSide = true
+	cfg.Pricing.CatalogURL = "http://catalog:8080"
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestEventTypes_MatchMessaging(t *testing.T) {
+	assert.ElementsMatch(t, messaging.EventTypes(), eventTypes)
+}
+
+func TestConfig_Validate_InvalidValues_ReturnsError(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(c *Config)
+		wantErr string
+	}{
+		{
+			name:    "port out of range",
+			mutate:  func(c *Config) { c.Server.HTTPPort = 70000 },
+			wantErr: "server.http_port (HTTP_PORT): must be between 1 and 65535, got 70000",
+		},
+		{
+			name:    "port clash",
+			mutate:  func(c *Config) { c.Server.GRPCPort = c.Server.HTTPPort },
+			wantErr: "server.grpc_port (GRPC_PORT): must differ from the HTTP port 8080",
+		},
+		{
+			name: "pprof address without port",
+			mutate: func(c *Config) {
+				c.Server.EnablePprof = true
+				c.Server.PprofAddr = "localhost"
+			},
+			wantErr: `server.pprof_addr (PPROF_ADDR): must be host:port, got "localhost"`,
+		},
+		{
+			name: "pprof on the HTTP port",
+			mutate: func(c *Config) {
+				c.Server.EnablePprof = true
+				c.Server.PprofAddr = ":8080"
+			},
+			wantErr: "server.pprof_addr (PPROF_ADDR): must not reuse the HTTP or gRPC port, got 8080",
+		},
+		{
+			name:    "no publish attempts",
+			mutate:  func(c *Config) { c.Resilience.MaxAttempts = 0 },
+			wantErr: "resilience.max_attempts (RESILIENCE_MAX_ATTEMPTS): must be at least 1, got 0",
+		},
+		{
+			name: "stripe without secret key",
+			mutate: func(c *Config) {
+				c.Payments.Provider = PaymentsProviderStripe
+				c.Payments.StripeWebhookSecret = "whsec_test"
+			},
+			wantErr: "payments.stripe_secret_key (STRIPE_SECRET_KEY): is required",
+		},
+		{
+			name: "mock payments in production",
+			mutate: func(c *Config) {
+				c.App.Environment = EnvironmentProduction
+				c.Database.Password = "secret"
+				c.Payments.Provider = PaymentsProviderMock
+			},
+			wantErr: "payments.provider (PAYMENTS_PROVIDER): must not be mock in production",
+		},
+		{
+			name:    "no outbound timeout",
+			mutate:  func(c *Config) { c.Outbound.Timeout = 0 },
+			wantErr: "outbound.timeout (OUTBOUND_TIMEOUT): must be positive, got 0s",
+		},
+		{
+			name:    "startup max backoff below initial",
+			mutate:  func(c *Config) { c.Startup.MaxBackoff = 100 * time.Millisecond },
+			wantErr: "startup.max_backoff (STARTUP_MAX_BACKOFF): must not be below the initial backoff 500ms, got 100ms",
+		},
+		{
+			name: "production without database password",
+			mutate: func(c *Config) {
+				c.App.Environment = EnvironmentProduction
+				c.Database.Password = ""
+			},
+			wantErr: "database.password (DATABASE_PASSWORD): is required",
+		},
+		{
+			name:    "idle conns above open conns",
+			mutate:  func(c *Config) { c.Database.MaxIdleConns = 30 },
+			wantErr: "database.max_idle_conns (DATABASE_MAX_IDLE_CONNS)",
+		},
+		{
+			name:    "hot ttl below default",
+			mutate:  func(c *Config) { c.Cache.HotTTL = time.Minute },
+			wantErr: "cache.hot_ttl (CACHE_HOT_TTL): must not be shorter than the default TTL 5m0s",
+		},
+		{
+			name:    "zero idempotency ttl",
+			mutate:  func(c *Config) { c.Cache.IdempotencyTTL = 0 },
+			wantErr: "cache.idempotency_ttl (IDEMPOTENCY_TTL)",
+		},
+		{
+			name:    "query timeout not below write timeout",
+			mutate:  func(c *Config) { c.Database.QueryTimeout = c.Server.WriteTimeout },
+			wantErr: "database.query_timeout (DATABASE_QUERY_TIMEOUT): must be between 0 and write_timeout (10s), got 10s",
+		},
+		{
+			name:    "negative slow query threshold",
+			mutate:  func(c *Config) { c.Database.SlowQueryThreshold = -time.Millisecond },
+			wantErr: "database.slow_query_threshold (DATABASE_SLOW_QUERY_THRESHOLD): must not be negative",
+		},
+		{
+			name:    "negative list ttl",
+			mutate:  func(c *Config) { c.Cache.ListTTL = -time.Second },
+			wantErr: "cache.list_ttl (CACHE_LIST_TTL): must not be negative",
+		},
+		{
+			name:    "kafka topic with invalid characters",
+			mutate:  func(c *Config) { c.Kafka.Topic = "order events" },
+			wantErr: `kafka.topic (KAFKA_TOPIC): must be 1-249 characters`,
+		},
+		{
+			name:    "kafka topic too long",
+			mutate:  func(c *Config) { c.Kafka.Topic = strings.Repeat("a", 250) },
+			wantErr: "kafka.topic (KAFKA_TOPIC)",
+		},
+		{
+			name:    "kafka topic dot",
+			mutate:  func(c *Config) { c.Kafka.Topic = ".." },
+			wantErr: "kafka.topic (KAFKA_TOPIC)",
+		},
+		{
+			name: "sns without topic",
+			mutate: func(c *Config) {
+				c.Messaging.Backend = MessagingBackendSNS
+			},
+			wantErr: "sns.topic_arn (SNS_TOPIC_ARN): is required",
+		},
+		{
+			name:    "unknown messaging backend",
+			mutate:  func(c *Config) { c.Messaging.Backend = "rabbit" },
+			wantErr: `messaging.backend (MESSAGING_BACKEND): must be kafka, nats, sns or none, got "rabbit"`,
+		},
+		{
+			name:    "retention enabled without interval",
+			mutate:  func(c *Config) { c.Retention.DeletedOrders, c.Retention.Interval = 24*time.Hour, 0 },
+			wantErr: "retention.interval (RETENTION_INTERVAL): must be positive, got 0s",
+		},
+		{
+			name:    "zero stats cache",
+			mutate:  func(c *Config) { c.Reports.StatsTTL = 0 },
+			wantErr: "reports.stats_ttl (REPORTS_STATS_TTL): must be positive, got 0s",
+		},
+		{
+			name: "completed order retention shorter than archive age",
+			mutate: func(c *Config) {
+				c.Archive.URL, c.Archive.After = "s3://archive/orders", 90*24*time.Hour
+				c.Retention.CompletedOrders = 30 * 24 * time.Hour
+			},
+			wantErr: "retention.completed_orders (RETENTION_COMPLETED_ORDERS): must be longer than archive.after (2160h0m0s) when archiving, got 720h0m0s",
+		},
+		{
+			name: "opensearch username without password",
+			mutate: func(c *Config) {
+				c.Search.Backend = SearchBackendOpenSearch
+				c.Search.OpenSearchUsername = "indexer"
+			},
+			wantErr: "search.opensearch_password (OPENSEARCH_PASSWORD): is required",
+		},
+		{
+			name:    "unknown tenancy mode",
+			mutate:  func(c *Config) { c.Tenancy.Mode = "path" },
+			wantErr: `tenancy.mode (TENANCY_MODE): must be none, header, subdomain or claim, got "path"`,
+		},
+		{
+			name:    "subdomain tenancy without base domain",
+			mutate:  func(c *Config) { c.Tenancy.Mode = TenancyModeSubdomain },
+			wantErr: "tenancy.base_domain (TENANCY_BASE_DOMAIN): is required",
+		},
+		{
+			name:    "claim tenancy without auth",
+			mutate:  func(c *Config) { c.Tenancy.Mode = TenancyModeClaim },
+			wantErr: "auth.jwt_secret (AUTH_JWT_SECRET): is required when tenancy.mode is claim",
+		},
+		{
+			name:    "rate limit without burst",
+			mutate:  func(c *Config) { c.RateLimit.Burst = 0 },
+			wantErr: "rate_limit.burst (RATE_LIMIT_BURST): must be at least 1, got 0",
+		},
+		{
+			name: "rate limit policy naming tenant and customer",
+			mutate: func(c *Config) {
+				c.RateLimit.Policies = []RateLimitPolicyConfig{{Tenant: "acme", CustomerID: "*", RequestsPerMinute: 10, Burst: 1}}
+			},
+			wantErr: "rate_limit.policies (RATE_LIMIT_POLICIES): policy 1 must name either a tenant or a customer",
+		},
+		{
+			name: "rate limit policy without burst",
+			mutate: func(c *Config) {
+				c.RateLimit.Policies = []RateLimitPolicyConfig{{Tenant: "acme", RequestsPerMinute: 10}}
+			},
+			wantErr: "rate_limit.policies (RATE_LIMIT_POLICIES): policy 1 needs requests per minute and burst of at least 1, got 10/0/0",
+		},
+		{
+			name:    "request timeout beyond write timeout",
+			mutate:  func(c *Config) { c.Server.RequestTimeout = 15 * time.Second },
+			wantErr: "server.request_timeout (HTTP_REQUEST_TIMEOUT): must be between 0 and write_timeout (10s), got 15s",
+		},
+		{
+			name:    "negative cache max age",
+			mutate:  func(c *Config) { c.Server.CacheMaxAge = -time.Second },
+			wantErr: "server.cache_max_age (HTTP_CACHE_MAX_AGE): must not be negative, got -1s",
+		},
+		{
+			name:    "negative order total limit",
+			mutate:  func(c *Config) { c.OrderLimits.MaxTotal = -1 },
+			wantErr: "order_limits.max_total (ORDER_LIMITS_MAX_TOTAL): must not be negative, got -1",
+		},
+		{
+			name:    "server-side pricing without a catalog",
+			mutate:  func(c *Config) { c.Pricing.RequireServerSide = true },
+			wantErr: "pricing.require_server_side (PRICING_REQUIRE_SERVER_SIDE): needs a product catalog (PRICING_CATALOG_URL)",
+		},
+		{
+			name: "chaos in production",
+			mutate: func(c *Config) {
+				c.App.Environment = EnvironmentProduction
+				c.Database.Password = "secret"
+				c.Chaos.Enabled = true
+			},
+			wantErr: "chaos.enabled (CHAOS_ENABLED): must be false in production",
+		},
+		{
+			name: "chaos rate above one",
+			mutate: func(c *Config) {
+				c.Chaos.Enabled = true
+				c.Chaos.ErrorRate = 1.5
+			},
+			wantErr: "chaos.error_rate (CHAOS_ERROR_RATE): must be between 0 and 1, got 1.5",
+		},
+		{
+			name:    "route timeout without leading slash",
+			mutate:  func(c *Config) { c.Server.RouteTimeouts = map[string]time.Duration{"api/v1/reports": time.Second} },
+			wantErr: `server.route_timeouts (HTTP_ROUTE_TIMEOUTS): path prefix "api/v1/reports" must start with /`,
+		},
+		{
+			name:    "page size cap above api limit",
+			mutate:  func(c *Config) { c.Pagination.MaxPageSize = 500 },
+			wantErr: "pagination.max_page_size (PAGINATION_MAX_PAGE_SIZE): must be between 1 and 100, got 500",
+		},
+		{
+			name:    "vault without token",
+			mutate:  func(c *Config) { c.Secrets.VaultAddr = "https://vault:8200" },
+			wantErr: "secrets.vault_token (VAULT_TOKEN): is required",
+		},
+		{
+			name:    "unknown kafka compression",
+			mutate:  func(c *Config) { c.Kafka.Compression = "brotli" },
+			wantErr: `kafka.compression (KAFKA_COMPRESSION): must be none, gzip, snappy, lz4 or zstd, got "brotli"`,
+		},
+		{
+			name:    "idempotent kafka producer without acks from all replicas",
+			mutate:  func(c *Config) { c.Kafka.Idempotent = true },
+			wantErr: `kafka.required_acks (KAFKA_REQUIRED_ACKS): must be all with KAFKA_IDEMPOTENT, got "one"`,
+		},
+		{
+			name: "idempotent kafka producer in async mode",
+			mutate: func(c *Config) {
+				c.Kafka.Idempotent = true
+				c.Kafka.RequiredAcks = "all"
+				c.Kafka.PublishMode = "async"
+			},
+			wantErr: `kafka.publish_mode (KAFKA_PUBLISH_MODE): must be sync with KAFKA_IDEMPOTENT, got "async"`,
+		},
+		{
+			name:    "avro without schema registry",
+			mutate:  func(c *Config) { c.Kafka.EventFormat = "avro" },
+			wantErr: "kafka.schema_registry_url (KAFKA_SCHEMA_REGISTRY_URL): is required",
+		},
+		{
+			name: "protobuf on nats",
+			mutate: func(c *Config) {
+				c.Messaging.Backend = MessagingBackendNATS
+				c.Kafka.EventFormat = "protobuf"
+				c.Kafka.SchemaRegistryURL = "http://registry:8081"
+			},
+			wantErr: `kafka.event_format (KAFKA_EVENT_FORMAT): protobuf needs the kafka messaging backend, got "nats"`,
+		},
+		{
+			name:    "unknown kafka topic routing",
+			mutate:  func(c *Config) { c.Kafka.TopicRouting = "round_robin" },
+			wantErr: `kafka.topic_routing (KAFKA_TOPIC_ROUTING): must be single, event_type or prefix, got "round_robin"`,
+		},
+		{
+			name:    "event type routing without topics",
+			mutate:  func(c *Config) { c.Kafka.TopicRouting = "event_type" },
+			wantErr: "kafka.event_topics (KAFKA_EVENT_TOPICS): must map at least one event type to a topic",
+		},
+		{
+			name: "event type routing of an unknown event type",
+			mutate: func(c *Config) {
+				c.Kafka.TopicRouting = "event_type"
+				c.Kafka.EventTopics = map[string]string{"order.shipped": "orders-shipped"}
+			},
+			wantErr: `kafka.event_topics (KAFKA_EVENT_TOPICS): unknown event type "order.shipped"`,
+		},
+		{
+			name: "event type routing to an invalid topic",
+			mutate: func(c *Config) {
+				c.Kafka.TopicRouting = "event_type"
+				c.Kafka.EventTopics = map[string]string{"order.created": "orders created"}
+			},
+			wantErr: `kafka.event_topics (KAFKA_EVENT_TOPICS): topic of order.created must be`,
+		},
+		{
+			name:    "unknown log format",
+			mutate:  func(c *Config) { c.App.LogFormat = "logfmt" },
+			wantErr: `app.log_format (APP_LOG_FORMAT): must be json or text, got "logfmt"`,
+		},
+		{
+			name:    "unknown log level",
+			mutate:  func(c *Config) { c.App.LogLevel = "verbose" },
+			wantErr: "app.log_level (APP_LOG_LEVEL)",
+		},
+		{
+			name:    "default shipping method not configured",
+			mutate:  func(c *Config) { c.Delivery.DefaultMethod = "overnight" },
+			wantErr: `delivery.default_method (DELIVERY_DEFAULT_METHOD): must be one of delivery.transit_times, got "overnight"`,
+		},
+		{
+			name:    "zero transit time",
+			mutate:  func(c *Config) { c.Delivery.TransitTimes["express"] = 0 },
+			wantErr: `delivery.transit_times (DELIVERY_TRANSIT_TIMES): must map non-empty method names to positive durations, got "express"=0s`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := defaults()
+			tt.mutate(cfg)
+
+			err := cfg.Validate()
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestConfig_Validate_DisabledFeatures_SkipTheirSettings(t *testing.T) {
+	cfg := defaults()
+	cfg.Messaging.Backend = MessagingBackendNone
+	cfg.Kafka.Topic = ""
+	cfg.Reports.RefreshInterval = 0
+	cfg.Retention.Interval = 0
+	cfg.Partitions.MonthsAhead = 0
+
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestConfig_Validate_ReportsAllProblems(t *testing.T) {
+	cfg := defaults()
+	cfg.Server.HTTPPort = 0
+	cfg.Database.Host = ""
+	cfg.Kafka.GroupID = ""
+
+	err := cfg.Validate()
+
+	require.Error(t, err)
+	assert.Len(t, strings.Split(err.Error(), "\n"), 3)
+}
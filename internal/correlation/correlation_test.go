@@ -0,0 +1,109 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package correlation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithID(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"set", WithID(context.Background(), "req-1"), "req-1"},
+		{"empty id leaves context unchanged", WithID(context.Background(), ""), ""},
+		{"missing", context.Background(), ""},
+		{"nil context", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ID(tt.ctx))
+		})
+	}
+}
+
+func TestHandler_Handle_AddsRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(NewHandler(slog.NewJSONHandler(&buf, nil))).With(slog.String("component", "test"))
+
+	logger.InfoContext(WithID(context.Background(), "req-42"), "hello")
+
+	var rec map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &rec))
+	assert.Equal(t, "req-42", rec[LogKey])
+	assert.Equal(t, "test", rec["component"])
+}
+
+func TestHandler_Handle_NoRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(NewHandler(slog.NewJSONHandler(&buf, nil)))
+
+	logger.Info("hello")
+
+	var rec map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &rec))
+	assert.NotContains(t, rec, LogKey)
+}
+
+func TestHandler_Handle_KeepsExplicitRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(NewHandler(slog.NewJSONHandler(&buf, nil)))
+
+	logger.InfoContext(WithID(context.Background(), "req-42"), "hello", slog.String(LogKey, "req-42"))
+
+	assert.Equal(t, 1, bytes.Count(buf.Bytes(), []byte(`"request_id"`)))
+}
+
+func TestWithTraceParent(t *testing.T) {
+	valid := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	tests := []struct {
+		name        string
+		traceParent string
+		wantTraceID string
+	}{
+		{"valid", valid, "4bf92f3577b34da6a3ce929d0e0e4736"},
+		{"unknown version", "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", ""},
+		{"uppercase hex", "00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01", ""},
+		{"zero trace ID", "00-00000000000000000000000000000000-00f067aa0ba902b7-01", ""},
+		{"zero parent ID", "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01", ""},
+		{"truncated", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7", ""},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := WithTraceParent(context.Background(), tt.traceParent)
+
+			assert.Equal(t, tt.wantTraceID, TraceID(ctx))
+		})
+	}
+}
+
+func TestNewTraceParent_IsValidAndUnique(t *testing.T) {
+	a, b := NewTraceParent(), NewTraceParent()
+
+	assert.True(t, ValidTraceParent(a), a)
+	assert.NotEqual(t, a, b)
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package correlation
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+)
+
+// TraceParentHeader is the W3C Trace Context header, and the HTTP header,
+// gRPC metadata key and Kafka message header the trace travels in.
+const TraceParentHeader = "traceparent"
+
+type traceKey struct{}
+
+// WithTraceParent returns a context carrying the W3C traceparent value tp.
+// An invalid tp leaves ctx unchanged.
+func WithTraceParent(ctx context.Context, tp string) context.Context {
+	if !ValidTraceParent(tp) {
+		return ctx
+	}
+	return context.WithValue(ctx, traceKey{}, tp)
+}
+
+// TraceParent returns the traceparent stored in ctx, or "" if there is none.
+func TraceParent(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	tp, _ := ctx.Value(traceKey{}).(string)
+	return tp
+}
+
+// TraceID returns the trace ID of the traceparent stored in ctx, or "".
+func TraceID(ctx context.Context) string {
+	tp := TraceParent(ctx)
+	if tp == "" {
+		return ""
+	}
+	return tp[3:35]
+}
+
+// NewTraceParent starts a trace: a version 00 traceparent with a random
+// trace and parent ID and no flags set.
+func NewTraceParent() string {
+	var ids [24]byte
+	_, _ = rand.Read(ids[:])
+	return "00-" + hex.EncodeToString(ids[:16]) + "-" + hex.EncodeToString(ids[16:]) + "-00"
+}
+
+// ValidTraceParent reports whether tp is a version 00 traceparent, e.g.
+// 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01, whose trace and
+// parent IDs are not all zeros.
+func ValidTraceParent(tp string) bool {
+	parts := strings.Split(tp, "-")
+	if len(parts) != 4 || parts[0] != "00" {
+		return false
+	}
+	for i, size := range []int{2, 32, 16, 2} {
+		if len(parts[i]) != size || !isLowerHex(parts[i]) {
+			return false
+		}
+	}
+	return strings.Trim(parts[1], "0") != "" && strings.Trim(parts[2], "0") != ""
+}
+
+func isLowerHex(s string) bool {
+	for _, c := range s {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
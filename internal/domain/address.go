@@ -0,0 +1,123 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Limits on address fields, in characters.
+const (
+	MaxAddressFieldLength = 200
+	MaxPostalCodeLength   = 20
+)
+
+// Address is a postal address. Line1, City and Country are required;
+// Country is an ISO 3166-1 alpha-2 code.
+type Address struct {
+	Name       string
+	Line1      string
+	Line2      string
+	City       string
+	Region     string
+	PostalCode string
+	Country    string
+}
+
+// NormalizeAddress trims every field and uppercases the country code,
+// returning ErrInvalidAddress if the result is not a valid address
+func NormalizeAddress(a Address) (Address, error) {
+	a = Address{
+		Name:       strings.TrimSpace(a.Name),
+		Line1:      strings.TrimSpace(a.Line1),
+		Line2:      strings.TrimSpace(a.Line2),
+		City:       strings.TrimSpace(a.City),
+		Region:     strings.TrimSpace(a.Region),
+		PostalCode: strings.TrimSpace(a.PostalCode),
+		Country:    strings.ToUpper(strings.TrimSpace(a.Country)),
+	}
+	if a.Line1 == "" || a.City == "" || !isCountryCode(a.Country) {
+		return Address{}, ErrInvalidAddress
+	}
+	for _, field := range []string{a.Name, a.Line1, a.Line2, a.City, a.Region} {
+		if utf8.RuneCountInString(field) > MaxAddressFieldLength {
+			return Address{}, ErrInvalidAddress
+		}
+	}
+	if utf8.RuneCountInString(a.PostalCode) > MaxPostalCodeLength {
+		return Address{}, ErrInvalidAddress
+	}
+	return a, nil
+}
+
+// isCountryCode reports whether s is two uppercase ASCII letters
+func isCountryCode(s string) bool {
+	if len(s) != 2 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// CanChangeAddress reports whether an order in this status may have its
+// addresses changed. Once processing starts the order may already be packed
+// for the shipping address.
+func (s OrderStatus) CanChangeAddress() bool {
+	return s == OrderStatusPending || s == OrderStatusConfirmed
+}
+
+// SetAddresses normalizes and sets the order's shipping and billing
+// addresses. A nil address leaves that address unchanged. Nothing changes on
+// error.
+func (o *Order) SetAddresses(shipping, billing *Address) error {
+	var next [2]*Address
+	for i, a := range []*Address{shipping, billing} {
+		if a == nil {
+			continue
+		}
+		normalized, err := NormalizeAddress(*a)
+		if err != nil {
+			return err
+		}
+		next[i] = &normalized
+	}
+
+	if next[0] != nil {
+		o.ShippingAddress = next[0]
+	}
+	if next[1] != nil {
+		o.BillingAddress = next[1]
+	}
+	return nil
+}
+
+// ChangeAddresses is SetAddresses for an existing order, allowed only while
+// its status CanChangeAddress
+func (o *Order) ChangeAddresses(shipping, billing *Address, now time.Time) error {
+	if !o.Status.CanChangeAddress() {
+		return ErrAddressLocked
+	}
+	if err := o.SetAddresses(shipping, billing); err != nil {
+		return err
+	}
+	o.UpdatedAt = now
+	return nil
+}
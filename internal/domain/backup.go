@@ -0,0 +1,25 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+// OrderBackup is an order with everything stored alongside it, the unit that
+// is backed up and restored. The order may be soft-deleted.
+type OrderBackup struct {
+	Order *Order
+	// History is the order's history, oldest first
+	History []*OrderHistoryEntry
+	// Notes are the order's notes of every visibility, oldest first
+	Notes []*OrderNote
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import "time"
+
+// EstimateDelivery sets the estimated delivery time to transit after
+// confirmedAt. An estimate already set is kept, so it reflects the first
+// confirmation rather than a later release from hold.
+func (o *Order) EstimateDelivery(confirmedAt time.Time, transit time.Duration) {
+	if o.EstimatedDeliveryAt != nil {
+		return
+	}
+	at := confirmedAt.Add(transit)
+	o.EstimatedDeliveryAt = &at
+}
+
+// IsOverdue reports whether the order's estimated delivery time has passed
+// before it was delivered or cancelled, and it has not been flagged yet
+func (o *Order) IsOverdue(now time.Time) bool {
+	if o.EstimatedDeliveryAt == nil || o.SLABreachedAt != nil {
+		return false
+	}
+	if o.Status == OrderStatusDelivered || o.Status == OrderStatusCancelled {
+		return false
+	}
+	return now.After(*o.EstimatedDeliveryAt)
+}
+
+// MarkSLABreached flags an overdue order so its breach is reported once
+func (o *Order) MarkSLABreached(now time.Time) error {
+	if !o.IsOverdue(now) {
+		return ErrSLANotBreached
+	}
+	o.SLABreachedAt = &now
+	o.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrderDiff is what changed in an order between two of its versions
+type OrderDiff struct {
+	OrderID     uuid.UUID
+	FromVersion int
+	ToVersion   int
+	// From and To are the order at FromVersion and ToVersion
+	From *Order
+	To   *Order
+	// Changes lists the order fields that differ, in a fixed field order.
+	// The version and update time always differ and are not listed.
+	Changes []FieldChange
+	// Items lists the items added, removed or changed, matched by item ID
+	Items []ItemChange
+}
+
+// FieldChange is one field that differs between two versions. Field is the
+// field's name in the API; From and To hold the domain values, nil when unset.
+type FieldChange struct {
+	Field string
+	From  any
+	To    any
+}
+
+// ItemChangeKind says how an item differs between two versions
+type ItemChangeKind string
+
+// Item changes.
+const (
+	ItemAdded   ItemChangeKind = "added"
+	ItemRemoved ItemChangeKind = "removed"
+	ItemChanged ItemChangeKind = "changed"
+)
+
+// ItemChange is one item that differs between two versions. From is nil for
+// an added item and To for a removed one; Changes is set for a changed item.
+type ItemChange struct {
+	ItemID  uuid.UUID
+	Kind    ItemChangeKind
+	From    *OrderItem
+	To      *OrderItem
+	Changes []FieldChange
+}
+
+// DiffOrders compares two versions of one order
+func DiffOrders(from, to *Order) *OrderDiff {
+	d := &OrderDiff{OrderID: to.ID, FromVersion: from.Version, ToVersion: to.Version, From: from, To: to}
+
+	var c changes
+	c.add("customer_id", from.CustomerID, to.CustomerID, from.CustomerID != to.CustomerID)
+	c.add("status", from.Status, to.Status, from.Status != to.Status)
+	c.add("total", from.Total, to.Total, MoneyFromFloat(from.Total) != MoneyFromFloat(to.Total))
+	c.add("hold", from.Hold, to.Hold, !sameHold(from.Hold, to.Hold))
+	c.add("metadata", from.Metadata, to.Metadata, !maps.Equal(from.Metadata, to.Metadata))
+	c.add("tags", from.Tags, to.Tags, !slices.Equal(from.Tags, to.Tags))
+	c.add("shipping_address", from.ShippingAddress, to.ShippingAddress, !samePtr(from.ShippingAddress, to.ShippingAddress))
+	c.add("billing_address", from.BillingAddress, to.BillingAddress, !samePtr(from.BillingAddress, to.BillingAddress))
+	c.add("shipping_method", from.ShippingMethod, to.ShippingMethod, from.ShippingMethod != to.ShippingMethod)
+	c.add("estimated_delivery_at", from.EstimatedDeliveryAt, to.EstimatedDeliveryAt, !sameTime(from.EstimatedDeliveryAt, to.EstimatedDeliveryAt))
+	c.add("sla_breached_at", from.SLABreachedAt, to.SLABreachedAt, !sameTime(from.SLABreachedAt, to.SLABreachedAt))
+	c.add("deleted_at", from.DeletedAt, to.DeletedAt, !sameTime(from.DeletedAt, to.DeletedAt))
+	d.Changes = c
+
+	d.Items = diffItems(from.Items, to.Items)
+	return d
+}
+
+// diffItems lists the removed and changed items in their order in from,
+// then the added items in their order in to
+func diffItems(from, to []OrderItem) []ItemChange {
+	var itemChanges []ItemChange
+	for i := range from {
+		old := &from[i]
+		j := slices.IndexFunc(to, func(item OrderItem) bool { return item.ID == old.ID })
+		if j < 0 {
+			itemChanges = append(itemChanges, ItemChange{ItemID: old.ID, Kind: ItemRemoved, From: old})
+			continue
+		}
+		updated := &to[j]
+
+		var c changes
+		c.add("product_id", old.ProductID, updated.ProductID, old.ProductID != updated.ProductID)
+		c.add("name", old.Name, updated.Name, old.Name != updated.Name)
+		c.add("quantity", old.Quantity, updated.Quantity, old.Quantity != updated.Quantity)
+		c.add("price", old.Price, updated.Price, MoneyFromFloat(old.Price) != MoneyFromFloat(updated.Price))
+		c.add("subtotal", old.Subtotal, updated.Subtotal, MoneyFromFloat(old.Subtotal) != MoneyFromFloat(updated.Subtotal))
+		c.add("status", old.Status, updated.Status, old.Status != updated.Status)
+		if len(c) > 0 {
+			itemChanges = append(itemChanges, ItemChange{ItemID: old.ID, Kind: ItemChanged, From: old, To: updated, Changes: c})
+		}
+	}
+	for i := range to {
+		added := &to[i]
+		if !slices.ContainsFunc(from, func(item OrderItem) bool { return item.ID == added.ID }) {
+			itemChanges = append(itemChanges, ItemChange{ItemID: added.ID, Kind: ItemAdded, To: added})
+		}
+	}
+	return itemChanges
+}
+
+// changes collects the fields that differ
+type changes []FieldChange
+
+// add records the field if it differs, with nil for an unset pointer value
+func (c *changes) add(field string, from, to any, differs bool) {
+	if differs {
+		*c = append(*c, FieldChange{Field: field, From: nilIfUnset(from), To: nilIfUnset(to)})
+	}
+}
+
+// nilIfUnset returns an untyped nil for a nil pointer, map or slice, so an
+// unset value compares equal to nil
+func nilIfUnset(v any) any {
+	switch v := v.(type) {
+	case *OrderHold:
+		if v == nil {
+			return nil
+		}
+	case *Address:
+		if v == nil {
+			return nil
+		}
+	case *time.Time:
+		if v == nil {
+			return nil
+		}
+	case map[string]string:
+		if v == nil {
+			return nil
+		}
+	case []string:
+		if v == nil {
+			return nil
+		}
+	}
+	return v
+}
+
+func samePtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func sameHold(a, b *OrderHold) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Reason == b.Reason && a.PreviousStatus == b.PreviousStatus &&
+		a.HeldAt.Equal(b.HeldAt) && sameTime(a.ReleaseAt, b.ReleaseAt)
+}
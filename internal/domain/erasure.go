@@ -0,0 +1,66 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrCustomerNotFound is returned when a customer has no orders to act on.
+var ErrCustomerNotFound = errors.New("customer not found")
+
+// ErasedItemName replaces item names when a customer's data is erased
+const ErasedItemName = "[erased]"
+
+// CustomerErasure records the erasure of a customer's personal data.
+// CustomerID is only held in memory; the audit record stores CustomerHash.
+type CustomerErasure struct {
+	ID         uuid.UUID
+	CustomerID string
+	// TenantID is the tenant whose orders of the customer were erased
+	TenantID   string
+	OrderCount int
+	Actor      string
+	ErasedAt   time.Time
+}
+
+// NewCustomerErasure starts an erasure of customerID's data
+func NewCustomerErasure(customerID, actor string) *CustomerErasure {
+	return &CustomerErasure{
+		ID:         uuid.New(),
+		CustomerID: customerID,
+		Actor:      actor,
+		ErasedAt:   time.Now(),
+	}
+}
+
+// AnonymizedCustomerID is the customer ID given to erased orders. It is the
+// same for all of the customer's orders, so per-customer aggregates survive,
+// but cannot be linked back to the original ID.
+func (e *CustomerErasure) AnonymizedCustomerID() string {
+	return "erased-" + e.ID.String()
+}
+
+// CustomerHash is a SHA-256 digest of the customer ID, letting auditors
+// confirm that a given customer was erased without storing the ID itself.
+func (e *CustomerErasure) CustomerHash() string {
+	sum := sha256.Sum256([]byte(e.CustomerID))
+	return hex.EncodeToString(sum[:])
+}
@@ -30,4 +30,80 @@ var (
 	ErrInvalidTransition      = errors.New("invalid status transition")
 	ErrOrderAlreadyDeleted    = errors.New("order is already deleted")
 	ErrConcurrentModification = errors.New("order was modified by another process")
+	ErrVersionMismatch        = errors.New("order version does not match expected version")
+	ErrInvalidRetention       = errors.New("retention period must not be negative")
+	ErrInvalidSearchQuery     = errors.New("search query must be 1 to 100 characters")
+	ErrInvalidTotalRange      = errors.New("minimum total must not exceed maximum total")
+	ErrInvalidHoldReason      = errors.New("hold reason must be 1 to 500 characters")
+	ErrOrderNotHeld           = errors.New("order is not on hold")
+	ErrItemNotFound           = errors.New("order item not found")
+	ErrInvalidItemStatus      = errors.New("invalid item status")
+	ErrInvalidItemTransition  = errors.New("invalid item status transition")
+	ErrItemsInFulfillment     = errors.New("items cannot be replaced once fulfillment has started")
+	ErrInvalidNote            = errors.New("note body must be 1 to 2000 characters")
+	ErrInvalidNoteVisibility  = errors.New("note visibility must be customer or internal")
+	ErrInvalidMetadata        = errors.New("metadata allows up to 50 entries with keys of 1 to 64 and values of up to 512 characters")
+	ErrInvalidTag             = errors.New("tags allow up to 20 labels of 1 to 64 characters")
+	ErrInvalidAddress         = errors.New("address requires line1, city and a two-letter country code")
+	ErrAddressLocked          = errors.New("addresses can only change while the order is pending or confirmed")
+	ErrInvalidShippingMethod  = errors.New("shipping method is not configured")
+	ErrSLANotBreached         = errors.New("order is not overdue")
+	ErrProductNotPriced       = errors.New("product has no catalog price")
+	ErrPricingUnavailable     = errors.New("pricing service is unavailable")
+	ErrItemNotPatchable       = errors.New("only the name and quantity of an existing item can change")
+	ErrItemsLocked            = errors.New("items can only change while the order is pending or confirmed")
+	ErrTotalsConsistent       = errors.New("order total already matches its items")
+	ErrTooManyItems           = errors.New("order has more items than allowed")
+	ErrQuantityLimitExceeded  = errors.New("item quantity exceeds the allowed maximum")
+	ErrTotalLimitExceeded     = errors.New("order total exceeds the allowed maximum")
+	ErrVersionNotInHistory    = errors.New("order version is not recorded in its history")
+	ErrInvalidVersionRange    = errors.New("from version must be at least 1 and below to version")
 )
+
+// Domain errors for subscription operations.
+var (
+	ErrSubscriptionNotFound          = errors.New("subscription not found")
+	ErrInvalidCadence                = errors.New("cadence must be daily, weekly, biweekly or monthly")
+	ErrSubscriptionNotDue            = errors.New("subscription is not due")
+	ErrInvalidSubscriptionTransition = errors.New("subscription cannot be paused or resumed in its current status")
+)
+
+// Domain errors for event replay.
+var (
+	ErrInvalidReplayTarget     = errors.New("replay target must be broker or webhook")
+	ErrInvalidWebhookURL       = errors.New("webhook URL must be an absolute http or https URL")
+	ErrInvalidReplayRange      = errors.New("replay needs an order ID or a from time, and from must be before to")
+	ErrInvalidReplayCursor     = errors.New("invalid replay cursor")
+	ErrReplayTargetUnavailable = errors.New("no message broker is configured to replay events to")
+	ErrReplayDeliveryFailed    = errors.New("replayed event could not be delivered")
+)
+
+// Domain errors for the job queue.
+var (
+	ErrJobNotFound      = errors.New("job not found")
+	ErrInvalidJobStatus = errors.New("job status must be queued, running, succeeded or failed")
+	ErrUnknownJobKind   = errors.New("no handler is registered for the job kind")
+	ErrJobLeaseLost     = errors.New("job was claimed again after its lease expired")
+)
+
+// Domain errors for backup and restore.
+var (
+	ErrInvalidBackupRecord = errors.New("backup record is malformed or of an unsupported format")
+)
+
+// Domain errors for request quotas.
+var (
+	ErrQuotaNotFound = errors.New("no request quota applies to the caller")
+)
+
+// Domain errors for payments.
+var (
+	ErrPaymentsDisabled           = errors.New("no payment provider is configured")
+	ErrInvalidPaymentMethod       = errors.New("payment method is required")
+	ErrOrderNotPayable            = errors.New("only pending orders, or orders held after a failed payment, can be paid")
+	ErrPaymentProviderUnavailable = errors.New("payment provider is unavailable")
+	ErrInvalidPaymentWebhook      = errors.New("payment webhook is not signed by the provider or is malformed")
+)
+
+// ErrInjectedFault is the error returned by fault injection (CHAOS_ENABLED)
+var ErrInjectedFault = errors.New("fault injected for resilience testing")
@@ -0,0 +1,193 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ItemStatus is the fulfillment state of a single order item. Items of one
+// order may be at different states, so an order can ship in several consignments.
+type ItemStatus string
+
+// Valid item statuses.
+const (
+	ItemStatusPending   ItemStatus = "pending"
+	ItemStatusPicked    ItemStatus = "picked"
+	ItemStatusShipped   ItemStatus = "shipped"
+	ItemStatusDelivered ItemStatus = "delivered"
+	ItemStatusReturned  ItemStatus = "returned"
+)
+
+// ValidItemStatuses returns all valid item statuses
+func ValidItemStatuses() []ItemStatus {
+	return []ItemStatus{
+		ItemStatusPending,
+		ItemStatusPicked,
+		ItemStatusShipped,
+		ItemStatusDelivered,
+		ItemStatusReturned,
+	}
+}
+
+// IsValid reports whether s is one of ValidItemStatuses
+func (s ItemStatus) IsValid() bool {
+	for _, status := range ValidItemStatuses() {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// CanTransitionTo checks if an item status transition is valid. Picking is
+// optional, and an item can be returned whether or not it was delivered.
+func (s ItemStatus) CanTransitionTo(newStatus ItemStatus) bool {
+	validTransitions := map[ItemStatus][]ItemStatus{
+		ItemStatusPending:   {ItemStatusPicked, ItemStatusShipped},
+		ItemStatusPicked:    {ItemStatusShipped},
+		ItemStatusShipped:   {ItemStatusDelivered, ItemStatusReturned},
+		ItemStatusDelivered: {ItemStatusReturned},
+		ItemStatusReturned:  {},
+	}
+
+	for _, status := range validTransitions[s] {
+		if status == newStatus {
+			return true
+		}
+	}
+	return false
+}
+
+// shipped reports whether the item has left the warehouse
+func (s ItemStatus) shipped() bool {
+	return s == ItemStatusShipped || s == ItemStatusDelivered || s == ItemStatusReturned
+}
+
+// done reports whether the item needs no further fulfillment
+func (s ItemStatus) done() bool {
+	return s == ItemStatusDelivered || s == ItemStatusReturned
+}
+
+// fulfillmentRank orders the statuses an order moves through while its
+// items are fulfilled; other statuses rank 0
+func (s OrderStatus) fulfillmentRank() int {
+	switch s {
+	case OrderStatusConfirmed:
+		return 1
+	case OrderStatusProcessing:
+		return 2
+	case OrderStatusShipped:
+		return 3
+	case OrderStatusDelivered:
+		return 4
+	}
+	return 0
+}
+
+// CanChangeItems reports whether items may be added to, changed on or
+// removed from an order in this status one at a time. Once processing
+// starts the items may already be picked.
+func (s OrderStatus) CanChangeItems() bool {
+	return s == OrderStatusPending || s == OrderStatusConfirmed
+}
+
+// FulfillmentStarted reports whether any item has moved past pending; an
+// unset status counts as pending
+func (o *Order) FulfillmentStarted() bool {
+	for _, item := range o.Items {
+		if item.Status != ItemStatusPending && item.Status != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// SetItemStatus moves the items with itemIDs to status and advances the
+// order status to match its items. Items can only change while the order is
+// confirmed, processing, shipped or delivered. Either every item changes or,
+// on error, none does.
+func (o *Order) SetItemStatus(itemIDs []uuid.UUID, status ItemStatus, now time.Time) error {
+	if !status.IsValid() {
+		return ErrInvalidItemStatus
+	}
+	if o.Status.fulfillmentRank() == 0 {
+		return ErrInvalidTransition
+	}
+
+	indexes := make([]int, len(itemIDs))
+	for i, id := range itemIDs {
+		indexes[i] = -1
+		for j, item := range o.Items {
+			if item.ID == id {
+				indexes[i] = j
+				break
+			}
+		}
+		if indexes[i] < 0 {
+			return ErrItemNotFound
+		}
+		if !o.Items[indexes[i]].Status.CanTransitionTo(status) {
+			return ErrInvalidItemTransition
+		}
+	}
+
+	for _, j := range indexes {
+		o.Items[j].Status = status
+	}
+	if derived := o.derivedStatus(); derived.fulfillmentRank() > o.Status.fulfillmentRank() {
+		o.Status = derived
+	}
+	o.UpdatedAt = now
+	return nil
+}
+
+// derivedStatus is the order status implied by its items: delivered once
+// every item is delivered or returned, shipped once every item has shipped,
+// and processing once any item has been picked or shipped
+func (o *Order) derivedStatus() OrderStatus {
+	allShipped, allDone := len(o.Items) > 0, len(o.Items) > 0
+	for _, item := range o.Items {
+		allShipped = allShipped && item.Status.shipped()
+		allDone = allDone && item.Status.done()
+	}
+
+	switch {
+	case allDone:
+		return OrderStatusDelivered
+	case allShipped:
+		return OrderStatusShipped
+	case o.FulfillmentStarted():
+		return OrderStatusProcessing
+	default:
+		return o.Status
+	}
+}
+
+// ApplyStatusToItems brings items up to the order status after the order
+// itself was moved on: shipping an order ships its remaining items and
+// delivering it delivers every shipped item
+func (o *Order) ApplyStatusToItems() {
+	for i, item := range o.Items {
+		switch {
+		case o.Status == OrderStatusShipped && !item.Status.shipped():
+			o.Items[i].Status = ItemStatusShipped
+		case o.Status == OrderStatusDelivered && !item.Status.done():
+			o.Items[i].Status = ItemStatusDelivered
+		}
+	}
+}
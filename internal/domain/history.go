@@ -0,0 +1,171 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HistoryAction identifies the kind of mutation recorded in an order's history
+type HistoryAction string
+
+// Recorded order mutations.
+const (
+	HistoryActionCreated       HistoryAction = "created"
+	HistoryActionItemsChanged  HistoryAction = "items_changed"
+	HistoryActionStatusChanged HistoryAction = "status_changed"
+	HistoryActionUpdated       HistoryAction = "updated"
+	HistoryActionDeleted       HistoryAction = "deleted"
+	HistoryActionRestored      HistoryAction = "restored"
+	HistoryActionErased        HistoryAction = "erased"
+	HistoryActionHeld          HistoryAction = "held"
+	HistoryActionReleased      HistoryAction = "released"
+)
+
+// Actors used when no caller identity is available.
+const (
+	ActorAnonymous = "anonymous"
+	ActorSystem    = "system"
+)
+
+// ActorType is the kind of caller performing a mutation
+type ActorType string
+
+// Actor types.
+const (
+	// ActorTypeUser is a caller named by a bearer token or the X-Actor header
+	ActorTypeUser ActorType = "user"
+	// ActorTypeAPIKey is a caller presenting the admin API key
+	ActorTypeAPIKey ActorType = "api_key"
+	// ActorTypeSystem is a background job of the service itself
+	ActorTypeSystem ActorType = "system"
+	// ActorTypeAnonymous is a caller that did not identify itself
+	ActorTypeAnonymous ActorType = "anonymous"
+)
+
+// Channel is the way a mutation reached the service
+type Channel string
+
+// Channels.
+const (
+	ChannelHTTP   Channel = "http"
+	ChannelGRPC   Channel = "grpc"
+	ChannelWorker Channel = "worker"
+)
+
+// OrderHistoryEntry is one recorded mutation of an order.
+// OldState is nil for creation and erasure; NewState holds the order after the change.
+// ActorType and Channel are empty for entries recorded before they were.
+type OrderHistoryEntry struct {
+	ID        uuid.UUID
+	OrderID   uuid.UUID
+	Action    HistoryAction
+	Actor     string
+	ActorType ActorType
+	Channel   Channel
+	OldState  *Order
+	NewState  *Order
+	CreatedAt time.Time
+}
+
+// ClassifyChange picks the history action describing the change from old to updated
+func ClassifyChange(old, updated *Order) HistoryAction {
+	statusChanged := old.Status != updated.Status
+	itemsChanged := !sameItems(old.Items, updated.Items)
+
+	switch {
+	case statusChanged && updated.Status == OrderStatusOnHold:
+		return HistoryActionHeld
+	case statusChanged && old.Status == OrderStatusOnHold && updated.Status != OrderStatusCancelled:
+		return HistoryActionReleased
+	case statusChanged && !itemsChanged:
+		return HistoryActionStatusChanged
+	case itemsChanged && !statusChanged:
+		return HistoryActionItemsChanged
+	default:
+		return HistoryActionUpdated
+	}
+}
+
+func sameItems(a, b []OrderItem) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+type actorKey struct{}
+
+// WithActor returns a context carrying the identity performing a mutation
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor set by WithActor, or ActorAnonymous
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return ActorAnonymous
+}
+
+type actorTypeKey struct{}
+
+// WithActorType returns a context recording what kind of caller the actor is
+func WithActorType(ctx context.Context, actorType ActorType) context.Context {
+	return context.WithValue(ctx, actorTypeKey{}, actorType)
+}
+
+// ActorTypeFromContext returns the type set by WithActorType. Without one, a
+// named actor is a user and an unnamed one anonymous.
+func ActorTypeFromContext(ctx context.Context) ActorType {
+	if actorType, ok := ctx.Value(actorTypeKey{}).(ActorType); ok && actorType != "" {
+		return actorType
+	}
+	if ActorFromContext(ctx) == ActorAnonymous {
+		return ActorTypeAnonymous
+	}
+	return ActorTypeUser
+}
+
+type channelKey struct{}
+
+// WithChannel returns a context recording how a mutation reached the service
+func WithChannel(ctx context.Context, channel Channel) context.Context {
+	return context.WithValue(ctx, channelKey{}, channel)
+}
+
+// ChannelFromContext returns the channel set by WithChannel, or "" outside
+// the APIs and workers, as when seeding
+func ChannelFromContext(ctx context.Context) Channel {
+	channel, _ := ctx.Value(channelKey{}).(Channel)
+	return channel
+}
+
+// WithSystemActor returns a context for a background job: the system actor,
+// reached through the worker channel
+func WithSystemActor(ctx context.Context) context.Context {
+	ctx = WithActor(ctx, ActorSystem)
+	ctx = WithActorType(ctx, ActorTypeSystem)
+	return WithChannel(ctx, ChannelWorker)
+}
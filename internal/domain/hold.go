@@ -0,0 +1,76 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"time"
+	"unicode/utf8"
+)
+
+// MaxHoldReasonLength caps the length of a hold reason in characters
+const MaxHoldReasonLength = 500
+
+// OrderHold records why an order was put on hold and what it returns to
+type OrderHold struct {
+	Reason string
+	// PreviousStatus is the status the order is released back to
+	PreviousStatus OrderStatus
+	HeldAt         time.Time
+	// ReleaseAt, if set, is when the order is released automatically
+	ReleaseAt *time.Time
+}
+
+// CanHold reports whether an order in this status may be put on hold.
+// Orders that have shipped or reached a final status cannot be held.
+func (s OrderStatus) CanHold() bool {
+	switch s {
+	case OrderStatusPending, OrderStatusConfirmed, OrderStatusProcessing:
+		return true
+	}
+	return false
+}
+
+// PlaceOnHold moves the order to on_hold, remembering its current status for
+// Release. A nil releaseAt holds the order until it is released explicitly.
+func (o *Order) PlaceOnHold(reason string, releaseAt *time.Time, now time.Time) error {
+	if reason == "" || utf8.RuneCountInString(reason) > MaxHoldReasonLength {
+		return ErrInvalidHoldReason
+	}
+	if !o.Status.CanHold() {
+		return ErrInvalidTransition
+	}
+
+	o.Hold = &OrderHold{
+		Reason:         reason,
+		PreviousStatus: o.Status,
+		HeldAt:         now,
+		ReleaseAt:      releaseAt,
+	}
+	o.Status = OrderStatusOnHold
+	o.UpdatedAt = now
+	return nil
+}
+
+// Release returns a held order to the status it was held from
+func (o *Order) Release(now time.Time) error {
+	if o.Status != OrderStatusOnHold || o.Hold == nil {
+		return ErrOrderNotHeld
+	}
+
+	o.Status = o.Hold.PreviousStatus
+	o.Hold = nil
+	o.UpdatedAt = now
+	return nil
+}
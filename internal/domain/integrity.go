@@ -0,0 +1,52 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import "time"
+
+// OrderAmounts are an order's stored total beside the amounts its items add
+// up to, as read by the total check
+type OrderAmounts struct {
+	OrderID  string
+	TenantID string
+	// Total is the stored order total
+	Total float64
+	// ItemTotal is the sum of price × quantity over the items
+	ItemTotal float64
+	// BadSubtotals counts items whose stored subtotal is not price × quantity
+	BadSubtotals int
+}
+
+// Consistent reports whether the stored amounts match the items, to the cent
+func (a OrderAmounts) Consistent() bool {
+	return a.BadSubtotals == 0 && MoneyFromFloat(a.Total) == MoneyFromFloat(a.ItemTotal)
+}
+
+// TotalCheckReport is the outcome of one pass over the order totals
+type TotalCheckReport struct {
+	StartedAt  time.Time
+	FinishedAt time.Time
+	// Checked counts the live orders looked at
+	Checked int
+	// MismatchCount counts the inconsistent orders found, of which the
+	// first MaxReportedMismatches are listed in Mismatches
+	MismatchCount int
+	Mismatches    []OrderAmounts
+	// Repaired counts the mismatches corrected in this pass
+	Repaired int
+}
+
+// MaxReportedMismatches caps the mismatches listed in a TotalCheckReport
+const MaxReportedMismatches = 1000
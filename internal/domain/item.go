@@ -24,11 +24,15 @@ type OrderItem struct {
 	Quantity  int
 	Price     float64
 	Subtotal  float64
+	// Status is the item's fulfillment state; new items are ItemStatusPending
+	Status ItemStatus
 }
 
-// CalculateSubtotal computes item subtotal
+// CalculateSubtotal computes the item subtotal in currency units, e.g. 25.98
+// for two items at 12.99. The price is rounded to the cent and multiplied
+// in whole cents, so the result is exact to the cent.
 func (i *OrderItem) CalculateSubtotal() float64 {
-	return float64(i.Quantity) * i.Price
+	return (MoneyFromFloat(i.Price) * Money(i.Quantity)).Float64()
 }
 
 // Validate performs item validation
@@ -42,7 +46,8 @@ func (i *OrderItem) Validate() error {
 	if i.Quantity <= 0 {
 		return ErrInvalidQuantity
 	}
-	if i.Price <= 0 {
+	// A price that rounds to zero cents is no price
+	if MoneyFromFloat(i.Price) <= 0 {
 		return ErrInvalidPrice
 	}
 	return nil
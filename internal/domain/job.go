@@ -0,0 +1,128 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobKind names the work a job does; the worker runs each kind with its own handler
+type JobKind string
+
+// Job kinds run by the worker.
+const (
+	JobKindRetentionPurge   JobKind = "retention_purge"
+	JobKindSLACheck         JobKind = "sla_check"
+	JobKindHoldRelease      JobKind = "hold_release"
+	JobKindPendingExpiry    JobKind = "pending_expiry"
+	JobKindSubscriptionRun  JobKind = "subscription_run"
+	JobKindDeadLetterRelay  JobKind = "dead_letter_relay"
+	JobKindFinishedJobPurge JobKind = "finished_job_purge"
+	JobKindTotalCheck       JobKind = "total_check"
+	JobKindOrderArchive     JobKind = "order_archive"
+)
+
+// JobStatus is where a job is in its lifecycle
+type JobStatus string
+
+// Job statuses. A queued job runs once RunAt has passed; a running job whose
+// lease (RunAt) expires is claimed again, e.g. after its worker stopped.
+const (
+	JobStatusQueued    JobStatus = "queued"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	JobStatusFailed    JobStatus = "failed"
+)
+
+// ValidJobStatuses returns all valid job statuses
+func ValidJobStatuses() []JobStatus {
+	return []JobStatus{JobStatusQueued, JobStatusRunning, JobStatusSucceeded, JobStatusFailed}
+}
+
+// IsValid reports whether s is one of ValidJobStatuses
+func (s JobStatus) IsValid() bool {
+	for _, status := range ValidJobStatuses() {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFinished reports whether a job in status s will not run again
+func (s JobStatus) IsFinished() bool {
+	return s == JobStatusSucceeded || s == JobStatusFailed
+}
+
+// Job is a unit of asynchronous work in the job queue
+type Job struct {
+	ID   uuid.UUID
+	Kind JobKind
+	// Payload is the kind-specific input; periodic jobs have none
+	Payload json.RawMessage
+	// UniqueKey, if set, allows only one queued or running job with the key
+	UniqueKey string
+	Status    JobStatus
+	// Attempts counts the claims so far, including the running one
+	Attempts    int
+	MaxAttempts int
+	// RunAt is when a queued job becomes due, or when a running job's lease expires
+	RunAt      time.Time
+	LastError  string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	FinishedAt *time.Time
+}
+
+// NewJob creates a queued job that becomes due at runAt and is attempted up
+// to maxAttempts times
+func NewJob(kind JobKind, payload json.RawMessage, runAt time.Time, maxAttempts int) *Job {
+	now := time.Now()
+	return &Job{
+		ID:          uuid.New(),
+		Kind:        kind,
+		Payload:     payload,
+		Status:      JobStatusQueued,
+		MaxAttempts: maxAttempts,
+		RunAt:       runAt,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// Succeed finishes a running job
+func (j *Job) Succeed(now time.Time) {
+	j.Status = JobStatusSucceeded
+	j.LastError = ""
+	j.UpdatedAt = now
+	j.FinishedAt = &now
+}
+
+// Fail records a failed attempt. The job is queued again at retryAt while it
+// has attempts left and fails for good otherwise.
+func (j *Job) Fail(cause string, now, retryAt time.Time) {
+	j.LastError = cause
+	j.UpdatedAt = now
+	if j.Attempts < j.MaxAttempts {
+		j.Status = JobStatusQueued
+		j.RunAt = retryAt
+		return
+	}
+	j.Status = JobStatusFailed
+	j.FinishedAt = &now
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Limits on order metadata and tags.
+const (
+	MaxMetadataEntries     = 50
+	MaxMetadataKeyLength   = 64
+	MaxMetadataValueLength = 512
+	MaxTags                = 20
+	MaxTagLength           = 64
+)
+
+// SetLabels replaces the order's metadata and tags. Metadata is free-form
+// key/value data for integrators; tags are short labels orders can be
+// filtered by. Tags are trimmed, lowercased and deduplicated, keeping the
+// first occurrence. Nothing changes on error.
+func (o *Order) SetLabels(metadata map[string]string, tags []string) error {
+	if err := validateMetadata(metadata); err != nil {
+		return err
+	}
+	normalized, err := NormalizeTags(tags)
+	if err != nil {
+		return err
+	}
+
+	o.Metadata = make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		o.Metadata[k] = v
+	}
+	o.Tags = normalized
+	return nil
+}
+
+// validateMetadata checks the number of entries and the length of each key and value
+func validateMetadata(metadata map[string]string) error {
+	if len(metadata) > MaxMetadataEntries {
+		return ErrInvalidMetadata
+	}
+	for k, v := range metadata {
+		if k == "" || utf8.RuneCountInString(k) > MaxMetadataKeyLength || utf8.RuneCountInString(v) > MaxMetadataValueLength {
+			return ErrInvalidMetadata
+		}
+	}
+	return nil
+}
+
+// NormalizeTags trims, lowercases and deduplicates tags, returning
+// ErrInvalidTag for an empty or overlong tag or more than MaxTags tags
+func NormalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || utf8.RuneCountInString(tag) > MaxTagLength {
+			return nil, ErrInvalidTag
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	if len(normalized) > MaxTags {
+		return nil, ErrInvalidTag
+	}
+	return normalized, nil
+}
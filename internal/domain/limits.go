@@ -0,0 +1,46 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+// OrderLimits caps the size of an order so that absurd or abusive orders are
+// rejected. A zero field sets no limit.
+type OrderLimits struct {
+	// MaxItems caps the number of items in an order
+	MaxItems int
+	// MaxItemQuantity caps the quantity of each item
+	MaxItemQuantity int
+	// MaxTotal caps the order total
+	MaxTotal Money
+}
+
+// CheckLimits returns ErrTooManyItems, ErrQuantityLimitExceeded or
+// ErrTotalLimitExceeded if the order exceeds limits. The total is computed
+// from the items rather than read from o.Total.
+func (o *Order) CheckLimits(limits OrderLimits) error {
+	if limits.MaxItems > 0 && len(o.Items) > limits.MaxItems {
+		return ErrTooManyItems
+	}
+	if limits.MaxItemQuantity > 0 {
+		for _, item := range o.Items {
+			if item.Quantity > limits.MaxItemQuantity {
+				return ErrQuantityLimitExceeded
+			}
+		}
+	}
+	if limits.MaxTotal > 0 && MoneyFromFloat(o.CalculateTotal()) > limits.MaxTotal {
+		return ErrTotalLimitExceeded
+	}
+	return nil
+}
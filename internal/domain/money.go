@@ -0,0 +1,60 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Money is an amount in minor units, hundredths of the currency unit.
+// Prices, subtotals and totals stay float64 in the API and are two-place
+// decimals in the database, but arithmetic on them goes through Money so
+// that sums such as 3 × 0.10 come out exact.
+type Money int64
+
+// MoneyFromFloat rounds an amount to the nearest minor unit, halves away
+// from zero, as PostgreSQL rounds numeric values. The amount is rounded as
+// the shortest decimal that reads back as it, so 1.005 becomes 1.01 even
+// though the float64 closest to 1.005 lies just below it.
+func MoneyFromFloat(amount float64) Money {
+	digits := strconv.FormatFloat(math.Abs(amount), 'f', -1, 64)
+	whole, frac, _ := strings.Cut(digits, ".")
+	frac += "000"
+	units, err := strconv.ParseInt(whole+frac[:2], 10, 64)
+	if err != nil {
+		// Beyond int64 minor units, where float64 holds no fractions anyway
+		return Money(math.Round(amount * 100))
+	}
+	if frac[2] >= '5' {
+		units++
+	}
+	if amount < 0 {
+		units = -units
+	}
+	return Money(units)
+}
+
+// Float64 returns the amount in currency units. The result is the float64
+// closest to the decimal, so it prints with at most two decimal places.
+func (m Money) Float64() float64 {
+	return float64(m) / 100
+}
+
+// RoundMoney rounds an amount to whole minor units
+func RoundMoney(amount float64) float64 {
+	return MoneyFromFloat(amount).Float64()
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxNoteLength caps the length of an order note body
+const MaxNoteLength = 2000
+
+// NoteVisibility decides who may read an order note
+type NoteVisibility string
+
+// Note visibilities.
+const (
+	// NoteVisibilityCustomer notes are shown to the customer who owns the order
+	NoteVisibilityCustomer NoteVisibility = "customer"
+	// NoteVisibilityInternal notes are only shown to service callers
+	NoteVisibilityInternal NoteVisibility = "internal"
+)
+
+// IsValid reports whether v is a known visibility
+func (v NoteVisibility) IsValid() bool {
+	return v == NoteVisibilityCustomer || v == NoteVisibilityInternal
+}
+
+// OrderNote is a free-text comment attached to an order. Notes are not part
+// of the order itself: adding one does not change the order's version.
+type OrderNote struct {
+	ID         uuid.UUID
+	OrderID    uuid.UUID
+	Author     string
+	Body       string
+	Visibility NoteVisibility
+	CreatedAt  time.Time
+}
+
+// NewOrderNote validates and creates a note on orderID
+func NewOrderNote(orderID uuid.UUID, author, body string, visibility NoteVisibility, now time.Time) (*OrderNote, error) {
+	if body == "" || len(body) > MaxNoteLength {
+		return nil, ErrInvalidNote
+	}
+	if !visibility.IsValid() {
+		return nil, ErrInvalidNoteVisibility
+	}
+	return &OrderNote{
+		ID:         uuid.New(),
+		OrderID:    orderID,
+		Author:     author,
+		Body:       body,
+		Visibility: visibility,
+		CreatedAt:  now,
+	}, nil
+}
@@ -28,6 +28,7 @@ const (
 	OrderStatusPending    OrderStatus = "pending"
 	OrderStatusConfirmed  OrderStatus = "confirmed"
 	OrderStatusProcessing OrderStatus = "processing"
+	OrderStatusOnHold     OrderStatus = "on_hold"
 	OrderStatusShipped    OrderStatus = "shipped"
 	OrderStatusDelivered  OrderStatus = "delivered"
 	OrderStatusCancelled  OrderStatus = "cancelled"
@@ -39,18 +40,32 @@ func ValidStatuses() []OrderStatus {
 		OrderStatusPending,
 		OrderStatusConfirmed,
 		OrderStatusProcessing,
+		OrderStatusOnHold,
 		OrderStatusShipped,
 		OrderStatusDelivered,
 		OrderStatusCancelled,
 	}
 }
 
-// CanTransitionTo checks if status transition is valid
+// IsValid reports whether s is one of ValidStatuses
+func (s OrderStatus) IsValid() bool {
+	for _, status := range ValidStatuses() {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// CanTransitionTo checks if status transition is valid. Entering and leaving
+// on_hold other than by cancelling goes through Order.PlaceOnHold and
+// Order.Release, so those transitions are not listed here.
 func (s OrderStatus) CanTransitionTo(newStatus OrderStatus) bool {
 	validTransitions := map[OrderStatus][]OrderStatus{
 		OrderStatusPending:    {OrderStatusConfirmed, OrderStatusCancelled},
 		OrderStatusConfirmed:  {OrderStatusProcessing, OrderStatusCancelled},
 		OrderStatusProcessing: {OrderStatusShipped, OrderStatusCancelled},
+		OrderStatusOnHold:     {OrderStatusCancelled},
 		OrderStatusShipped:    {OrderStatusDelivered},
 		OrderStatusDelivered:  {},
 		OrderStatusCancelled:  {},
@@ -67,7 +82,10 @@ func (s OrderStatus) CanTransitionTo(newStatus OrderStatus) bool {
 
 // Order represents a customer order
 type Order struct {
-	ID         uuid.UUID
+	ID uuid.UUID
+	// TenantID is the tenant the order belongs to; empty in a deployment
+	// without tenants
+	TenantID   string
 	CustomerID string
 	Items      []OrderItem
 	Status     OrderStatus
@@ -76,15 +94,87 @@ type Order struct {
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 	DeletedAt  *time.Time
+	// Hold is set while the order is on hold
+	Hold *OrderHold
+	// Metadata and Tags are set through SetLabels
+	Metadata map[string]string
+	Tags     []string
+	// ShippingAddress and BillingAddress are optional and set through
+	// SetAddresses or ChangeAddresses
+	ShippingAddress *Address
+	BillingAddress  *Address
+	// ShippingMethod selects the transit time used for EstimatedDeliveryAt,
+	// which is set when the order is confirmed
+	ShippingMethod      string
+	EstimatedDeliveryAt *time.Time
+	// SLABreachedAt is set once the order is flagged as overdue
+	SLABreachedAt *time.Time
+}
+
+// Clone returns a copy of the order that shares no memory with it
+func (o *Order) Clone() *Order {
+	c := *o
+	c.Items = append([]OrderItem(nil), o.Items...)
+	if o.DeletedAt != nil {
+		deletedAt := *o.DeletedAt
+		c.DeletedAt = &deletedAt
+	}
+	if o.Hold != nil {
+		hold := *o.Hold
+		if o.Hold.ReleaseAt != nil {
+			releaseAt := *o.Hold.ReleaseAt
+			hold.ReleaseAt = &releaseAt
+		}
+		c.Hold = &hold
+	}
+	if o.Metadata != nil {
+		c.Metadata = make(map[string]string, len(o.Metadata))
+		for k, v := range o.Metadata {
+			c.Metadata[k] = v
+		}
+	}
+	c.Tags = append([]string(nil), o.Tags...)
+	if o.ShippingAddress != nil {
+		shipping := *o.ShippingAddress
+		c.ShippingAddress = &shipping
+	}
+	if o.BillingAddress != nil {
+		billing := *o.BillingAddress
+		c.BillingAddress = &billing
+	}
+	if o.EstimatedDeliveryAt != nil {
+		estimatedDeliveryAt := *o.EstimatedDeliveryAt
+		c.EstimatedDeliveryAt = &estimatedDeliveryAt
+	}
+	if o.SLABreachedAt != nil {
+		slaBreachedAt := *o.SLABreachedAt
+		c.SLABreachedAt = &slaBreachedAt
+	}
+	return &c
 }
 
-// CalculateTotal computes the total from items
+// CalculateTotal computes the total from item subtotals in minor units
 func (o *Order) CalculateTotal() float64 {
-	total := 0.0
+	var total Money
 	for _, item := range o.Items {
-		total += item.Subtotal
+		total += MoneyFromFloat(item.Subtotal)
+	}
+	return total.Float64()
+}
+
+// RecalculateAmounts recomputes each item subtotal and the order total from
+// prices and quantities, reporting whether any amount was off by a cent or more
+func (o *Order) RecalculateAmounts() bool {
+	changed := false
+	for i := range o.Items {
+		subtotal := o.Items[i].CalculateSubtotal()
+		changed = changed || MoneyFromFloat(o.Items[i].Subtotal) != MoneyFromFloat(subtotal)
+		o.Items[i].Subtotal = subtotal
 	}
-	return total
+	total := o.CalculateTotal()
+	changed = changed || MoneyFromFloat(o.Total) != MoneyFromFloat(total)
+	o.Total = total
+	return changed
 }
 
 // Validate performs domain validation
@@ -21,4 +21,9 @@ type PaginatedOrders struct {
 	PageSize   int
 	TotalCount int64
 	TotalPages int
+	// TotalEstimated is set when TotalCount comes from table statistics
+	// rather than an exact count
+	TotalEstimated bool
+	// Offset is the number of orders before this page
+	Offset int
 }
@@ -0,0 +1,114 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// PaymentStatus is the outcome of a payment as its provider reports it
+type PaymentStatus string
+
+// Payment statuses
+const (
+	// PaymentSucceeded means the money was collected
+	PaymentSucceeded PaymentStatus = "succeeded"
+	// PaymentPending means the provider confirms the payment later, by webhook
+	PaymentPending PaymentStatus = "pending"
+	// PaymentFailed means the payment was declined or abandoned
+	PaymentFailed PaymentStatus = "failed"
+)
+
+// PaymentHoldReasonPrefix starts the reason of every hold placed because a
+// payment failed, which tells those holds apart from holds placed by people
+const PaymentHoldReasonPrefix = "payment failed"
+
+// PaymentMismatchHoldReasonPrefix starts the reason of a hold placed because
+// a successful payment did not cover the order, e.g. after its items changed
+// while the payment was pending. Paying again would charge twice, so unlike
+// a failed payment only an operator releases these holds.
+const PaymentMismatchHoldReasonPrefix = "payment mismatch"
+
+// Payment is one attempt to collect an order's total through a provider
+type Payment struct {
+	// Provider names the provider, e.g. "stripe"
+	Provider string
+	// Reference is the provider's ID of the payment
+	Reference string
+	OrderID   string
+	// TenantID is the tenant of the order, carried through the provider so
+	// its webhooks can be matched to the order
+	TenantID string
+	Amount   Money
+	// Currency is a lowercase ISO 4217 code
+	Currency string
+	Status   PaymentStatus
+	// FailureCode is the provider's reason for a failed payment, e.g.
+	// "card_declined" or "insufficient_funds"
+	FailureCode    string
+	FailureMessage string
+}
+
+// HoldReason returns the reason an order is held with after this payment
+// failed, e.g. "payment failed: insufficient_funds: Your card has
+// insufficient funds."
+func (p *Payment) HoldReason() string {
+	reason := PaymentHoldReasonPrefix
+	for _, part := range []string{p.FailureCode, p.FailureMessage} {
+		if part != "" {
+			reason += ": " + part
+		}
+	}
+	if utf8.RuneCountInString(reason) > MaxHoldReasonLength {
+		reason = string([]rune(reason)[:MaxHoldReasonLength])
+	}
+	return reason
+}
+
+// Mismatch returns the reason an order is held with when this payment does
+// not pay total in currency, e.g. "payment mismatch: paid 10.00 usd, order
+// total 12.50 usd", or "" when it does
+func (p *Payment) Mismatch(total Money, currency string) string {
+	if p.Amount == total && strings.EqualFold(p.Currency, currency) {
+		return ""
+	}
+	return fmt.Sprintf("%s: paid %.2f %s, order total %.2f %s",
+		PaymentMismatchHoldReasonPrefix, p.Amount.Float64(), p.Currency, total.Float64(), currency)
+}
+
+// IsPaymentHoldReason reports whether reason is that of a hold placed
+// because of a payment. Those holds wait for a payment or an operator, so
+// they are never released automatically.
+func IsPaymentHoldReason(reason string) bool {
+	return strings.HasPrefix(reason, PaymentHoldReasonPrefix) ||
+		strings.HasPrefix(reason, PaymentMismatchHoldReasonPrefix)
+}
+
+// HeldForPaymentMismatch reports whether the order is on hold because a
+// payment did not cover it, so only an operator may release it
+func (o *Order) HeldForPaymentMismatch() bool {
+	return o.Status == OrderStatusOnHold && o.Hold != nil &&
+		strings.HasPrefix(o.Hold.Reason, PaymentMismatchHoldReasonPrefix)
+}
+
+// HeldForPayment reports whether the order is on hold because a payment
+// failed, so a successful payment may release it
+func (o *Order) HeldForPayment() bool {
+	return o.Status == OrderStatusOnHold && o.Hold != nil &&
+		o.Hold.PreviousStatus == OrderStatusPending &&
+		strings.HasPrefix(o.Hold.Reason, PaymentHoldReasonPrefix)
+}
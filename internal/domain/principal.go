@@ -0,0 +1,86 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrAccessDenied is returned when the caller's token does not grant access
+// to the orders involved
+var ErrAccessDenied = errors.New("access to another customer's orders denied")
+
+// Role is the kind of caller a Principal represents
+type Role string
+
+const (
+	// RoleService callers (other services, operators) may access every order
+	RoleService Role = "service"
+	// RoleCustomer callers may only access orders of their own customer ID
+	RoleCustomer Role = "customer"
+)
+
+// IsValid reports whether r is a known role
+func (r Role) IsValid() bool {
+	return r == RoleService || r == RoleCustomer
+}
+
+// Principal is the authenticated caller of a request
+type Principal struct {
+	Subject string
+	Role    Role
+	// CustomerID is the customer a RoleCustomer principal acts for
+	CustomerID string
+	// TenantID is the tenant named by the token's tenant_id claim, if any
+	TenantID string
+}
+
+// CanAccess reports whether p may read and modify orders of customerID
+func (p *Principal) CanAccess(customerID string) bool {
+	return p.Role == RoleService || (p.Role == RoleCustomer && p.CustomerID == customerID)
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a context carrying the authenticated caller
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal set by WithPrincipal, if any
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
+
+// AuthorizeCustomer returns ErrAccessDenied if ctx carries a principal that
+// may not access orders of customerID. Without a principal (authentication
+// disabled, background jobs) access is not restricted.
+func AuthorizeCustomer(ctx context.Context, customerID string) error {
+	if p, ok := PrincipalFromContext(ctx); ok && !p.CanAccess(customerID) {
+		return ErrAccessDenied
+	}
+	return nil
+}
+
+// AuthorizeAllCustomers returns ErrAccessDenied if ctx carries a principal
+// limited to a single customer, for operations spanning every customer.
+func AuthorizeAllCustomers(ctx context.Context) error {
+	if p, ok := PrincipalFromContext(ctx); ok && p.Role != RoleService {
+		return ErrAccessDenied
+	}
+	return nil
+}
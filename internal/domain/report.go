@@ -0,0 +1,102 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Report errors.
+var (
+	ErrInvalidGroupBy     = errors.New("invalid report grouping")
+	ErrInvalidReportRange = errors.New("report start must be before its end")
+)
+
+// ReportGroupBy is the dimension an order report is aggregated by
+type ReportGroupBy string
+
+// Report groupings. Day, week and month bucket orders by creation time in UTC;
+// weeks start on Monday.
+const (
+	ReportGroupByDay      ReportGroupBy = "day"
+	ReportGroupByWeek     ReportGroupBy = "week"
+	ReportGroupByMonth    ReportGroupBy = "month"
+	ReportGroupByStatus   ReportGroupBy = "status"
+	ReportGroupByCustomer ReportGroupBy = "customer"
+)
+
+// IsValid reports whether g is a known grouping
+func (g ReportGroupBy) IsValid() bool {
+	switch g {
+	case ReportGroupByDay, ReportGroupByWeek, ReportGroupByMonth, ReportGroupByStatus, ReportGroupByCustomer:
+		return true
+	}
+	return false
+}
+
+// IsPeriod reports whether g buckets orders by time
+func (g ReportGroupBy) IsPeriod() bool {
+	return g == ReportGroupByDay || g == ReportGroupByWeek || g == ReportGroupByMonth
+}
+
+// OrderReportRow aggregates the orders in one report bucket
+type OrderReportRow struct {
+	// Key identifies the bucket: the period start date (YYYY-MM-DD),
+	// the order status, or the customer ID
+	Key        string
+	OrderCount int64
+	Revenue    float64
+}
+
+// OrderReport is the result of an order report query
+type OrderReport struct {
+	GroupBy      ReportGroupBy
+	From         *time.Time
+	To           *time.Time
+	Rows         []OrderReportRow
+	TotalOrders  int64
+	TotalRevenue float64
+}
+
+// OrderStats is a snapshot of order activity for operations dashboards
+type OrderStats struct {
+	GeneratedAt time.Time
+	// ByStatus counts live orders in each status, listing every status
+	ByStatus map[OrderStatus]int64
+	// TotalOrders is the sum of ByStatus
+	TotalOrders int64
+	// Today is the UTC date TodayOrders and TodayRevenue cover
+	Today        time.Time
+	TodayOrders  int64
+	TodayRevenue float64
+	Cache        CacheStats
+}
+
+// CacheStats counts order cache lookups since the process started
+type CacheStats struct {
+	Hits   int64
+	Misses int64
+}
+
+// HitRate returns the share of lookups served from the cache, or false
+// before the first lookup
+func (s CacheStats) HitRate() (float64, bool) {
+	lookups := s.Hits + s.Misses
+	if lookups == 0 {
+		return 0, false
+	}
+	return float64(s.Hits) / float64(lookups), true
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Cadence is how often a subscription places an order
+type Cadence string
+
+// Subscription cadences.
+const (
+	CadenceDaily    Cadence = "daily"
+	CadenceWeekly   Cadence = "weekly"
+	CadenceBiweekly Cadence = "biweekly"
+	CadenceMonthly  Cadence = "monthly"
+)
+
+// ValidCadences returns all valid subscription cadences
+func ValidCadences() []Cadence {
+	return []Cadence{CadenceDaily, CadenceWeekly, CadenceBiweekly, CadenceMonthly}
+}
+
+// IsValid reports whether c is one of ValidCadences
+func (c Cadence) IsValid() bool {
+	for _, cadence := range ValidCadences() {
+		if c == cadence {
+			return true
+		}
+	}
+	return false
+}
+
+// Next returns the run that follows t. Monthly runs keep the day of the
+// month, normalized as time.AddDate does, e.g. January 31 to March 3.
+func (c Cadence) Next(t time.Time) time.Time {
+	switch c {
+	case CadenceDaily:
+		return t.AddDate(0, 0, 1)
+	case CadenceWeekly:
+		return t.AddDate(0, 0, 7)
+	case CadenceBiweekly:
+		return t.AddDate(0, 0, 14)
+	default:
+		return t.AddDate(0, 1, 0)
+	}
+}
+
+// SubscriptionStatus is whether a subscription places orders
+type SubscriptionStatus string
+
+// Subscription statuses.
+const (
+	SubscriptionStatusActive SubscriptionStatus = "active"
+	SubscriptionStatusPaused SubscriptionStatus = "paused"
+)
+
+// Subscription is a recurring order template. While active, an order is
+// created from it at NextRunAt and NextRunAt moves on by one cadence.
+type Subscription struct {
+	ID         uuid.UUID
+	CustomerID string
+	// Items are the order lines of every order placed; their IDs, subtotals
+	// and statuses are set on each order
+	Items          []OrderItem
+	Cadence        Cadence
+	Status         SubscriptionStatus
+	NextRunAt      time.Time
+	ShippingMethod string
+	// ShippingAddress is optional
+	ShippingAddress *Address
+	// TenantID is the tenant the subscription and its orders belong to;
+	// empty in a deployment without tenants
+	TenantID  string
+	Version   int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewSubscription validates and creates an active subscription whose first
+// order is placed at firstRunAt, or at now if firstRunAt is zero
+func NewSubscription(customerID string, items []OrderItem, cadence Cadence, firstRunAt, now time.Time) (*Subscription, error) {
+	if firstRunAt.IsZero() {
+		firstRunAt = now
+	}
+	s := &Subscription{
+		ID:         uuid.New(),
+		CustomerID: customerID,
+		Status:     SubscriptionStatusActive,
+		NextRunAt:  firstRunAt,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := s.SetTemplate(items, cadence); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// SetTemplate replaces the items and cadence of the subscription. The next
+// run keeps its time.
+func (s *Subscription) SetTemplate(items []OrderItem, cadence Cadence) error {
+	if s.CustomerID == "" {
+		return ErrInvalidCustomerID
+	}
+	if len(items) == 0 {
+		return ErrNoItems
+	}
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			return err
+		}
+	}
+	if !cadence.IsValid() {
+		return ErrInvalidCadence
+	}
+	s.Items = append([]OrderItem(nil), items...)
+	s.Cadence = cadence
+	return nil
+}
+
+// IsDue reports whether the subscription should place an order at now
+func (s *Subscription) IsDue(now time.Time) bool {
+	return s.Status == SubscriptionStatusActive && !s.NextRunAt.After(now)
+}
+
+// Advance moves NextRunAt to the first run after now, once an order has been
+// placed for the due run. Runs missed while the scheduler was down are not
+// made up.
+func (s *Subscription) Advance(now time.Time) error {
+	if !s.IsDue(now) {
+		return ErrSubscriptionNotDue
+	}
+	for !s.NextRunAt.After(now) {
+		s.NextRunAt = s.Cadence.Next(s.NextRunAt)
+	}
+	s.UpdatedAt = now
+	return nil
+}
+
+// Skip moves NextRunAt on by one cadence without placing an order
+func (s *Subscription) Skip(now time.Time) {
+	s.NextRunAt = s.Cadence.Next(s.NextRunAt)
+	s.UpdatedAt = now
+}
+
+// Pause stops an active subscription from placing orders
+func (s *Subscription) Pause(now time.Time) error {
+	if s.Status != SubscriptionStatusActive {
+		return ErrInvalidSubscriptionTransition
+	}
+	s.Status = SubscriptionStatusPaused
+	s.UpdatedAt = now
+	return nil
+}
+
+// Resume reactivates a paused subscription. Runs that fell due while it was
+// paused are skipped, so the next order is placed at the first run after now.
+func (s *Subscription) Resume(now time.Time) error {
+	if s.Status != SubscriptionStatusPaused {
+		return ErrInvalidSubscriptionTransition
+	}
+	s.Status = SubscriptionStatusActive
+	for s.NextRunAt.Before(now) {
+		s.NextRunAt = s.Cadence.Next(s.NextRunAt)
+	}
+	s.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"context"
+	"errors"
+	"regexp"
+)
+
+// ErrTenantRequired is returned when a request does not identify its tenant
+// in a deployment that serves several
+var ErrTenantRequired = errors.New("tenant is required")
+
+// ErrInvalidTenantID is returned for a tenant ID that is not 1-63 lowercase
+// letters, digits and hyphens starting with a letter or digit
+var ErrInvalidTenantID = errors.New("invalid tenant ID")
+
+// tenantIDRe matches tenant IDs, which must also be valid DNS labels so a
+// tenant can be named by its subdomain
+var tenantIDRe = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,62}$`)
+
+// ValidTenantID reports whether id is a well-formed tenant ID
+func ValidTenantID(id string) bool {
+	return tenantIDRe.MatchString(id)
+}
+
+type tenantKey struct{}
+
+// WithTenant returns a context scoped to tenantID. Repositories then only
+// see that tenant's orders; an empty tenantID leaves ctx unscoped.
+func WithTenant(ctx context.Context, tenantID string) context.Context {
+	if tenantID == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, tenantKey{}, tenantID)
+}
+
+// TenantFromContext returns the tenant set by WithTenant, if any. Without
+// one (tenancy disabled, background jobs) data of every tenant is visible.
+func TenantFromContext(ctx context.Context) (string, bool) {
+	tenantID, ok := ctx.Value(tenantKey{}).(string)
+	return tenantID, ok && tenantID != ""
+}
+
+// TenantID returns the tenant set by WithTenant, or "" if there is none
+func TenantID(ctx context.Context) string {
+	tenantID, _ := TenantFromContext(ctx)
+	return tenantID
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package errcode is the registry of machine-readable error codes. Each
+// entry ties a domain error to its code, its HTTP status, its gRPC code and
+// the message clients see, so both protocols report a failure the same way.
+package errcode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+)
+
+// Domain names this service in the ErrorInfo detail of gRPC errors.
+const Domain = "ordersvc"
+
+// Entry describes how one domain error is reported.
+type Entry struct {
+	Err        error
+	Code       string
+	HTTPStatus int
+	GRPCCode   codes.Code
+	Message    string
+}
+
+// Internal reports errors the registry does not know.
+var Internal = Entry{
+	Code:       "INTERNAL_ERROR",
+	HTTPStatus: http.StatusInternalServerError,
+	GRPCCode:   codes.Internal,
+	Message:    "internal server error",
+}
+
+// Lookup returns the entry of the first registered error err matches with
+// errors.Is. When none matches it returns Internal and false.
+func Lookup(err error) (Entry, bool) {
+	for _, e := range registry {
+		if errors.Is(err, e.Err) {
+			return e, true
+		}
+	}
+	return Internal, false
+}
+
+// Entries returns a copy of the registry, in lookup order.
+func Entries() []Entry {
+	return append([]Entry(nil), registry...)
+}
+
+var registry = []Entry{
+	{domain.ErrOrderNotFound, "ORDER_NOT_FOUND", http.StatusNotFound, codes.NotFound, "order not found"},
+	{domain.ErrCustomerNotFound, "CUSTOMER_NOT_FOUND", http.StatusNotFound, codes.NotFound, "customer not found"},
+	{domain.ErrInvalidSearchQuery, "INVALID_QUERY", http.StatusBadRequest, codes.InvalidArgument, "q must be 1 to 100 characters"},
+	{domain.ErrInvalidTotalRange, "INVALID_TOTAL_RANGE", http.StatusBadRequest, codes.InvalidArgument, "min_total must not exceed max_total"},
+	{domain.ErrInvalidGroupBy, "INVALID_GROUP_BY", http.StatusBadRequest, codes.InvalidArgument, "group_by must be one of day, week, month, status, customer"},
+	{domain.ErrInvalidReportRange, "INVALID_RANGE", http.StatusBadRequest, codes.InvalidArgument, "from must be before to"},
+	{domain.ErrInvalidStatus, "INVALID_STATUS", http.StatusBadRequest, codes.InvalidArgument, "status must be one of " + join(domain.ValidStatuses())},
+	{domain.ErrInvalidTransition, "INVALID_TRANSITION", http.StatusBadRequest, codes.InvalidArgument, "invalid status transition"},
+	{domain.ErrItemNotFound, "ITEM_NOT_FOUND", http.StatusNotFound, codes.NotFound, "order item not found"},
+	{domain.ErrInvalidItemStatus, "INVALID_ITEM_STATUS", http.StatusBadRequest, codes.InvalidArgument, "status must be one of " + join(domain.ValidItemStatuses())},
+	{domain.ErrInvalidItemTransition, "INVALID_ITEM_TRANSITION", http.StatusBadRequest, codes.InvalidArgument, "invalid item status transition"},
+	{domain.ErrItemNotPatchable, "ITEM_NOT_PATCHABLE", http.StatusBadRequest, codes.InvalidArgument, "only the name and quantity of an existing item can change; remove it and add another instead"},
+	{domain.ErrItemsLocked, "ITEMS_LOCKED", http.StatusConflict, codes.FailedPrecondition, "items can only change while the order is pending or confirmed"},
+	{domain.ErrItemsInFulfillment, "ITEMS_IN_FULFILLMENT", http.StatusConflict, codes.FailedPrecondition, "items cannot be replaced once fulfillment has started"},
+	{domain.ErrInvalidNote, "INVALID_NOTE", http.StatusBadRequest, codes.InvalidArgument, "note body must be 1 to 2000 characters"},
+	{domain.ErrInvalidNoteVisibility, "INVALID_NOTE_VISIBILITY", http.StatusBadRequest, codes.InvalidArgument, "note visibility must be customer or internal"},
+	{domain.ErrInvalidMetadata, "INVALID_METADATA", http.StatusBadRequest, codes.InvalidArgument, fmt.Sprintf("metadata allows at most %d entries with keys of 1 to %d and values of at most %d characters", domain.MaxMetadataEntries, domain.MaxMetadataKeyLength, domain.MaxMetadataValueLength)},
+	{domain.ErrInvalidTag, "INVALID_TAG", http.StatusBadRequest, codes.InvalidArgument, fmt.Sprintf("at most %d tags of 1 to %d characters are allowed", domain.MaxTags, domain.MaxTagLength)},
+	{domain.ErrInvalidAddress, "INVALID_ADDRESS", http.StatusBadRequest, codes.InvalidArgument, "address requires line1, city and a two-letter ISO 3166-1 country code"},
+	{domain.ErrAddressLocked, "ADDRESS_LOCKED", http.StatusConflict, codes.FailedPrecondition, "addresses can only change while the order is pending or confirmed"},
+	{domain.ErrInvalidShippingMethod, "INVALID_SHIPPING_METHOD", http.StatusBadRequest, codes.InvalidArgument, "shipping method is not one of the configured shipping methods"},
+	{domain.ErrInvalidPrice, "INVALID_PRICE", http.StatusBadRequest, codes.InvalidArgument, "price is required unless the product has a catalog price"},
+	{domain.ErrProductNotPriced, "PRODUCT_NOT_PRICED", http.StatusBadRequest, codes.InvalidArgument, "a product has no catalog price and client prices are not accepted"},
+	{domain.ErrInvalidHoldReason, "INVALID_HOLD_REASON", http.StatusBadRequest, codes.InvalidArgument, "reason must be 1 to 500 characters"},
+	{domain.ErrOrderNotHeld, "ORDER_NOT_ON_HOLD", http.StatusConflict, codes.FailedPrecondition, "order is not on hold"},
+	{domain.ErrSubscriptionNotFound, "SUBSCRIPTION_NOT_FOUND", http.StatusNotFound, codes.NotFound, "subscription not found"},
+	{domain.ErrInvalidCadence, "INVALID_CADENCE", http.StatusBadRequest, codes.InvalidArgument, "cadence must be one of " + join(domain.ValidCadences())},
+	{domain.ErrInvalidSubscriptionTransition, "INVALID_SUBSCRIPTION_TRANSITION", http.StatusConflict, codes.FailedPrecondition, "only active subscriptions can be paused and only paused ones resumed"},
+	{domain.ErrVersionNotInHistory, "VERSION_NOT_FOUND", http.StatusNotFound, codes.NotFound, "order version is not recorded in its history"},
+	{domain.ErrInvalidVersionRange, "INVALID_VERSION_RANGE", http.StatusBadRequest, codes.InvalidArgument, "from_version must be at least 1 and below to_version"},
+	{domain.ErrVersionMismatch, "VERSION_MISMATCH", http.StatusConflict, codes.Aborted, "order version does not match expected version"},
+	{domain.ErrConcurrentModification, "CONCURRENT_MODIFICATION", http.StatusConflict, codes.Aborted, "order was modified by another process"},
+	{domain.ErrInvalidCustomerID, "INVALID_CUSTOMER_ID", http.StatusBadRequest, codes.InvalidArgument, "invalid customer ID"},
+	{domain.ErrNoItems, "NO_ITEMS", http.StatusBadRequest, codes.InvalidArgument, "order must have at least one item"},
+	{domain.ErrInvalidQuantity, "INVALID_QUANTITY", http.StatusBadRequest, codes.InvalidArgument, "item quantity must be greater than 0"},
+	{domain.ErrTooManyItems, "TOO_MANY_ITEMS", http.StatusBadRequest, codes.InvalidArgument, "order has more items than allowed"},
+	{domain.ErrQuantityLimitExceeded, "QUANTITY_LIMIT_EXCEEDED", http.StatusBadRequest, codes.InvalidArgument, "item quantity exceeds the allowed maximum"},
+	{domain.ErrTotalLimitExceeded, "TOTAL_LIMIT_EXCEEDED", http.StatusBadRequest, codes.InvalidArgument, "order total exceeds the allowed maximum"},
+	{domain.ErrInvalidProductID, "INVALID_PRODUCT_ID", http.StatusBadRequest, codes.InvalidArgument, "item product ID is required"},
+	{domain.ErrInvalidProductName, "INVALID_PRODUCT_NAME", http.StatusBadRequest, codes.InvalidArgument, "item product name is required"},
+	{domain.ErrAccessDenied, "ORDER_ACCESS_DENIED", http.StatusForbidden, codes.PermissionDenied, "access to another customer's orders denied"},
+	{domain.ErrOrderAlreadyDeleted, "ORDER_NOT_FOUND", http.StatusNotFound, codes.NotFound, "order not found"},
+	{domain.ErrJobNotFound, "JOB_NOT_FOUND", http.StatusNotFound, codes.NotFound, "job not found"},
+	{domain.ErrInvalidJobStatus, "INVALID_JOB_STATUS", http.StatusBadRequest, codes.InvalidArgument, "status must be one of " + join(domain.ValidJobStatuses())},
+	{domain.ErrQuotaNotFound, "QUOTA_NOT_FOUND", http.StatusNotFound, codes.NotFound, "no request quota applies"},
+	{domain.ErrInvalidReplayTarget, "INVALID_REPLAY_TARGET", http.StatusBadRequest, codes.InvalidArgument, "target must be broker or webhook"},
+	{domain.ErrInvalidWebhookURL, "INVALID_WEBHOOK_URL", http.StatusBadRequest, codes.InvalidArgument, "webhook_url must be an absolute http or https URL"},
+	{domain.ErrInvalidReplayRange, "INVALID_REPLAY_RANGE", http.StatusBadRequest, codes.InvalidArgument, "order_id or from is required, and from must be before to"},
+	{domain.ErrInvalidReplayCursor, "INVALID_CURSOR", http.StatusBadRequest, codes.InvalidArgument, "cursor is invalid"},
+	{domain.ErrReplayTargetUnavailable, "REPLAY_TARGET_UNAVAILABLE", http.StatusServiceUnavailable, codes.Unavailable, "no message broker is configured"},
+	{domain.ErrReplayDeliveryFailed, "REPLAY_DELIVERY_FAILED", http.StatusBadGateway, codes.Unavailable, "a replayed event could not be delivered"},
+	{domain.ErrPaymentsDisabled, "PAYMENTS_DISABLED", http.StatusServiceUnavailable, codes.Unavailable, "no payment provider is configured"},
+	{domain.ErrInvalidPaymentMethod, "INVALID_PAYMENT_METHOD", http.StatusBadRequest, codes.InvalidArgument, "payment_method is required"},
+	{domain.ErrOrderNotPayable, "ORDER_NOT_PAYABLE", http.StatusConflict, codes.FailedPrecondition, "only pending orders, or orders held after a failed payment, can be paid"},
+	{domain.ErrPricingUnavailable, "PRICING_UNAVAILABLE", http.StatusServiceUnavailable, codes.Unavailable, "pricing service is unavailable; retry later"},
+	{domain.ErrPaymentProviderUnavailable, "PAYMENT_PROVIDER_UNAVAILABLE", http.StatusServiceUnavailable, codes.Unavailable, "payment provider is unavailable; retry later"},
+	{domain.ErrInvalidPaymentWebhook, "INVALID_PAYMENT_WEBHOOK", http.StatusBadRequest, codes.InvalidArgument, "webhook signature or payload is invalid"},
+	{domain.ErrInjectedFault, "INJECTED_FAULT", http.StatusServiceUnavailable, codes.Unavailable, "fault injected for resilience testing"},
+	{messaging.ErrDeadLetterNotFound, "DEAD_LETTER_NOT_FOUND", http.StatusNotFound, codes.NotFound, "dead letter not found"},
+	{context.DeadlineExceeded, "QUERY_TIMEOUT", http.StatusServiceUnavailable, codes.DeadlineExceeded, "query timed out"},
+}
+
+func join[T ~string](values []T) string {
+	names := make([]string, len(values))
+	for i, v := range values {
+		names[i] = string(v)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errcode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+func TestLookup_WrappedError(t *testing.T) {
+	e, ok := Lookup(fmt.Errorf("get order: %w", domain.ErrOrderNotFound))
+
+	assert.True(t, ok)
+	assert.Equal(t, "ORDER_NOT_FOUND", e.Code)
+	assert.Equal(t, http.StatusNotFound, e.HTTPStatus)
+	assert.Equal(t, codes.NotFound, e.GRPCCode)
+}
+
+func TestLookup_QueryTimeout(t *testing.T) {
+	e, ok := Lookup(fmt.Errorf("list orders: %w", context.DeadlineExceeded))
+
+	assert.True(t, ok)
+	assert.Equal(t, "QUERY_TIMEOUT", e.Code)
+	assert.Equal(t, http.StatusServiceUnavailable, e.HTTPStatus)
+	assert.Equal(t, codes.DeadlineExceeded, e.GRPCCode)
+}
+
+func TestLookup_Unknown_ReturnsInternal(t *testing.T) {
+	e, ok := Lookup(errors.New("boom"))
+
+	assert.False(t, ok)
+	assert.Equal(t, Internal, e)
+}
+
+func TestEntries_Complete(t *testing.T) {
+	for _, e := range Entries() {
+		t.Run(e.Code, func(t *testing.T) {
+			assert.NotNil(t, e.Err)
+			assert.NotEmpty(t, e.Message)
+			assert.NotZero(t, e.HTTPStatus)
+			assert.NotEqual(t, codes.OK, e.GRPCCode)
+		})
+	}
+}
+
+func TestEntries_StatusAgreesAcrossProtocols(t *testing.T) {
+	// A code means the same thing whichever protocol carries it
+	byCode := map[string]Entry{}
+	for _, e := range Entries() {
+		if prev, ok := byCode[e.Code]; ok {
+			assert.Equal(t, prev.HTTPStatus, e.HTTPStatus, e.Code)
+			assert.Equal(t, prev.GRPCCode, e.GRPCCode, e.Code)
+		}
+		byCode[e.Code] = e
+	}
+}
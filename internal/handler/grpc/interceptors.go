@@ -0,0 +1,380 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/audit"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/auth"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/correlation"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/problem"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/tenancy"
+)
+
+// RequestIDMetadataKey is the metadata key carrying the request ID, matching
+// the X-Request-Id header used over HTTP.
+const RequestIDMetadataKey = "x-request-id"
+
+// CorrelationIDMetadataKey carries the same ID under the name used by
+// published events, for callers that propagate a correlation ID rather than a
+// request ID. Both keys are accepted and both are sent back.
+const CorrelationIDMetadataKey = "correlation-id"
+
+// Metrics records gRPC call latency.
+type Metrics struct {
+	handled *prometheus.HistogramVec
+}
+
+// NewMetrics registers the gRPC server metrics with reg.
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		handled: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_server_handling_seconds",
+			Help:    "Latency of gRPC calls by method and status code. Streams are measured until they end.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "type", "code"}),
+	}
+	reg.MustRegister(m.handled)
+	return m
+}
+
+func (m *Metrics) observe(method, callType string, code codes.Code, d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.handled.WithLabelValues(method, callType, code.String()).Observe(d.Seconds())
+}
+
+// ServerOptions returns the interceptors every ordersvc gRPC server uses.
+// Request IDs are attached first so the log line and recovered panics carry
+// them; rejected tokens are still logged; recovery runs innermost so a panic
+// is logged and measured as Internal. A nil verifier disables authentication;
+// the tenant is resolved after it so a token claim can name the tenant, and
+// calls are audited once both are known. A nil auditLog disables auditing.
+func ServerOptions(logger *slog.Logger, metrics *Metrics, verifier *auth.Verifier, tenants *tenancy.Resolver, auditLog *audit.Logger) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			unaryRequestID(),
+			unaryLogging(logger, metrics),
+			unaryAuth(verifier),
+			unaryTenant(tenants),
+			unaryAudit(auditLog),
+			unaryRecovery(logger),
+		),
+		grpc.ChainStreamInterceptor(
+			streamRequestID(),
+			streamLogging(logger, metrics),
+			streamAuth(verifier),
+			streamTenant(tenants),
+			streamAudit(auditLog),
+			streamRecovery(logger),
+		),
+	}
+}
+
+// withRequestID reads the request ID from incoming metadata or creates one,
+// stores it where chi's middleware.GetReqID and correlation.ID find it, and
+// echoes it back in the response header. The caller's traceparent is kept
+// the same way, or a trace started. Mutations made by the call are audited
+// as coming over gRPC.
+func withRequestID(ctx context.Context) context.Context {
+	ctx = domain.WithChannel(ctx, domain.ChannelGRPC)
+	var id string
+	traceParent := correlation.NewTraceParent()
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, key := range []string{RequestIDMetadataKey, CorrelationIDMetadataKey} {
+			if vals := md.Get(key); len(vals) > 0 && vals[0] != "" {
+				id = vals[0]
+				break
+			}
+		}
+		if vals := md.Get(correlation.TraceParentHeader); len(vals) > 0 && correlation.ValidTraceParent(vals[0]) {
+			traceParent = vals[0]
+		}
+	}
+	ctx = correlation.WithTraceParent(ctx, traceParent)
+	if id == "" {
+		id = uuid.New().String()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadataKey, id, CorrelationIDMetadataKey, id))
+	ctx = context.WithValue(ctx, chimiddleware.RequestIDKey, id)
+	return correlation.WithID(ctx, id)
+}
+
+func unaryRequestID() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(withRequestID(ctx), req)
+	}
+}
+
+func streamRequestID() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &contextStream{ServerStream: ss, ctx: withRequestID(ss.Context())})
+	}
+}
+
+// contextStream overrides the context of a server stream.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// AuthorizationMetadataKey carries the caller's "Bearer <token>", like the
+// HTTP Authorization header.
+const AuthorizationMetadataKey = "authorization"
+
+// authenticate verifies the bearer token in incoming metadata and stores its
+// principal and subject in ctx, mirroring middleware.Authenticate.
+func authenticate(ctx context.Context, verifier *auth.Verifier) (context.Context, error) {
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(AuthorizationMetadataKey); len(vals) > 0 {
+			token, _ = strings.CutPrefix(vals[0], "Bearer ")
+		}
+	}
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+
+	principal, err := verifier.Verify(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+	ctx = domain.WithPrincipal(ctx, principal)
+	if principal.Subject != "" {
+		ctx = domain.WithActor(ctx, principal.Subject)
+	}
+	return ctx, nil
+}
+
+func unaryAuth(verifier *auth.Verifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if verifier == nil {
+			return handler(ctx, req)
+		}
+		ctx, err := authenticate(ctx, verifier)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func streamAuth(verifier *auth.Verifier) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if verifier == nil {
+			return handler(srv, ss)
+		}
+		ctx, err := authenticate(ss.Context(), verifier)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authorityMetadataKey is the pseudo-header gRPC servers expose as metadata,
+// carrying the host the client dialled.
+const authorityMetadataKey = ":authority"
+
+// withTenant resolves the caller's tenant from the metadata key named by the
+// resolver's header (lowercased, as in HTTP/2) or the :authority host, and
+// scopes ctx to it, mirroring middleware.Tenant.
+func withTenant(ctx context.Context, tenants *tenancy.Resolver) (context.Context, error) {
+	var header, host string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(tenants.Header()); len(vals) > 0 {
+			header = vals[0]
+		}
+		if vals := md.Get(authorityMetadataKey); len(vals) > 0 {
+			host = vals[0]
+		}
+	}
+	tenantID, err := tenants.Resolve(ctx, header, host)
+	switch {
+	case errors.Is(err, domain.ErrTenantRequired):
+		return nil, status.Error(codes.InvalidArgument, "request does not identify a tenant")
+	case errors.Is(err, domain.ErrInvalidTenantID):
+		return nil, status.Error(codes.InvalidArgument, "invalid tenant ID")
+	case err != nil:
+		return nil, status.Error(codes.PermissionDenied, "token is not valid for this tenant")
+	}
+	return domain.WithTenant(ctx, tenantID), nil
+}
+
+func unaryTenant(tenants *tenancy.Resolver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !tenants.Enabled() {
+			return handler(ctx, req)
+		}
+		ctx, err := withTenant(ctx, tenants)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func streamTenant(tenants *tenancy.Resolver) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !tenants.Enabled() {
+			return handler(srv, ss)
+		}
+		ctx, err := withTenant(ss.Context(), tenants)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// mutating reports whether a method may change orders: every method but
+// the Get, List and Watch reads
+func mutating(fullMethod string) bool {
+	name := fullMethod[strings.LastIndexByte(fullMethod, '/')+1:]
+	for _, prefix := range []string{"Get", "List", "Watch"} {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// auditCall writes the audit entry of a finished call
+func auditCall(ctx context.Context, auditLog *audit.Logger, rec *audit.Recorder, method string, err error) {
+	entry := audit.NewEntry(ctx, rec)
+	entry.Method = method
+	entry.Code = status.Code(err).String()
+	if p, ok := peer.FromContext(ctx); ok {
+		entry.IP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(entry.IP); err == nil {
+			entry.IP = host
+		}
+	}
+	if err := auditLog.Log(ctx, entry); err != nil {
+		slog.ErrorContext(ctx, "failed to write audit entry", slog.String("method", method), slog.String("error", err.Error()))
+	}
+}
+
+// unaryAudit records mutating calls in the audit log, mirroring
+// middleware.Audit for HTTP.
+func unaryAudit(auditLog *audit.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if auditLog == nil || !mutating(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		ctx, rec := audit.WithRecorder(ctx)
+		resp, err := handler(ctx, req)
+		auditCall(ctx, auditLog, rec, info.FullMethod, err)
+		return resp, err
+	}
+}
+
+func streamAudit(auditLog *audit.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if auditLog == nil || !mutating(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		ctx, rec := audit.WithRecorder(ss.Context())
+		err := handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+		auditCall(ctx, auditLog, rec, info.FullMethod, err)
+		return err
+	}
+}
+
+func logCall(ctx context.Context, logger *slog.Logger, method string, err error, duration time.Duration) codes.Code {
+	code := status.Code(err)
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("code", code.String()),
+		slog.Duration("duration", duration),
+		slog.String("request_id", chimiddleware.GetReqID(ctx)),
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		attrs = append(attrs, slog.String("remote_addr", p.Addr.String()))
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	logger.LogAttrs(ctx, slog.LevelInfo, "grpc call completed", attrs...)
+	return code
+}
+
+// unaryLogging logs every unary call with slog and records its latency,
+// mirroring middleware.Logging for HTTP.
+func unaryLogging(logger *slog.Logger, metrics *Metrics) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		duration := time.Since(start)
+		code := logCall(ctx, logger, info.FullMethod, err, duration)
+		metrics.observe(info.FullMethod, "unary", code, duration)
+		return resp, err
+	}
+}
+
+func streamLogging(logger *slog.Logger, metrics *Metrics) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		duration := time.Since(start)
+		code := logCall(ss.Context(), logger, info.FullMethod, err, duration)
+		metrics.observe(info.FullMethod, "stream", code, duration)
+		return err
+	}
+}
+
+func recovered(ctx context.Context, logger *slog.Logger, method string, p any) error {
+	errorID := problem.NewErrorID()
+	logger.Error("panic in grpc handler",
+		slog.String("error_id", errorID),
+		slog.String("method", method),
+		slog.Any("panic", p),
+		slog.String("request_id", chimiddleware.GetReqID(ctx)),
+		slog.String("stack", string(debug.Stack())),
+	)
+	return status.Errorf(codes.Internal, "internal server error (error_id %s)", errorID)
+}
+
+// unaryRecovery turns a handler panic into an Internal error instead of
+// crashing the process, like chi's Recoverer does for HTTP.
+func unaryRecovery(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = recovered(ctx, logger, info.FullMethod, p)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+func streamRecovery(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = recovered(ss.Context(), logger, info.FullMethod, p)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
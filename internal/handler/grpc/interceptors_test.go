@@ -0,0 +1,257 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"testing"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/audit"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/auth"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/correlation"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/tenancy"
+)
+
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// chainUnary runs handler through the interceptors ServerOptions installs.
+func chainUnary(ctx context.Context, metrics *Metrics, handler grpc.UnaryHandler) (any, error) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/order.v1.OrderService/GetOrder"}
+	interceptors := []grpc.UnaryServerInterceptor{
+		unaryRequestID(),
+		unaryLogging(discardLogger, metrics),
+		unaryRecovery(discardLogger),
+	}
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		next, interceptor := handler, interceptors[i]
+		handler = func(ctx context.Context, req any) (any, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+	return handler(ctx, nil)
+}
+
+func TestUnaryInterceptors_Panic_ReturnsInternalAndRecordsMetric(t *testing.T) {
+	metrics := NewMetrics(prometheus.NewRegistry())
+
+	_, err := chainUnary(context.Background(), metrics, func(context.Context, any) (any, error) {
+		panic("boom")
+	})
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "error_id ")
+	assert.Equal(t, 1, testutil.CollectAndCount(metrics.handled, "grpc_server_handling_seconds"))
+}
+
+func TestUnaryInterceptors_RequestID_FromMetadata(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadataKey, "req-42"))
+
+	var got string
+	_, err := chainUnary(ctx, nil, func(ctx context.Context, _ any) (any, error) {
+		got = chimiddleware.GetReqID(ctx)
+		return nil, nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "req-42", got)
+}
+
+func TestUnaryInterceptors_RequestID_FromCorrelationMetadata(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(CorrelationIDMetadataKey, "req-42"))
+
+	var got string
+	_, err := chainUnary(ctx, nil, func(ctx context.Context, _ any) (any, error) {
+		got = correlation.ID(ctx)
+		return nil, nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "req-42", got)
+}
+
+func TestUnaryInterceptors_RequestID_AssignedWhenMissing(t *testing.T) {
+	var got string
+	_, err := chainUnary(context.Background(), nil, func(ctx context.Context, _ any) (any, error) {
+		got = chimiddleware.GetReqID(ctx)
+		return nil, status.Error(codes.NotFound, "order not found")
+	})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Len(t, got, 36, "a UUID is assigned when the caller sends none")
+}
+
+func TestUnaryInterceptors_MarksGRPCChannel(t *testing.T) {
+	var got domain.Channel
+	_, err := chainUnary(context.Background(), nil, func(ctx context.Context, _ any) (any, error) {
+		got = domain.ChannelFromContext(ctx)
+		return nil, nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.ChannelGRPC, got)
+}
+
+func TestUnaryInterceptors_TraceParent_KeptOrStarted(t *testing.T) {
+	traceParent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	for name, tt := range map[string]struct {
+		md   metadata.MD
+		want func(t *testing.T, got string)
+	}{
+		"from metadata": {
+			md:   metadata.Pairs(correlation.TraceParentHeader, traceParent),
+			want: func(t *testing.T, got string) { assert.Equal(t, traceParent, got) },
+		},
+		"invalid metadata starts a trace": {
+			md: metadata.Pairs(correlation.TraceParentHeader, "not-a-trace"),
+			want: func(t *testing.T, got string) {
+				assert.True(t, correlation.ValidTraceParent(got))
+			},
+		},
+		"missing starts a trace": {
+			md:   metadata.MD{},
+			want: func(t *testing.T, got string) { assert.True(t, correlation.ValidTraceParent(got)) },
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var got string
+			_, err := chainUnary(metadata.NewIncomingContext(context.Background(), tt.md), nil, func(ctx context.Context, _ any) (any, error) {
+				got = correlation.TraceParent(ctx)
+				return nil, nil
+			})
+
+			require.NoError(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestUnaryAuth_Token(t *testing.T) {
+	verifier := auth.NewVerifier("test-secret", "")
+	token, err := verifier.Sign(auth.Claims{Subject: "user-1", Role: "customer", CustomerID: "cust-1"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		wantCode codes.Code
+	}{
+		{name: "valid token", md: metadata.Pairs(AuthorizationMetadataKey, "Bearer "+token), wantCode: codes.OK},
+		{name: "missing token", md: metadata.MD{}, wantCode: codes.Unauthenticated},
+		{name: "invalid token", md: metadata.Pairs(AuthorizationMetadataKey, "Bearer nope"), wantCode: codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			var got *domain.Principal
+			_, err := unaryAuth(verifier)(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+				got, _ = domain.PrincipalFromContext(ctx)
+				return nil, nil
+			})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, "cust-1", got.CustomerID)
+			} else {
+				assert.Nil(t, got)
+			}
+		})
+	}
+}
+
+func TestUnaryTenant_Metadata(t *testing.T) {
+	tenants := tenancy.NewResolver(tenancy.ModeHeader, "X-Tenant-ID", "")
+
+	tests := []struct {
+		name       string
+		md         metadata.MD
+		principal  *domain.Principal
+		wantCode   codes.Code
+		wantTenant string
+	}{
+		{name: "tenant in metadata", md: metadata.Pairs("x-tenant-id", "acme"), wantCode: codes.OK, wantTenant: "acme"},
+		{name: "missing tenant", md: metadata.MD{}, wantCode: codes.InvalidArgument},
+		{name: "malformed tenant", md: metadata.Pairs("x-tenant-id", "Acme Corp"), wantCode: codes.InvalidArgument},
+		{
+			name:      "token bound to another tenant",
+			md:        metadata.Pairs("x-tenant-id", "acme"),
+			principal: &domain.Principal{Role: domain.RoleService, TenantID: "globex"},
+			wantCode:  codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			if tt.principal != nil {
+				ctx = domain.WithPrincipal(ctx, tt.principal)
+			}
+			var got string
+			_, err := unaryTenant(tenants)(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+				got = domain.TenantID(ctx)
+				return nil, nil
+			})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantTenant, got)
+		})
+	}
+}
+
+// auditedStream is a client stream whose context streamAudit replaces
+type auditedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *auditedStream) Context() context.Context { return s.ctx }
+
+func TestStreamAudit_ImportRecordsCallAndOrders(t *testing.T) {
+	var buf bytes.Buffer
+	orderID := uuid.New()
+	ctx := domain.WithActor(withRequestID(metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadataKey, "req-7"))), "importer")
+	info := &grpc.StreamServerInfo{FullMethod: "/order.v1.OrderService/ImportOrders", IsClientStream: true}
+
+	err := streamAudit(audit.NewLogger(&buf))(nil, &auditedStream{ctx: ctx}, info, func(_ any, ss grpc.ServerStream) error {
+		audit.RecordOrder(ss.Context(), orderID, 0, 1)
+		return status.Error(codes.InvalidArgument, "order 2: no items")
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	var line struct {
+		Entry audit.Entry `json:"entry"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+	assert.Equal(t, "/order.v1.OrderService/ImportOrders", line.Entry.Method)
+	assert.Equal(t, "InvalidArgument", line.Entry.Code)
+	assert.Equal(t, "grpc", line.Entry.Channel)
+	assert.Equal(t, "importer", line.Entry.Actor)
+	assert.Equal(t, "req-7", line.Entry.RequestID)
+	assert.Equal(t, []audit.OrderVersion{{OrderID: orderID, AfterVersion: 1}}, line.Entry.Orders)
+}
+
+func TestStreamAudit_ReadsAreNotAudited(t *testing.T) {
+	var buf bytes.Buffer
+	info := &grpc.StreamServerInfo{FullMethod: "/order.v1.OrderService/WatchOrders", IsServerStream: true}
+
+	err := streamAudit(audit.NewLogger(&buf))(nil, &auditedStream{ctx: context.Background()}, info, func(any, grpc.ServerStream) error {
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, buf.String())
+}
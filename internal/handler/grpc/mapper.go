@@ -2,8 +2,11 @@
 package grpc
 
 import (
+	"time"
+
 	orderv1 "github.com/sridharn-code-sandbox/go-ordersvc/api/proto/order/v1"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -20,13 +23,80 @@ func orderToProto(o *domain.Order) *orderv1.Order {
 		}
 	}
 	return &orderv1.Order{
-		Id:         o.ID.String(),
-		CustomerId: o.CustomerID,
-		Items:      items,
-		Status:     string(o.Status),
-		Total:      o.Total,
-		Version:    int32(o.Version), // #nosec G115 -- version is a small incrementing counter
-		CreatedAt:  timestamppb.New(o.CreatedAt),
-		UpdatedAt:  timestamppb.New(o.UpdatedAt),
+		Id:              o.ID.String(),
+		CustomerId:      o.CustomerID,
+		Items:           items,
+		Status:          string(o.Status),
+		Total:           o.Total,
+		Version:         int32(o.Version), // #nosec G115 -- version is a small incrementing counter
+		CreatedAt:       timestamppb.New(o.CreatedAt),
+		UpdatedAt:       timestamppb.New(o.UpdatedAt),
+		ShippingAddress: addressToProto(o.ShippingAddress),
+		BillingAddress:  addressToProto(o.BillingAddress),
+
+		ShippingMethod:      o.ShippingMethod,
+		EstimatedDeliveryAt: optionalTimestamp(o.EstimatedDeliveryAt),
+		SlaBreachedAt:       optionalTimestamp(o.SLABreachedAt),
+	}
+}
+
+// optionalTimestamp leaves the field unset for a nil time
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func addressToProto(a *domain.Address) *orderv1.Address {
+	if a == nil {
+		return nil
+	}
+	return &orderv1.Address{
+		Name:       a.Name,
+		Line1:      a.Line1,
+		Line2:      a.Line2,
+		City:       a.City,
+		Region:     a.Region,
+		PostalCode: a.PostalCode,
+		Country:    a.Country,
+	}
+}
+
+// createOrderFromProto maps an imported order to the service's create DTO
+func createOrderFromProto(req *orderv1.CreateOrderRequest) service.CreateOrderDTO {
+	items := make([]domain.OrderItem, len(req.GetItems()))
+	for i, item := range req.GetItems() {
+		items[i] = domain.OrderItem{
+			ProductID: item.GetProductId(),
+			Name:      item.GetName(),
+			Quantity:  int(item.GetQuantity()),
+			Price:     item.GetPrice(),
+		}
+		items[i].Subtotal = items[i].CalculateSubtotal()
+	}
+	return service.CreateOrderDTO{
+		CustomerID:      req.GetCustomerId(),
+		Items:           items,
+		Metadata:        req.GetMetadata(),
+		Tags:            req.GetTags(),
+		ShippingAddress: addressFromProto(req.GetShippingAddress()),
+		BillingAddress:  addressFromProto(req.GetBillingAddress()),
+		ShippingMethod:  req.GetShippingMethod(),
+	}
+}
+
+func addressFromProto(a *orderv1.Address) *domain.Address {
+	if a == nil {
+		return nil
+	}
+	return &domain.Address{
+		Name:       a.GetName(),
+		Line1:      a.GetLine1(),
+		Line2:      a.GetLine2(),
+		City:       a.GetCity(),
+		Region:     a.GetRegion(),
+		PostalCode: a.GetPostalCode(),
+		Country:    a.GetCountry(),
 	}
 }
@@ -2,17 +2,16 @@ package grpc
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"log/slog"
+	"errors"
+	"io"
 
 	"github.com/google/uuid"
 	orderv1 "github.com/sridharn-code-sandbox/go-ordersvc/api/proto/order/v1"
-	"github.com/sridharn-code-sandbox/go-ordersvc/internal/config"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/errcode"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
-	"github.com/segmentio/kafka-go"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -22,18 +21,26 @@ import (
 type orderHandler struct {
 	orderv1.UnimplementedOrderServiceServer
 	svc      service.OrderService
-	kafkaCfg config.KafkaConfig
+	events   *messaging.Broker
+	replayer messaging.Replayer
 }
 
 // RegisterOrderServer registers the gRPC order service on the given server.
-func RegisterOrderServer(srv *grpc.Server, svc service.OrderService, kafkaCfg config.KafkaConfig) {
+// WatchOrders streams are fed by events; without a broker they are refused.
+// Streams resume from a resume token through replayer; without one, resuming
+// is refused.
+func RegisterOrderServer(srv *grpc.Server, svc service.OrderService, events *messaging.Broker, replayer messaging.Replayer) {
 	orderv1.RegisterOrderServiceServer(srv, &orderHandler{
 		svc:      svc,
-		kafkaCfg: kafkaCfg,
+		events:   events,
+		replayer: replayer,
 	})
 }
 
 func (h *orderHandler) GetOrder(ctx context.Context, req *orderv1.GetOrderRequest) (*orderv1.GetOrderResponse, error) {
+	if _, err := uuid.Parse(req.GetOrderId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "order_id must be a UUID")
+	}
 	order, err := h.svc.GetOrderByID(ctx, req.GetOrderId())
 	if err != nil {
 		return nil, domainToGRPCError(err)
@@ -42,9 +49,11 @@ func (h *orderHandler) GetOrder(ctx context.Context, req *orderv1.GetOrderReques
 }
 
 func (h *orderHandler) ListOrders(ctx context.Context, req *orderv1.ListOrdersRequest) (*orderv1.ListOrdersResponse, error) {
+	// The response has no field to flag an estimated total, so always count
 	listReq := service.ListOrdersRequest{
-		Page:     int(req.GetPage()),
-		PageSize: int(req.GetPageSize()),
+		Page:       int(req.GetPage()),
+		PageSize:   int(req.GetPageSize()),
+		ExactTotal: true,
 	}
 	if req.GetStatus() != "" {
 		s := domain.OrderStatus(req.GetStatus())
@@ -52,8 +61,15 @@ func (h *orderHandler) ListOrders(ctx context.Context, req *orderv1.ListOrdersRe
 	}
 	if req.GetCustomerId() != "" {
 		cid := req.GetCustomerId()
+		if _, err := uuid.Parse(cid); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "customer_id must be a UUID")
+		}
 		listReq.CustomerID = &cid
 	}
+	if req.GetProductId() != "" {
+		pid := req.GetProductId()
+		listReq.ProductID = &pid
+	}
 
 	result, err := h.svc.ListOrders(ctx, listReq)
 	if err != nil {
@@ -75,81 +91,254 @@ func (h *orderHandler) ListOrders(ctx context.Context, req *orderv1.ListOrdersRe
 }
 
 func (h *orderHandler) WatchOrders(req *orderv1.WatchOrdersRequest, stream grpc.ServerStreamingServer[orderv1.OrderEvent]) error {
-	if len(h.kafkaCfg.Brokers) == 0 || h.kafkaCfg.Brokers[0] == "" {
-		return status.Error(codes.Unavailable, "Kafka not configured")
+	filter, err := newWatchFilter(req)
+	if err != nil {
+		return err
 	}
+	if p, ok := domain.PrincipalFromContext(stream.Context()); ok && p.Role != domain.RoleService {
+		filter.customerID = p.CustomerID
+	}
+	filter.tenantID = domain.TenantID(stream.Context())
 
-	// Per-client consumer with unique group ID for fan-out
-	groupID := fmt.Sprintf("%s-watch-%s", h.kafkaCfg.GroupID, uuid.New().String()[:8])
-	reader := kafka.NewReader(kafka.ReaderConfig{
-		Brokers: h.kafkaCfg.Brokers,
-		Topic:   h.kafkaCfg.Topic,
-		GroupID: groupID,
-	})
-	defer func() {
-		if err := reader.Close(); err != nil {
-			slog.Warn("failed to close Kafka reader", slog.String("error", err.Error()))
+	var resumeFrom messaging.Position
+	if req.GetResumeToken() != "" {
+		if resumeFrom, err = messaging.ParsePosition(req.GetResumeToken()); err != nil {
+			return status.Error(codes.InvalidArgument, "resume_token is invalid")
 		}
-	}()
+	}
 
-	// Build status filter set
-	statusFilter := make(map[string]struct{}, len(req.GetStatuses()))
-	for _, s := range req.GetStatuses() {
-		statusFilter[s] = struct{}{}
+	if h.events == nil {
+		return status.Error(codes.Unavailable, "Kafka not configured")
+	}
+
+	// All streams share the broker's consumer; this one only gets a buffer.
+	// Subscribe before replaying so no event falls between the two.
+	sub := h.events.Subscribe()
+	defer sub.Close()
+
+	w := &watchStream{stream: stream, filter: filter, position: sub.Start()}
+	if resumeFrom != nil {
+		if h.replayer == nil {
+			return status.Error(codes.FailedPrecondition, "resuming streams is not supported")
+		}
+		for tp, offset := range resumeFrom {
+			w.position[tp] = offset
+		}
+		if err := h.replayer.Replay(stream.Context(), resumeFrom, w.deliver); err != nil {
+			if stream.Context().Err() != nil {
+				return nil // client disconnected
+			}
+			return status.Error(codes.Unavailable, "failed to replay events; retry to resume")
+		}
 	}
 
 	ctx := stream.Context()
 	for {
-		msg, err := reader.ReadMessage(ctx)
-		if err != nil {
-			if ctx.Err() != nil {
-				return nil // client disconnected
+		select {
+		case <-ctx.Done():
+			return nil // client disconnected
+		case evt, ok := <-sub.Events():
+			if !ok {
+				if errors.Is(sub.Err(), messaging.ErrSubscriberTooSlow) {
+					return status.Error(codes.ResourceExhausted, "stream fell behind the event feed; reconnect to resume")
+				}
+				return status.Error(codes.Unavailable, "event stream stopped")
+			}
+			if w.position.Includes(evt.Topic, evt.Partition, evt.Offset) {
+				continue // already replayed
+			}
+			if err := w.deliver(evt); err != nil {
+				return err
 			}
-			return status.Errorf(codes.Internal, "failed to read Kafka message: %v", err)
 		}
+	}
+}
 
-		var evt messaging.OrderEvent
-		if err := json.Unmarshal(msg.Value, &evt); err != nil {
-			slog.Warn("failed to unmarshal event", slog.String("error", err.Error()))
-			continue
+// maxImportErrors caps the rejected orders an ImportOrders response lists,
+// keeping it well below the default 4 MB message limit
+const maxImportErrors = 1000
+
+// ImportOrders creates orders in batches of service.MaxBulkCreateOrders as
+// they arrive. Batches created before the client fails or cancels the
+// stream are kept.
+func (h *orderHandler) ImportOrders(stream grpc.ClientStreamingServer[orderv1.CreateOrderRequest, orderv1.ImportOrdersResponse]) error {
+	resp := &orderv1.ImportOrdersResponse{}
+	var batch importBatch
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
 		}
+		if err != nil {
+			return err
+		}
+		batch.add(req)
+		resp.Received++
+		if len(batch.orders) == service.MaxBulkCreateOrders {
+			h.createBatch(stream.Context(), &batch, resp)
+		}
+	}
+	h.createBatch(stream.Context(), &batch, resp)
+	return stream.SendAndClose(resp)
+}
 
-		// Apply status filter if specified
-		if len(statusFilter) > 0 {
-			if _, ok := statusFilter[evt.Status]; !ok {
-				continue
-			}
+// importBatch is the orders of an ImportOrders stream awaiting creation
+type importBatch struct {
+	// start is the stream index of the first order
+	start  int
+	orders []service.CreateOrderDTO
+	// errs holds, by position, errors found before creation
+	errs []error
+}
+
+func (b *importBatch) add(req *orderv1.CreateOrderRequest) {
+	var err error
+	if _, parseErr := uuid.Parse(req.GetCustomerId()); parseErr != nil {
+		err = domain.ErrInvalidCustomerID
+	}
+	b.orders = append(b.orders, createOrderFromProto(req))
+	b.errs = append(b.errs, err)
+}
+
+// createBatch creates the orders of batch that passed its checks, counts
+// each order's outcome in resp and empties batch
+func (h *orderHandler) createBatch(ctx context.Context, batch *importBatch, resp *orderv1.ImportOrdersResponse) {
+	var valid []service.CreateOrderDTO
+	var positions []int
+	for i, dto := range batch.orders {
+		if batch.errs[i] == nil {
+			valid = append(valid, dto)
+			positions = append(positions, i)
 		}
+	}
+	if len(valid) > 0 {
+		for j, res := range h.svc.BulkCreateOrders(ctx, valid) {
+			batch.errs[positions[j]] = res.Err
+		}
+	}
 
-		protoEvt := &orderv1.OrderEvent{
-			EventType:  evt.EventType,
-			OrderId:    evt.OrderID,
-			CustomerId: evt.CustomerID,
-			Status:     evt.Status,
-			OldStatus:  evt.OldStatus,
-			NewStatus:  evt.NewStatus,
-			Total:      evt.Total,
-			Version:    int32(evt.Version), // #nosec G115 -- version is a small incrementing counter
-			OccurredAt: timestamppb.New(evt.OccurredAt),
+	for i, err := range batch.errs {
+		if err == nil {
+			resp.Imported++
+			continue
+		}
+		resp.Failed++
+		if len(resp.Errors) < maxImportErrors {
+			e, _ := errcode.Lookup(err)
+			resp.Errors = append(resp.Errors, &orderv1.ImportError{
+				Index:   int32(batch.start + i), // #nosec G115 -- a stream carries far fewer than 2^31 orders
+				Code:    e.Code,
+				Message: e.Message,
+			})
 		}
+	}
 
-		if err := stream.Send(protoEvt); err != nil {
-			return err
+	batch.start += len(batch.orders)
+	batch.orders = batch.orders[:0]
+	batch.errs = batch.errs[:0]
+}
+
+// watchStream sends events to one WatchOrders client, tracking the position
+// its resume tokens carry.
+type watchStream struct {
+	stream   grpc.ServerStreamingServer[orderv1.OrderEvent]
+	filter   *watchFilter
+	position messaging.Position
+}
+
+// deliver advances the stream past evt and sends it if the filter selects
+// it. Filtered events advance the position too, so a resumed stream does not
+// read them again.
+func (w *watchStream) deliver(evt messaging.OrderEvent) error {
+	w.position.Advance(evt.Topic, evt.Partition, evt.Offset)
+	if !w.filter.matches(evt) {
+		return nil
+	}
+	return w.stream.Send(&orderv1.OrderEvent{
+		EventId:     evt.EventID,
+		EventType:   evt.EventType,
+		OrderId:     evt.OrderID,
+		CustomerId:  evt.CustomerID,
+		Status:      evt.Status,
+		OldStatus:   evt.OldStatus,
+		NewStatus:   evt.NewStatus,
+		Total:       evt.Total,
+		Version:     int32(evt.Version), // #nosec G115 -- version is a small incrementing counter
+		OccurredAt:  timestamppb.New(evt.OccurredAt),
+		ResumeToken: w.position.Token(),
+	})
+}
+
+// watchFilter selects the events a WatchOrders stream receives. An empty set
+// matches everything.
+type watchFilter struct {
+	statuses   map[string]struct{}
+	eventTypes map[string]struct{}
+	// customerID limits customer tokens to events of their own orders
+	customerID string
+	// tenantID limits the stream to the caller's tenant; the consumer reads
+	// the events of every tenant
+	tenantID string
+}
+
+// newWatchFilter builds the filter from the request, rejecting event types
+// that are not order events.
+func newWatchFilter(req *orderv1.WatchOrdersRequest) (*watchFilter, error) {
+	f := &watchFilter{
+		statuses:   make(map[string]struct{}, len(req.GetStatuses())),
+		eventTypes: make(map[string]struct{}, len(req.GetEventTypes())),
+	}
+	for _, s := range req.GetStatuses() {
+		f.statuses[s] = struct{}{}
+	}
+	for _, t := range req.GetEventTypes() {
+		if !messaging.IsOrderEventType(t) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown event type %q", t)
 		}
+		f.eventTypes[t] = struct{}{}
 	}
+	return f, nil
 }
 
+func (f *watchFilter) matches(evt messaging.OrderEvent) bool {
+	// Customer-scoped events such as customer.data_erased are not order changes
+	if evt.OrderID == "" {
+		return false
+	}
+	if f.tenantID != "" && evt.TenantID != f.tenantID {
+		return false
+	}
+	if f.customerID != "" && evt.CustomerID != f.customerID {
+		return false
+	}
+	if len(f.statuses) > 0 {
+		if _, ok := f.statuses[evt.Status]; !ok {
+			return false
+		}
+	}
+	if len(f.eventTypes) > 0 {
+		if _, ok := f.eventTypes[evt.EventType]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// domainToGRPCError reports err with the gRPC code and message of its
+// errcode entry, attaching the code as an ErrorInfo detail. Errors outside
+// the registry keep their own message under Internal.
 func domainToGRPCError(err error) error {
-	switch err {
-	case domain.ErrOrderNotFound:
-		return status.Error(codes.NotFound, err.Error())
-	case domain.ErrInvalidCustomerID, domain.ErrNoItems, domain.ErrInvalidQuantity,
-		domain.ErrInvalidPrice, domain.ErrInvalidProductID, domain.ErrInvalidProductName,
-		domain.ErrInvalidTransition:
-		return status.Error(codes.InvalidArgument, err.Error())
-	case domain.ErrConcurrentModification:
-		return status.Error(codes.Aborted, err.Error())
-	default:
-		return status.Error(codes.Internal, err.Error())
+	e, ok := errcode.Lookup(err)
+	msg := e.Message
+	if !ok {
+		msg = err.Error()
+	}
+	st, detailErr := status.New(e.GRPCCode, msg).WithDetails(&errdetails.ErrorInfo{
+		Reason: e.Code,
+		Domain: errcode.Domain,
+	})
+	if detailErr != nil {
+		return status.Error(e.GRPCCode, msg)
 	}
+	return st.Err()
 }
@@ -0,0 +1,353 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	orderv1 "github.com/sridharn-code-sandbox/go-ordersvc/api/proto/order/v1"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+)
+
+func TestWatchFilter_Matches(t *testing.T) {
+	deleted := messaging.OrderEvent{EventType: messaging.EventOrderDeleted, OrderID: "o-1", Status: "pending"}
+	created := messaging.OrderEvent{EventType: messaging.EventOrderCreated, OrderID: "o-2", Status: "pending"}
+	erased := messaging.OrderEvent{EventType: messaging.EventCustomerDataErased, CustomerID: "c-1"}
+
+	tests := []struct {
+		name  string
+		req   *orderv1.WatchOrdersRequest
+		evt   messaging.OrderEvent
+		match bool
+	}{
+		{name: "no filter", req: &orderv1.WatchOrdersRequest{}, evt: deleted, match: true},
+		{name: "event type selected", req: &orderv1.WatchOrdersRequest{EventTypes: []string{messaging.EventOrderDeleted}}, evt: deleted, match: true},
+		{name: "event type not selected", req: &orderv1.WatchOrdersRequest{EventTypes: []string{messaging.EventOrderDeleted}}, evt: created, match: false},
+		{name: "status and type both match", req: &orderv1.WatchOrdersRequest{Statuses: []string{"pending"}, EventTypes: []string{messaging.EventOrderDeleted}}, evt: deleted, match: true},
+		{name: "status does not match", req: &orderv1.WatchOrdersRequest{Statuses: []string{"shipped"}, EventTypes: []string{messaging.EventOrderDeleted}}, evt: deleted, match: false},
+		{name: "customer-scoped event", req: &orderv1.WatchOrdersRequest{}, evt: erased, match: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := newWatchFilter(tt.req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.match, f.matches(tt.evt))
+		})
+	}
+}
+
+func TestWatchFilter_CustomerToken_OwnOrdersOnly(t *testing.T) {
+	f, err := newWatchFilter(&orderv1.WatchOrdersRequest{})
+	require.NoError(t, err)
+	f.customerID = "c-1"
+
+	assert.True(t, f.matches(messaging.OrderEvent{EventType: messaging.EventOrderCreated, OrderID: "o-1", CustomerID: "c-1"}))
+	assert.False(t, f.matches(messaging.OrderEvent{EventType: messaging.EventOrderCreated, OrderID: "o-2", CustomerID: "c-2"}))
+}
+
+func TestWatchFilter_Tenant_OwnTenantOnly(t *testing.T) {
+	f, err := newWatchFilter(&orderv1.WatchOrdersRequest{})
+	require.NoError(t, err)
+	f.tenantID = "acme"
+
+	assert.True(t, f.matches(messaging.OrderEvent{EventType: messaging.EventOrderCreated, OrderID: "o-1", TenantID: "acme"}))
+	assert.False(t, f.matches(messaging.OrderEvent{EventType: messaging.EventOrderCreated, OrderID: "o-2", TenantID: "globex"}))
+	assert.False(t, f.matches(messaging.OrderEvent{EventType: messaging.EventOrderCreated, OrderID: "o-3"}))
+}
+
+func TestWatchFilter_UnknownEventType_InvalidArgument(t *testing.T) {
+	_, err := newWatchFilter(&orderv1.WatchOrdersRequest{EventTypes: []string{"order.exploded"}})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestDomainToGRPCError_Codes(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"not found", domain.ErrOrderNotFound, codes.NotFound},
+		{"invalid argument", domain.ErrNoItems, codes.InvalidArgument},
+		{"invalid status", domain.ErrInvalidStatus, codes.InvalidArgument},
+		{"concurrent modification", domain.ErrConcurrentModification, codes.Aborted},
+		{"access denied", domain.ErrAccessDenied, codes.PermissionDenied},
+		{"query timeout", fmt.Errorf("find order: %w", context.DeadlineExceeded), codes.DeadlineExceeded},
+		{"other", errors.New("boom"), codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, status.Code(domainToGRPCError(tt.err)))
+		})
+	}
+}
+
+func TestDomainToGRPCError_AttachesErrorInfo(t *testing.T) {
+	st := status.Convert(domainToGRPCError(fmt.Errorf("get order: %w", domain.ErrOrderNotFound)))
+
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Equal(t, "order not found", st.Message())
+	require.Len(t, st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, "ORDER_NOT_FOUND", info.GetReason())
+	assert.Equal(t, "ordersvc", info.GetDomain())
+}
+
+func TestOrderHandler_MalformedIDs_InvalidArgument(t *testing.T) {
+	// The service is never reached, so the handler needs none
+	h := &orderHandler{}
+
+	_, err := h.GetOrder(context.Background(), &orderv1.GetOrderRequest{OrderId: "not-a-uuid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = h.ListOrders(context.Background(), &orderv1.ListOrdersRequest{CustomerId: "not-a-uuid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestOrderToProto_Addresses(t *testing.T) {
+	order := &domain.Order{
+		ShippingAddress: &domain.Address{Name: "Ada Lovelace", Line1: "12 St James's Square", City: "London", PostalCode: "SW1Y 4JH", Country: "GB"},
+	}
+
+	pb := orderToProto(order)
+
+	require.NotNil(t, pb.ShippingAddress)
+	assert.Equal(t, "12 St James's Square", pb.ShippingAddress.Line1)
+	assert.Equal(t, "SW1Y 4JH", pb.ShippingAddress.PostalCode)
+	assert.Equal(t, "GB", pb.ShippingAddress.Country)
+	assert.Nil(t, pb.BillingAddress, "an unset address stays unset")
+}
+
+func TestOrderToProto_DeliveryEstimate(t *testing.T) {
+	estimate := time.Date(2026, 10, 22, 9, 0, 0, 0, time.UTC)
+	order := &domain.Order{
+		ShippingMethod:      "express",
+		EstimatedDeliveryAt: &estimate,
+	}
+
+	pb := orderToProto(order)
+
+	assert.Equal(t, "express", pb.ShippingMethod)
+	require.NotNil(t, pb.EstimatedDeliveryAt)
+	assert.True(t, estimate.Equal(pb.EstimatedDeliveryAt.AsTime()))
+	assert.Nil(t, pb.SlaBreachedAt, "an order not flagged has no breach time")
+}
+
+// watchStreamRecorder records the events a WatchOrders stream sends and
+// cancels the stream once it has sent want of them.
+type watchStreamRecorder struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	want   int
+	sent   []*orderv1.OrderEvent
+}
+
+func (s *watchStreamRecorder) Context() context.Context { return s.ctx }
+
+func (s *watchStreamRecorder) Send(evt *orderv1.OrderEvent) error {
+	s.sent = append(s.sent, evt)
+	if len(s.sent) == s.want {
+		s.cancel()
+	}
+	return nil
+}
+
+// stubReplayer replays a fixed set of events.
+type stubReplayer struct {
+	events []messaging.OrderEvent
+	from   messaging.Position
+}
+
+func (r *stubReplayer) Replay(_ context.Context, from messaging.Position, fn func(messaging.OrderEvent) error) error {
+	r.from = from
+	for _, evt := range r.events {
+		if err := fn(evt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// feedSource is a messaging.EventSource yielding fixed events, then
+// blocking until cancelled.
+type feedSource struct{ events chan messaging.OrderEvent }
+
+func (s *feedSource) ReadEvent(ctx context.Context) (messaging.OrderEvent, error) {
+	select {
+	case <-ctx.Done():
+		return messaging.OrderEvent{}, ctx.Err()
+	case evt := <-s.events:
+		return evt, nil
+	}
+}
+
+func (s *feedSource) Close() error { return nil }
+
+func TestOrderHandler_WatchOrders_InvalidResumeToken_InvalidArgument(t *testing.T) {
+	h := &orderHandler{}
+	stream := &watchStreamRecorder{ctx: context.Background()}
+
+	err := h.WatchOrders(&orderv1.WatchOrdersRequest{ResumeToken: "not a token"}, stream)
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestOrderHandler_WatchOrders_ResumeToken_ReplaysThenSkipsDuplicates(t *testing.T) {
+	source := &feedSource{events: make(chan messaging.OrderEvent, 2)}
+	broker := messaging.NewBroker(source, 4)
+	brokerCtx, stopBroker := context.WithCancel(context.Background())
+	defer stopBroker()
+	go broker.Run(brokerCtx)
+
+	replayer := &stubReplayer{events: []messaging.OrderEvent{
+		{EventType: messaging.EventOrderCreated, OrderID: "o-3", Partition: 0, Offset: 3},
+		{EventType: messaging.EventOrderCreated, OrderID: "o-4", Partition: 0, Offset: 4},
+	}}
+	h := &orderHandler{events: broker, replayer: replayer}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream := &watchStreamRecorder{ctx: ctx, cancel: cancel, want: 3}
+
+	// The live feed repeats the last replayed event before a new one
+	go func() {
+		for broker.Subscribers() == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		source.events <- messaging.OrderEvent{EventType: messaging.EventOrderCreated, OrderID: "o-4", Partition: 0, Offset: 4}
+		source.events <- messaging.OrderEvent{EventType: messaging.EventOrderCreated, OrderID: "o-5", Partition: 0, Offset: 5}
+	}()
+
+	token := messaging.Position{{Partition: 0}: 3}.Token()
+	err := h.WatchOrders(&orderv1.WatchOrdersRequest{ResumeToken: token}, stream)
+
+	require.NoError(t, err)
+	assert.Equal(t, messaging.Position{{Partition: 0}: 3}, replayer.from)
+	require.Len(t, stream.sent, 3)
+	for i, want := range []string{"o-3", "o-4", "o-5"} {
+		assert.Equal(t, want, stream.sent[i].OrderId)
+	}
+	assert.Equal(t, messaging.Position{{Partition: 0}: 6}.Token(), stream.sent[2].ResumeToken)
+}
+
+func TestOrderHandler_WatchOrders_OtherTenants_NotSent(t *testing.T) {
+	source := &feedSource{events: make(chan messaging.OrderEvent, 2)}
+	broker := messaging.NewBroker(source, 4)
+	brokerCtx, stopBroker := context.WithCancel(context.Background())
+	defer stopBroker()
+	go broker.Run(brokerCtx)
+
+	replayer := &stubReplayer{events: []messaging.OrderEvent{
+		{EventType: messaging.EventOrderCreated, OrderID: "o-3", TenantID: "globex", Partition: 0, Offset: 3},
+		{EventType: messaging.EventOrderCreated, OrderID: "o-4", TenantID: "acme", Partition: 0, Offset: 4},
+	}}
+	h := &orderHandler{events: broker, replayer: replayer}
+
+	ctx, cancel := context.WithTimeout(domain.WithTenant(context.Background(), "acme"), 5*time.Second)
+	defer cancel()
+	stream := &watchStreamRecorder{ctx: ctx, cancel: cancel, want: 2}
+
+	go func() {
+		for broker.Subscribers() == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		source.events <- messaging.OrderEvent{EventType: messaging.EventOrderCreated, OrderID: "o-5", TenantID: "globex", Partition: 0, Offset: 5}
+		source.events <- messaging.OrderEvent{EventType: messaging.EventOrderCreated, OrderID: "o-6", TenantID: "acme", Partition: 0, Offset: 6}
+	}()
+
+	token := messaging.Position{{Partition: 0}: 3}.Token()
+	err := h.WatchOrders(&orderv1.WatchOrdersRequest{ResumeToken: token}, stream)
+
+	require.NoError(t, err)
+	require.Len(t, stream.sent, 2)
+	assert.Equal(t, "o-4", stream.sent[0].OrderId, "replayed events of other tenants are skipped")
+	assert.Equal(t, "o-6", stream.sent[1].OrderId, "live events of other tenants are skipped")
+}
+
+// importStreamRecorder feeds orders to an ImportOrders stream and records
+// its response.
+type importStreamRecorder struct {
+	grpc.ServerStream
+	orders []*orderv1.CreateOrderRequest
+	resp   *orderv1.ImportOrdersResponse
+}
+
+func (s *importStreamRecorder) Context() context.Context { return context.Background() }
+
+func (s *importStreamRecorder) Recv() (*orderv1.CreateOrderRequest, error) {
+	if len(s.orders) == 0 {
+		return nil, io.EOF
+	}
+	req := s.orders[0]
+	s.orders = s.orders[1:]
+	return req, nil
+}
+
+func (s *importStreamRecorder) SendAndClose(resp *orderv1.ImportOrdersResponse) error {
+	s.resp = resp
+	return nil
+}
+
+func TestOrderHandler_ImportOrders_ReportsRejectedOrders(t *testing.T) {
+	var batches []int
+	repo := &mocks.OrderRepositoryMock{
+		CreateBatchFunc: func(_ context.Context, orders []*domain.Order) ([]error, error) {
+			batches = append(batches, len(orders))
+			return make([]error, len(orders)), nil
+		},
+	}
+	h := &orderHandler{svc: service.NewOrderService(repo, nil, nil, nil, nil, service.StaticConfig(service.DefaultSettings))}
+
+	orders := make([]*orderv1.CreateOrderRequest, service.MaxBulkCreateOrders+50)
+	for i := range orders {
+		orders[i] = &orderv1.CreateOrderRequest{
+			CustomerId: uuid.NewString(),
+			Items:      []*orderv1.CreateOrderItem{{ProductId: "prod-1", Name: "Widget", Quantity: 1, Price: 9.99}},
+		}
+	}
+	orders[3].CustomerId = "legacy-42"
+	orders[120].Items = nil
+	stream := &importStreamRecorder{orders: orders}
+
+	err := h.ImportOrders(stream)
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(150), stream.resp.Received)
+	assert.Equal(t, int32(148), stream.resp.Imported)
+	assert.Equal(t, int32(2), stream.resp.Failed)
+	require.Len(t, stream.resp.Errors, 2)
+	assert.Equal(t, int32(3), stream.resp.Errors[0].Index)
+	assert.Equal(t, "INVALID_CUSTOMER_ID", stream.resp.Errors[0].Code)
+	assert.Equal(t, int32(120), stream.resp.Errors[1].Index)
+	assert.Equal(t, "NO_ITEMS", stream.resp.Errors[1].Code)
+	assert.Equal(t, []int{service.MaxBulkCreateOrders - 1, 49}, batches)
+}
+
+func TestOrderHandler_ImportOrders_Empty(t *testing.T) {
+	stream := &importStreamRecorder{}
+
+	err := (&orderHandler{}).ImportOrders(stream)
+
+	require.NoError(t, err)
+	assert.Zero(t, stream.resp.Received)
+	assert.Empty(t, stream.resp.Errors)
+}
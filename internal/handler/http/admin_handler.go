@@ -0,0 +1,164 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+)
+
+// AdminHandler handles operator requests for orders under /api/v1/admin
+type AdminHandler struct {
+	service service.AdminService
+}
+
+// NewAdminHandler creates a new admin handler
+func NewAdminHandler(svc service.AdminService) *AdminHandler {
+	return &AdminHandler{
+		service: svc,
+	}
+}
+
+// ListDeletedOrders handles GET /api/v1/admin/orders/deleted
+func (h *AdminHandler) ListDeletedOrders(w http.ResponseWriter, r *http.Request) {
+	limit := parseIntParam(r, "limit", defaultLimit)
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	if limit < 1 {
+		limit = defaultLimit
+	}
+
+	offset := parseIntParam(r, "offset", 0)
+	if offset < 0 {
+		offset = 0
+	}
+
+	result, err := h.service.ListDeletedOrders(r.Context(), limit, offset)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response := ListOrdersResponse{
+		Orders: MapOrdersToResponse(result.Data),
+		Total:  result.Total,
+		Limit:  limit,
+		Offset: offset,
+		Links:  pageLinks(r, limit, offset, len(result.Data), result.Total, false),
+	}
+	setLinkHeader(w, response.Links)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		return
+	}
+}
+
+// RestoreOrder handles POST /api/v1/admin/orders/{id}/restore
+func (h *AdminHandler) RestoreOrder(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "order")
+	if !ok {
+		return
+	}
+
+	order, err := h.service.RestoreOrder(r.Context(), id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(MapOrderToResponse(order)); err != nil {
+		return
+	}
+}
+
+// ForceOrderStatus handles POST /api/v1/admin/orders/{id}/force-status
+func (h *AdminHandler) ForceOrderStatus(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "order")
+	if !ok {
+		return
+	}
+
+	var req ForceStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+
+	if !validateRequest(w, r, &req) {
+		return
+	}
+
+	order, err := h.service.ForceOrderStatus(r.Context(), id, domain.OrderStatus(req.Status))
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(MapOrderToResponse(order)); err != nil {
+		return
+	}
+}
+
+// PurgeOrders handles POST /api/v1/admin/orders/purge
+func (h *AdminHandler) PurgeOrders(w http.ResponseWriter, r *http.Request) {
+	var req PurgeOrdersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+	if !validateRequest(w, r, &req) {
+		return
+	}
+
+	olderThan, err := time.ParseDuration(req.OlderThan)
+	if err != nil || olderThan < 0 {
+		writeError(w, r, http.StatusBadRequest, "older_than must be a non-negative duration such as 720h", "INVALID_OLDER_THAN")
+		return
+	}
+
+	purged, err := h.service.PurgeDeletedOrders(r.Context(), olderThan)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(PurgeOrdersResponse{Purged: purged}); err != nil {
+		return
+	}
+}
+
+// RegisterRoutes registers admin order routes on the admin route group
+func (h *AdminHandler) RegisterRoutes(r chi.Router) {
+	r.Route("/orders", func(r chi.Router) {
+		r.Get("/deleted", h.ListDeletedOrders)
+		r.Post("/purge", h.PurgeOrders)
+		r.Post("/{id}/restore", h.RestoreOrder)
+		r.Post("/{id}/force-status", h.ForceOrderStatus)
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional package name matching handler layer
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// orderETag is the entity tag of an order read. It is weak because
+// ?fields= and content negotiation change the bytes but not the order, and
+// it parses as an If-Match version, so a client can send it back on writes.
+func orderETag(order *domain.Order) string {
+	return `W/"` + strconv.Itoa(order.Version) + `"`
+}
+
+// setCacheControl tells clients to keep order reads to themselves and for
+// how long they may reuse one without revalidating it
+func setCacheControl(w http.ResponseWriter, maxAge time.Duration) {
+	if maxAge <= 0 {
+		w.Header().Set("Cache-Control", "private, no-cache")
+	} else {
+		w.Header().Set("Cache-Control", "private, max-age="+strconv.Itoa(int(maxAge.Seconds())))
+	}
+	w.Header().Set("Vary", "Authorization")
+}
+
+// setValidators sets the ETag and Last-Modified of an order read
+func setValidators(w http.ResponseWriter, order *domain.Order) {
+	w.Header().Set("ETag", orderETag(order))
+	w.Header().Set("Last-Modified", order.UpdatedAt.UTC().Format(http.TimeFormat))
+}
+
+// notModified reports whether the client already holds this version of the
+// order. If-None-Match is used when present, as RFC 9110 requires, and
+// If-Modified-Since otherwise; a malformed date is ignored.
+func notModified(r *http.Request, order *domain.Order) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		etag := strings.TrimPrefix(orderETag(order), "W/")
+		for _, tag := range strings.Split(inm, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+				return true
+			}
+		}
+		return false
+	}
+	since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	// Last-Modified has whole seconds, so compare at that precision
+	return !order.UpdatedAt.Truncate(time.Second).After(since)
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+)
+
+// CustomerDataHandler handles data protection requests for customers
+type CustomerDataHandler struct {
+	service service.CustomerDataService
+}
+
+// NewCustomerDataHandler creates a new customer data handler
+func NewCustomerDataHandler(svc service.CustomerDataService) *CustomerDataHandler {
+	return &CustomerDataHandler{
+		service: svc,
+	}
+}
+
+// EraseCustomerData handles DELETE /api/v1/customers/{id}/data
+func (h *CustomerDataHandler) EraseCustomerData(w http.ResponseWriter, r *http.Request) {
+	customerID, ok := idParam(w, r, "customer")
+	if !ok {
+		return
+	}
+
+	erasure, err := h.service.EraseCustomerData(r.Context(), customerID)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response := CustomerErasureResponse{
+		ErasureID:    erasure.ID.String(),
+		OrdersErased: erasure.OrderCount,
+		ErasedAt:     erasure.ErasedAt,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		return
+	}
+}
+
+// RegisterRoutes registers customer data routes
+func (h *CustomerDataHandler) RegisterRoutes(r chi.Router) {
+	r.Delete("/api/v1/customers/{id}/data", h.EraseCustomerData)
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+)
+
+// DeadLetterHandler handles operator requests for the event dead-letter queue
+type DeadLetterHandler struct {
+	service service.DeadLetterService
+}
+
+// NewDeadLetterHandler creates a new dead-letter handler
+func NewDeadLetterHandler(svc service.DeadLetterService) *DeadLetterHandler {
+	return &DeadLetterHandler{
+		service: svc,
+	}
+}
+
+// ListDeadLetters handles GET /api/v1/admin/dead-letters
+func (h *DeadLetterHandler) ListDeadLetters(w http.ResponseWriter, r *http.Request) {
+	limit := parseIntParam(r, "limit", defaultLimit)
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	if limit < 1 {
+		limit = defaultLimit
+	}
+
+	offset := parseIntParam(r, "offset", 0)
+	if offset < 0 {
+		offset = 0
+	}
+
+	result, err := h.service.ListDeadLetters(r.Context(), limit, offset)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response := ListDeadLettersResponse{
+		DeadLetters: MapDeadLettersToResponse(result.Data),
+		Total:       result.Total,
+		Limit:       limit,
+		Offset:      offset,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		return
+	}
+}
+
+// RequeueDeadLetter handles POST /api/v1/admin/dead-letters/{id}/requeue
+func (h *DeadLetterHandler) RequeueDeadLetter(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "dead letter")
+	if !ok {
+		return
+	}
+
+	if err := h.service.RequeueDeadLetter(r.Context(), id); err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// RegisterRoutes registers dead-letter routes on the admin route group
+func (h *DeadLetterHandler) RegisterRoutes(r chi.Router) {
+	r.Route("/dead-letters", func(r chi.Router) {
+		r.Get("/", h.ListDeadLetters)
+		r.Post("/{id}/requeue", h.RequeueDeadLetter)
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"expvar"
+	"net/http"
+	"net/http/pprof" // #nosec G108 -- routes are mounted on the debug listener only
+
+	"github.com/go-chi/chi/v5"
+)
+
+// DebugHandler serves the runtime profiling and expvar endpoints. It is
+// mounted on its own listener, never on the API router, and only when
+// pprof is enabled in the configuration.
+type DebugHandler struct{}
+
+// NewDebugHandler creates a debug handler
+func NewDebugHandler() *DebugHandler {
+	return &DebugHandler{}
+}
+
+// RegisterRoutes registers /debug/pprof/* and GET /debug/vars
+func (h *DebugHandler) RegisterRoutes(r chi.Router) {
+	r.HandleFunc("/debug/pprof/", pprof.Index)
+	r.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	r.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	r.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	r.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	// Named profiles: heap, goroutine, allocs, block, mutex, threadcreate
+	r.Handle("/debug/pprof/{profile}", http.HandlerFunc(pprof.Index))
+	r.Method(http.MethodGet, "/debug/vars", expvar.Handler())
+}
+
+// NewDebugRouter returns a router serving only the debug endpoints
+func NewDebugRouter() http.Handler {
+	r := chi.NewRouter()
+	NewDebugHandler().RegisterRoutes(r)
+	return r
+}
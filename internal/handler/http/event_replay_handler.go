@@ -0,0 +1,90 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+)
+
+// EventReplayHandler handles operator requests to re-send order events
+type EventReplayHandler struct {
+	service service.EventReplayService
+}
+
+// NewEventReplayHandler creates a new event replay handler
+func NewEventReplayHandler(svc service.EventReplayService) *EventReplayHandler {
+	return &EventReplayHandler{
+		service: svc,
+	}
+}
+
+// ReplayEvents handles POST /api/v1/admin/events/replay
+func (h *EventReplayHandler) ReplayEvents(w http.ResponseWriter, r *http.Request) {
+	var req ReplayEventsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+	if !validateRequest(w, r, &req) {
+		return
+	}
+
+	result, err := h.service.ReplayEvents(r.Context(), service.EventReplayRequest{
+		OrderID:    req.OrderID,
+		From:       req.From,
+		To:         req.To,
+		Target:     req.Target,
+		WebhookURL: req.WebhookURL,
+		Limit:      req.Limit,
+		Cursor:     req.Cursor,
+	})
+	if errors.Is(err, domain.ErrReplayDeliveryFailed) {
+		// Tell the operator where to pick up once the target is back
+		resume := "repeat the request to retry"
+		if result.NextCursor != "" {
+			resume = "resume with cursor " + result.NextCursor
+		}
+		writeError(w, r, http.StatusBadGateway,
+			fmt.Sprintf("a replayed event could not be delivered after %d events; %s", result.Replayed, resume),
+			"REPLAY_DELIVERY_FAILED")
+		return
+	}
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ReplayEventsResponse{
+		Replayed:   result.Replayed,
+		Skipped:    result.Skipped,
+		NextCursor: result.NextCursor,
+	}); err != nil {
+		return
+	}
+}
+
+// RegisterRoutes registers event replay routes on the admin route group
+func (h *EventReplayHandler) RegisterRoutes(r chi.Router) {
+	r.Post("/events/replay", h.ReplayEvents)
+}
@@ -27,18 +27,25 @@ type HealthChecker interface {
 	Ping(ctx context.Context) error
 }
 
+// SchemaChecker verifies the database schema is at the version this build expects
+type SchemaChecker interface {
+	CheckSchema(ctx context.Context) error
+}
+
 // HealthHandler handles health check endpoints
 // CONSTRAINT: Health endpoints must not require authentication (ADR-0002)
 type HealthHandler struct {
-	version   string
-	dbChecker HealthChecker
+	version       string
+	dbChecker     HealthChecker
+	schemaChecker SchemaChecker
 }
 
-// NewHealthHandler creates a new health handler
-func NewHealthHandler(version string, dbChecker HealthChecker) *HealthHandler {
+// NewHealthHandler creates a new health handler. schemaChecker may be nil.
+func NewHealthHandler(version string, dbChecker HealthChecker, schemaChecker SchemaChecker) *HealthHandler {
 	return &HealthHandler{
-		version:   version,
-		dbChecker: dbChecker,
+		version:       version,
+		dbChecker:     dbChecker,
+		schemaChecker: schemaChecker,
 	}
 }
 
@@ -81,6 +88,19 @@ func (h *HealthHandler) Readyz(w http.ResponseWriter, r *http.Request) {
 		checks["database"] = "not configured"
 	}
 
+	// Check schema version
+	if h.schemaChecker != nil {
+		ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		if err := h.schemaChecker.CheckSchema(ctx); err != nil {
+			checks["schema"] = "unhealthy: " + err.Error()
+			allHealthy = false
+		} else {
+			checks["schema"] = "ok"
+		}
+	}
+
 	status := "ok"
 	httpStatus := http.StatusOK
 	if !allHealthy {
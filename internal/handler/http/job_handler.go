@@ -0,0 +1,98 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+)
+
+// JobHandler handles operator requests for the asynchronous job queue
+type JobHandler struct {
+	service service.JobService
+}
+
+// NewJobHandler creates a new job handler
+func NewJobHandler(svc service.JobService) *JobHandler {
+	return &JobHandler{
+		service: svc,
+	}
+}
+
+// ListJobs handles GET /api/v1/admin/jobs
+func (h *JobHandler) ListJobs(w http.ResponseWriter, r *http.Request) {
+	limit := parseIntParam(r, "limit", defaultLimit)
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	if limit < 1 {
+		limit = defaultLimit
+	}
+
+	offset := parseIntParam(r, "offset", 0)
+	if offset < 0 {
+		offset = 0
+	}
+
+	result, err := h.service.ListJobs(r.Context(), r.URL.Query().Get("status"), limit, offset)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response := ListJobsResponse{
+		Jobs:   MapJobsToResponse(result.Data),
+		Total:  result.Total,
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		return
+	}
+}
+
+// GetJob handles GET /api/v1/admin/jobs/{id}
+func (h *JobHandler) GetJob(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "job")
+	if !ok {
+		return
+	}
+
+	job, err := h.service.GetJob(r.Context(), id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(MapJobToResponse(job)); err != nil {
+		return
+	}
+}
+
+// RegisterRoutes registers job routes on the admin route group
+func (h *JobHandler) RegisterRoutes(r chi.Router) {
+	r.Route("/jobs", func(r chi.Router) {
+		r.Get("/", h.ListJobs)
+		r.Get("/{id}", h.GetJob)
+	})
+}
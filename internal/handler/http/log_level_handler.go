@@ -0,0 +1,86 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// logLevels are the accepted level names, as in APP_LOG_LEVEL
+var logLevels = map[string]slog.Level{
+	"debug": slog.LevelDebug,
+	"info":  slog.LevelInfo,
+	"warn":  slog.LevelWarn,
+	"error": slog.LevelError,
+}
+
+// LogLevelHandler lets operators read and change the log level of a running instance
+type LogLevelHandler struct {
+	level *slog.LevelVar
+}
+
+// NewLogLevelHandler creates a handler controlling level, the LevelVar of the service logger
+func NewLogLevelHandler(level *slog.LevelVar) *LogLevelHandler {
+	return &LogLevelHandler{
+		level: level,
+	}
+}
+
+// GetLogLevel handles GET /api/v1/admin/loglevel
+func (h *LogLevelHandler) GetLogLevel(w http.ResponseWriter, _ *http.Request) {
+	h.writeLevel(w)
+}
+
+// SetLogLevel handles PUT /api/v1/admin/loglevel with {"level": "debug"}.
+// The change applies to this instance only and lasts until a restart, or
+// until a config reload changes APP_LOG_LEVEL.
+func (h *LogLevelHandler) SetLogLevel(w http.ResponseWriter, r *http.Request) {
+	var req LogLevelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+	if !validateRequest(w, r, &req) {
+		return
+	}
+
+	level, ok := logLevels[strings.ToLower(req.Level)]
+	if !ok {
+		writeError(w, r, http.StatusBadRequest, "level must be debug, info, warn or error", "INVALID_LOG_LEVEL")
+		return
+	}
+	h.level.Set(level)
+
+	h.writeLevel(w)
+}
+
+func (h *LogLevelHandler) writeLevel(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(LogLevelResponse{Level: strings.ToLower(h.level.Level().String())}); err != nil {
+		return
+	}
+}
+
+// RegisterRoutes registers log level routes on the admin route group
+func (h *LogLevelHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/loglevel", h.GetLogLevel)
+	r.Put("/loglevel", h.SetLogLevel)
+}
@@ -15,32 +15,76 @@
 package http //nolint:revive // intentional package name matching handler layer
 
 import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+	"unicode/utf8"
+
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
 )
 
 // MapOrderToResponse maps a domain order to HTTP response
 func MapOrderToResponse(order *domain.Order) OrderResponse {
 	items := make([]OrderItemResponse, len(order.Items))
 	for i, item := range order.Items {
-		items[i] = OrderItemResponse{
-			ID:        item.ID.String(),
-			ProductID: item.ProductID,
-			Name:      item.Name,
-			Quantity:  item.Quantity,
-			Price:     item.Price,
-			Subtotal:  item.Subtotal,
-		}
+		items[i] = mapItemToResponse(item)
 	}
 
-	return OrderResponse{
-		ID:         order.ID.String(),
-		CustomerID: order.CustomerID,
-		Items:      items,
-		Status:     string(order.Status),
-		Total:      order.Total,
-		Version:    order.Version,
-		CreatedAt:  order.CreatedAt,
-		UpdatedAt:  order.UpdatedAt,
+	resp := OrderResponse{
+		ID:              order.ID.String(),
+		TenantID:        order.TenantID,
+		CustomerID:      order.CustomerID,
+		Items:           items,
+		Status:          string(order.Status),
+		Total:           order.Total,
+		Version:         order.Version,
+		CreatedAt:       order.CreatedAt,
+		UpdatedAt:       order.UpdatedAt,
+		DeletedAt:       order.DeletedAt,
+		Metadata:        order.Metadata,
+		Tags:            order.Tags,
+		ShippingAddress: mapAddressToResponse(order.ShippingAddress),
+		BillingAddress:  mapAddressToResponse(order.BillingAddress),
+
+		ShippingMethod:      order.ShippingMethod,
+		EstimatedDeliveryAt: order.EstimatedDeliveryAt,
+		SLABreachedAt:       order.SLABreachedAt,
+	}
+	// Always render labels as objects and arrays, never null
+	if resp.Metadata == nil {
+		resp.Metadata = map[string]string{}
+	}
+	if resp.Tags == nil {
+		resp.Tags = []string{}
+	}
+	resp.Hold = mapHoldToResponse(order.Hold)
+	return resp
+}
+
+func mapItemToResponse(item domain.OrderItem) OrderItemResponse {
+	return OrderItemResponse{
+		ID:        item.ID.String(),
+		ProductID: item.ProductID,
+		Name:      item.Name,
+		Quantity:  item.Quantity,
+		Price:     item.Price,
+		Subtotal:  item.Subtotal,
+		Status:    string(item.Status),
+	}
+}
+
+// mapHoldToResponse returns nil for an order not on hold
+func mapHoldToResponse(hold *domain.OrderHold) *HoldResponse {
+	if hold == nil {
+		return nil
+	}
+	return &HoldResponse{
+		Reason:         hold.Reason,
+		PreviousStatus: string(hold.PreviousStatus),
+		HeldAt:         hold.HeldAt,
+		ReleaseAt:      hold.ReleaseAt,
 	}
 }
 
@@ -53,6 +97,352 @@ func MapOrdersToResponse(orders []*domain.Order) []OrderResponse {
 	return responses
 }
 
+// orderFieldNames holds the JSON names of the order fields ?fields= may select
+var orderFieldNames = jsonFieldNames(reflect.TypeOf(OrderResponse{}))
+
+// SelectOrderFields limits the responses to the named fields, which must be
+// in orderFieldNames; nil fields keeps every field
+func SelectOrderFields(responses []OrderResponse, fields []string) {
+	for i := range responses {
+		responses[i].fields = fields
+	}
+}
+
+// MarshalJSON writes only the selected fields when SelectOrderFields was used.
+// Unset optional fields are left out as usual, even when selected.
+func (o OrderResponse) MarshalJSON() ([]byte, error) {
+	type order OrderResponse // drops this method so Marshal does not recurse
+	data, err := json.Marshal(order(o))
+	if err != nil || o.fields == nil {
+		return data, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+	sparse := make(map[string]json.RawMessage, len(o.fields))
+	for _, name := range o.fields {
+		if value, ok := all[name]; ok {
+			sparse[name] = value
+		}
+	}
+	return json.Marshal(sparse)
+}
+
+// jsonFieldNames returns the JSON names of the exported fields of a struct type
+func jsonFieldNames(t reflect.Type) []string {
+	names := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// MapNoteToResponse converts a domain order note to a response DTO
+func MapNoteToResponse(note *domain.OrderNote) NoteResponse {
+	return NoteResponse{
+		ID:         note.ID.String(),
+		OrderID:    note.OrderID.String(),
+		Author:     note.Author,
+		Body:       note.Body,
+		Visibility: string(note.Visibility),
+		CreatedAt:  note.CreatedAt,
+	}
+}
+
+// MapNotesToResponse converts a slice of domain order notes to response DTOs
+func MapNotesToResponse(notes []*domain.OrderNote) []NoteResponse {
+	responses := make([]NoteResponse, len(notes))
+	for i, note := range notes {
+		responses[i] = MapNoteToResponse(note)
+	}
+	return responses
+}
+
+// MapSubscriptionToResponse converts a domain subscription to a response DTO
+func MapSubscriptionToResponse(sub *domain.Subscription) SubscriptionResponse {
+	items := make([]SubscriptionItemResponse, len(sub.Items))
+	for i, item := range sub.Items {
+		items[i] = SubscriptionItemResponse{
+			ProductID: item.ProductID,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			Price:     item.Price,
+			Subtotal:  item.CalculateSubtotal(),
+		}
+	}
+
+	return SubscriptionResponse{
+		ID:              sub.ID.String(),
+		CustomerID:      sub.CustomerID,
+		Items:           items,
+		Cadence:         string(sub.Cadence),
+		Status:          string(sub.Status),
+		NextRunAt:       sub.NextRunAt,
+		ShippingMethod:  sub.ShippingMethod,
+		ShippingAddress: mapAddressToResponse(sub.ShippingAddress),
+		Version:         sub.Version,
+		CreatedAt:       sub.CreatedAt,
+		UpdatedAt:       sub.UpdatedAt,
+	}
+}
+
+// MapSubscriptionsToResponse converts a slice of domain subscriptions to response DTOs
+func MapSubscriptionsToResponse(subs []*domain.Subscription) []SubscriptionResponse {
+	responses := make([]SubscriptionResponse, len(subs))
+	for i, sub := range subs {
+		responses[i] = MapSubscriptionToResponse(sub)
+	}
+	return responses
+}
+
+// MapDeadLetterToResponse converts a dead letter to its response DTO
+func MapDeadLetterToResponse(dl *messaging.DeadLetter) DeadLetterResponse {
+	payload := json.RawMessage(dl.Payload)
+	switch {
+	case json.Valid(payload):
+	case utf8.Valid(dl.Payload):
+		// Non-JSON payloads are surfaced as a JSON string so the response stays valid.
+		payload, _ = json.Marshal(string(dl.Payload))
+	default:
+		// Avro and protobuf payloads are binary; a []byte marshals as base64
+		payload, _ = json.Marshal(dl.Payload)
+	}
+
+	return DeadLetterResponse{
+		ID:            dl.ID.String(),
+		Topic:         dl.Topic,
+		Key:           dl.Key,
+		EventType:     dl.EventType,
+		OrderID:       dl.OrderID,
+		Headers:       dl.Headers,
+		Payload:       payload,
+		LastError:     dl.LastError,
+		Attempts:      dl.Attempts,
+		CreatedAt:     dl.CreatedAt,
+		UpdatedAt:     dl.UpdatedAt,
+		NextAttemptAt: dl.NextAttemptAt,
+	}
+}
+
+// MapDeadLettersToResponse converts a slice of dead letters to response DTOs
+func MapDeadLettersToResponse(letters []*messaging.DeadLetter) []DeadLetterResponse {
+	responses := make([]DeadLetterResponse, len(letters))
+	for i, dl := range letters {
+		responses[i] = MapDeadLetterToResponse(dl)
+	}
+	return responses
+}
+
+// MapJobToResponse converts a job to its response DTO
+func MapJobToResponse(job *domain.Job) JobResponse {
+	return JobResponse{
+		ID:          job.ID.String(),
+		Kind:        string(job.Kind),
+		Status:      string(job.Status),
+		Payload:     job.Payload,
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		RunAt:       job.RunAt,
+		LastError:   job.LastError,
+		CreatedAt:   job.CreatedAt,
+		UpdatedAt:   job.UpdatedAt,
+		FinishedAt:  job.FinishedAt,
+	}
+}
+
+// MapJobsToResponse converts a slice of jobs to response DTOs
+func MapJobsToResponse(jobs []*domain.Job) []JobResponse {
+	responses := make([]JobResponse, len(jobs))
+	for i, job := range jobs {
+		responses[i] = MapJobToResponse(job)
+	}
+	return responses
+}
+
+// MapOrderEventToResponse converts an order event to its response DTO
+func MapOrderEventToResponse(evt messaging.OrderEvent) OrderEventResponse {
+	return OrderEventResponse{
+		EventID:    evt.EventID,
+		EventType:  evt.EventType,
+		OrderID:    evt.OrderID,
+		CustomerID: evt.CustomerID,
+		Status:     evt.Status,
+		OldStatus:  evt.OldStatus,
+		NewStatus:  evt.NewStatus,
+		Total:      evt.Total,
+		Version:    evt.Version,
+		OccurredAt: evt.OccurredAt,
+	}
+}
+
+// MapOrderHistoryEntryToResponse converts a history entry to its response DTO
+func MapOrderHistoryEntryToResponse(entry *domain.OrderHistoryEntry) OrderHistoryEntryResponse {
+	resp := OrderHistoryEntryResponse{
+		ID:        entry.ID.String(),
+		OrderID:   entry.OrderID.String(),
+		Action:    string(entry.Action),
+		Actor:     entry.Actor,
+		ActorType: string(entry.ActorType),
+		Channel:   string(entry.Channel),
+		CreatedAt: entry.CreatedAt,
+	}
+	if entry.OldState != nil {
+		old := MapOrderToResponse(entry.OldState)
+		resp.OldState = &old
+	}
+	if entry.NewState != nil {
+		updated := MapOrderToResponse(entry.NewState)
+		resp.NewState = &updated
+	}
+	return resp
+}
+
+// MapOrderHistoryToResponse converts a slice of history entries to response DTOs
+func MapOrderHistoryToResponse(entries []*domain.OrderHistoryEntry) []OrderHistoryEntryResponse {
+	responses := make([]OrderHistoryEntryResponse, len(entries))
+	for i, entry := range entries {
+		responses[i] = MapOrderHistoryEntryToResponse(entry)
+	}
+	return responses
+}
+
+// MapOrderDiffToResponse converts an order diff to its response DTO
+func MapOrderDiffToResponse(diff *domain.OrderDiff) OrderDiffResponse {
+	items := make([]ItemChangeResponse, len(diff.Items))
+	for i, change := range diff.Items {
+		items[i] = ItemChangeResponse{
+			ItemID:  change.ItemID.String(),
+			Change:  string(change.Kind),
+			Changes: mapFieldChangesToResponse(change.Changes),
+		}
+		if change.From != nil {
+			from := mapItemToResponse(*change.From)
+			items[i].From = &from
+		}
+		if change.To != nil {
+			to := mapItemToResponse(*change.To)
+			items[i].To = &to
+		}
+	}
+	return OrderDiffResponse{
+		OrderID:     diff.OrderID.String(),
+		FromVersion: diff.FromVersion,
+		ToVersion:   diff.ToVersion,
+		Changes:     mapFieldChangesToResponse(diff.Changes),
+		Items:       items,
+		From:        MapOrderToResponse(diff.From),
+		To:          MapOrderToResponse(diff.To),
+	}
+}
+
+// mapFieldChangesToResponse renders changed values as the order response
+// renders them, e.g. addresses with snake_case keys
+func mapFieldChangesToResponse(changes []domain.FieldChange) []FieldChangeResponse {
+	responses := make([]FieldChangeResponse, len(changes))
+	for i, c := range changes {
+		responses[i] = FieldChangeResponse{Field: c.Field, From: mapDiffValue(c.From), To: mapDiffValue(c.To)}
+	}
+	return responses
+}
+
+func mapDiffValue(v any) any {
+	switch v := v.(type) {
+	case *domain.Address:
+		return mapAddressToResponse(v)
+	case *domain.OrderHold:
+		return mapHoldToResponse(v)
+	}
+	return v
+}
+
+// MapOrderReportToResponse converts a domain order report to a response DTO
+func MapOrderReportToResponse(report *domain.OrderReport) OrderReportResponse {
+	rows := make([]OrderReportRowResponse, len(report.Rows))
+	for i, row := range report.Rows {
+		rows[i] = OrderReportRowResponse{
+			Key:        row.Key,
+			OrderCount: row.OrderCount,
+			Revenue:    row.Revenue,
+		}
+	}
+	return OrderReportResponse{
+		GroupBy:      string(report.GroupBy),
+		From:         report.From,
+		To:           report.To,
+		Rows:         rows,
+		TotalOrders:  report.TotalOrders,
+		TotalRevenue: report.TotalRevenue,
+	}
+}
+
+// MapPaymentToResponse converts a payment and its order to a response DTO
+func MapPaymentToResponse(p *domain.Payment, order *domain.Order) PaymentResponse {
+	return PaymentResponse{
+		Provider:       p.Provider,
+		Reference:      p.Reference,
+		Status:         string(p.Status),
+		Amount:         p.Amount.Float64(),
+		Currency:       p.Currency,
+		FailureCode:    p.FailureCode,
+		FailureMessage: p.FailureMessage,
+		Order:          MapOrderToResponse(order),
+	}
+}
+
+// MapOrderStatsToResponse converts domain order stats to a response DTO
+func MapOrderStatsToResponse(stats *domain.OrderStats) StatsResponse {
+	byStatus := make(map[string]int64, len(stats.ByStatus))
+	for status, count := range stats.ByStatus {
+		byStatus[string(status)] = count
+	}
+	resp := StatsResponse{
+		GeneratedAt:    stats.GeneratedAt,
+		OrdersByStatus: byStatus,
+		TotalOrders:    stats.TotalOrders,
+		Today: TodayStatsResponse{
+			Date:    stats.Today.Format(time.DateOnly),
+			Orders:  stats.TodayOrders,
+			Revenue: stats.TodayRevenue,
+		},
+		Cache: CacheStatsResponse{
+			Hits:   stats.Cache.Hits,
+			Misses: stats.Cache.Misses,
+		},
+	}
+	if rate, ok := stats.Cache.HitRate(); ok {
+		resp.Cache.HitRate = &rate
+	}
+	return resp
+}
+
+// mapAddressToResponse returns nil for a nil address
+func mapAddressToResponse(a *domain.Address) *AddressResponse {
+	if a == nil {
+		return nil
+	}
+	resp := AddressResponse(*a)
+	return &resp
+}
+
+// MapRequestToAddress maps an HTTP request address to a domain address,
+// returning nil for a nil one
+func MapRequestToAddress(a *Address) *domain.Address {
+	if a == nil {
+		return nil
+	}
+	addr := domain.Address(*a)
+	return &addr
+}
+
 // MapRequestToOrderItems maps HTTP request items to domain items
 func MapRequestToOrderItems(items []OrderItem) []domain.OrderItem {
 	domainItems := make([]domain.OrderItem, len(items))
@@ -62,8 +452,8 @@ func MapRequestToOrderItems(items []OrderItem) []domain.OrderItem {
 			Name:      item.Name,
 			Quantity:  item.Quantity,
 			Price:     item.Price,
-			Subtotal:  float64(item.Quantity) * item.Price,
 		}
+		domainItems[i].Subtotal = domainItems[i].CalculateSubtotal()
 	}
 	return domainItems
 }
@@ -0,0 +1,38 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// MetricsHandler exposes Prometheus metrics for scraping
+type MetricsHandler struct {
+	exporter http.Handler
+}
+
+// NewMetricsHandler creates a handler serving exporter, e.g. promhttp.Handler()
+func NewMetricsHandler(exporter http.Handler) *MetricsHandler {
+	return &MetricsHandler{
+		exporter: exporter,
+	}
+}
+
+// RegisterRoutes registers GET /metrics; like the health probes it is unauthenticated
+func (h *MetricsHandler) RegisterRoutes(r chi.Router) {
+	r.Method(http.MethodGet, "/metrics", h.exporter)
+}
@@ -15,14 +15,22 @@
 package http //nolint:revive // intentional: matches handler layer convention
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
+	"runtime/debug"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/errcode"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/problem"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
 )
 
@@ -33,13 +41,19 @@ const (
 
 // OrderHandler handles HTTP requests for order operations
 type OrderHandler struct {
-	service service.OrderService
+	service     service.OrderService
+	notes       service.OrderNoteService
+	cacheMaxAge time.Duration
 }
 
-// NewOrderHandler creates a new order handler
-func NewOrderHandler(svc service.OrderService) *OrderHandler {
+// NewOrderHandler creates a new order handler. notes serves ?include=notes
+// and may be nil, in which case notes are never included. cacheMaxAge is
+// how long clients may reuse an order read before revalidating it.
+func NewOrderHandler(svc service.OrderService, notes service.OrderNoteService, cacheMaxAge time.Duration) *OrderHandler {
 	return &OrderHandler{
-		service: svc,
+		service:     svc,
+		notes:       notes,
+		cacheMaxAge: cacheMaxAge,
 	}
 }
 
@@ -48,27 +62,27 @@ func NewOrderHandler(svc service.OrderService) *OrderHandler {
 func (h *OrderHandler) CreateOrder(w http.ResponseWriter, r *http.Request) {
 	var req CreateOrderRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeError(w, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
 		return
 	}
 
-	if req.CustomerID == "" {
-		writeError(w, http.StatusBadRequest, "customer_id is required", "MISSING_CUSTOMER_ID")
-		return
-	}
-	if len(req.Items) == 0 {
-		writeError(w, http.StatusBadRequest, "items are required", "MISSING_ITEMS")
+	if !validateRequest(w, r, &req) {
 		return
 	}
 
 	dto := service.CreateOrderDTO{
-		CustomerID: req.CustomerID,
-		Items:      MapRequestToOrderItems(req.Items),
+		CustomerID:      req.CustomerID,
+		Items:           MapRequestToOrderItems(req.Items),
+		Metadata:        req.Metadata,
+		Tags:            req.Tags,
+		ShippingAddress: MapRequestToAddress(req.ShippingAddress),
+		BillingAddress:  MapRequestToAddress(req.BillingAddress),
+		ShippingMethod:  req.ShippingMethod,
 	}
 
 	order, err := h.service.CreateOrder(r.Context(), dto)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
@@ -83,28 +97,69 @@ func (h *OrderHandler) CreateOrder(w http.ResponseWriter, r *http.Request) {
 
 // GetOrder handles GET /api/v1/orders/{id}
 // CONSTRAINT: Returns 404 for missing orders (ADR-0002)
+// Returns 304 when If-None-Match or If-Modified-Since shows the client has this version
 func (h *OrderHandler) GetOrder(w http.ResponseWriter, r *http.Request) {
-	id := chi.URLParam(r, "id")
-	if id == "" {
-		writeError(w, http.StatusBadRequest, "order ID is required", "MISSING_ID")
+	id, ok := idParam(w, r, "order")
+	if !ok {
+		return
+	}
+
+	withNotes, ok := includeNotesQuery(w, r)
+	if !ok {
 		return
 	}
+	fields, ok := fieldsQuery(w, r)
+	if !ok {
+		return
+	}
+
+	// include_deleted=true also finds a soft-deleted order; service callers only
+	includeDeleted, _ := strconv.ParseBool(r.URL.Query().Get("include_deleted"))
 
-	order, err := h.service.GetOrderByID(r.Context(), id)
+	var (
+		order *domain.Order
+		err   error
+	)
+	if includeDeleted {
+		order, err = h.service.GetOrderByIDIncludingDeleted(r.Context(), id)
+	} else {
+		order, err = h.service.GetOrderByID(r.Context(), id)
+	}
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
+	setCacheControl(w, h.cacheMaxAge)
+	// Notes change without bumping the version, so reads with them get no validators
+	if !withNotes {
+		setValidators(w, order)
+		if notModified(r, order) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	responses := []OrderResponse{MapOrderToResponse(order)}
+	if withNotes {
+		if err := h.attachNotes(r.Context(), []*domain.Order{order}, responses); err != nil {
+			handleServiceError(w, r, err)
+			return
+		}
+	}
+	SelectOrderFields(responses, fields)
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(MapOrderToResponse(order)); err != nil {
+	if err := json.NewEncoder(w).Encode(responses[0]); err != nil {
 		return
 	}
 }
 
 // ListOrders handles GET /api/v1/orders
-// Supports ?status=pending&limit=20&offset=0
+// Supports ?status=pending&customer_id=c1&product_id=p1&tag=vip&limit=20&offset=0
+// tag may be repeated; orders must carry every tag
+// fields=id,status,total returns only those fields of each order
 func (h *OrderHandler) ListOrders(w http.ResponseWriter, r *http.Request) {
 	// Parse query parameters
 	limit := parseIntParam(r, "limit", defaultLimit)
@@ -120,10 +175,6 @@ func (h *OrderHandler) ListOrders(w http.ResponseWriter, r *http.Request) {
 		offset = 0
 	}
 
-	// Convert limit/offset to page/pageSize for service layer
-	page := (offset / limit) + 1
-	pageSize := limit
-
 	// Parse status filter
 	var status *domain.OrderStatus
 	if statusStr := r.URL.Query().Get("status"); statusStr != "" {
@@ -132,30 +183,70 @@ func (h *OrderHandler) ListOrders(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Parse customer_id filter
-	var customerID *string
-	if cid := r.URL.Query().Get("customer_id"); cid != "" {
-		customerID = &cid
+	customerID, ok := customerIDQuery(w, r)
+	if !ok {
+		return
+	}
+
+	// Parse product_id filter
+	var productID *string
+	if pid := r.URL.Query().Get("product_id"); pid != "" {
+		productID = &pid
+	}
+
+	// Parse tag filter
+	tags := r.URL.Query()["tag"]
+
+	// exact=true counts the matches even when totals are estimated
+	exact, _ := strconv.ParseBool(r.URL.Query().Get("exact"))
+
+	// include_deleted=true lists soft-deleted orders too; service callers only
+	includeDeleted, _ := strconv.ParseBool(r.URL.Query().Get("include_deleted"))
+
+	withNotes, ok := includeNotesQuery(w, r)
+	if !ok {
+		return
+	}
+	fields, ok := fieldsQuery(w, r)
+	if !ok {
+		return
 	}
 
 	req := service.ListOrdersRequest{
-		Page:       page,
-		PageSize:   pageSize,
-		Status:     status,
-		CustomerID: customerID,
+		PageSize:       limit,
+		Offset:         offset,
+		Status:         status,
+		CustomerID:     customerID,
+		ProductID:      productID,
+		Tags:           tags,
+		ExactTotal:     exact,
+		IncludeDeleted: includeDeleted,
 	}
 
 	result, err := h.service.ListOrders(r.Context(), req)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
+	orders := MapOrdersToResponse(result.Data)
+	if withNotes {
+		if err := h.attachNotes(r.Context(), result.Data, orders); err != nil {
+			handleServiceError(w, r, err)
+			return
+		}
+	}
+	SelectOrderFields(orders, fields)
+
 	response := ListOrdersResponse{
-		Orders: MapOrdersToResponse(result.Data),
-		Total:  result.TotalCount,
-		Limit:  limit,
-		Offset: offset,
+		Orders:         orders,
+		Total:          result.TotalCount,
+		TotalEstimated: result.TotalEstimated,
+		Limit:          result.PageSize, // the service may cap the requested limit
+		Offset:         result.Offset,
+		Links:          pageLinks(r, result.PageSize, result.Offset, len(result.Data), result.TotalCount, result.TotalEstimated),
 	}
+	setLinkHeader(w, response.Links)
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
@@ -165,30 +256,39 @@ func (h *OrderHandler) ListOrders(w http.ResponseWriter, r *http.Request) {
 }
 
 // UpdateOrderStatus handles PATCH /api/v1/orders/{id}/status
+// The expected version may be sent as "version" in the body or as an If-Match header.
 // Returns 200 on success, 400 for invalid transitions, 404 for missing, 409 for conflicts
 func (h *OrderHandler) UpdateOrderStatus(w http.ResponseWriter, r *http.Request) {
-	id := chi.URLParam(r, "id")
-	if id == "" {
-		writeError(w, http.StatusBadRequest, "order ID is required", "MISSING_ID")
+	id, ok := idParam(w, r, "order")
+	if !ok {
 		return
 	}
 
 	var req UpdateStatusRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeError(w, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
 		return
 	}
 
-	if req.Status == "" {
-		writeError(w, http.StatusBadRequest, "status is required", "MISSING_STATUS")
+	if !validateRequest(w, r, &req) {
 		return
 	}
 
+	expectedVersion := req.Version
+	if expectedVersion == nil {
+		v, ok := parseIfMatchVersion(r)
+		if !ok {
+			writeError(w, r, http.StatusBadRequest, "If-Match must be an order version", "INVALID_IF_MATCH")
+			return
+		}
+		expectedVersion = v
+	}
+
 	newStatus := domain.OrderStatus(req.Status)
 
-	order, err := h.service.UpdateOrderStatus(r.Context(), id, newStatus)
+	order, err := h.service.UpdateOrderStatus(r.Context(), id, newStatus, expectedVersion)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
@@ -199,32 +299,195 @@ func (h *OrderHandler) UpdateOrderStatus(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// BulkCreateOrders handles POST /api/v1/orders/bulk
+// Returns 200 with a per-order result; individual failures do not fail the request
+func (h *OrderHandler) BulkCreateOrders(w http.ResponseWriter, r *http.Request) {
+	var req BulkCreateOrdersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+
+	if !validateRequest(w, r, &req) {
+		return
+	}
+
+	dtos := make([]service.CreateOrderDTO, len(req.Orders))
+	for i, o := range req.Orders {
+		dtos[i] = service.CreateOrderDTO{
+			CustomerID:      o.CustomerID,
+			Items:           MapRequestToOrderItems(o.Items),
+			Metadata:        o.Metadata,
+			Tags:            o.Tags,
+			ShippingAddress: MapRequestToAddress(o.ShippingAddress),
+			BillingAddress:  MapRequestToAddress(o.BillingAddress),
+			ShippingMethod:  o.ShippingMethod,
+		}
+	}
+
+	results := h.service.BulkCreateOrders(r.Context(), dtos)
+
+	response := BulkCreateOrdersResponse{
+		Results: make([]BulkCreateResult, len(results)),
+	}
+	for i, res := range results {
+		if res.Err != nil {
+			_, errResp := mapServiceError(res.Err)
+			response.Results[i] = BulkCreateResult{Index: i, Error: &errResp}
+			response.Failed++
+			continue
+		}
+		orderResp := MapOrderToResponse(res.Order)
+		response.Results[i] = BulkCreateResult{Index: i, Success: true, Order: &orderResp}
+		response.Succeeded++
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		return
+	}
+}
+
+// BulkUpdateOrderStatus handles PATCH /api/v1/orders/status
+// Returns 200 with a per-order result; individual failures do not fail the request
+func (h *OrderHandler) BulkUpdateOrderStatus(w http.ResponseWriter, r *http.Request) {
+	var req BulkUpdateStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+
+	if !validateRequest(w, r, &req) {
+		return
+	}
+
+	// Reject an unknown status once rather than failing every order with it
+	newStatus := domain.OrderStatus(req.Status)
+	if !newStatus.IsValid() {
+		handleServiceError(w, r, domain.ErrInvalidStatus)
+		return
+	}
+
+	results := h.service.BulkUpdateOrderStatus(r.Context(), req.OrderIDs, newStatus)
+
+	response := BulkUpdateStatusResponse{
+		Results: make([]BulkStatusResult, len(results)),
+	}
+	for i, res := range results {
+		if res.Err != nil {
+			_, errResp := mapServiceError(res.Err)
+			response.Results[i] = BulkStatusResult{OrderID: res.OrderID, Error: &errResp}
+			response.Failed++
+			continue
+		}
+		orderResp := MapOrderToResponse(res.Order)
+		response.Results[i] = BulkStatusResult{OrderID: res.OrderID, Success: true, Order: &orderResp}
+		response.Succeeded++
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		return
+	}
+}
+
 // UpdateOrder handles PUT /api/v1/orders/{id}
 func (h *OrderHandler) UpdateOrder(w http.ResponseWriter, r *http.Request) {
-	id := chi.URLParam(r, "id")
-	if id == "" {
-		writeError(w, http.StatusBadRequest, "order ID is required", "MISSING_ID")
+	id, ok := idParam(w, r, "order")
+	if !ok {
 		return
 	}
 
 	var req UpdateOrderRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeError(w, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
 		return
 	}
 
-	if len(req.Items) == 0 {
-		writeError(w, http.StatusBadRequest, "items are required", "MISSING_ITEMS")
+	if !validateRequest(w, r, &req) {
 		return
 	}
 
 	dto := service.UpdateOrderDTO{
-		Items: MapRequestToOrderItems(req.Items),
+		Items:    MapRequestToOrderItems(req.Items),
+		Metadata: req.Metadata,
+		Tags:     req.Tags,
 	}
 
 	order, err := h.service.UpdateOrder(r.Context(), id, dto)
 	if err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(MapOrderToResponse(order)); err != nil {
+		return
+	}
+}
+
+// PatchOrder handles PATCH /api/v1/orders/{id}
+// The body is a JSON merge patch of items, metadata and tags. The expected
+// version may be sent as "version" in the body or as an If-Match header.
+func (h *OrderHandler) PatchOrder(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "order")
+	if !ok {
+		return
+	}
+
+	var req PatchOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		var patchErr *patchError
+		if errors.As(err, &patchErr) {
+			writeError(w, r, http.StatusBadRequest, patchErr.Error(), "INVALID_PATCH")
+			return
+		}
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+	if !validateRequest(w, r, &req) {
+		return
+	}
+
+	expectedVersion := req.Version
+	if expectedVersion == nil {
+		v, ok := parseIfMatchVersion(r)
+		if !ok {
+			writeError(w, r, http.StatusBadRequest, "If-Match must be an order version", "INVALID_IF_MATCH")
+			return
+		}
+		expectedVersion = v
+	}
+
+	patch := service.PatchOrderDTO{
+		Metadata:      req.Metadata,
+		ClearMetadata: req.clearMetadata,
+	}
+	if len(req.Items) > 0 {
+		patch.Items = make(map[string]*service.ItemPatch, len(req.Items))
+		for key, item := range req.Items {
+			if item == nil {
+				patch.Items[key] = nil
+				continue
+			}
+			patch.Items[key] = &service.ItemPatch{
+				ProductID: item.ProductID,
+				Name:      item.Name,
+				Quantity:  item.Quantity,
+				Price:     item.Price,
+			}
+		}
+	}
+	if req.tagsSet {
+		patch.Tags = &req.Tags
+	}
+
+	order, err := h.service.PatchOrder(r.Context(), id, patch, expectedVersion)
+	if err != nil {
+		handleServiceError(w, r, err)
 		return
 	}
 
@@ -237,30 +500,409 @@ func (h *OrderHandler) UpdateOrder(w http.ResponseWriter, r *http.Request) {
 
 // DeleteOrder handles DELETE /api/v1/orders/{id}
 func (h *OrderHandler) DeleteOrder(w http.ResponseWriter, r *http.Request) {
-	id := chi.URLParam(r, "id")
-	if id == "" {
-		writeError(w, http.StatusBadRequest, "order ID is required", "MISSING_ID")
+	id, ok := idParam(w, r, "order")
+	if !ok {
 		return
 	}
 
 	if err := h.service.DeleteOrder(r.Context(), id); err != nil {
-		handleServiceError(w, err)
+		handleServiceError(w, r, err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// RestoreOrder handles POST /api/v1/orders/{id}/restore
+// The body is optional; a version may also be given in If-Match.
+func (h *OrderHandler) RestoreOrder(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "order")
+	if !ok {
+		return
+	}
+
+	var req RestoreOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+	if !validateRequest(w, r, &req) {
+		return
+	}
+
+	expectedVersion := req.Version
+	if expectedVersion == nil {
+		v, ok := parseIfMatchVersion(r)
+		if !ok {
+			writeError(w, r, http.StatusBadRequest, "If-Match must be an order version", "INVALID_IF_MATCH")
+			return
+		}
+		expectedVersion = v
+	}
+
+	order, err := h.service.RestoreOrder(r.Context(), id, expectedVersion)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(MapOrderToResponse(order)); err != nil {
+		return
+	}
+}
+
+// HoldOrder handles POST /api/v1/orders/{id}/hold
+// The expected version may be sent as "version" in the body or as an If-Match header.
+// Returns 200 on success, 400 if the order cannot be held, 404 for missing, 409 for conflicts
+func (h *OrderHandler) HoldOrder(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "order")
+	if !ok {
+		return
+	}
+
+	var req HoldOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+	if !validateRequest(w, r, &req) {
+		return
+	}
+
+	expectedVersion := req.Version
+	if expectedVersion == nil {
+		v, ok := parseIfMatchVersion(r)
+		if !ok {
+			writeError(w, r, http.StatusBadRequest, "If-Match must be an order version", "INVALID_IF_MATCH")
+			return
+		}
+		expectedVersion = v
+	}
+
+	order, err := h.service.HoldOrder(r.Context(), id, req.Reason, expectedVersion)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(MapOrderToResponse(order)); err != nil {
+		return
+	}
+}
+
+// ReleaseOrder handles POST /api/v1/orders/{id}/release
+// The body is optional; a version may also be given in If-Match.
+// Returns 200 on success, 404 for missing, 409 if the order is not on hold or for conflicts
+func (h *OrderHandler) ReleaseOrder(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "order")
+	if !ok {
+		return
+	}
+
+	var req ReleaseOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+	if !validateRequest(w, r, &req) {
+		return
+	}
+
+	expectedVersion := req.Version
+	if expectedVersion == nil {
+		v, ok := parseIfMatchVersion(r)
+		if !ok {
+			writeError(w, r, http.StatusBadRequest, "If-Match must be an order version", "INVALID_IF_MATCH")
+			return
+		}
+		expectedVersion = v
+	}
+
+	order, err := h.service.ReleaseOrder(r.Context(), id, expectedVersion)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(MapOrderToResponse(order)); err != nil {
+		return
+	}
+}
+
+// UpdateItemStatus handles PATCH /api/v1/orders/{id}/items/{itemID}/status
+// The expected version may be sent as "version" in the body or as an If-Match header.
+// Returns 200 with the order, 400 for invalid transitions, 404 for a missing order or item
+func (h *OrderHandler) UpdateItemStatus(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "order")
+	if !ok {
+		return
+	}
+	itemID, ok := uuidParam(w, r, "itemID", "item")
+	if !ok {
+		return
+	}
+
+	var req UpdateItemStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+	if !validateRequest(w, r, &req) {
+		return
+	}
+
+	h.updateItemStatus(w, r, id, []string{itemID}, req.Status, req.Version)
+}
+
+// UpdateItemsStatus handles PATCH /api/v1/orders/{id}/items/status
+// All listed items change together or, on error, none does.
+func (h *OrderHandler) UpdateItemsStatus(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "order")
+	if !ok {
+		return
+	}
+
+	var req UpdateItemsStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+	if !validateRequest(w, r, &req) {
+		return
+	}
+
+	h.updateItemStatus(w, r, id, req.ItemIDs, req.Status, req.Version)
+}
+
+func (h *OrderHandler) updateItemStatus(w http.ResponseWriter, r *http.Request, id string, itemIDs []string, status string, version *int) {
+	expectedVersion := version
+	if expectedVersion == nil {
+		v, ok := parseIfMatchVersion(r)
+		if !ok {
+			writeError(w, r, http.StatusBadRequest, "If-Match must be an order version", "INVALID_IF_MATCH")
+			return
+		}
+		expectedVersion = v
+	}
+
+	order, err := h.service.UpdateItemStatus(r.Context(), id, itemIDs, domain.ItemStatus(status), expectedVersion)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(MapOrderToResponse(order)); err != nil {
+		return
+	}
+}
+
+// AddItem handles POST /api/v1/orders/{id}/items
+// The expected version may be sent as "version" in the body or as an If-Match header.
+// Returns 201 with the order, the new item last, or 409 once the order is past confirmed
+func (h *OrderHandler) AddItem(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "order")
+	if !ok {
+		return
+	}
+
+	var req AddItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+	if !validateRequest(w, r, &req) {
+		return
+	}
+	expectedVersion, ok := expectedVersionOf(w, r, req.Version)
+	if !ok {
+		return
+	}
+
+	item := domain.OrderItem{
+		ProductID: req.ProductID,
+		Name:      req.Name,
+		Quantity:  req.Quantity,
+		Price:     req.Price,
+	}
+	order, err := h.service.AddItem(r.Context(), id, item, expectedVersion)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(MapOrderToResponse(order)); err != nil {
+		return
+	}
+}
+
+// UpdateItem handles PATCH /api/v1/orders/{id}/items/{itemID}
+// The expected version may be sent as "version" in the body or as an If-Match header.
+// Returns 200 with the order, or 409 once the order is past confirmed
+func (h *OrderHandler) UpdateItem(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "order")
+	if !ok {
+		return
+	}
+	itemID, ok := uuidParam(w, r, "itemID", "item")
+	if !ok {
+		return
+	}
+
+	var req UpdateItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+	if !validateRequest(w, r, &req) {
+		return
+	}
+	expectedVersion, ok := expectedVersionOf(w, r, req.Version)
+	if !ok {
+		return
+	}
+
+	patch := service.ItemPatch{
+		ProductID: req.ProductID,
+		Name:      req.Name,
+		Quantity:  req.Quantity,
+		Price:     req.Price,
+	}
+	order, err := h.service.UpdateItem(r.Context(), id, itemID, patch, expectedVersion)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(MapOrderToResponse(order)); err != nil {
+		return
+	}
+}
+
+// RemoveItem handles DELETE /api/v1/orders/{id}/items/{itemID}
+// The expected version may be sent as an If-Match header.
+// Returns 200 with the order, or 409 once the order is past confirmed
+func (h *OrderHandler) RemoveItem(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "order")
+	if !ok {
+		return
+	}
+	itemID, ok := uuidParam(w, r, "itemID", "item")
+	if !ok {
+		return
+	}
+	expectedVersion, ok := expectedVersionOf(w, r, nil)
+	if !ok {
+		return
+	}
+
+	order, err := h.service.RemoveItem(r.Context(), id, itemID, expectedVersion)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(MapOrderToResponse(order)); err != nil {
+		return
+	}
+}
+
+// expectedVersionOf returns the version from the body, or else from the
+// If-Match header, writing 400 INVALID_IF_MATCH if that is not a version
+func expectedVersionOf(w http.ResponseWriter, r *http.Request, version *int) (*int, bool) {
+	if version != nil {
+		return version, true
+	}
+	v, ok := parseIfMatchVersion(r)
+	if !ok {
+		writeError(w, r, http.StatusBadRequest, "If-Match must be an order version", "INVALID_IF_MATCH")
+		return nil, false
+	}
+	return v, true
+}
+
+// UpdateAddresses handles PATCH /api/v1/orders/{id}/addresses
+// The expected version may be sent as "version" in the body or as an If-Match header.
+// Returns 200 with the order, or 409 once the order is past confirmed
+func (h *OrderHandler) UpdateAddresses(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "order")
+	if !ok {
+		return
+	}
+
+	var req UpdateAddressesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+	if !validateRequest(w, r, &req) {
+		return
+	}
+	if req.ShippingAddress == nil && req.BillingAddress == nil {
+		problem.Write(w, r, http.StatusBadRequest, "request validation failed", "VALIDATION_FAILED", []FieldError{
+			{Field: "shipping_address", Code: "REQUIRED", Message: "is required unless billing_address is given"},
+		})
+		return
+	}
+
+	expectedVersion := req.Version
+	if expectedVersion == nil {
+		v, ok := parseIfMatchVersion(r)
+		if !ok {
+			writeError(w, r, http.StatusBadRequest, "If-Match must be an order version", "INVALID_IF_MATCH")
+			return
+		}
+		expectedVersion = v
+	}
+
+	order, err := h.service.UpdateAddresses(r.Context(), id, MapRequestToAddress(req.ShippingAddress), MapRequestToAddress(req.BillingAddress), expectedVersion)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(MapOrderToResponse(order)); err != nil {
+		return
+	}
+}
+
 // RegisterRoutes registers all order routes on the router
 // CONSTRAINT: All endpoints must use /api/v1 prefix (ADR-0002)
 func (h *OrderHandler) RegisterRoutes(r chi.Router) {
 	r.Route("/api/v1/orders", func(r chi.Router) {
 		r.Post("/", h.CreateOrder)
 		r.Get("/", h.ListOrders)
+		r.Post("/bulk", h.BulkCreateOrders)
+		r.Patch("/status", h.BulkUpdateOrderStatus)
 		r.Get("/{id}", h.GetOrder)
 		r.Put("/{id}", h.UpdateOrder)
+		r.Patch("/{id}", h.PatchOrder)
 		r.Delete("/{id}", h.DeleteOrder)
 		r.Patch("/{id}/status", h.UpdateOrderStatus)
+		r.Post("/{id}/restore", h.RestoreOrder)
+		r.Post("/{id}/hold", h.HoldOrder)
+		r.Post("/{id}/release", h.ReleaseOrder)
+		r.Post("/{id}/items", h.AddItem)
+		r.Patch("/{id}/items/status", h.UpdateItemsStatus)
+		r.Patch("/{id}/items/{itemID}", h.UpdateItem)
+		r.Delete("/{id}/items/{itemID}", h.RemoveItem)
+		r.Patch("/{id}/items/{itemID}/status", h.UpdateItemStatus)
+		r.Patch("/{id}/addresses", h.UpdateAddresses)
 	})
 }
 
@@ -278,31 +920,70 @@ func parseIntParam(r *http.Request, name string, defaultVal int) int {
 	return val
 }
 
-func writeError(w http.ResponseWriter, status int, message, code string) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(status)
-	resp := ErrorResponse{
-		Error: message,
-		Code:  code,
+// parseIfMatchVersion reads an order version from If-Match, accepting 3, "3" or W/"3".
+// Returns nil, true when the header is absent.
+func parseIfMatchVersion(r *http.Request) (*int, bool) {
+	h := r.Header.Get("If-Match")
+	if h == "" {
+		return nil, true
+	}
+	h = strings.Trim(strings.TrimPrefix(h, "W/"), `"`)
+	v, err := strconv.Atoi(h)
+	if err != nil {
+		return nil, false
+	}
+	return &v, true
+}
+
+// writeError writes an ErrorResponse, or a problem document when the
+// client asks for application/problem+json
+func writeError(w http.ResponseWriter, r *http.Request, status int, message, code string) {
+	problem.Write(w, r, status, message, code, nil)
+}
+
+func handleServiceError(w http.ResponseWriter, r *http.Request, err error) {
+	status, resp := mapServiceError(err)
+	if status == http.StatusInternalServerError {
+		writeInternalError(w, r, err)
+		return
 	}
-	_ = json.NewEncoder(w).Encode(resp)
+	writeError(w, r, status, resp.Error, resp.Code)
 }
 
-func handleServiceError(w http.ResponseWriter, err error) {
-	switch {
-	case errors.Is(err, domain.ErrOrderNotFound):
-		writeError(w, http.StatusNotFound, "order not found", "ORDER_NOT_FOUND")
-	case errors.Is(err, domain.ErrInvalidTransition):
-		writeError(w, http.StatusBadRequest, "invalid status transition", "INVALID_TRANSITION")
-	case errors.Is(err, domain.ErrConcurrentModification):
-		writeError(w, http.StatusConflict, "order was modified by another process", "CONCURRENT_MODIFICATION")
-	case errors.Is(err, domain.ErrInvalidCustomerID):
-		writeError(w, http.StatusBadRequest, "invalid customer ID", "INVALID_CUSTOMER_ID")
-	case errors.Is(err, domain.ErrNoItems):
-		writeError(w, http.StatusBadRequest, "order must have at least one item", "NO_ITEMS")
-	case errors.Is(err, domain.ErrOrderAlreadyDeleted):
-		writeError(w, http.StatusNotFound, "order not found", "ORDER_NOT_FOUND")
-	default:
-		writeError(w, http.StatusInternalServerError, "internal server error", "INTERNAL_ERROR")
+// writeInternalError logs err with its stack under a new error ID and
+// answers 500 with that ID, keeping the cause itself out of the response.
+func writeInternalError(w http.ResponseWriter, r *http.Request, err error) {
+	errorID := problem.NewErrorID()
+	slog.ErrorContext(r.Context(), "request failed",
+		slog.String("error_id", errorID),
+		slog.String("method", r.Method),
+		slog.String("path", r.URL.Path),
+		slog.String("error", err.Error()),
+		slog.String("stack", string(debug.Stack())),
+	)
+	problem.WriteInternal(w, r, errorID)
+}
+
+// mapServiceError translates a service error into an HTTP status and error body
+func mapServiceError(err error) (int, ErrorResponse) {
+	e, _ := errcode.Lookup(err)
+	return e.HTTPStatus, ErrorResponse{Error: e.Message, Code: e.Code}
+}
+
+// attachNotes sets the notes of each order on its response, responses[i]
+// belonging to orders[i]. Without a note service it does nothing.
+func (h *OrderHandler) attachNotes(ctx context.Context, orders []*domain.Order, responses []OrderResponse) error {
+	if h.notes == nil || len(orders) == 0 {
+		return nil
+	}
+	notes, err := h.notes.NotesForOrders(ctx, orders)
+	if err != nil {
+		return err
+	}
+	for i, order := range orders {
+		if orderNotes := notes[order.ID]; len(orderNotes) > 0 {
+			responses[i].Notes = MapNotesToResponse(orderNotes)
+		}
 	}
+	return nil
 }
@@ -0,0 +1,126 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// listOrders serves target through ListOrders over a repository of total
+// orders, with the service capping pages at maxPageSize. It returns the
+// response and the options the repository was asked for.
+func listOrders(t *testing.T, target string, total int64, maxPageSize int) (ListOrdersResponse, repository.ListOptions) {
+	t.Helper()
+	var got repository.ListOptions
+	repo := &mocks.OrderRepositoryMock{
+		ListFunc: func(_ context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+			got = opts
+			n := max(min(int64(opts.Limit), total-int64(opts.Offset)), 0)
+			orders := make([]*domain.Order, n)
+			for i := range orders {
+				orders[i] = &domain.Order{ID: uuid.New(), Status: domain.OrderStatusPending}
+			}
+			return orders, total, nil
+		},
+	}
+	settings := service.DefaultSettings
+	settings.MaxPageSize = maxPageSize
+	settings.EstimateListTotals = false
+	h := NewOrderHandler(service.NewOrderService(repo, nil, nil, nil, nil, service.StaticConfig(settings)), nil, 0)
+
+	rec := httptest.NewRecorder()
+	h.ListOrders(rec, httptest.NewRequest(http.MethodGet, target, nil))
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp ListOrdersResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	return resp, got
+}
+
+func TestOrderHandler_ListOrders_CappedPageSize_KeepsOffset(t *testing.T) {
+	resp, opts := listOrders(t, "/api/v1/orders?limit=100&offset=100", 1000, 50)
+
+	assert.Equal(t, 50, opts.Limit, "the service caps the page size")
+	assert.Equal(t, 100, opts.Offset, "the requested offset is read, not the capped page it falls in")
+	assert.Equal(t, 50, resp.Limit)
+	assert.Equal(t, 100, resp.Offset)
+}
+
+func TestOrderHandler_ListOrders_Links(t *testing.T) {
+	tests := []struct {
+		name        string
+		target      string
+		total       int64
+		maxPageSize int
+		want        PaginationLinks
+	}{
+		{
+			name:        "capped page size",
+			target:      "/api/v1/orders?limit=100&offset=100",
+			total:       1000,
+			maxPageSize: 50,
+			want: PaginationLinks{
+				First: "/api/v1/orders?limit=50&offset=0",
+				Prev:  "/api/v1/orders?limit=50&offset=50",
+				Next:  "/api/v1/orders?limit=50&offset=150",
+				Last:  "/api/v1/orders?limit=50&offset=950",
+			},
+		},
+		{
+			name:        "offset between pages",
+			target:      "/api/v1/orders?limit=20&offset=30",
+			total:       95,
+			maxPageSize: 100,
+			want: PaginationLinks{
+				First: "/api/v1/orders?limit=20&offset=0",
+				Prev:  "/api/v1/orders?limit=20&offset=10",
+				Next:  "/api/v1/orders?limit=20&offset=50",
+				Last:  "/api/v1/orders?limit=20&offset=90",
+			},
+		},
+		{
+			name:        "offset within the first page",
+			target:      "/api/v1/orders?limit=20&offset=5",
+			total:       3,
+			maxPageSize: 100,
+			want: PaginationLinks{
+				First: "/api/v1/orders?limit=20&offset=0",
+				Prev:  "/api/v1/orders?limit=20&offset=0",
+				Last:  "/api/v1/orders?limit=20&offset=0",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, opts := listOrders(t, tt.target, tt.total, tt.maxPageSize)
+
+			assert.Equal(t, tt.want, resp.Links)
+			assert.Equal(t, opts.Offset, resp.Offset, "links and offset describe the page read")
+		})
+	}
+}
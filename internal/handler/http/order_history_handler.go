@@ -0,0 +1,105 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+)
+
+// OrderHistoryHandler handles requests for an order's audit trail
+type OrderHistoryHandler struct {
+	service service.OrderHistoryService
+}
+
+// NewOrderHistoryHandler creates a new order history handler
+func NewOrderHistoryHandler(svc service.OrderHistoryService) *OrderHistoryHandler {
+	return &OrderHistoryHandler{
+		service: svc,
+	}
+}
+
+// GetOrderHistory handles GET /api/v1/orders/{id}/history
+func (h *OrderHistoryHandler) GetOrderHistory(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "order")
+	if !ok {
+		return
+	}
+
+	limit := parseIntParam(r, "limit", defaultLimit)
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	if limit < 1 {
+		limit = defaultLimit
+	}
+
+	offset := parseIntParam(r, "offset", 0)
+	if offset < 0 {
+		offset = 0
+	}
+
+	result, err := h.service.GetOrderHistory(r.Context(), id, limit, offset)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response := ListOrderHistoryResponse{
+		Entries: MapOrderHistoryToResponse(result.Data),
+		Total:   result.Total,
+		Limit:   limit,
+		Offset:  offset,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		return
+	}
+}
+
+// DiffOrderVersions handles GET /api/v1/orders/{id}/diff
+func (h *OrderHistoryHandler) DiffOrderVersions(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "order")
+	if !ok {
+		return
+	}
+
+	// A missing or malformed version reads as 0, which the service rejects
+	fromVersion := parseIntParam(r, "from_version", 0)
+	toVersion := parseIntParam(r, "to_version", 0)
+
+	diff, err := h.service.DiffOrderVersions(r.Context(), id, fromVersion, toVersion)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(MapOrderDiffToResponse(diff)); err != nil {
+		return
+	}
+}
+
+// RegisterRoutes registers order history routes on the router
+func (h *OrderHistoryHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/api/v1/orders/{id}/history", h.GetOrderHistory)
+	r.Get("/api/v1/orders/{id}/diff", h.DiffOrderVersions)
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+)
+
+// OrderNoteHandler handles requests for notes on an order
+type OrderNoteHandler struct {
+	service service.OrderNoteService
+}
+
+// NewOrderNoteHandler creates a new order note handler
+func NewOrderNoteHandler(svc service.OrderNoteService) *OrderNoteHandler {
+	return &OrderNoteHandler{
+		service: svc,
+	}
+}
+
+// AddNote handles POST /api/v1/orders/{id}/notes
+// Returns 201 with the note; customer tokens may not add internal notes (403)
+func (h *OrderNoteHandler) AddNote(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "order")
+	if !ok {
+		return
+	}
+
+	var req AddNoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+	if !validateRequest(w, r, &req) {
+		return
+	}
+
+	note, err := h.service.AddNote(r.Context(), id, req.Body, domain.NoteVisibility(req.Visibility))
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(MapNoteToResponse(note)); err != nil {
+		return
+	}
+}
+
+// ListNotes handles GET /api/v1/orders/{id}/notes
+// Customer tokens only see customer-visible notes.
+func (h *OrderNoteHandler) ListNotes(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "order")
+	if !ok {
+		return
+	}
+
+	limit := parseIntParam(r, "limit", defaultLimit)
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	if limit < 1 {
+		limit = defaultLimit
+	}
+
+	offset := parseIntParam(r, "offset", 0)
+	if offset < 0 {
+		offset = 0
+	}
+
+	result, err := h.service.ListNotes(r.Context(), id, limit, offset)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response := ListNotesResponse{
+		Notes:  MapNotesToResponse(result.Data),
+		Total:  result.Total,
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		return
+	}
+}
+
+// RegisterRoutes registers order note routes on the router
+func (h *OrderNoteHandler) RegisterRoutes(r chi.Router) {
+	r.Post("/api/v1/orders/{id}/notes", h.AddNote)
+	r.Get("/api/v1/orders/{id}/notes", h.ListNotes)
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+)
+
+// OrderSearchHandler handles free-text order search requests
+type OrderSearchHandler struct {
+	service service.OrderSearchService
+}
+
+// NewOrderSearchHandler creates a new order search handler
+func NewOrderSearchHandler(svc service.OrderSearchService) *OrderSearchHandler {
+	return &OrderSearchHandler{
+		service: svc,
+	}
+}
+
+// SearchOrders handles GET /api/v1/orders/search?q=
+// Results are ordered by relevance, then newest first.
+func (h *OrderSearchHandler) SearchOrders(w http.ResponseWriter, r *http.Request) {
+	limit := parseIntParam(r, "limit", defaultLimit)
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	if limit < 1 {
+		limit = defaultLimit
+	}
+
+	offset := parseIntParam(r, "offset", 0)
+	if offset < 0 {
+		offset = 0
+	}
+
+	req := service.SearchOrdersRequest{
+		Query:    r.URL.Query().Get("q"),
+		PageSize: limit,
+		Offset:   offset,
+	}
+
+	if statusStr := r.URL.Query().Get("status"); statusStr != "" {
+		s := domain.OrderStatus(statusStr)
+		req.Status = &s
+	}
+	var ok bool
+	if req.CustomerID, ok = customerIDQuery(w, r); !ok {
+		return
+	}
+	if pid := r.URL.Query().Get("product_id"); pid != "" {
+		req.ProductID = &pid
+	}
+
+	if req.MinTotal, ok = parseFloatParam(r, "min_total"); !ok {
+		writeError(w, r, http.StatusBadRequest, "min_total must be a number", "INVALID_TOTAL")
+		return
+	}
+	if req.MaxTotal, ok = parseFloatParam(r, "max_total"); !ok {
+		writeError(w, r, http.StatusBadRequest, "max_total must be a number", "INVALID_TOTAL")
+		return
+	}
+	fields, ok := fieldsQuery(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.service.SearchOrders(r.Context(), req)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	orders := MapOrdersToResponse(result.Data)
+	SelectOrderFields(orders, fields)
+
+	response := ListOrdersResponse{
+		Orders: orders,
+		Total:  result.TotalCount,
+		Limit:  result.PageSize, // the service may cap the requested limit
+		Offset: result.Offset,
+		Links:  pageLinks(r, result.PageSize, result.Offset, len(result.Data), result.TotalCount, false),
+	}
+	setLinkHeader(w, response.Links)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		return
+	}
+}
+
+// RegisterRoutes registers order search routes
+func (h *OrderSearchHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/api/v1/orders/search", h.SearchOrders)
+}
+
+// parseFloatParam reads an optional number. Returns nil, true when the parameter is absent.
+func parseFloatParam(r *http.Request, name string) (*float64, bool) {
+	str := r.URL.Query().Get(name)
+	if str == "" {
+		return nil, true
+	}
+	val, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return nil, false
+	}
+	return &val, true
+}
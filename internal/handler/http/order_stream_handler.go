@@ -0,0 +1,234 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+	"golang.org/x/net/websocket"
+)
+
+// streamWriteTimeout bounds each message written to a WebSocket client, so
+// a client that stopped reading is disconnected instead of blocking
+const streamWriteTimeout = 10 * time.Second
+
+// OrderStreamHandler streams order events to WebSocket clients
+type OrderStreamHandler struct {
+	events    *messaging.Broker
+	heartbeat time.Duration
+}
+
+// NewOrderStreamHandler creates a new order stream handler fed by events,
+// which is shared with gRPC WatchOrders. A nil broker refuses every stream.
+// A heartbeat message is sent every heartbeat.
+func NewOrderStreamHandler(events *messaging.Broker, heartbeat time.Duration) *OrderStreamHandler {
+	return &OrderStreamHandler{
+		events:    events,
+		heartbeat: heartbeat,
+	}
+}
+
+// RegisterRoutes registers the WebSocket stream route on the router
+func (h *OrderStreamHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/ws/orders", h.StreamOrders)
+}
+
+// StreamOrders handles GET /ws/orders. The client sends a subscribe message
+// to start receiving events, and may send it again to change the filter.
+// Customer tokens only receive events of their own orders, and every caller
+// only those of its own tenant.
+func (h *OrderStreamHandler) StreamOrders(w http.ResponseWriter, r *http.Request) {
+	if h.events == nil {
+		writeError(w, r, http.StatusServiceUnavailable, "order event stream is not configured", "STREAM_UNAVAILABLE")
+		return
+	}
+
+	scope := streamScope{tenantID: domain.TenantID(r.Context())}
+	if p, ok := domain.PrincipalFromContext(r.Context()); ok && p.Role != domain.RoleService {
+		scope.customerID = p.CustomerID
+	}
+
+	srv := websocket.Server{
+		// Callers authenticate with a bearer token rather than cookies, so
+		// the origin of the page opening the socket grants nothing
+		Handshake: func(*websocket.Config, *http.Request) error { return nil },
+		Handler: func(ws *websocket.Conn) {
+			h.stream(r.Context(), ws, scope)
+		},
+	}
+	srv.ServeHTTP(w, r)
+}
+
+// streamScope is what a connection may see whatever it subscribes to: the
+// tenant of the request, and the customer of a customer token. An empty
+// field does not limit the stream.
+type streamScope struct {
+	tenantID   string
+	customerID string
+}
+
+// orderStreamFilter selects the events a subscribed client receives. An
+// empty field matches everything.
+type orderStreamFilter struct {
+	tenantID   string
+	customerID string
+	statuses   []string
+}
+
+func (f *orderStreamFilter) matches(evt messaging.OrderEvent) bool {
+	// Customer-scoped events such as customer.data_erased are not order changes
+	if evt.OrderID == "" {
+		return false
+	}
+	if f.tenantID != "" && evt.TenantID != f.tenantID {
+		return false
+	}
+	if f.customerID != "" && evt.CustomerID != f.customerID {
+		return false
+	}
+	return len(f.statuses) == 0 || slices.Contains(f.statuses, evt.Status)
+}
+
+// stream serves one connection until the client leaves, a write fails or
+// the broker ends the subscription. scope limits the events it may receive.
+func (h *OrderStreamHandler) stream(ctx context.Context, ws *websocket.Conn, scope streamScope) {
+	defer func() { _ = ws.Close() }()
+
+	// The server's read and write timeouts would cut a long-lived stream;
+	// each write gets its own deadline instead
+	_ = ws.SetDeadline(time.Time{})
+
+	sub := h.events.Subscribe()
+	defer sub.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+	requests := make(chan []byte)
+	go func() {
+		defer close(requests)
+		for {
+			var data []byte
+			if err := websocket.Message.Receive(ws, &data); err != nil {
+				return
+			}
+			select {
+			case requests <- data:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+
+	var filter *orderStreamFilter
+	for {
+		var msg OrderStreamMessage
+		select {
+		case <-ctx.Done():
+			return
+		case data, ok := <-requests:
+			if !ok {
+				return // client closed the connection
+			}
+			msg = handleStreamRequest(data, scope, &filter)
+		case evt, ok := <-sub.Events():
+			if !ok {
+				if errors.Is(sub.Err(), messaging.ErrSubscriberTooSlow) {
+					msg = OrderStreamMessage{Type: "error", Error: "stream fell behind the event feed; reconnect to resume", Code: "STREAM_LAGGING"}
+				} else {
+					msg = OrderStreamMessage{Type: "error", Error: "event stream stopped", Code: "STREAM_UNAVAILABLE"}
+				}
+				_ = sendStreamMessage(ws, msg)
+				return
+			}
+			if filter == nil || !filter.matches(evt) {
+				continue
+			}
+			resp := MapOrderEventToResponse(evt)
+			msg = OrderStreamMessage{Type: "event", Event: &resp}
+		case now := <-heartbeat.C:
+			msg = OrderStreamMessage{Type: "heartbeat", Time: &now}
+		}
+
+		if err := sendStreamMessage(ws, msg); err != nil {
+			return
+		}
+	}
+}
+
+// handleStreamRequest applies a client message to filter and returns the reply
+func handleStreamRequest(data []byte, scope streamScope, filter **orderStreamFilter) OrderStreamMessage {
+	var req OrderStreamRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return OrderStreamMessage{Type: "error", Error: "invalid message", Code: "INVALID_REQUEST"}
+	}
+
+	switch req.Type {
+	case "subscribe":
+		f, err := newOrderStreamFilter(req, scope)
+		if err != nil {
+			_, resp := mapServiceError(err)
+			return OrderStreamMessage{Type: "error", Error: resp.Error, Code: resp.Code}
+		}
+		*filter = f
+		return OrderStreamMessage{Type: "subscribed", CustomerID: f.customerID, Statuses: f.statuses}
+	case "unsubscribe":
+		*filter = nil
+		return OrderStreamMessage{Type: "unsubscribed"}
+	case "ping":
+		now := time.Now()
+		return OrderStreamMessage{Type: "pong", Time: &now}
+	default:
+		return OrderStreamMessage{Type: "error", Error: "type must be subscribe, unsubscribe or ping", Code: "INVALID_REQUEST"}
+	}
+}
+
+// newOrderStreamFilter builds the filter of a subscribe message within
+// scope. Customer tokens default to their own customer and may not name
+// another.
+func newOrderStreamFilter(req OrderStreamRequest, scope streamScope) (*orderStreamFilter, error) {
+	for _, s := range req.Statuses {
+		if !domain.OrderStatus(s).IsValid() {
+			return nil, domain.ErrInvalidStatus
+		}
+	}
+	customerID := req.CustomerID
+	if scope.customerID != "" {
+		if customerID == "" {
+			customerID = scope.customerID
+		}
+		if customerID != scope.customerID {
+			return nil, domain.ErrAccessDenied
+		}
+	}
+	return &orderStreamFilter{tenantID: scope.tenantID, customerID: customerID, statuses: req.Statuses}, nil
+}
+
+func sendStreamMessage(ws *websocket.Conn, msg OrderStreamMessage) error {
+	if err := ws.SetWriteDeadline(time.Now().Add(streamWriteTimeout)); err != nil {
+		return err
+	}
+	return websocket.JSON.Send(ws, msg)
+}
@@ -0,0 +1,218 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+// chanSource is a messaging.EventSource fed through a channel
+type chanSource chan messaging.OrderEvent
+
+func (s chanSource) ReadEvent(ctx context.Context) (messaging.OrderEvent, error) {
+	select {
+	case evt := <-s:
+		return evt, nil
+	case <-ctx.Done():
+		return messaging.OrderEvent{}, ctx.Err()
+	}
+}
+
+func (s chanSource) Close() error { return nil }
+
+// streamTest is a /ws/orders server over a broker fed by events
+type streamTest struct {
+	events chanSource
+	broker *messaging.Broker
+	ws     *websocket.Conn
+}
+
+// openStream serves /ws/orders to a caller with ctxFn applied to its request
+// context, and connects to it. The broker buffers buffer events per client.
+func openStream(t *testing.T, heartbeat time.Duration, buffer int, ctxFn func(context.Context) context.Context) *streamTest {
+	t.Helper()
+	st := &streamTest{events: make(chanSource, 1024)}
+	st.broker = messaging.NewBroker(st.events, buffer)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go st.broker.Run(ctx)
+
+	h := NewOrderStreamHandler(st.broker, heartbeat)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ctxFn != nil {
+			r = r.WithContext(ctxFn(r.Context()))
+		}
+		h.StreamOrders(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	ws, err := websocket.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/ws/orders", "", srv.URL)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ws.Close() })
+	st.ws = ws
+
+	// Events published before the handler subscribes are not delivered
+	require.Eventually(t, func() bool { return st.broker.Subscribers() == 1 }, 5*time.Second, time.Millisecond)
+	return st
+}
+
+func (st *streamTest) send(t *testing.T, req OrderStreamRequest) {
+	t.Helper()
+	require.NoError(t, websocket.JSON.Send(st.ws, req))
+}
+
+func (st *streamTest) receive(t *testing.T) OrderStreamMessage {
+	t.Helper()
+	require.NoError(t, st.ws.SetReadDeadline(time.Now().Add(5*time.Second)))
+	var msg OrderStreamMessage
+	require.NoError(t, websocket.JSON.Receive(st.ws, &msg))
+	return msg
+}
+
+// publish feeds an order event for customerID in status to the broker
+func (st *streamTest) publish(orderID, customerID, status string) {
+	st.events <- messaging.OrderEvent{EventType: messaging.EventOrderStatusChanged, OrderID: orderID, CustomerID: customerID, Status: status}
+}
+
+// asCustomer runs the stream as a customer token of customerID
+func asCustomer(customerID string) func(context.Context) context.Context {
+	return func(ctx context.Context) context.Context {
+		return domain.WithPrincipal(ctx, &domain.Principal{Subject: customerID, Role: domain.RoleCustomer, CustomerID: customerID})
+	}
+}
+
+func TestOrderStreamHandler_SubscribeAndUnsubscribe(t *testing.T) {
+	st := openStream(t, time.Hour, 16, nil)
+
+	st.publish("o-0", "c-1", "pending")
+	st.send(t, OrderStreamRequest{Type: "subscribe"})
+	assert.Equal(t, "subscribed", st.receive(t).Type)
+
+	st.publish("o-1", "c-1", "pending")
+	msg := st.receive(t)
+	require.Equal(t, "event", msg.Type, "events before the subscription are not sent")
+	assert.Equal(t, "o-1", msg.Event.OrderID)
+
+	st.send(t, OrderStreamRequest{Type: "unsubscribe"})
+	assert.Equal(t, "unsubscribed", st.receive(t).Type)
+	st.publish("o-2", "c-1", "pending")
+	st.send(t, OrderStreamRequest{Type: "ping"})
+	assert.Equal(t, "pong", st.receive(t).Type, "no events after unsubscribing")
+}
+
+func TestOrderStreamHandler_CustomerScope(t *testing.T) {
+	st := openStream(t, time.Hour, 16, asCustomer("c-1"))
+
+	st.send(t, OrderStreamRequest{Type: "subscribe", CustomerID: "c-2"})
+	msg := st.receive(t)
+	assert.Equal(t, "error", msg.Type)
+	assert.Equal(t, "ORDER_ACCESS_DENIED", msg.Code)
+
+	st.send(t, OrderStreamRequest{Type: "subscribe"})
+	msg = st.receive(t)
+	assert.Equal(t, "subscribed", msg.Type)
+	assert.Equal(t, "c-1", msg.CustomerID, "customer tokens default to their own orders")
+
+	st.publish("o-1", "c-2", "pending")
+	st.publish("o-2", "c-1", "pending")
+	msg = st.receive(t)
+	require.Equal(t, "event", msg.Type)
+	assert.Equal(t, "o-2", msg.Event.OrderID)
+}
+
+func TestOrderStreamHandler_OtherTenants_NotSent(t *testing.T) {
+	st := openStream(t, time.Hour, 16, func(ctx context.Context) context.Context {
+		return domain.WithTenant(ctx, "acme")
+	})
+	st.send(t, OrderStreamRequest{Type: "subscribe"})
+	require.Equal(t, "subscribed", st.receive(t).Type)
+
+	st.events <- messaging.OrderEvent{EventType: messaging.EventOrderCreated, OrderID: "o-1", CustomerID: "c-1", TenantID: "globex"}
+	st.events <- messaging.OrderEvent{EventType: messaging.EventOrderCreated, OrderID: "o-2", CustomerID: "c-1", TenantID: "acme"}
+	msg := st.receive(t)
+
+	require.Equal(t, "event", msg.Type)
+	assert.Equal(t, "o-2", msg.Event.OrderID)
+}
+
+func TestOrderStreamHandler_StatusFilter(t *testing.T) {
+	st := openStream(t, time.Hour, 16, nil)
+
+	st.send(t, OrderStreamRequest{Type: "subscribe", Statuses: []string{"on_fire"}})
+	msg := st.receive(t)
+	assert.Equal(t, "error", msg.Type)
+	assert.Equal(t, "INVALID_STATUS", msg.Code)
+
+	st.send(t, OrderStreamRequest{Type: "subscribe", Statuses: []string{"shipped"}})
+	assert.Equal(t, []string{"shipped"}, st.receive(t).Statuses)
+
+	st.publish("o-1", "c-1", "pending")
+	st.publish("o-2", "c-1", "shipped")
+	msg = st.receive(t)
+	require.Equal(t, "event", msg.Type)
+	assert.Equal(t, "o-2", msg.Event.OrderID)
+}
+
+func TestOrderStreamHandler_Heartbeat(t *testing.T) {
+	st := openStream(t, 10*time.Millisecond, 16, nil)
+
+	msg := st.receive(t)
+
+	assert.Equal(t, "heartbeat", msg.Type, "heartbeats are sent without a subscription")
+	assert.NotNil(t, msg.Time)
+}
+
+func TestOrderStreamHandler_SlowClient_ClosedWithStreamLagging(t *testing.T) {
+	st := openStream(t, time.Hour, 1, nil)
+	st.send(t, OrderStreamRequest{Type: "subscribe"})
+	require.Equal(t, "subscribed", st.receive(t).Type)
+
+	// Events arrive faster than the handler writes them to the socket, so
+	// its one-event buffer overflows
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case st.events <- messaging.OrderEvent{EventType: messaging.EventOrderCreated, OrderID: "o-1", Status: "pending"}:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	for {
+		msg := st.receive(t)
+		if msg.Type == "event" {
+			continue
+		}
+		assert.Equal(t, "error", msg.Type)
+		assert.Equal(t, "STREAM_LAGGING", msg.Code)
+		break
+	}
+	var msg OrderStreamMessage
+	assert.Error(t, websocket.JSON.Receive(st.ws, &msg), "the stream is closed")
+}
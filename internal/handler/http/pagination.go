@@ -0,0 +1,70 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional package name matching handler layer
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// pageLinks builds the links of a limit/offset page holding count of total
+// items. limit and offset must be the ones the page was read with, which the
+// service may have capped. The URLs keep the request's path and filters.
+// When total is an estimate there is no last link, and next is given
+// whenever the page is full.
+func pageLinks(r *http.Request, limit, offset, count int, total int64, estimated bool) PaginationLinks {
+	pageURL := func(offset int) string {
+		q := r.URL.Query()
+		q.Set("limit", strconv.Itoa(limit))
+		q.Set("offset", strconv.Itoa(offset))
+		return (&url.URL{Path: r.URL.Path, RawQuery: q.Encode()}).String()
+	}
+
+	links := PaginationLinks{First: pageURL(0)}
+	if offset > 0 {
+		links.Prev = pageURL(max(offset-limit, 0))
+	}
+	if estimated {
+		if count == limit {
+			links.Next = pageURL(offset + limit)
+		}
+		return links
+	}
+	if int64(offset+limit) < total {
+		links.Next = pageURL(offset + limit)
+	}
+	// next steps by limit from offset, so last is on the same grid even when
+	// offset is not a multiple of limit
+	links.Last = pageURL(0)
+	if phase := int64(offset % limit); total > phase {
+		links.Last = pageURL(int(phase + (total-1-phase)/int64(limit)*int64(limit)))
+	}
+	return links
+}
+
+// setLinkHeader writes page links as an RFC 8288 Link header
+func setLinkHeader(w http.ResponseWriter, links PaginationLinks) {
+	var parts []string
+	for _, link := range []struct{ rel, url string }{
+		{"first", links.First}, {"prev", links.Prev}, {"next", links.Next}, {"last", links.Last},
+	} {
+		if link.url != "" {
+			parts = append(parts, "<"+link.url+`>; rel="`+link.rel+`"`)
+		}
+	}
+	w.Header().Set("Link", strings.Join(parts, ", "))
+}
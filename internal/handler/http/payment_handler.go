@@ -0,0 +1,116 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+)
+
+// maxWebhookBody caps the size of a payment webhook body
+const maxWebhookBody = 1 << 20
+
+// PaymentHandler handles HTTP requests to pay orders
+type PaymentHandler struct {
+	service service.PaymentService
+}
+
+// NewPaymentHandler creates a new payment handler
+func NewPaymentHandler(svc service.PaymentService) *PaymentHandler {
+	return &PaymentHandler{
+		service: svc,
+	}
+}
+
+// PayOrder handles POST /api/v1/orders/{id}/payment
+// Returns 200 with the payment whatever its outcome, 404 for missing orders,
+// 409 if the order cannot be paid and 503 if the provider is unavailable
+func (h *PaymentHandler) PayOrder(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "order")
+	if !ok {
+		return
+	}
+
+	var req PayOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+	if !validateRequest(w, r, &req) {
+		return
+	}
+
+	result, err := h.service.PayOrder(r.Context(), id, req.PaymentMethod)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(MapPaymentToResponse(result.Payment, result.Order)); err != nil {
+		return
+	}
+}
+
+// RegisterRoutes registers payment routes on the router
+func (h *PaymentHandler) RegisterRoutes(r chi.Router) {
+	r.Post("/api/v1/orders/{id}/payment", h.PayOrder)
+}
+
+// PaymentWebhookHandler receives the payment provider's webhooks. The
+// provider's signature authenticates them, so it is mounted outside the
+// authenticated routes.
+type PaymentWebhookHandler struct {
+	service service.PaymentService
+}
+
+// NewPaymentWebhookHandler creates a new payment webhook handler
+func NewPaymentWebhookHandler(svc service.PaymentService) *PaymentWebhookHandler {
+	return &PaymentWebhookHandler{
+		service: svc,
+	}
+}
+
+// ReceiveWebhook handles POST /api/v1/payments/webhook
+// Returns 204 once the webhook is applied or ignored, 400 if it is not
+// signed by the provider and 503 without a provider
+func (h *PaymentWebhookHandler) ReceiveWebhook(w http.ResponseWriter, r *http.Request) {
+	// The signature covers the exact bytes sent, so the body is kept raw
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBody))
+	if err != nil {
+		writeError(w, r, http.StatusRequestEntityTooLarge, "request body too large", "BODY_TOO_LARGE")
+		return
+	}
+
+	signature := ""
+	if header := h.service.WebhookSignatureHeader(); header != "" {
+		signature = r.Header.Get(header)
+	}
+	if err := h.service.HandleWebhook(r.Context(), payload, signature); err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RegisterRoutes registers the payment webhook route on the router
+func (h *PaymentWebhookHandler) RegisterRoutes(r chi.Router) {
+	r.Post("/api/v1/payments/webhook", h.ReceiveWebhook)
+}
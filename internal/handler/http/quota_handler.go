@@ -0,0 +1,83 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+)
+
+// QuotaHandler reports the request quotas of callers to operators
+type QuotaHandler struct {
+	service service.QuotaService
+}
+
+// NewQuotaHandler creates a new quota handler
+func NewQuotaHandler(svc service.QuotaService) *QuotaHandler {
+	return &QuotaHandler{
+		service: svc,
+	}
+}
+
+// GetUsage handles GET /api/v1/admin/quotas?ip=10.0.0.1, or
+// ?tenant=acme&customer_id=... for the quota of a tenant or customer
+func (h *QuotaHandler) GetUsage(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	subject := service.QuotaSubject{
+		ClientIP:   q.Get("ip"),
+		Tenant:     q.Get("tenant"),
+		CustomerID: q.Get("customer_id"),
+	}
+	hasCaller := subject.Tenant != "" || subject.CustomerID != ""
+	if (subject.ClientIP == "") == !hasCaller {
+		writeError(w, r, http.StatusBadRequest, "either ip, or tenant and customer_id, is required", "INVALID_REQUEST")
+		return
+	}
+
+	usage, err := h.service.Usage(r.Context(), subject)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(mapQuotaUsage(usage)); err != nil {
+		return
+	}
+}
+
+// mapQuotaUsage converts quota usage to a response DTO
+func mapQuotaUsage(usage *service.QuotaUsage) QuotaUsageResponse {
+	resp := QuotaUsageResponse{
+		Subject:                usage.Subject,
+		RequestsPerMinute:      usage.RequestsPerMinute,
+		WriteRequestsPerMinute: usage.WriteRequestsPerMinute,
+		Burst:                  usage.Burst,
+		Buckets:                make([]QuotaBucketResponse, len(usage.Buckets)),
+	}
+	for i, b := range usage.Buckets {
+		resp.Buckets[i] = QuotaBucketResponse{Name: b.Name, Used: b.Used, Limit: b.Limit, WindowSeconds: int(b.Window.Seconds())}
+	}
+	return resp
+}
+
+// RegisterRoutes registers quota routes on the admin route group
+func (h *QuotaHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/quotas", h.GetUsage)
+}
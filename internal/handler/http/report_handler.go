@@ -0,0 +1,96 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+)
+
+// ReportHandler handles HTTP requests for order reports
+type ReportHandler struct {
+	service service.ReportService
+}
+
+// NewReportHandler creates a new report handler
+func NewReportHandler(svc service.ReportService) *ReportHandler {
+	return &ReportHandler{
+		service: svc,
+	}
+}
+
+// GetOrderReport handles GET /api/v1/reports/orders
+func (h *ReportHandler) GetOrderReport(w http.ResponseWriter, r *http.Request) {
+	query := service.ReportQuery{
+		GroupBy: domain.ReportGroupBy(r.URL.Query().Get("group_by")),
+		Limit:   parseIntParam(r, "limit", defaultLimit),
+	}
+	if query.GroupBy == "" {
+		query.GroupBy = domain.ReportGroupByDay
+	}
+
+	var ok bool
+	if query.From, ok = parseTimeParam(r, "from"); !ok {
+		writeError(w, r, http.StatusBadRequest, "from must be an RFC 3339 timestamp or a YYYY-MM-DD date", "INVALID_DATE")
+		return
+	}
+	if query.To, ok = parseTimeParam(r, "to"); !ok {
+		writeError(w, r, http.StatusBadRequest, "to must be an RFC 3339 timestamp or a YYYY-MM-DD date", "INVALID_DATE")
+		return
+	}
+
+	if statusStr := r.URL.Query().Get("status"); statusStr != "" {
+		s := domain.OrderStatus(statusStr)
+		query.Status = &s
+	}
+
+	report, err := h.service.GetOrderReport(r.Context(), query)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(MapOrderReportToResponse(report)); err != nil {
+		return
+	}
+}
+
+// RegisterRoutes registers report routes
+func (h *ReportHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/api/v1/reports/orders", h.GetOrderReport)
+}
+
+// parseTimeParam reads an RFC 3339 timestamp or a YYYY-MM-DD date (midnight UTC).
+// Returns nil, true when the parameter is absent.
+func parseTimeParam(r *http.Request, name string) (*time.Time, bool) {
+	str := r.URL.Query().Get(name)
+	if str == "" {
+		return nil, true
+	}
+	if t, err := time.Parse(time.RFC3339, str); err == nil {
+		return &t, true
+	}
+	if t, err := time.Parse(time.DateOnly, str); err == nil {
+		return &t, true
+	}
+	return nil, false
+}
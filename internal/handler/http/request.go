@@ -14,26 +14,296 @@
 
 package http //nolint:revive // intentional package name matching handler layer
 
+import (
+	"encoding/json"
+	"time"
+)
+
 // CreateOrderRequest represents the request to create an order
 type CreateOrderRequest struct {
-	CustomerID string      `json:"customer_id"`
-	Items      []OrderItem `json:"items"`
+	CustomerID string      `json:"customer_id" validate:"required,uuid"`
+	Items      []OrderItem `json:"items" validate:"required,min=1,dive"`
+	// Metadata and Tags are checked against the domain limits by the service
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Tags     []string          `json:"tags,omitempty"`
+	// ShippingAddress and BillingAddress are optional
+	ShippingAddress *Address `json:"shipping_address,omitempty"`
+	BillingAddress  *Address `json:"billing_address,omitempty"`
+	// ShippingMethod defaults to the configured default method
+	ShippingMethod string `json:"shipping_method,omitempty" validate:"max=64"`
+}
+
+// Address represents a postal address in a request. The country code is
+// checked by the service.
+type Address struct {
+	Name       string `json:"name,omitempty" validate:"max=200"`
+	Line1      string `json:"line1" validate:"required,max=200"`
+	Line2      string `json:"line2,omitempty" validate:"max=200"`
+	City       string `json:"city" validate:"required,max=200"`
+	Region     string `json:"region,omitempty" validate:"max=200"`
+	PostalCode string `json:"postal_code,omitempty" validate:"max=20"`
+	Country    string `json:"country" validate:"required"`
 }
 
 // OrderItem represents an item in an order request
 type OrderItem struct {
-	ProductID string  `json:"product_id"`
-	Name      string  `json:"name"`
-	Quantity  int     `json:"quantity"`
-	Price     float64 `json:"price"`
+	ProductID string `json:"product_id" validate:"required,max=255"`
+	Name      string `json:"name" validate:"required,max=255"`
+	Quantity  int    `json:"quantity" validate:"gt=0"`
+	// Price may be omitted when the pricing service prices the product
+	Price float64 `json:"price,omitempty" validate:"omitempty,gt=0"`
 }
 
 // UpdateOrderRequest represents the request to update an order
 type UpdateOrderRequest struct {
-	Items []OrderItem `json:"items"`
+	Items []OrderItem `json:"items" validate:"required,min=1,dive"`
+	// Metadata and Tags are left unchanged when omitted and cleared when empty
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Tags     []string          `json:"tags,omitempty"`
+}
+
+// PatchOrderRequest is the body of PATCH /api/v1/orders/{id}, a JSON merge
+// patch (RFC 7386) of the order. Items are keyed by item ID, or by any other
+// key for an item to add; a null item removes it. Metadata entries are
+// merged and a null value removes one; a null metadata removes them all.
+// Tags, an array, are replaced whole.
+type PatchOrderRequest struct {
+	Items    map[string]*PatchOrderItem `json:"items,omitempty" validate:"dive"`
+	Metadata map[string]*string         `json:"metadata,omitempty"`
+	Tags     []string                   `json:"tags,omitempty"`
+	// Version is the order version the client last read; optional
+	Version *int `json:"version,omitempty" validate:"omitempty,gte=1"`
+
+	// clearMetadata and tagsSet record a null metadata and a present tags,
+	// which the fields above cannot tell from an absent member
+	clearMetadata bool
+	tagsSet       bool
+}
+
+// PatchOrderItem is one item of a PatchOrderRequest. An existing item takes
+// name and quantity only; a new one is given like an OrderItem.
+type PatchOrderItem struct {
+	ProductID *string  `json:"product_id,omitempty" validate:"omitempty,min=1,max=255"`
+	Name      *string  `json:"name,omitempty" validate:"omitempty,min=1,max=255"`
+	Quantity  *int     `json:"quantity,omitempty" validate:"omitempty,gt=0"`
+	Price     *float64 `json:"price,omitempty" validate:"omitempty,gt=0"`
+}
+
+// AddItemRequest represents the request to add one item to an order
+type AddItemRequest struct {
+	ProductID string `json:"product_id" validate:"required,max=255"`
+	Name      string `json:"name" validate:"required,max=255"`
+	Quantity  int    `json:"quantity" validate:"gt=0"`
+	// Price may be omitted when the pricing service prices the product
+	Price float64 `json:"price,omitempty" validate:"omitempty,gt=0"`
+	// Version is the order version the client last read; optional
+	Version *int `json:"version,omitempty" validate:"omitempty,gte=1"`
+}
+
+// UpdateItemRequest represents the request to change one item of an order.
+// ProductID and Price are refused by the service; they are accepted here so
+// that sending them fails instead of being ignored.
+type UpdateItemRequest struct {
+	ProductID *string  `json:"product_id,omitempty" validate:"omitempty,min=1,max=255"`
+	Name      *string  `json:"name,omitempty" validate:"omitempty,min=1,max=255"`
+	Quantity  *int     `json:"quantity,omitempty" validate:"omitempty,gt=0"`
+	Price     *float64 `json:"price,omitempty" validate:"omitempty,gt=0"`
+	// Version is the order version the client last read; optional
+	Version *int `json:"version,omitempty" validate:"omitempty,gte=1"`
+}
+
+// patchError rejects a merge patch member that cannot be applied
+type patchError struct {
+	message string
+}
+
+func (e *patchError) Error() string {
+	return e.message
+}
+
+// UnmarshalJSON decodes a merge patch, rejecting members other than items,
+// metadata, tags and version
+func (p *PatchOrderRequest) UnmarshalJSON(data []byte) error {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return err
+	}
+	for name, raw := range members {
+		null := string(raw) == "null"
+		var err error
+		switch name {
+		case "items":
+			if null {
+				return &patchError{message: "items cannot be removed; an order needs at least one item"}
+			}
+			err = json.Unmarshal(raw, &p.Items)
+		case "metadata":
+			p.clearMetadata = null
+			err = json.Unmarshal(raw, &p.Metadata)
+		case "tags":
+			p.tagsSet = true
+			err = json.Unmarshal(raw, &p.Tags)
+		case "version":
+			err = json.Unmarshal(raw, &p.Version)
+		default:
+			return &patchError{message: name + " cannot be patched; only items, metadata and tags can"}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // UpdateStatusRequest represents the request to update order status
 type UpdateStatusRequest struct {
-	Status string `json:"status"`
+	Status string `json:"status" validate:"required"`
+	// Version is the order version the client last read; optional
+	Version *int `json:"version,omitempty" validate:"omitempty,gte=1"`
+}
+
+// RestoreOrderRequest represents the optional body of a restore request
+type RestoreOrderRequest struct {
+	// Version is the deleted order's version the client last read; optional
+	Version *int `json:"version,omitempty" validate:"omitempty,gte=1"`
+}
+
+// HoldOrderRequest represents a request to put an order on hold
+type HoldOrderRequest struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+	// Version is the order version the client last read; optional
+	Version *int `json:"version,omitempty" validate:"omitempty,gte=1"`
+}
+
+// PayOrderRequest represents a request to pay an order's total
+type PayOrderRequest struct {
+	// PaymentMethod is the provider's token of the customer's card or account
+	PaymentMethod string `json:"payment_method" validate:"required,max=255"`
+}
+
+// AddNoteRequest represents a request to add a note to an order
+type AddNoteRequest struct {
+	Body string `json:"body" validate:"required,max=2000"`
+	// Visibility is customer or internal; optional
+	Visibility string `json:"visibility,omitempty"`
+}
+
+// ReleaseOrderRequest represents the optional body of a release request
+type ReleaseOrderRequest struct {
+	// Version is the order version the client last read; optional
+	Version *int `json:"version,omitempty" validate:"omitempty,gte=1"`
+}
+
+// ForceStatusRequest represents an admin request to set an order's status
+type ForceStatusRequest struct {
+	Status string `json:"status" validate:"required"`
+}
+
+// PurgeOrdersRequest represents an admin request to hard-delete soft-deleted orders
+type PurgeOrdersRequest struct {
+	// OlderThan is a Go duration, e.g. "720h"; orders deleted longer ago are purged
+	OlderThan string `json:"older_than" validate:"required"`
+}
+
+// ReplayEventsRequest represents an admin request to re-send the events
+// recorded in order history
+type ReplayEventsRequest struct {
+	// OrderID limits the replay to one order; without it From is required
+	OrderID string     `json:"order_id,omitempty"`
+	From    *time.Time `json:"from,omitempty"`
+	To      *time.Time `json:"to,omitempty"`
+	// Target is broker or webhook
+	Target     string `json:"target" validate:"required"`
+	WebhookURL string `json:"webhook_url,omitempty" validate:"max=2048"`
+	// Limit caps the history entries read; defaults to 500, at most 5000
+	Limit int `json:"limit,omitempty" validate:"gte=0"`
+	// Cursor continues a replay from the next_cursor of an earlier response
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// LogLevelRequest represents an admin request to change the log level
+type LogLevelRequest struct {
+	Level string `json:"level" validate:"required"`
+}
+
+// BulkCreateOrdersRequest represents the request to create several orders
+type BulkCreateOrdersRequest struct {
+	// Orders are checked one by one by the service so that a bad order
+	// fails alone; only the batch size is checked up front. The max mirrors
+	// service.MaxBulkCreateOrders.
+	Orders []CreateOrderRequest `json:"orders" validate:"required,min=1,max=100"`
+}
+
+// UpdateItemStatusRequest represents a request to move one order item to a
+// fulfillment status
+type UpdateItemStatusRequest struct {
+	Status string `json:"status" validate:"required"`
+	// Version is the order version the client last read; optional
+	Version *int `json:"version,omitempty" validate:"omitempty,gte=1"`
+}
+
+// UpdateItemsStatusRequest represents a request to move several items of an
+// order, e.g. one consignment, to a fulfillment status
+type UpdateItemsStatusRequest struct {
+	ItemIDs []string `json:"item_ids" validate:"required,min=1,max=100,dive,uuid"`
+	Status  string   `json:"status" validate:"required"`
+	// Version is the order version the client last read; optional
+	Version *int `json:"version,omitempty" validate:"omitempty,gte=1"`
+}
+
+// UpdateAddressesRequest represents a request to change an order's
+// addresses. At least one address must be given; an omitted one is unchanged.
+type UpdateAddressesRequest struct {
+	ShippingAddress *Address `json:"shipping_address,omitempty"`
+	BillingAddress  *Address `json:"billing_address,omitempty"`
+	// Version is the order version the client last read; optional
+	Version *int `json:"version,omitempty" validate:"omitempty,gte=1"`
+}
+
+// BulkUpdateStatusRequest represents the request to transition several orders
+type BulkUpdateStatusRequest struct {
+	// The max mirrors service.MaxBulkStatusOrders
+	OrderIDs []string `json:"order_ids" validate:"required,min=1,max=100,dive,required"`
+	Status   string   `json:"status" validate:"required"`
+}
+
+// CreateSubscriptionRequest represents the request to register a recurring order
+type CreateSubscriptionRequest struct {
+	CustomerID string      `json:"customer_id" validate:"required,uuid"`
+	Items      []OrderItem `json:"items" validate:"required,min=1,dive"`
+	// Cadence is daily, weekly, biweekly or monthly
+	Cadence string `json:"cadence" validate:"required"`
+	// FirstRunAt defaults to now, placing the first order on the next scheduler pass
+	FirstRunAt *time.Time `json:"first_run_at,omitempty"`
+	// ShippingMethod defaults to the configured default method when each order is placed
+	ShippingMethod  string   `json:"shipping_method,omitempty" validate:"max=64"`
+	ShippingAddress *Address `json:"shipping_address,omitempty"`
+}
+
+// UpdateSubscriptionRequest represents the request to replace the order
+// template of a subscription
+type UpdateSubscriptionRequest struct {
+	Items           []OrderItem `json:"items" validate:"required,min=1,dive"`
+	Cadence         string      `json:"cadence" validate:"required"`
+	ShippingMethod  string      `json:"shipping_method,omitempty" validate:"max=64"`
+	ShippingAddress *Address    `json:"shipping_address,omitempty"`
+	// Version is the subscription version the client last read; optional
+	Version *int `json:"version,omitempty" validate:"omitempty,gte=1"`
+}
+
+// SubscriptionActionRequest represents the optional body of a pause, resume
+// or skip request
+type SubscriptionActionRequest struct {
+	// Version is the subscription version the client last read; optional
+	Version *int `json:"version,omitempty" validate:"omitempty,gte=1"`
+}
+
+// OrderStreamRequest is a message from a /ws/orders client. Type is
+// "subscribe", "unsubscribe" or "ping".
+type OrderStreamRequest struct {
+	Type string `json:"type"`
+	// CustomerID and Statuses filter a subscription; empty matches every
+	// order the caller may see
+	CustomerID string   `json:"customer_id,omitempty"`
+	Statuses   []string `json:"statuses,omitempty"`
 }
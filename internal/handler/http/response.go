@@ -14,11 +14,15 @@
 
 package http //nolint:revive // intentional: matches handler layer convention
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // OrderResponse represents an order in HTTP responses
 type OrderResponse struct {
 	ID         string              `json:"id"`
+	TenantID   string              `json:"tenant_id,omitempty"`
 	CustomerID string              `json:"customer_id"`
 	Items      []OrderItemResponse `json:"items"`
 	Status     string              `json:"status"`
@@ -26,6 +30,94 @@ type OrderResponse struct {
 	Version    int                 `json:"version"`
 	CreatedAt  time.Time           `json:"created_at"`
 	UpdatedAt  time.Time           `json:"updated_at"`
+	DeletedAt  *time.Time          `json:"deleted_at,omitempty"`
+	Hold       *HoldResponse       `json:"hold,omitempty"`
+	Metadata   map[string]string   `json:"metadata"`
+	Tags       []string            `json:"tags"`
+	// ShippingAddress and BillingAddress are omitted when not set
+	ShippingAddress *AddressResponse `json:"shipping_address,omitempty"`
+	BillingAddress  *AddressResponse `json:"billing_address,omitempty"`
+	// ShippingMethod is omitted for orders created before methods existed;
+	// EstimatedDeliveryAt is set on confirmation and SLABreachedAt once the
+	// order is flagged as overdue
+	ShippingMethod      string     `json:"shipping_method,omitempty"`
+	EstimatedDeliveryAt *time.Time `json:"estimated_delivery_at,omitempty"`
+	SLABreachedAt       *time.Time `json:"sla_breached_at,omitempty"`
+	// Notes is only set for ?include=notes and omitted when there are none
+	Notes []NoteResponse `json:"notes,omitempty"`
+
+	// fields, when set by SelectOrderFields, limits what MarshalJSON writes
+	fields []string
+}
+
+// AddressResponse represents a postal address of an order
+type AddressResponse struct {
+	Name       string `json:"name,omitempty"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country"`
+}
+
+// NoteResponse represents a note on an order
+type NoteResponse struct {
+	ID         string    `json:"id"`
+	OrderID    string    `json:"order_id"`
+	Author     string    `json:"author"`
+	Body       string    `json:"body"`
+	Visibility string    `json:"visibility"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ListNotesResponse represents a paginated list of order notes, oldest first
+type ListNotesResponse struct {
+	Notes  []NoteResponse `json:"notes"`
+	Total  int64          `json:"total"`
+	Limit  int            `json:"limit"`
+	Offset int            `json:"offset"`
+}
+
+// SubscriptionResponse represents a recurring order subscription
+type SubscriptionResponse struct {
+	ID         string                     `json:"id"`
+	CustomerID string                     `json:"customer_id"`
+	Items      []SubscriptionItemResponse `json:"items"`
+	Cadence    string                     `json:"cadence"`
+	Status     string                     `json:"status"`
+	NextRunAt  time.Time                  `json:"next_run_at"`
+	// ShippingMethod is omitted when orders use the default method
+	ShippingMethod  string           `json:"shipping_method,omitempty"`
+	ShippingAddress *AddressResponse `json:"shipping_address,omitempty"`
+	Version         int              `json:"version"`
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
+}
+
+// SubscriptionItemResponse represents an order line of a subscription template
+type SubscriptionItemResponse struct {
+	ProductID string  `json:"product_id"`
+	Name      string  `json:"name"`
+	Quantity  int     `json:"quantity"`
+	Price     float64 `json:"price"`
+	Subtotal  float64 `json:"subtotal"`
+}
+
+// ListSubscriptionsResponse represents a paginated list of subscriptions, oldest first
+type ListSubscriptionsResponse struct {
+	Subscriptions []SubscriptionResponse `json:"subscriptions"`
+	Total         int64                  `json:"total"`
+	Limit         int                    `json:"limit"`
+	Offset        int                    `json:"offset"`
+}
+
+// HoldResponse describes the hold on an order with status on_hold
+type HoldResponse struct {
+	Reason         string     `json:"reason"`
+	PreviousStatus string     `json:"previous_status"`
+	HeldAt         time.Time  `json:"held_at"`
+	ReleaseAt      *time.Time `json:"release_at,omitempty"`
 }
 
 // OrderItemResponse represents an item in an order response
@@ -36,20 +128,325 @@ type OrderItemResponse struct {
 	Quantity  int     `json:"quantity"`
 	Price     float64 `json:"price"`
 	Subtotal  float64 `json:"subtotal"`
+	Status    string  `json:"status"`
 }
 
 // ListOrdersResponse represents a paginated list of orders (ADR-0002 format)
 type ListOrdersResponse struct {
 	Orders []OrderResponse `json:"orders"`
 	Total  int64           `json:"total"`
-	Limit  int             `json:"limit"`
-	Offset int             `json:"offset"`
+	// TotalEstimated is set when Total comes from table statistics
+	TotalEstimated bool `json:"total_estimated,omitempty"`
+	Limit          int  `json:"limit"`
+	Offset         int  `json:"offset"`
+	// Links are also sent as a Link header
+	Links PaginationLinks `json:"links"`
+}
+
+// PaginationLinks are the URLs of the pages around a list page, relative
+// to the server. Prev and Next are omitted at either end, and Last when the
+// total is estimated.
+type PaginationLinks struct {
+	First string `json:"first"`
+	Prev  string `json:"prev,omitempty"`
+	Next  string `json:"next,omitempty"`
+	Last  string `json:"last,omitempty"`
+}
+
+// DeadLetterResponse represents a dead-lettered event in API responses
+type DeadLetterResponse struct {
+	ID            string            `json:"id"`
+	Topic         string            `json:"topic"`
+	Key           string            `json:"key"`
+	EventType     string            `json:"event_type"`
+	OrderID       string            `json:"order_id"`
+	Headers       map[string]string `json:"headers,omitempty"`
+	Payload       json.RawMessage   `json:"payload"`
+	LastError     string            `json:"last_error"`
+	Attempts      int               `json:"attempts"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+	NextAttemptAt time.Time         `json:"next_attempt_at"`
+}
+
+// ListDeadLettersResponse represents a paginated list of dead-lettered events
+type ListDeadLettersResponse struct {
+	DeadLetters []DeadLetterResponse `json:"dead_letters"`
+	Total       int64                `json:"total"`
+	Limit       int                  `json:"limit"`
+	Offset      int                  `json:"offset"`
+}
+
+// JobResponse represents an asynchronous job in API responses
+type JobResponse struct {
+	ID          string          `json:"id"`
+	Kind        string          `json:"kind"`
+	Status      string          `json:"status"`
+	Payload     json.RawMessage `json:"payload,omitempty"`
+	Attempts    int             `json:"attempts"`
+	MaxAttempts int             `json:"max_attempts"`
+	RunAt       time.Time       `json:"run_at"`
+	LastError   string          `json:"last_error,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+	FinishedAt  *time.Time      `json:"finished_at,omitempty"`
+}
+
+// ListJobsResponse represents a paginated list of jobs
+type ListJobsResponse struct {
+	Jobs   []JobResponse `json:"jobs"`
+	Total  int64         `json:"total"`
+	Limit  int           `json:"limit"`
+	Offset int           `json:"offset"`
+}
+
+// QuotaUsageResponse represents the request quota of a caller and how much
+// of it is used
+type QuotaUsageResponse struct {
+	Subject                string                `json:"subject"`
+	RequestsPerMinute      int                   `json:"requests_per_minute"`
+	WriteRequestsPerMinute int                   `json:"write_requests_per_minute"`
+	Burst                  int                   `json:"burst"`
+	Buckets                []QuotaBucketResponse `json:"buckets"`
+}
+
+// QuotaBucketResponse represents one counted window of a quota
+type QuotaBucketResponse struct {
+	Name          string `json:"name"`
+	Used          int    `json:"used"`
+	Limit         int    `json:"limit"`
+	WindowSeconds int    `json:"window_seconds"`
+}
+
+// OrderEventResponse represents an order event streamed to WebSocket clients
+type OrderEventResponse struct {
+	EventID    string    `json:"event_id,omitempty"`
+	EventType  string    `json:"event_type"`
+	OrderID    string    `json:"order_id"`
+	CustomerID string    `json:"customer_id"`
+	Status     string    `json:"status"`
+	OldStatus  string    `json:"old_status,omitempty"`
+	NewStatus  string    `json:"new_status,omitempty"`
+	Total      float64   `json:"total"`
+	Version    int       `json:"version"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// OrderStreamMessage is a message sent to a /ws/orders client. Type is
+// "event", "subscribed", "unsubscribed", "heartbeat", "pong" or "error", and
+// only the fields of that type are set.
+type OrderStreamMessage struct {
+	Type       string              `json:"type"`
+	Event      *OrderEventResponse `json:"event,omitempty"`
+	CustomerID string              `json:"customer_id,omitempty"`
+	Statuses   []string            `json:"statuses,omitempty"`
+	Time       *time.Time          `json:"time,omitempty"`
+	Error      string              `json:"error,omitempty"`
+	Code       string              `json:"code,omitempty"`
+}
+
+// OrderHistoryEntryResponse represents one recorded order mutation in API responses
+type OrderHistoryEntryResponse struct {
+	ID        string         `json:"id"`
+	OrderID   string         `json:"order_id"`
+	Action    string         `json:"action"`
+	Actor     string         `json:"actor"`
+	ActorType string         `json:"actor_type,omitempty"`
+	Channel   string         `json:"channel,omitempty"`
+	OldState  *OrderResponse `json:"old_state"`
+	NewState  *OrderResponse `json:"new_state"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// ListOrderHistoryResponse represents a paginated order history, newest first
+type ListOrderHistoryResponse struct {
+	Entries []OrderHistoryEntryResponse `json:"entries"`
+	Total   int64                       `json:"total"`
+	Limit   int                         `json:"limit"`
+	Offset  int                         `json:"offset"`
+}
+
+// OrderDiffResponse represents the changes to an order between two versions,
+// with the order at each
+type OrderDiffResponse struct {
+	OrderID     string                `json:"order_id"`
+	FromVersion int                   `json:"from_version"`
+	ToVersion   int                   `json:"to_version"`
+	Changes     []FieldChangeResponse `json:"changes"`
+	Items       []ItemChangeResponse  `json:"items"`
+	From        OrderResponse         `json:"from"`
+	To          OrderResponse         `json:"to"`
+}
+
+// FieldChangeResponse represents one changed field; from and to are null
+// when the field was unset
+type FieldChangeResponse struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
+
+// ItemChangeResponse represents an item added, removed or changed between
+// two versions of an order
+type ItemChangeResponse struct {
+	ItemID  string                `json:"item_id"`
+	Change  string                `json:"change"`
+	From    *OrderItemResponse    `json:"from,omitempty"`
+	To      *OrderItemResponse    `json:"to,omitempty"`
+	Changes []FieldChangeResponse `json:"changes,omitempty"`
+}
+
+// OrderReportRowResponse represents one bucket of an order report
+type OrderReportRowResponse struct {
+	Key        string  `json:"key"`
+	OrderCount int64   `json:"order_count"`
+	Revenue    float64 `json:"revenue"`
+}
+
+// OrderReportResponse represents order counts and revenue grouped by one dimension
+type OrderReportResponse struct {
+	GroupBy      string                   `json:"group_by"`
+	From         *time.Time               `json:"from,omitempty"`
+	To           *time.Time               `json:"to,omitempty"`
+	Rows         []OrderReportRowResponse `json:"rows"`
+	TotalOrders  int64                    `json:"total_orders"`
+	TotalRevenue float64                  `json:"total_revenue"`
+}
+
+// StatsResponse is a snapshot of order activity for dashboards
+type StatsResponse struct {
+	GeneratedAt    time.Time          `json:"generated_at"`
+	OrdersByStatus map[string]int64   `json:"orders_by_status"`
+	TotalOrders    int64              `json:"total_orders"`
+	Today          TodayStatsResponse `json:"today"`
+	Cache          CacheStatsResponse `json:"cache"`
+}
+
+// TodayStatsResponse counts the orders created since midnight UTC
+type TodayStatsResponse struct {
+	Date    string  `json:"date"`
+	Orders  int64   `json:"orders"`
+	Revenue float64 `json:"revenue"`
+}
+
+// CacheStatsResponse counts order cache lookups since the instance started.
+// HitRate is null before the first lookup.
+type CacheStatsResponse struct {
+	Hits    int64    `json:"hits"`
+	Misses  int64    `json:"misses"`
+	HitRate *float64 `json:"hit_rate"`
+}
+
+// PaymentResponse reports a payment and the order after it. failure_code
+// and failure_message explain a failed payment.
+type PaymentResponse struct {
+	Provider       string        `json:"provider"`
+	Reference      string        `json:"reference"`
+	Status         string        `json:"status"`
+	Amount         float64       `json:"amount"`
+	Currency       string        `json:"currency"`
+	FailureCode    string        `json:"failure_code,omitempty"`
+	FailureMessage string        `json:"failure_message,omitempty"`
+	Order          OrderResponse `json:"order"`
+}
+
+// PurgeOrdersResponse reports the outcome of an admin purge
+type PurgeOrdersResponse struct {
+	Purged int64 `json:"purged"`
+}
+
+// ReplayEventsResponse reports how far an event replay got. next_cursor is
+// set when the limit stopped it before the end of the range.
+type ReplayEventsResponse struct {
+	Replayed   int    `json:"replayed"`
+	Skipped    int    `json:"skipped"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// RetentionPurgeResponse reports the orders removed by a retention pass
+type RetentionPurgeResponse struct {
+	DeletedPurged   int64 `json:"deleted_purged"`
+	CompletedPurged int64 `json:"completed_purged"`
+}
+
+// TotalCheckResponse is the anomaly report of an order total check
+type TotalCheckResponse struct {
+	StartedAt     time.Time               `json:"started_at"`
+	FinishedAt    time.Time               `json:"finished_at"`
+	Checked       int                     `json:"checked"`
+	MismatchCount int                     `json:"mismatch_count"`
+	Repaired      int                     `json:"repaired"`
+	Mismatches    []TotalMismatchResponse `json:"mismatches"`
+}
+
+// TotalMismatchResponse is an order whose amounts disagree with its items
+type TotalMismatchResponse struct {
+	OrderID      string  `json:"order_id"`
+	TenantID     string  `json:"tenant_id,omitempty"`
+	Total        float64 `json:"total"`
+	ItemTotal    float64 `json:"item_total"`
+	BadSubtotals int     `json:"bad_subtotals"`
+}
+
+// LogLevelResponse reports the current log level
+type LogLevelResponse struct {
+	Level string `json:"level"`
+}
+
+// CustomerErasureResponse confirms that a customer's data was erased
+type CustomerErasureResponse struct {
+	ErasureID    string    `json:"erasure_id"`
+	OrdersErased int       `json:"orders_erased"`
+	ErasedAt     time.Time `json:"erased_at"`
+}
+
+// BulkCreateResult represents the outcome for one order in a bulk create.
+// Index is the order's position in the request.
+type BulkCreateResult struct {
+	Index   int            `json:"index"`
+	Success bool           `json:"success"`
+	Order   *OrderResponse `json:"order,omitempty"`
+	Error   *ErrorResponse `json:"error,omitempty"`
+}
+
+// BulkCreateOrdersResponse represents the response for a bulk create
+type BulkCreateOrdersResponse struct {
+	Results   []BulkCreateResult `json:"results"`
+	Succeeded int                `json:"succeeded"`
+	Failed    int                `json:"failed"`
+}
+
+// BulkStatusResult represents the outcome for one order in a bulk status update
+type BulkStatusResult struct {
+	OrderID string         `json:"order_id"`
+	Success bool           `json:"success"`
+	Order   *OrderResponse `json:"order,omitempty"`
+	Error   *ErrorResponse `json:"error,omitempty"`
+}
+
+// BulkUpdateStatusResponse represents the response for a bulk status update
+type BulkUpdateStatusResponse struct {
+	Results   []BulkStatusResult `json:"results"`
+	Succeeded int                `json:"succeeded"`
+	Failed    int                `json:"failed"`
 }
 
 // ErrorResponse represents an error response
 type ErrorResponse struct {
 	Error string `json:"error"`
 	Code  string `json:"code,omitempty"`
+	// Errors lists each failing field when Code is VALIDATION_FAILED
+	Errors []FieldError `json:"errors,omitempty"`
+	// ErrorID identifies the logged failure when Code is INTERNAL_ERROR
+	ErrorID string `json:"error_id,omitempty"`
+}
+
+// FieldError describes one request field that failed validation
+type FieldError struct {
+	// Field is the JSON path of the field, e.g. "items[0].quantity"
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
 // HealthResponse represents a health check response
@@ -0,0 +1,60 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+)
+
+// RetentionHandler lets operators run the data retention purge on demand
+type RetentionHandler struct {
+	service service.RetentionService
+}
+
+// NewRetentionHandler creates a new retention handler
+func NewRetentionHandler(svc service.RetentionService) *RetentionHandler {
+	return &RetentionHandler{
+		service: svc,
+	}
+}
+
+// RunRetention handles POST /api/v1/admin/retention/purge
+func (h *RetentionHandler) RunRetention(w http.ResponseWriter, r *http.Request) {
+	result, err := h.service.ApplyRetention(r.Context())
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response := RetentionPurgeResponse{
+		DeletedPurged:   result.DeletedPurged,
+		CompletedPurged: result.CompletedPurged,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		return
+	}
+}
+
+// RegisterRoutes registers retention routes on the admin route group
+func (h *RetentionHandler) RegisterRoutes(r chi.Router) {
+	r.Post("/retention/purge", h.RunRetention)
+}
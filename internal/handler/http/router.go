@@ -16,29 +16,116 @@ package http //nolint:revive // intentional: matches handler layer convention
 
 import (
 	"log/slog"
+	"net/http"
 
 	"github.com/go-chi/chi/v5"
 	chimiddleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/middleware"
 )
 
-// NewRouter creates a new Chi router with all routes configured
+// RouteRegistrar is implemented by handlers that mount their own routes
+type RouteRegistrar interface {
+	RegisterRoutes(r chi.Router)
+}
+
+// adminRoutes mounts operator handlers under /api/v1/admin behind the admin API key
+type adminRoutes struct {
+	apiKey   string
+	audit    func(http.Handler) http.Handler
+	handlers []RouteRegistrar
+}
+
+// NewAdminRoutes groups handlers under /api/v1/admin. Their routes are
+// registered relative to that prefix and require the admin API key; an empty
+// key disables the group. Admitted requests then pass through audit (see
+// middleware.AuditAll), if not nil.
+func NewAdminRoutes(apiKey string, audit func(http.Handler) http.Handler, handlers ...RouteRegistrar) RouteRegistrar {
+	return &adminRoutes{apiKey: apiKey, audit: audit, handlers: handlers}
+}
+
+// RegisterRoutes registers the admin route group on the router
+func (a *adminRoutes) RegisterRoutes(r chi.Router) {
+	r.Route("/api/v1/admin", func(r chi.Router) {
+		r.Use(middleware.AdminAuth(a.apiKey))
+		if a.audit != nil {
+			r.Use(a.audit)
+		}
+		for _, h := range a.handlers {
+			h.RegisterRoutes(r)
+		}
+	})
+}
+
+// authenticatedRoutes mounts handlers whose routes require a caller token
+type authenticatedRoutes struct {
+	authenticate func(http.Handler) http.Handler
+	handlers     []RouteRegistrar
+}
+
+// NewAuthenticatedRoutes groups handlers behind authenticate (see
+// middleware.Authenticate). Their routes keep their own paths.
+func NewAuthenticatedRoutes(authenticate func(http.Handler) http.Handler, handlers ...RouteRegistrar) RouteRegistrar {
+	return &authenticatedRoutes{authenticate: authenticate, handlers: handlers}
+}
+
+// RegisterRoutes registers the authenticated route group on the router
+func (a *authenticatedRoutes) RegisterRoutes(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(a.authenticate)
+		for _, h := range a.handlers {
+			h.RegisterRoutes(r)
+		}
+	})
+}
+
+// NewRouter creates a new Chi router with all routes configured.
+// mw is applied to every route after the built-in middleware stack.
+// orderRoutes is usually the OrderHandler, or a NewAuthenticatedRoutes group
+// containing it. Additional handlers (e.g. NewAdminRoutes) are mounted after
+// the order routes.
 // CONSTRAINT: Health endpoints must not require authentication (ADR-0002)
-func NewRouter(orderHandler *OrderHandler, healthHandler *HealthHandler, logger *slog.Logger) *chi.Mux {
+func NewRouter(orderRoutes RouteRegistrar, healthHandler *HealthHandler, logger *slog.Logger, mw []func(http.Handler) http.Handler, extra ...RouteRegistrar) *chi.Mux {
 	r := chi.NewRouter()
 
 	// Middleware stack
 	r.Use(chimiddleware.RequestID)
+	r.Use(middleware.Correlation())
 	r.Use(chimiddleware.RealIP)
 	r.Use(middleware.Logging(logger))
-	r.Use(chimiddleware.Recoverer)
+	r.Use(middleware.Actor())
+	r.Use(middleware.Recovery())
+	r.Use(mw...)
 
 	// Health checks (outside any auth middleware)
 	r.Get("/healthz", healthHandler.Healthz)
 	r.Get("/readyz", healthHandler.Readyz)
 
 	// Order routes with /api/v1 prefix
-	orderHandler.RegisterRoutes(r)
+	orderRoutes.RegisterRoutes(r)
+
+	for _, h := range extra {
+		h.RegisterRoutes(r)
+	}
+
+	return r
+}
+
+// NewWorkerRouter creates the router of `ordersvc worker`, which serves
+// health checks and the extra handlers (e.g. metrics) but no API routes
+func NewWorkerRouter(healthHandler *HealthHandler, logger *slog.Logger, extra ...RouteRegistrar) *chi.Mux {
+	r := chi.NewRouter()
+
+	r.Use(chimiddleware.RequestID)
+	r.Use(middleware.Correlation())
+	r.Use(middleware.Logging(logger))
+	r.Use(middleware.Recovery())
+
+	r.Get("/healthz", healthHandler.Healthz)
+	r.Get("/readyz", healthHandler.Readyz)
+
+	for _, h := range extra {
+		h.RegisterRoutes(r)
+	}
 
 	return r
 }
@@ -0,0 +1,60 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+)
+
+// StatsHandler handles HTTP requests for order stats
+type StatsHandler struct {
+	service service.StatsService
+	maxAge  time.Duration
+}
+
+// NewStatsHandler creates a new stats handler. maxAge is how long clients
+// may reuse a response, normally the service's own TTL.
+func NewStatsHandler(svc service.StatsService, maxAge time.Duration) *StatsHandler {
+	return &StatsHandler{
+		service: svc,
+		maxAge:  maxAge,
+	}
+}
+
+// GetStats handles GET /api/v1/stats
+func (h *StatsHandler) GetStats(w http.ResponseWriter, r *http.Request) {
+	stats, err := h.service.GetStats(r.Context())
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	setCacheControl(w, h.maxAge)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(MapOrderStatsToResponse(stats)); err != nil {
+		return
+	}
+}
+
+// RegisterRoutes registers stats routes
+func (h *StatsHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/api/v1/stats", h.GetStats)
+}
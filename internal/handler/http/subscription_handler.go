@@ -0,0 +1,266 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional: matches handler layer convention
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+)
+
+// SubscriptionHandler handles requests for recurring order subscriptions
+type SubscriptionHandler struct {
+	service service.SubscriptionService
+}
+
+// NewSubscriptionHandler creates a new subscription handler
+func NewSubscriptionHandler(svc service.SubscriptionService) *SubscriptionHandler {
+	return &SubscriptionHandler{
+		service: svc,
+	}
+}
+
+// CreateSubscription handles POST /api/v1/subscriptions
+// Returns 201 with the subscription; the template is validated as an order would be
+func (h *SubscriptionHandler) CreateSubscription(w http.ResponseWriter, r *http.Request) {
+	var req CreateSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+	if !validateRequest(w, r, &req) {
+		return
+	}
+
+	dto := service.CreateSubscriptionDTO{
+		CustomerID:      req.CustomerID,
+		Items:           MapRequestToOrderItems(req.Items),
+		Cadence:         domain.Cadence(req.Cadence),
+		ShippingMethod:  req.ShippingMethod,
+		ShippingAddress: MapRequestToAddress(req.ShippingAddress),
+	}
+	if req.FirstRunAt != nil {
+		dto.FirstRunAt = *req.FirstRunAt
+	}
+
+	sub, err := h.service.CreateSubscription(r.Context(), dto)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	writeSubscription(w, http.StatusCreated, sub)
+}
+
+// GetSubscription handles GET /api/v1/subscriptions/{id}
+func (h *SubscriptionHandler) GetSubscription(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "subscription")
+	if !ok {
+		return
+	}
+
+	sub, err := h.service.GetSubscription(r.Context(), id)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	writeSubscription(w, http.StatusOK, sub)
+}
+
+// ListSubscriptions handles GET /api/v1/subscriptions
+// Customer tokens list their own subscriptions unless customer_id names another (403).
+func (h *SubscriptionHandler) ListSubscriptions(w http.ResponseWriter, r *http.Request) {
+	customerID, ok := customerIDQuery(w, r)
+	if !ok {
+		return
+	}
+
+	limit := parseIntParam(r, "limit", defaultLimit)
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	if limit < 1 {
+		limit = defaultLimit
+	}
+
+	offset := parseIntParam(r, "offset", 0)
+	if offset < 0 {
+		offset = 0
+	}
+
+	var cid string
+	if customerID != nil {
+		cid = *customerID
+	}
+	result, err := h.service.ListSubscriptions(r.Context(), cid, limit, offset)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	response := ListSubscriptionsResponse{
+		Subscriptions: MapSubscriptionsToResponse(result.Data),
+		Total:         result.Total,
+		Limit:         limit,
+		Offset:        offset,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		return
+	}
+}
+
+// UpdateSubscription handles PUT /api/v1/subscriptions/{id}
+// The expected version may be sent as "version" in the body or as an If-Match header.
+func (h *SubscriptionHandler) UpdateSubscription(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "subscription")
+	if !ok {
+		return
+	}
+
+	var req UpdateSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+	if !validateRequest(w, r, &req) {
+		return
+	}
+	expectedVersion, ok := subscriptionVersion(w, r, req.Version)
+	if !ok {
+		return
+	}
+
+	dto := service.UpdateSubscriptionDTO{
+		Items:           MapRequestToOrderItems(req.Items),
+		Cadence:         domain.Cadence(req.Cadence),
+		ShippingMethod:  req.ShippingMethod,
+		ShippingAddress: MapRequestToAddress(req.ShippingAddress),
+	}
+	sub, err := h.service.UpdateSubscription(r.Context(), id, dto, expectedVersion)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	writeSubscription(w, http.StatusOK, sub)
+}
+
+// DeleteSubscription handles DELETE /api/v1/subscriptions/{id}
+// Returns 204; orders already placed are kept
+func (h *SubscriptionHandler) DeleteSubscription(w http.ResponseWriter, r *http.Request) {
+	id, ok := idParam(w, r, "subscription")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteSubscription(r.Context(), id); err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// PauseSubscription handles POST /api/v1/subscriptions/{id}/pause
+// Returns 409 if the subscription is already paused
+func (h *SubscriptionHandler) PauseSubscription(w http.ResponseWriter, r *http.Request) {
+	h.control(w, r, h.service.PauseSubscription)
+}
+
+// ResumeSubscription handles POST /api/v1/subscriptions/{id}/resume
+// Returns 409 if the subscription is not paused
+func (h *SubscriptionHandler) ResumeSubscription(w http.ResponseWriter, r *http.Request) {
+	h.control(w, r, h.service.ResumeSubscription)
+}
+
+// SkipNextRun handles POST /api/v1/subscriptions/{id}/skip
+// Moves the next run on by one cadence without placing an order
+func (h *SubscriptionHandler) SkipNextRun(w http.ResponseWriter, r *http.Request) {
+	h.control(w, r, h.service.SkipNextRun)
+}
+
+// control runs a pause, resume or skip whose optional body carries only the
+// expected version
+func (h *SubscriptionHandler) control(w http.ResponseWriter, r *http.Request, action func(ctx context.Context, id string, expectedVersion *int) (*domain.Subscription, error)) {
+	id, ok := idParam(w, r, "subscription")
+	if !ok {
+		return
+	}
+
+	var req SubscriptionActionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, r, http.StatusBadRequest, "invalid request body", "INVALID_REQUEST")
+		return
+	}
+	if !validateRequest(w, r, &req) {
+		return
+	}
+	expectedVersion, ok := subscriptionVersion(w, r, req.Version)
+	if !ok {
+		return
+	}
+
+	sub, err := action(r.Context(), id, expectedVersion)
+	if err != nil {
+		handleServiceError(w, r, err)
+		return
+	}
+
+	writeSubscription(w, http.StatusOK, sub)
+}
+
+// subscriptionVersion returns the version from the body, or else from the
+// If-Match header. On a malformed header it writes a 400 and reports false.
+func subscriptionVersion(w http.ResponseWriter, r *http.Request, body *int) (*int, bool) {
+	if body != nil {
+		return body, true
+	}
+	v, ok := parseIfMatchVersion(r)
+	if !ok {
+		writeError(w, r, http.StatusBadRequest, "If-Match must be a subscription version", "INVALID_IF_MATCH")
+		return nil, false
+	}
+	return v, true
+}
+
+func writeSubscription(w http.ResponseWriter, status int, sub *domain.Subscription) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(MapSubscriptionToResponse(sub)); err != nil {
+		return
+	}
+}
+
+// RegisterRoutes registers subscription routes on the router
+func (h *SubscriptionHandler) RegisterRoutes(r chi.Router) {
+	r.Post("/api/v1/subscriptions", h.CreateSubscription)
+	r.Get("/api/v1/subscriptions", h.ListSubscriptions)
+	r.Get("/api/v1/subscriptions/{id}", h.GetSubscription)
+	r.Put("/api/v1/subscriptions/{id}", h.UpdateSubscription)
+	r.Delete("/api/v1/subscriptions/{id}", h.DeleteSubscription)
+	r.Post("/api/v1/subscriptions/{id}/pause", h.PauseSubscription)
+	r.Post("/api/v1/subscriptions/{id}/resume", h.ResumeSubscription)
+	r.Post("/api/v1/subscriptions/{id}/skip", h.SkipNextRun)
+}
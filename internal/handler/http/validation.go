@@ -0,0 +1,192 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http //nolint:revive // intentional package name matching handler layer
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/problem"
+)
+
+// requestValidator checks request structs against their validate tags.
+// Field names are reported by their JSON names so clients can map an error
+// back to the body they sent.
+var requestValidator = newRequestValidator()
+
+func newRequestValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	return v
+}
+
+// validateRequest checks req and, if any field fails, writes a 400
+// VALIDATION_FAILED response listing every failing field. It reports
+// whether the request is valid.
+func validateRequest(w http.ResponseWriter, r *http.Request, req any) bool {
+	fields := fieldErrors(requestValidator.Struct(req))
+	if len(fields) == 0 {
+		return true
+	}
+
+	problem.Write(w, r, http.StatusBadRequest, "request validation failed", "VALIDATION_FAILED", fields)
+	return false
+}
+
+// fieldErrors converts validator output into the API's field errors
+func fieldErrors(err error) []FieldError {
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		return nil
+	}
+
+	fields := make([]FieldError, len(errs))
+	for i, fe := range errs {
+		code, message := describeFieldError(fe)
+		fields[i] = FieldError{
+			Field:   fieldPath(fe),
+			Code:    code,
+			Message: message,
+		}
+	}
+	return fields
+}
+
+// fieldPath drops the struct name from the namespace, e.g.
+// "CreateOrderRequest.items[0].quantity" becomes "items[0].quantity".
+func fieldPath(fe validator.FieldError) string {
+	_, path, ok := strings.Cut(fe.Namespace(), ".")
+	if !ok {
+		return fe.Field()
+	}
+	return path
+}
+
+// describeFieldError maps a failed validate tag to an error code and a
+// human-readable message
+func describeFieldError(fe validator.FieldError) (code, message string) {
+	collection := fe.Kind() == reflect.Slice || fe.Kind() == reflect.Map
+
+	switch fe.Tag() {
+	case "required":
+		return "REQUIRED", "is required"
+	case "min":
+		if collection {
+			return "TOO_FEW", fmt.Sprintf("must have a length of at least %s", fe.Param())
+		}
+		return "TOO_SHORT", fmt.Sprintf("must be at least %s characters", fe.Param())
+	case "max":
+		if collection {
+			return "TOO_MANY", fmt.Sprintf("must have a length of at most %s", fe.Param())
+		}
+		return "TOO_LONG", fmt.Sprintf("must be at most %s characters", fe.Param())
+	case "gt":
+		return "TOO_SMALL", fmt.Sprintf("must be greater than %s", fe.Param())
+	case "gte":
+		return "TOO_SMALL", fmt.Sprintf("must be at least %s", fe.Param())
+	case "uuid":
+		return "INVALID_ID", "must be a UUID"
+	default:
+		return "INVALID", fmt.Sprintf("failed the %s check", fe.Tag())
+	}
+}
+
+// idParam reads the {id} path parameter and checks that it is a UUID, so a
+// malformed ID is rejected here instead of failing in the database. On
+// failure it writes a 400 naming what the ID identifies, e.g. "order", and
+// reports false.
+func idParam(w http.ResponseWriter, r *http.Request, what string) (string, bool) {
+	return uuidParam(w, r, "id", what)
+}
+
+// uuidParam reads the path parameter name, which must be a UUID. what names
+// the ID in error messages. On failure it writes a 400 and reports false.
+func uuidParam(w http.ResponseWriter, r *http.Request, name, what string) (string, bool) {
+	id := chi.URLParam(r, name)
+	if id == "" {
+		writeError(w, r, http.StatusBadRequest, what+" ID is required", "MISSING_ID")
+		return "", false
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		writeError(w, r, http.StatusBadRequest, what+" ID must be a UUID", "INVALID_ID")
+		return "", false
+	}
+	return id, true
+}
+
+// customerIDQuery reads the optional customer_id filter, which must be a
+// UUID when present. On failure it writes a 400 and reports false.
+func customerIDQuery(w http.ResponseWriter, r *http.Request) (*string, bool) {
+	cid := r.URL.Query().Get("customer_id")
+	if cid == "" {
+		return nil, true
+	}
+	if _, err := uuid.Parse(cid); err != nil {
+		writeError(w, r, http.StatusBadRequest, "customer_id must be a UUID", "INVALID_ID")
+		return nil, false
+	}
+	return &cid, true
+}
+
+// includeNotesQuery reads the optional include parameter, a comma-delimited
+// list whose only accepted value is "notes". It reports whether notes were
+// requested; on an unknown value it writes a 400 and reports false.
+func includeNotesQuery(w http.ResponseWriter, r *http.Request) (notes, ok bool) {
+	include := r.URL.Query().Get("include")
+	if include == "" {
+		return false, true
+	}
+	for _, part := range strings.Split(include, ",") {
+		if strings.TrimSpace(part) != "notes" {
+			writeError(w, r, http.StatusBadRequest, "include must be notes", "INVALID_INCLUDE")
+			return false, false
+		}
+	}
+	return true, true
+}
+
+// fieldsQuery reads the optional ?fields= list of order fields to return,
+// such as id,status,total. Nil means every field. On an unknown or empty
+// name it writes a 400 and reports false.
+func fieldsQuery(w http.ResponseWriter, r *http.Request) ([]string, bool) {
+	raw := r.URL.Query().Get("fields")
+	if raw == "" {
+		return nil, true
+	}
+	fields := strings.Split(raw, ",")
+	for i, field := range fields {
+		fields[i] = strings.TrimSpace(field)
+		if !slices.Contains(orderFieldNames, fields[i]) {
+			writeError(w, r, http.StatusBadRequest,
+				fmt.Sprintf("unknown field %q; valid fields are %s", fields[i], strings.Join(orderFieldNames, ", ")),
+				"INVALID_FIELDS")
+			return nil, false
+		}
+	}
+	return fields, true
+}
@@ -0,0 +1,224 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package httpclient is the HTTP client for the service's calls to other
+// systems, such as webhook receivers. Every integration gets the same
+// resilience: a timeout per attempt, retries with jittered exponential
+// backoff, a circuit breaker per host, metrics, and the caller's request ID
+// and trace context.
+package httpclient
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/breaker"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/correlation"
+)
+
+// ErrCircuitOpen is returned without calling a host whose breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// IdempotencyKeyHeader marks a request safe to retry whatever its method
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// Config tunes a Client
+type Config struct {
+	// Name labels the client's metrics, e.g. "webhook"
+	Name string
+	// Timeout bounds each attempt, reading the response body included
+	Timeout time.Duration
+	// MaxAttempts is how many times a retryable request is tried
+	MaxAttempts int
+	// InitialBackoff is the first retry's backoff, doubling up to
+	// MaxBackoff; each wait is a random duration up to the backoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// BreakerFailures is how many consecutive failures of a host open its
+	// breaker, skipping it for BreakerCooldown
+	BreakerFailures int
+	BreakerCooldown time.Duration
+	// Transport defaults to http.DefaultTransport
+	Transport http.RoundTripper
+}
+
+// Client sends requests with retries and a circuit breaker per host. It is
+// safe for concurrent use.
+//
+// A request is retried after a transport error or a 429, 502, 503 or 504
+// response, waiting for Retry-After if the server sends one shorter than
+// MaxBackoff. Only requests with an idempotent method, or an
+// Idempotency-Key header, are retried, and only if their body can be
+// replayed (see http.Request.GetBody). Transport errors and 5xx responses
+// count as failures of the host; a call its caller cancelled does not.
+type Client struct {
+	cfg     Config
+	client  *http.Client
+	metrics *Metrics
+
+	mu       sync.Mutex
+	breakers map[string]*breaker.Breaker
+
+	// sleep waits d or until done is closed, reporting which
+	sleep func(done <-chan struct{}, d time.Duration) bool
+}
+
+// New creates a client. metrics may be nil.
+func New(cfg Config, metrics *Metrics) *Client {
+	return &Client{
+		cfg:      cfg,
+		client:   &http.Client{Timeout: cfg.Timeout, Transport: cfg.Transport},
+		metrics:  metrics,
+		breakers: make(map[string]*breaker.Breaker),
+		sleep:    sleep,
+	}
+}
+
+// Do sends req, retrying as described on Client, and returns the last
+// response or error. Like http.Client.Do, a non-2xx response is not an
+// error, and the caller must close the response body.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	req = req.Clone(ctx)
+	if id := correlation.ID(ctx); id != "" && req.Header.Get(chimiddleware.RequestIDHeader) == "" {
+		req.Header.Set(chimiddleware.RequestIDHeader, id)
+	}
+	if tp := correlation.TraceParent(ctx); tp != "" && req.Header.Get(correlation.TraceParentHeader) == "" {
+		req.Header.Set(correlation.TraceParentHeader, tp)
+	}
+
+	host := req.URL.Host
+	b := c.breaker(host)
+	retryable := replayable(req)
+	backoff := c.cfg.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+		if !b.Allow() {
+			if req.Body != nil {
+				_ = req.Body.Close()
+			}
+			c.metrics.request(c.cfg.Name, host, "circuit_open")
+			return nil, fmt.Errorf("%s %s: %w", req.Method, host, ErrCircuitOpen)
+		}
+
+		start := time.Now()
+		resp, err := c.client.Do(req)
+		c.metrics.observe(c.cfg.Name, host, resp, err, time.Since(start))
+		switch {
+		case err != nil && ctx.Err() != nil:
+			b.Abandon()
+			return nil, err
+		case err != nil || resp.StatusCode >= 500:
+			b.Failure()
+		default:
+			b.Success()
+		}
+
+		if !retryable || attempt >= c.cfg.MaxAttempts || !retryableOutcome(resp, err) {
+			return resp, err
+		}
+		wait := rand.N(backoff + 1)
+		if resp != nil {
+			if after, ok := retryAfter(resp); ok && after <= c.cfg.MaxBackoff {
+				wait = after
+			}
+			// Drain so the connection is reused for the retry
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+			_ = resp.Body.Close()
+		}
+		c.metrics.retry(c.cfg.Name, host)
+		if !c.sleep(ctx.Done(), wait) {
+			return nil, ctx.Err()
+		}
+		backoff = min(backoff*2, c.cfg.MaxBackoff)
+	}
+}
+
+// breaker returns the breaker of host, creating it closed
+func (c *Client) breaker(host string) *breaker.Breaker {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b, ok := c.breakers[host]
+	if !ok {
+		b = breaker.New(breaker.Config{
+			FailureThreshold: c.cfg.BreakerFailures,
+			Cooldown:         c.cfg.BreakerCooldown,
+			OnStateChange: func(s breaker.State) {
+				c.metrics.setState(c.cfg.Name, host, s)
+			},
+		})
+		c.breakers[host] = b
+	}
+	return b
+}
+
+// replayable reports whether req may be sent again
+func replayable(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return req.Header.Get(IdempotencyKeyHeader) != ""
+}
+
+// retryableOutcome reports whether an attempt ending in resp or err is
+// worth repeating
+func retryableOutcome(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryAfter reads a Retry-After header given in seconds
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	secs, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || secs < 0 {
+		return 0, false
+	}
+	return time.Duration(secs) * time.Second, true
+}
+
+func sleep(done <-chan struct{}, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
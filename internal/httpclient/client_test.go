@@ -0,0 +1,164 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/correlation"
+)
+
+func newTestClient(metrics *Metrics) (*Client, *[]time.Duration) {
+	c := New(Config{
+		Name:            "test",
+		Timeout:         time.Second,
+		MaxAttempts:     3,
+		InitialBackoff:  100 * time.Millisecond,
+		MaxBackoff:      2 * time.Second,
+		BreakerFailures: 3,
+		BreakerCooldown: time.Minute,
+	}, metrics)
+	var waits []time.Duration
+	c.sleep = func(_ <-chan struct{}, d time.Duration) bool {
+		waits = append(waits, d)
+		return true
+	}
+	return c, &waits
+}
+
+// statusServer answers with statuses in turn, the last one repeatedly
+func statusServer(t *testing.T, hits *atomic.Int32, statuses ...int) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		n := int(hits.Add(1))
+		status := statuses[min(n, len(statuses))-1]
+		if status == http.StatusServiceUnavailable {
+			w.Header().Set("Retry-After", "1")
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestClient_Do_RetriesUntilSuccess(t *testing.T) {
+	var hits atomic.Int32
+	srv := statusServer(t, &hits, http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK)
+	reg := prometheus.NewRegistry()
+	c, waits := newTestClient(NewMetrics(reg))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, srv.URL, strings.NewReader("payload"))
+	require.NoError(t, err)
+	req.Header.Set(IdempotencyKeyHeader, "evt-1")
+	resp, err := c.Do(req)
+
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "payload", string(body), "body replayed on each attempt")
+	assert.Equal(t, int32(3), hits.Load())
+	require.Len(t, *waits, 2)
+	assert.Equal(t, time.Second, (*waits)[0], "Retry-After honored")
+	assert.LessOrEqual(t, (*waits)[1], 200*time.Millisecond, "jittered backoff doubled")
+	host := req.URL.Host
+	assert.Equal(t, 2.0, testutil.ToFloat64(c.metrics.retries.WithLabelValues("test", host)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(c.metrics.requests.WithLabelValues("test", host, "200")))
+}
+
+func TestClient_Do_NonIdempotentRequest_NotRetried(t *testing.T) {
+	var hits atomic.Int32
+	srv := statusServer(t, &hits, http.StatusServiceUnavailable)
+	c, _ := newTestClient(nil)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, srv.URL, strings.NewReader("payload"))
+	require.NoError(t, err)
+	resp, err := c.Do(req)
+
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, int32(1), hits.Load())
+}
+
+func TestClient_Do_ClientError_NotRetried(t *testing.T) {
+	var hits atomic.Int32
+	srv := statusServer(t, &hits, http.StatusNotFound)
+	c, _ := newTestClient(nil)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+	resp, err := c.Do(req)
+
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, int32(1), hits.Load())
+}
+
+func TestClient_Do_FailingHost_OpensBreaker(t *testing.T) {
+	var hits atomic.Int32
+	srv := statusServer(t, &hits, http.StatusInternalServerError)
+	c, _ := newTestClient(nil)
+
+	for range 3 {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL, nil)
+		require.NoError(t, err)
+		resp, err := c.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+	_, err = c.Do(req)
+
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, int32(3), hits.Load(), "500 is a failure but not retried")
+}
+
+func TestClient_Do_PropagatesRequestIDAndTraceParent(t *testing.T) {
+	var requestID, traceParent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID = r.Header.Get("X-Request-Id")
+		traceParent = r.Header.Get(correlation.TraceParentHeader)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+	c, _ := newTestClient(nil)
+	tp := correlation.NewTraceParent()
+	ctx := correlation.WithTraceParent(correlation.WithID(context.Background(), "req-1"), tp)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+	resp, err := c.Do(req)
+
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "req-1", requestID)
+	assert.Equal(t, tp, traceParent)
+}
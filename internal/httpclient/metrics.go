@@ -0,0 +1,92 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpclient
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/breaker"
+)
+
+// Metrics records outbound requests of every Client sharing it, labelled by
+// client name and host.
+type Metrics struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	retries  *prometheus.CounterVec
+	state    *prometheus.GaugeVec
+}
+
+// NewMetrics registers the outbound HTTP client metrics with reg.
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_client_requests_total",
+			Help: "Outbound HTTP attempts by client, host and status code, error for transport errors or circuit_open when skipped.",
+		}, []string{"client", "host", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_client_request_duration_seconds",
+			Help:    "Latency of outbound HTTP attempts by client and host.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"client", "host"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_client_retries_total",
+			Help: "Outbound HTTP requests retried, by client and host.",
+		}, []string{"client", "host"}),
+		state: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "http_client_breaker_state",
+			Help: "State of the circuit breaker of each outbound host: 0 closed, 1 open, 2 half-open.",
+		}, []string{"client", "host"}),
+	}
+	reg.MustRegister(m.requests, m.duration, m.retries, m.state)
+	return m
+}
+
+func (m *Metrics) observe(client, host string, resp *http.Response, err error, d time.Duration) {
+	if m == nil {
+		return
+	}
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	m.requests.WithLabelValues(client, host, code).Inc()
+	m.duration.WithLabelValues(client, host).Observe(d.Seconds())
+}
+
+func (m *Metrics) request(client, host, code string) {
+	if m == nil {
+		return
+	}
+	m.requests.WithLabelValues(client, host, code).Inc()
+}
+
+func (m *Metrics) retry(client, host string) {
+	if m == nil {
+		return
+	}
+	m.retries.WithLabelValues(client, host).Inc()
+}
+
+func (m *Metrics) setState(client, host string, s breaker.State) {
+	if m == nil {
+		return
+	}
+	m.state.WithLabelValues(client, host).Set(float64(s))
+}
@@ -0,0 +1,260 @@
+package messaging
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// errAvroTruncated is returned when an Avro payload ends inside a value.
+var errAvroTruncated = errors.New("avro: truncated payload")
+
+// encodeAvroOrderEvent writes evt in the Avro binary encoding of
+// api/avro/order_event.avsc. Fields are written in schema order, so the two
+// must be changed together.
+func encodeAvroOrderEvent(evt OrderEvent) []byte {
+	w := &avroWriter{}
+	w.string(evt.EventID)
+	w.string(evt.EventType)
+	w.string(evt.OrderID)
+	w.string(evt.CustomerID)
+	w.string(evt.Status)
+	w.string(evt.OldStatus)
+	w.string(evt.NewStatus)
+	w.double(evt.Total)
+	w.long(int64(evt.Version))
+	w.timestamp(evt.OccurredAt)
+	w.long(int64(evt.OrderCount))
+	w.string(evt.CorrelationID)
+	w.string(evt.HoldReason)
+	w.optionalTimestamp(evt.HoldReleaseAt)
+	w.stringMap(evt.Metadata)
+	w.stringArray(evt.Tags)
+	w.optionalTimestamp(evt.EstimatedDeliveryAt)
+	w.optionalTimestamp(evt.SLABreachedAt)
+	w.boolean(evt.Replayed)
+	w.string(evt.TenantID)
+	w.string(evt.Actor)
+	w.string(evt.ActorType)
+	w.string(evt.Channel)
+	return w.buf
+}
+
+// decodeAvroOrderEvent reads an event written by encodeAvroOrderEvent.
+// Fields are only ever appended to the schema, so bytes left over after the
+// known fields belong to a newer writer and are ignored.
+func decodeAvroOrderEvent(data []byte) (OrderEvent, error) {
+	r := &avroReader{buf: data}
+	var evt OrderEvent
+	evt.EventID = r.string()
+	evt.EventType = r.string()
+	evt.OrderID = r.string()
+	evt.CustomerID = r.string()
+	evt.Status = r.string()
+	evt.OldStatus = r.string()
+	evt.NewStatus = r.string()
+	evt.Total = r.double()
+	evt.Version = int(r.long())
+	evt.OccurredAt = r.timestamp()
+	evt.OrderCount = int(r.long())
+	evt.CorrelationID = r.string()
+	evt.HoldReason = r.string()
+	evt.HoldReleaseAt = r.optionalTimestamp()
+	evt.Metadata = r.stringMap()
+	evt.Tags = r.stringArray()
+	evt.EstimatedDeliveryAt = r.optionalTimestamp()
+	evt.SLABreachedAt = r.optionalTimestamp()
+	evt.Replayed = r.boolean()
+	// Events written before tenant_id was added end here
+	if r.err == nil && len(r.buf) > 0 {
+		evt.TenantID = r.string()
+	}
+	// and those written before the actor fields were added here
+	if r.err == nil && len(r.buf) > 0 {
+		evt.Actor = r.string()
+		evt.ActorType = r.string()
+		evt.Channel = r.string()
+	}
+	if r.err != nil {
+		return OrderEvent{}, r.err
+	}
+	return evt, nil
+}
+
+// avroWriter appends values in the Avro binary encoding.
+type avroWriter struct {
+	buf []byte
+}
+
+func (w *avroWriter) long(v int64) {
+	w.buf = binary.AppendVarint(w.buf, v)
+}
+
+func (w *avroWriter) string(s string) {
+	w.long(int64(len(s)))
+	w.buf = append(w.buf, s...)
+}
+
+func (w *avroWriter) double(v float64) {
+	w.buf = binary.LittleEndian.AppendUint64(w.buf, math.Float64bits(v))
+}
+
+func (w *avroWriter) boolean(v bool) {
+	if v {
+		w.buf = append(w.buf, 1)
+	} else {
+		w.buf = append(w.buf, 0)
+	}
+}
+
+// timestamp writes a timestamp-micros long.
+func (w *avroWriter) timestamp(t time.Time) {
+	w.long(t.UnixMicro())
+}
+
+// optionalTimestamp writes a ["null", timestamp-micros] union.
+func (w *avroWriter) optionalTimestamp(t *time.Time) {
+	if t == nil {
+		w.long(0)
+		return
+	}
+	w.long(1)
+	w.timestamp(*t)
+}
+
+// stringMap writes a map as a single block followed by the empty block.
+func (w *avroWriter) stringMap(m map[string]string) {
+	if len(m) > 0 {
+		w.long(int64(len(m)))
+		for k, v := range m {
+			w.string(k)
+			w.string(v)
+		}
+	}
+	w.long(0)
+}
+
+func (w *avroWriter) stringArray(a []string) {
+	if len(a) > 0 {
+		w.long(int64(len(a)))
+		for _, s := range a {
+			w.string(s)
+		}
+	}
+	w.long(0)
+}
+
+// avroReader reads values in the Avro binary encoding. The first error
+// sticks and turns every later read into a zero value.
+type avroReader struct {
+	buf []byte
+	err error
+}
+
+func (r *avroReader) fail(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+func (r *avroReader) long() int64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(r.buf)
+	if n <= 0 {
+		r.fail(errAvroTruncated)
+		return 0
+	}
+	r.buf = r.buf[n:]
+	return v
+}
+
+func (r *avroReader) bytes(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || n > len(r.buf) {
+		r.fail(errAvroTruncated)
+		return nil
+	}
+	b := r.buf[:n]
+	r.buf = r.buf[n:]
+	return b
+}
+
+func (r *avroReader) string() string {
+	return string(r.bytes(int(r.long())))
+}
+
+func (r *avroReader) double() float64 {
+	b := r.bytes(8)
+	if b == nil {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(b))
+}
+
+func (r *avroReader) boolean() bool {
+	b := r.bytes(1)
+	return b != nil && b[0] == 1
+}
+
+func (r *avroReader) timestamp() time.Time {
+	return time.UnixMicro(r.long()).UTC()
+}
+
+func (r *avroReader) optionalTimestamp() *time.Time {
+	switch branch := r.long(); branch {
+	case 0:
+		return nil
+	case 1:
+		t := r.timestamp()
+		return &t
+	default:
+		r.fail(fmt.Errorf("avro: invalid union branch %d", branch))
+		return nil
+	}
+}
+
+// blockCount reads the item count of the next map or array block. A
+// negative count is followed by the block's size in bytes, which is skipped.
+func (r *avroReader) blockCount() int {
+	n := r.long()
+	if n < 0 {
+		r.long()
+		n = -n
+	}
+	if n > int64(len(r.buf)) {
+		// Every item takes at least one byte
+		r.fail(errAvroTruncated)
+		return 0
+	}
+	return int(n)
+}
+
+func (r *avroReader) stringMap() map[string]string {
+	var m map[string]string
+	for n := r.blockCount(); n > 0 && r.err == nil; n = r.blockCount() {
+		if m == nil {
+			m = make(map[string]string, n)
+		}
+		for range n {
+			k := r.string()
+			m[k] = r.string()
+		}
+	}
+	return m
+}
+
+func (r *avroReader) stringArray() []string {
+	var a []string
+	for n := r.blockCount(); n > 0 && r.err == nil; n = r.blockCount() {
+		for range n {
+			a = append(a, r.string())
+		}
+	}
+	return a
+}
@@ -0,0 +1,192 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrSubscriberTooSlow ends a subscription whose buffer filled up
+	// because its consumer did not keep up with the event feed.
+	ErrSubscriberTooSlow = errors.New("subscriber fell behind the event feed")
+	// ErrBrokerStopped ends every subscription once the broker stops.
+	ErrBrokerStopped = errors.New("event broker stopped")
+)
+
+// EventSource yields the order events a Broker fans out, blocking until the
+// next one arrives, with their Partition and Offset set. Events that cannot
+// be decoded are skipped by the source.
+type EventSource interface {
+	ReadEvent(ctx context.Context) (OrderEvent, error)
+	Close() error
+}
+
+// Broker reads order events from a single source and fans each one out to
+// every subscriber, so streaming clients share one consumer instead of
+// starting their own.
+//
+// Each subscriber has a bounded buffer. The broker never waits for a
+// subscriber: one whose buffer is full is dropped with ErrSubscriberTooSlow,
+// so a stalled client cannot hold back the others or grow memory without
+// bound. Subscribers only receive events read after they subscribed.
+type Broker struct {
+	source EventSource
+	buffer int
+
+	mu      sync.Mutex
+	subs    map[*Subscription]struct{}
+	stopped bool
+	// position is how far the source has been read
+	position Position
+}
+
+// NewBroker creates a broker over source that buffers up to buffer events
+// per subscriber. Run starts reading.
+func NewBroker(source EventSource, buffer int) *Broker {
+	if buffer < 1 {
+		buffer = 1
+	}
+	return &Broker{
+		source: source,
+		buffer: buffer,
+		subs:   make(map[*Subscription]struct{}),
+
+		position: Position{},
+	}
+}
+
+// Subscription receives the events of a Broker until it is closed.
+type Subscription struct {
+	broker *Broker
+	events chan OrderEvent
+	// start is the broker's position when the subscription began
+	start Position
+	// err is why the broker ended the subscription; guarded by broker.mu
+	err error
+}
+
+// Subscribe registers a new subscriber. The caller must Close it when done.
+func (b *Broker) Subscribe() *Subscription {
+	s := &Subscription{
+		broker: b,
+		events: make(chan OrderEvent, b.buffer),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s.start = b.position.Clone()
+	if b.stopped {
+		s.err = ErrBrokerStopped
+		close(s.events)
+		return s
+	}
+	b.subs[s] = struct{}{}
+	return s
+}
+
+// Subscribers returns the number of active subscriptions.
+func (b *Broker) Subscribers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subs)
+}
+
+// Start returns the broker's position when s began: s receives every event
+// the position does not include, in partitions the broker had read by then.
+func (s *Subscription) Start() Position {
+	return s.start.Clone()
+}
+
+// Events returns the channel events are delivered on. It is closed when the
+// subscription ends; Err then reports why.
+func (s *Subscription) Events() <-chan OrderEvent {
+	return s.events
+}
+
+// Err returns ErrSubscriberTooSlow or ErrBrokerStopped once the broker has
+// ended the subscription, and nil otherwise.
+func (s *Subscription) Err() error {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	return s.err
+}
+
+// Close unsubscribes. It is safe to call more than once and after the
+// broker has ended the subscription.
+func (s *Subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	s.broker.remove(s, nil)
+}
+
+// remove ends a subscription still registered, recording cause. The caller
+// holds b.mu.
+func (b *Broker) remove(s *Subscription, cause error) {
+	if _, ok := b.subs[s]; !ok {
+		return
+	}
+	delete(b.subs, s)
+	s.err = cause
+	close(s.events)
+}
+
+// Run reads events and fans them out until ctx is cancelled, then closes
+// the source and the broker. A read error is logged and the read retried
+// after a second.
+func (b *Broker) Run(ctx context.Context) {
+	defer func() {
+		if err := b.source.Close(); err != nil {
+			slog.Warn("failed to close event broker source", slog.String("error", err.Error()))
+		}
+		b.Close()
+	}()
+
+	for {
+		evt, err := b.source.ReadEvent(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			slog.Warn("event broker failed to read event", slog.String("error", err.Error()))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+		b.publish(evt)
+	}
+}
+
+// publish delivers evt to every subscriber with room in its buffer and
+// drops the rest.
+func (b *Broker) publish(evt OrderEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.position.Advance(evt.Topic, evt.Partition, evt.Offset)
+	for s := range b.subs {
+		select {
+		case s.events <- evt:
+		default:
+			b.remove(s, ErrSubscriberTooSlow)
+			slog.Warn("event broker dropped a slow subscriber", slog.Int("buffer", b.buffer))
+		}
+	}
+}
+
+// Close ends every subscription with ErrBrokerStopped and refuses new ones,
+// so streams end before a graceful server stop waits for them. Events read
+// afterwards are discarded.
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.stopped = true
+	for s := range b.subs {
+		b.remove(s, ErrBrokerStopped)
+	}
+}
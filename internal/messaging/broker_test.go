@@ -0,0 +1,157 @@
+package messaging
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// chanSource is an EventSource fed through a channel.
+type chanSource struct {
+	events chan OrderEvent
+	mu     sync.Mutex
+	closed bool
+}
+
+func newChanSource() *chanSource {
+	return &chanSource{events: make(chan OrderEvent)}
+}
+
+func (s *chanSource) ReadEvent(ctx context.Context) (OrderEvent, error) {
+	select {
+	case <-ctx.Done():
+		return OrderEvent{}, ctx.Err()
+	case evt := <-s.events:
+		return evt, nil
+	}
+}
+
+func (s *chanSource) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	return nil
+}
+
+// startBroker runs b until the test ends and returns a func that stops it
+// and waits for Run to return.
+func startBroker(t *testing.T, b *Broker) func() {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		b.Run(ctx)
+	}()
+	stop := func() {
+		cancel()
+		<-done
+	}
+	t.Cleanup(stop)
+	return stop
+}
+
+func receive(t *testing.T, sub *Subscription) OrderEvent {
+	t.Helper()
+	select {
+	case evt, ok := <-sub.Events():
+		require.True(t, ok, "subscription ended: %v", sub.Err())
+		return evt
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+		return OrderEvent{}
+	}
+}
+
+func TestBroker_Publish_FansOutToEverySubscriber(t *testing.T) {
+	source := newChanSource()
+	b := NewBroker(source, 4)
+	startBroker(t, b)
+
+	first, second := b.Subscribe(), b.Subscribe()
+	defer first.Close()
+	defer second.Close()
+
+	source.events <- OrderEvent{EventType: EventOrderCreated, OrderID: "o-1"}
+
+	assert.Equal(t, "o-1", receive(t, first).OrderID)
+	assert.Equal(t, "o-1", receive(t, second).OrderID)
+	assert.Equal(t, 2, b.Subscribers())
+}
+
+func TestBroker_Publish_DropsSlowSubscriber(t *testing.T) {
+	source := newChanSource()
+	b := NewBroker(source, 1)
+	startBroker(t, b)
+
+	slow, fast := b.Subscribe(), b.Subscribe()
+	defer fast.Close()
+
+	source.events <- OrderEvent{OrderID: "o-1"}
+	assert.Equal(t, "o-1", receive(t, fast).OrderID)
+	source.events <- OrderEvent{OrderID: "o-2"}
+	assert.Equal(t, "o-2", receive(t, fast).OrderID, "a full subscriber does not hold back the others")
+
+	evt, ok := <-slow.Events()
+	require.True(t, ok)
+	assert.Equal(t, "o-1", evt.OrderID, "buffered events are still delivered")
+	_, ok = <-slow.Events()
+	assert.False(t, ok)
+	assert.ErrorIs(t, slow.Err(), ErrSubscriberTooSlow)
+	assert.Equal(t, 1, b.Subscribers())
+}
+
+func TestSubscription_Close_Unsubscribes(t *testing.T) {
+	b := NewBroker(newChanSource(), 1)
+
+	sub := b.Subscribe()
+	sub.Close()
+	sub.Close()
+
+	_, ok := <-sub.Events()
+	assert.False(t, ok)
+	assert.NoError(t, sub.Err())
+	assert.Equal(t, 0, b.Subscribers())
+}
+
+func TestBroker_Run_ContextCancelled_EndsSubscriptionsAndClosesSource(t *testing.T) {
+	source := newChanSource()
+	b := NewBroker(source, 1)
+	stop := startBroker(t, b)
+	sub := b.Subscribe()
+
+	stop()
+
+	_, ok := <-sub.Events()
+	assert.False(t, ok)
+	assert.ErrorIs(t, sub.Err(), ErrBrokerStopped)
+	assert.True(t, source.closed)
+
+	late := b.Subscribe()
+	_, ok = <-late.Events()
+	assert.False(t, ok, "a stopped broker refuses new subscribers")
+	assert.ErrorIs(t, late.Err(), ErrBrokerStopped)
+}
+
+func TestBroker_Subscribe_StartsAtBrokerPosition(t *testing.T) {
+	source := newChanSource()
+	b := NewBroker(source, 4)
+	startBroker(t, b)
+
+	first := b.Subscribe()
+	defer first.Close()
+	assert.Empty(t, first.Start())
+
+	source.events <- OrderEvent{OrderID: "o-1", Partition: 0, Offset: 7}
+	receive(t, first)
+	source.events <- OrderEvent{OrderID: "o-2", Partition: 1, Offset: 3}
+	receive(t, first)
+
+	second := b.Subscribe()
+	defer second.Close()
+	assert.Equal(t, Position{{"", 0}: 8, {"", 1}: 4}, second.Start())
+}
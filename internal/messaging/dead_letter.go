@@ -0,0 +1,142 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrDeadLetterNotFound is returned when a dead letter does not exist.
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// DeadLetter is an encoded event that could not be delivered to the broker.
+// It keeps the exact wire payload so redelivery is byte-for-byte identical.
+type DeadLetter struct {
+	ID            uuid.UUID
+	Topic         string
+	Key           string
+	Payload       []byte
+	Headers       map[string]string
+	EventType     string
+	OrderID       string
+	LastError     string
+	Attempts      int
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	NextAttemptAt time.Time
+}
+
+// DeadLetterStore persists undeliverable events.
+type DeadLetterStore interface {
+	// Save stores a new dead letter. ID and timestamps are set if empty.
+	Save(ctx context.Context, dl *DeadLetter) error
+
+	// ListDue returns dead letters whose next attempt is at or before now and
+	// that have been attempted fewer than maxAttempts times.
+	ListDue(ctx context.Context, now time.Time, maxAttempts, limit int) ([]*DeadLetter, error)
+
+	// List returns dead letters ordered by creation time, newest first.
+	List(ctx context.Context, limit, offset int) ([]*DeadLetter, int64, error)
+
+	// MarkFailed records a failed redelivery attempt.
+	MarkFailed(ctx context.Context, id string, lastErr string, nextAttemptAt time.Time) error
+
+	// Requeue resets the attempt counter so the retrier picks the letter up again.
+	// Returns ErrDeadLetterNotFound if the letter does not exist.
+	Requeue(ctx context.Context, id string) error
+
+	// Delete removes a dead letter after successful redelivery.
+	Delete(ctx context.Context, id string) error
+}
+
+// Redeliverer re-sends a previously encoded event to the broker.
+type Redeliverer interface {
+	Redeliver(ctx context.Context, dl *DeadLetter) error
+}
+
+// DeadLetterRetrier periodically redelivers due dead letters with exponential backoff.
+type DeadLetterRetrier struct {
+	store       DeadLetterStore
+	sender      Redeliverer
+	interval    time.Duration
+	maxAttempts int
+	batchSize   int
+	now         func() time.Time
+}
+
+const (
+	defaultRetryBatchSize = 100
+	maxRetryBackoff       = time.Hour
+)
+
+// NewDeadLetterRetrier creates a retrier that polls the store every interval.
+func NewDeadLetterRetrier(store DeadLetterStore, sender Redeliverer, interval time.Duration, maxAttempts int) *DeadLetterRetrier {
+	return &DeadLetterRetrier{
+		store:       store,
+		sender:      sender,
+		interval:    interval,
+		maxAttempts: maxAttempts,
+		batchSize:   defaultRetryBatchSize,
+		now:         time.Now,
+	}
+}
+
+// Run polls for due dead letters until ctx is cancelled.
+func (r *DeadLetterRetrier) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := r.RetryDue(ctx); err != nil && ctx.Err() == nil {
+				slog.Warn("dead-letter retry pass failed", slog.String("error", err.Error()))
+			}
+		}
+	}
+}
+
+// RetryDue redelivers one batch of due dead letters and returns how many succeeded.
+func (r *DeadLetterRetrier) RetryDue(ctx context.Context) (int, error) {
+	due, err := r.store.ListDue(ctx, r.now(), r.maxAttempts, r.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	for _, dl := range due {
+		if err := r.sender.Redeliver(ctx, dl); err != nil {
+			next := r.now().Add(r.backoff(dl.Attempts + 1))
+			if markErr := r.store.MarkFailed(ctx, dl.ID.String(), err.Error(), next); markErr != nil {
+				return delivered, markErr
+			}
+			continue
+		}
+		if err := r.store.Delete(ctx, dl.ID.String()); err != nil {
+			return delivered, err
+		}
+		delivered++
+	}
+
+	if delivered > 0 {
+		slog.Info("redelivered dead-lettered events", slog.Int("count", delivered))
+	}
+	return delivered, nil
+}
+
+// backoff doubles the base interval per attempt, capped at maxRetryBackoff.
+func (r *DeadLetterRetrier) backoff(attempts int) time.Duration {
+	d := r.interval
+	for i := 1; i < attempts; i++ {
+		d *= 2
+		if d >= maxRetryBackoff {
+			return maxRetryBackoff
+		}
+	}
+	return d
+}
@@ -0,0 +1,138 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryDeadLetterStore is an in-memory DeadLetterStore for retrier tests.
+type memoryDeadLetterStore struct {
+	mu      sync.Mutex
+	letters map[string]*DeadLetter
+}
+
+func newMemoryDeadLetterStore(letters ...*DeadLetter) *memoryDeadLetterStore {
+	s := &memoryDeadLetterStore{letters: map[string]*DeadLetter{}}
+	for _, dl := range letters {
+		s.letters[dl.ID.String()] = dl
+	}
+	return s
+}
+
+func (s *memoryDeadLetterStore) Save(_ context.Context, dl *DeadLetter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.letters[dl.ID.String()] = dl
+	return nil
+}
+
+func (s *memoryDeadLetterStore) ListDue(_ context.Context, now time.Time, maxAttempts, _ int) ([]*DeadLetter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var due []*DeadLetter
+	for _, dl := range s.letters {
+		if !dl.NextAttemptAt.After(now) && dl.Attempts < maxAttempts {
+			due = append(due, dl)
+		}
+	}
+	return due, nil
+}
+
+func (s *memoryDeadLetterStore) List(_ context.Context, _, _ int) ([]*DeadLetter, int64, error) {
+	return nil, 0, nil
+}
+
+func (s *memoryDeadLetterStore) MarkFailed(_ context.Context, id string, lastErr string, next time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	dl := s.letters[id]
+	dl.Attempts++
+	dl.LastError = lastErr
+	dl.NextAttemptAt = next
+	return nil
+}
+
+func (s *memoryDeadLetterStore) Requeue(_ context.Context, _ string) error { return nil }
+
+func (s *memoryDeadLetterStore) Delete(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.letters, id)
+	return nil
+}
+
+type redelivererFunc func(ctx context.Context, dl *DeadLetter) error
+
+func (f redelivererFunc) Redeliver(ctx context.Context, dl *DeadLetter) error { return f(ctx, dl) }
+
+func newDueLetter(attempts int) *DeadLetter {
+	return &DeadLetter{ID: uuid.New(), Key: "order-1", Payload: []byte(`{}`), Attempts: attempts}
+}
+
+func TestDeadLetterRetrier_RetryDue_Success_DeletesLetter(t *testing.T) {
+	dl := newDueLetter(0)
+	store := newMemoryDeadLetterStore(dl)
+	var sent []string
+	sender := redelivererFunc(func(_ context.Context, dl *DeadLetter) error {
+		sent = append(sent, dl.Key)
+		return nil
+	})
+
+	retrier := NewDeadLetterRetrier(store, sender, time.Second, 5)
+	delivered, err := retrier.RetryDue(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+	assert.Equal(t, []string{"order-1"}, sent)
+	assert.Empty(t, store.letters)
+}
+
+func TestDeadLetterRetrier_RetryDue_Failure_SchedulesBackoff(t *testing.T) {
+	dl := newDueLetter(2)
+	store := newMemoryDeadLetterStore(dl)
+	sender := redelivererFunc(func(_ context.Context, _ *DeadLetter) error {
+		return errors.New("broker unavailable")
+	})
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	retrier := NewDeadLetterRetrier(store, sender, time.Second, 5)
+	retrier.now = func() time.Time { return now }
+	delivered, err := retrier.RetryDue(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, delivered)
+	assert.Equal(t, 3, dl.Attempts)
+	assert.Equal(t, "broker unavailable", dl.LastError)
+	assert.Equal(t, now.Add(4*time.Second), dl.NextAttemptAt, "third attempt waits 2^2 intervals")
+}
+
+func TestDeadLetterRetrier_RetryDue_ExhaustedLetter_Skipped(t *testing.T) {
+	dl := newDueLetter(5)
+	store := newMemoryDeadLetterStore(dl)
+	sender := redelivererFunc(func(_ context.Context, _ *DeadLetter) error {
+		t.Fatal("exhausted letter must not be redelivered")
+		return nil
+	})
+
+	retrier := NewDeadLetterRetrier(store, sender, time.Second, 5)
+	delivered, err := retrier.RetryDue(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, delivered)
+	assert.Len(t, store.letters, 1)
+}
+
+func TestDeadLetterRetrier_Backoff_CappedAtMax(t *testing.T) {
+	retrier := NewDeadLetterRetrier(nil, nil, time.Minute, 100)
+
+	assert.Equal(t, time.Minute, retrier.backoff(1))
+	assert.Equal(t, 2*time.Minute, retrier.backoff(2))
+	assert.Equal(t, maxRetryBackoff, retrier.backoff(30))
+}
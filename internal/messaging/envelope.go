@@ -0,0 +1,182 @@
+package messaging
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventFormat selects the wire format used for outbound order events.
+type EventFormat string
+
+// Supported event wire formats.
+const (
+	// EventFormatCloudEvents wraps OrderEvent in a CloudEvents 1.0 structured envelope.
+	EventFormatCloudEvents EventFormat = "cloudevents"
+	// EventFormatLegacy emits the bare OrderEvent JSON used before envelopes were introduced.
+	EventFormatLegacy EventFormat = "legacy"
+	// EventFormatAvro encodes OrderEvent as Avro, framed for the schema registry.
+	EventFormatAvro EventFormat = "avro"
+	// EventFormatProtobuf encodes OrderEvent as protobuf, framed for the schema registry.
+	EventFormatProtobuf EventFormat = "protobuf"
+)
+
+// UsesSchemaRegistry reports whether events in format f are encoded by a
+// SchemaCodec rather than EncodeOrderEvent.
+func (f EventFormat) UsesSchemaRegistry() bool {
+	return f == EventFormatAvro || f == EventFormatProtobuf
+}
+
+// CloudEvents envelope constants.
+const (
+	CloudEventsSpecVersion = "1.0"
+	CloudEventsContentType = "application/cloudevents+json"
+	DefaultEventSource     = "/ordersvc"
+
+	// OrderEventSchemaVersion is the version of the OrderEvent payload schema.
+	// Bump it whenever a field is removed or changes meaning.
+	OrderEventSchemaVersion = "1.0"
+)
+
+// ErrUnsupportedSchemaVersion is returned when an envelope carries a payload
+// schema version this build does not understand.
+var ErrUnsupportedSchemaVersion = errors.New("unsupported event schema version")
+
+// CloudEvent is a CloudEvents 1.0 structured-mode envelope carrying an OrderEvent.
+// SchemaVersion is an extension attribute identifying the payload schema.
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	SchemaVersion   string          `json:"schemaversion"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// ParseEventFormat converts a config string into an EventFormat.
+// An empty string selects CloudEvents.
+func ParseEventFormat(s string) (EventFormat, error) {
+	switch EventFormat(s) {
+	case "", EventFormatCloudEvents:
+		return EventFormatCloudEvents, nil
+	case EventFormatLegacy, EventFormatAvro, EventFormatProtobuf:
+		return EventFormat(s), nil
+	default:
+		return "", fmt.Errorf("unknown event format %q", s)
+	}
+}
+
+// EncodeOrderEvent serializes evt in the requested format. source is used as
+// the CloudEvents source attribute and defaults to DefaultEventSource.
+// The schema registry formats need a SchemaCodec instead.
+func EncodeOrderEvent(evt OrderEvent, format EventFormat, source string) ([]byte, error) {
+	if format == EventFormatLegacy {
+		return json.Marshal(evt)
+	}
+	if format.UsesSchemaRegistry() {
+		return nil, fmt.Errorf("event format %q needs a schema registry codec", format)
+	}
+
+	data, err := json.Marshal(evt)
+	if err != nil {
+		return nil, err
+	}
+	if source == "" {
+		source = DefaultEventSource
+	}
+	// A stable ID lets consumers drop redelivered envelopes
+	id := evt.EventID
+	if id == "" {
+		id = uuid.New().String()
+	}
+	return json.Marshal(CloudEvent{
+		SpecVersion:     CloudEventsSpecVersion,
+		ID:              id,
+		Source:          source,
+		Type:            evt.EventType,
+		Subject:         evt.Key(),
+		Time:            evt.OccurredAt,
+		DataContentType: "application/json",
+		SchemaVersion:   OrderEventSchemaVersion,
+		Data:            data,
+	})
+}
+
+// DecodeOrderEvent parses a message produced in either the CloudEvents or the
+// legacy format. Consumers should use it instead of unmarshalling directly so
+// they keep working while producers migrate between formats. Messages in the
+// schema registry formats need SchemaCodec.Decode.
+func DecodeOrderEvent(data []byte) (OrderEvent, error) {
+	if len(data) > 0 && data[0] == wireMagicByte {
+		return OrderEvent{}, errSchemaFramed
+	}
+	var probe struct {
+		SpecVersion string `json:"specversion"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return OrderEvent{}, err
+	}
+
+	if probe.SpecVersion == "" {
+		var evt OrderEvent
+		if err := json.Unmarshal(data, &evt); err != nil {
+			return OrderEvent{}, err
+		}
+		return evt, nil
+	}
+
+	ce, err := DecodeCloudEvent(data)
+	if err != nil {
+		return OrderEvent{}, err
+	}
+	return ce.OrderEvent()
+}
+
+// DecodeCloudEvent parses a CloudEvents structured-mode envelope without
+// interpreting its payload.
+func DecodeCloudEvent(data []byte) (CloudEvent, error) {
+	var ce CloudEvent
+	if err := json.Unmarshal(data, &ce); err != nil {
+		return CloudEvent{}, err
+	}
+	if ce.SpecVersion != CloudEventsSpecVersion {
+		return CloudEvent{}, fmt.Errorf("unsupported cloudevents specversion %q", ce.SpecVersion)
+	}
+	return ce, nil
+}
+
+// OrderEvent extracts the OrderEvent payload, rejecting unknown major schema versions.
+func (ce CloudEvent) OrderEvent() (OrderEvent, error) {
+	if !compatibleSchemaVersion(ce.SchemaVersion) {
+		return OrderEvent{}, fmt.Errorf("%w: %q", ErrUnsupportedSchemaVersion, ce.SchemaVersion)
+	}
+	var evt OrderEvent
+	if err := json.Unmarshal(ce.Data, &evt); err != nil {
+		return OrderEvent{}, err
+	}
+	if evt.EventType == "" {
+		evt.EventType = ce.Type
+	}
+	if evt.EventID == "" {
+		evt.EventID = ce.ID
+	}
+	return evt, nil
+}
+
+// compatibleSchemaVersion reports whether v shares a major version with
+// OrderEventSchemaVersion. Minor versions only add optional fields.
+func compatibleSchemaVersion(v string) bool {
+	return majorVersion(v) == majorVersion(OrderEventSchemaVersion)
+}
+
+func majorVersion(v string) string {
+	major, _, _ := strings.Cut(v, ".")
+	return major
+}
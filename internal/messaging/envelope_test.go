@@ -0,0 +1,138 @@
+package messaging
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEvent() OrderEvent {
+	return OrderEvent{
+		EventType:  EventOrderStatusChanged,
+		OrderID:    "order-1",
+		CustomerID: "cust-1",
+		Status:     "confirmed",
+		OldStatus:  "pending",
+		NewStatus:  "confirmed",
+		Total:      42.5,
+		Version:    2,
+		OccurredAt: time.Now().UTC().Truncate(time.Millisecond),
+	}
+}
+
+func TestDecodeOrderEvent_BothFormats_ReturnsSameEvent(t *testing.T) {
+	tests := []struct {
+		name   string
+		format EventFormat
+	}{
+		{name: "cloudevents", format: EventFormatCloudEvents},
+		{name: "legacy", format: EventFormatLegacy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := newTestEvent()
+			data, err := EncodeOrderEvent(want, tt.format, "")
+			require.NoError(t, err)
+
+			got, err := DecodeOrderEvent(data)
+			require.NoError(t, err)
+			assert.Equal(t, want.EventType, got.EventType)
+			assert.Equal(t, want.OrderID, got.OrderID)
+			assert.Equal(t, want.OldStatus, got.OldStatus)
+			assert.Equal(t, want.NewStatus, got.NewStatus)
+			assert.Equal(t, want.Total, got.Total)
+			assert.Equal(t, want.Version, got.Version)
+			assert.True(t, want.OccurredAt.Equal(got.OccurredAt))
+		})
+	}
+}
+
+func TestDecodeOrderEvent_UnknownMajorSchemaVersion_ReturnsError(t *testing.T) {
+	data, err := EncodeOrderEvent(newTestEvent(), EventFormatCloudEvents, "")
+	require.NoError(t, err)
+
+	var ce CloudEvent
+	require.NoError(t, json.Unmarshal(data, &ce))
+	ce.SchemaVersion = "2.0"
+	data, err = json.Marshal(ce)
+	require.NoError(t, err)
+
+	_, err = DecodeOrderEvent(data)
+	assert.ErrorIs(t, err, ErrUnsupportedSchemaVersion)
+}
+
+func TestDecodeOrderEvent_NewerMinorSchemaVersion_Accepted(t *testing.T) {
+	data, err := EncodeOrderEvent(newTestEvent(), EventFormatCloudEvents, "")
+	require.NoError(t, err)
+
+	var ce CloudEvent
+	require.NoError(t, json.Unmarshal(data, &ce))
+	ce.SchemaVersion = "1.7"
+	data, err = json.Marshal(ce)
+	require.NoError(t, err)
+
+	got, err := DecodeOrderEvent(data)
+	require.NoError(t, err)
+	assert.Equal(t, "order-1", got.OrderID)
+}
+
+func TestEncodeOrderEvent_CloudEventIDIsEventID(t *testing.T) {
+	evt := newTestEvent()
+	evt.EventID = "3f0c0c8e-5d0b-5b8a-9a43-0d7f5b3b9f11"
+
+	data, err := EncodeOrderEvent(evt, EventFormatCloudEvents, "")
+	require.NoError(t, err)
+
+	ce, err := DecodeCloudEvent(data)
+	require.NoError(t, err)
+	assert.Equal(t, evt.EventID, ce.ID)
+}
+
+func TestDecodeOrderEvent_NoEventID_UsesCloudEventID(t *testing.T) {
+	data, err := EncodeOrderEvent(newTestEvent(), EventFormatCloudEvents, "")
+	require.NoError(t, err)
+	ce, err := DecodeCloudEvent(data)
+	require.NoError(t, err)
+
+	evt, err := DecodeOrderEvent(data)
+
+	require.NoError(t, err)
+	assert.Equal(t, ce.ID, evt.EventID, "events from older producers still get a stable ID")
+}
+
+func TestParseEventFormat_Values(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    EventFormat
+		wantErr bool
+	}{
+		{in: "", want: EventFormatCloudEvents},
+		{in: "cloudevents", want: EventFormatCloudEvents},
+		{in: "legacy", want: EventFormatLegacy},
+		{in: "avro", want: EventFormatAvro},
+		{in: "protobuf", want: EventFormatProtobuf},
+		{in: "xml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseEventFormat(tt.in)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEncodeOrderEvent_SchemaRegistryFormat_ReturnsError(t *testing.T) {
+	_, err := EncodeOrderEvent(newTestEvent(), EventFormatAvro, "")
+
+	assert.Error(t, err, "avro needs a SchemaCodec with a registered schema ID")
+}
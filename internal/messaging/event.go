@@ -1,17 +1,56 @@
 // Package messaging defines event types for order domain events.
 package messaging
 
-import "time"
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
 
 // Event type constants for order domain events.
 const (
 	EventOrderCreated       = "order.created"
 	EventOrderUpdated       = "order.updated"
 	EventOrderStatusChanged = "order.status_changed"
+	EventOrderRestored      = "order.restored"
+	EventOrderDeleted       = "order.deleted"
+	EventOrderSLABreached   = "order.sla_breached"
+
+	EventCustomerDataErased = "customer.data_erased"
 )
 
+// EventTypes returns every event type the service publishes.
+func EventTypes() []string {
+	return []string{
+		EventOrderCreated, EventOrderUpdated, EventOrderStatusChanged, EventOrderRestored, EventOrderDeleted,
+		EventOrderSLABreached, EventCustomerDataErased,
+	}
+}
+
+// IsOrderEventType reports whether t is one of the order-scoped event types.
+func IsOrderEventType(t string) bool {
+	switch t {
+	case EventOrderCreated, EventOrderUpdated, EventOrderStatusChanged, EventOrderRestored, EventOrderDeleted,
+		EventOrderSLABreached:
+		return true
+	}
+	return false
+}
+
 // OrderEvent is the Kafka message envelope for order domain events.
+//
+// EventID and Version sequence the events of an order for consumers (see
+// SequenceTracker). Version is the order's version after the change, so it
+// grows by one per change; a change may publish more than one event at the
+// same version, e.g. order.updated and order.status_changed.
 type OrderEvent struct {
+	// EventID identifies the change an event reports. It is derived from the
+	// order, version and event type, so a redelivered or replayed event keeps
+	// the ID of the original.
+	EventID    string    `json:"event_id,omitempty"`
 	EventType  string    `json:"event_type"`
 	OrderID    string    `json:"order_id"`
 	CustomerID string    `json:"customer_id"`
@@ -21,4 +60,140 @@ type OrderEvent struct {
 	Total      float64   `json:"total"`
 	Version    int       `json:"version"`
 	OccurredAt time.Time `json:"occurred_at"`
+	// OrderCount is set on customer-scoped events such as customer.data_erased
+	OrderCount int `json:"order_count,omitempty"`
+	// CorrelationID is the request ID of the API call that caused the event
+	CorrelationID string `json:"correlation_id,omitempty"`
+	// TenantID is the tenant the order belongs to; empty in a deployment
+	// without tenants
+	TenantID string `json:"tenant_id,omitempty"`
+	// HoldReason and HoldReleaseAt are set when an order is put on hold
+	HoldReason    string     `json:"hold_reason,omitempty"`
+	HoldReleaseAt *time.Time `json:"hold_release_at,omitempty"`
+	// Metadata and Tags mirror the order's labels
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Tags     []string          `json:"tags,omitempty"`
+	// EstimatedDeliveryAt is set once the order is confirmed, and
+	// SLABreachedAt once it is flagged as overdue
+	EstimatedDeliveryAt *time.Time `json:"estimated_delivery_at,omitempty"`
+	SLABreachedAt       *time.Time `json:"sla_breached_at,omitempty"`
+	// Replayed marks an event re-sent from order history by an operator
+	// rather than published when the change happened
+	Replayed bool `json:"replayed,omitempty"`
+	// Actor, ActorType and Channel say who made a status change and how it
+	// reached the service, as recorded in the order history. They are set
+	// on order.status_changed events.
+	Actor     string `json:"actor,omitempty"`
+	ActorType string `json:"actor_type,omitempty"`
+	Channel   string `json:"channel,omitempty"`
+
+	// Topic, Partition and Offset locate a consumed event. Topic is empty
+	// for the main topic and names the topic otherwise (see TopicRouter).
+	// Event sources set them; they are never published.
+	Topic     string `json:"-"`
+	Partition int    `json:"-"`
+	Offset    int64  `json:"-"`
+}
+
+// Key is the partitioning and ordering key: the order ID, or the customer ID
+// for customer-scoped events that carry no order.
+func (e OrderEvent) Key() string {
+	if e.OrderID != "" {
+		return e.OrderID
+	}
+	return e.CustomerID
+}
+
+// eventIDNamespace is the UUID namespace event IDs are derived in
+var eventIDNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("urn:ordersvc:event"))
+
+// NewEventID derives the ID of the event of eventType reporting the change of
+// aggregate, an order or customer ID, identified by seq.
+func NewEventID(aggregate, seq, eventType string) string {
+	return uuid.NewSHA1(eventIDNamespace, []byte(aggregate+"/"+seq+"/"+eventType)).String()
+}
+
+// NewOrderEvent builds an event of the given type from the order's current state.
+func NewOrderEvent(eventType string, order *domain.Order) OrderEvent {
+	return OrderEvent{
+		EventID:    NewEventID(order.ID.String(), strconv.Itoa(order.Version), eventType),
+		EventType:  eventType,
+		OrderID:    order.ID.String(),
+		CustomerID: order.CustomerID,
+		TenantID:   order.TenantID,
+		Status:     string(order.Status),
+		Total:      order.Total,
+		Version:    order.Version,
+		OccurredAt: time.Now(),
+		Metadata:   order.Metadata,
+		Tags:       order.Tags,
+
+		EstimatedDeliveryAt: order.EstimatedDeliveryAt,
+		SLABreachedAt:       order.SLABreachedAt,
+	}
+}
+
+// NewOrderStatusChangedEvent builds an order.status_changed event, with the
+// actor and channel of the change taken from ctx.
+func NewOrderStatusChangedEvent(ctx context.Context, order *domain.Order, oldStatus, newStatus domain.OrderStatus) OrderEvent {
+	evt := NewOrderEvent(EventOrderStatusChanged, order)
+	evt.OldStatus = string(oldStatus)
+	evt.NewStatus = string(newStatus)
+	evt.Actor = domain.ActorFromContext(ctx)
+	evt.ActorType = string(domain.ActorTypeFromContext(ctx))
+	evt.Channel = string(domain.ChannelFromContext(ctx))
+	if newStatus == domain.OrderStatusOnHold && order.Hold != nil {
+		evt.HoldReason = order.Hold.Reason
+		evt.HoldReleaseAt = order.Hold.ReleaseAt
+	}
+	return evt
+}
+
+// NewCustomerDataErasedEvent builds a customer.data_erased event. It carries
+// the original customer ID so downstream systems can erase their own copies.
+func NewCustomerDataErasedEvent(erasure *domain.CustomerErasure) OrderEvent {
+	return OrderEvent{
+		EventID:    NewEventID(erasure.CustomerID, strconv.FormatInt(erasure.ErasedAt.UnixNano(), 10), EventCustomerDataErased),
+		EventType:  EventCustomerDataErased,
+		CustomerID: erasure.CustomerID,
+		TenantID:   erasure.TenantID,
+		OrderCount: erasure.OrderCount,
+		OccurredAt: erasure.ErasedAt,
+	}
+}
+
+// NewHistoryEvent rebuilds the event published for a recorded order
+// mutation, dated when the mutation happened and marked as replayed. It
+// returns false for erasures, whose customer-scoped event cannot be rebuilt
+// from the anonymized snapshot.
+func NewHistoryEvent(entry *domain.OrderHistoryEntry) (OrderEvent, bool) {
+	var evt OrderEvent
+	switch entry.Action {
+	case domain.HistoryActionCreated:
+		evt = NewOrderEvent(EventOrderCreated, entry.NewState)
+	case domain.HistoryActionStatusChanged, domain.HistoryActionHeld, domain.HistoryActionReleased:
+		var oldStatus domain.OrderStatus
+		if entry.OldState != nil {
+			oldStatus = entry.OldState.Status
+		}
+		evt = NewOrderStatusChangedEvent(context.Background(), entry.NewState, oldStatus, entry.NewState.Status)
+		evt.Actor, evt.ActorType, evt.Channel = entry.Actor, string(entry.ActorType), string(entry.Channel)
+	case domain.HistoryActionItemsChanged, domain.HistoryActionUpdated:
+		evt = NewOrderEvent(EventOrderUpdated, entry.NewState)
+	case domain.HistoryActionDeleted:
+		evt = NewOrderEvent(EventOrderDeleted, entry.NewState)
+	case domain.HistoryActionRestored:
+		evt = NewOrderEvent(EventOrderRestored, entry.NewState)
+	default:
+		return OrderEvent{}, false
+	}
+	evt.OccurredAt = entry.CreatedAt
+	evt.Replayed = true
+	return evt, true
+}
+
+// EventSender publishes an already built event through the message broker,
+// as replays do.
+type EventSender interface {
+	SendEvent(ctx context.Context, evt OrderEvent) error
 }
@@ -0,0 +1,100 @@
+package messaging
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHistoryEvent_StatusChange_CarriesOldAndNewStatus(t *testing.T) {
+	id := uuid.New()
+	at := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	entry := &domain.OrderHistoryEntry{
+		Action:    domain.HistoryActionStatusChanged,
+		Actor:     "ops@example.com",
+		ActorType: domain.ActorTypeAPIKey,
+		Channel:   domain.ChannelHTTP,
+		OldState:  &domain.Order{ID: id, Status: domain.OrderStatusPending},
+		NewState:  &domain.Order{ID: id, Status: domain.OrderStatusConfirmed, Version: 2},
+		CreatedAt: at,
+	}
+
+	evt, ok := NewHistoryEvent(entry)
+
+	require.True(t, ok)
+	assert.Equal(t, EventOrderStatusChanged, evt.EventType)
+	assert.Equal(t, "pending", evt.OldStatus)
+	assert.Equal(t, "confirmed", evt.NewStatus)
+	assert.Equal(t, 2, evt.Version)
+	assert.Equal(t, "ops@example.com", evt.Actor)
+	assert.Equal(t, "api_key", evt.ActorType)
+	assert.Equal(t, "http", evt.Channel)
+	assert.Equal(t, at, evt.OccurredAt, "replays keep when the change happened")
+	assert.True(t, evt.Replayed)
+}
+
+func TestNewHistoryEvent_EventTypes(t *testing.T) {
+	tests := []struct {
+		action domain.HistoryAction
+		want   string
+	}{
+		{domain.HistoryActionCreated, EventOrderCreated},
+		{domain.HistoryActionItemsChanged, EventOrderUpdated},
+		{domain.HistoryActionUpdated, EventOrderUpdated},
+		{domain.HistoryActionHeld, EventOrderStatusChanged},
+		{domain.HistoryActionReleased, EventOrderStatusChanged},
+		{domain.HistoryActionDeleted, EventOrderDeleted},
+		{domain.HistoryActionRestored, EventOrderRestored},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.action), func(t *testing.T) {
+			evt, ok := NewHistoryEvent(&domain.OrderHistoryEntry{Action: tt.action, NewState: &domain.Order{ID: uuid.New()}})
+
+			require.True(t, ok)
+			assert.Equal(t, tt.want, evt.EventType)
+		})
+	}
+}
+
+func TestNewHistoryEvent_Erasure_NotReplayable(t *testing.T) {
+	_, ok := NewHistoryEvent(&domain.OrderHistoryEntry{Action: domain.HistoryActionErased, NewState: &domain.Order{}})
+
+	assert.False(t, ok)
+}
+
+func TestNewOrderEvent_EventID_IdentifiesTheChange(t *testing.T) {
+	order := &domain.Order{ID: uuid.New(), Version: 3}
+
+	created := NewOrderEvent(EventOrderUpdated, order)
+	again := NewOrderEvent(EventOrderUpdated, order)
+	statusChanged := NewOrderStatusChangedEvent(context.Background(), order, domain.OrderStatusPending, domain.OrderStatusConfirmed)
+	order.Version++
+	next := NewOrderEvent(EventOrderUpdated, order)
+
+	assert.NotEmpty(t, created.EventID)
+	assert.Equal(t, created.EventID, again.EventID, "rebuilding the event keeps its ID")
+	assert.NotEqual(t, created.EventID, statusChanged.EventID, "each event of a change has its own ID")
+	assert.NotEqual(t, created.EventID, next.EventID)
+}
+
+func TestNewOrderStatusChangedEvent_CarriesActor(t *testing.T) {
+	ctx := domain.WithChannel(domain.WithActor(context.Background(), "alice"), domain.ChannelGRPC)
+
+	evt := NewOrderStatusChangedEvent(ctx, &domain.Order{ID: uuid.New()}, domain.OrderStatusPending, domain.OrderStatusConfirmed)
+
+	assert.Equal(t, "alice", evt.Actor)
+	assert.Equal(t, "user", evt.ActorType)
+	assert.Equal(t, "grpc", evt.Channel)
+
+	evt = NewOrderStatusChangedEvent(domain.WithSystemActor(context.Background()), &domain.Order{ID: uuid.New()}, domain.OrderStatusPending, domain.OrderStatusCancelled)
+
+	assert.Equal(t, domain.ActorSystem, evt.Actor)
+	assert.Equal(t, "system", evt.ActorType)
+	assert.Equal(t, "worker", evt.Channel)
+}
@@ -0,0 +1,57 @@
+package messaging
+
+import (
+	"context"
+	"sync"
+)
+
+// InFlight counts operations in progress, such as event publishes, so a
+// shutdown can wait for them before closing what they use. The zero value
+// is ready to use.
+type InFlight struct {
+	mu sync.Mutex
+	n  int
+	// idle is closed when n drops to zero
+	idle chan struct{}
+}
+
+// Add records the start of an operation. Every Add is paired with a Done.
+func (f *InFlight) Add() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.n == 0 {
+		f.idle = make(chan struct{})
+	}
+	f.n++
+}
+
+// Done records the end of an operation.
+func (f *InFlight) Done() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.n--
+	if f.n == 0 {
+		close(f.idle)
+	}
+}
+
+// Wait blocks until no operation is in progress, or returns ctx.Err() when
+// ctx ends first. Operations may start while it waits; it returns once they
+// are all done.
+func (f *InFlight) Wait(ctx context.Context) error {
+	for {
+		f.mu.Lock()
+		if f.n == 0 {
+			f.mu.Unlock()
+			return nil
+		}
+		idle := f.idle
+		f.mu.Unlock()
+
+		select {
+		case <-idle:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
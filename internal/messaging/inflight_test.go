@@ -0,0 +1,37 @@
+package messaging
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInFlight_Wait_ReturnsWhenIdle(t *testing.T) {
+	var f InFlight
+
+	assert.NoError(t, f.Wait(context.Background()), "nothing in flight")
+
+	f.Add()
+	f.Add()
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		f.Done()
+		f.Done()
+	}()
+
+	assert.NoError(t, f.Wait(context.Background()))
+}
+
+func TestInFlight_Wait_ContextEnds(t *testing.T) {
+	var f InFlight
+	f.Add()
+	defer f.Done()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := f.Wait(ctx)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,81 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+)
+
+// messageReader abstracts kafka.Reader for testability.
+type messageReader interface {
+	ReadMessage(ctx context.Context) (kafka.Message, error)
+	io.Closer
+}
+
+// EventSource implements messaging.EventSource by consuming the order
+// event topics.
+type EventSource struct {
+	reader messageReader
+	topic  string
+	codec  *messaging.SchemaCodec
+}
+
+// NewEventSource creates an event source for one process. It joins a
+// consumer group of its own, named after groupID, so every process sees
+// every event, and it starts at the end of every topic router may publish
+// to. codec decodes the schema registry formats; nil decodes only JSON.
+func NewEventSource(brokers []string, router *messaging.TopicRouter, groupID string, codec *messaging.SchemaCodec) *EventSource {
+	return &EventSource{
+		topic: router.MainTopic(),
+		codec: codec,
+		reader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers:     brokers,
+			GroupTopics: router.Topics(),
+			GroupID:     fmt.Sprintf("%s-watch-%s", groupID, uuid.New().String()[:8]),
+			StartOffset: kafka.LastOffset,
+		}),
+	}
+}
+
+// ReadEvent returns the next event on the topics, skipping messages that
+// are not order events.
+func (s *EventSource) ReadEvent(ctx context.Context) (messaging.OrderEvent, error) {
+	for {
+		msg, err := s.reader.ReadMessage(ctx)
+		if err != nil {
+			return messaging.OrderEvent{}, err
+		}
+
+		evt, err := s.codec.Decode(ctx, msg.Value)
+		if err != nil {
+			slog.Warn("failed to decode order event",
+				slog.String("topic", msg.Topic),
+				slog.Int64("offset", msg.Offset),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		evt.Topic = eventTopic(msg.Topic, s.topic)
+		evt.Partition, evt.Offset = msg.Partition, msg.Offset
+		return evt, nil
+	}
+}
+
+// eventTopic returns the OrderEvent.Topic of a message read from topic,
+// which is empty for the main topic.
+func eventTopic(topic, mainTopic string) string {
+	if topic == mainTopic {
+		return ""
+	}
+	return topic
+}
+
+// Close closes the underlying reader.
+func (s *EventSource) Close() error {
+	return s.reader.Close()
+}
@@ -0,0 +1,82 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	kafkago "github.com/segmentio/kafka-go"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockReader returns queued messages, then err.
+type mockReader struct {
+	messages []kafkago.Message
+	err      error
+	closed   bool
+}
+
+func (m *mockReader) ReadMessage(_ context.Context) (kafkago.Message, error) {
+	if len(m.messages) == 0 {
+		return kafkago.Message{}, m.err
+	}
+	msg := m.messages[0]
+	m.messages = m.messages[1:]
+	return msg, nil
+}
+
+func (m *mockReader) Close() error {
+	m.closed = true
+	return nil
+}
+
+func TestEventSource_ReadEvent_SkipsUndecodableMessages(t *testing.T) {
+	payload, err := json.Marshal(messaging.OrderEvent{EventType: messaging.EventOrderCreated, OrderID: "o-1"})
+	require.NoError(t, err)
+	reader := &mockReader{messages: []kafkago.Message{
+		{Value: []byte("not json")},
+		{Value: payload},
+	}}
+	source := &EventSource{reader: reader}
+
+	evt, err := source.ReadEvent(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "o-1", evt.OrderID)
+	assert.Equal(t, messaging.EventOrderCreated, evt.EventType)
+
+	require.NoError(t, source.Close())
+	assert.True(t, reader.closed)
+}
+
+func TestEventSource_ReadEvent_ReaderError_ReturnsError(t *testing.T) {
+	readErr := errors.New("broker unreachable")
+	source := &EventSource{reader: &mockReader{err: readErr}}
+
+	_, err := source.ReadEvent(context.Background())
+
+	assert.ErrorIs(t, err, readErr)
+}
+
+func TestEventSource_ReadEvent_SetsTopicOfRoutedEvents(t *testing.T) {
+	payload, err := json.Marshal(messaging.OrderEvent{EventType: messaging.EventOrderCreated, OrderID: "o-1"})
+	require.NoError(t, err)
+	reader := &mockReader{messages: []kafkago.Message{
+		{Topic: "order-events", Partition: 1, Offset: 4, Value: payload},
+		{Topic: "orders-created", Partition: 0, Offset: 9, Value: payload},
+	}}
+	source := &EventSource{reader: reader, topic: "order-events"}
+
+	main, err := source.ReadEvent(context.Background())
+	require.NoError(t, err)
+	routed, err := source.ReadEvent(context.Background())
+	require.NoError(t, err)
+
+	assert.Empty(t, main.Topic, "the main topic is left empty, as in resume tokens")
+	assert.Equal(t, int64(4), main.Offset)
+	assert.Equal(t, "orders-created", routed.Topic)
+	assert.Equal(t, int64(9), routed.Offset)
+}
@@ -3,81 +3,213 @@ package kafka
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"log/slog"
 	"time"
 
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/correlation"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
 	"github.com/segmentio/kafka-go"
 )
 
+// Message headers describing an event, so consumers can route and filter
+// messages without decoding them. The trace travels in the W3C traceparent
+// header, correlation.TraceParentHeader.
+const (
+	TraceIDHeader       = "trace-id"
+	CorrelationIDHeader = "correlation-id"
+	EventTypeHeader     = "event-type"
+	SchemaVersionHeader = "schema-version"
+	TenantIDHeader      = "tenant-id"
+)
+
 // messageWriter abstracts kafka.Writer for testability.
 type messageWriter interface {
 	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
 	io.Closer
 }
 
+// Config holds Kafka publisher settings.
+type Config struct {
+	Brokers []string
+	Topic   string
+	// Router, if set, picks the topic of each event; Topic still receives
+	// the events it does not route elsewhere.
+	Router *messaging.TopicRouter
+	// Format selects the CloudEvents envelope or the legacy bare JSON payload.
+	Format messaging.EventFormat
+	// Source is the CloudEvents source attribute; defaults to messaging.DefaultEventSource.
+	Source string
+	// Codec, if set, encodes events as Avro or protobuf for the schema
+	// registry instead of Format.
+	Codec *messaging.SchemaCodec
+	// DeadLetters, if set, receives events the writer failed to deliver.
+	DeadLetters messaging.DeadLetterStore
+	// Resilience, if set, retries failed writes and stops attempting them
+	// while the broker is down; events it gives up on are dead-lettered.
+	Resilience *messaging.Resilience
+
+	// RequiredAcks is how many replicas must acknowledge a write. Its zero
+	// value is kafka.RequireNone, so set it explicitly.
+	RequiredAcks kafka.RequiredAcks
+	// BatchSize, BatchBytes and BatchTimeout bound a batch of messages sent
+	// to one partition. Zero keeps the kafka-go defaults of 100 messages,
+	// 1 MiB and 1s.
+	BatchSize    int
+	BatchBytes   int64
+	BatchTimeout time.Duration
+	// Compression codec of batches; zero sends them uncompressed.
+	Compression kafka.Compression
+	// MaxAttempts is how often the writer tries a batch. Zero means one
+	// attempt with Resilience, whose policy owns retries, and ten without.
+	MaxAttempts int
+	// Async returns from a publish once the event is queued in the writer.
+	// Failed batches are dead-lettered when the writer gives up on them,
+	// and Resilience retries nothing, as the write never fails the publish.
+	Async bool
+}
+
 // Publisher implements service.EventPublisher using Kafka.
 type Publisher struct {
-	writer messageWriter
-	topic  string
+	writer      messageWriter
+	topic       string
+	router      *messaging.TopicRouter
+	format      messaging.EventFormat
+	source      string
+	codec       *messaging.SchemaCodec
+	deadLetters messaging.DeadLetterStore
+	resilience  *messaging.Resilience
+	// inflight counts publishes and redeliveries that have not returned
+	inflight messaging.InFlight
 }
 
 // NewPublisher creates a Kafka event publisher.
-func NewPublisher(brokers []string, topic string) *Publisher {
+func NewPublisher(cfg Config) *Publisher {
 	w := &kafka.Writer{
-		Addr:         kafka.TCP(brokers...),
-		Topic:        topic,
+		Addr:         kafka.TCP(cfg.Brokers...),
+		Topic:        cfg.Topic,
 		Balancer:     &kafka.Hash{},
-		BatchTimeout: 10 * time.Millisecond,
-		RequiredAcks: kafka.RequireOne,
+		BatchSize:    cfg.BatchSize,
+		BatchBytes:   cfg.BatchBytes,
+		BatchTimeout: cfg.BatchTimeout,
+		Compression:  cfg.Compression,
+		RequiredAcks: cfg.RequiredAcks,
+		MaxAttempts:  cfg.MaxAttempts,
+		Async:        cfg.Async,
+	}
+	if cfg.Router.Routed() {
+		// The writer refuses messages naming a topic when it has one itself
+		w.Topic = ""
+	}
+	if w.MaxAttempts == 0 && cfg.Resilience != nil {
+		// The policy owns retries; the writer's own ten attempts would
+		// multiply with it
+		w.MaxAttempts = 1
+	}
+	p := &Publisher{
+		writer:      w,
+		topic:       cfg.Topic,
+		router:      cfg.Router,
+		format:      cfg.Format,
+		source:      cfg.Source,
+		codec:       cfg.Codec,
+		deadLetters: cfg.DeadLetters,
+		resilience:  cfg.Resilience,
+	}
+	if cfg.Async {
+		w.Completion = p.completed
+	}
+	return p
+}
+
+// ParseRequiredAcks converts "none", "one" or "all" into kafka.RequiredAcks.
+func ParseRequiredAcks(s string) (kafka.RequiredAcks, error) {
+	switch s {
+	case "none":
+		return kafka.RequireNone, nil
+	case "one":
+		return kafka.RequireOne, nil
+	case "all":
+		return kafka.RequireAll, nil
+	default:
+		return 0, fmt.Errorf("unknown required acks %q", s)
+	}
+}
+
+// ParseCompression converts "none", "gzip", "snappy", "lz4" or "zstd" into
+// a kafka.Compression.
+func ParseCompression(s string) (kafka.Compression, error) {
+	switch s {
+	case "none":
+		return 0, nil
+	case "gzip":
+		return kafka.Gzip, nil
+	case "snappy":
+		return kafka.Snappy, nil
+	case "lz4":
+		return kafka.Lz4, nil
+	case "zstd":
+		return kafka.Zstd, nil
+	default:
+		return 0, fmt.Errorf("unknown compression %q", s)
+	}
+}
+
+// Ping reports whether any of the brokers accepts a connection.
+func Ping(ctx context.Context, brokers []string) error {
+	var lastErr error
+	for _, broker := range brokers {
+		conn, err := kafka.DialContext(ctx, "tcp", broker)
+		if err == nil {
+			return conn.Close()
+		}
+		lastErr = err
 	}
-	return &Publisher{writer: w, topic: topic}
+	return fmt.Errorf("no kafka broker reachable: %w", lastErr)
 }
 
 // PublishOrderCreated publishes an order.created event to Kafka.
 func (p *Publisher) PublishOrderCreated(ctx context.Context, order *domain.Order) error {
-	evt := messaging.OrderEvent{
-		EventType:  messaging.EventOrderCreated,
-		OrderID:    order.ID.String(),
-		CustomerID: order.CustomerID,
-		Status:     string(order.Status),
-		Total:      order.Total,
-		Version:    order.Version,
-		OccurredAt: time.Now(),
-	}
-	return p.publish(ctx, order.ID.String(), evt)
+	return p.publish(ctx, order.ID.String(), messaging.NewOrderEvent(messaging.EventOrderCreated, order))
 }
 
 // PublishOrderUpdated publishes an order.updated event to Kafka.
 func (p *Publisher) PublishOrderUpdated(ctx context.Context, order *domain.Order) error {
-	evt := messaging.OrderEvent{
-		EventType:  messaging.EventOrderUpdated,
-		OrderID:    order.ID.String(),
-		CustomerID: order.CustomerID,
-		Status:     string(order.Status),
-		Total:      order.Total,
-		Version:    order.Version,
-		OccurredAt: time.Now(),
-	}
-	return p.publish(ctx, order.ID.String(), evt)
+	return p.publish(ctx, order.ID.String(), messaging.NewOrderEvent(messaging.EventOrderUpdated, order))
 }
 
 // PublishOrderStatusChanged publishes an order.status_changed event to Kafka.
 func (p *Publisher) PublishOrderStatusChanged(ctx context.Context, order *domain.Order, oldStatus, newStatus domain.OrderStatus) error {
-	evt := messaging.OrderEvent{
-		EventType:  messaging.EventOrderStatusChanged,
-		OrderID:    order.ID.String(),
-		CustomerID: order.CustomerID,
-		Status:     string(order.Status),
-		OldStatus:  string(oldStatus),
-		NewStatus:  string(newStatus),
-		Total:      order.Total,
-		Version:    order.Version,
-		OccurredAt: time.Now(),
-	}
-	return p.publish(ctx, order.ID.String(), evt)
+	return p.publish(ctx, order.ID.String(), messaging.NewOrderStatusChangedEvent(ctx, order, oldStatus, newStatus))
+}
+
+// PublishOrderRestored publishes an order.restored event to Kafka.
+func (p *Publisher) PublishOrderRestored(ctx context.Context, order *domain.Order) error {
+	return p.publish(ctx, order.ID.String(), messaging.NewOrderEvent(messaging.EventOrderRestored, order))
+}
+
+// PublishOrderDeleted publishes an order.deleted event to Kafka.
+func (p *Publisher) PublishOrderDeleted(ctx context.Context, order *domain.Order) error {
+	return p.publish(ctx, order.ID.String(), messaging.NewOrderEvent(messaging.EventOrderDeleted, order))
+}
+
+// PublishOrderSLABreached publishes an order.sla_breached event to Kafka.
+func (p *Publisher) PublishOrderSLABreached(ctx context.Context, order *domain.Order) error {
+	return p.publish(ctx, order.ID.String(), messaging.NewOrderEvent(messaging.EventOrderSLABreached, order))
+}
+
+// PublishCustomerDataErased publishes a customer.data_erased event to Kafka, keyed by customer ID.
+func (p *Publisher) PublishCustomerDataErased(ctx context.Context, erasure *domain.CustomerErasure) error {
+	return p.publish(ctx, erasure.CustomerID, messaging.NewCustomerDataErasedEvent(erasure))
+}
+
+// SendEvent publishes an already built event, such as one replayed from
+// order history.
+func (p *Publisher) SendEvent(ctx context.Context, evt messaging.OrderEvent) error {
+	return p.publish(ctx, evt.Key(), evt)
 }
 
 // Close flushes and closes the underlying Kafka writer.
@@ -85,13 +217,164 @@ func (p *Publisher) Close() error {
 	return p.writer.Close()
 }
 
+// Shutdown waits for publishes in progress, then closes the writer, which
+// sends the batches still queued and dead-letters those that fail. Both
+// steps give up when ctx ends; the writer then finishes closing in the
+// background.
+func (p *Publisher) Shutdown(ctx context.Context) error {
+	var errs []error
+	if err := p.inflight.Wait(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("waiting for in-flight publishes: %w", err))
+	}
+	closed := make(chan error, 1)
+	go func() {
+		closed <- p.writer.Close()
+	}()
+	select {
+	case err := <-closed:
+		errs = append(errs, err)
+	case <-ctx.Done():
+		errs = append(errs, fmt.Errorf("flushing queued events: %w", ctx.Err()))
+	}
+	return errors.Join(errs...)
+}
+
 func (p *Publisher) publish(ctx context.Context, key string, evt messaging.OrderEvent) error {
-	value, err := json.Marshal(evt)
+	p.inflight.Add()
+	defer p.inflight.Done()
+
+	evt.CorrelationID = correlation.ID(ctx)
+	msg, err := p.encode(ctx, key, evt)
 	if err != nil {
 		return err
 	}
-	return p.writer.WriteMessages(ctx, kafka.Message{
-		Key:   []byte(key),
-		Value: value,
+	err = p.resilience.Do(ctx, func(ctx context.Context) error {
+		return p.writer.WriteMessages(ctx, msg)
 	})
+	if err != nil {
+		return p.deadLetter(ctx, msg, evt, err)
+	}
+	return nil
+}
+
+// encode builds the Kafka message carrying evt in the configured format,
+// addressed to the topic the router picks for it.
+func (p *Publisher) encode(ctx context.Context, key string, evt messaging.OrderEvent) (kafka.Message, error) {
+	msg := kafka.Message{Key: []byte(key)}
+	if p.router.Routed() {
+		msg.Topic = p.router.Topic(evt.EventType)
+	}
+	var err error
+	switch {
+	case p.codec != nil:
+		msg.Value, err = p.codec.Encode(ctx, evt)
+		msg.Headers = []kafka.Header{{Key: "content-type", Value: []byte(p.codec.ContentType())}}
+	case p.format == messaging.EventFormatLegacy:
+		msg.Value, err = messaging.EncodeOrderEvent(evt, p.format, p.source)
+	default:
+		msg.Value, err = messaging.EncodeOrderEvent(evt, p.format, p.source)
+		// CloudEvents Kafka protocol binding, structured content mode.
+		msg.Headers = []kafka.Header{{Key: "content-type", Value: []byte(messaging.CloudEventsContentType)}}
+	}
+	msg.Headers = append(msg.Headers, eventHeaders(ctx, evt)...)
+	return msg, err
+}
+
+// eventHeaders returns the headers describing evt, leaving out those with
+// no value.
+func eventHeaders(ctx context.Context, evt messaging.OrderEvent) []kafka.Header {
+	headers := make([]kafka.Header, 0, 6)
+	for _, h := range []struct{ key, value string }{
+		{correlation.TraceParentHeader, correlation.TraceParent(ctx)},
+		{TraceIDHeader, correlation.TraceID(ctx)},
+		{CorrelationIDHeader, evt.CorrelationID},
+		{EventTypeHeader, evt.EventType},
+		{SchemaVersionHeader, messaging.OrderEventSchemaVersion},
+		{TenantIDHeader, evt.TenantID},
+	} {
+		if h.value != "" {
+			headers = append(headers, kafka.Header{Key: h.key, Value: []byte(h.value)})
+		}
+	}
+	return headers
+}
+
+// Redeliver re-sends a dead-lettered message exactly as it was first
+// encoded. With routing it goes to the topic it was meant for; without, to
+// the main topic.
+func (p *Publisher) Redeliver(ctx context.Context, dl *messaging.DeadLetter) error {
+	p.inflight.Add()
+	defer p.inflight.Done()
+
+	msg := kafka.Message{
+		Key:   []byte(dl.Key),
+		Value: dl.Payload,
+	}
+	if p.router.Routed() {
+		msg.Topic = dl.Topic
+	}
+	for k, v := range dl.Headers {
+		msg.Headers = append(msg.Headers, kafka.Header{Key: k, Value: []byte(v)})
+	}
+	return p.writer.WriteMessages(ctx, msg)
+}
+
+// completed is the writer's callback for a batch sent in async mode. The
+// events of a failed batch are dead-lettered; their publish has long
+// returned, so the event type and order come from decoding the message.
+func (p *Publisher) completed(msgs []kafka.Message, writeErr error) {
+	if writeErr == nil {
+		return
+	}
+	ctx := context.Background()
+	for _, msg := range msgs {
+		evt, err := p.codec.Decode(ctx, msg.Value)
+		if err != nil {
+			evt = messaging.OrderEvent{}
+		}
+		if err := p.deadLetter(ctx, msg, evt, writeErr); err != nil {
+			slog.Error("async event publish failed, event lost",
+				slog.String("key", string(msg.Key)),
+				slog.String("event_type", evt.EventType),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+}
+
+// deadLetter persists a message the writer gave up on. The write error is
+// swallowed once the event is safely stored, since the retrier owns it now.
+func (p *Publisher) deadLetter(ctx context.Context, msg kafka.Message, evt messaging.OrderEvent, writeErr error) error {
+	if p.deadLetters == nil {
+		return writeErr
+	}
+
+	headers := make(map[string]string, len(msg.Headers))
+	for _, h := range msg.Headers {
+		headers[h.Key] = string(h.Value)
+	}
+	topic := msg.Topic
+	if topic == "" {
+		topic = p.topic
+	}
+	dl := &messaging.DeadLetter{
+		Topic:     topic,
+		Key:       string(msg.Key),
+		Payload:   msg.Value,
+		Headers:   headers,
+		EventType: evt.EventType,
+		OrderID:   evt.OrderID,
+		LastError: writeErr.Error(),
+	}
+	if err := p.deadLetters.Save(ctx, dl); err != nil {
+		return fmt.Errorf("%w (dead-letter save failed: %v)", writeErr, err)
+	}
+
+	slog.WarnContext(ctx, "event publish failed, stored in dead-letter queue",
+		slog.String("event_type", evt.EventType),
+		slog.String("order_id", evt.OrderID),
+		slog.String("dead_letter_id", dl.ID.String()),
+		slog.String("error", writeErr.Error()),
+	)
+	return nil
 }
@@ -4,10 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"net"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/correlation"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
 	kafkago "github.com/segmentio/kafka-go"
@@ -21,11 +24,13 @@ type mockWriter struct {
 	messages []kafkago.Message
 	err      error
 	closed   bool
+	attempts int
 }
 
 func (m *mockWriter) WriteMessages(_ context.Context, msgs ...kafkago.Message) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
+	m.attempts++
 	if m.err != nil {
 		return m.err
 	}
@@ -50,6 +55,16 @@ func newTestPublisher(w *mockWriter) *Publisher {
 	return &Publisher{writer: w, topic: "order-events"}
 }
 
+// header returns the value of the message header named key, or "".
+func header(msg kafkago.Message, key string) string {
+	for _, h := range msg.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
 func newTestOrder() *domain.Order {
 	return &domain.Order{
 		ID:         uuid.New(),
@@ -76,8 +91,8 @@ func TestPublisher_PublishOrderCreated_WritesCorrectMessage(t *testing.T) {
 	msg := w.lastMessage()
 	assert.Equal(t, order.ID.String(), string(msg.Key))
 
-	var evt messaging.OrderEvent
-	require.NoError(t, json.Unmarshal(msg.Value, &evt))
+	evt, err := messaging.DecodeOrderEvent(msg.Value)
+	require.NoError(t, err)
 	assert.Equal(t, messaging.EventOrderCreated, evt.EventType)
 	assert.Equal(t, order.ID.String(), evt.OrderID)
 	assert.Equal(t, order.CustomerID, evt.CustomerID)
@@ -89,6 +104,18 @@ func TestPublisher_PublishOrderCreated_WritesCorrectMessage(t *testing.T) {
 	assert.False(t, evt.OccurredAt.IsZero())
 }
 
+func TestPublisher_PublishOrderCreated_CarriesCorrelationID(t *testing.T) {
+	w := &mockWriter{}
+	pub := newTestPublisher(w)
+	ctx := correlation.WithID(context.Background(), "req-42")
+
+	require.NoError(t, pub.PublishOrderCreated(ctx, newTestOrder()))
+
+	evt, err := messaging.DecodeOrderEvent(w.lastMessage().Value)
+	require.NoError(t, err)
+	assert.Equal(t, "req-42", evt.CorrelationID)
+}
+
 func TestPublisher_PublishOrderUpdated_WritesCorrectMessage(t *testing.T) {
 	w := &mockWriter{}
 	pub := newTestPublisher(w)
@@ -102,8 +129,8 @@ func TestPublisher_PublishOrderUpdated_WritesCorrectMessage(t *testing.T) {
 	msg := w.lastMessage()
 	assert.Equal(t, order.ID.String(), string(msg.Key))
 
-	var evt messaging.OrderEvent
-	require.NoError(t, json.Unmarshal(msg.Value, &evt))
+	evt, err := messaging.DecodeOrderEvent(msg.Value)
+	require.NoError(t, err)
 	assert.Equal(t, messaging.EventOrderUpdated, evt.EventType)
 	assert.Equal(t, "confirmed", evt.Status)
 	assert.Equal(t, 3, evt.Version)
@@ -125,14 +152,124 @@ func TestPublisher_PublishOrderStatusChanged_IncludesOldAndNewStatus(t *testing.
 	require.NoError(t, err)
 	msg := w.lastMessage()
 
-	var evt messaging.OrderEvent
-	require.NoError(t, json.Unmarshal(msg.Value, &evt))
+	evt, err := messaging.DecodeOrderEvent(msg.Value)
+	require.NoError(t, err)
 	assert.Equal(t, messaging.EventOrderStatusChanged, evt.EventType)
 	assert.Equal(t, "pending", evt.OldStatus)
 	assert.Equal(t, "confirmed", evt.NewStatus)
 	assert.Equal(t, "confirmed", evt.Status)
 }
 
+func TestPublisher_CloudEventsFormat_WrapsEventInEnvelope(t *testing.T) {
+	w := &mockWriter{}
+	pub := &Publisher{writer: w, topic: "order-events", format: messaging.EventFormatCloudEvents, source: "/ordersvc-test"}
+	order := newTestOrder()
+
+	err := pub.PublishOrderCreated(context.Background(), order)
+	require.NoError(t, err)
+
+	msg := w.lastMessage()
+	assert.Equal(t, messaging.CloudEventsContentType, header(msg, "content-type"))
+
+	ce, err := messaging.DecodeCloudEvent(msg.Value)
+	require.NoError(t, err)
+	assert.Equal(t, messaging.CloudEventsSpecVersion, ce.SpecVersion)
+	assert.Equal(t, messaging.EventOrderCreated, ce.Type)
+	assert.Equal(t, "/ordersvc-test", ce.Source)
+	assert.Equal(t, order.ID.String(), ce.Subject)
+	assert.Equal(t, messaging.OrderEventSchemaVersion, ce.SchemaVersion)
+	assert.NotEmpty(t, ce.ID)
+
+	evt, err := ce.OrderEvent()
+	require.NoError(t, err)
+	assert.Equal(t, order.ID.String(), evt.OrderID)
+}
+
+func TestPublisher_LegacyFormat_WritesBareEvent(t *testing.T) {
+	w := &mockWriter{}
+	pub := &Publisher{writer: w, topic: "order-events", format: messaging.EventFormatLegacy}
+	order := newTestOrder()
+
+	err := pub.PublishOrderCreated(context.Background(), order)
+	require.NoError(t, err)
+
+	msg := w.lastMessage()
+	assert.Empty(t, header(msg, "content-type"))
+
+	var evt messaging.OrderEvent
+	require.NoError(t, json.Unmarshal(msg.Value, &evt))
+	assert.Equal(t, messaging.EventOrderCreated, evt.EventType)
+	assert.Equal(t, order.ID.String(), evt.OrderID)
+}
+
+// fixedRegistry is a schema registry holding one schema under ID 1.
+type fixedRegistry struct {
+	schema messaging.Schema
+}
+
+func (r *fixedRegistry) CheckCompatibility(context.Context, string, messaging.Schema) (bool, []string, error) {
+	return true, nil, nil
+}
+
+func (r *fixedRegistry) Register(_ context.Context, _ string, schema messaging.Schema) (int, error) {
+	r.schema = schema
+	return 1, nil
+}
+
+func (r *fixedRegistry) Lookup(context.Context, string, messaging.Schema) (int, error) {
+	return 1, nil
+}
+
+func (r *fixedRegistry) SchemaByID(context.Context, int) (messaging.Schema, error) {
+	return r.schema, nil
+}
+
+func TestPublisher_SchemaCodec_WritesRegistryFramedEvent(t *testing.T) {
+	codec, err := messaging.NewSchemaCodec(messaging.SchemaCodecConfig{
+		Registry:     &fixedRegistry{},
+		Format:       messaging.EventFormatAvro,
+		Topic:        "order-events",
+		AutoRegister: true,
+	})
+	require.NoError(t, err)
+	w := &mockWriter{}
+	pub := &Publisher{writer: w, topic: "order-events", codec: codec}
+	order := newTestOrder()
+
+	require.NoError(t, pub.PublishOrderCreated(context.Background(), order))
+
+	msg := w.lastMessage()
+	assert.Equal(t, messaging.AvroContentType, header(msg, "content-type"))
+	assert.Equal(t, byte(0), msg.Value[0], "schema registry magic byte")
+
+	evt, err := codec.Decode(context.Background(), msg.Value)
+	require.NoError(t, err)
+	assert.Equal(t, messaging.EventOrderCreated, evt.EventType)
+	assert.Equal(t, order.ID.String(), evt.OrderID)
+}
+
+func TestPublisher_Publish_SetsEventHeaders(t *testing.T) {
+	w := &mockWriter{}
+	pub := newTestPublisher(w)
+	traceParent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	ctx := correlation.WithTraceParent(correlation.WithID(context.Background(), "req-42"), traceParent)
+
+	require.NoError(t, pub.PublishOrderStatusChanged(ctx, newTestOrder(), domain.OrderStatusPending, domain.OrderStatusConfirmed))
+	require.NoError(t, pub.SendEvent(context.Background(), messaging.OrderEvent{EventType: messaging.EventOrderCreated, OrderID: "o-1", TenantID: "acme"}))
+
+	msg := w.messages[0]
+	assert.Equal(t, traceParent, header(msg, correlation.TraceParentHeader))
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", header(msg, TraceIDHeader))
+	assert.Equal(t, "req-42", header(msg, CorrelationIDHeader))
+	assert.Equal(t, messaging.EventOrderStatusChanged, header(msg, EventTypeHeader))
+	assert.Equal(t, messaging.OrderEventSchemaVersion, header(msg, SchemaVersionHeader))
+	assert.Empty(t, header(msg, TenantIDHeader), "headers without a value are left out")
+
+	msg = w.messages[1]
+	assert.Equal(t, "acme", header(msg, TenantIDHeader))
+	assert.Empty(t, header(msg, TraceIDHeader))
+}
+
 func TestPublisher_PublishOrderCreated_WriterError_ReturnsError(t *testing.T) {
 	w := &mockWriter{err: errors.New("broker unavailable")}
 	pub := newTestPublisher(w)
@@ -143,6 +280,204 @@ func TestPublisher_PublishOrderCreated_WriterError_ReturnsError(t *testing.T) {
 	assert.Contains(t, err.Error(), "broker unavailable")
 }
 
+// memoryDeadLetters records dead letters saved by the publisher.
+type memoryDeadLetters struct {
+	messaging.DeadLetterStore
+	saved   []*messaging.DeadLetter
+	saveErr error
+}
+
+func (m *memoryDeadLetters) Save(_ context.Context, dl *messaging.DeadLetter) error {
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	dl.ID = uuid.New()
+	m.saved = append(m.saved, dl)
+	return nil
+}
+
+func TestPublisher_WriterError_WithDeadLetters_StoresEventAndReturnsNil(t *testing.T) {
+	w := &mockWriter{err: errors.New("broker unavailable")}
+	dlq := &memoryDeadLetters{}
+	pub := newTestPublisher(w)
+	pub.deadLetters = dlq
+	order := newTestOrder()
+
+	err := pub.PublishOrderCreated(context.Background(), order)
+
+	require.NoError(t, err)
+	require.Len(t, dlq.saved, 1)
+	dl := dlq.saved[0]
+	assert.Equal(t, order.ID.String(), dl.Key)
+	assert.Equal(t, order.ID.String(), dl.OrderID)
+	assert.Equal(t, messaging.EventOrderCreated, dl.EventType)
+	assert.Equal(t, "order-events", dl.Topic)
+	assert.Equal(t, "broker unavailable", dl.LastError)
+	assert.Equal(t, messaging.CloudEventsContentType, dl.Headers["content-type"])
+
+	evt, err := messaging.DecodeOrderEvent(dl.Payload)
+	require.NoError(t, err)
+	assert.Equal(t, order.ID.String(), evt.OrderID)
+}
+
+func TestPublisher_AsyncBatchFailed_DeadLettersEvents(t *testing.T) {
+	w := &mockWriter{}
+	dlq := &memoryDeadLetters{}
+	pub := newTestPublisher(w)
+	pub.deadLetters = dlq
+	order := newTestOrder()
+	require.NoError(t, pub.PublishOrderCreated(context.Background(), order))
+
+	// The writer reports the batch through its completion callback
+	pub.completed(w.messages, errors.New("broker unavailable"))
+
+	require.Len(t, dlq.saved, 1)
+	assert.Equal(t, order.ID.String(), dlq.saved[0].OrderID)
+	assert.Equal(t, messaging.EventOrderCreated, dlq.saved[0].EventType)
+	assert.Equal(t, "broker unavailable", dlq.saved[0].LastError)
+}
+
+func TestNewPublisher_TuningReachesWriter(t *testing.T) {
+	pub := NewPublisher(Config{
+		Brokers:      []string{"localhost:9092"},
+		Topic:        "order-events",
+		RequiredAcks: kafkago.RequireAll,
+		BatchSize:    50,
+		BatchBytes:   4096,
+		BatchTimeout: 5 * time.Millisecond,
+		Compression:  kafkago.Zstd,
+		MaxAttempts:  3,
+		Async:        true,
+		Resilience:   messaging.NewResilience(messaging.ResilienceConfig{MaxAttempts: 2}, nil),
+	})
+
+	w := pub.writer.(*kafkago.Writer)
+	assert.Equal(t, kafkago.RequireAll, w.RequiredAcks)
+	assert.Equal(t, 50, w.BatchSize)
+	assert.Equal(t, int64(4096), w.BatchBytes)
+	assert.Equal(t, 5*time.Millisecond, w.BatchTimeout)
+	assert.Equal(t, kafkago.Zstd, w.Compression)
+	assert.Equal(t, 3, w.MaxAttempts, "an explicit attempt count is kept alongside resilience")
+	assert.True(t, w.Async)
+	assert.NotNil(t, w.Completion)
+}
+
+func TestPublisher_Routed_WritesEachEventTypeToItsTopic(t *testing.T) {
+	router, err := messaging.NewTopicRouter("order-events", messaging.TopicRoutingEventType, map[string]string{
+		messaging.EventOrderCreated: "orders-created",
+	})
+	require.NoError(t, err)
+	w := &mockWriter{}
+	pub := newTestPublisher(w)
+	pub.router = router
+	order := newTestOrder()
+
+	require.NoError(t, pub.PublishOrderCreated(context.Background(), order))
+	require.NoError(t, pub.PublishOrderUpdated(context.Background(), order))
+
+	require.Len(t, w.messages, 2)
+	assert.Equal(t, "orders-created", w.messages[0].Topic)
+	assert.Equal(t, "order-events", w.messages[1].Topic, "unmapped event types stay on the main topic")
+}
+
+func TestPublisher_Routed_DeadLettersAndRedeliversToRoutedTopic(t *testing.T) {
+	router, err := messaging.NewTopicRouter("order-events", messaging.TopicRoutingPrefix, nil)
+	require.NoError(t, err)
+	w := &mockWriter{err: errors.New("broker unavailable")}
+	dlq := &memoryDeadLetters{}
+	pub := newTestPublisher(w)
+	pub.router = router
+	pub.deadLetters = dlq
+
+	require.NoError(t, pub.PublishOrderDeleted(context.Background(), newTestOrder()))
+	require.Len(t, dlq.saved, 1)
+	assert.Equal(t, "order-events.order.deleted", dlq.saved[0].Topic)
+
+	w.err = nil
+	require.NoError(t, pub.Redeliver(context.Background(), dlq.saved[0]))
+	assert.Equal(t, "order-events.order.deleted", w.lastMessage().Topic)
+}
+
+func TestNewPublisher_Routed_LeavesWriterTopicEmpty(t *testing.T) {
+	router, err := messaging.NewTopicRouter("order-events", messaging.TopicRoutingPrefix, nil)
+	require.NoError(t, err)
+
+	pub := NewPublisher(Config{Brokers: []string{"localhost:9092"}, Topic: "order-events", Router: router})
+
+	assert.Empty(t, pub.writer.(*kafkago.Writer).Topic, "kafka-go rejects per-message topics when the writer has one")
+}
+
+func TestParseRequiredAcksAndCompression(t *testing.T) {
+	acks, err := ParseRequiredAcks("all")
+	require.NoError(t, err)
+	assert.Equal(t, kafkago.RequireAll, acks)
+	_, err = ParseRequiredAcks("2")
+	assert.Error(t, err)
+
+	c, err := ParseCompression("snappy")
+	require.NoError(t, err)
+	assert.Equal(t, kafkago.Snappy, c)
+	c, err = ParseCompression("none")
+	require.NoError(t, err)
+	assert.Zero(t, c)
+	_, err = ParseCompression("brotli")
+	assert.Error(t, err)
+}
+
+func TestPublisher_WriterError_WithResilience_RetriesThenDeadLetters(t *testing.T) {
+	w := &mockWriter{err: errors.New("broker unavailable")}
+	dlq := &memoryDeadLetters{}
+	pub := newTestPublisher(w)
+	pub.deadLetters = dlq
+	pub.resilience = messaging.NewResilience(messaging.ResilienceConfig{
+		MaxAttempts:      2,
+		InitialBackoff:   time.Millisecond,
+		MaxBackoff:       time.Millisecond,
+		FailureThreshold: 1,
+		Cooldown:         time.Hour,
+	}, nil)
+
+	require.NoError(t, pub.PublishOrderCreated(context.Background(), newTestOrder()))
+	assert.Equal(t, 2, w.attempts, "the write is retried")
+
+	require.NoError(t, pub.PublishOrderCreated(context.Background(), newTestOrder()))
+	assert.Equal(t, 2, w.attempts, "the open breaker skips the broker")
+
+	require.Len(t, dlq.saved, 2)
+	assert.Equal(t, messaging.ErrCircuitOpen.Error(), dlq.saved[1].LastError)
+}
+
+func TestPublisher_WriterError_DeadLetterSaveFails_ReturnsError(t *testing.T) {
+	w := &mockWriter{err: errors.New("broker unavailable")}
+	pub := newTestPublisher(w)
+	pub.deadLetters = &memoryDeadLetters{saveErr: errors.New("db down")}
+
+	err := pub.PublishOrderCreated(context.Background(), newTestOrder())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "broker unavailable")
+	assert.Contains(t, err.Error(), "db down")
+}
+
+func TestPublisher_Redeliver_WritesStoredPayload(t *testing.T) {
+	w := &mockWriter{}
+	pub := newTestPublisher(w)
+	dl := &messaging.DeadLetter{
+		Key:     "order-1",
+		Payload: []byte(`{"specversion":"1.0"}`),
+		Headers: map[string]string{"content-type": messaging.CloudEventsContentType},
+	}
+
+	err := pub.Redeliver(context.Background(), dl)
+
+	require.NoError(t, err)
+	msg := w.lastMessage()
+	assert.Equal(t, "order-1", string(msg.Key))
+	assert.Equal(t, dl.Payload, msg.Value)
+	require.Len(t, msg.Headers, 1)
+	assert.Equal(t, "content-type", msg.Headers[0].Key)
+}
+
 func TestPublisher_Close_ClosesWriter(t *testing.T) {
 	w := &mockWriter{}
 	pub := newTestPublisher(w)
@@ -153,6 +488,61 @@ func TestPublisher_Close_ClosesWriter(t *testing.T) {
 	assert.True(t, w.closed)
 }
 
+// blockingWriter holds each write until release is closed.
+type blockingWriter struct {
+	*mockWriter
+	started chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingWriter) WriteMessages(ctx context.Context, msgs ...kafkago.Message) error {
+	close(b.started)
+	<-b.release
+	return b.mockWriter.WriteMessages(ctx, msgs...)
+}
+
+func TestPublisher_Shutdown_WaitsForInFlightPublish(t *testing.T) {
+	w := &blockingWriter{mockWriter: &mockWriter{}, started: make(chan struct{}), release: make(chan struct{})}
+	pub := &Publisher{writer: w, topic: "order-events"}
+	published := make(chan error, 1)
+	go func() {
+		published <- pub.PublishOrderCreated(context.Background(), newTestOrder())
+	}()
+	<-w.started
+
+	shutdown := make(chan error, 1)
+	go func() {
+		shutdown <- pub.Shutdown(context.Background())
+	}()
+	select {
+	case <-shutdown:
+		t.Fatal("Shutdown returned while a publish was in flight")
+	case <-time.After(20 * time.Millisecond):
+	}
+	close(w.release)
+
+	require.NoError(t, <-published)
+	require.NoError(t, <-shutdown)
+	assert.Len(t, w.messages, 1)
+	assert.True(t, w.closed, "writer is closed after the publish")
+}
+
+func TestPublisher_Shutdown_GivesUpAtDeadline(t *testing.T) {
+	w := &blockingWriter{mockWriter: &mockWriter{}, started: make(chan struct{}), release: make(chan struct{})}
+	defer close(w.release)
+	pub := &Publisher{writer: w, topic: "order-events"}
+	go func() {
+		_ = pub.PublishOrderCreated(context.Background(), newTestOrder())
+	}()
+	<-w.started
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := pub.Shutdown(ctx)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
 func TestPublisher_MessageKey_IsOrderID(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -179,6 +569,18 @@ func TestPublisher_MessageKey_IsOrderID(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "restored",
+			publish: func(pub *Publisher, order *domain.Order) error {
+				return pub.PublishOrderRestored(context.Background(), order)
+			},
+		},
+		{
+			name: "deleted",
+			publish: func(pub *Publisher, order *domain.Order) error {
+				return pub.PublishOrderDeleted(context.Background(), order)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -196,3 +598,14 @@ func TestPublisher_MessageKey_IsOrderID(t *testing.T) {
 		})
 	}
 }
+
+func TestPing_NoBrokerReachable_ReturnsError(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := lis.Addr().String()
+	require.NoError(t, lis.Close())
+
+	err = Ping(context.Background(), []string{addr})
+
+	assert.ErrorContains(t, err, "no kafka broker reachable")
+}
@@ -0,0 +1,112 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+)
+
+// Replayer implements messaging.Replayer by reading partitions of the order
+// event topics directly, outside any consumer group, so replays never move a
+// group's committed offsets.
+type Replayer struct {
+	brokers []string
+	topic   string
+	codec   *messaging.SchemaCodec
+}
+
+// NewReplayer creates a replayer for topic, the main event topic; positions
+// in routed topics name their own topic. codec decodes the schema
+// registry formats; nil decodes only JSON.
+func NewReplayer(brokers []string, topic string, codec *messaging.SchemaCodec) *Replayer {
+	return &Replayer{brokers: brokers, topic: topic, codec: codec}
+}
+
+// Replay reads each partition in from, from its offset up to the end of the
+// partition when the partition is reached. A position older than the topic
+// retains starts at the oldest event left; the events in between are gone.
+func (r *Replayer) Replay(ctx context.Context, from messaging.Position, fn func(messaging.OrderEvent) error) error {
+	for _, tp := range from.SortedPartitions() {
+		topic := tp.Topic
+		if topic == "" {
+			topic = r.topic
+		}
+		if err := r.replayPartition(ctx, topic, tp.Partition, from[tp], fn); err != nil {
+			return fmt.Errorf("replay %s partition %d: %w", topic, tp.Partition, err)
+		}
+	}
+	return nil
+}
+
+func (r *Replayer) replayPartition(ctx context.Context, topic string, partition int, offset int64, fn func(messaging.OrderEvent) error) error {
+	first, end, err := r.offsets(ctx, topic, partition)
+	if err != nil {
+		return err
+	}
+	if offset < first {
+		slog.Warn("resume position is older than the topic retains; replaying from the oldest event",
+			slog.String("topic", topic),
+			slog.Int("partition", partition),
+			slog.Int64("offset", offset),
+			slog.Int64("oldest_offset", first),
+		)
+		offset = first
+	}
+	if offset >= end {
+		return nil
+	}
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:   r.brokers,
+		Topic:     topic,
+		Partition: partition,
+	})
+	defer func() { _ = reader.Close() }()
+	if err := reader.SetOffset(offset); err != nil {
+		return err
+	}
+
+	for {
+		msg, err := reader.ReadMessage(ctx)
+		if err != nil {
+			return err
+		}
+		evt, err := r.codec.Decode(ctx, msg.Value)
+		if err != nil {
+			slog.Warn("failed to decode order event",
+				slog.Int("partition", msg.Partition),
+				slog.Int64("offset", msg.Offset),
+				slog.String("error", err.Error()),
+			)
+		} else {
+			evt.Topic = eventTopic(msg.Topic, r.topic)
+			evt.Partition, evt.Offset = msg.Partition, msg.Offset
+			if err := fn(evt); err != nil {
+				return err
+			}
+		}
+		if msg.Offset+1 >= end {
+			return nil
+		}
+	}
+}
+
+// offsets returns the oldest offset of partition of topic and the offset the next
+// event written to it will get, asking the first broker that answers.
+func (r *Replayer) offsets(ctx context.Context, topic string, partition int) (first, end int64, err error) {
+	var lastErr error
+	for _, broker := range r.brokers {
+		conn, err := kafka.DialLeader(ctx, "tcp", broker, topic, partition)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		first, end, err = conn.ReadOffsets()
+		_ = conn.Close()
+		return first, end, err
+	}
+	return 0, 0, fmt.Errorf("no kafka broker reachable: %w", lastErr)
+}
@@ -0,0 +1,190 @@
+// Package memory provides an in-memory EventPublisher that records the events
+// it publishes, for tests of the service and of programs that embed ordersvc
+// in their own tests.
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/correlation"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+)
+
+// Match selects recorded events.
+type Match func(evt messaging.OrderEvent) bool
+
+// OfType matches events of eventType.
+func OfType(eventType string) Match {
+	return func(evt messaging.OrderEvent) bool { return evt.EventType == eventType }
+}
+
+// ForOrder matches events of the order with the given ID.
+func ForOrder(orderID string) Match {
+	return func(evt messaging.OrderEvent) bool { return evt.OrderID == orderID }
+}
+
+// All matches events that match every one of matches.
+func All(matches ...Match) Match {
+	return func(evt messaging.OrderEvent) bool {
+		for _, m := range matches {
+			if !m(evt) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Publisher implements service.EventPublisher by recording events in memory.
+//
+// Events are built as the broker publishers build them, with the request's
+// correlation ID, and kept in publish order: the Offset of each event is its
+// position, starting at 0. Publisher is safe for concurrent use, and
+// WaitForEvent lets a test wait for events published by another goroutine.
+type Publisher struct {
+	mu     sync.Mutex
+	events []messaging.OrderEvent
+	err    error
+	// published is closed, and replaced, whenever an event is recorded
+	published chan struct{}
+}
+
+// NewPublisher returns a publisher with no events recorded.
+func NewPublisher() *Publisher {
+	return &Publisher{published: make(chan struct{})}
+}
+
+// PublishOrderCreated records an order.created event.
+func (p *Publisher) PublishOrderCreated(ctx context.Context, order *domain.Order) error {
+	return p.SendEvent(ctx, messaging.NewOrderEvent(messaging.EventOrderCreated, order))
+}
+
+// PublishOrderUpdated records an order.updated event.
+func (p *Publisher) PublishOrderUpdated(ctx context.Context, order *domain.Order) error {
+	return p.SendEvent(ctx, messaging.NewOrderEvent(messaging.EventOrderUpdated, order))
+}
+
+// PublishOrderStatusChanged records an order.status_changed event.
+func (p *Publisher) PublishOrderStatusChanged(ctx context.Context, order *domain.Order, oldStatus, newStatus domain.OrderStatus) error {
+	return p.SendEvent(ctx, messaging.NewOrderStatusChangedEvent(ctx, order, oldStatus, newStatus))
+}
+
+// PublishOrderRestored records an order.restored event.
+func (p *Publisher) PublishOrderRestored(ctx context.Context, order *domain.Order) error {
+	return p.SendEvent(ctx, messaging.NewOrderEvent(messaging.EventOrderRestored, order))
+}
+
+// PublishOrderDeleted records an order.deleted event.
+func (p *Publisher) PublishOrderDeleted(ctx context.Context, order *domain.Order) error {
+	return p.SendEvent(ctx, messaging.NewOrderEvent(messaging.EventOrderDeleted, order))
+}
+
+// PublishOrderSLABreached records an order.sla_breached event.
+func (p *Publisher) PublishOrderSLABreached(ctx context.Context, order *domain.Order) error {
+	return p.SendEvent(ctx, messaging.NewOrderEvent(messaging.EventOrderSLABreached, order))
+}
+
+// PublishCustomerDataErased records a customer.data_erased event.
+func (p *Publisher) PublishCustomerDataErased(ctx context.Context, erasure *domain.CustomerErasure) error {
+	return p.SendEvent(ctx, messaging.NewCustomerDataErasedEvent(erasure))
+}
+
+// SendEvent records an already built event, such as one replayed from order
+// history. It returns the error set by FailWith instead, if any.
+func (p *Publisher) SendEvent(ctx context.Context, evt messaging.OrderEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	if evt.CorrelationID == "" {
+		evt.CorrelationID = correlation.ID(ctx)
+	}
+	evt.Offset = int64(len(p.events))
+	p.events = append(p.events, evt)
+	close(p.published)
+	p.published = make(chan struct{})
+	return nil
+}
+
+// FailWith makes later publishes return err without recording their events,
+// as when the broker is down; nil restores publishing.
+func (p *Publisher) FailWith(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.err = err
+}
+
+// Events returns the recorded events in publish order.
+func (p *Publisher) Events() []messaging.OrderEvent {
+	return p.Find(func(messaging.OrderEvent) bool { return true })
+}
+
+// EventsForOrder returns the recorded events of the order with the given ID,
+// in publish order.
+func (p *Publisher) EventsForOrder(orderID string) []messaging.OrderEvent {
+	return p.Find(ForOrder(orderID))
+}
+
+// EventTypesForOrder returns the types of the recorded events of the order
+// with the given ID, in publish order, for asserting the sequence of changes.
+func (p *Publisher) EventTypesForOrder(orderID string) []string {
+	events := p.EventsForOrder(orderID)
+	types := make([]string, len(events))
+	for i, evt := range events {
+		types[i] = evt.EventType
+	}
+	return types
+}
+
+// Find returns the recorded events that match, in publish order.
+func (p *Publisher) Find(match Match) []messaging.OrderEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var found []messaging.OrderEvent
+	for _, evt := range p.events {
+		if match(evt) {
+			found = append(found, evt)
+		}
+	}
+	return found
+}
+
+// WaitForEvent returns the first recorded event that matches match, waiting
+// for one to be published if none has been yet. It fails once ctx ends.
+func (p *Publisher) WaitForEvent(ctx context.Context, match Match) (messaging.OrderEvent, error) {
+	seen := 0
+	for {
+		p.mu.Lock()
+		if seen > len(p.events) {
+			// Reset was called; look at the events recorded since
+			seen = 0
+		}
+		for ; seen < len(p.events); seen++ {
+			if match(p.events[seen]) {
+				evt := p.events[seen]
+				p.mu.Unlock()
+				return evt, nil
+			}
+		}
+		published := p.published
+		p.mu.Unlock()
+
+		select {
+		case <-published:
+		case <-ctx.Done():
+			return messaging.OrderEvent{}, fmt.Errorf("no matching event among %d published: %w", seen, ctx.Err())
+		}
+	}
+}
+
+// Reset discards the recorded events and clears FailWith.
+func (p *Publisher) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = nil
+	p.err = nil
+}
@@ -0,0 +1,126 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/correlation"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+)
+
+func newOrder(version int) *domain.Order {
+	return &domain.Order{ID: uuid.New(), CustomerID: "customer-1", Status: domain.OrderStatusPending, Version: version}
+}
+
+func TestPublisher_RecordsEventsInOrder(t *testing.T) {
+	p := NewPublisher()
+	ctx := correlation.WithID(context.Background(), "req-1")
+	a, b := newOrder(1), newOrder(1)
+
+	require.NoError(t, p.PublishOrderCreated(ctx, a))
+	require.NoError(t, p.PublishOrderCreated(ctx, b))
+	a.Version = 2
+	a.Status = domain.OrderStatusConfirmed
+	require.NoError(t, p.PublishOrderUpdated(ctx, a))
+	require.NoError(t, p.PublishOrderStatusChanged(ctx, a, domain.OrderStatusPending, domain.OrderStatusConfirmed))
+
+	events := p.Events()
+	require.Len(t, events, 4)
+	for i, evt := range events {
+		assert.Equal(t, int64(i), evt.Offset)
+		assert.Equal(t, "req-1", evt.CorrelationID)
+	}
+	assert.Equal(t, []string{messaging.EventOrderCreated, messaging.EventOrderUpdated, messaging.EventOrderStatusChanged},
+		p.EventTypesForOrder(a.ID.String()))
+	changed := p.EventsForOrder(a.ID.String())[2]
+	assert.Equal(t, "pending", changed.OldStatus)
+	assert.Equal(t, "confirmed", changed.NewStatus)
+	assert.Equal(t, 2, changed.Version)
+	assert.Len(t, p.EventsForOrder(b.ID.String()), 1)
+}
+
+func TestPublisher_Find(t *testing.T) {
+	p := NewPublisher()
+	order := newOrder(1)
+	require.NoError(t, p.PublishOrderCreated(context.Background(), order))
+	require.NoError(t, p.PublishOrderDeleted(context.Background(), order))
+	require.NoError(t, p.PublishOrderCreated(context.Background(), newOrder(1)))
+
+	assert.Len(t, p.Find(OfType(messaging.EventOrderCreated)), 2)
+	found := p.Find(All(OfType(messaging.EventOrderDeleted), ForOrder(order.ID.String())))
+	require.Len(t, found, 1)
+	assert.Equal(t, int64(1), found[0].Offset)
+	assert.Empty(t, p.Find(OfType(messaging.EventOrderRestored)))
+}
+
+func TestPublisher_FailWith(t *testing.T) {
+	p := NewPublisher()
+	errDown := errors.New("broker down")
+
+	p.FailWith(errDown)
+	assert.ErrorIs(t, p.PublishOrderCreated(context.Background(), newOrder(1)), errDown)
+	assert.Empty(t, p.Events())
+
+	p.FailWith(nil)
+	assert.NoError(t, p.PublishOrderCreated(context.Background(), newOrder(1)))
+	assert.Len(t, p.Events(), 1)
+}
+
+func TestPublisher_WaitForEvent_AlreadyPublished(t *testing.T) {
+	p := NewPublisher()
+	order := newOrder(1)
+	require.NoError(t, p.PublishOrderCreated(context.Background(), order))
+
+	evt, err := p.WaitForEvent(context.Background(), ForOrder(order.ID.String()))
+
+	require.NoError(t, err)
+	assert.Equal(t, messaging.EventOrderCreated, evt.EventType)
+}
+
+func TestPublisher_WaitForEvent_PublishedLater(t *testing.T) {
+	p := NewPublisher()
+	order := newOrder(3)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		_ = p.PublishOrderCreated(context.Background(), newOrder(1))
+		_ = p.PublishOrderSLABreached(context.Background(), order)
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	evt, err := p.WaitForEvent(ctx, All(OfType(messaging.EventOrderSLABreached), ForOrder(order.ID.String())))
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, evt.Version)
+	assert.Equal(t, int64(1), evt.Offset)
+}
+
+func TestPublisher_WaitForEvent_Timeout(t *testing.T) {
+	p := NewPublisher()
+	require.NoError(t, p.PublishOrderCreated(context.Background(), newOrder(1)))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := p.WaitForEvent(ctx, OfType(messaging.EventOrderDeleted))
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestPublisher_Reset(t *testing.T) {
+	p := NewPublisher()
+	require.NoError(t, p.PublishOrderCreated(context.Background(), newOrder(1)))
+	p.FailWith(errors.New("broker down"))
+
+	p.Reset()
+
+	assert.Empty(t, p.Events())
+	require.NoError(t, p.PublishOrderCreated(context.Background(), newOrder(1)))
+	assert.Equal(t, int64(0), p.Events()[0].Offset)
+}
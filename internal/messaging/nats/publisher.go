@@ -0,0 +1,213 @@
+// Package nats implements event publishing using NATS JetStream.
+package nats
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/correlation"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+)
+
+// OrderingKeyHeader carries the order ID, mirroring the Kafka message key.
+const OrderingKeyHeader = "Ordering-Key"
+
+// streamPublisher abstracts jetstream.JetStream for testability.
+type streamPublisher interface {
+	PublishMsg(ctx context.Context, msg *nats.Msg, opts ...jetstream.PublishOpt) (*jetstream.PubAck, error)
+}
+
+// Config holds NATS JetStream publisher settings.
+type Config struct {
+	URL string
+	// Stream is the JetStream stream that captures all order event subjects.
+	Stream string
+	// SubjectPrefix is prepended to every subject, e.g. "orders".
+	SubjectPrefix string
+	// Format selects the CloudEvents envelope or the legacy bare JSON payload.
+	Format messaging.EventFormat
+	// Source is the CloudEvents source attribute; defaults to messaging.DefaultEventSource.
+	Source string
+	// DeadLetters, if set, receives events JetStream failed to acknowledge.
+	DeadLetters messaging.DeadLetterStore
+	// Resilience, if set, retries failed writes and stops attempting them
+	// while the broker is down; events it gives up on are dead-lettered.
+	Resilience *messaging.Resilience
+}
+
+// Publisher implements service.EventPublisher using NATS JetStream.
+//
+// Events are published to <prefix>.<event_type>.<order_id>, e.g.
+// orders.order.status_changed.550e8400-e29b-41d4-a716-446655440000.
+// JetStream preserves order per subject, and the trailing order ID gives every
+// order its own subject, matching the per-key ordering of the Kafka publisher.
+// Consumers select event types with wildcards such as orders.order.created.>.
+type Publisher struct {
+	js          streamPublisher
+	conn        *nats.Conn
+	prefix      string
+	format      messaging.EventFormat
+	source      string
+	deadLetters messaging.DeadLetterStore
+	resilience  *messaging.Resilience
+}
+
+// NewPublisher connects to NATS and ensures the event stream exists.
+func NewPublisher(ctx context.Context, cfg Config) (*Publisher, error) {
+	conn, err := nats.Connect(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create JetStream context: %w", err)
+	}
+
+	_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     cfg.Stream,
+		Subjects: []string{cfg.SubjectPrefix + ".>"},
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to ensure stream %s: %w", cfg.Stream, err)
+	}
+
+	return &Publisher{
+		js:          js,
+		conn:        conn,
+		prefix:      cfg.SubjectPrefix,
+		format:      cfg.Format,
+		source:      cfg.Source,
+		deadLetters: cfg.DeadLetters,
+		resilience:  cfg.Resilience,
+	}, nil
+}
+
+// Subject returns the subject an event of eventType for orderID is published on.
+func Subject(prefix, eventType, orderID string) string {
+	return strings.Join([]string{prefix, eventType, orderID}, ".")
+}
+
+// PublishOrderCreated publishes an order.created event to JetStream.
+func (p *Publisher) PublishOrderCreated(ctx context.Context, order *domain.Order) error {
+	return p.publish(ctx, messaging.NewOrderEvent(messaging.EventOrderCreated, order))
+}
+
+// PublishOrderUpdated publishes an order.updated event to JetStream.
+func (p *Publisher) PublishOrderUpdated(ctx context.Context, order *domain.Order) error {
+	return p.publish(ctx, messaging.NewOrderEvent(messaging.EventOrderUpdated, order))
+}
+
+// PublishOrderStatusChanged publishes an order.status_changed event to JetStream.
+func (p *Publisher) PublishOrderStatusChanged(ctx context.Context, order *domain.Order, oldStatus, newStatus domain.OrderStatus) error {
+	return p.publish(ctx, messaging.NewOrderStatusChangedEvent(ctx, order, oldStatus, newStatus))
+}
+
+// PublishOrderRestored publishes an order.restored event to JetStream.
+func (p *Publisher) PublishOrderRestored(ctx context.Context, order *domain.Order) error {
+	return p.publish(ctx, messaging.NewOrderEvent(messaging.EventOrderRestored, order))
+}
+
+// PublishOrderDeleted publishes an order.deleted event to JetStream.
+func (p *Publisher) PublishOrderDeleted(ctx context.Context, order *domain.Order) error {
+	return p.publish(ctx, messaging.NewOrderEvent(messaging.EventOrderDeleted, order))
+}
+
+// PublishOrderSLABreached publishes an order.sla_breached event to JetStream.
+func (p *Publisher) PublishOrderSLABreached(ctx context.Context, order *domain.Order) error {
+	return p.publish(ctx, messaging.NewOrderEvent(messaging.EventOrderSLABreached, order))
+}
+
+// PublishCustomerDataErased publishes a customer.data_erased event to JetStream
+// on a subject ending in the customer ID.
+func (p *Publisher) PublishCustomerDataErased(ctx context.Context, erasure *domain.CustomerErasure) error {
+	return p.publish(ctx, messaging.NewCustomerDataErasedEvent(erasure))
+}
+
+// Redeliver re-sends a dead-lettered message to the subject it was first published on.
+func (p *Publisher) Redeliver(ctx context.Context, dl *messaging.DeadLetter) error {
+	msg := nats.NewMsg(dl.Topic)
+	msg.Data = dl.Payload
+	for k, v := range dl.Headers {
+		msg.Header.Set(k, v)
+	}
+	_, err := p.js.PublishMsg(ctx, msg)
+	return err
+}
+
+// SendEvent publishes an already built event, such as one replayed from
+// order history.
+func (p *Publisher) SendEvent(ctx context.Context, evt messaging.OrderEvent) error {
+	return p.publish(ctx, evt)
+}
+
+// Close drains and closes the NATS connection.
+func (p *Publisher) Close() error {
+	if p.conn == nil {
+		return nil
+	}
+	return p.conn.Drain()
+}
+
+func (p *Publisher) publish(ctx context.Context, evt messaging.OrderEvent) error {
+	evt.CorrelationID = correlation.ID(ctx)
+	value, err := messaging.EncodeOrderEvent(evt, p.format, p.source)
+	if err != nil {
+		return err
+	}
+
+	msg := nats.NewMsg(Subject(p.prefix, evt.EventType, evt.Key()))
+	msg.Data = value
+	msg.Header.Set(OrderingKeyHeader, evt.Key())
+	if p.format != messaging.EventFormatLegacy {
+		msg.Header.Set("content-type", messaging.CloudEventsContentType)
+	}
+
+	err = p.resilience.Do(ctx, func(ctx context.Context) error {
+		_, err := p.js.PublishMsg(ctx, msg)
+		return err
+	})
+	if err != nil {
+		return p.deadLetter(ctx, msg, evt, err)
+	}
+	return nil
+}
+
+// deadLetter persists a message JetStream did not acknowledge.
+func (p *Publisher) deadLetter(ctx context.Context, msg *nats.Msg, evt messaging.OrderEvent, pubErr error) error {
+	if p.deadLetters == nil {
+		return pubErr
+	}
+
+	headers := make(map[string]string, len(msg.Header))
+	for k := range msg.Header {
+		headers[k] = msg.Header.Get(k)
+	}
+	dl := &messaging.DeadLetter{
+		Topic:     msg.Subject,
+		Key:       evt.Key(),
+		Payload:   msg.Data,
+		Headers:   headers,
+		EventType: evt.EventType,
+		OrderID:   evt.OrderID,
+		LastError: pubErr.Error(),
+	}
+	if err := p.deadLetters.Save(ctx, dl); err != nil {
+		return fmt.Errorf("%w (dead-letter save failed: %v)", pubErr, err)
+	}
+
+	slog.WarnContext(ctx, "event publish failed, stored in dead-letter queue",
+		slog.String("event_type", evt.EventType),
+		slog.String("order_id", evt.OrderID),
+		slog.String("dead_letter_id", dl.ID.String()),
+		slog.String("error", pubErr.Error()),
+	)
+	return nil
+}
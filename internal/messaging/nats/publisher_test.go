@@ -0,0 +1,188 @@
+package nats
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockStream captures messages published to JetStream for test assertions.
+type mockStream struct {
+	mu       sync.Mutex
+	messages []*nats.Msg
+	err      error
+}
+
+func (m *mockStream) PublishMsg(_ context.Context, msg *nats.Msg, _ ...jetstream.PublishOpt) (*jetstream.PubAck, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.messages = append(m.messages, msg)
+	return &jetstream.PubAck{Stream: "ORDERS", Sequence: uint64(len(m.messages))}, nil
+}
+
+func (m *mockStream) lastMessage() *nats.Msg {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.messages[len(m.messages)-1]
+}
+
+func newTestPublisher(js *mockStream) *Publisher {
+	return &Publisher{js: js, prefix: "orders"}
+}
+
+func newTestOrder() *domain.Order {
+	return &domain.Order{
+		ID:         uuid.New(),
+		CustomerID: "cust-123",
+		Items: []domain.OrderItem{
+			{ID: uuid.New(), ProductID: "p-1", Name: "Widget", Quantity: 2, Price: 10.50, Subtotal: 21.00},
+		},
+		Status:  domain.OrderStatusConfirmed,
+		Total:   21.00,
+		Version: 2,
+	}
+}
+
+func TestPublisher_SubjectPerEventType(t *testing.T) {
+	tests := []struct {
+		name      string
+		publish   func(pub *Publisher, order *domain.Order) error
+		eventType string
+	}{
+		{
+			name: "created",
+			publish: func(pub *Publisher, order *domain.Order) error {
+				return pub.PublishOrderCreated(context.Background(), order)
+			},
+			eventType: messaging.EventOrderCreated,
+		},
+		{
+			name: "updated",
+			publish: func(pub *Publisher, order *domain.Order) error {
+				return pub.PublishOrderUpdated(context.Background(), order)
+			},
+			eventType: messaging.EventOrderUpdated,
+		},
+		{
+			name: "status_changed",
+			publish: func(pub *Publisher, order *domain.Order) error {
+				return pub.PublishOrderStatusChanged(context.Background(), order,
+					domain.OrderStatusPending, domain.OrderStatusConfirmed)
+			},
+			eventType: messaging.EventOrderStatusChanged,
+		},
+		{
+			name: "restored",
+			publish: func(pub *Publisher, order *domain.Order) error {
+				return pub.PublishOrderRestored(context.Background(), order)
+			},
+			eventType: messaging.EventOrderRestored,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			js := &mockStream{}
+			pub := newTestPublisher(js)
+			order := newTestOrder()
+
+			require.NoError(t, tt.publish(pub, order))
+
+			msg := js.lastMessage()
+			assert.Equal(t, "orders."+tt.eventType+"."+order.ID.String(), msg.Subject)
+			assert.Equal(t, order.ID.String(), msg.Header.Get(OrderingKeyHeader),
+				"ordering key must be order ID, matching the Kafka message key")
+
+			evt, err := messaging.DecodeOrderEvent(msg.Data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.eventType, evt.EventType)
+			assert.Equal(t, order.ID.String(), evt.OrderID)
+			assert.Equal(t, 2, evt.Version)
+		})
+	}
+}
+
+func TestPublisher_StatusChanged_IncludesOldAndNewStatus(t *testing.T) {
+	js := &mockStream{}
+	pub := newTestPublisher(js)
+
+	err := pub.PublishOrderStatusChanged(context.Background(), newTestOrder(),
+		domain.OrderStatusPending, domain.OrderStatusConfirmed)
+
+	require.NoError(t, err)
+	evt, err := messaging.DecodeOrderEvent(js.lastMessage().Data)
+	require.NoError(t, err)
+	assert.Equal(t, "pending", evt.OldStatus)
+	assert.Equal(t, "confirmed", evt.NewStatus)
+}
+
+func TestPublisher_PublishError_NoDeadLetters_ReturnsError(t *testing.T) {
+	js := &mockStream{err: errors.New("no responders")}
+	pub := newTestPublisher(js)
+
+	err := pub.PublishOrderCreated(context.Background(), newTestOrder())
+
+	assert.ErrorContains(t, err, "no responders")
+}
+
+type memoryDeadLetters struct {
+	messaging.DeadLetterStore
+	saved []*messaging.DeadLetter
+}
+
+func (m *memoryDeadLetters) Save(_ context.Context, dl *messaging.DeadLetter) error {
+	dl.ID = uuid.New()
+	m.saved = append(m.saved, dl)
+	return nil
+}
+
+func TestPublisher_PublishError_WithDeadLetters_StoresSubject(t *testing.T) {
+	js := &mockStream{err: errors.New("no responders")}
+	dlq := &memoryDeadLetters{}
+	pub := newTestPublisher(js)
+	pub.deadLetters = dlq
+	order := newTestOrder()
+
+	err := pub.PublishOrderCreated(context.Background(), order)
+
+	require.NoError(t, err)
+	require.Len(t, dlq.saved, 1)
+	assert.Equal(t, Subject("orders", messaging.EventOrderCreated, order.ID.String()), dlq.saved[0].Topic)
+	assert.Equal(t, order.ID.String(), dlq.saved[0].Headers[OrderingKeyHeader])
+
+	js.err = nil
+	require.NoError(t, pub.Redeliver(context.Background(), dlq.saved[0]))
+	assert.Equal(t, dlq.saved[0].Topic, js.lastMessage().Subject)
+	assert.Equal(t, dlq.saved[0].Payload, js.lastMessage().Data)
+}
+
+func TestPublisher_CustomerDataErased_KeyedByCustomerID(t *testing.T) {
+	js := &mockStream{}
+	pub := newTestPublisher(js)
+	erasure := domain.NewCustomerErasure("cust-123", "dpo")
+	erasure.OrderCount = 3
+
+	require.NoError(t, pub.PublishCustomerDataErased(context.Background(), erasure))
+
+	msg := js.lastMessage()
+	assert.Equal(t, "orders."+messaging.EventCustomerDataErased+".cust-123", msg.Subject)
+	assert.Equal(t, "cust-123", msg.Header.Get(OrderingKeyHeader))
+
+	evt, err := messaging.DecodeOrderEvent(msg.Data)
+	require.NoError(t, err)
+	assert.Empty(t, evt.OrderID)
+	assert.Equal(t, "cust-123", evt.CustomerID)
+	assert.Equal(t, 3, evt.OrderCount)
+}
@@ -20,3 +20,17 @@ func (Publisher) PublishOrderUpdated(_ context.Context, _ *domain.Order) error {
 func (Publisher) PublishOrderStatusChanged(_ context.Context, _ *domain.Order, _, _ domain.OrderStatus) error {
 	return nil
 }
+
+// PublishOrderRestored is a no-op.
+func (Publisher) PublishOrderRestored(_ context.Context, _ *domain.Order) error { return nil }
+
+// PublishOrderDeleted is a no-op.
+func (Publisher) PublishOrderDeleted(_ context.Context, _ *domain.Order) error { return nil }
+
+// PublishOrderSLABreached is a no-op.
+func (Publisher) PublishOrderSLABreached(_ context.Context, _ *domain.Order) error { return nil }
+
+// PublishCustomerDataErased is a no-op.
+func (Publisher) PublishCustomerDataErased(_ context.Context, _ *domain.CustomerErasure) error {
+	return nil
+}
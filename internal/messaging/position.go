@@ -0,0 +1,130 @@
+package messaging
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidResumeToken is returned for a resume token not issued by Position.Token.
+var ErrInvalidResumeToken = errors.New("invalid resume token")
+
+// resumeTokenPrefix versions the token format
+const resumeTokenPrefix = "v1:"
+
+// TopicPartition identifies a partition of the main event topic, whose
+// Topic is empty, or of a topic events are routed to (see TopicRouter).
+type TopicPartition struct {
+	Topic     string
+	Partition int
+}
+
+// Position is how far a stream has read the event topics: for each
+// partition it has seen, the offset of the next event to read.
+type Position map[TopicPartition]int64
+
+// Advance records that the event at offset in partition of topic has been
+// read. topic is empty for the main topic, as in OrderEvent.Topic.
+func (p Position) Advance(topic string, partition int, offset int64) {
+	tp := TopicPartition{Topic: topic, Partition: partition}
+	if next, ok := p[tp]; !ok || offset >= next {
+		p[tp] = offset + 1
+	}
+}
+
+// Includes reports whether the event at offset in partition of topic was
+// read before p, so a stream at p has already seen it.
+func (p Position) Includes(topic string, partition int, offset int64) bool {
+	next, ok := p[TopicPartition{Topic: topic, Partition: partition}]
+	return ok && offset < next
+}
+
+// compareTopicPartitions orders partitions by topic, then number.
+func compareTopicPartitions(a, b TopicPartition) int {
+	if c := strings.Compare(a.Topic, b.Topic); c != 0 {
+		return c
+	}
+	return a.Partition - b.Partition
+}
+
+// SortedPartitions returns the partitions of p in topic and partition order.
+func (p Position) SortedPartitions() []TopicPartition {
+	return slices.SortedFunc(maps.Keys(p), compareTopicPartitions)
+}
+
+// Clone returns a copy of p that can be advanced independently.
+func (p Position) Clone() Position {
+	if p == nil {
+		return Position{}
+	}
+	return maps.Clone(p)
+}
+
+// Token encodes p as an opaque resume token for clients. Partitions of the
+// main topic are written as "partition=offset" and those of routed topics as
+// "topic/partition=offset", so tokens issued before routing stay valid.
+func (p Position) Token() string {
+	parts := make([]string, 0, len(p))
+	for _, tp := range p.SortedPartitions() {
+		part := fmt.Sprintf("%d=%d", tp.Partition, p[tp])
+		if tp.Topic != "" {
+			part = tp.Topic + "/" + part
+		}
+		parts = append(parts, part)
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(resumeTokenPrefix + strings.Join(parts, ",")))
+}
+
+// ParsePosition decodes a resume token returned by Position.Token.
+func ParsePosition(token string) (Position, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidResumeToken
+	}
+	body, ok := strings.CutPrefix(string(raw), resumeTokenPrefix)
+	if !ok {
+		return nil, ErrInvalidResumeToken
+	}
+
+	pos := Position{}
+	if body == "" {
+		return pos, nil
+	}
+	for _, part := range strings.Split(body, ",") {
+		var topic string
+		if t, rest, routed := strings.Cut(part, "/"); routed {
+			if t == "" {
+				return nil, ErrInvalidResumeToken
+			}
+			topic, part = t, rest
+		}
+		partitionStr, offsetStr, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, ErrInvalidResumeToken
+		}
+		partition, err := strconv.Atoi(partitionStr)
+		if err != nil || partition < 0 {
+			return nil, ErrInvalidResumeToken
+		}
+		offset, err := strconv.ParseInt(offsetStr, 10, 64)
+		if err != nil || offset < 0 {
+			return nil, ErrInvalidResumeToken
+		}
+		pos[TopicPartition{Topic: topic, Partition: partition}] = offset
+	}
+	return pos, nil
+}
+
+// Replayer reads events already on the topic, so a stream can resume from
+// the Position of an earlier one.
+type Replayer interface {
+	// Replay calls fn with each event from the offsets in from up to the end
+	// of each partition at the time of the call, partition by partition.
+	// Events carry their Topic, Partition and Offset.
+	Replay(ctx context.Context, from Position, fn func(OrderEvent) error) error
+}
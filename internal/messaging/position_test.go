@@ -0,0 +1,62 @@
+package messaging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPosition_Advance_MovesPastOffset(t *testing.T) {
+	p := Position{}
+
+	p.Advance("", 0, 5)
+	p.Advance("", 0, 3)
+	p.Advance("", 2, 0)
+	p.Advance("orders-created", 0, 9)
+
+	assert.Equal(t, Position{{"", 0}: 6, {"", 2}: 1, {"orders-created", 0}: 10}, p)
+}
+
+func TestPosition_Includes(t *testing.T) {
+	p := Position{{"", 0}: 6}
+
+	assert.True(t, p.Includes("", 0, 5))
+	assert.False(t, p.Includes("", 0, 6))
+	assert.False(t, p.Includes("", 1, 0), "an unseen partition includes nothing")
+	assert.False(t, p.Includes("orders-created", 0, 0), "partitions of other topics are tracked apart")
+}
+
+func TestPosition_Token_RoundTrips(t *testing.T) {
+	for _, p := range []Position{
+		{},
+		{{"", 0}: 6},
+		{{"", 0}: 12, {"", 3}: 0, {"", 1}: 400},
+		{{"", 0}: 2, {"orders-created", 0}: 7, {"orders-deleted", 1}: 3},
+	} {
+		got, err := ParsePosition(p.Token())
+		require.NoError(t, err)
+		assert.Equal(t, p, got)
+	}
+}
+
+func TestParsePosition_TokenWithoutTopics_ReadsMainTopic(t *testing.T) {
+	got, err := ParsePosition("djE6MD02") // "v1:0=6", as issued before topic routing
+
+	require.NoError(t, err)
+	assert.Equal(t, Position{{"", 0}: 6}, got)
+}
+
+func TestParsePosition_InvalidToken_ReturnsError(t *testing.T) {
+	for _, token := range []string{
+		"not base64!",
+		"MDo1",       // "0:5", no version
+		"djE6MA",     // "v1:0", no offset
+		"djE6MD0tMQ", // "v1:0=-1"
+		"djE6eD01",   // "v1:x=5"
+		"djE6LzA9NQ", // "v1:/0=5", empty topic
+	} {
+		_, err := ParsePosition(token)
+		assert.ErrorIs(t, err, ErrInvalidResumeToken, token)
+	}
+}
@@ -0,0 +1,86 @@
+package messaging
+
+import (
+	"time"
+
+	eventsv1 "github.com/sridharn-code-sandbox/go-ordersvc/api/proto/events/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// encodeProtobufOrderEvent marshals evt as an ordersvc.events.v1.OrderEvent.
+func encodeProtobufOrderEvent(evt OrderEvent) ([]byte, error) {
+	return proto.Marshal(&eventsv1.OrderEvent{
+		EventId:             evt.EventID,
+		EventType:           evt.EventType,
+		OrderId:             evt.OrderID,
+		CustomerId:          evt.CustomerID,
+		Status:              evt.Status,
+		OldStatus:           evt.OldStatus,
+		NewStatus:           evt.NewStatus,
+		Total:               evt.Total,
+		Version:             int32(evt.Version), // #nosec G115 -- version is a small incrementing counter
+		OccurredAt:          timestamppb.New(evt.OccurredAt),
+		OrderCount:          int32(evt.OrderCount), // #nosec G115 -- a customer's order count fits in int32
+		CorrelationId:       evt.CorrelationID,
+		HoldReason:          evt.HoldReason,
+		HoldReleaseAt:       optionalTimestamppb(evt.HoldReleaseAt),
+		Metadata:            evt.Metadata,
+		Tags:                evt.Tags,
+		EstimatedDeliveryAt: optionalTimestamppb(evt.EstimatedDeliveryAt),
+		SlaBreachedAt:       optionalTimestamppb(evt.SLABreachedAt),
+		Replayed:            evt.Replayed,
+		TenantId:            evt.TenantID,
+		Actor:               evt.Actor,
+		ActorType:           evt.ActorType,
+		Channel:             evt.Channel,
+	})
+}
+
+// decodeProtobufOrderEvent unmarshals an ordersvc.events.v1.OrderEvent.
+func decodeProtobufOrderEvent(data []byte) (OrderEvent, error) {
+	var pb eventsv1.OrderEvent
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return OrderEvent{}, err
+	}
+	return OrderEvent{
+		EventID:             pb.GetEventId(),
+		EventType:           pb.GetEventType(),
+		OrderID:             pb.GetOrderId(),
+		CustomerID:          pb.GetCustomerId(),
+		Status:              pb.GetStatus(),
+		OldStatus:           pb.GetOldStatus(),
+		NewStatus:           pb.GetNewStatus(),
+		Total:               pb.GetTotal(),
+		Version:             int(pb.GetVersion()),
+		OccurredAt:          pb.GetOccurredAt().AsTime(),
+		OrderCount:          int(pb.GetOrderCount()),
+		CorrelationID:       pb.GetCorrelationId(),
+		HoldReason:          pb.GetHoldReason(),
+		HoldReleaseAt:       optionalTime(pb.GetHoldReleaseAt()),
+		Metadata:            pb.GetMetadata(),
+		Tags:                pb.GetTags(),
+		EstimatedDeliveryAt: optionalTime(pb.GetEstimatedDeliveryAt()),
+		SLABreachedAt:       optionalTime(pb.GetSlaBreachedAt()),
+		Replayed:            pb.GetReplayed(),
+		TenantID:            pb.GetTenantId(),
+		Actor:               pb.GetActor(),
+		ActorType:           pb.GetActorType(),
+		Channel:             pb.GetChannel(),
+	}, nil
+}
+
+func optionalTimestamppb(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
@@ -0,0 +1,155 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/breaker"
+)
+
+// ErrCircuitOpen is returned by Resilience.Do while the broker's circuit
+// breaker is open and calls are not attempted.
+var ErrCircuitOpen = errors.New("event broker circuit breaker is open")
+
+// ResilienceConfig bounds how hard a publisher tries the broker.
+type ResilienceConfig struct {
+	// MaxAttempts is how many times a write is tried, including the first
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry; it doubles up to MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// FailureThreshold is how many consecutive failed publishes open the breaker
+	FailureThreshold int
+	// Cooldown is how long the breaker stays open before a trial publish
+	Cooldown time.Duration
+}
+
+// ResilienceMetrics records publish retries, rejections and breaker state.
+type ResilienceMetrics struct {
+	state    prometheus.Gauge
+	retries  prometheus.Counter
+	rejected prometheus.Counter
+}
+
+// NewResilienceMetrics registers the event publishing resilience metrics with reg.
+func NewResilienceMetrics(reg prometheus.Registerer) *ResilienceMetrics {
+	m := &ResilienceMetrics{
+		state: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "event_publish_breaker_state",
+			Help: "State of the event broker circuit breaker: 0 closed, 1 open, 2 half-open.",
+		}),
+		retries: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "event_publish_retries_total",
+			Help: "Event broker writes retried after a failure.",
+		}),
+		rejected: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "event_publish_rejected_total",
+			Help: "Event publishes not attempted because the circuit breaker was open.",
+		}),
+	}
+	reg.MustRegister(m.state, m.retries, m.rejected)
+	return m
+}
+
+func (m *ResilienceMetrics) setState(s breaker.State) {
+	if m != nil {
+		m.state.Set(float64(s))
+	}
+}
+
+func (m *ResilienceMetrics) retry() {
+	if m != nil {
+		m.retries.Inc()
+	}
+}
+
+func (m *ResilienceMetrics) reject() {
+	if m != nil {
+		m.rejected.Inc()
+	}
+}
+
+// Resilience retries broker writes with exponential backoff and stops
+// attempting them while the broker keeps failing. Publishers run each write
+// through Do and fall back to logging and dead-lettering the event when it
+// returns an error, so a broker outage costs requests no more than a quick
+// dead-letter insert once the breaker has opened.
+type Resilience struct {
+	cfg     ResilienceConfig
+	breaker *breaker.Breaker
+	metrics *ResilienceMetrics
+	sleep   func(ctx context.Context, d time.Duration) error
+}
+
+// NewResilience creates a Resilience policy. metrics may be nil.
+func NewResilience(cfg ResilienceConfig, metrics *ResilienceMetrics) *Resilience {
+	return &Resilience{
+		cfg: cfg,
+		breaker: breaker.New(breaker.Config{
+			FailureThreshold: cfg.FailureThreshold,
+			Cooldown:         cfg.Cooldown,
+			OnStateChange:    metrics.setState,
+		}),
+		metrics: metrics,
+		sleep:   sleep,
+	}
+}
+
+// Do runs write until it succeeds or MaxAttempts is reached, and returns
+// ErrCircuitOpen without running it while the breaker is open. A nil
+// Resilience runs write once.
+func (r *Resilience) Do(ctx context.Context, write func(ctx context.Context) error) error {
+	if r == nil {
+		return write(ctx)
+	}
+	if !r.breaker.Allow() {
+		r.metrics.reject()
+		return ErrCircuitOpen
+	}
+
+	delay := r.cfg.InitialBackoff
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = write(ctx); err == nil {
+			if r.breaker.Success() {
+				slog.InfoContext(ctx, "event broker recovered, circuit breaker closed")
+			}
+			return nil
+		}
+		if ctx.Err() != nil {
+			r.breaker.Abandon()
+			return err
+		}
+		if attempt >= r.cfg.MaxAttempts {
+			break
+		}
+		r.metrics.retry()
+		if sleepErr := r.sleep(ctx, delay); sleepErr != nil {
+			r.breaker.Abandon()
+			return err
+		}
+		delay = min(delay*2, r.cfg.MaxBackoff)
+	}
+
+	if r.breaker.Failure() {
+		slog.WarnContext(ctx, "event broker failing, circuit breaker open",
+			slog.Duration("cooldown", r.cfg.Cooldown),
+			slog.String("error", err.Error()),
+		)
+	}
+	return err
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,97 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errBrokerDown = errors.New("broker unreachable")
+
+// newTestResilience returns a policy that records its backoff delays instead of sleeping
+func newTestResilience(delays *[]time.Duration) (*Resilience, *ResilienceMetrics) {
+	metrics := NewResilienceMetrics(prometheus.NewRegistry())
+	r := NewResilience(ResilienceConfig{
+		MaxAttempts:      3,
+		InitialBackoff:   100 * time.Millisecond,
+		MaxBackoff:       150 * time.Millisecond,
+		FailureThreshold: 2,
+		Cooldown:         time.Hour,
+	}, metrics)
+	r.sleep = func(_ context.Context, d time.Duration) error {
+		*delays = append(*delays, d)
+		return nil
+	}
+	return r, metrics
+}
+
+func TestResilience_Do_RetriesUntilSuccess(t *testing.T) {
+	var delays []time.Duration
+	r, metrics := newTestResilience(&delays)
+	calls := 0
+
+	err := r.Do(context.Background(), func(context.Context) error {
+		calls++
+		if calls < 3 {
+			return errBrokerDown
+		}
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 150 * time.Millisecond}, delays)
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.retries))
+}
+
+func TestResilience_Do_AttemptsExhausted_ReturnsLastError(t *testing.T) {
+	var delays []time.Duration
+	r, _ := newTestResilience(&delays)
+	calls := 0
+
+	err := r.Do(context.Background(), func(context.Context) error {
+		calls++
+		return errBrokerDown
+	})
+
+	require.ErrorIs(t, err, errBrokerDown)
+	assert.Equal(t, 3, calls)
+}
+
+func TestResilience_Do_BreakerOpen_SkipsWrite(t *testing.T) {
+	var delays []time.Duration
+	r, metrics := newTestResilience(&delays)
+	failing := func(context.Context) error { return errBrokerDown }
+	_ = r.Do(context.Background(), failing)
+	_ = r.Do(context.Background(), failing)
+
+	called := false
+	err := r.Do(context.Background(), func(context.Context) error {
+		called = true
+		return nil
+	})
+
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	assert.False(t, called)
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.rejected))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.state))
+}
+
+func TestResilience_Do_Nil_WritesOnce(t *testing.T) {
+	var r *Resilience
+	calls := 0
+
+	err := r.Do(context.Background(), func(context.Context) error {
+		calls++
+		return errBrokerDown
+	})
+
+	require.ErrorIs(t, err, errBrokerDown)
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,98 @@
+package messaging
+
+import (
+	"fmt"
+	"slices"
+)
+
+// TopicRouting selects how events are spread over topics.
+type TopicRouting string
+
+const (
+	// TopicRoutingSingle publishes every event to the main topic.
+	TopicRoutingSingle TopicRouting = "single"
+	// TopicRoutingEventType publishes the event types of a map to the
+	// topics they map to, and the others to the main topic.
+	TopicRoutingEventType TopicRouting = "event_type"
+	// TopicRoutingPrefix publishes each event type to "<topic>.<event type>",
+	// e.g. order-events.order.created.
+	TopicRoutingPrefix TopicRouting = "prefix"
+)
+
+// ParseTopicRouting converts a routing name into a TopicRouting. An empty
+// string selects TopicRoutingSingle.
+func ParseTopicRouting(s string) (TopicRouting, error) {
+	switch TopicRouting(s) {
+	case "", TopicRoutingSingle:
+		return TopicRoutingSingle, nil
+	case TopicRoutingEventType, TopicRoutingPrefix:
+		return TopicRouting(s), nil
+	default:
+		return "", fmt.Errorf("unknown topic routing %q", s)
+	}
+}
+
+// TopicRouter picks the topic of each event, so consumers can subscribe to
+// the event types they need instead of filtering the main topic.
+type TopicRouter struct {
+	topic   string
+	routing TopicRouting
+	topics  map[string]string
+}
+
+// NewTopicRouter creates a router with topic as the main topic. eventTopics
+// maps event types to topics for TopicRoutingEventType and is ignored
+// otherwise.
+func NewTopicRouter(topic string, routing TopicRouting, eventTopics map[string]string) (*TopicRouter, error) {
+	r := &TopicRouter{topic: topic, routing: routing, topics: make(map[string]string)}
+	switch routing {
+	case "", TopicRoutingSingle:
+		r.routing = TopicRoutingSingle
+	case TopicRoutingEventType:
+		for eventType, t := range eventTopics {
+			if !slices.Contains(EventTypes(), eventType) {
+				return nil, fmt.Errorf("unknown event type %q in topic routing", eventType)
+			}
+			r.topics[eventType] = t
+		}
+	case TopicRoutingPrefix:
+		for _, eventType := range EventTypes() {
+			r.topics[eventType] = topic + "." + eventType
+		}
+	default:
+		return nil, fmt.Errorf("unknown topic routing %q", routing)
+	}
+	return r, nil
+}
+
+// MainTopic returns the topic of events that are not routed elsewhere.
+func (r *TopicRouter) MainTopic() string {
+	return r.topic
+}
+
+// Routed reports whether any event type goes to a topic other than the
+// main one, which a nil router never does.
+func (r *TopicRouter) Routed() bool {
+	return r != nil && len(r.topics) > 0
+}
+
+// Topic returns the topic events of eventType are published to.
+func (r *TopicRouter) Topic(eventType string) string {
+	if t, ok := r.topics[eventType]; ok {
+		return t
+	}
+	return r.topic
+}
+
+// Topics returns every topic events may be published to, the main topic
+// first and the others sorted.
+func (r *TopicRouter) Topics() []string {
+	routed := make([]string, 0, len(r.topics))
+	for _, t := range r.topics {
+		if t != r.topic && !slices.Contains(routed, t) {
+			routed = append(routed, t)
+		}
+	}
+	slices.Sort(routed)
+	return append([]string{r.topic}, routed...)
+}
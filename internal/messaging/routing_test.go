@@ -0,0 +1,64 @@
+package messaging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopicRouter_Single_RoutesEverythingToMainTopic(t *testing.T) {
+	r, err := NewTopicRouter("order-events", TopicRoutingSingle, map[string]string{EventOrderCreated: "ignored"})
+	require.NoError(t, err)
+
+	assert.False(t, r.Routed())
+	assert.Equal(t, "order-events", r.Topic(EventOrderCreated))
+	assert.Equal(t, []string{"order-events"}, r.Topics())
+}
+
+func TestTopicRouter_EventType_RoutesMappedTypes(t *testing.T) {
+	r, err := NewTopicRouter("order-events", TopicRoutingEventType, map[string]string{
+		EventOrderCreated:       "orders-created",
+		EventOrderStatusChanged: "orders-status",
+		EventOrderDeleted:       "orders-created",
+	})
+	require.NoError(t, err)
+
+	assert.True(t, r.Routed())
+	assert.Equal(t, "orders-created", r.Topic(EventOrderCreated))
+	assert.Equal(t, "orders-status", r.Topic(EventOrderStatusChanged))
+	assert.Equal(t, "order-events", r.Topic(EventOrderUpdated))
+	assert.Equal(t, []string{"order-events", "orders-created", "orders-status"}, r.Topics())
+}
+
+func TestTopicRouter_Prefix_RoutesEveryTypeToItsOwnTopic(t *testing.T) {
+	r, err := NewTopicRouter("order-events", TopicRoutingPrefix, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "order-events.order.created", r.Topic(EventOrderCreated))
+	assert.Equal(t, "order-events.customer.data_erased", r.Topic(EventCustomerDataErased))
+	assert.Len(t, r.Topics(), len(EventTypes())+1)
+	assert.Equal(t, "order-events", r.Topics()[0])
+}
+
+func TestNewTopicRouter_UnknownEventType_ReturnsError(t *testing.T) {
+	_, err := NewTopicRouter("order-events", TopicRoutingEventType, map[string]string{"order.shipped": "orders-shipped"})
+
+	assert.ErrorContains(t, err, "order.shipped")
+}
+
+func TestParseTopicRouting(t *testing.T) {
+	for in, want := range map[string]TopicRouting{
+		"":           TopicRoutingSingle,
+		"single":     TopicRoutingSingle,
+		"event_type": TopicRoutingEventType,
+		"prefix":     TopicRoutingPrefix,
+	} {
+		got, err := ParseTopicRouting(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+
+	_, err := ParseTopicRouting("round_robin")
+	assert.Error(t, err)
+}
@@ -0,0 +1,323 @@
+package messaging
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/api/avro"
+	eventsv1 "github.com/sridharn-code-sandbox/go-ordersvc/api/proto/events/v1"
+)
+
+// Schema types as named by the schema registry.
+const (
+	SchemaTypeAvro     = "AVRO"
+	SchemaTypeProtobuf = "PROTOBUF"
+)
+
+// OrderEventRecordName is the fully qualified name of the OrderEvent record
+// in both the Avro and the protobuf schema.
+const OrderEventRecordName = "ordersvc.events.v1.OrderEvent"
+
+// Content types of the schema-registry event formats.
+const (
+	AvroContentType     = "application/avro"
+	ProtobufContentType = "application/x-protobuf"
+)
+
+// wireMagicByte starts every message in the schema registry wire format,
+// followed by the big-endian schema ID.
+const wireMagicByte = 0
+
+var (
+	// ErrIncompatibleSchema is returned when the registry rejects the event
+	// schema as an incompatible change of the subject's latest version.
+	ErrIncompatibleSchema = errors.New("event schema is incompatible with the registered schema")
+
+	// errSchemaFramed is returned when a schema registry message is decoded
+	// without a SchemaCodec.
+	errSchemaFramed = errors.New("message is in the schema registry wire format; decode it with a SchemaCodec")
+)
+
+// Schema is a schema as stored in the schema registry.
+type Schema struct {
+	// Type is SchemaTypeAvro or SchemaTypeProtobuf
+	Type       string
+	Definition string
+}
+
+// SchemaRegistry is the part of the Confluent Schema Registry API the
+// SchemaCodec needs.
+type SchemaRegistry interface {
+	// CheckCompatibility reports whether schema may be registered as the next
+	// version of subject, and why not. A subject without versions accepts
+	// any schema.
+	CheckCompatibility(ctx context.Context, subject string, schema Schema) (bool, []string, error)
+	// Register adds schema to subject, or finds it if already there, and
+	// returns its ID.
+	Register(ctx context.Context, subject string, schema Schema) (int, error)
+	// Lookup returns the ID of schema if it is registered under subject.
+	Lookup(ctx context.Context, subject string, schema Schema) (int, error)
+	// SchemaByID returns the schema with the given ID.
+	SchemaByID(ctx context.Context, id int) (Schema, error)
+}
+
+// SubjectStrategy selects the registry subject the event schema is
+// registered under, mirroring the Confluent serializer strategies.
+type SubjectStrategy string
+
+// Supported subject naming strategies.
+const (
+	// SubjectTopicName uses "<topic>-value", one schema per topic.
+	SubjectTopicName SubjectStrategy = "topic_name"
+	// SubjectRecordName uses the record name, shared by every topic.
+	SubjectRecordName SubjectStrategy = "record_name"
+	// SubjectTopicRecordName uses "<topic>-<record name>".
+	SubjectTopicRecordName SubjectStrategy = "topic_record_name"
+)
+
+// ParseSubjectStrategy converts a config string into a SubjectStrategy.
+// An empty string selects SubjectTopicName.
+func ParseSubjectStrategy(s string) (SubjectStrategy, error) {
+	switch SubjectStrategy(s) {
+	case "", SubjectTopicName:
+		return SubjectTopicName, nil
+	case SubjectRecordName, SubjectTopicRecordName:
+		return SubjectStrategy(s), nil
+	default:
+		return "", fmt.Errorf("unknown schema subject strategy %q", s)
+	}
+}
+
+// Subject returns the subject of the OrderEvent schema on topic.
+func (s SubjectStrategy) Subject(topic string) string {
+	switch s {
+	case SubjectRecordName:
+		return OrderEventRecordName
+	case SubjectTopicRecordName:
+		return topic + "-" + OrderEventRecordName
+	default:
+		return topic + "-value"
+	}
+}
+
+// SchemaCodecConfig holds SchemaCodec settings.
+type SchemaCodecConfig struct {
+	Registry SchemaRegistry
+	// Format is EventFormatAvro or EventFormatProtobuf
+	Format EventFormat
+	Topic  string
+	// Topics are further topics events are routed to (see TopicRouter).
+	// The schema is registered under their subjects as well.
+	Topics   []string
+	Strategy SubjectStrategy
+	// AutoRegister registers the schema when the subject lacks it. Without
+	// it the schema must have been registered beforehand, e.g. by a
+	// deployment pipeline.
+	AutoRegister bool
+}
+
+// SchemaCodec encodes order events as Avro or protobuf in the schema
+// registry wire format: a zero byte, the big-endian schema ID and the
+// payload (for protobuf, preceded by the message index). It decodes that
+// format as well as the JSON formats, so consumers keep working while
+// producers switch formats.
+//
+// The schema ID is resolved on first use and then cached, as are the
+// schemas of decoded messages.
+type SchemaCodec struct {
+	registry     SchemaRegistry
+	format       EventFormat
+	subject      string
+	subjects     []string
+	autoRegister bool
+
+	mu      sync.Mutex
+	id      int
+	schemas map[int]Schema
+}
+
+// NewSchemaCodec creates a codec for the avro or protobuf event format.
+func NewSchemaCodec(cfg SchemaCodecConfig) (*SchemaCodec, error) {
+	if !cfg.Format.UsesSchemaRegistry() {
+		return nil, fmt.Errorf("event format %q does not use a schema registry", cfg.Format)
+	}
+	subject := cfg.Strategy.Subject(cfg.Topic)
+	subjects := []string{subject}
+	for _, topic := range cfg.Topics {
+		if s := cfg.Strategy.Subject(topic); !slices.Contains(subjects, s) {
+			subjects = append(subjects, s)
+		}
+	}
+	return &SchemaCodec{
+		registry:     cfg.Registry,
+		format:       cfg.Format,
+		subject:      subject,
+		subjects:     subjects,
+		autoRegister: cfg.AutoRegister,
+		schemas:      make(map[int]Schema),
+	}, nil
+}
+
+// Subject returns the registry subject of the event schema on the main
+// topic.
+func (c *SchemaCodec) Subject() string {
+	return c.subject
+}
+
+// ContentType returns the content type of encoded events.
+func (c *SchemaCodec) ContentType() string {
+	if c.format == EventFormatAvro {
+		return AvroContentType
+	}
+	return ProtobufContentType
+}
+
+// Prepare checks the event schema against the latest version of each
+// subject and registers it, or looks it up when auto-registration is off,
+// returning its ID on the main topic's subject. An incompatible schema fails
+// with ErrIncompatibleSchema, so a build that would break consumers does not
+// publish.
+func (c *SchemaCodec) Prepare(ctx context.Context) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.id != 0 {
+		return c.id, nil
+	}
+
+	schema := c.schema()
+	var mainID int
+	for _, subject := range c.subjects {
+		id, err := c.resolve(ctx, subject, schema)
+		if err != nil {
+			return 0, err
+		}
+		c.schemas[id] = schema
+		if subject == c.subject {
+			mainID = id
+		}
+	}
+	c.id = mainID
+	return mainID, nil
+}
+
+// resolve checks schema against subject and registers or looks it up.
+func (c *SchemaCodec) resolve(ctx context.Context, subject string, schema Schema) (int, error) {
+	ok, reasons, err := c.registry.CheckCompatibility(ctx, subject, schema)
+	if err != nil {
+		return 0, fmt.Errorf("check schema compatibility of %s: %w", subject, err)
+	}
+	if !ok {
+		return 0, fmt.Errorf("%w: subject %s: %s", ErrIncompatibleSchema, subject, strings.Join(reasons, "; "))
+	}
+
+	var id int
+	if c.autoRegister {
+		id, err = c.registry.Register(ctx, subject, schema)
+	} else {
+		id, err = c.registry.Lookup(ctx, subject, schema)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("resolve schema of %s: %w", subject, err)
+	}
+	return id, nil
+}
+
+// Encode serializes evt, resolving the schema ID first if Prepare has not
+// succeeded yet.
+func (c *SchemaCodec) Encode(ctx context.Context, evt OrderEvent) ([]byte, error) {
+	id, err := c.Prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := []byte{wireMagicByte}
+	msg = binary.BigEndian.AppendUint32(msg, uint32(id)) // #nosec G115 -- registry IDs are positive int32
+	if c.format == EventFormatAvro {
+		return append(msg, encodeAvroOrderEvent(evt)...), nil
+	}
+	payload, err := encodeProtobufOrderEvent(evt)
+	if err != nil {
+		return nil, err
+	}
+	// Message index [0]: the first message of the schema, encoded as a
+	// single zero byte
+	msg = append(msg, 0)
+	return append(msg, payload...), nil
+}
+
+// Decode parses a message in any event format. A nil codec decodes only the
+// JSON formats.
+func (c *SchemaCodec) Decode(ctx context.Context, data []byte) (OrderEvent, error) {
+	if c == nil || len(data) == 0 || data[0] != wireMagicByte {
+		return DecodeOrderEvent(data)
+	}
+	if len(data) < 5 {
+		return OrderEvent{}, errors.New("schema registry message too short")
+	}
+
+	schema, err := c.schemaByID(ctx, int(binary.BigEndian.Uint32(data[1:5])))
+	if err != nil {
+		return OrderEvent{}, err
+	}
+	payload := data[5:]
+	switch schema.Type {
+	case SchemaTypeAvro:
+		return decodeAvroOrderEvent(payload)
+	case SchemaTypeProtobuf:
+		payload, err = skipMessageIndexes(payload)
+		if err != nil {
+			return OrderEvent{}, err
+		}
+		return decodeProtobufOrderEvent(payload)
+	default:
+		return OrderEvent{}, fmt.Errorf("unsupported schema type %q", schema.Type)
+	}
+}
+
+func (c *SchemaCodec) schema() Schema {
+	if c.format == EventFormatAvro {
+		return Schema{Type: SchemaTypeAvro, Definition: avro.OrderEventSchema}
+	}
+	return Schema{Type: SchemaTypeProtobuf, Definition: eventsv1.OrderEventSchema}
+}
+
+func (c *SchemaCodec) schemaByID(ctx context.Context, id int) (Schema, error) {
+	c.mu.Lock()
+	schema, ok := c.schemas[id]
+	c.mu.Unlock()
+	if ok {
+		return schema, nil
+	}
+
+	schema, err := c.registry.SchemaByID(ctx, id)
+	if err != nil {
+		return Schema{}, fmt.Errorf("fetch schema %d: %w", id, err)
+	}
+	c.mu.Lock()
+	c.schemas[id] = schema
+	c.mu.Unlock()
+	return schema, nil
+}
+
+// skipMessageIndexes drops the protobuf message index that precedes the
+// payload: a zigzag varint count followed by that many indexes. The schema
+// declares a single message, so the indexes themselves are not needed.
+func skipMessageIndexes(data []byte) ([]byte, error) {
+	count, n := binary.Varint(data)
+	if n <= 0 || count < 0 {
+		return nil, errors.New("invalid protobuf message index")
+	}
+	data = data[n:]
+	for range count {
+		if _, n = binary.Varint(data); n <= 0 {
+			return nil, errors.New("invalid protobuf message index")
+		}
+		data = data[n:]
+	}
+	return data, nil
+}
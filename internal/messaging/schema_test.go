@@ -0,0 +1,229 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/api/avro"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubRegistry is an in-memory SchemaRegistry.
+type stubRegistry struct {
+	incompatible []string
+	err          error
+	schemas      map[int]Schema
+	registered   []string
+	lookedUp     []string
+	fetched      int
+}
+
+func newStubRegistry() *stubRegistry {
+	return &stubRegistry{schemas: make(map[int]Schema)}
+}
+
+func (r *stubRegistry) CheckCompatibility(_ context.Context, _ string, _ Schema) (bool, []string, error) {
+	return len(r.incompatible) == 0, r.incompatible, r.err
+}
+
+func (r *stubRegistry) Register(_ context.Context, subject string, schema Schema) (int, error) {
+	r.registered = append(r.registered, subject)
+	id := len(r.schemas) + 1
+	r.schemas[id] = schema
+	return id, nil
+}
+
+func (r *stubRegistry) Lookup(_ context.Context, subject string, _ Schema) (int, error) {
+	r.lookedUp = append(r.lookedUp, subject)
+	return 7, nil
+}
+
+func (r *stubRegistry) SchemaByID(_ context.Context, id int) (Schema, error) {
+	r.fetched++
+	schema, ok := r.schemas[id]
+	if !ok {
+		return Schema{}, errors.New("schema not found")
+	}
+	return schema, nil
+}
+
+func newFullTestEvent() OrderEvent {
+	evt := newTestEvent()
+	evt.EventID = NewEventID(evt.OrderID, "2", evt.EventType)
+	evt.OccurredAt = time.Now().UTC().Truncate(time.Microsecond)
+	evt.CorrelationID = "req-1"
+	evt.HoldReason = "fraud review"
+	releaseAt := evt.OccurredAt.Add(time.Hour)
+	evt.HoldReleaseAt = &releaseAt
+	evt.Metadata = map[string]string{"channel": "web", "region": "eu"}
+	evt.Tags = []string{"gift", "priority"}
+	evt.Replayed = true
+	evt.TenantID = "acme"
+	evt.Actor = "ops@example.com"
+	evt.ActorType = "api_key"
+	evt.Channel = "http"
+	return evt
+}
+
+func newTestCodec(t *testing.T, registry SchemaRegistry, format EventFormat) *SchemaCodec {
+	t.Helper()
+	codec, err := NewSchemaCodec(SchemaCodecConfig{
+		Registry:     registry,
+		Format:       format,
+		Topic:        "order-events",
+		Strategy:     SubjectTopicName,
+		AutoRegister: true,
+	})
+	require.NoError(t, err)
+	return codec
+}
+
+func TestSchemaCodec_Encode_RoundTripsBothFormats(t *testing.T) {
+	for _, format := range []EventFormat{EventFormatAvro, EventFormatProtobuf} {
+		t.Run(string(format), func(t *testing.T) {
+			registry := newStubRegistry()
+			evt := newFullTestEvent()
+
+			data, err := newTestCodec(t, registry, format).Encode(context.Background(), evt)
+			require.NoError(t, err)
+			assert.Equal(t, []byte{0, 0, 0, 0, 1}, data[:5], "magic byte and schema ID 1")
+
+			// A consumer with its own codec fetches the schema by ID
+			got, err := newTestCodec(t, registry, EventFormatAvro).Decode(context.Background(), data)
+			require.NoError(t, err)
+			assert.Equal(t, evt, got)
+		})
+	}
+}
+
+func TestSchemaCodec_Encode_RegistersOnce(t *testing.T) {
+	registry := newStubRegistry()
+	codec := newTestCodec(t, registry, EventFormatAvro)
+
+	for range 3 {
+		_, err := codec.Encode(context.Background(), newTestEvent())
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"order-events-value"}, registry.registered)
+}
+
+func TestSchemaCodec_Prepare_RoutedTopics_RegistersEverySubject(t *testing.T) {
+	registry := newStubRegistry()
+	codec, err := NewSchemaCodec(SchemaCodecConfig{
+		Registry:     registry,
+		Format:       EventFormatAvro,
+		Topic:        "order-events",
+		Topics:       []string{"orders-created", "orders-deleted"},
+		Strategy:     SubjectTopicName,
+		AutoRegister: true,
+	})
+	require.NoError(t, err)
+
+	id, err := codec.Prepare(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, id, "events carry the ID of the main topic's subject")
+	assert.Equal(t, []string{"order-events-value", "orders-created-value", "orders-deleted-value"}, registry.registered)
+}
+
+func TestSchemaCodec_Prepare_IncompatibleSchema_ReturnsError(t *testing.T) {
+	registry := newStubRegistry()
+	registry.incompatible = []string{"READER_FIELD_MISSING_DEFAULT_VALUE: total"}
+
+	_, err := newTestCodec(t, registry, EventFormatAvro).Prepare(context.Background())
+
+	require.ErrorIs(t, err, ErrIncompatibleSchema)
+	assert.Contains(t, err.Error(), "READER_FIELD_MISSING_DEFAULT_VALUE")
+	assert.Empty(t, registry.registered, "an incompatible schema is never registered")
+}
+
+func TestSchemaCodec_Prepare_NoAutoRegister_LooksUpSchema(t *testing.T) {
+	registry := newStubRegistry()
+	codec, err := NewSchemaCodec(SchemaCodecConfig{
+		Registry: registry,
+		Format:   EventFormatProtobuf,
+		Topic:    "order-events",
+		Strategy: SubjectRecordName,
+	})
+	require.NoError(t, err)
+
+	id, err := codec.Prepare(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 7, id)
+	assert.Equal(t, []string{OrderEventRecordName}, registry.lookedUp)
+	assert.Empty(t, registry.registered)
+}
+
+func TestSchemaCodec_Decode_JSONAndCachedSchemas(t *testing.T) {
+	registry := newStubRegistry()
+	codec := newTestCodec(t, registry, EventFormatAvro)
+	evt := newTestEvent()
+
+	legacy, err := EncodeOrderEvent(evt, EventFormatLegacy, "")
+	require.NoError(t, err)
+	got, err := codec.Decode(context.Background(), legacy)
+	require.NoError(t, err)
+	assert.Equal(t, evt.OrderID, got.OrderID)
+
+	consumer := newTestCodec(t, registry, EventFormatAvro)
+	data, err := codec.Encode(context.Background(), evt)
+	require.NoError(t, err)
+	for range 2 {
+		_, err = consumer.Decode(context.Background(), data)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, registry.fetched, "schemas are fetched once per ID")
+}
+
+func TestDecodeOrderEvent_SchemaRegistryMessage_ReturnsError(t *testing.T) {
+	data, err := newTestCodec(t, newStubRegistry(), EventFormatProtobuf).Encode(context.Background(), newTestEvent())
+	require.NoError(t, err)
+
+	_, err = DecodeOrderEvent(data)
+
+	assert.ErrorIs(t, err, errSchemaFramed)
+}
+
+func TestSubjectStrategy_Subject(t *testing.T) {
+	assert.Equal(t, "order-events-value", SubjectTopicName.Subject("order-events"))
+	assert.Equal(t, "ordersvc.events.v1.OrderEvent", SubjectRecordName.Subject("order-events"))
+	assert.Equal(t, "order-events-ordersvc.events.v1.OrderEvent", SubjectTopicRecordName.Subject("order-events"))
+}
+
+// The Avro encoder writes fields by hand, so it must follow the schema's order.
+func TestAvroSchema_FieldOrderMatchesEncoder(t *testing.T) {
+	var schema struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+		Fields    []struct {
+			Name string `json:"name"`
+		} `json:"fields"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(avro.OrderEventSchema), &schema))
+
+	var names []string
+	for _, f := range schema.Fields {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, OrderEventRecordName, schema.Namespace+"."+schema.Name)
+	assert.Equal(t, []string{
+		"event_id", "event_type", "order_id", "customer_id", "status", "old_status", "new_status",
+		"total", "version", "occurred_at", "order_count", "correlation_id", "hold_reason",
+		"hold_release_at", "metadata", "tags", "estimated_delivery_at", "sla_breached_at", "replayed",
+		"tenant_id", "actor", "actor_type", "channel",
+	}, names)
+}
+
+func TestDecodeAvroOrderEvent_Truncated_ReturnsError(t *testing.T) {
+	data := encodeAvroOrderEvent(newFullTestEvent())
+
+	_, err := decodeAvroOrderEvent(data[:len(data)/2])
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,203 @@
+// Package schemaregistry is a client for the Confluent Schema Registry REST API.
+package schemaregistry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+)
+
+// Registry error codes returned alongside 404s.
+const (
+	errorSubjectNotFound = 40401
+	errorVersionNotFound = 40402
+	errorSchemaNotFound  = 40403
+)
+
+// registryContentType is the media type of registry requests and responses.
+const registryContentType = "application/vnd.schemaregistry.v1+json"
+
+// ErrSchemaNotRegistered is returned by Lookup when the subject lacks the schema.
+var ErrSchemaNotRegistered = errors.New("schema is not registered under the subject")
+
+// Config holds Schema Registry connection settings.
+type Config struct {
+	// URL is the registry endpoint, e.g. http://localhost:8081
+	URL      string
+	Username string
+	Password string
+	// Timeout bounds each request; defaults to 10s
+	Timeout time.Duration
+}
+
+// Client implements messaging.SchemaRegistry over HTTP.
+type Client struct {
+	baseURL  string
+	username string
+	password string
+	client   *http.Client
+}
+
+// NewClient creates a Schema Registry client.
+func NewClient(cfg Config) *Client {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &Client{
+		baseURL:  strings.TrimRight(cfg.URL, "/"),
+		username: cfg.Username,
+		password: cfg.Password,
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+// schemaRequest is the body of the register, lookup and compatibility calls.
+// The registry treats an empty schemaType as AVRO.
+type schemaRequest struct {
+	Schema     string `json:"schema"`
+	SchemaType string `json:"schemaType,omitempty"`
+}
+
+// errorResponse is the body of a failed registry call.
+type errorResponse struct {
+	ErrorCode int    `json:"error_code"`
+	Message   string `json:"message"`
+}
+
+// CheckCompatibility implements messaging.SchemaRegistry against the
+// subject's latest version, under whatever compatibility level the subject
+// is configured with.
+func (c *Client) CheckCompatibility(ctx context.Context, subject string, schema messaging.Schema) (bool, []string, error) {
+	path := "/compatibility/subjects/" + url.PathEscape(subject) + "/versions/latest?verbose=true"
+	var resp struct {
+		IsCompatible bool     `json:"is_compatible"`
+		Messages     []string `json:"messages"`
+	}
+	err := c.do(ctx, http.MethodPost, path, newSchemaRequest(schema), &resp)
+	var regErr *Error
+	if errors.As(err, &regErr) && (regErr.Code == errorSubjectNotFound || regErr.Code == errorVersionNotFound) {
+		// The first version of a subject is compatible with nothing
+		return true, nil, nil
+	}
+	if err != nil {
+		return false, nil, err
+	}
+	return resp.IsCompatible, resp.Messages, nil
+}
+
+// Register implements messaging.SchemaRegistry.
+func (c *Client) Register(ctx context.Context, subject string, schema messaging.Schema) (int, error) {
+	var resp struct {
+		ID int `json:"id"`
+	}
+	path := "/subjects/" + url.PathEscape(subject) + "/versions"
+	if err := c.do(ctx, http.MethodPost, path, newSchemaRequest(schema), &resp); err != nil {
+		return 0, err
+	}
+	return resp.ID, nil
+}
+
+// Lookup implements messaging.SchemaRegistry. A subject or schema the
+// registry does not know fails with ErrSchemaNotRegistered.
+func (c *Client) Lookup(ctx context.Context, subject string, schema messaging.Schema) (int, error) {
+	var resp struct {
+		ID int `json:"id"`
+	}
+	err := c.do(ctx, http.MethodPost, "/subjects/"+url.PathEscape(subject), newSchemaRequest(schema), &resp)
+	var regErr *Error
+	if errors.As(err, &regErr) && (regErr.Code == errorSubjectNotFound || regErr.Code == errorSchemaNotFound) {
+		return 0, fmt.Errorf("%w: %s", ErrSchemaNotRegistered, subject)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return resp.ID, nil
+}
+
+// SchemaByID implements messaging.SchemaRegistry.
+func (c *Client) SchemaByID(ctx context.Context, id int) (messaging.Schema, error) {
+	var resp schemaRequest
+	if err := c.do(ctx, http.MethodGet, "/schemas/ids/"+strconv.Itoa(id), nil, &resp); err != nil {
+		return messaging.Schema{}, err
+	}
+	if resp.SchemaType == "" {
+		resp.SchemaType = messaging.SchemaTypeAvro
+	}
+	return messaging.Schema{Type: resp.SchemaType, Definition: resp.Schema}, nil
+}
+
+func newSchemaRequest(schema messaging.Schema) schemaRequest {
+	return schemaRequest{Schema: schema.Definition, SchemaType: schema.Type}
+}
+
+// Error is a failed registry call.
+type Error struct {
+	Status  int
+	Code    int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("schema registry: status %d (error code %d): %s", e.Status, e.Code, e.Message)
+}
+
+// do sends payload as JSON and decodes a successful response into out.
+func (c *Client) do(ctx context.Context, method, path string, payload, out any) error {
+	var body io.Reader
+	if payload != nil {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", registryContentType)
+	if payload != nil {
+		req.Header.Set("Content-Type", registryContentType)
+	}
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("schema registry: %s %s: %w", method, path, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("schema registry: %s %s: %w", method, path, err)
+	}
+	if resp.StatusCode >= 300 {
+		regErr := &Error{Status: resp.StatusCode}
+		var e errorResponse
+		if json.Unmarshal(respBody, &e) == nil && e.ErrorCode != 0 {
+			regErr.Code, regErr.Message = e.ErrorCode, e.Message
+		} else {
+			const maxBody = 512
+			if len(respBody) > maxBody {
+				respBody = respBody[:maxBody]
+			}
+			regErr.Message = string(respBody)
+		}
+		return regErr
+	}
+	return json.Unmarshal(respBody, out)
+}
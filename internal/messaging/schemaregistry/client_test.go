@@ -0,0 +1,107 @@
+package schemaregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeRegistryError(w http.ResponseWriter, status, code int, msg string) {
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(errorResponse{ErrorCode: code, Message: msg})
+}
+
+func TestClient_Register_SendsSchemaAndType(t *testing.T) {
+	var gotPath, gotUser string
+	var got schemaRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.EscapedPath()
+		gotUser, _, _ = r.BasicAuth()
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		_, _ = w.Write([]byte(`{"id": 42}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(Config{URL: srv.URL + "/", Username: "svc", Password: "secret"})
+	id, err := client.Register(context.Background(), "order-events-value",
+		messaging.Schema{Type: messaging.SchemaTypeProtobuf, Definition: `syntax = "proto3";`})
+
+	require.NoError(t, err)
+	assert.Equal(t, 42, id)
+	assert.Equal(t, "/subjects/order-events-value/versions", gotPath)
+	assert.Equal(t, "svc", gotUser)
+	assert.Equal(t, schemaRequest{Schema: `syntax = "proto3";`, SchemaType: "PROTOBUF"}, got)
+}
+
+func TestClient_CheckCompatibility_ReturnsMessages(t *testing.T) {
+	var gotQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		_, _ = w.Write([]byte(`{"is_compatible": false, "messages": ["field total removed"]}`))
+	}))
+	defer srv.Close()
+
+	ok, reasons, err := NewClient(Config{URL: srv.URL}).CheckCompatibility(context.Background(), "order-events-value", messaging.Schema{})
+
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, []string{"field total removed"}, reasons)
+	assert.Equal(t, "verbose=true", gotQuery)
+}
+
+func TestClient_CheckCompatibility_NewSubject_IsCompatible(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeRegistryError(w, http.StatusNotFound, errorSubjectNotFound, "Subject 'order-events-value' not found.")
+	}))
+	defer srv.Close()
+
+	ok, _, err := NewClient(Config{URL: srv.URL}).CheckCompatibility(context.Background(), "order-events-value", messaging.Schema{})
+
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestClient_Lookup_UnknownSchema_ReturnsErrSchemaNotRegistered(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeRegistryError(w, http.StatusNotFound, errorSchemaNotFound, "Schema not found")
+	}))
+	defer srv.Close()
+
+	_, err := NewClient(Config{URL: srv.URL}).Lookup(context.Background(), "order-events-value", messaging.Schema{})
+
+	assert.ErrorIs(t, err, ErrSchemaNotRegistered)
+}
+
+func TestClient_SchemaByID_DefaultsToAvro(t *testing.T) {
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		_, _ = w.Write([]byte(`{"schema": "{\"type\": \"record\"}"}`))
+	}))
+	defer srv.Close()
+
+	schema, err := NewClient(Config{URL: srv.URL}).SchemaByID(context.Background(), 3)
+
+	require.NoError(t, err)
+	assert.Equal(t, "/schemas/ids/3", gotPath)
+	assert.Equal(t, messaging.Schema{Type: messaging.SchemaTypeAvro, Definition: `{"type": "record"}`}, schema)
+}
+
+func TestClient_ServerError_ReturnsError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeRegistryError(w, http.StatusInternalServerError, 50001, "Error in the backend data store")
+	}))
+	defer srv.Close()
+
+	_, err := NewClient(Config{URL: srv.URL}).Register(context.Background(), "s", messaging.Schema{})
+
+	var regErr *Error
+	require.ErrorAs(t, err, &regErr)
+	assert.Equal(t, 50001, regErr.Code)
+}
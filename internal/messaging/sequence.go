@@ -0,0 +1,197 @@
+package messaging
+
+import (
+	"container/list"
+	"sync"
+)
+
+// SequenceResult classifies an event against the events of its order a
+// consumer has already seen.
+type SequenceResult int
+
+const (
+	// SequenceInOrder is the next change of the order, another event of the
+	// latest change, or the first event seen for the order.
+	SequenceInOrder SequenceResult = iota
+	// SequenceGap skips versions: the events of the changes between
+	// Observation.LastVersion and the event's version were not seen (yet).
+	SequenceGap
+	// SequenceDuplicate has the ID of an event already seen, e.g. one
+	// delivered again after a consumer restart or replayed from history.
+	SequenceDuplicate
+	// SequenceStale is older than the latest change seen and not a known
+	// duplicate, e.g. a dead-lettered event redelivered late.
+	SequenceStale
+)
+
+// String returns the result's name for logs.
+func (r SequenceResult) String() string {
+	switch r {
+	case SequenceInOrder:
+		return "in_order"
+	case SequenceGap:
+		return "gap"
+	case SequenceDuplicate:
+		return "duplicate"
+	case SequenceStale:
+		return "stale"
+	default:
+		return "unknown"
+	}
+}
+
+// Observation is the outcome of SequenceTracker.Observe.
+type Observation struct {
+	Result SequenceResult
+	// LastVersion is the latest version seen for the order before the
+	// event, or 0 if none was. A gap misses LastVersion+1 to the event's
+	// version minus one.
+	LastVersion int
+}
+
+// SequenceTracker detects gaps and duplicates in the order events a consumer
+// processes, so it can skip what it has already handled and resync orders
+// whose events went missing. Events of an order arrive in version order on
+// one partition, except when a publish failed or was dead-lettered and
+// redelivered later.
+//
+// It remembers the latest version of up to capacity orders and the IDs of
+// the last capacity events, dropping the least recently seen first; a
+// forgotten order starts over as in order. Nothing is persisted, so a
+// consumer that must never process an event twice still needs idempotent
+// handling or its own store. It is safe for concurrent use.
+type SequenceTracker struct {
+	mu     sync.Mutex
+	orders *boundedMap[string, *orderSequence]
+	events *boundedMap[string, struct{}]
+}
+
+// orderSequence is what a SequenceTracker knows about one order
+type orderSequence struct {
+	customerID string
+	version    int
+	// resync accepts the next version whatever it is, after an erasure
+	// changed the order without an order event
+	resync bool
+}
+
+// NewSequenceTracker creates a tracker remembering capacity orders and
+// capacity event IDs.
+func NewSequenceTracker(capacity int) *SequenceTracker {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &SequenceTracker{
+		orders: newBoundedMap[string, *orderSequence](capacity),
+		events: newBoundedMap[string, struct{}](capacity),
+	}
+}
+
+// Observe classifies evt and records it. Events without an order or version,
+// such as customer.data_erased, are always in order; an erasure lets the
+// customer's orders jump ahead, since it bumps their versions without
+// publishing order events.
+func (t *SequenceTracker) Observe(evt OrderEvent) Observation {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if evt.EventID != "" {
+		if _, ok := t.events.get(evt.EventID); ok {
+			return Observation{Result: SequenceDuplicate, LastVersion: t.lastVersion(evt.OrderID)}
+		}
+		t.events.put(evt.EventID, struct{}{})
+	}
+
+	if evt.EventType == EventCustomerDataErased {
+		t.orders.each(func(_ string, seq *orderSequence) {
+			if seq.customerID == evt.CustomerID {
+				seq.resync = true
+			}
+		})
+		return Observation{Result: SequenceInOrder}
+	}
+	if evt.OrderID == "" || evt.Version == 0 {
+		return Observation{Result: SequenceInOrder}
+	}
+
+	seq, ok := t.orders.get(evt.OrderID)
+	if !ok {
+		t.orders.put(evt.OrderID, &orderSequence{customerID: evt.CustomerID, version: evt.Version})
+		return Observation{Result: SequenceInOrder}
+	}
+
+	obs := Observation{Result: SequenceInOrder, LastVersion: seq.version}
+	switch {
+	case evt.Version < seq.version:
+		obs.Result = SequenceStale
+		return obs
+	case evt.Version > seq.version+1 && !seq.resync:
+		obs.Result = SequenceGap
+	}
+	seq.version = evt.Version
+	seq.customerID = evt.CustomerID
+	seq.resync = false
+	return obs
+}
+
+// lastVersion returns the latest version seen for orderID, or 0. The caller
+// holds t.mu.
+func (t *SequenceTracker) lastVersion(orderID string) int {
+	if seq, ok := t.orders.get(orderID); ok {
+		return seq.version
+	}
+	return 0
+}
+
+// boundedMap is a map that drops its least recently used entry once it holds
+// more than capacity. It is not safe for concurrent use.
+type boundedMap[K comparable, V any] struct {
+	capacity int
+	order    *list.List
+	entries  map[K]*list.Element
+}
+
+type boundedEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+func newBoundedMap[K comparable, V any](capacity int) *boundedMap[K, V] {
+	return &boundedMap[K, V]{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[K]*list.Element),
+	}
+}
+
+func (m *boundedMap[K, V]) get(key K) (V, bool) {
+	el, ok := m.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	m.order.MoveToFront(el)
+	return el.Value.(*boundedEntry[K, V]).value, true
+}
+
+func (m *boundedMap[K, V]) put(key K, value V) {
+	if el, ok := m.entries[key]; ok {
+		el.Value.(*boundedEntry[K, V]).value = value
+		m.order.MoveToFront(el)
+		return
+	}
+	m.entries[key] = m.order.PushFront(&boundedEntry[K, V]{key: key, value: value})
+	if m.order.Len() > m.capacity {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.entries, oldest.Value.(*boundedEntry[K, V]).key)
+	}
+}
+
+// each calls fn for every entry without changing their recency
+func (m *boundedMap[K, V]) each(fn func(K, V)) {
+	for el := m.order.Front(); el != nil; el = el.Next() {
+		e := el.Value.(*boundedEntry[K, V])
+		fn(e.key, e.value)
+	}
+}
@@ -0,0 +1,67 @@
+package messaging
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func seqEvent(orderID string, version int, eventType string) OrderEvent {
+	return OrderEvent{
+		EventID:    NewEventID(orderID, strconv.Itoa(version), eventType),
+		EventType:  eventType,
+		OrderID:    orderID,
+		CustomerID: "c-1",
+		Version:    version,
+	}
+}
+
+func TestSequenceTracker_Observe_Classifies(t *testing.T) {
+	tr := NewSequenceTracker(10)
+
+	assert.Equal(t, SequenceInOrder, tr.Observe(seqEvent("o-1", 1, EventOrderCreated)).Result)
+	assert.Equal(t, SequenceInOrder, tr.Observe(seqEvent("o-1", 2, EventOrderUpdated)).Result)
+	assert.Equal(t, SequenceInOrder, tr.Observe(seqEvent("o-1", 2, EventOrderStatusChanged)).Result,
+		"one change may publish several events")
+
+	gap := tr.Observe(seqEvent("o-1", 5, EventOrderUpdated))
+	assert.Equal(t, SequenceGap, gap.Result)
+	assert.Equal(t, 2, gap.LastVersion)
+
+	assert.Equal(t, SequenceStale, tr.Observe(seqEvent("o-1", 3, EventOrderUpdated)).Result,
+		"a late event does not move the order back")
+	assert.Equal(t, SequenceDuplicate, tr.Observe(seqEvent("o-1", 2, EventOrderUpdated)).Result)
+	assert.Equal(t, SequenceInOrder, tr.Observe(seqEvent("o-1", 6, EventOrderUpdated)).Result)
+}
+
+func TestSequenceTracker_Observe_FirstEventOfOrderIsInOrder(t *testing.T) {
+	tr := NewSequenceTracker(10)
+
+	obs := tr.Observe(seqEvent("o-1", 7, EventOrderUpdated))
+
+	assert.Equal(t, SequenceInOrder, obs.Result)
+	assert.Zero(t, obs.LastVersion)
+}
+
+func TestSequenceTracker_Observe_ErasureAllowsJump(t *testing.T) {
+	tr := NewSequenceTracker(10)
+	tr.Observe(seqEvent("o-1", 1, EventOrderCreated))
+
+	tr.Observe(OrderEvent{EventID: "erasure-1", EventType: EventCustomerDataErased, CustomerID: "c-1"})
+
+	assert.Equal(t, SequenceInOrder, tr.Observe(seqEvent("o-1", 3, EventOrderDeleted)).Result)
+	assert.Equal(t, SequenceGap, tr.Observe(seqEvent("o-1", 5, EventOrderRestored)).Result,
+		"only the change after the erasure may jump")
+}
+
+func TestSequenceTracker_Observe_ForgetsLeastRecentlySeen(t *testing.T) {
+	tr := NewSequenceTracker(2)
+	tr.Observe(seqEvent("o-1", 1, EventOrderCreated))
+	tr.Observe(seqEvent("o-2", 1, EventOrderCreated))
+	tr.Observe(seqEvent("o-3", 1, EventOrderCreated))
+
+	assert.Equal(t, SequenceInOrder, tr.Observe(seqEvent("o-1", 5, EventOrderUpdated)).Result,
+		"a forgotten order starts over")
+	assert.Equal(t, SequenceGap, tr.Observe(seqEvent("o-3", 5, EventOrderUpdated)).Result)
+}
@@ -0,0 +1,209 @@
+// Package sns implements event publishing using Amazon SNS.
+package sns
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/correlation"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+)
+
+// EventTypeAttribute is the message attribute carrying the event type, so SQS
+// subscriptions can use SNS filter policies instead of decoding every message.
+const EventTypeAttribute = "event_type"
+
+// topicPublisher abstracts sns.Client for testability.
+type topicPublisher interface {
+	Publish(ctx context.Context, params *sns.PublishInput, optFns ...func(*sns.Options)) (*sns.PublishOutput, error)
+}
+
+// Config holds SNS publisher settings.
+type Config struct {
+	// TopicARN is the destination topic. ARNs ending in ".fifo" enable FIFO mode.
+	TopicARN string
+	// Endpoint overrides the SNS endpoint, e.g. for LocalStack. Empty uses AWS.
+	Endpoint string
+	// Format selects the CloudEvents envelope or the legacy bare JSON payload.
+	Format messaging.EventFormat
+	// Source is the CloudEvents source attribute; defaults to messaging.DefaultEventSource.
+	Source string
+	// DeadLetters, if set, receives events SNS failed to accept.
+	DeadLetters messaging.DeadLetterStore
+	// Resilience, if set, retries failed writes and stops attempting them
+	// while the broker is down; events it gives up on are dead-lettered.
+	Resilience *messaging.Resilience
+}
+
+// Publisher implements service.EventPublisher using Amazon SNS.
+//
+// Credentials and region come from the default AWS chain (AWS_REGION,
+// AWS_PROFILE, instance roles, ...). On FIFO topics the order ID is the
+// message group ID, so events for one order reach subscribed FIFO queues in
+// order, matching the per-key ordering of the Kafka publisher.
+type Publisher struct {
+	client      topicPublisher
+	topicARN    string
+	format      messaging.EventFormat
+	source      string
+	deadLetters messaging.DeadLetterStore
+	resilience  *messaging.Resilience
+}
+
+// NewPublisher creates an SNS event publisher using the default AWS config.
+func NewPublisher(ctx context.Context, cfg Config) (*Publisher, error) {
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	client := sns.NewFromConfig(awsCfg, func(o *sns.Options) {
+		if cfg.Endpoint != "" {
+			o.BaseEndpoint = aws.String(cfg.Endpoint)
+		}
+	})
+
+	return &Publisher{
+		client:      client,
+		topicARN:    cfg.TopicARN,
+		format:      cfg.Format,
+		source:      cfg.Source,
+		deadLetters: cfg.DeadLetters,
+		resilience:  cfg.Resilience,
+	}, nil
+}
+
+// IsFIFOTopic reports whether topicARN names a FIFO topic.
+func IsFIFOTopic(topicARN string) bool {
+	return strings.HasSuffix(topicARN, ".fifo")
+}
+
+// PublishOrderCreated publishes an order.created event to SNS.
+func (p *Publisher) PublishOrderCreated(ctx context.Context, order *domain.Order) error {
+	return p.publish(ctx, messaging.NewOrderEvent(messaging.EventOrderCreated, order))
+}
+
+// PublishOrderUpdated publishes an order.updated event to SNS.
+func (p *Publisher) PublishOrderUpdated(ctx context.Context, order *domain.Order) error {
+	return p.publish(ctx, messaging.NewOrderEvent(messaging.EventOrderUpdated, order))
+}
+
+// PublishOrderStatusChanged publishes an order.status_changed event to SNS.
+func (p *Publisher) PublishOrderStatusChanged(ctx context.Context, order *domain.Order, oldStatus, newStatus domain.OrderStatus) error {
+	return p.publish(ctx, messaging.NewOrderStatusChangedEvent(ctx, order, oldStatus, newStatus))
+}
+
+// PublishOrderRestored publishes an order.restored event to SNS.
+func (p *Publisher) PublishOrderRestored(ctx context.Context, order *domain.Order) error {
+	return p.publish(ctx, messaging.NewOrderEvent(messaging.EventOrderRestored, order))
+}
+
+// PublishOrderDeleted publishes an order.deleted event to SNS.
+func (p *Publisher) PublishOrderDeleted(ctx context.Context, order *domain.Order) error {
+	return p.publish(ctx, messaging.NewOrderEvent(messaging.EventOrderDeleted, order))
+}
+
+// PublishOrderSLABreached publishes an order.sla_breached event to SNS.
+func (p *Publisher) PublishOrderSLABreached(ctx context.Context, order *domain.Order) error {
+	return p.publish(ctx, messaging.NewOrderEvent(messaging.EventOrderSLABreached, order))
+}
+
+// PublishCustomerDataErased publishes a customer.data_erased event to SNS.
+// On FIFO topics the customer ID is the message group ID.
+func (p *Publisher) PublishCustomerDataErased(ctx context.Context, erasure *domain.CustomerErasure) error {
+	return p.publish(ctx, messaging.NewCustomerDataErasedEvent(erasure))
+}
+
+// SendEvent publishes an already built event, such as one replayed from
+// order history.
+func (p *Publisher) SendEvent(ctx context.Context, evt messaging.OrderEvent) error {
+	return p.publish(ctx, evt)
+}
+
+// Redeliver re-sends a dead-lettered message to the topic it was first published to.
+// On FIFO topics the deduplication ID is derived from the payload, so a message
+// that SNS did accept before the error is not delivered twice.
+func (p *Publisher) Redeliver(ctx context.Context, dl *messaging.DeadLetter) error {
+	_, err := p.client.Publish(ctx, p.input(dl.Topic, dl.Key, dl.Payload, dl.Headers))
+	return err
+}
+
+func (p *Publisher) publish(ctx context.Context, evt messaging.OrderEvent) error {
+	evt.CorrelationID = correlation.ID(ctx)
+	value, err := messaging.EncodeOrderEvent(evt, p.format, p.source)
+	if err != nil {
+		return err
+	}
+
+	attrs := map[string]string{EventTypeAttribute: evt.EventType}
+	if p.format != messaging.EventFormatLegacy {
+		attrs["content-type"] = messaging.CloudEventsContentType
+	}
+
+	err = p.resilience.Do(ctx, func(ctx context.Context) error {
+		_, err := p.client.Publish(ctx, p.input(p.topicARN, evt.Key(), value, attrs))
+		return err
+	})
+	if err != nil {
+		return p.deadLetter(ctx, evt, value, attrs, err)
+	}
+	return nil
+}
+
+// input builds a PublishInput. groupID is only sent to FIFO topics.
+func (p *Publisher) input(topicARN, groupID string, payload []byte, attrs map[string]string) *sns.PublishInput {
+	in := &sns.PublishInput{
+		TopicArn:          aws.String(topicARN),
+		Message:           aws.String(string(payload)),
+		MessageAttributes: make(map[string]types.MessageAttributeValue, len(attrs)),
+	}
+	for k, v := range attrs {
+		in.MessageAttributes[k] = types.MessageAttributeValue{
+			DataType:    aws.String("String"),
+			StringValue: aws.String(v),
+		}
+	}
+	if IsFIFOTopic(topicARN) {
+		sum := sha256.Sum256(payload)
+		in.MessageGroupId = aws.String(groupID)
+		in.MessageDeduplicationId = aws.String(hex.EncodeToString(sum[:]))
+	}
+	return in
+}
+
+// deadLetter persists a message SNS did not accept.
+func (p *Publisher) deadLetter(ctx context.Context, evt messaging.OrderEvent, payload []byte, attrs map[string]string, pubErr error) error {
+	if p.deadLetters == nil {
+		return pubErr
+	}
+
+	dl := &messaging.DeadLetter{
+		Topic:     p.topicARN,
+		Key:       evt.Key(),
+		Payload:   payload,
+		Headers:   attrs,
+		EventType: evt.EventType,
+		OrderID:   evt.OrderID,
+		LastError: pubErr.Error(),
+	}
+	if err := p.deadLetters.Save(ctx, dl); err != nil {
+		return fmt.Errorf("%w (dead-letter save failed: %v)", pubErr, err)
+	}
+
+	slog.WarnContext(ctx, "event publish failed, stored in dead-letter queue",
+		slog.String("event_type", evt.EventType),
+		slog.String("order_id", evt.OrderID),
+		slog.String("dead_letter_id", dl.ID.String()),
+		slog.String("error", pubErr.Error()),
+	)
+	return nil
+}
@@ -0,0 +1,147 @@
+package sns
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	standardTopic = "arn:aws:sns:us-east-1:123456789012:order-events"
+	fifoTopic     = "arn:aws:sns:us-east-1:123456789012:order-events.fifo"
+)
+
+// mockTopic captures SNS publish calls for test assertions.
+type mockTopic struct {
+	mu     sync.Mutex
+	inputs []*sns.PublishInput
+	err    error
+}
+
+func (m *mockTopic) Publish(_ context.Context, in *sns.PublishInput, _ ...func(*sns.Options)) (*sns.PublishOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.inputs = append(m.inputs, in)
+	return &sns.PublishOutput{MessageId: aws.String(uuid.NewString())}, nil
+}
+
+func (m *mockTopic) lastInput() *sns.PublishInput {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.inputs[len(m.inputs)-1]
+}
+
+func newTestOrder() *domain.Order {
+	return &domain.Order{
+		ID:         uuid.New(),
+		CustomerID: "cust-123",
+		Status:     domain.OrderStatusConfirmed,
+		Total:      21.00,
+		Version:    2,
+	}
+}
+
+func TestPublisher_StandardTopic_NoGroupID(t *testing.T) {
+	client := &mockTopic{}
+	pub := &Publisher{client: client, topicARN: standardTopic}
+	order := newTestOrder()
+
+	require.NoError(t, pub.PublishOrderCreated(context.Background(), order))
+
+	in := client.lastInput()
+	assert.Equal(t, standardTopic, aws.ToString(in.TopicArn))
+	assert.Nil(t, in.MessageGroupId, "standard topics reject MessageGroupId")
+	assert.Nil(t, in.MessageDeduplicationId)
+	assert.Equal(t, messaging.EventOrderCreated, aws.ToString(in.MessageAttributes[EventTypeAttribute].StringValue))
+
+	evt, err := messaging.DecodeOrderEvent([]byte(aws.ToString(in.Message)))
+	require.NoError(t, err)
+	assert.Equal(t, order.ID.String(), evt.OrderID)
+	assert.Equal(t, 2, evt.Version)
+}
+
+func TestPublisher_FIFOTopic_GroupsByOrderID(t *testing.T) {
+	client := &mockTopic{}
+	pub := &Publisher{client: client, topicARN: fifoTopic}
+	order := newTestOrder()
+
+	require.NoError(t, pub.PublishOrderStatusChanged(context.Background(), order,
+		domain.OrderStatusPending, domain.OrderStatusConfirmed))
+	require.NoError(t, pub.PublishOrderUpdated(context.Background(), order))
+
+	require.Len(t, client.inputs, 2)
+	for _, in := range client.inputs {
+		assert.Equal(t, order.ID.String(), aws.ToString(in.MessageGroupId),
+			"message group ID must be order ID to preserve per-order ordering")
+		assert.NotEmpty(t, aws.ToString(in.MessageDeduplicationId))
+	}
+	assert.NotEqual(t, aws.ToString(client.inputs[0].MessageDeduplicationId),
+		aws.ToString(client.inputs[1].MessageDeduplicationId))
+}
+
+func TestPublisher_LegacyFormat_NoContentTypeAttribute(t *testing.T) {
+	client := &mockTopic{}
+	pub := &Publisher{client: client, topicARN: standardTopic, format: messaging.EventFormatLegacy}
+
+	require.NoError(t, pub.PublishOrderCreated(context.Background(), newTestOrder()))
+
+	_, ok := client.lastInput().MessageAttributes["content-type"]
+	assert.False(t, ok)
+}
+
+func TestPublisher_PublishError_NoDeadLetters_ReturnsError(t *testing.T) {
+	client := &mockTopic{err: errors.New("throttled")}
+	pub := &Publisher{client: client, topicARN: standardTopic}
+
+	err := pub.PublishOrderCreated(context.Background(), newTestOrder())
+
+	assert.ErrorContains(t, err, "throttled")
+}
+
+type memoryDeadLetters struct {
+	messaging.DeadLetterStore
+	saved []*messaging.DeadLetter
+}
+
+func (m *memoryDeadLetters) Save(_ context.Context, dl *messaging.DeadLetter) error {
+	dl.ID = uuid.New()
+	m.saved = append(m.saved, dl)
+	return nil
+}
+
+func TestPublisher_PublishError_WithDeadLetters_RedeliversSameDedupID(t *testing.T) {
+	client := &mockTopic{err: errors.New("throttled")}
+	dlq := &memoryDeadLetters{}
+	pub := &Publisher{client: client, topicARN: fifoTopic, deadLetters: dlq}
+	order := newTestOrder()
+
+	err := pub.PublishOrderCreated(context.Background(), order)
+
+	require.NoError(t, err)
+	require.Len(t, dlq.saved, 1)
+	assert.Equal(t, fifoTopic, dlq.saved[0].Topic)
+	assert.Equal(t, order.ID.String(), dlq.saved[0].Key)
+
+	client.err = nil
+	require.NoError(t, pub.Redeliver(context.Background(), dlq.saved[0]))
+	require.NoError(t, pub.Redeliver(context.Background(), dlq.saved[0]))
+
+	require.Len(t, client.inputs, 2)
+	assert.Equal(t, order.ID.String(), aws.ToString(client.inputs[0].MessageGroupId))
+	assert.Equal(t, string(dlq.saved[0].Payload), aws.ToString(client.inputs[0].Message))
+	assert.Equal(t, aws.ToString(client.inputs[0].MessageDeduplicationId),
+		aws.ToString(client.inputs[1].MessageDeduplicationId),
+		"redeliveries of one dead letter must share a deduplication ID")
+}
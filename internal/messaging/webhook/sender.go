@@ -0,0 +1,68 @@
+// Package webhook delivers order events to HTTP endpoints.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/correlation"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/httpclient"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+)
+
+// Sender posts order events to a webhook URL, one request per event,
+// encoded as the message broker would carry them.
+type Sender struct {
+	client *httpclient.Client
+	format messaging.EventFormat
+	source string
+}
+
+// NewSender creates a sender posting through client. Events are encoded in
+// format with source as the CloudEvents source attribute.
+func NewSender(client *httpclient.Client, format messaging.EventFormat, source string) *Sender {
+	return &Sender{
+		client: client,
+		format: format,
+		source: source,
+	}
+}
+
+// SendEvent posts evt to url. Any response other than 2xx is an error. The
+// event ID is sent as the Idempotency-Key, so receivers can drop the
+// duplicates a retried request may deliver and the client may retry it.
+func (s *Sender) SendEvent(ctx context.Context, url string, evt messaging.OrderEvent) error {
+	evt.CorrelationID = correlation.ID(ctx)
+	body, err := messaging.EncodeOrderEvent(evt, s.format, s.source)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.format != messaging.EventFormatLegacy {
+		req.Header.Set("Content-Type", messaging.CloudEventsContentType)
+	}
+	if evt.EventID != "" {
+		req.Header.Set(httpclient.IdempotencyKeyHeader, evt.EventID)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	// Drain so the connection is reused for the next event
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
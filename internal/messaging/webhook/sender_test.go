@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/httpclient"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSender_SendEvent_PostsEncodedEvent(t *testing.T) {
+	var contentType, idempotencyKey string
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		idempotencyKey = r.Header.Get(httpclient.IdempotencyKeyHeader)
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	s := NewSender(newClient(), messaging.EventFormatCloudEvents, "/ordersvc")
+	err := s.SendEvent(context.Background(), srv.URL, messaging.OrderEvent{
+		EventID:   "evt-1",
+		EventType: messaging.EventOrderCreated,
+		OrderID:   "o-1",
+		Replayed:  true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, messaging.CloudEventsContentType, contentType)
+	assert.Equal(t, "evt-1", idempotencyKey)
+	evt, err := messaging.DecodeOrderEvent(body)
+	require.NoError(t, err)
+	assert.Equal(t, "o-1", evt.OrderID)
+	assert.True(t, evt.Replayed)
+}
+
+func TestSender_SendEvent_ErrorStatus_ReturnsError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	s := NewSender(newClient(), messaging.EventFormatLegacy, "/ordersvc")
+	err := s.SendEvent(context.Background(), srv.URL, messaging.OrderEvent{EventType: messaging.EventOrderCreated})
+
+	assert.ErrorContains(t, err, "502")
+}
+
+func newClient() *httpclient.Client {
+	return httpclient.New(httpclient.Config{
+		Timeout:         time.Second,
+		MaxAttempts:     1,
+		InitialBackoff:  time.Millisecond,
+		MaxBackoff:      time.Millisecond,
+		BreakerFailures: 5,
+		BreakerCooldown: time.Second,
+	}, nil)
+}
@@ -0,0 +1,40 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// ActorHeader identifies the caller recorded in the order history
+const ActorHeader = "X-Actor"
+
+// Actor returns a middleware that stores the X-Actor header in the request
+// context for audit records, with HTTP as the channel. The header is trusted
+// as sent; deployments must set or strip it at the gateway until the service
+// authenticates callers.
+func Actor() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := domain.WithChannel(r.Context(), domain.ChannelHTTP)
+			if actor := r.Header.Get(ActorHeader); actor != "" {
+				ctx = domain.WithActor(ctx, actor)
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/problem"
+)
+
+// AdminAuth returns a middleware that admits only requests presenting the
+// admin API key as "Authorization: Bearer <key>". With no key configured the
+// admin API is disabled and every request is rejected. Admitted requests are
+// audited as made with the API key.
+func AdminAuth(apiKey string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if apiKey == "" {
+				writeJSONError(w, r, http.StatusForbidden, "admin API is disabled", "ADMIN_DISABLED")
+				return
+			}
+
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(apiKey)) != 1 {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="admin"`)
+				writeJSONError(w, r, http.StatusUnauthorized, "invalid or missing admin API key", "UNAUTHORIZED")
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(domain.WithActorType(r.Context(), domain.ActorTypeAPIKey)))
+		})
+	}
+}
+
+func writeJSONError(w http.ResponseWriter, r *http.Request, status int, message, code string) {
+	problem.Write(w, r, status, message, code, nil)
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"log/slog"
+	"net"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/audit"
+)
+
+// Audit returns a middleware that records every POST, PUT, PATCH and DELETE
+// in the audit log, with the orders it changed. It belongs inside the
+// authentication middleware so the entry names the authenticated caller. A
+// nil logger disables it.
+func Audit(logger *audit.Logger) func(http.Handler) http.Handler {
+	return audited(logger, func(r *http.Request) bool {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return false
+		}
+		return true
+	})
+}
+
+// AuditAll is Audit for every request, reads included, as for the admin API
+func AuditAll(logger *audit.Logger) func(http.Handler) http.Handler {
+	return audited(logger, func(*http.Request) bool { return true })
+}
+
+func audited(logger *audit.Logger, match func(*http.Request) bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if logger == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !match(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx, rec := audit.WithRecorder(r.Context())
+			wrapped := wrapResponseWriter(w)
+			next.ServeHTTP(wrapped, r.WithContext(ctx))
+
+			entry := audit.NewEntry(ctx, rec)
+			entry.Method = r.Method
+			entry.Path = r.URL.Path
+			if rctx := chi.RouteContext(ctx); rctx != nil {
+				entry.Route = rctx.RoutePattern()
+			}
+			entry.Status = wrapped.status
+			// RealIP has already replaced RemoteAddr with a forwarded address
+			entry.IP = r.RemoteAddr
+			if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+				entry.IP = host
+			}
+			if err := logger.Log(ctx, entry); err != nil {
+				slog.ErrorContext(ctx, "failed to write audit entry",
+					slog.String("method", r.Method), slog.String("path", r.URL.Path), slog.String("error", err.Error()))
+			}
+		})
+	}
+}
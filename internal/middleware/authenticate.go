@@ -0,0 +1,63 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/auth"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// Authenticate returns a middleware that requires an "Authorization: Bearer
+// <token>" header accepted by verifier. It stores the token's principal in the
+// request context and records its subject as the actor, replacing X-Actor.
+// A nil verifier disables authentication and every request passes through.
+//
+// Browsers cannot set headers on a WebSocket handshake, so an upgrade
+// request may pass the token as the access_token query parameter instead.
+func Authenticate(verifier *auth.Verifier) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if verifier == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok && strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+				token = r.URL.Query().Get("access_token")
+				ok = true
+			}
+			if !ok || token == "" {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="orders"`)
+				writeJSONError(w, r, http.StatusUnauthorized, "missing bearer token", "UNAUTHORIZED")
+				return
+			}
+
+			principal, err := verifier.Verify(token)
+			if err != nil {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="orders", error="invalid_token"`)
+				writeJSONError(w, r, http.StatusUnauthorized, "invalid bearer token", "INVALID_TOKEN")
+				return
+			}
+
+			ctx := domain.WithPrincipal(r.Context(), principal)
+			if principal.Subject != "" {
+				ctx = domain.WithActor(ctx, principal.Subject)
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/chaos"
+)
+
+// Chaos returns a middleware that injects the API faults of injector for
+// resilience testing: a delay before a share of requests, and 503
+// INJECTED_FAULT with Retry-After instead of a share of responses. It is
+// installed only with CHAOS_ENABLED.
+func Chaos(injector *chaos.Injector) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := injector.Delay(r.Context()); err != nil {
+				// The client went away or the request timed out meanwhile
+				return
+			}
+			if injector.FailRequest() {
+				w.Header().Set("Retry-After", "1")
+				writeJSONError(w, r, http.StatusServiceUnavailable, "fault injected for resilience testing", "INJECTED_FAULT")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/cache"
+)
+
+// IdempotencyKeyHeader carries the client-chosen key of a retryable request
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+const (
+	maxIdempotencyKeyLength = 255
+	// maxIdempotentBodyBytes bounds the request body read for fingerprinting
+	maxIdempotentBodyBytes = 1 << 20
+	// idempotencyPendingTTL bounds how long a crashed request can hold its key
+	idempotencyPendingTTL = time.Minute
+)
+
+// recordingWriter captures a response while passing it through
+type recordingWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (rw *recordingWriter) WriteHeader(code int) {
+	if !rw.wroteHeader {
+		rw.status = code
+		rw.wroteHeader = true
+		rw.ResponseWriter.WriteHeader(code)
+	}
+}
+
+func (rw *recordingWriter) Write(b []byte) (int, error) {
+	if !rw.wroteHeader {
+		rw.WriteHeader(http.StatusOK)
+	}
+	rw.body.Write(b)
+	return rw.ResponseWriter.Write(b)
+}
+
+// Idempotency returns a middleware that makes mutating requests safe to retry.
+// The first request with a given Idempotency-Key runs normally and its
+// response is kept for ttl; a retry with the same key and body gets that
+// response replayed with an Idempotent-Replayed header instead of running again.
+// Server errors are not kept, so a failed request can be retried.
+//
+// Reusing a key for a different request returns 422, and a retry that arrives
+// while the first request is still running returns 409. If the store is
+// unavailable requests run without idempotency (ADR-0004 cache failure pattern).
+func Idempotency(store cache.IdempotencyStore, ttl time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" || r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				writeJSONError(w, r, http.StatusBadRequest, "Idempotency-Key must be at most 255 characters", "INVALID_IDEMPOTENCY_KEY")
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentBodyBytes))
+			if err != nil {
+				writeJSONError(w, r, http.StatusRequestEntityTooLarge, "request body too large", "BODY_TOO_LARGE")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			fingerprint := requestFingerprint(r, body)
+
+			// Outlive client disconnects so the key is always completed or released
+			ctx := context.WithoutCancel(r.Context())
+
+			existing, err := store.Reserve(ctx, key, fingerprint, idempotencyPendingTTL)
+			if err != nil {
+				slog.WarnContext(ctx, "idempotency store unavailable", slog.String("error", err.Error()))
+				next.ServeHTTP(w, r)
+				return
+			}
+			if existing != nil {
+				switch {
+				case existing.Fingerprint != fingerprint:
+					writeJSONError(w, r, http.StatusUnprocessableEntity, "Idempotency-Key was used for a different request", "IDEMPOTENCY_KEY_REUSED")
+				case existing.Pending:
+					w.Header().Set("Retry-After", "1")
+					writeJSONError(w, r, http.StatusConflict, "a request with this Idempotency-Key is in progress", "IDEMPOTENCY_KEY_IN_USE")
+				default:
+					replay(w, existing)
+				}
+				return
+			}
+
+			rec := &recordingWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+
+			if rec.status >= http.StatusInternalServerError {
+				if err := store.Release(ctx, key); err != nil {
+					slog.WarnContext(ctx, "failed to release idempotency key", slog.String("error", err.Error()))
+				}
+				return
+			}
+
+			record := &cache.IdempotencyRecord{
+				Fingerprint: fingerprint,
+				StatusCode:  rec.status,
+				Header:      rec.Header().Clone(),
+				Body:        rec.body.Bytes(),
+			}
+			if err := store.Complete(ctx, key, record, ttl); err != nil {
+				slog.WarnContext(ctx, "failed to store idempotent response", slog.String("error", err.Error()))
+			}
+		})
+	}
+}
+
+func replay(w http.ResponseWriter, record *cache.IdempotencyRecord) {
+	for k, values := range record.Header {
+		for _, v := range values {
+			w.Header().Add(k, v)
+		}
+	}
+	w.Header().Set("Idempotent-Replayed", "true")
+	w.WriteHeader(record.StatusCode)
+	_, _ = w.Write(record.Body)
+}
+
+// requestFingerprint identifies a request by method, path and body
+func requestFingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.Path + "\n"))
+	// Runs before authentication, so a replay must present the same credentials
+	h.Write([]byte(r.Header.Get("Authorization") + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
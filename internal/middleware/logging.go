@@ -15,7 +15,9 @@
 package middleware
 
 import (
+	"bufio"
 	"log/slog"
+	"net"
 	"net/http"
 	"time"
 
@@ -41,6 +43,22 @@ func (rw *responseWriter) WriteHeader(code int) {
 	}
 }
 
+// Hijack hands the connection to a WebSocket upgrade, which writes its own
+// 101 response
+func (rw *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, buf, err := http.NewResponseController(rw.ResponseWriter).Hijack()
+	if err == nil {
+		rw.status = http.StatusSwitchingProtocols
+		rw.wroteHeader = true
+	}
+	return conn, buf, err
+}
+
+// Unwrap returns the wrapped writer for http.ResponseController
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 // Logging returns a middleware that logs HTTP requests using slog
 // CONSTRAINT: Every request must be logged with slog (ADR-0002)
 func Logging(logger *slog.Logger) func(http.Handler) http.Handler {
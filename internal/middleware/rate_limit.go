@@ -15,15 +15,80 @@
 package middleware
 
 import (
+	"log/slog"
+	"net"
 	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/ratelimit"
 )
 
-// RateLimit returns a middleware that rate limits requests
-func RateLimit() func(http.Handler) http.Handler {
+// RateLimit returns a middleware that limits each client IP to the client
+// policy of limiter. Requests over a limit get 429 with a Retry-After header.
+// If the limiter is unavailable requests are let through (ADR-0005).
+func RateLimit(limiter *ratelimit.Limiter) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// TODO: implement rate limiting using Redis
+			decision, err := limiter.AllowClient(r.Context(), clientIP(r), isWrite(r))
+			if !allowed(w, r, decision, err) {
+				return
+			}
 			next.ServeHTTP(w, r)
 		})
 	}
 }
+
+// CallerRateLimit returns a middleware that limits the callers of a tenant or
+// customer to the rule of limiter that matches them, on top of the client IP
+// limit. It runs after Authenticate and Tenant, which identify the caller.
+func CallerRateLimit(limiter *ratelimit.Limiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var customerID string
+			if p, ok := domain.PrincipalFromContext(r.Context()); ok {
+				customerID = p.CustomerID
+			}
+			decision, err := limiter.AllowCaller(r.Context(), domain.TenantID(r.Context()), customerID, isWrite(r))
+			if !allowed(w, r, decision, err) {
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// allowed writes the 429 response for a refused request. Limiter errors let
+// the request through.
+func allowed(w http.ResponseWriter, r *http.Request, decision ratelimit.Decision, err error) bool {
+	if err != nil {
+		slog.WarnContext(r.Context(), "rate limiter unavailable", slog.String("error", err.Error()))
+		return true
+	}
+	if !decision.Allowed {
+		w.Header().Set("Retry-After", strconv.Itoa(int(decision.RetryAfter/time.Second)))
+		writeJSONError(w, r, http.StatusTooManyRequests, "rate limit exceeded", "RATE_LIMITED")
+		return false
+	}
+	return true
+}
+
+// isWrite reports whether r changes state and counts against write buckets
+func isWrite(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// clientIP keys the limits; RemoteAddr already holds the forwarded client IP
+// when chi's RealIP middleware runs first
+func clientIP(r *http.Request) string {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
@@ -15,17 +15,44 @@
 package middleware
 
 import (
+	"log/slog"
 	"net/http"
+	"runtime/debug"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/problem"
 )
 
-// Recovery returns a middleware that recovers from panics
+// Recovery returns a middleware that recovers from panics. The panic is
+// logged with its stack under a new error ID, and the client gets a 500
+// INTERNAL_ERROR carrying that ID. http.ErrAbortHandler is re-panicked so
+// net/http still aborts the response as the handler intended.
 func Recovery() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			defer func() {
-				if err := recover(); err != nil {
-					// TODO: log panic
-					w.WriteHeader(http.StatusInternalServerError)
+				rec := recover()
+				if rec == nil {
+					return
+				}
+				if rec == http.ErrAbortHandler {
+					panic(rec)
+				}
+
+				stack := debug.Stack()
+				if p, ok := rec.(*handlerPanic); ok {
+					rec, stack = p.value, p.stack
+				}
+
+				errorID := problem.NewErrorID()
+				slog.ErrorContext(r.Context(), "panic recovered",
+					slog.String("error_id", errorID),
+					slog.String("method", r.Method),
+					slog.String("path", r.URL.Path),
+					slog.Any("panic", rec),
+					slog.String("stack", string(stack)),
+				)
+				if r.Header.Get("Connection") != "Upgrade" {
+					problem.WriteInternal(w, r, errorID)
 				}
 			}()
 			next.ServeHTTP(w, r)
@@ -18,7 +18,10 @@ import (
 	"context"
 	"net/http"
 
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/google/uuid"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/correlation"
 )
 
 type contextKey string
@@ -40,3 +43,28 @@ func RequestID() func(http.Handler) http.Handler {
 		})
 	}
 }
+
+// Correlation copies the request ID assigned by chi's RequestID middleware
+// into the correlation context, so service logs and published events carry
+// it, and echoes it back in the X-Request-ID response header. It also keeps
+// the caller's W3C traceparent, or starts a trace, for published events.
+func Correlation() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			traceParent := r.Header.Get(correlation.TraceParentHeader)
+			if !correlation.ValidTraceParent(traceParent) {
+				traceParent = correlation.NewTraceParent()
+			}
+			ctx := correlation.WithTraceParent(r.Context(), traceParent)
+
+			requestID := chimiddleware.GetReqID(ctx)
+			if requestID == "" {
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
+			w.Header().Set(chimiddleware.RequestIDHeader, requestID)
+			next.ServeHTTP(w, r.WithContext(correlation.WithID(ctx, requestID)))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/tenancy"
+)
+
+// Tenant returns a middleware that resolves the tenant of every request with
+// resolver and scopes the request context to it (see domain.WithTenant).
+// Requests naming no tenant, or a malformed one, are rejected with 400; a
+// token bound to another tenant gets 403. It must run after Authenticate when
+// the tenant comes from a token claim. A disabled resolver passes every
+// request through unscoped.
+func Tenant(resolver *tenancy.Resolver) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !resolver.Enabled() {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tenantID, err := resolver.Resolve(r.Context(), r.Header.Get(resolver.Header()), r.Host)
+			switch {
+			case errors.Is(err, domain.ErrTenantRequired):
+				writeJSONError(w, r, http.StatusBadRequest, "request does not identify a tenant", "TENANT_REQUIRED")
+				return
+			case errors.Is(err, domain.ErrInvalidTenantID):
+				writeJSONError(w, r, http.StatusBadRequest, "invalid tenant ID", "INVALID_TENANT")
+				return
+			case err != nil:
+				writeJSONError(w, r, http.StatusForbidden, "token is not valid for this tenant", "TENANT_ACCESS_DENIED")
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(domain.WithTenant(r.Context(), tenantID)))
+		})
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Timeout returns a middleware that puts a deadline on the request context:
+// the timeout of the longest path prefix in routes that matches the request,
+// or fallback. A zero timeout leaves the request unbounded. The response is
+// buffered, so a request that misses its deadline gets a whole
+// 504 GATEWAY_TIMEOUT rather than a half-written body cut off by the
+// server's WriteTimeout. The handler keeps running until it notices the
+// cancelled context; what it writes after the deadline is discarded.
+// WebSocket upgrades are not bounded.
+func Timeout(fallback time.Duration, routes map[string]time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			d := routeTimeout(r.URL.Path, fallback, routes)
+			if d <= 0 || strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(r.Context(), d)
+			defer cancel()
+			r = r.WithContext(ctx)
+
+			tw := &timeoutWriter{header: make(http.Header)}
+			done := make(chan struct{})
+			panicked := make(chan any, 1)
+			go func() {
+				defer func() {
+					if p := recover(); p != nil {
+						if p != http.ErrAbortHandler {
+							// The stack is the handler's only until re-raised
+							p = &handlerPanic{value: p, stack: debug.Stack()}
+						}
+						panicked <- p
+					}
+				}()
+				next.ServeHTTP(tw, r)
+				close(done)
+			}()
+
+			select {
+			case p := <-panicked:
+				// Re-raised here so the recovering middleware sees it
+				panic(p)
+			case <-done:
+				tw.flush(w)
+			case <-ctx.Done():
+				tw.abandon()
+				if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+					// The client went away; nobody reads a response
+					return
+				}
+				slog.WarnContext(ctx, "request timed out",
+					slog.String("method", r.Method),
+					slog.String("path", r.URL.Path),
+					slog.Duration("timeout", d))
+				writeJSONError(w, r, http.StatusGatewayTimeout, "request timed out", "GATEWAY_TIMEOUT")
+			}
+		})
+	}
+}
+
+// handlerPanic is a panic of a handler run by Timeout, re-raised on the
+// request goroutine with the stack of the goroutine that panicked
+type handlerPanic struct {
+	value any
+	stack []byte
+}
+
+func (p *handlerPanic) Error() string {
+	return fmt.Sprint(p.value)
+}
+
+// routeTimeout returns the timeout of the longest prefix of path in routes,
+// or fallback when none matches
+func routeTimeout(path string, fallback time.Duration, routes map[string]time.Duration) time.Duration {
+	d, longest := fallback, -1
+	for prefix, timeout := range routes {
+		if strings.HasPrefix(path, prefix) && len(prefix) > longest {
+			d, longest = timeout, len(prefix)
+		}
+	}
+	return d
+}
+
+// timeoutWriter buffers a response until the handler returns, and drops
+// writes once the request has been answered with a timeout
+type timeoutWriter struct {
+	mu        sync.Mutex
+	header    http.Header
+	body      bytes.Buffer
+	status    int
+	abandoned bool
+}
+
+func (tw *timeoutWriter) Header() http.Header {
+	return tw.header
+}
+
+func (tw *timeoutWriter) WriteHeader(status int) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.abandoned || tw.status != 0 {
+		return
+	}
+	tw.status = status
+}
+
+func (tw *timeoutWriter) Write(b []byte) (int, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.abandoned {
+		return 0, http.ErrHandlerTimeout
+	}
+	if tw.status == 0 {
+		tw.status = http.StatusOK
+	}
+	return tw.body.Write(b)
+}
+
+// flush copies the finished response to w
+func (tw *timeoutWriter) flush(w http.ResponseWriter) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	maps.Copy(w.Header(), tw.header)
+	if tw.status == 0 {
+		tw.status = http.StatusOK
+	}
+	w.WriteHeader(tw.status)
+	if _, err := w.Write(tw.body.Bytes()); err != nil {
+		return
+	}
+}
+
+// abandon makes later writes of the handler fail with http.ErrHandlerTimeout
+func (tw *timeoutWriter) abandon() {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	tw.abandoned = true
+}
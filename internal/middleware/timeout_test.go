@@ -0,0 +1,148 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeout_DeadlineMissed_Returns504(t *testing.T) {
+	handler := Timeout(10*time.Millisecond, nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/orders", nil))
+
+	assert.Equal(t, http.StatusGatewayTimeout, rec.Code)
+	var body struct {
+		Code string `json:"code"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	assert.Equal(t, "GATEWAY_TIMEOUT", body.Code)
+}
+
+func TestTimeout_HandlerFinishesInTime_PassesResponseThrough(t *testing.T) {
+	handler := Timeout(time.Second, nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, hasDeadline := r.Context().Deadline()
+		assert.True(t, hasDeadline)
+		w.Header().Set("Location", "/api/v1/orders/1")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"1"}`))
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/orders", nil))
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "/api/v1/orders/1", rec.Header().Get("Location"))
+	assert.Equal(t, `{"id":"1"}`, rec.Body.String())
+}
+
+func TestTimeout_RouteTimeouts(t *testing.T) {
+	routes := map[string]time.Duration{"/api/v1/orders": time.Second, "/api/v1/orders/export": 0}
+
+	tests := []struct {
+		path         string
+		wantDeadline bool
+	}{
+		{path: "/api/v1/orders/1", wantDeadline: true},
+		{path: "/api/v1/orders/export", wantDeadline: false},
+		{path: "/healthz", wantDeadline: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			var hasDeadline bool
+			handler := Timeout(time.Minute, routes)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				_, hasDeadline = r.Context().Deadline()
+			}))
+
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, tt.wantDeadline, hasDeadline)
+		})
+	}
+}
+
+func TestTimeout_WritesAfterDeadline_Dropped(t *testing.T) {
+	lateWrite := make(chan error, 1)
+	handler := Timeout(10*time.Millisecond, nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+		// Give the middleware time to answer before writing
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte("too late"))
+		lateWrite <- err
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/orders", nil))
+
+	select {
+	case err := <-lateWrite:
+		assert.ErrorIs(t, err, http.ErrHandlerTimeout)
+	case <-time.After(5 * time.Second):
+		t.Fatal("handler never wrote")
+	}
+	assert.Equal(t, http.StatusGatewayTimeout, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "too late")
+}
+
+func TestTimeout_HandlerPanics_RecoveryLogsHandlerStack(t *testing.T) {
+	var logs bytes.Buffer
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&logs, nil)))
+
+	handler := Recovery()(Timeout(time.Second, nil)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panicInHandler()
+	})))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/orders", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	var entry struct {
+		Panic string `json:"panic"`
+		Stack string `json:"stack"`
+	}
+	require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+	assert.Equal(t, "order total exploded", entry.Panic, "the handler's value, not the wrapper")
+	assert.Contains(t, entry.Stack, "panicInHandler", "the stack of the handler's goroutine")
+}
+
+func TestTimeout_AbortHandler_ReRaisedUnchanged(t *testing.T) {
+	handler := Timeout(time.Second, nil)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/orders", nil))
+	})
+}
+
+// panicInHandler panics from a named frame the logged stack must contain
+func panicInHandler() {
+	panic("order total exploded")
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// ArchiveRepositoryMock is a mock implementation of repository.ArchiveRepository
+type ArchiveRepositoryMock struct {
+	ListArchivableFunc func(ctx context.Context, statuses []domain.OrderStatus, updatedBefore time.Time, limit int) ([]*domain.OrderBackup, error)
+	MarkArchivedFunc   func(ctx context.Context, objectKey string, orders []*domain.Order) (int, error)
+	FindObjectKeyFunc  func(ctx context.Context, id string) (string, error)
+}
+
+// ListArchivable delegates to ListArchivableFunc if set.
+func (m *ArchiveRepositoryMock) ListArchivable(ctx context.Context, statuses []domain.OrderStatus, updatedBefore time.Time, limit int) ([]*domain.OrderBackup, error) {
+	if m.ListArchivableFunc != nil {
+		return m.ListArchivableFunc(ctx, statuses, updatedBefore, limit)
+	}
+	return nil, nil
+}
+
+// MarkArchived delegates to MarkArchivedFunc if set.
+func (m *ArchiveRepositoryMock) MarkArchived(ctx context.Context, objectKey string, orders []*domain.Order) (int, error) {
+	if m.MarkArchivedFunc != nil {
+		return m.MarkArchivedFunc(ctx, objectKey, orders)
+	}
+	return 0, nil
+}
+
+// FindObjectKey delegates to FindObjectKeyFunc if set.
+func (m *ArchiveRepositoryMock) FindObjectKey(ctx context.Context, id string) (string, error) {
+	if m.FindObjectKeyFunc != nil {
+		return m.FindObjectKeyFunc(ctx, id)
+	}
+	return "", nil
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// BackupRepositoryMock is a mock implementation of repository.BackupRepository
+type BackupRepositoryMock struct {
+	ExportOrdersFunc func(ctx context.Context, afterID string, limit int) ([]*domain.OrderBackup, error)
+	ImportOrdersFunc func(ctx context.Context, backups []*domain.OrderBackup) ([]bool, error)
+}
+
+// ExportOrders delegates to ExportOrdersFunc if set.
+func (m *BackupRepositoryMock) ExportOrders(ctx context.Context, afterID string, limit int) ([]*domain.OrderBackup, error) {
+	if m.ExportOrdersFunc != nil {
+		return m.ExportOrdersFunc(ctx, afterID, limit)
+	}
+	return nil, nil
+}
+
+// ImportOrders delegates to ImportOrdersFunc if set.
+func (m *BackupRepositoryMock) ImportOrders(ctx context.Context, backups []*domain.OrderBackup) ([]bool, error) {
+	if m.ImportOrdersFunc != nil {
+		return m.ImportOrdersFunc(ctx, backups)
+	}
+	return nil, nil
+}
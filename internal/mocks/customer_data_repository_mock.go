@@ -0,0 +1,35 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// CustomerDataRepositoryMock is a mock implementation of repository.CustomerDataRepository
+type CustomerDataRepositoryMock struct {
+	EraseCustomerFunc func(ctx context.Context, erasure *domain.CustomerErasure) ([]uuid.UUID, error)
+}
+
+// EraseCustomer delegates to EraseCustomerFunc if set.
+func (m *CustomerDataRepositoryMock) EraseCustomer(ctx context.Context, erasure *domain.CustomerErasure) ([]uuid.UUID, error) {
+	if m.EraseCustomerFunc != nil {
+		return m.EraseCustomerFunc(ctx, erasure)
+	}
+	return nil, nil
+}
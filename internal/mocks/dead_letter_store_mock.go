@@ -0,0 +1,74 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+)
+
+// DeadLetterStoreMock is a mock implementation of messaging.DeadLetterStore
+type DeadLetterStoreMock struct {
+	SaveFunc       func(ctx context.Context, dl *messaging.DeadLetter) error
+	ListDueFunc    func(ctx context.Context, now time.Time, maxAttempts, limit int) ([]*messaging.DeadLetter, error)
+	ListFunc       func(ctx context.Context, limit, offset int) ([]*messaging.DeadLetter, int64, error)
+	MarkFailedFunc func(ctx context.Context, id string, lastErr string, nextAttemptAt time.Time) error
+	RequeueFunc    func(ctx context.Context, id string) error
+	DeleteFunc     func(ctx context.Context, id string) error
+}
+
+func (m *DeadLetterStoreMock) Save(ctx context.Context, dl *messaging.DeadLetter) error {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(ctx, dl)
+	}
+	return nil
+}
+
+func (m *DeadLetterStoreMock) ListDue(ctx context.Context, now time.Time, maxAttempts, limit int) ([]*messaging.DeadLetter, error) {
+	if m.ListDueFunc != nil {
+		return m.ListDueFunc(ctx, now, maxAttempts, limit)
+	}
+	return nil, nil
+}
+
+func (m *DeadLetterStoreMock) List(ctx context.Context, limit, offset int) ([]*messaging.DeadLetter, int64, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+func (m *DeadLetterStoreMock) MarkFailed(ctx context.Context, id string, lastErr string, nextAttemptAt time.Time) error {
+	if m.MarkFailedFunc != nil {
+		return m.MarkFailedFunc(ctx, id, lastErr, nextAttemptAt)
+	}
+	return nil
+}
+
+func (m *DeadLetterStoreMock) Requeue(ctx context.Context, id string) error {
+	if m.RequeueFunc != nil {
+		return m.RequeueFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *DeadLetterStoreMock) Delete(ctx context.Context, id string) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
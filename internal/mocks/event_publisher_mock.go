@@ -11,6 +11,10 @@ type EventPublisherMock struct {
 	PublishOrderCreatedFunc       func(ctx context.Context, order *domain.Order) error
 	PublishOrderUpdatedFunc       func(ctx context.Context, order *domain.Order) error
 	PublishOrderStatusChangedFunc func(ctx context.Context, order *domain.Order, oldStatus, newStatus domain.OrderStatus) error
+	PublishOrderRestoredFunc      func(ctx context.Context, order *domain.Order) error
+	PublishOrderDeletedFunc       func(ctx context.Context, order *domain.Order) error
+	PublishCustomerDataErasedFunc func(ctx context.Context, erasure *domain.CustomerErasure) error
+	PublishOrderSLABreachedFunc   func(ctx context.Context, order *domain.Order) error
 }
 
 // PublishOrderCreated delegates to PublishOrderCreatedFunc if set.
@@ -36,3 +40,35 @@ func (m *EventPublisherMock) PublishOrderStatusChanged(ctx context.Context, orde
 	}
 	return nil
 }
+
+// PublishOrderRestored delegates to PublishOrderRestoredFunc if set.
+func (m *EventPublisherMock) PublishOrderRestored(ctx context.Context, order *domain.Order) error {
+	if m.PublishOrderRestoredFunc != nil {
+		return m.PublishOrderRestoredFunc(ctx, order)
+	}
+	return nil
+}
+
+// PublishOrderDeleted delegates to PublishOrderDeletedFunc if set.
+func (m *EventPublisherMock) PublishOrderDeleted(ctx context.Context, order *domain.Order) error {
+	if m.PublishOrderDeletedFunc != nil {
+		return m.PublishOrderDeletedFunc(ctx, order)
+	}
+	return nil
+}
+
+// PublishOrderSLABreached delegates to PublishOrderSLABreachedFunc if set.
+func (m *EventPublisherMock) PublishOrderSLABreached(ctx context.Context, order *domain.Order) error {
+	if m.PublishOrderSLABreachedFunc != nil {
+		return m.PublishOrderSLABreachedFunc(ctx, order)
+	}
+	return nil
+}
+
+// PublishCustomerDataErased delegates to PublishCustomerDataErasedFunc if set.
+func (m *EventPublisherMock) PublishCustomerDataErased(ctx context.Context, erasure *domain.CustomerErasure) error {
+	if m.PublishCustomerDataErasedFunc != nil {
+		return m.PublishCustomerDataErasedFunc(ctx, erasure)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// JobQueueMock is a mock implementation of repository.JobQueue
+type JobQueueMock struct {
+	EnqueueFunc       func(ctx context.Context, job *domain.Job) (bool, error)
+	ClaimFunc         func(ctx context.Context, now, leaseUntil time.Time) (*domain.Job, error)
+	FinishFunc        func(ctx context.Context, job *domain.Job) error
+	FindByIDFunc      func(ctx context.Context, id string) (*domain.Job, error)
+	ListFunc          func(ctx context.Context, status domain.JobStatus, limit, offset int) ([]*domain.Job, int64, error)
+	PurgeFinishedFunc func(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Enqueue delegates to EnqueueFunc if set.
+func (m *JobQueueMock) Enqueue(ctx context.Context, job *domain.Job) (bool, error) {
+	if m.EnqueueFunc != nil {
+		return m.EnqueueFunc(ctx, job)
+	}
+	return true, nil
+}
+
+// Claim delegates to ClaimFunc if set.
+func (m *JobQueueMock) Claim(ctx context.Context, now, leaseUntil time.Time) (*domain.Job, error) {
+	if m.ClaimFunc != nil {
+		return m.ClaimFunc(ctx, now, leaseUntil)
+	}
+	return nil, nil
+}
+
+// Finish delegates to FinishFunc if set.
+func (m *JobQueueMock) Finish(ctx context.Context, job *domain.Job) error {
+	if m.FinishFunc != nil {
+		return m.FinishFunc(ctx, job)
+	}
+	return nil
+}
+
+// FindByID delegates to FindByIDFunc if set.
+func (m *JobQueueMock) FindByID(ctx context.Context, id string) (*domain.Job, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// List delegates to ListFunc if set.
+func (m *JobQueueMock) List(ctx context.Context, status domain.JobStatus, limit, offset int) ([]*domain.Job, int64, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, status, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+// PurgeFinished delegates to PurgeFinishedFunc if set.
+func (m *JobQueueMock) PurgeFinished(ctx context.Context, before time.Time) (int64, error) {
+	if m.PurgeFinishedFunc != nil {
+		return m.PurgeFinishedFunc(ctx, before)
+	}
+	return 0, nil
+}
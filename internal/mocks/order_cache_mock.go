@@ -24,16 +24,18 @@ import (
 
 // OrderCacheMock is a mock implementation of OrderCache
 type OrderCacheMock struct {
-	GetFunc           func(ctx context.Context, id string) (*domain.Order, error)
+	GetFunc           func(ctx context.Context, tenantID, id string) (*domain.Order, error)
 	SetFunc           func(ctx context.Context, order *domain.Order, ttl time.Duration) error
-	DeleteFunc        func(ctx context.Context, id string) error
+	DeleteFunc        func(ctx context.Context, tenantID, id string) error
 	DeletePatternFunc func(ctx context.Context, pattern string) error
+	GetListFunc       func(ctx context.Context, key string) (*domain.PaginatedOrders, error)
+	SetListFunc       func(ctx context.Context, key string, page *domain.PaginatedOrders, ttl time.Duration) error
 }
 
 // Get retrieves an order from cache.
-func (m *OrderCacheMock) Get(ctx context.Context, id string) (*domain.Order, error) {
+func (m *OrderCacheMock) Get(ctx context.Context, tenantID, id string) (*domain.Order, error) {
 	if m.GetFunc != nil {
-		return m.GetFunc(ctx, id)
+		return m.GetFunc(ctx, tenantID, id)
 	}
 	return nil, nil
 }
@@ -47,9 +49,9 @@ func (m *OrderCacheMock) Set(ctx context.Context, order *domain.Order, ttl time.
 }
 
 // Delete removes an order from cache.
-func (m *OrderCacheMock) Delete(ctx context.Context, id string) error {
+func (m *OrderCacheMock) Delete(ctx context.Context, tenantID, id string) error {
 	if m.DeleteFunc != nil {
-		return m.DeleteFunc(ctx, id)
+		return m.DeleteFunc(ctx, tenantID, id)
 	}
 	return nil
 }
@@ -61,3 +63,19 @@ func (m *OrderCacheMock) DeletePattern(ctx context.Context, pattern string) erro
 	}
 	return nil
 }
+
+// GetList retrieves a page of orders from cache.
+func (m *OrderCacheMock) GetList(ctx context.Context, key string) (*domain.PaginatedOrders, error) {
+	if m.GetListFunc != nil {
+		return m.GetListFunc(ctx, key)
+	}
+	return nil, nil
+}
+
+// SetList stores a page of orders in cache with TTL.
+func (m *OrderCacheMock) SetList(ctx context.Context, key string, page *domain.PaginatedOrders, ttl time.Duration) error {
+	if m.SetListFunc != nil {
+		return m.SetListFunc(ctx, key, page, ttl)
+	}
+	return nil
+}
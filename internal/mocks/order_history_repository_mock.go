@@ -0,0 +1,44 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// OrderHistoryRepositoryMock is a mock implementation of repository.OrderHistoryRepository
+type OrderHistoryRepositoryMock struct {
+	ListByOrderIDFunc func(ctx context.Context, orderID string, limit, offset int) ([]*domain.OrderHistoryEntry, int64, error)
+	ListRangeFunc     func(ctx context.Context, q repository.HistoryRangeQuery) ([]*domain.OrderHistoryEntry, error)
+}
+
+// ListByOrderID delegates to ListByOrderIDFunc if set.
+func (m *OrderHistoryRepositoryMock) ListByOrderID(ctx context.Context, orderID string, limit, offset int) ([]*domain.OrderHistoryEntry, int64, error) {
+	if m.ListByOrderIDFunc != nil {
+		return m.ListByOrderIDFunc(ctx, orderID, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+// ListRange delegates to ListRangeFunc if set.
+func (m *OrderHistoryRepositoryMock) ListRange(ctx context.Context, q repository.HistoryRangeQuery) ([]*domain.OrderHistoryEntry, error) {
+	if m.ListRangeFunc != nil {
+		return m.ListRangeFunc(ctx, q)
+	}
+	return nil, nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// OrderNoteRepositoryMock is a mock implementation of repository.OrderNoteRepository
+type OrderNoteRepositoryMock struct {
+	CreateFunc         func(ctx context.Context, note *domain.OrderNote) error
+	ListByOrderIDFunc  func(ctx context.Context, orderID string, includeInternal bool, limit, offset int) ([]*domain.OrderNote, int64, error)
+	ListByOrderIDsFunc func(ctx context.Context, orderIDs []uuid.UUID, includeInternal bool) (map[uuid.UUID][]*domain.OrderNote, error)
+}
+
+// Create delegates to CreateFunc if set.
+func (m *OrderNoteRepositoryMock) Create(ctx context.Context, note *domain.OrderNote) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, note)
+	}
+	return nil
+}
+
+// ListByOrderID delegates to ListByOrderIDFunc if set.
+func (m *OrderNoteRepositoryMock) ListByOrderID(ctx context.Context, orderID string, includeInternal bool, limit, offset int) ([]*domain.OrderNote, int64, error) {
+	if m.ListByOrderIDFunc != nil {
+		return m.ListByOrderIDFunc(ctx, orderID, includeInternal, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+// ListByOrderIDs delegates to ListByOrderIDsFunc if set.
+func (m *OrderNoteRepositoryMock) ListByOrderIDs(ctx context.Context, orderIDs []uuid.UUID, includeInternal bool) (map[uuid.UUID][]*domain.OrderNote, error) {
+	if m.ListByOrderIDsFunc != nil {
+		return m.ListByOrderIDsFunc(ctx, orderIDs, includeInternal)
+	}
+	return nil, nil
+}
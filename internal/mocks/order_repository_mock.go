@@ -16,6 +16,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
@@ -23,12 +24,24 @@ import (
 
 // OrderRepositoryMock is a mock implementation of OrderRepository
 type OrderRepositoryMock struct {
-	CreateFunc           func(ctx context.Context, order *domain.Order) error
-	FindByIDFunc         func(ctx context.Context, id string) (*domain.Order, error)
-	UpdateFunc           func(ctx context.Context, order *domain.Order) error
-	DeleteFunc           func(ctx context.Context, id string) error
-	ListFunc             func(ctx context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error)
-	FindByCustomerIDFunc func(ctx context.Context, customerID string, opts repository.ListOptions) ([]*domain.Order, int64, error)
+	CreateFunc                   func(ctx context.Context, order *domain.Order) error
+	CreateBatchFunc              func(ctx context.Context, orders []*domain.Order) ([]error, error)
+	FindByIDFunc                 func(ctx context.Context, id string) (*domain.Order, error)
+	FindByIDIncludingDeletedFunc func(ctx context.Context, id string) (*domain.Order, error)
+	UpdateFunc                   func(ctx context.Context, order *domain.Order) error
+	DeleteFunc                   func(ctx context.Context, id string) error
+	ListFunc                     func(ctx context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error)
+	FindByCustomerIDFunc         func(ctx context.Context, customerID string, opts repository.ListOptions) ([]*domain.Order, int64, error)
+	EstimateTotalFunc            func(ctx context.Context) (int64, error)
+	SearchFunc                   func(ctx context.Context, query string, opts repository.SearchOptions) ([]*domain.Order, int64, error)
+	ListDeletedFunc              func(ctx context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error)
+	RestoreFunc                  func(ctx context.Context, id string, expectedVersion *int) (*domain.Order, error)
+	PurgeFunc                    func(ctx context.Context, deletedBefore time.Time) (int64, error)
+	PurgeCompletedFunc           func(ctx context.Context, statuses []domain.OrderStatus, updatedBefore time.Time) (int64, error)
+	ListDueHoldsFunc             func(ctx context.Context, now time.Time, limit int) ([]string, error)
+	ListOverdueDeliveriesFunc    func(ctx context.Context, now time.Time, limit int) ([]string, error)
+	ListStalePendingFunc         func(ctx context.Context, createdBefore time.Time, limit int) ([]string, error)
+	ListOrderAmountsFunc         func(ctx context.Context, afterID string, limit int) ([]domain.OrderAmounts, error)
 }
 
 // Create delegates to CreateFunc if set.
@@ -39,6 +52,15 @@ func (m *OrderRepositoryMock) Create(ctx context.Context, order *domain.Order) e
 	return nil
 }
 
+// CreateBatch delegates to CreateBatchFunc if set, otherwise reports every
+// order as inserted.
+func (m *OrderRepositoryMock) CreateBatch(ctx context.Context, orders []*domain.Order) ([]error, error) {
+	if m.CreateBatchFunc != nil {
+		return m.CreateBatchFunc(ctx, orders)
+	}
+	return make([]error, len(orders)), nil
+}
+
 // FindByID delegates to FindByIDFunc if set.
 func (m *OrderRepositoryMock) FindByID(ctx context.Context, id string) (*domain.Order, error) {
 	if m.FindByIDFunc != nil {
@@ -47,6 +69,14 @@ func (m *OrderRepositoryMock) FindByID(ctx context.Context, id string) (*domain.
 	return nil, nil
 }
 
+// FindByIDIncludingDeleted delegates to FindByIDIncludingDeletedFunc if set.
+func (m *OrderRepositoryMock) FindByIDIncludingDeleted(ctx context.Context, id string) (*domain.Order, error) {
+	if m.FindByIDIncludingDeletedFunc != nil {
+		return m.FindByIDIncludingDeletedFunc(ctx, id)
+	}
+	return nil, nil
+}
+
 // Update delegates to UpdateFunc if set.
 func (m *OrderRepositoryMock) Update(ctx context.Context, order *domain.Order) error {
 	if m.UpdateFunc != nil {
@@ -78,3 +108,84 @@ func (m *OrderRepositoryMock) FindByCustomerID(ctx context.Context, customerID s
 	}
 	return nil, 0, nil
 }
+
+// EstimateTotal delegates to EstimateTotalFunc if set, otherwise reports
+// that no estimate is available.
+func (m *OrderRepositoryMock) EstimateTotal(ctx context.Context) (int64, error) {
+	if m.EstimateTotalFunc != nil {
+		return m.EstimateTotalFunc(ctx)
+	}
+	return -1, nil
+}
+
+// Search delegates to SearchFunc if set.
+func (m *OrderRepositoryMock) Search(ctx context.Context, query string, opts repository.SearchOptions) ([]*domain.Order, int64, error) {
+	if m.SearchFunc != nil {
+		return m.SearchFunc(ctx, query, opts)
+	}
+	return nil, 0, nil
+}
+
+// ListDeleted delegates to ListDeletedFunc if set.
+func (m *OrderRepositoryMock) ListDeleted(ctx context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+	if m.ListDeletedFunc != nil {
+		return m.ListDeletedFunc(ctx, opts)
+	}
+	return nil, 0, nil
+}
+
+// Restore delegates to RestoreFunc if set.
+func (m *OrderRepositoryMock) Restore(ctx context.Context, id string, expectedVersion *int) (*domain.Order, error) {
+	if m.RestoreFunc != nil {
+		return m.RestoreFunc(ctx, id, expectedVersion)
+	}
+	return nil, nil
+}
+
+// Purge delegates to PurgeFunc if set.
+func (m *OrderRepositoryMock) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	if m.PurgeFunc != nil {
+		return m.PurgeFunc(ctx, deletedBefore)
+	}
+	return 0, nil
+}
+
+// PurgeCompleted delegates to PurgeCompletedFunc if set.
+func (m *OrderRepositoryMock) PurgeCompleted(ctx context.Context, statuses []domain.OrderStatus, updatedBefore time.Time) (int64, error) {
+	if m.PurgeCompletedFunc != nil {
+		return m.PurgeCompletedFunc(ctx, statuses, updatedBefore)
+	}
+	return 0, nil
+}
+
+// ListDueHolds delegates to ListDueHoldsFunc if set.
+func (m *OrderRepositoryMock) ListDueHolds(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	if m.ListDueHoldsFunc != nil {
+		return m.ListDueHoldsFunc(ctx, now, limit)
+	}
+	return nil, nil
+}
+
+// ListOverdueDeliveries delegates to ListOverdueDeliveriesFunc if set.
+func (m *OrderRepositoryMock) ListOverdueDeliveries(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	if m.ListOverdueDeliveriesFunc != nil {
+		return m.ListOverdueDeliveriesFunc(ctx, now, limit)
+	}
+	return nil, nil
+}
+
+// ListStalePending delegates to ListStalePendingFunc if set.
+func (m *OrderRepositoryMock) ListStalePending(ctx context.Context, createdBefore time.Time, limit int) ([]string, error) {
+	if m.ListStalePendingFunc != nil {
+		return m.ListStalePendingFunc(ctx, createdBefore, limit)
+	}
+	return nil, nil
+}
+
+// ListOrderAmounts delegates to ListOrderAmountsFunc if set.
+func (m *OrderRepositoryMock) ListOrderAmounts(ctx context.Context, afterID string, limit int) ([]domain.OrderAmounts, error) {
+	if m.ListOrderAmountsFunc != nil {
+		return m.ListOrderAmountsFunc(ctx, afterID, limit)
+	}
+	return nil, nil
+}
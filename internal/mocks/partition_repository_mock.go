@@ -0,0 +1,51 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocks
+
+import (
+	"context"
+	"time"
+)
+
+// PartitionRepositoryMock is a mock implementation of repository.PartitionRepository
+type PartitionRepositoryMock struct {
+	IsPartitionedFunc    func(ctx context.Context) (bool, error)
+	PartitionOrdersFunc  func(ctx context.Context, monthsAhead int) (int, error)
+	EnsurePartitionsFunc func(ctx context.Context, from time.Time, monthsAhead int) ([]string, error)
+}
+
+// IsPartitioned delegates to IsPartitionedFunc if set.
+func (m *PartitionRepositoryMock) IsPartitioned(ctx context.Context) (bool, error) {
+	if m.IsPartitionedFunc != nil {
+		return m.IsPartitionedFunc(ctx)
+	}
+	return false, nil
+}
+
+// PartitionOrders delegates to PartitionOrdersFunc if set.
+func (m *PartitionRepositoryMock) PartitionOrders(ctx context.Context, monthsAhead int) (int, error) {
+	if m.PartitionOrdersFunc != nil {
+		return m.PartitionOrdersFunc(ctx, monthsAhead)
+	}
+	return 0, nil
+}
+
+// EnsurePartitions delegates to EnsurePartitionsFunc if set.
+func (m *PartitionRepositoryMock) EnsurePartitions(ctx context.Context, from time.Time, monthsAhead int) ([]string, error) {
+	if m.EnsurePartitionsFunc != nil {
+		return m.EnsurePartitionsFunc(ctx, from, monthsAhead)
+	}
+	return nil, nil
+}
@@ -0,0 +1,30 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocks
+
+import "context"
+
+// PricingServiceMock is a mock implementation of service.PricingService
+type PricingServiceMock struct {
+	UnitPricesFunc func(ctx context.Context, productIDs []string) (map[string]float64, error)
+}
+
+// UnitPrices delegates to UnitPricesFunc if set.
+func (m *PricingServiceMock) UnitPrices(ctx context.Context, productIDs []string) (map[string]float64, error) {
+	if m.UnitPricesFunc != nil {
+		return m.UnitPricesFunc(ctx, productIDs)
+	}
+	return nil, nil
+}
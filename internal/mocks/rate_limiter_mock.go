@@ -0,0 +1,51 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocks
+
+import (
+	"context"
+	"time"
+)
+
+// RateLimiterMock is a mock implementation of cache.RateLimiter
+type RateLimiterMock struct {
+	AllowFunc func(ctx context.Context, key string, limit int, window time.Duration) (bool, error)
+	ResetFunc func(ctx context.Context, key string) error
+	CountFunc func(ctx context.Context, key string, window time.Duration) (int, error)
+}
+
+// Allow delegates to AllowFunc if set.
+func (m *RateLimiterMock) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	if m.AllowFunc != nil {
+		return m.AllowFunc(ctx, key, limit, window)
+	}
+	return true, nil
+}
+
+// Reset delegates to ResetFunc if set.
+func (m *RateLimiterMock) Reset(ctx context.Context, key string) error {
+	if m.ResetFunc != nil {
+		return m.ResetFunc(ctx, key)
+	}
+	return nil
+}
+
+// Count delegates to CountFunc if set.
+func (m *RateLimiterMock) Count(ctx context.Context, key string, window time.Duration) (int, error) {
+	if m.CountFunc != nil {
+		return m.CountFunc(ctx, key, window)
+	}
+	return 0, nil
+}
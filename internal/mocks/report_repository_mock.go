@@ -0,0 +1,44 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// ReportRepositoryMock is a mock implementation of repository.ReportRepository
+type ReportRepositoryMock struct {
+	AggregateOrdersFunc    func(ctx context.Context, opts repository.ReportOptions) ([]domain.OrderReportRow, error)
+	RefreshReportViewsFunc func(ctx context.Context) error
+}
+
+// AggregateOrders delegates to AggregateOrdersFunc if set.
+func (m *ReportRepositoryMock) AggregateOrders(ctx context.Context, opts repository.ReportOptions) ([]domain.OrderReportRow, error) {
+	if m.AggregateOrdersFunc != nil {
+		return m.AggregateOrdersFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+// RefreshReportViews delegates to RefreshReportViewsFunc if set.
+func (m *ReportRepositoryMock) RefreshReportViews(ctx context.Context) error {
+	if m.RefreshReportViewsFunc != nil {
+		return m.RefreshReportViewsFunc(ctx)
+	}
+	return nil
+}
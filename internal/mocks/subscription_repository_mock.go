@@ -0,0 +1,80 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// SubscriptionRepositoryMock is a mock implementation of repository.SubscriptionRepository
+type SubscriptionRepositoryMock struct {
+	CreateFunc   func(ctx context.Context, sub *domain.Subscription) error
+	FindByIDFunc func(ctx context.Context, id string) (*domain.Subscription, error)
+	ListFunc     func(ctx context.Context, customerID string, limit, offset int) ([]*domain.Subscription, int64, error)
+	UpdateFunc   func(ctx context.Context, sub *domain.Subscription) error
+	DeleteFunc   func(ctx context.Context, id string) error
+	ListDueFunc  func(ctx context.Context, now time.Time, limit int) ([]string, error)
+}
+
+// Create delegates to CreateFunc if set.
+func (m *SubscriptionRepositoryMock) Create(ctx context.Context, sub *domain.Subscription) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, sub)
+	}
+	return nil
+}
+
+// FindByID delegates to FindByIDFunc if set.
+func (m *SubscriptionRepositoryMock) FindByID(ctx context.Context, id string) (*domain.Subscription, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// List delegates to ListFunc if set.
+func (m *SubscriptionRepositoryMock) List(ctx context.Context, customerID string, limit, offset int) ([]*domain.Subscription, int64, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, customerID, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+// Update delegates to UpdateFunc if set.
+func (m *SubscriptionRepositoryMock) Update(ctx context.Context, sub *domain.Subscription) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, sub)
+	}
+	return nil
+}
+
+// Delete delegates to DeleteFunc if set.
+func (m *SubscriptionRepositoryMock) Delete(ctx context.Context, id string) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+// ListDue delegates to ListDueFunc if set.
+func (m *SubscriptionRepositoryMock) ListDue(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	if m.ListDueFunc != nil {
+		return m.ListDueFunc(ctx, now, limit)
+	}
+	return nil, nil
+}
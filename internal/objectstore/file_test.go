@@ -0,0 +1,76 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore_PutThenGet_CreatesDirectories(t *testing.T) {
+	store := NewFileStore(t.TempDir())
+
+	require.NoError(t, store.Put(context.Background(), "2026/10/orders.ndjson", strings.NewReader("data")))
+
+	r, err := store.Get(context.Background(), "2026/10/orders.ndjson")
+	require.NoError(t, err)
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(data))
+}
+
+func TestFileStore_Put_ReadFails_LeavesExistingObject(t *testing.T) {
+	dir := t.TempDir()
+	store := NewFileStore(dir)
+	require.NoError(t, store.Put(context.Background(), "orders.ndjson", strings.NewReader("old")))
+
+	err := store.Put(context.Background(), "orders.ndjson", iotest.ErrReader(errors.New("read failed")))
+
+	require.Error(t, err)
+	r, err := store.Get(context.Background(), "orders.ndjson")
+	require.NoError(t, err)
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "old", string(data))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary file left behind")
+}
+
+func TestFileStore_Get_Missing_ReturnsNotFound(t *testing.T) {
+	_, err := NewFileStore(t.TempDir()).Get(context.Background(), "missing.ndjson")
+
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestFileStore_KeyOutsideDirectory_Rejected(t *testing.T) {
+	store := NewFileStore(t.TempDir())
+
+	for _, key := range []string{"../escape", "/abs", "a/../../b", ""} {
+		assert.Error(t, store.Put(context.Background(), key, strings.NewReader("x")), key)
+		_, err := store.Get(context.Background(), key)
+		assert.Error(t, err, key)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package objectstore stores large blobs, such as order backups, in a local
+// directory or an S3-compatible bucket.
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// ErrNotFound is returned by Get when no object has the key
+var ErrNotFound = errors.New("object not found")
+
+// Store reads and writes objects by key. Keys are slash-separated paths
+// relative to the store's bucket prefix or directory.
+type Store interface {
+	// Put stores what body yields under key, replacing any object there. The
+	// object only appears once body is read to EOF; if reading body fails,
+	// nothing is stored.
+	Put(ctx context.Context, key string, body io.Reader) error
+
+	// Get opens the object stored under key.
+	// Returns ErrNotFound if there is none.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+// Config holds the settings of S3-compatible stores. Region and credentials
+// come from the default AWS chain (AWS_REGION, AWS_PROFILE, ...); for Google
+// Cloud Storage they are an HMAC key pair.
+type Config struct {
+	// Endpoint overrides the S3 endpoint, e.g. for MinIO or LocalStack
+	Endpoint string
+	// PathStyle addresses buckets as a path of the endpoint rather than as a
+	// subdomain, as MinIO and LocalStack need
+	PathStyle bool
+}
+
+// Open returns the store rawURL names:
+//
+//   - s3://bucket/prefix for an Amazon S3 bucket
+//   - gs://bucket/prefix for a Google Cloud Storage bucket, through its
+//     S3-compatible XML API
+//   - file:///dir or a plain path for a local directory
+func Open(ctx context.Context, rawURL string, cfg Config) (Store, error) {
+	loc, err := parseLocation(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	return loc.open(ctx, cfg)
+}
+
+// OpenObject opens the store holding the object rawURL names, in any form
+// Open accepts, and returns it with the object's key
+func OpenObject(ctx context.Context, rawURL string, cfg Config) (Store, string, error) {
+	loc, err := parseLocation(rawURL)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var key string
+	if loc.scheme == "file" {
+		loc.path, key = filepath.Split(loc.path)
+		if loc.path == "" {
+			loc.path = "."
+		}
+	} else {
+		loc.path, key = path.Split(loc.path)
+	}
+	if key == "" {
+		return nil, "", fmt.Errorf("%s names no object", rawURL)
+	}
+
+	store, err := loc.open(ctx, cfg)
+	if err != nil {
+		return nil, "", err
+	}
+	return store, key, nil
+}
+
+// WriteObject stores under key what write writes, streaming it to the store
+// as it is written. If write fails, nothing is stored and its error is
+// returned.
+func WriteObject(ctx context.Context, store Store, key string, write func(w io.Writer) error) error {
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		err := write(pw)
+		_ = pw.CloseWithError(err)
+		done <- err
+	}()
+
+	putErr := store.Put(ctx, key, pr)
+	// Unblock write if Put gave up before reading everything
+	_ = pr.CloseWithError(errStoppedReading)
+	if writeErr := <-done; writeErr != nil && !errors.Is(writeErr, errStoppedReading) {
+		return writeErr
+	}
+	return putErr
+}
+
+// errStoppedReading fails the writes of WriteObject once Put has returned
+var errStoppedReading = errors.New("object store stopped reading")
+
+// location is a parsed store URL
+type location struct {
+	scheme string
+	// bucket is empty for local directories
+	bucket string
+	// path is the key prefix in the bucket, or the local directory
+	path string
+}
+
+func parseLocation(rawURL string) (location, error) {
+	if !strings.Contains(rawURL, "://") {
+		return location{scheme: "file", path: rawURL}, nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return location{}, fmt.Errorf("invalid object store URL: %w", err)
+	}
+	switch u.Scheme {
+	case "file":
+		if u.Host != "" && u.Host != "localhost" {
+			return location{}, fmt.Errorf("file URL %s names a remote host", rawURL)
+		}
+		return location{scheme: u.Scheme, path: filepath.FromSlash(u.Path)}, nil
+	case "s3", "gs":
+		if u.Host == "" {
+			return location{}, fmt.Errorf("object store URL %s names no bucket", rawURL)
+		}
+		return location{scheme: u.Scheme, bucket: u.Host, path: strings.TrimPrefix(u.Path, "/")}, nil
+	default:
+		return location{}, fmt.Errorf("unsupported object store URL scheme %q", u.Scheme)
+	}
+}
+
+func (l location) open(ctx context.Context, cfg Config) (Store, error) {
+	switch l.scheme {
+	case "s3":
+		return NewS3Store(ctx, l.bucket, l.path, cfg)
+	case "gs":
+		return NewGCSStore(ctx, l.bucket, l.path, cfg)
+	default:
+		return NewFileStore(l.path), nil
+	}
+}
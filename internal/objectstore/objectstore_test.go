@@ -0,0 +1,133 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLocation(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		want    location
+		wantErr bool
+	}{
+		{name: "s3 with prefix", url: "s3://backups/ordersvc/daily", want: location{scheme: "s3", bucket: "backups", path: "ordersvc/daily"}},
+		{name: "gs bucket only", url: "gs://backups", want: location{scheme: "gs", bucket: "backups"}},
+		{name: "file URL", url: "file:///var/backups", want: location{scheme: "file", path: filepath.FromSlash("/var/backups")}},
+		{name: "plain path", url: "backups/orders", want: location{scheme: "file", path: "backups/orders"}},
+		{name: "no bucket", url: "s3:///orders", wantErr: true},
+		{name: "remote file host", url: "file://host/orders", wantErr: true},
+		{name: "unsupported scheme", url: "ftp://host/orders", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseLocation(tt.url)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestOpenObject_LocalPath_SplitsDirectoryAndKey(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "orders.ndjson"), []byte("{}\n"), 0o600))
+
+	store, key, err := OpenObject(context.Background(), filepath.Join(dir, "orders.ndjson"), Config{})
+	require.NoError(t, err)
+	assert.Equal(t, "orders.ndjson", key)
+
+	r, err := store.Get(context.Background(), key)
+	require.NoError(t, err)
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "{}\n", string(data))
+}
+
+func TestOpenObject_BucketWithoutKey_Fails(t *testing.T) {
+	_, _, err := OpenObject(context.Background(), "s3://backups/", Config{})
+
+	assert.ErrorContains(t, err, "names no object")
+}
+
+func TestWriteObject_StoresWhatIsWritten(t *testing.T) {
+	store := NewFileStore(t.TempDir())
+
+	err := WriteObject(context.Background(), store, "out.txt", func(w io.Writer) error {
+		_, err := io.WriteString(w, strings.Repeat("line\n", 1000))
+		return err
+	})
+	require.NoError(t, err)
+
+	r, err := store.Get(context.Background(), "out.txt")
+	require.NoError(t, err)
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("line\n", 1000), string(data))
+}
+
+func TestWriteObject_WriteFails_StoresNothing(t *testing.T) {
+	store := NewFileStore(t.TempDir())
+	boom := errors.New("export failed")
+
+	err := WriteObject(context.Background(), store, "out.txt", func(w io.Writer) error {
+		_, _ = io.WriteString(w, "partial\n")
+		return boom
+	})
+
+	assert.ErrorIs(t, err, boom)
+	_, err = store.Get(context.Background(), "out.txt")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+// failingStore reads a little of each object and then fails
+type failingStore struct {
+	Store
+	err error
+}
+
+func (s failingStore) Put(_ context.Context, _ string, body io.Reader) error {
+	_, _ = io.CopyN(io.Discard, body, 1)
+	return s.err
+}
+
+func TestWriteObject_PutFails_ReturnsPutError(t *testing.T) {
+	boom := errors.New("bucket unavailable")
+
+	err := WriteObject(context.Background(), failingStore{err: boom}, "out.txt", func(w io.Writer) error {
+		_, err := io.Copy(w, bytes.NewReader(make([]byte, 1<<20)))
+		return err
+	})
+
+	assert.ErrorIs(t, err, boom)
+}
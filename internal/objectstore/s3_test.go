@@ -0,0 +1,83 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/transfermanager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockBucket keeps objects put with PutObject in memory. Objects this small
+// are never uploaded in parts, so the multipart calls are left unimplemented.
+type mockBucket struct {
+	transfermanager.S3APIClient
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (m *mockBucket) PutObject(_ context.Context, in *s3.PutObjectInput, _ ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+	data, err := io.ReadAll(in.Body)
+	if err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.objects[aws.ToString(in.Bucket)+"/"+aws.ToString(in.Key)] = data
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (m *mockBucket) GetObject(_ context.Context, in *s3.GetObjectInput, _ ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.objects[aws.ToString(in.Bucket)+"/"+aws.ToString(in.Key)]
+	if !ok {
+		return nil, &types.NoSuchKey{}
+	}
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(data))}, nil
+}
+
+func TestS3Store_PutThenGet_UsesPrefix(t *testing.T) {
+	bucket := &mockBucket{objects: map[string][]byte{}}
+	store := newS3Store(bucket, "backups", "ordersvc/daily", aws.RequestChecksumCalculationWhenRequired)
+
+	require.NoError(t, store.Put(context.Background(), "orders.ndjson", strings.NewReader("data")))
+
+	assert.Contains(t, bucket.objects, "backups/ordersvc/daily/orders.ndjson")
+	r, err := store.Get(context.Background(), "orders.ndjson")
+	require.NoError(t, err)
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(data))
+}
+
+func TestS3Store_Get_NoSuchKey_ReturnsNotFound(t *testing.T) {
+	store := newS3Store(&mockBucket{objects: map[string][]byte{}}, "backups", "", aws.RequestChecksumCalculationWhenRequired)
+
+	_, err := store.Get(context.Background(), "missing.ndjson")
+
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mock is a deterministic payment provider for development and
+// tests (PAYMENTS_PROVIDER=mock). It calls nothing: the payment method alone
+// decides the outcome, named after Stripe's test payment methods.
+package mock
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+)
+
+// Name is the provider name payments carry
+const Name = "mock"
+
+// SignatureHeader is the header mock webhooks are signed in
+const SignatureHeader = "Mock-Signature"
+
+// Payment methods with a fixed outcome. Any other method succeeds.
+const (
+	MethodSucceeds          = "pm_card_visa"
+	MethodDeclined          = "pm_card_chargeDeclined"
+	MethodInsufficientFunds = "pm_card_chargeDeclinedInsufficientFunds"
+	MethodFraudulent        = "pm_card_chargeDeclinedFraudulent"
+	// MethodAsync leaves the payment pending until a webhook reports it
+	MethodAsync = "pm_card_async"
+	// MethodUnavailable fails the charge as if the provider were down
+	MethodUnavailable = "pm_card_unavailable"
+)
+
+// declines maps the declining methods to their failure code and message
+var declines = map[string][2]string{
+	MethodDeclined:          {"card_declined", "Your card was declined."},
+	MethodInsufficientFunds: {"insufficient_funds", "Your card has insufficient funds."},
+	MethodFraudulent:        {"fraudulent", "Your card was declined."},
+}
+
+// Webhook is the body of a mock webhook: the payment it reports
+type Webhook struct {
+	Reference      string `json:"reference"`
+	OrderID        string `json:"order_id"`
+	TenantID       string `json:"tenant_id,omitempty"`
+	Amount         int64  `json:"amount"`
+	Currency       string `json:"currency"`
+	Status         string `json:"status"`
+	FailureCode    string `json:"failure_code,omitempty"`
+	FailureMessage string `json:"failure_message,omitempty"`
+}
+
+// Provider is the mock service.PaymentProvider
+type Provider struct {
+	webhookSecret string
+}
+
+// New creates a mock provider. Webhooks must carry the hex HMAC-SHA256 of
+// their body under webhookSecret in the Mock-Signature header; an empty
+// secret accepts them unsigned.
+func New(webhookSecret string) *Provider {
+	return &Provider{webhookSecret: webhookSecret}
+}
+
+var _ service.PaymentProvider = (*Provider)(nil)
+
+// Name returns "mock"
+func (p *Provider) Name() string { return Name }
+
+// SignatureHeader returns "Mock-Signature"
+func (p *Provider) SignatureHeader() string { return SignatureHeader }
+
+// Charge returns the outcome of req.PaymentMethod. The reference is derived
+// from the idempotency key, so a retried charge is the same payment.
+func (p *Provider) Charge(_ context.Context, req service.ChargeRequest) (*domain.Payment, error) {
+	if req.PaymentMethod == MethodUnavailable {
+		return nil, fmt.Errorf("mock: %w", domain.ErrPaymentProviderUnavailable)
+	}
+
+	sum := sha256.Sum256([]byte(req.IdempotencyKey))
+	payment := &domain.Payment{
+		Provider:  Name,
+		Reference: "mock_" + hex.EncodeToString(sum[:12]),
+		OrderID:   req.OrderID,
+		TenantID:  req.TenantID,
+		Amount:    req.Amount,
+		Currency:  req.Currency,
+		Status:    domain.PaymentSucceeded,
+	}
+	if decline, ok := declines[req.PaymentMethod]; ok {
+		payment.Status = domain.PaymentFailed
+		payment.FailureCode, payment.FailureMessage = decline[0], decline[1]
+	} else if req.PaymentMethod == MethodAsync {
+		payment.Status = domain.PaymentPending
+	}
+	return payment, nil
+}
+
+// ParseWebhook reads a Webhook, checking its signature when the provider
+// has a secret
+func (p *Provider) ParseWebhook(payload []byte, signature string) (*domain.Payment, error) {
+	if p.webhookSecret != "" {
+		sig, err := hex.DecodeString(signature)
+		if err != nil || !hmac.Equal(sig, p.sign(payload)) {
+			return nil, fmt.Errorf("%w: signature mismatch", domain.ErrInvalidPaymentWebhook)
+		}
+	}
+
+	var w Webhook
+	if err := json.Unmarshal(payload, &w); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidPaymentWebhook, err)
+	}
+	status := domain.PaymentStatus(w.Status)
+	switch status {
+	case domain.PaymentSucceeded, domain.PaymentPending, domain.PaymentFailed:
+	default:
+		return nil, fmt.Errorf("%w: unknown status %q", domain.ErrInvalidPaymentWebhook, w.Status)
+	}
+	if w.OrderID == "" {
+		return nil, fmt.Errorf("%w: order_id is required", domain.ErrInvalidPaymentWebhook)
+	}
+	return &domain.Payment{
+		Provider:       Name,
+		Reference:      w.Reference,
+		OrderID:        w.OrderID,
+		TenantID:       w.TenantID,
+		Amount:         domain.Money(w.Amount),
+		Currency:       w.Currency,
+		Status:         status,
+		FailureCode:    w.FailureCode,
+		FailureMessage: w.FailureMessage,
+	}, nil
+}
+
+// Sign returns the Mock-Signature of payload, for tests and for reporting
+// asynchronous payments by hand
+func (p *Provider) Sign(payload []byte) string {
+	return hex.EncodeToString(p.sign(payload))
+}
+
+func (p *Provider) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(p.webhookSecret))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvider_Charge_OutcomeByMethod(t *testing.T) {
+	tests := []struct {
+		method     string
+		wantStatus domain.PaymentStatus
+		wantCode   string
+		wantErr    error
+	}{
+		{method: MethodSucceeds, wantStatus: domain.PaymentSucceeded},
+		{method: "pm_anything_else", wantStatus: domain.PaymentSucceeded},
+		{method: MethodDeclined, wantStatus: domain.PaymentFailed, wantCode: "card_declined"},
+		{method: MethodInsufficientFunds, wantStatus: domain.PaymentFailed, wantCode: "insufficient_funds"},
+		{method: MethodFraudulent, wantStatus: domain.PaymentFailed, wantCode: "fraudulent"},
+		{method: MethodAsync, wantStatus: domain.PaymentPending},
+		{method: MethodUnavailable, wantErr: domain.ErrPaymentProviderUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			req := service.ChargeRequest{OrderID: "order-1", Amount: 1000, Currency: "usd", PaymentMethod: tt.method, IdempotencyKey: "order-1-v1"}
+
+			payment, err := New("").Charge(context.Background(), req)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, payment.Status)
+			assert.Equal(t, tt.wantCode, payment.FailureCode)
+
+			again, err := New("").Charge(context.Background(), req)
+			require.NoError(t, err)
+			assert.Equal(t, payment.Reference, again.Reference, "a retried charge is the same payment")
+		})
+	}
+}
+
+func TestProvider_ParseWebhook(t *testing.T) {
+	p := New("secret")
+	payload := []byte(`{"reference":"mock_1","order_id":"order-1","amount":1000,"currency":"usd","status":"succeeded"}`)
+
+	payment, err := p.ParseWebhook(payload, p.Sign(payload))
+	require.NoError(t, err)
+	assert.Equal(t, domain.PaymentSucceeded, payment.Status)
+	assert.Equal(t, "order-1", payment.OrderID)
+
+	_, err = p.ParseWebhook(payload, New("other").Sign(payload))
+	assert.ErrorIs(t, err, domain.ErrInvalidPaymentWebhook)
+
+	_, err = New("").ParseWebhook([]byte(`{"order_id":"order-1","status":"refunded"}`), "")
+	assert.ErrorIs(t, err, domain.ErrInvalidPaymentWebhook)
+}
@@ -0,0 +1,292 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package stripe collects order payments through Stripe PaymentIntents
+// (PAYMENTS_PROVIDER=stripe).
+package stripe
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/httpclient"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+)
+
+// Name is the provider name payments and metrics carry
+const Name = "stripe"
+
+// SignatureHeader is the header Stripe signs webhooks in
+const SignatureHeader = "Stripe-Signature"
+
+// DefaultURL is Stripe's API
+const DefaultURL = "https://api.stripe.com"
+
+// signatureTolerance is how old a webhook signature may be, as in Stripe's
+// own libraries, so a captured webhook cannot be replayed later
+const signatureTolerance = 5 * time.Minute
+
+// Config tunes a Provider
+type Config struct {
+	// URL defaults to DefaultURL
+	URL string
+	// SecretKey authenticates API calls (sk_live_... or sk_test_...)
+	SecretKey string
+	// WebhookSecret verifies webhook signatures (whsec_...)
+	WebhookSecret string
+}
+
+// Provider is a service.PaymentProvider creating and confirming a Stripe
+// PaymentIntent per charge. Card declines come back as failed payments with
+// Stripe's decline code; payments needing further customer action are
+// pending until the payment_intent.succeeded or
+// payment_intent.payment_failed webhook arrives.
+type Provider struct {
+	client *httpclient.Client
+	cfg    Config
+	now    func() time.Time
+}
+
+// New creates a provider calling Stripe through client
+func New(client *httpclient.Client, cfg Config) *Provider {
+	if cfg.URL == "" {
+		cfg.URL = DefaultURL
+	}
+	cfg.URL = strings.TrimSuffix(cfg.URL, "/")
+	return &Provider{client: client, cfg: cfg, now: time.Now}
+}
+
+var _ service.PaymentProvider = (*Provider)(nil)
+
+// Name returns "stripe"
+func (p *Provider) Name() string { return Name }
+
+// SignatureHeader returns "Stripe-Signature"
+func (p *Provider) SignatureHeader() string { return SignatureHeader }
+
+// Charge creates and confirms a PaymentIntent for req, sending its
+// IdempotencyKey so Stripe collects a retried charge once
+func (p *Provider) Charge(ctx context.Context, req service.ChargeRequest) (*domain.Payment, error) {
+	form := url.Values{
+		"amount":                             {strconv.FormatInt(int64(req.Amount), 10)},
+		"currency":                           {req.Currency},
+		"payment_method":                     {req.PaymentMethod},
+		"confirm":                            {"true"},
+		"automatic_payment_methods[enabled]": {"true"},
+		// Redirects need a return URL this API has no way to offer
+		"automatic_payment_methods[allow_redirects]": {"never"},
+		"metadata[order_id]":                         {req.OrderID},
+	}
+	if req.TenantID != "" {
+		form.Set("metadata[tenant_id]", req.TenantID)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.cfg.URL+"/v1/payment_intents", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+p.cfg.SecretKey)
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpReq.Header.Set(httpclient.IdempotencyKeyHeader, req.IdempotencyKey)
+
+	resp, err := p.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("stripe: %w: %w", domain.ErrPaymentProviderUnavailable, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("stripe: %w: %w", domain.ErrPaymentProviderUnavailable, err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		var intent paymentIntent
+		if err := json.Unmarshal(body, &intent); err != nil {
+			return nil, fmt.Errorf("stripe: decode payment intent: %w", err)
+		}
+		return intent.payment(), nil
+
+	case resp.StatusCode == http.StatusPaymentRequired:
+		// Declines are errors in Stripe's API but outcomes here
+		var e errorResponse
+		if err := json.Unmarshal(body, &e); err != nil {
+			return nil, fmt.Errorf("stripe: decode error: %w", err)
+		}
+		payment := &domain.Payment{
+			Provider:       Name,
+			OrderID:        req.OrderID,
+			TenantID:       req.TenantID,
+			Amount:         req.Amount,
+			Currency:       req.Currency,
+			Status:         domain.PaymentFailed,
+			FailureCode:    e.Error.failureCode(),
+			FailureMessage: e.Error.Message,
+		}
+		if e.Error.PaymentIntent != nil {
+			payment.Reference = e.Error.PaymentIntent.ID
+		}
+		return payment, nil
+
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return nil, fmt.Errorf("stripe responded %s: %w", resp.Status, domain.ErrPaymentProviderUnavailable)
+
+	default:
+		// Invalid requests and keys are this service's fault, not the customer's
+		var e errorResponse
+		_ = json.Unmarshal(body, &e)
+		return nil, fmt.Errorf("stripe responded %s: %s", resp.Status, e.Error.Message)
+	}
+}
+
+// ParseWebhook verifies the Stripe-Signature of payload and returns the
+// payment of a payment_intent.succeeded or payment_intent.payment_failed
+// event. Other events return nil.
+func (p *Provider) ParseWebhook(payload []byte, signature string) (*domain.Payment, error) {
+	if err := p.verify(payload, signature); err != nil {
+		return nil, err
+	}
+
+	var evt struct {
+		Type string `json:"type"`
+		Data struct {
+			Object paymentIntent `json:"object"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidPaymentWebhook, err)
+	}
+	switch evt.Type {
+	case "payment_intent.succeeded", "payment_intent.payment_failed":
+	default:
+		return nil, nil
+	}
+	payment := evt.Data.Object.payment()
+	if payment.OrderID == "" {
+		// Not charged by this service, e.g. an invoice of the same account
+		return nil, nil
+	}
+	return payment, nil
+}
+
+// verify checks a Stripe-Signature header, "t=<unix time>,v1=<hex HMAC>",
+// whose HMAC-SHA256 covers "<unix time>.<payload>". Stripe sends several v1
+// signatures while a webhook secret is rolled.
+func (p *Provider) verify(payload []byte, header string) error {
+	var timestamp string
+	var signatures [][]byte
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			if sig, err := hex.DecodeString(value); err == nil {
+				signatures = append(signatures, sig)
+			}
+		}
+	}
+	secs, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return fmt.Errorf("%w: malformed %s header", domain.ErrInvalidPaymentWebhook, SignatureHeader)
+	}
+	if age := p.now().Sub(time.Unix(secs, 0)); age > signatureTolerance || age < -signatureTolerance {
+		return fmt.Errorf("%w: signature timestamp outside tolerance", domain.ErrInvalidPaymentWebhook)
+	}
+
+	mac := hmac.New(sha256.New, []byte(p.cfg.WebhookSecret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+	for _, sig := range signatures {
+		if hmac.Equal(sig, expected) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: signature mismatch", domain.ErrInvalidPaymentWebhook)
+}
+
+// paymentIntent is the part of a Stripe PaymentIntent this provider reads
+type paymentIntent struct {
+	ID       string            `json:"id"`
+	Amount   int64             `json:"amount"`
+	Currency string            `json:"currency"`
+	Status   string            `json:"status"`
+	Metadata map[string]string `json:"metadata"`
+	// LastPaymentError explains the latest failed attempt
+	LastPaymentError *stripeError `json:"last_payment_error"`
+}
+
+func (pi *paymentIntent) payment() *domain.Payment {
+	payment := &domain.Payment{
+		Provider:  Name,
+		Reference: pi.ID,
+		OrderID:   pi.Metadata["order_id"],
+		TenantID:  pi.Metadata["tenant_id"],
+		Amount:    domain.Money(pi.Amount),
+		Currency:  pi.Currency,
+	}
+	switch pi.Status {
+	case "succeeded":
+		payment.Status = domain.PaymentSucceeded
+	case "requires_payment_method", "canceled":
+		// Stripe returns a declined intent to requires_payment_method
+		payment.Status = domain.PaymentFailed
+		if pi.LastPaymentError != nil {
+			payment.FailureCode = pi.LastPaymentError.failureCode()
+			payment.FailureMessage = pi.LastPaymentError.Message
+		} else if pi.Status == "canceled" {
+			payment.FailureCode = "canceled"
+		}
+	default:
+		// processing, requires_action and requires_capture finish later
+		payment.Status = domain.PaymentPending
+	}
+	return payment
+}
+
+type errorResponse struct {
+	Error stripeError `json:"error"`
+}
+
+type stripeError struct {
+	Type          string         `json:"type"`
+	Code          string         `json:"code"`
+	DeclineCode   string         `json:"decline_code"`
+	Message       string         `json:"message"`
+	PaymentIntent *paymentIntent `json:"payment_intent"`
+}
+
+// failureCode prefers the decline code, e.g. insufficient_funds, to the
+// generic card_declined
+func (e *stripeError) failureCode() string {
+	if e.DeclineCode != "" {
+		return e.DeclineCode
+	}
+	if e.Code != "" {
+		return e.Code
+	}
+	return e.Type
+}
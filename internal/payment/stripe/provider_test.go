@@ -0,0 +1,201 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stripe
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/httpclient"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var chargeRequest = service.ChargeRequest{
+	OrderID:        "order-1",
+	TenantID:       "acme",
+	Amount:         2599,
+	Currency:       "usd",
+	PaymentMethod:  "pm_card_visa",
+	IdempotencyKey: "order-1-v1",
+}
+
+func TestProvider_Charge_Succeeded(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/payment_intents", r.URL.Path)
+		assert.Equal(t, "Bearer sk_test_123", r.Header.Get("Authorization"))
+		assert.Equal(t, "order-1-v1", r.Header.Get(httpclient.IdempotencyKeyHeader))
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "2599", r.PostForm.Get("amount"))
+		assert.Equal(t, "pm_card_visa", r.PostForm.Get("payment_method"))
+		assert.Equal(t, "order-1", r.PostForm.Get("metadata[order_id]"))
+		assert.Equal(t, "acme", r.PostForm.Get("metadata[tenant_id]"))
+		_, _ = fmt.Fprint(w, `{"id":"pi_1","amount":2599,"currency":"usd","status":"succeeded","metadata":{"order_id":"order-1","tenant_id":"acme"}}`)
+	}))
+	defer srv.Close()
+
+	payment, err := newProvider(srv.URL).Charge(context.Background(), chargeRequest)
+
+	require.NoError(t, err)
+	assert.Equal(t, &domain.Payment{
+		Provider:  Name,
+		Reference: "pi_1",
+		OrderID:   "order-1",
+		TenantID:  "acme",
+		Amount:    2599,
+		Currency:  "usd",
+		Status:    domain.PaymentSucceeded,
+	}, payment)
+}
+
+func TestProvider_Charge_Responses(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		wantStatus domain.PaymentStatus
+		wantCode   string
+		wantErr    error
+		wantAnyErr bool
+	}{
+		{
+			name:       "declined",
+			status:     http.StatusPaymentRequired,
+			body:       `{"error":{"type":"card_error","code":"card_declined","decline_code":"insufficient_funds","message":"Your card has insufficient funds.","payment_intent":{"id":"pi_2"}}}`,
+			wantStatus: domain.PaymentFailed,
+			wantCode:   "insufficient_funds",
+		},
+		{
+			name:       "requires action",
+			status:     http.StatusOK,
+			body:       `{"id":"pi_3","status":"requires_action","metadata":{"order_id":"order-1"}}`,
+			wantStatus: domain.PaymentPending,
+		},
+		{name: "rate limited", status: http.StatusTooManyRequests, body: `{}`, wantErr: domain.ErrPaymentProviderUnavailable},
+		{name: "server error", status: http.StatusInternalServerError, body: `{}`, wantErr: domain.ErrPaymentProviderUnavailable},
+		{name: "invalid request", status: http.StatusBadRequest, body: `{"error":{"message":"No such PaymentMethod"}}`, wantAnyErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = fmt.Fprint(w, tt.body)
+			}))
+			defer srv.Close()
+
+			payment, err := newProvider(srv.URL).Charge(context.Background(), chargeRequest)
+
+			switch {
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+			case tt.wantAnyErr:
+				require.Error(t, err)
+				assert.NotErrorIs(t, err, domain.ErrPaymentProviderUnavailable)
+				assert.ErrorContains(t, err, "No such PaymentMethod")
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantStatus, payment.Status)
+				assert.Equal(t, tt.wantCode, payment.FailureCode)
+				assert.Equal(t, "order-1", payment.OrderID)
+			}
+		})
+	}
+}
+
+func TestProvider_ParseWebhook(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	succeeded := []byte(`{"type":"payment_intent.succeeded","data":{"object":{"id":"pi_1","amount":2599,"currency":"usd","status":"succeeded","metadata":{"order_id":"order-1","tenant_id":"acme"}}}}`)
+	failed := []byte(`{"type":"payment_intent.payment_failed","data":{"object":{"id":"pi_1","status":"requires_payment_method","metadata":{"order_id":"order-1"},"last_payment_error":{"code":"card_declined","message":"Your card was declined."}}}}`)
+	other := []byte(`{"type":"charge.refunded","data":{"object":{"id":"ch_1"}}}`)
+	foreign := []byte(`{"type":"payment_intent.succeeded","data":{"object":{"id":"pi_9","status":"succeeded","metadata":{}}}}`)
+
+	tests := []struct {
+		name       string
+		payload    []byte
+		signature  string
+		wantStatus domain.PaymentStatus
+		wantCode   string
+		wantNil    bool
+		wantErr    bool
+	}{
+		{name: "succeeded", payload: succeeded, signature: sign(succeeded, "whsec_1", now), wantStatus: domain.PaymentSucceeded},
+		{name: "failed", payload: failed, signature: sign(failed, "whsec_1", now), wantStatus: domain.PaymentFailed, wantCode: "card_declined"},
+		{name: "rolled secret", payload: succeeded, signature: sign(succeeded, "whsec_old", now) + ",v1=" + mac(succeeded, "whsec_1", now), wantStatus: domain.PaymentSucceeded},
+		{name: "other event", payload: other, signature: sign(other, "whsec_1", now), wantNil: true},
+		{name: "not charged by this service", payload: foreign, signature: sign(foreign, "whsec_1", now), wantNil: true},
+		{name: "wrong secret", payload: succeeded, signature: sign(succeeded, "whsec_2", now), wantErr: true},
+		{name: "tampered payload", payload: failed, signature: sign(succeeded, "whsec_1", now), wantErr: true},
+		{name: "replayed", payload: succeeded, signature: sign(succeeded, "whsec_1", now.Add(-10*time.Minute)), wantErr: true},
+		{name: "missing signature", payload: succeeded, signature: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newProvider("")
+			p.now = func() time.Time { return now }
+
+			payment, err := p.ParseWebhook(tt.payload, tt.signature)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, domain.ErrInvalidPaymentWebhook)
+				return
+			}
+			require.NoError(t, err)
+			if tt.wantNil {
+				assert.Nil(t, payment)
+				return
+			}
+			require.NotNil(t, payment)
+			assert.Equal(t, "order-1", payment.OrderID)
+			assert.Equal(t, tt.wantStatus, payment.Status)
+			assert.Equal(t, tt.wantCode, payment.FailureCode)
+		})
+	}
+}
+
+func newProvider(url string) *Provider {
+	client := httpclient.New(httpclient.Config{
+		Timeout:         time.Second,
+		MaxAttempts:     1,
+		InitialBackoff:  time.Millisecond,
+		MaxBackoff:      time.Millisecond,
+		BreakerFailures: 5,
+		BreakerCooldown: time.Second,
+	}, nil)
+	return New(client, Config{URL: url, SecretKey: "sk_test_123", WebhookSecret: "whsec_1"})
+}
+
+// sign returns the Stripe-Signature of payload signed with secret at t
+func sign(payload []byte, secret string, t time.Time) string {
+	return fmt.Sprintf("t=%d,v1=%s", t.Unix(), mac(payload, secret, t))
+}
+
+// mac returns the hex v1 signature of payload signed with secret at t
+func mac(payload []byte, secret string, t time.Time) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	_, _ = fmt.Fprintf(h, "%d.", t.Unix())
+	h.Write(payload)
+	return hex.EncodeToString(h.Sum(nil))
+}
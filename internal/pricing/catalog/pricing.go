@@ -0,0 +1,96 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package catalog prices order items from a product catalog service
+// (PRICING_CATALOG_URL).
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/httpclient"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+)
+
+// Name labels the catalog client's metrics
+const Name = "catalog"
+
+// Pricing is a service.PricingService reading unit prices from a catalog
+// service with one request per lookup:
+//
+//	GET <URL>/prices?product_id=p-1&product_id=p-2
+//	{"prices": {"p-1": 12.50}}
+//
+// Products the catalog leaves out of prices are unknown to it.
+type Pricing struct {
+	client *httpclient.Client
+	url    string
+}
+
+// New creates a Pricing calling the catalog at baseURL through client
+func New(client *httpclient.Client, baseURL string) *Pricing {
+	return &Pricing{client: client, url: strings.TrimSuffix(baseURL, "/")}
+}
+
+var _ service.PricingService = (*Pricing)(nil)
+
+// pricesResponse is the catalog's answer to a price lookup
+type pricesResponse struct {
+	Prices map[string]float64 `json:"prices"`
+}
+
+// UnitPrices returns the catalog price of each of productIDs it knows.
+// Returns domain.ErrPricingUnavailable when the catalog cannot be reached or
+// fails.
+func (p *Pricing) UnitPrices(ctx context.Context, productIDs []string) (map[string]float64, error) {
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+
+	query := url.Values{"product_id": productIDs}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url+"/prices?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("catalog: %w: %w", domain.ErrPricingUnavailable, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("catalog: %w: status %d", domain.ErrPricingUnavailable, resp.StatusCode)
+	}
+
+	var body pricesResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("catalog: %w: decode prices: %w", domain.ErrPricingUnavailable, err)
+	}
+
+	prices := make(map[string]float64, len(productIDs))
+	for _, id := range productIDs {
+		if price, ok := body.Prices[id]; ok {
+			prices[id] = price
+		}
+	}
+	return prices, nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/httpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPricing_UnitPrices_ReturnsKnownPrices(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/prices", r.URL.Path)
+		assert.Equal(t, []string{"p-1", "p-2"}, r.URL.Query()["product_id"])
+		_, _ = fmt.Fprint(w, `{"prices":{"p-1":12.50,"p-9":1.00}}`)
+	}))
+	defer srv.Close()
+
+	prices, err := newPricing(srv.URL+"/").UnitPrices(context.Background(), []string{"p-1", "p-2"})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"p-1": 12.50}, prices, "unknown and unrequested products are left out")
+}
+
+func TestPricing_UnitPrices_NoProducts_NoRequest(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		t.Error("catalog called without products")
+	}))
+	defer srv.Close()
+
+	prices, err := newPricing(srv.URL).UnitPrices(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, prices)
+}
+
+func TestPricing_UnitPrices_CatalogFails_ReturnsUnavailable(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{name: "server error", status: http.StatusInternalServerError, body: `{}`},
+		{name: "not found", status: http.StatusNotFound, body: `{}`},
+		{name: "invalid body", status: http.StatusOK, body: `not json`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = fmt.Fprint(w, tt.body)
+			}))
+			defer srv.Close()
+
+			_, err := newPricing(srv.URL).UnitPrices(context.Background(), []string{"p-1"})
+
+			assert.ErrorIs(t, err, domain.ErrPricingUnavailable)
+		})
+	}
+}
+
+func TestPricing_UnitPrices_Unreachable_ReturnsUnavailable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+
+	_, err := newPricing(srv.URL).UnitPrices(context.Background(), []string{"p-1"})
+
+	assert.ErrorIs(t, err, domain.ErrPricingUnavailable)
+}
+
+func newPricing(url string) *Pricing {
+	client := httpclient.New(httpclient.Config{
+		Timeout:         time.Second,
+		MaxAttempts:     1,
+		InitialBackoff:  time.Millisecond,
+		MaxBackoff:      time.Millisecond,
+		BreakerFailures: 5,
+		BreakerCooldown: time.Second,
+	}, nil)
+	return New(client, url)
+}
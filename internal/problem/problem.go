@@ -0,0 +1,130 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package problem writes API error bodies. By default they keep the
+// service's {"error", "code"} shape; clients that accept
+// application/problem+json, or every client when the server forces it, get
+// an RFC 7807 problem document instead.
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ContentType is the media type of an RFC 7807 problem document.
+const ContentType = "application/problem+json"
+
+// Details is an RFC 7807 problem document. Code, Errors and ErrorID are
+// extension members carrying the same values as the legacy error body, so
+// clients can switch formats without losing information.
+type Details struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Code     string `json:"code,omitempty"`
+	Errors   any    `json:"errors,omitempty"`
+	ErrorID  string `json:"error_id,omitempty"`
+}
+
+// legacy is the error body clients receive unless they ask for problems.
+type legacy struct {
+	Error   string `json:"error"`
+	Code    string `json:"code,omitempty"`
+	Errors  any    `json:"errors,omitempty"`
+	ErrorID string `json:"error_id,omitempty"`
+}
+
+type contextKey struct{}
+
+// Force returns a context in which errors are always written as problems,
+// whatever the client accepts.
+func Force(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, true)
+}
+
+// Wanted reports whether the error response to r should be a problem
+// document: the server forces it, or the Accept header lists ContentType.
+func Wanted(r *http.Request) bool {
+	if forced, _ := r.Context().Value(contextKey{}).(bool); forced {
+		return true
+	}
+	for _, accept := range r.Header.Values("Accept") {
+		for _, part := range strings.Split(accept, ",") {
+			mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+			if err == nil && mediaType == ContentType && params["q"] != "0" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// TypeURI identifies the problem type of an error code, e.g.
+// "urn:ordersvc:problem:order-not-found". Errors without a code use
+// "about:blank", meaning the type is described by the status alone.
+func TypeURI(code string) string {
+	if code == "" {
+		return "about:blank"
+	}
+	return "urn:ordersvc:problem:" + strings.ToLower(strings.ReplaceAll(code, "_", "-"))
+}
+
+// NewErrorID returns an identifier for one failed request. It is logged
+// alongside the failure and sent to the client, so a support ticket quoting
+// it leads straight to the log line.
+func NewErrorID() string {
+	return uuid.NewString()
+}
+
+// Write writes an error response to r. errs lists per-field failures and
+// may be nil.
+func Write(w http.ResponseWriter, r *http.Request, status int, message, code string, errs any) {
+	write(w, r, status, message, code, errs, "")
+}
+
+// WriteInternal writes a 500 INTERNAL_ERROR response to r that carries
+// errorID instead of the cause, which stays in the logs.
+func WriteInternal(w http.ResponseWriter, r *http.Request, errorID string) {
+	write(w, r, http.StatusInternalServerError, "internal server error", "INTERNAL_ERROR", nil, errorID)
+}
+
+func write(w http.ResponseWriter, r *http.Request, status int, message, code string, errs any, errorID string) {
+	if !Wanted(r) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(legacy{Error: message, Code: code, Errors: errs, ErrorID: errorID})
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(Details{
+		Type:     TypeURI(code),
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   message,
+		Instance: r.URL.Path,
+		Code:     code,
+		Errors:   errs,
+		ErrorID:  errorID,
+	})
+}
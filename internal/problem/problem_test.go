@@ -0,0 +1,119 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package problem
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWanted(t *testing.T) {
+	tests := []struct {
+		name   string
+		accept string
+		forced bool
+		want   bool
+	}{
+		{"no accept", "", false, false},
+		{"plain json", "application/json", false, false},
+		{"problem json", "application/problem+json", false, true},
+		{"among others", "application/json, application/problem+json;q=0.9", false, true},
+		{"refused", "application/problem+json;q=0", false, false},
+		{"forced", "application/json", true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/api/v1/orders", nil)
+			if tt.accept != "" {
+				r.Header.Set("Accept", tt.accept)
+			}
+			if tt.forced {
+				r = r.WithContext(Force(r.Context()))
+			}
+
+			assert.Equal(t, tt.want, Wanted(r))
+		})
+	}
+}
+
+func TestTypeURI(t *testing.T) {
+	assert.Equal(t, "urn:ordersvc:problem:order-not-found", TypeURI("ORDER_NOT_FOUND"))
+	assert.Equal(t, "about:blank", TypeURI(""))
+}
+
+func TestWrite_Legacy_KeepsErrorCodeShape(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/orders/1", nil)
+	w := httptest.NewRecorder()
+
+	Write(w, r, http.StatusNotFound, "order not found", "ORDER_NOT_FOUND", nil)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"error":"order not found","code":"ORDER_NOT_FOUND"}`, w.Body.String())
+}
+
+func TestWrite_Problem(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/orders", nil)
+	r.Header.Set("Accept", ContentType)
+	w := httptest.NewRecorder()
+	fields := []map[string]string{{"field": "customer_id", "code": "REQUIRED"}}
+
+	Write(w, r, http.StatusBadRequest, "request validation failed", "VALIDATION_FAILED", fields)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, ContentType, w.Header().Get("Content-Type"))
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+	assert.Equal(t, "urn:ordersvc:problem:validation-failed", got["type"])
+	assert.Equal(t, "Bad Request", got["title"])
+	assert.EqualValues(t, http.StatusBadRequest, got["status"])
+	assert.Equal(t, "request validation failed", got["detail"])
+	assert.Equal(t, "/api/v1/orders", got["instance"])
+	assert.Equal(t, "VALIDATION_FAILED", got["code"])
+	assert.Len(t, got["errors"], 1)
+}
+
+func TestWriteInternal_Legacy_IncludesErrorID(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/orders/1", nil)
+	w := httptest.NewRecorder()
+
+	WriteInternal(w, r, "7d3c0f5e-0f0a-4c1e-9d55-3a1b2c4d5e6f")
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.JSONEq(t, `{"error":"internal server error","code":"INTERNAL_ERROR","error_id":"7d3c0f5e-0f0a-4c1e-9d55-3a1b2c4d5e6f"}`, w.Body.String())
+}
+
+func TestWriteInternal_Problem_IncludesErrorID(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/orders/1", nil)
+	r.Header.Set("Accept", ContentType)
+	w := httptest.NewRecorder()
+
+	WriteInternal(w, r, "7d3c0f5e-0f0a-4c1e-9d55-3a1b2c4d5e6f")
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+	assert.Equal(t, "urn:ordersvc:problem:internal-error", got["type"])
+	assert.Equal(t, "7d3c0f5e-0f0a-4c1e-9d55-3a1b2c4d5e6f", got["error_id"])
+}
+
+func TestNewErrorID_Unique(t *testing.T) {
+	assert.NotEqual(t, NewErrorID(), NewErrorID())
+}
@@ -0,0 +1,218 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ratelimit applies the request limits of ADR-0005: a policy for each
+// client IP, and policies for the callers of a tenant or customer. Counters
+// live in a cache.RateLimiter, so replicas share them.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/cache"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// Wildcard in a Rule matches every tenant or customer, each counted on its own
+const Wildcard = "*"
+
+// Policy is a request allowance
+type Policy struct {
+	// RequestsPerMinute is the sustained limit for reads, and for writes too
+	// when WriteRequestsPerMinute is zero; zero disables the policy
+	RequestsPerMinute int
+	// WriteRequestsPerMinute gives writes a bucket of their own; zero counts
+	// them with the reads
+	WriteRequestsPerMinute int
+	// Burst is the most requests of a bucket allowed within one second
+	Burst int
+}
+
+// Rule applies a Policy to the callers of one tenant or one customer.
+// Exactly one of Tenant and CustomerID is set.
+type Rule struct {
+	Tenant     string
+	CustomerID string
+	Policy
+}
+
+// Policies are the limits in force
+type Policies struct {
+	// Client limits each client IP
+	Client Policy
+	// Rules limit identified callers on top of the client limit
+	Rules []Rule
+}
+
+// Bucket is one counted window of a subject
+type Bucket struct {
+	// Name is read, read_burst, write or write_burst
+	Name   string
+	Key    string
+	Limit  int
+	Period time.Duration
+}
+
+// Buckets returns the buckets a request of subject counts against, the
+// one-second burst window first. Reads keep the keys used before writes
+// were counted on their own.
+func (p Policy) Buckets(subject string, write bool) []Bucket {
+	if write && p.WriteRequestsPerMinute > 0 {
+		return []Bucket{
+			{Name: "write_burst", Key: subject + ":write:burst", Limit: p.Burst, Period: time.Second},
+			{Name: "write", Key: subject + ":write", Limit: p.WriteRequestsPerMinute, Period: time.Minute},
+		}
+	}
+	return []Bucket{
+		{Name: "read_burst", Key: subject + ":burst", Limit: p.Burst, Period: time.Second},
+		{Name: "read", Key: subject, Limit: p.RequestsPerMinute, Period: time.Minute},
+	}
+}
+
+// allBuckets returns every bucket of subject
+func (p Policy) allBuckets(subject string) []Bucket {
+	buckets := p.Buckets(subject, false)
+	if p.WriteRequestsPerMinute > 0 {
+		buckets = append(buckets, p.Buckets(subject, true)...)
+	}
+	return buckets
+}
+
+// Match returns the rule for a caller and the subject its buckets are keyed
+// by. A customer rule comes before a tenant rule, and an exact match before
+// the wildcard, so a tenant's callers share one bucket unless their customer
+// has a rule of its own.
+func Match(rules []Rule, tenant, customerID string) (Rule, string, bool) {
+	candidates := []struct {
+		match   func(Rule) bool
+		subject string
+	}{
+		{func(r Rule) bool { return r.CustomerID == customerID }, "customer:" + customerID},
+		{func(r Rule) bool { return r.CustomerID == Wildcard }, "customer:" + customerID},
+		{func(r Rule) bool { return r.Tenant == tenant }, "tenant:" + tenant},
+		{func(r Rule) bool { return r.Tenant == Wildcard }, "tenant:" + tenant},
+	}
+	for i, c := range candidates {
+		// Customer rules need a customer and tenant rules a tenant
+		if (i < 2 && customerID == "") || (i >= 2 && tenant == "") {
+			continue
+		}
+		for _, r := range rules {
+			if c.match(r) {
+				return r, c.subject, true
+			}
+		}
+	}
+	return Rule{}, "", false
+}
+
+// Decision is the outcome of counting a request
+type Decision struct {
+	Allowed bool
+	// RetryAfter is the window of the bucket that refused the request
+	RetryAfter time.Duration
+}
+
+// Usage is how full the buckets of a subject are
+type Usage struct {
+	Subject string
+	Policy  Policy
+	Buckets []BucketUsage
+}
+
+// BucketUsage is a bucket and the requests it holds
+type BucketUsage struct {
+	Bucket
+	Used int
+}
+
+// Limiter counts requests against the current Policies
+type Limiter struct {
+	store    cache.RateLimiter
+	policies func() Policies
+}
+
+// NewLimiter creates a Limiter over store. policies is read per request so
+// limits can change while serving.
+func NewLimiter(store cache.RateLimiter, policies func() Policies) *Limiter {
+	return &Limiter{
+		store:    store,
+		policies: policies,
+	}
+}
+
+// AllowClient counts a request from a client IP against the client policy
+func (l *Limiter) AllowClient(ctx context.Context, ip string, write bool) (Decision, error) {
+	p := l.policies().Client
+	if p.RequestsPerMinute <= 0 {
+		return Decision{Allowed: true}, nil
+	}
+	return l.allow(ctx, p.Buckets("ip:"+ip, write))
+}
+
+// AllowCaller counts a request from an identified caller against the rule
+// that matches it. Callers no rule matches are allowed.
+func (l *Limiter) AllowCaller(ctx context.Context, tenant, customerID string, write bool) (Decision, error) {
+	rule, subject, ok := Match(l.policies().Rules, tenant, customerID)
+	if !ok {
+		return Decision{Allowed: true}, nil
+	}
+	return l.allow(ctx, rule.Buckets(subject, write))
+}
+
+func (l *Limiter) allow(ctx context.Context, buckets []Bucket) (Decision, error) {
+	for _, b := range buckets {
+		allowed, err := l.store.Allow(ctx, b.Key, b.Limit, b.Period)
+		if err != nil {
+			return Decision{}, err
+		}
+		if !allowed {
+			return Decision{RetryAfter: b.Period}, nil
+		}
+	}
+	return Decision{Allowed: true}, nil
+}
+
+// ClientUsage reports the buckets of a client IP
+func (l *Limiter) ClientUsage(ctx context.Context, ip string) (*Usage, error) {
+	p := l.policies().Client
+	if p.RequestsPerMinute <= 0 {
+		return nil, domain.ErrQuotaNotFound
+	}
+	return l.usage(ctx, "ip:"+ip, p)
+}
+
+// CallerUsage reports the buckets of the rule matching a caller, or
+// domain.ErrQuotaNotFound when none does
+func (l *Limiter) CallerUsage(ctx context.Context, tenant, customerID string) (*Usage, error) {
+	rule, subject, ok := Match(l.policies().Rules, tenant, customerID)
+	if !ok {
+		return nil, domain.ErrQuotaNotFound
+	}
+	return l.usage(ctx, subject, rule.Policy)
+}
+
+func (l *Limiter) usage(ctx context.Context, subject string, p Policy) (*Usage, error) {
+	usage := &Usage{Subject: subject, Policy: p}
+	for _, b := range p.allBuckets(subject) {
+		n, err := l.store.Count(ctx, b.Key, b.Period)
+		if err != nil {
+			return nil, fmt.Errorf("usage of %s: %w", subject, err)
+		}
+		usage.Buckets = append(usage.Buckets, BucketUsage{Bucket: b, Used: n})
+	}
+	return usage, nil
+}
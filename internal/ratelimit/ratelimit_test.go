@@ -0,0 +1,185 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// fakeStore counts requests per key without expiring them
+type fakeStore struct {
+	counts map[string]int
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{counts: make(map[string]int)}
+}
+
+func (f *fakeStore) Allow(_ context.Context, key string, limit int, _ time.Duration) (bool, error) {
+	if f.counts[key] >= limit {
+		return false, nil
+	}
+	f.counts[key]++
+	return true, nil
+}
+
+func (f *fakeStore) Reset(_ context.Context, key string) error {
+	delete(f.counts, key)
+	return nil
+}
+
+func (f *fakeStore) Count(_ context.Context, key string, _ time.Duration) (int, error) {
+	return f.counts[key], nil
+}
+
+func staticPolicies(p Policies) func() Policies {
+	return func() Policies { return p }
+}
+
+func TestMatch_PrefersCustomerAndExactRules(t *testing.T) {
+	rules := []Rule{
+		{Tenant: Wildcard, Policy: Policy{RequestsPerMinute: 1}},
+		{Tenant: "acme", Policy: Policy{RequestsPerMinute: 2}},
+		{CustomerID: Wildcard, Policy: Policy{RequestsPerMinute: 3}},
+		{CustomerID: "cust-1", Policy: Policy{RequestsPerMinute: 4}},
+	}
+
+	tests := []struct {
+		name        string
+		rules       []Rule
+		tenant      string
+		customerID  string
+		wantRPM     int
+		wantSubject string
+		wantOK      bool
+	}{
+		{name: "exact customer", rules: rules, tenant: "acme", customerID: "cust-1", wantRPM: 4, wantSubject: "customer:cust-1", wantOK: true},
+		{name: "any customer", rules: rules, tenant: "acme", customerID: "cust-2", wantRPM: 3, wantSubject: "customer:cust-2", wantOK: true},
+		{name: "exact tenant", rules: rules[:2], tenant: "acme", customerID: "cust-1", wantRPM: 2, wantSubject: "tenant:acme", wantOK: true},
+		{name: "any tenant", rules: rules[:2], tenant: "globex", wantRPM: 1, wantSubject: "tenant:globex", wantOK: true},
+		{name: "wildcard needs a tenant", rules: rules[:2], customerID: "cust-1"},
+		{name: "no caller", rules: rules},
+		{name: "no rules", tenant: "acme", customerID: "cust-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, subject, ok := Match(tt.rules, tt.tenant, tt.customerID)
+
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantRPM, rule.RequestsPerMinute)
+			assert.Equal(t, tt.wantSubject, subject)
+		})
+	}
+}
+
+func TestLimiter_AllowClient_SeparatesWrites(t *testing.T) {
+	store := newFakeStore()
+	limiter := NewLimiter(store, staticPolicies(Policies{
+		Client: Policy{RequestsPerMinute: 2, WriteRequestsPerMinute: 1, Burst: 10},
+	}))
+	ctx := context.Background()
+
+	d, err := limiter.AllowClient(ctx, "10.0.0.1", true)
+	require.NoError(t, err)
+	assert.True(t, d.Allowed)
+
+	d, err = limiter.AllowClient(ctx, "10.0.0.1", true)
+	require.NoError(t, err)
+	assert.False(t, d.Allowed)
+	assert.Equal(t, time.Minute, d.RetryAfter)
+
+	for i := range 2 {
+		d, err = limiter.AllowClient(ctx, "10.0.0.1", false)
+		require.NoError(t, err)
+		assert.True(t, d.Allowed, "read %d has its own bucket", i+1)
+	}
+	assert.Equal(t, 2, store.counts["ip:10.0.0.1"])
+	assert.Equal(t, 1, store.counts["ip:10.0.0.1:write"])
+}
+
+func TestLimiter_AllowClient_WritesShareReadBucketByDefault(t *testing.T) {
+	store := newFakeStore()
+	limiter := NewLimiter(store, staticPolicies(Policies{Client: Policy{RequestsPerMinute: 1, Burst: 10}}))
+	ctx := context.Background()
+
+	d, err := limiter.AllowClient(ctx, "10.0.0.1", false)
+	require.NoError(t, err)
+	require.True(t, d.Allowed)
+
+	d, err = limiter.AllowClient(ctx, "10.0.0.1", true)
+	require.NoError(t, err)
+	assert.False(t, d.Allowed)
+}
+
+func TestLimiter_AllowCaller_BurstRefusesFirst(t *testing.T) {
+	store := newFakeStore()
+	limiter := NewLimiter(store, staticPolicies(Policies{
+		Rules: []Rule{{Tenant: "acme", Policy: Policy{RequestsPerMinute: 100, Burst: 1}}},
+	}))
+	ctx := context.Background()
+
+	d, err := limiter.AllowCaller(ctx, "acme", "", false)
+	require.NoError(t, err)
+	require.True(t, d.Allowed)
+
+	d, err = limiter.AllowCaller(ctx, "acme", "", false)
+	require.NoError(t, err)
+	assert.False(t, d.Allowed)
+	assert.Equal(t, time.Second, d.RetryAfter)
+
+	d, err = limiter.AllowCaller(ctx, "globex", "", false)
+	require.NoError(t, err)
+	assert.True(t, d.Allowed, "callers without a rule are not limited")
+	assert.NotContains(t, store.counts, "tenant:globex")
+}
+
+func TestLimiter_CallerUsage_ReportsBuckets(t *testing.T) {
+	store := newFakeStore()
+	limiter := NewLimiter(store, staticPolicies(Policies{
+		Rules: []Rule{{CustomerID: Wildcard, Policy: Policy{RequestsPerMinute: 60, WriteRequestsPerMinute: 10, Burst: 5}}},
+	}))
+	ctx := context.Background()
+	_, err := limiter.AllowCaller(ctx, "", "cust-1", true)
+	require.NoError(t, err)
+
+	usage, err := limiter.CallerUsage(ctx, "", "cust-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "customer:cust-1", usage.Subject)
+	assert.Equal(t, 60, usage.Policy.RequestsPerMinute)
+	used := make(map[string]int)
+	for _, b := range usage.Buckets {
+		used[b.Name] = b.Used
+	}
+	assert.Equal(t, map[string]int{"read_burst": 0, "read": 0, "write_burst": 1, "write": 1}, used)
+}
+
+func TestLimiter_Usage_NoPolicy_ReturnsErrQuotaNotFound(t *testing.T) {
+	limiter := NewLimiter(newFakeStore(), staticPolicies(Policies{}))
+
+	_, err := limiter.CallerUsage(context.Background(), "acme", "cust-1")
+	assert.ErrorIs(t, err, domain.ErrQuotaNotFound)
+
+	_, err = limiter.ClientUsage(context.Background(), "10.0.0.1")
+	assert.ErrorIs(t, err, domain.ErrQuotaNotFound)
+}
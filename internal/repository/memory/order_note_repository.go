@@ -0,0 +1,84 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// orderNoteRepositoryMemory implements OrderNoteRepository with the notes of
+// each order in the order they were added
+type orderNoteRepositoryMemory struct {
+	mu    sync.RWMutex
+	notes map[uuid.UUID][]domain.OrderNote
+}
+
+// NewOrderNoteRepository creates an empty in-memory order note repository
+func NewOrderNoteRepository() repository.OrderNoteRepository {
+	return &orderNoteRepositoryMemory{
+		notes: make(map[uuid.UUID][]domain.OrderNote),
+	}
+}
+
+func (r *orderNoteRepositoryMemory) Create(ctx context.Context, note *domain.OrderNote) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.notes[note.OrderID] = append(r.notes[note.OrderID], *note)
+	return nil
+}
+
+func (r *orderNoteRepositoryMemory) ListByOrderID(ctx context.Context, orderID string, includeInternal bool, limit, offset int) ([]*domain.OrderNote, int64, error) {
+	id, err := uuid.Parse(orderID)
+	if err != nil {
+		return []*domain.OrderNote{}, 0, nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	notes := r.visible(id, includeInternal)
+	return page(notes, limit, offset), int64(len(notes)), nil
+}
+
+func (r *orderNoteRepositoryMemory) ListByOrderIDs(ctx context.Context, orderIDs []uuid.UUID, includeInternal bool) (map[uuid.UUID][]*domain.OrderNote, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	byOrder := make(map[uuid.UUID][]*domain.OrderNote)
+	for _, id := range orderIDs {
+		if notes := r.visible(id, includeInternal); len(notes) > 0 {
+			byOrder[id] = notes
+		}
+	}
+	return byOrder, nil
+}
+
+// visible returns copies of the notes of the order, oldest first, leaving out
+// internal notes unless includeInternal is set; the caller holds the lock
+func (r *orderNoteRepositoryMemory) visible(orderID uuid.UUID, includeInternal bool) []*domain.OrderNote {
+	notes := []*domain.OrderNote{}
+	for _, note := range r.notes[orderID] {
+		if includeInternal || note.Visibility == domain.NoteVisibilityCustomer {
+			notes = append(notes, &note)
+		}
+	}
+	return notes
+}
@@ -0,0 +1,428 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package memory implements the order and note repositories in memory, for
+// running the service in tests without PostgreSQL. It keeps no order
+// history and has no transactions (see repository.NoTx).
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// orderRepositoryMemory implements OrderRepository with a map of orders.
+// Orders are copied in and out (copyOrder), so callers never share memory
+// with it.
+type orderRepositoryMemory struct {
+	mu     sync.RWMutex
+	orders map[uuid.UUID]*domain.Order
+}
+
+// NewOrderRepository creates an empty in-memory order repository
+func NewOrderRepository() repository.OrderRepository {
+	return &orderRepositoryMemory{
+		orders: make(map[uuid.UUID]*domain.Order),
+	}
+}
+
+func (r *orderRepositoryMemory) Create(ctx context.Context, order *domain.Order) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	order.Version = 1
+	return r.insert(order)
+}
+
+func (r *orderRepositoryMemory) CreateBatch(ctx context.Context, orders []*domain.Order) ([]error, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	errs := make([]error, len(orders))
+	for i, order := range orders {
+		order.Version = 1
+		errs[i] = r.insert(order)
+	}
+	return errs, nil
+}
+
+// insert stores a copy of a new order; the caller holds the write lock
+func (r *orderRepositoryMemory) insert(order *domain.Order) error {
+	if _, ok := r.orders[order.ID]; ok {
+		return fmt.Errorf("order %s already exists", order.ID)
+	}
+	r.orders[order.ID] = copyOrder(order)
+	return nil
+}
+
+func (r *orderRepositoryMemory) FindByID(ctx context.Context, id string) (*domain.Order, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	order := r.find(ctx, id)
+	if order == nil || order.DeletedAt != nil {
+		return nil, nil
+	}
+	return copyOrder(order), nil
+}
+
+func (r *orderRepositoryMemory) FindByIDIncludingDeleted(ctx context.Context, id string) (*domain.Order, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	order := r.find(ctx, id)
+	if order == nil {
+		return nil, nil
+	}
+	return copyOrder(order), nil
+}
+
+func (r *orderRepositoryMemory) Update(ctx context.Context, order *domain.Order) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	old := r.find(ctx, order.ID.String())
+	if old == nil {
+		return domain.ErrOrderNotFound
+	}
+	if old.Version != order.Version || old.DeletedAt != nil {
+		return domain.ErrConcurrentModification
+	}
+
+	updated := copyOrder(order)
+	updated.Version++
+	updated.UpdatedAt = time.Now()
+	// Creation time, tenant and deletion are not written by an update
+	updated.CreatedAt = old.CreatedAt
+	updated.TenantID = old.TenantID
+	updated.DeletedAt = nil
+	r.orders[order.ID] = updated
+
+	order.Version++
+	return nil
+}
+
+func (r *orderRepositoryMemory) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	order := r.find(ctx, id)
+	if order == nil || order.DeletedAt != nil {
+		return domain.ErrOrderNotFound
+	}
+	now := time.Now()
+	order.DeletedAt = &now
+	order.Version++
+	return nil
+}
+
+func (r *orderRepositoryMemory) List(ctx context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+	return r.list(ctx, opts, func(*domain.Order) bool { return true })
+}
+
+func (r *orderRepositoryMemory) FindByCustomerID(ctx context.Context, customerID string, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+	return r.list(ctx, opts, func(o *domain.Order) bool { return o.CustomerID == customerID })
+}
+
+// list returns a page of the tenant's orders matching match and the status,
+// product and tag filters of opts, newest first, with the total match count
+func (r *orderRepositoryMemory) list(ctx context.Context, opts repository.ListOptions, match func(*domain.Order) bool) ([]*domain.Order, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	matches := r.filter(ctx, func(o *domain.Order) bool {
+		switch {
+		case o.DeletedAt != nil && !opts.IncludeDeleted:
+			return false
+		case opts.Status != nil && o.Status != *opts.Status:
+			return false
+		case opts.ProductID != nil && !hasProduct(o, *opts.ProductID):
+			return false
+		}
+		for _, tag := range opts.Tags {
+			if !slices.Contains(o.Tags, tag) {
+				return false
+			}
+		}
+		return match(o)
+	})
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].CreatedAt.After(matches[j].CreatedAt) })
+
+	total := int64(len(matches))
+	if opts.SkipTotal {
+		total = 0
+	}
+	return page(matches, opts.Limit, opts.Offset), total, nil
+}
+
+// EstimateTotal counts the orders exactly; there is no cheaper way to know
+func (r *orderRepositoryMemory) EstimateTotal(ctx context.Context) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return int64(len(r.orders)), nil
+}
+
+// Search matches the query, case-insensitively, as a prefix of the order ID
+// or part of the customer ID or an item name. Matches are returned newest
+// first rather than ranked.
+func (r *orderRepositoryMemory) Search(ctx context.Context, query string, opts repository.SearchOptions) ([]*domain.Order, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	q := strings.ToLower(query)
+	matches := r.filter(ctx, func(o *domain.Order) bool {
+		switch {
+		case o.DeletedAt != nil:
+			return false
+		case opts.Status != nil && o.Status != *opts.Status:
+			return false
+		case opts.CustomerID != nil && o.CustomerID != *opts.CustomerID:
+			return false
+		case opts.ProductID != nil && !hasProduct(o, *opts.ProductID):
+			return false
+		case opts.MinTotal != nil && o.Total < *opts.MinTotal:
+			return false
+		case opts.MaxTotal != nil && o.Total > *opts.MaxTotal:
+			return false
+		}
+		if strings.HasPrefix(o.ID.String(), q) || strings.Contains(strings.ToLower(o.CustomerID), q) {
+			return true
+		}
+		return slices.ContainsFunc(o.Items, func(item domain.OrderItem) bool {
+			return strings.Contains(strings.ToLower(item.Name), q)
+		})
+	})
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].CreatedAt.After(matches[j].CreatedAt) })
+
+	return page(matches, opts.Limit, opts.Offset), int64(len(matches)), nil
+}
+
+func (r *orderRepositoryMemory) ListDeleted(ctx context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	matches := r.filter(ctx, func(o *domain.Order) bool { return o.DeletedAt != nil })
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].DeletedAt.After(*matches[j].DeletedAt) })
+
+	return page(matches, opts.Limit, opts.Offset), int64(len(matches)), nil
+}
+
+func (r *orderRepositoryMemory) Restore(ctx context.Context, id string, expectedVersion *int) (*domain.Order, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	order := r.find(ctx, id)
+	if order == nil || order.DeletedAt == nil {
+		return nil, nil
+	}
+	if expectedVersion != nil && *expectedVersion != order.Version {
+		return nil, domain.ErrVersionMismatch
+	}
+	order.DeletedAt = nil
+	order.Version++
+	order.UpdatedAt = time.Now()
+	return copyOrder(order), nil
+}
+
+func (r *orderRepositoryMemory) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	return r.purge(func(o *domain.Order) bool {
+		return o.DeletedAt != nil && o.DeletedAt.Before(deletedBefore)
+	}), nil
+}
+
+func (r *orderRepositoryMemory) PurgeCompleted(ctx context.Context, statuses []domain.OrderStatus, updatedBefore time.Time) (int64, error) {
+	return r.purge(func(o *domain.Order) bool {
+		return o.DeletedAt == nil && slices.Contains(statuses, o.Status) && o.UpdatedAt.Before(updatedBefore)
+	}), nil
+}
+
+// purge removes the orders of every tenant matching match and counts them
+func (r *orderRepositoryMemory) purge(match func(*domain.Order) bool) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var purged int64
+	for id, order := range r.orders {
+		if match(order) {
+			delete(r.orders, id)
+			purged++
+		}
+	}
+	return purged
+}
+
+func (r *orderRepositoryMemory) ListDueHolds(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	return r.listIDs(limit, func(o *domain.Order) bool {
+		return o.Status == domain.OrderStatusOnHold && o.Hold != nil && o.Hold.ReleaseAt != nil && !o.Hold.ReleaseAt.After(now)
+	}, func(a, b *domain.Order) bool {
+		return a.Hold.ReleaseAt.Before(*b.Hold.ReleaseAt)
+	}), nil
+}
+
+func (r *orderRepositoryMemory) ListOverdueDeliveries(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	return r.listIDs(limit, func(o *domain.Order) bool {
+		return o.EstimatedDeliveryAt != nil && o.EstimatedDeliveryAt.Before(now) && o.SLABreachedAt == nil &&
+			o.Status != domain.OrderStatusDelivered && o.Status != domain.OrderStatusCancelled
+	}, func(a, b *domain.Order) bool {
+		return a.EstimatedDeliveryAt.Before(*b.EstimatedDeliveryAt)
+	}), nil
+}
+
+func (r *orderRepositoryMemory) ListStalePending(ctx context.Context, createdBefore time.Time, limit int) ([]string, error) {
+	return r.listIDs(limit, func(o *domain.Order) bool {
+		return o.Status == domain.OrderStatusPending && o.CreatedAt.Before(createdBefore)
+	}, func(a, b *domain.Order) bool {
+		return a.CreatedAt.Before(b.CreatedAt)
+	}), nil
+}
+
+// listIDs returns the IDs of up to limit live orders of every tenant
+// matching match, sorted by less
+func (r *orderRepositoryMemory) listIDs(limit int, match func(*domain.Order) bool, less func(a, b *domain.Order) bool) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matches []*domain.Order
+	for _, order := range r.orders {
+		if order.DeletedAt == nil && match(order) {
+			matches = append(matches, order)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return less(matches[i], matches[j]) })
+
+	var ids []string
+	for _, order := range page(matches, limit, 0) {
+		ids = append(ids, order.ID.String())
+	}
+	return ids
+}
+
+func (r *orderRepositoryMemory) ListOrderAmounts(ctx context.Context, afterID string, limit int) ([]domain.OrderAmounts, error) {
+	after := uuid.Nil
+	if afterID != "" {
+		var err error
+		if after, err = uuid.Parse(afterID); err != nil {
+			return nil, err
+		}
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	matches := r.filter(ctx, func(o *domain.Order) bool {
+		return o.DeletedAt == nil && strings.Compare(o.ID.String(), after.String()) > 0
+	})
+	sort.Slice(matches, func(i, j int) bool { return matches[i].ID.String() < matches[j].ID.String() })
+
+	var amounts []domain.OrderAmounts
+	for _, order := range page(matches, limit, 0) {
+		// Summed in cents, as the database sums exact numerics; 0.10 × 3 in
+		// floats is not 0.30
+		a := domain.OrderAmounts{OrderID: order.ID.String(), TenantID: order.TenantID, Total: order.Total}
+		var itemTotal domain.Money
+		for _, item := range order.Items {
+			line := domain.MoneyFromFloat(item.Price) * domain.Money(item.Quantity)
+			itemTotal += line
+			if domain.MoneyFromFloat(item.Subtotal) != line {
+				a.BadSubtotals++
+			}
+		}
+		a.ItemTotal = itemTotal.Float64()
+		amounts = append(amounts, a)
+	}
+	return amounts, nil
+}
+
+// find returns the stored order with this ID if it belongs to the tenant in
+// ctx, deleted or not; the caller holds the lock
+func (r *orderRepositoryMemory) find(ctx context.Context, id string) *domain.Order {
+	orderID, err := uuid.Parse(id)
+	if err != nil {
+		return nil
+	}
+	order, ok := r.orders[orderID]
+	if !ok || !inTenant(ctx, order) {
+		return nil
+	}
+	return order
+}
+
+// filter returns copies of the stored orders of the tenant in ctx that match
+// match; the caller holds the lock
+func (r *orderRepositoryMemory) filter(ctx context.Context, match func(*domain.Order) bool) []*domain.Order {
+	var matches []*domain.Order
+	for _, order := range r.orders {
+		if inTenant(ctx, order) && match(order) {
+			matches = append(matches, copyOrder(order))
+		}
+	}
+	return matches
+}
+
+// inTenant reports whether order belongs to the tenant in ctx. Without a
+// tenant in ctx every order matches, as in the PostgreSQL repository.
+func inTenant(ctx context.Context, order *domain.Order) bool {
+	tenantID, ok := domain.TenantFromContext(ctx)
+	return !ok || order.TenantID == tenantID
+}
+
+// copyOrder returns a copy of order to store or return, with items, metadata
+// and tags empty rather than nil as when read back from PostgreSQL, and
+// unset item statuses stored as pending
+func copyOrder(order *domain.Order) *domain.Order {
+	c := order.Clone()
+	if c.Items == nil {
+		c.Items = []domain.OrderItem{}
+	}
+	for i := range c.Items {
+		if c.Items[i].Status == "" {
+			c.Items[i].Status = domain.ItemStatusPending
+		}
+	}
+	if c.Metadata == nil {
+		c.Metadata = map[string]string{}
+	}
+	if c.Tags == nil {
+		c.Tags = []string{}
+	}
+	return c
+}
+
+// hasProduct reports whether order has an item for productID
+func hasProduct(order *domain.Order, productID string) bool {
+	return slices.ContainsFunc(order.Items, func(item domain.OrderItem) bool { return item.ProductID == productID })
+}
+
+// page returns the limit orders after the first offset; a limit of 0 or
+// less returns them all
+func page[T any](all []T, limit, offset int) []T {
+	if offset >= len(all) {
+		return []T{}
+	}
+	all = all[offset:]
+	if limit > 0 && limit < len(all) {
+		all = all[:limit]
+	}
+	return all
+}
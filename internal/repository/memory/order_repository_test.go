@@ -0,0 +1,167 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+func newOrder(customerID string, createdAt time.Time) *domain.Order {
+	return &domain.Order{
+		ID:         uuid.New(),
+		CustomerID: customerID,
+		Status:     domain.OrderStatusPending,
+		Items:      []domain.OrderItem{{ID: uuid.New(), ProductID: "prod-1", Name: "Widget", Quantity: 1, Price: 5, Subtotal: 5}},
+		Total:      5,
+		CreatedAt:  createdAt,
+		UpdatedAt:  createdAt,
+	}
+}
+
+func TestOrderRepository_UpdateUsesOptimisticLocking(t *testing.T) {
+	repo := NewOrderRepository()
+	ctx := context.Background()
+	order := newOrder("cust-1", time.Now())
+	require.NoError(t, repo.Create(ctx, order))
+
+	stale, err := repo.FindByID(ctx, order.ID.String())
+	require.NoError(t, err)
+	order.Status = domain.OrderStatusConfirmed
+	require.NoError(t, repo.Update(ctx, order))
+	assert.Equal(t, 2, order.Version)
+
+	stale.Status = domain.OrderStatusCancelled
+	assert.ErrorIs(t, repo.Update(ctx, stale), domain.ErrConcurrentModification)
+
+	found, err := repo.FindByID(ctx, order.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, domain.OrderStatusConfirmed, found.Status)
+	assert.Equal(t, 2, found.Version)
+}
+
+func TestOrderRepository_CallersShareNoMemory(t *testing.T) {
+	repo := NewOrderRepository()
+	ctx := context.Background()
+	order := newOrder("cust-1", time.Now())
+	require.NoError(t, repo.Create(ctx, order))
+
+	order.Items[0].Name = "changed"
+	found, err := repo.FindByID(ctx, order.ID.String())
+	require.NoError(t, err)
+	found.Items[0].Quantity = 9
+
+	again, err := repo.FindByID(ctx, order.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, "Widget", again.Items[0].Name)
+	assert.Equal(t, 1, again.Items[0].Quantity)
+	assert.Equal(t, domain.ItemStatusPending, again.Items[0].Status)
+}
+
+func TestOrderRepository_DeleteAndRestore(t *testing.T) {
+	repo := NewOrderRepository()
+	ctx := context.Background()
+	order := newOrder("cust-1", time.Now())
+	require.NoError(t, repo.Create(ctx, order))
+
+	require.NoError(t, repo.Delete(ctx, order.ID.String()))
+	assert.ErrorIs(t, repo.Delete(ctx, order.ID.String()), domain.ErrOrderNotFound)
+	found, err := repo.FindByID(ctx, order.ID.String())
+	require.NoError(t, err)
+	assert.Nil(t, found)
+
+	wrongVersion := 1
+	_, err = repo.Restore(ctx, order.ID.String(), &wrongVersion)
+	assert.ErrorIs(t, err, domain.ErrVersionMismatch)
+
+	restored, err := repo.Restore(ctx, order.ID.String(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, restored.Version)
+	assert.Nil(t, restored.DeletedAt)
+}
+
+func TestOrderRepository_ListFiltersAndPages(t *testing.T) {
+	repo := NewOrderRepository()
+	ctx := context.Background()
+	now := time.Now()
+	oldest, middle, newest := newOrder("cust-1", now.Add(-2*time.Hour)), newOrder("cust-1", now.Add(-time.Hour)), newOrder("cust-2", now)
+	middle.Tags = []string{"gift"}
+	for _, o := range []*domain.Order{oldest, middle, newest} {
+		require.NoError(t, repo.Create(ctx, o))
+	}
+
+	orders, total, err := repo.List(ctx, repository.ListOptions{Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, orders, 2)
+	assert.Equal(t, newest.ID, orders[0].ID)
+	assert.Equal(t, middle.ID, orders[1].ID)
+
+	orders, total, err = repo.FindByCustomerID(ctx, "cust-1", repository.ListOptions{Limit: 10, Offset: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, orders, 1)
+	assert.Equal(t, oldest.ID, orders[0].ID)
+
+	orders, _, err = repo.List(ctx, repository.ListOptions{Limit: 10, Tags: []string{"gift"}})
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	assert.Equal(t, middle.ID, orders[0].ID)
+}
+
+func TestOrderRepository_ScopesToTenant(t *testing.T) {
+	repo := NewOrderRepository()
+	acme := domain.WithTenant(context.Background(), "acme")
+	order := newOrder("cust-1", time.Now())
+	order.TenantID = "acme"
+	require.NoError(t, repo.Create(acme, order))
+
+	found, err := repo.FindByID(domain.WithTenant(context.Background(), "globex"), order.ID.String())
+	require.NoError(t, err)
+	assert.Nil(t, found)
+
+	_, total, err := repo.List(domain.WithTenant(context.Background(), "globex"), repository.ListOptions{Limit: 10})
+	require.NoError(t, err)
+	assert.Zero(t, total)
+
+	found, err = repo.FindByID(acme, order.ID.String())
+	require.NoError(t, err)
+	assert.NotNil(t, found)
+}
+
+func TestOrderRepository_ListOrderAmounts_SumsInCents(t *testing.T) {
+	repo := NewOrderRepository()
+	ctx := context.Background()
+	order := newOrder("cust-1", time.Now())
+	order.Items = []domain.OrderItem{{ID: uuid.New(), ProductID: "prod-1", Name: "Sticker", Quantity: 3, Price: 0.10, Subtotal: 0.30}}
+	order.Total = 0.30
+	require.NoError(t, repo.Create(ctx, order))
+
+	amounts, err := repo.ListOrderAmounts(ctx, "", 10)
+
+	require.NoError(t, err)
+	require.Len(t, amounts, 1)
+	assert.Zero(t, amounts[0].BadSubtotals, "0.10 × 3 is 0.30")
+	assert.Equal(t, 0.30, amounts[0].ItemTotal)
+	assert.True(t, amounts[0].Consistent())
+}
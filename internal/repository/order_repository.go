@@ -17,7 +17,9 @@ package repository
 
 import (
 	"context"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
 )
 
@@ -27,9 +29,19 @@ type OrderRepository interface {
 	// The order.Version is set to 1 on creation.
 	Create(ctx context.Context, order *domain.Order) error
 
+	// CreateBatch inserts several new orders, setting each order.Version to 1.
+	// It returns one error per order, nil for each order that was inserted;
+	// a failing order does not prevent the others from being inserted. The
+	// second result reports a failure of the batch as a whole.
+	CreateBatch(ctx context.Context, orders []*domain.Order) ([]error, error)
+
 	// FindByID retrieves an order by its ID
 	FindByID(ctx context.Context, id string) (*domain.Order, error)
 
+	// FindByIDIncludingDeleted is FindByID that also returns the order if it
+	// was soft-deleted, with DeletedAt set
+	FindByIDIncludingDeleted(ctx context.Context, id string) (*domain.Order, error)
+
 	// Update updates an existing order using optimistic locking.
 	// The update will only succeed if the order's version matches the database.
 	// On success, the order's version is incremented.
@@ -42,11 +54,55 @@ type OrderRepository interface {
 	// Returns domain.ErrConcurrentModification if version mismatch.
 	Delete(ctx context.Context, id string) error
 
-	// List returns paginated orders with optional status filter
+	// List returns paginated orders with optional status and product filters
 	List(ctx context.Context, opts ListOptions) ([]*domain.Order, int64, error)
 
 	// FindByCustomerID retrieves all orders for a customer
 	FindByCustomerID(ctx context.Context, customerID string, opts ListOptions) ([]*domain.Order, int64, error)
+
+	// EstimateTotal returns the approximate number of orders, including
+	// soft-deleted ones, from table statistics without scanning the table.
+	// It returns -1 when no estimate is available yet.
+	EstimateTotal(ctx context.Context) (int64, error)
+
+	// Search runs a free-text search against the orders table
+	OrderSearcher
+
+	// ListDeleted returns soft-deleted orders, most recently deleted first.
+	// Only Limit and Offset of opts are applied.
+	ListDeleted(ctx context.Context, opts ListOptions) ([]*domain.Order, int64, error)
+
+	// Restore clears deleted_at on a soft-deleted order and increments its version.
+	// If expectedVersion is set it must match the deleted order's version,
+	// otherwise domain.ErrVersionMismatch is returned.
+	// Returns nil if no soft-deleted order has this ID.
+	Restore(ctx context.Context, id string, expectedVersion *int) (*domain.Order, error)
+
+	// Purge hard-deletes orders soft-deleted before the cutoff, together with
+	// their items and history, and returns the number of orders removed.
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+
+	// PurgeCompleted hard-deletes live orders in one of statuses last updated
+	// before the cutoff, together with their items and history.
+	PurgeCompleted(ctx context.Context, statuses []domain.OrderStatus, updatedBefore time.Time) (int64, error)
+
+	// ListDueHolds returns the IDs of up to limit live orders on hold whose
+	// automatic release time is at or before now, earliest first
+	ListDueHolds(ctx context.Context, now time.Time, limit int) ([]string, error)
+
+	// ListOverdueDeliveries returns the IDs of up to limit live orders whose
+	// estimated delivery time is before now and that are neither delivered,
+	// cancelled nor already flagged as SLA breached, most overdue first
+	ListOverdueDeliveries(ctx context.Context, now time.Time, limit int) ([]string, error)
+
+	// ListStalePending returns the IDs of up to limit live pending orders
+	// created before createdBefore, oldest first
+	ListStalePending(ctx context.Context, createdBefore time.Time, limit int) ([]string, error)
+
+	// ListOrderAmounts returns the stored and item-derived amounts of up to
+	// limit live orders whose ID sorts after afterID (empty for the first
+	// page), in ID order, for the total check
+	ListOrderAmounts(ctx context.Context, afterID string, limit int) ([]domain.OrderAmounts, error)
 }
 
 // ListOptions represents query options for listing orders
@@ -54,4 +110,214 @@ type ListOptions struct {
 	Limit  int
 	Offset int
 	Status *domain.OrderStatus
+	// ProductID restricts results to orders containing an item for this product
+	ProductID *string
+	// Tags restricts results to orders carrying every one of these tags
+	Tags []string
+	// SkipTotal skips counting the matches; List and FindByCustomerID then
+	// return a total of 0
+	SkipTotal bool
+	// IncludeDeleted also returns soft-deleted orders
+	IncludeDeleted bool
+}
+
+// OrderSearcher runs free-text order searches. OrderRepository implements it
+// against PostgreSQL; an external search index can stand in for it.
+type OrderSearcher interface {
+	// Search returns live orders matching query by order ID prefix, customer ID,
+	// or item name, most relevant first, and the total number of matches
+	Search(ctx context.Context, query string, opts SearchOptions) ([]*domain.Order, int64, error)
+}
+
+// SearchOptions represents pagination and filters for an order search
+type SearchOptions struct {
+	Limit      int
+	Offset     int
+	Status     *domain.OrderStatus
+	CustomerID *string
+	ProductID  *string
+	// MinTotal and MaxTotal bound the order total, both inclusive
+	MinTotal *float64
+	MaxTotal *float64
+}
+
+// CustomerDataRepository manages personal data held across a customer's orders
+type CustomerDataRepository interface {
+	// EraseCustomer anonymizes every order of erasure.CustomerID, including
+	// soft-deleted ones: the customer ID is replaced, item names and metadata
+	// are scrubbed, addresses are cleared, notes are deleted, and prior history
+	// snapshots are dropped in favour of one erased entry. The customer's
+	// subscriptions are deleted.
+	// An audit record of the erasure is stored in the same transaction.
+	// Returns the IDs of the erased orders; none means the customer had no orders.
+	EraseCustomer(ctx context.Context, erasure *domain.CustomerErasure) ([]uuid.UUID, error)
+}
+
+// OrderHistoryRepository reads the audit trail of order mutations.
+// Entries are written by OrderRepository as part of each mutation.
+type OrderHistoryRepository interface {
+	// ListByOrderID returns an order's history entries, newest first, and the total count
+	ListByOrderID(ctx context.Context, orderID string, limit, offset int) ([]*domain.OrderHistoryEntry, int64, error)
+
+	// ListRange returns the entries selected by q, oldest first, for
+	// replaying the events they record
+	ListRange(ctx context.Context, q HistoryRangeQuery) ([]*domain.OrderHistoryEntry, error)
+}
+
+// HistoryRangeQuery selects history entries across orders. Pages continue
+// after the created_at and ID of the last entry of the previous page.
+type HistoryRangeQuery struct {
+	// OrderID limits the entries to one order; empty selects every order
+	OrderID string
+	// From and To bound created_at, inclusive and exclusive; zero is unbounded
+	From time.Time
+	To   time.Time
+	// After, if set, starts the page after the entry at AfterTime with AfterID
+	After     bool
+	AfterTime time.Time
+	AfterID   uuid.UUID
+	Limit     int
+}
+
+// OrderNoteRepository stores notes attached to orders
+type OrderNoteRepository interface {
+	// Create stores a new note
+	Create(ctx context.Context, note *domain.OrderNote) error
+
+	// ListByOrderID returns an order's notes, oldest first, and the total
+	// count. Internal notes are left out unless includeInternal is set.
+	ListByOrderID(ctx context.Context, orderID string, includeInternal bool, limit, offset int) ([]*domain.OrderNote, int64, error)
+
+	// ListByOrderIDs returns the notes of several orders keyed by order ID,
+	// each oldest first. Orders without notes have no entry.
+	ListByOrderIDs(ctx context.Context, orderIDs []uuid.UUID, includeInternal bool) (map[uuid.UUID][]*domain.OrderNote, error)
+}
+
+// BackupRepository reads and writes orders wholesale, across all tenants, to
+// back them up and restore them
+type BackupRepository interface {
+	// ExportOrders returns up to limit orders, live and soft-deleted, whose
+	// ID sorts after afterID (empty for the first page), in ID order, each
+	// with its history and notes
+	ExportOrders(ctx context.Context, afterID string, limit int) ([]*domain.OrderBackup, error)
+
+	// ImportOrders inserts orders as they were backed up, keeping their IDs,
+	// versions, timestamps, history and notes, in one transaction. Orders
+	// whose ID is already taken are left alone. It returns one flag per
+	// backup, set for those inserted.
+	ImportOrders(ctx context.Context, backups []*domain.OrderBackup) ([]bool, error)
+}
+
+// ArchiveRepository moves finished orders out of the orders table once they
+// have been written to object storage, and remembers where they went
+type ArchiveRepository interface {
+	// ListArchivable returns up to limit live orders in one of statuses that
+	// were last updated before updatedBefore, across all tenants, least
+	// recently updated first, each with its history and notes
+	ListArchivable(ctx context.Context, statuses []domain.OrderStatus, updatedBefore time.Time, limit int) ([]*domain.OrderBackup, error)
+
+	// MarkArchived records that orders were written to objectKey and deletes
+	// them with their items, history and notes, in one transaction. An order
+	// whose version changed since it was listed is kept. It returns the
+	// number of orders archived.
+	MarkArchived(ctx context.Context, objectKey string, orders []*domain.Order) (int, error)
+
+	// FindObjectKey returns the object an order of the caller's tenant was
+	// archived to, or "" if it was not archived
+	FindObjectKey(ctx context.Context, id string) (string, error)
+}
+
+// SubscriptionRepository stores recurring order templates
+type SubscriptionRepository interface {
+	// Create inserts a new subscription with version 1
+	Create(ctx context.Context, sub *domain.Subscription) error
+
+	// FindByID returns the subscription, or nil if it does not exist
+	FindByID(ctx context.Context, id string) (*domain.Subscription, error)
+
+	// List returns subscriptions oldest first and the total count, limited
+	// to one customer unless customerID is empty
+	List(ctx context.Context, customerID string, limit, offset int) ([]*domain.Subscription, int64, error)
+
+	// Update writes sub if its version still matches and increments the
+	// version. Returns domain.ErrConcurrentModification on a version
+	// mismatch and domain.ErrSubscriptionNotFound if it no longer exists.
+	Update(ctx context.Context, sub *domain.Subscription) error
+
+	// Delete removes the subscription. Returns domain.ErrSubscriptionNotFound
+	// if it does not exist. Orders it placed are kept.
+	Delete(ctx context.Context, id string) error
+
+	// ListDue returns the IDs of up to limit active subscriptions whose next
+	// run is at or before now, earliest first
+	ListDue(ctx context.Context, now time.Time, limit int) ([]string, error)
+}
+
+// JobQueue stores asynchronous jobs for the worker. PostgreSQL and Redis
+// implementations are selectable with JOBS_BACKEND.
+type JobQueue interface {
+	// Enqueue adds a queued job. It returns false without adding it if the
+	// job has a UniqueKey that a queued or running job already holds.
+	Enqueue(ctx context.Context, job *domain.Job) (bool, error)
+
+	// Claim takes the earliest job due at now, queued or with an expired
+	// lease, marks it running with a lease until leaseUntil and counts the
+	// attempt. It returns nil if no job is due. Concurrent workers never
+	// claim the same job.
+	Claim(ctx context.Context, now, leaseUntil time.Time) (*domain.Job, error)
+
+	// Finish writes the outcome of a claimed job (see domain.Job Succeed and
+	// Fail). Returns domain.ErrJobLeaseLost if the job has been claimed again
+	// since, so a late worker cannot overwrite a newer attempt.
+	Finish(ctx context.Context, job *domain.Job) error
+
+	// FindByID returns the job, or nil if it does not exist
+	FindByID(ctx context.Context, id string) (*domain.Job, error)
+
+	// List returns jobs newest first and the total count, limited to one
+	// status unless status is empty
+	List(ctx context.Context, status domain.JobStatus, limit, offset int) ([]*domain.Job, int64, error)
+
+	// PurgeFinished deletes succeeded and failed jobs that finished before
+	// before, returning how many were deleted
+	PurgeFinished(ctx context.Context, before time.Time) (int64, error)
+}
+
+// ReportRepository runs aggregate queries over live orders
+type ReportRepository interface {
+	// AggregateOrders counts orders and sums their totals per opts.GroupBy bucket.
+	// Period buckets are returned oldest first, status buckets by name, and
+	// customer buckets by revenue, highest first, capped at opts.Limit.
+	AggregateOrders(ctx context.Context, opts ReportOptions) ([]domain.OrderReportRow, error)
+
+	// RefreshReportViews recomputes the materialized views backing reports
+	RefreshReportViews(ctx context.Context) error
+}
+
+// ReportOptions represents the query options for an order report
+type ReportOptions struct {
+	GroupBy domain.ReportGroupBy
+	// From and To bound order creation time: From inclusive, To exclusive
+	From   *time.Time
+	To     *time.Time
+	Status *domain.OrderStatus
+	// Limit caps the number of customer buckets; other groupings return every bucket
+	Limit int
+}
+
+// PartitionRepository manages the optional monthly range partitioning of the
+// orders table by created_at. Months are UTC calendar months.
+type PartitionRepository interface {
+	// IsPartitioned reports whether the orders table is partitioned
+	IsPartitioned(ctx context.Context) (bool, error)
+
+	// PartitionOrders rewrites the orders table as a partitioned table with
+	// partitions through monthsAhead months from now, locking it for the copy.
+	// It returns the number of partitions created, 0 if already partitioned.
+	PartitionOrders(ctx context.Context, monthsAhead int) (int, error)
+
+	// EnsurePartitions creates the missing partitions for the month of from
+	// and the monthsAhead months after it, returning their names. It creates
+	// nothing if the orders table is not partitioned.
+	EnsurePartitions(ctx context.Context, from time.Time, monthsAhead int) ([]string, error)
 }
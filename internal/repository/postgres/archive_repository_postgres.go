@@ -0,0 +1,118 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// archiveRepositoryPostgres implements ArchiveRepository using PostgreSQL
+type archiveRepositoryPostgres struct {
+	pool *pgxpool.Pool
+}
+
+// NewArchiveRepository creates a new PostgreSQL archive repository
+func NewArchiveRepository(pool *pgxpool.Pool) repository.ArchiveRepository {
+	return &archiveRepositoryPostgres{
+		pool: pool,
+	}
+}
+
+func (r *archiveRepositoryPostgres) ListArchivable(ctx context.Context, statuses []domain.OrderStatus, updatedBefore time.Time, limit int) ([]*domain.OrderBackup, error) {
+	names := make([]string, len(statuses))
+	for i, status := range statuses {
+		names[i] = string(status)
+	}
+
+	var backups []*domain.OrderBackup
+	opts := pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}
+	err := pgx.BeginTxFunc(ctx, r.pool, opts, func(tx pgx.Tx) error {
+		orders, err := queryOrders(ctx, tx, `
+			SELECT `+orderColumns+`
+			FROM orders
+			WHERE deleted_at IS NULL AND status = ANY($1) AND updated_at < $2
+			ORDER BY updated_at, id
+			LIMIT $3
+		`, names, updatedBefore, limit)
+		if err != nil {
+			return err
+		}
+		backups, err = backupsFor(ctx, tx, orders)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return backups, nil
+}
+
+func (r *archiveRepositoryPostgres) MarkArchived(ctx context.Context, objectKey string, orders []*domain.Order) (int, error) {
+	ids := make([]uuid.UUID, len(orders))
+	versions := make([]int, len(orders))
+	for i, o := range orders {
+		ids[i] = o.ID
+		versions[i] = o.Version
+	}
+
+	var archived int
+	err := pgx.BeginFunc(ctx, conn(ctx, r.pool), func(tx pgx.Tx) error {
+		return tx.QueryRow(ctx, `
+			WITH listed AS (
+				SELECT * FROM unnest($1::uuid[], $2::int[]) AS l(id, version)
+			), archived AS (
+				DELETE FROM orders o
+				USING listed l
+				WHERE o.id = l.id AND o.version = l.version
+				RETURNING o.id, o.tenant_id
+			), items AS (
+				DELETE FROM order_items WHERE order_id IN (SELECT id FROM archived)
+			), history AS (
+				DELETE FROM order_history WHERE order_id IN (SELECT id FROM archived)
+			), notes AS (
+				DELETE FROM order_notes WHERE order_id IN (SELECT id FROM archived)
+			), indexed AS (
+				INSERT INTO archived_orders (id, tenant_id, object_key)
+				SELECT id, tenant_id, $3 FROM archived
+				ON CONFLICT (id) DO UPDATE SET object_key = EXCLUDED.object_key, archived_at = NOW()
+			)
+			SELECT COUNT(*) FROM archived
+		`, ids, versions, objectKey).Scan(&archived)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return archived, nil
+}
+
+func (r *archiveRepositoryPostgres) FindObjectKey(ctx context.Context, id string) (string, error) {
+	var key string
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT object_key
+		FROM archived_orders
+		WHERE id = $1 AND `+tenantMatch("$2"),
+		id, domain.TenantID(ctx)).Scan(&key)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+	return key, err
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// backupRepositoryPostgres implements BackupRepository using PostgreSQL
+type backupRepositoryPostgres struct {
+	pool *pgxpool.Pool
+}
+
+// NewBackupRepository creates a new PostgreSQL backup repository
+func NewBackupRepository(pool *pgxpool.Pool) repository.BackupRepository {
+	return &backupRepositoryPostgres{
+		pool: pool,
+	}
+}
+
+// ExportOrders reads a page in one read-only snapshot, so an order is never
+// paired with history or notes written after it was read
+func (r *backupRepositoryPostgres) ExportOrders(ctx context.Context, afterID string, limit int) ([]*domain.OrderBackup, error) {
+	after := uuid.Nil
+	if afterID != "" {
+		var err error
+		if after, err = uuid.Parse(afterID); err != nil {
+			return nil, err
+		}
+	}
+
+	var backups []*domain.OrderBackup
+	opts := pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}
+	err := pgx.BeginTxFunc(ctx, r.pool, opts, func(tx pgx.Tx) error {
+		orders, err := queryOrders(ctx, tx, `
+			SELECT `+orderColumns+`
+			FROM orders
+			WHERE id > $1
+			ORDER BY id
+			LIMIT $2
+		`, after, limit)
+		if err != nil {
+			return err
+		}
+		backups, err = backupsFor(ctx, tx, orders)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return backups, nil
+}
+
+func (r *backupRepositoryPostgres) ImportOrders(ctx context.Context, backups []*domain.OrderBackup) ([]bool, error) {
+	inserted := make([]bool, len(backups))
+	err := pgx.BeginFunc(ctx, conn(ctx, r.pool), func(tx pgx.Tx) error {
+		for i, b := range backups {
+			ok, err := importOrder(ctx, tx, b)
+			if err != nil {
+				return fmt.Errorf("order %s: %w", b.Order.ID, err)
+			}
+			inserted[i] = ok
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return inserted, nil
+}
+
+// importOrder writes a backed-up order with its items, history and notes
+// unless its ID is taken, reporting whether it did
+func importOrder(ctx context.Context, tx pgx.Tx, b *domain.OrderBackup) (bool, error) {
+	o := b.Order
+	holdReason, heldFrom, heldAt, holdUntil := holdColumns(o)
+	metadata, tags := labelColumns(o)
+	shipping, billing := addressColumns(o)
+
+	// Without a conflict target this also holds on a partitioned table,
+	// whose key is (id, created_at)
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO orders (`+orderColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
+		ON CONFLICT DO NOTHING
+	`,
+		o.ID,
+		o.CustomerID,
+		o.Status,
+		o.Total,
+		o.Version,
+		o.CreatedAt,
+		o.UpdatedAt,
+		o.DeletedAt,
+		holdReason,
+		heldFrom,
+		heldAt,
+		holdUntil,
+		metadata,
+		tags,
+		shipping,
+		billing,
+		nullString(o.ShippingMethod),
+		o.EstimatedDeliveryAt,
+		o.SLABreachedAt,
+		o.TenantID,
+	)
+	if err != nil {
+		return false, err
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+	if err := insertItems(ctx, tx, o.ID, o.Items); err != nil {
+		return false, err
+	}
+
+	batch := &pgx.Batch{}
+	for _, entry := range b.History {
+		oldJSON, err := encodeSnapshot(entry.OldState)
+		if err != nil {
+			return false, err
+		}
+		newJSON, err := encodeSnapshot(entry.NewState)
+		if err != nil {
+			return false, err
+		}
+		batch.Queue(`
+			INSERT INTO order_history (id, order_id, action, actor, actor_type, channel, old_state, new_state, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		`, entry.ID, o.ID, entry.Action, entry.Actor, entry.ActorType, entry.Channel, oldJSON, newJSON, entry.CreatedAt)
+	}
+	for _, note := range b.Notes {
+		batch.Queue(`
+			INSERT INTO order_notes (id, order_id, author, body, visibility, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`, note.ID, o.ID, note.Author, note.Body, note.Visibility, note.CreatedAt)
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// backupsFor pairs orders with their history and notes
+func backupsFor(ctx context.Context, q querier, orders []*domain.Order) ([]*domain.OrderBackup, error) {
+	if len(orders) == 0 {
+		return nil, nil
+	}
+	ids := make([]uuid.UUID, len(orders))
+	byID := make(map[uuid.UUID]*domain.OrderBackup, len(orders))
+	backups := make([]*domain.OrderBackup, len(orders))
+	for i, order := range orders {
+		ids[i] = order.ID
+		backups[i] = &domain.OrderBackup{Order: order}
+		byID[order.ID] = backups[i]
+	}
+
+	rows, err := q.Query(ctx, `
+		SELECT id, order_id, action, actor, actor_type, channel, old_state, new_state, created_at
+		FROM order_history
+		WHERE order_id = ANY($1)
+		ORDER BY order_id, created_at, id
+	`, ids)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := scanHistoryEntries(rows)
+	rows.Close()
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		b := byID[entry.OrderID]
+		b.History = append(b.History, entry)
+	}
+
+	rows, err = q.Query(ctx, `
+		SELECT id, order_id, author, body, visibility, created_at
+		FROM order_notes
+		WHERE order_id = ANY($1)
+		ORDER BY order_id, created_at, id
+	`, ids)
+	if err != nil {
+		return nil, err
+	}
+	notes, err := scanNotes(rows)
+	if err != nil {
+		return nil, err
+	}
+	for _, note := range notes {
+		b := byID[note.OrderID]
+		b.Notes = append(b.Notes, note)
+	}
+	return backups, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// customerDataRepositoryPostgres implements CustomerDataRepository using PostgreSQL
+type customerDataRepositoryPostgres struct {
+	pool *pgxpool.Pool
+}
+
+// NewCustomerDataRepository creates a new PostgreSQL customer data repository
+func NewCustomerDataRepository(pool *pgxpool.Pool) repository.CustomerDataRepository {
+	return &customerDataRepositoryPostgres{
+		pool: pool,
+	}
+}
+
+func (r *customerDataRepositoryPostgres) EraseCustomer(ctx context.Context, erasure *domain.CustomerErasure) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := pgx.BeginFunc(ctx, conn(ctx, r.pool), func(tx pgx.Tx) error {
+		// Subscriptions would keep placing orders under the erased identity.
+		// Customer IDs are only unique within a tenant.
+		_, err := tx.Exec(ctx, `DELETE FROM subscriptions WHERE customer_id = $1 AND `+tenantMatch("$2"), erasure.CustomerID, domain.TenantID(ctx))
+		if err != nil {
+			return err
+		}
+
+		// Lock every order of the customer in the tenant, live or soft-deleted
+		orders, err := queryOrders(ctx, tx, `
+			SELECT `+orderColumns+`
+			FROM orders
+			WHERE customer_id = $1 AND `+tenantMatch("$2")+`
+			ORDER BY created_at
+			FOR UPDATE
+		`, erasure.CustomerID, domain.TenantID(ctx))
+		if err != nil || len(orders) == 0 {
+			return err
+		}
+
+		ids = make([]uuid.UUID, len(orders))
+		for i, order := range orders {
+			ids[i] = order.ID
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE orders
+			SET customer_id = $1, metadata = '{}', shipping_address = NULL, billing_address = NULL, version = version + 1, updated_at = $2
+			WHERE id = ANY($3)
+		`, erasure.AnonymizedCustomerID(), erasure.ErasedAt, ids)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, `UPDATE order_items SET name = $1 WHERE order_id = ANY($2)`, domain.ErasedItemName, ids); err != nil {
+			return err
+		}
+
+		// Earlier snapshots still hold the customer's data
+		if _, err := tx.Exec(ctx, `DELETE FROM order_history WHERE order_id = ANY($1)`, ids); err != nil {
+			return err
+		}
+		// Notes are free text and may name the customer
+		if _, err := tx.Exec(ctx, `DELETE FROM order_notes WHERE order_id = ANY($1)`, ids); err != nil {
+			return err
+		}
+		for _, order := range orders {
+			order.CustomerID = erasure.AnonymizedCustomerID()
+			order.Metadata = nil
+			order.ShippingAddress = nil
+			order.BillingAddress = nil
+			order.Version++
+			order.UpdatedAt = erasure.ErasedAt
+			for i := range order.Items {
+				order.Items[i].Name = domain.ErasedItemName
+			}
+			if err := insertHistory(ctx, tx, domain.HistoryActionErased, nil, order); err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO customer_erasures (id, customer_hash, order_count, actor, erased_at)
+			VALUES ($1, $2, $3, $4, $5)
+		`, erasure.ID, erasure.CustomerHash(), len(orders), erasure.Actor, erasure.ErasedAt)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
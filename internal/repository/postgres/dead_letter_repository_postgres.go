@@ -0,0 +1,209 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+)
+
+// deadLetterRepositoryPostgres implements messaging.DeadLetterStore using PostgreSQL
+type deadLetterRepositoryPostgres struct {
+	pool *pgxpool.Pool
+}
+
+// NewDeadLetterRepository creates a new PostgreSQL dead-letter store
+func NewDeadLetterRepository(pool *pgxpool.Pool) messaging.DeadLetterStore {
+	return &deadLetterRepositoryPostgres{
+		pool: pool,
+	}
+}
+
+const deadLetterColumns = `id, topic, key, payload, headers, event_type, order_id, last_error, attempts, created_at, updated_at, next_attempt_at`
+
+func (r *deadLetterRepositoryPostgres) Save(ctx context.Context, dl *messaging.DeadLetter) error {
+	if dl.ID == uuid.Nil {
+		dl.ID = uuid.New()
+	}
+	now := time.Now()
+	if dl.CreatedAt.IsZero() {
+		dl.CreatedAt = now
+	}
+	dl.UpdatedAt = now
+	if dl.NextAttemptAt.IsZero() {
+		dl.NextAttemptAt = now
+	}
+
+	headersJSON, err := json.Marshal(dl.Headers)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO dead_letters (` + deadLetterColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err = conn(ctx, r.pool).Exec(ctx, query,
+		dl.ID,
+		dl.Topic,
+		dl.Key,
+		dl.Payload,
+		headersJSON,
+		dl.EventType,
+		dl.OrderID,
+		dl.LastError,
+		dl.Attempts,
+		dl.CreatedAt,
+		dl.UpdatedAt,
+		dl.NextAttemptAt,
+	)
+	return err
+}
+
+func (r *deadLetterRepositoryPostgres) ListDue(ctx context.Context, now time.Time, maxAttempts, limit int) ([]*messaging.DeadLetter, error) {
+	query := `
+		SELECT ` + deadLetterColumns + `
+		FROM dead_letters
+		WHERE next_attempt_at <= $1 AND attempts < $2
+		ORDER BY next_attempt_at
+		LIMIT $3
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, now, maxAttempts, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanDeadLetters(rows)
+}
+
+func (r *deadLetterRepositoryPostgres) List(ctx context.Context, limit, offset int) ([]*messaging.DeadLetter, int64, error) {
+	var total int64
+	if err := conn(ctx, r.pool).QueryRow(ctx, `SELECT COUNT(*) FROM dead_letters`).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `
+		SELECT ` + deadLetterColumns + `
+		FROM dead_letters
+		ORDER BY created_at DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	letters, err := scanDeadLetters(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return letters, total, nil
+}
+
+func (r *deadLetterRepositoryPostgres) MarkFailed(ctx context.Context, id string, lastErr string, nextAttemptAt time.Time) error {
+	query := `
+		UPDATE dead_letters
+		SET attempts = attempts + 1,
+		    last_error = $1,
+		    next_attempt_at = $2,
+		    updated_at = $3
+		WHERE id = $4
+	`
+
+	result, err := conn(ctx, r.pool).Exec(ctx, query, lastErr, nextAttemptAt, time.Now(), id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return messaging.ErrDeadLetterNotFound
+	}
+	return nil
+}
+
+func (r *deadLetterRepositoryPostgres) Requeue(ctx context.Context, id string) error {
+	now := time.Now()
+	query := `
+		UPDATE dead_letters
+		SET attempts = 0,
+		    next_attempt_at = $1,
+		    updated_at = $1
+		WHERE id = $2
+	`
+
+	result, err := conn(ctx, r.pool).Exec(ctx, query, now, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return messaging.ErrDeadLetterNotFound
+	}
+	return nil
+}
+
+func (r *deadLetterRepositoryPostgres) Delete(ctx context.Context, id string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM dead_letters WHERE id = $1`, id)
+	return err
+}
+
+func scanDeadLetters(rows pgx.Rows) ([]*messaging.DeadLetter, error) {
+	var letters []*messaging.DeadLetter
+	for rows.Next() {
+		var dl messaging.DeadLetter
+		var headersJSON []byte
+
+		err := rows.Scan(
+			&dl.ID,
+			&dl.Topic,
+			&dl.Key,
+			&dl.Payload,
+			&headersJSON,
+			&dl.EventType,
+			&dl.OrderID,
+			&dl.LastError,
+			&dl.Attempts,
+			&dl.CreatedAt,
+			&dl.UpdatedAt,
+			&dl.NextAttemptAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(headersJSON) > 0 {
+			if err := json.Unmarshal(headersJSON, &dl.Headers); err != nil {
+				return nil, err
+			}
+		}
+
+		letters = append(letters, &dl)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return letters, nil
+}
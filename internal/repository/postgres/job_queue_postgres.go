@@ -0,0 +1,229 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// jobColumns are the jobs columns scanned by scanJobs, in order
+const jobColumns = `id, kind, payload, unique_key, status, attempts, max_attempts, run_at, last_error, created_at, updated_at, finished_at`
+
+// jobQueuePostgres implements JobQueue using PostgreSQL
+type jobQueuePostgres struct {
+	pool *pgxpool.Pool
+}
+
+// NewJobQueue creates a new PostgreSQL job queue
+func NewJobQueue(pool *pgxpool.Pool) repository.JobQueue {
+	return &jobQueuePostgres{
+		pool: pool,
+	}
+}
+
+func (q *jobQueuePostgres) Enqueue(ctx context.Context, job *domain.Job) (bool, error) {
+	// Conflicts only on idx_jobs_unique_pending; a NULL unique_key never conflicts
+	result, err := conn(ctx, q.pool).Exec(ctx, `
+		INSERT INTO jobs (`+jobColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (unique_key) WHERE status IN ('queued', 'running') DO NOTHING
+	`,
+		job.ID,
+		job.Kind,
+		nullJSON(job.Payload),
+		nullString(job.UniqueKey),
+		job.Status,
+		job.Attempts,
+		job.MaxAttempts,
+		job.RunAt,
+		nullString(job.LastError),
+		job.CreatedAt,
+		job.UpdatedAt,
+		job.FinishedAt,
+	)
+	if err != nil {
+		return false, err
+	}
+	return result.RowsAffected() > 0, nil
+}
+
+func (q *jobQueuePostgres) Claim(ctx context.Context, now, leaseUntil time.Time) (*domain.Job, error) {
+	// SKIP LOCKED lets concurrent workers pass over a job another is claiming
+	rows, err := conn(ctx, q.pool).Query(ctx, `
+		UPDATE jobs
+		SET status = 'running',
+		    attempts = attempts + 1,
+		    run_at = $2,
+		    updated_at = $1
+		WHERE id = (
+			SELECT id
+			FROM jobs
+			WHERE status IN ('queued', 'running') AND run_at <= $1
+			ORDER BY run_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+jobColumns, now, leaseUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := scanJobs(rows)
+	if err != nil || len(jobs) == 0 {
+		return nil, err
+	}
+	return jobs[0], nil
+}
+
+func (q *jobQueuePostgres) Finish(ctx context.Context, job *domain.Job) error {
+	// The attempt count identifies the claim: a reclaimed job has moved on
+	result, err := conn(ctx, q.pool).Exec(ctx, `
+		UPDATE jobs
+		SET status = $1,
+		    run_at = $2,
+		    last_error = $3,
+		    updated_at = $4,
+		    finished_at = $5
+		WHERE id = $6 AND status = 'running' AND attempts = $7
+	`,
+		job.Status,
+		job.RunAt,
+		nullString(job.LastError),
+		job.UpdatedAt,
+		job.FinishedAt,
+		job.ID,
+		job.Attempts,
+	)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrJobLeaseLost
+	}
+	return nil
+}
+
+func (q *jobQueuePostgres) FindByID(ctx context.Context, id string) (*domain.Job, error) {
+	rows, err := conn(ctx, q.pool).Query(ctx, `
+		SELECT `+jobColumns+`
+		FROM jobs
+		WHERE id = $1
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := scanJobs(rows)
+	if err != nil || len(jobs) == 0 {
+		return nil, err
+	}
+	return jobs[0], nil
+}
+
+func (q *jobQueuePostgres) List(ctx context.Context, status domain.JobStatus, limit, offset int) ([]*domain.Job, int64, error) {
+	var total int64
+	err := conn(ctx, q.pool).QueryRow(ctx, `
+		SELECT COUNT(*) FROM jobs
+		WHERE $1 = '' OR status = $1
+	`, status).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := conn(ctx, q.pool).Query(ctx, `
+		SELECT `+jobColumns+`
+		FROM jobs
+		WHERE $1 = '' OR status = $1
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3
+	`, status, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	jobs, err := scanJobs(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return jobs, total, nil
+}
+
+func (q *jobQueuePostgres) PurgeFinished(ctx context.Context, before time.Time) (int64, error) {
+	result, err := conn(ctx, q.pool).Exec(ctx, `
+		DELETE FROM jobs
+		WHERE status IN ('succeeded', 'failed') AND finished_at < $1
+	`, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+// nullJSON stores an empty payload as NULL
+func nullJSON(payload json.RawMessage) any {
+	if len(payload) == 0 {
+		return nil
+	}
+	return []byte(payload)
+}
+
+// scanJobs reads every row of a jobColumns query and closes rows
+func scanJobs(rows pgx.Rows) ([]*domain.Job, error) {
+	defer rows.Close()
+
+	jobs := []*domain.Job{}
+	for rows.Next() {
+		var (
+			job       domain.Job
+			payload   []byte
+			uniqueKey *string
+			lastError *string
+		)
+		err := rows.Scan(
+			&job.ID,
+			&job.Kind,
+			&payload,
+			&uniqueKey,
+			&job.Status,
+			&job.Attempts,
+			&job.MaxAttempts,
+			&job.RunAt,
+			&lastError,
+			&job.CreatedAt,
+			&job.UpdatedAt,
+			&job.FinishedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		job.Payload = payload
+		if uniqueKey != nil {
+			job.UniqueKey = *uniqueKey
+		}
+		if lastError != nil {
+			job.LastError = *lastError
+		}
+		jobs = append(jobs, &job)
+	}
+	return jobs, rows.Err()
+}
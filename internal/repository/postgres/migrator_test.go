@@ -0,0 +1,78 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/db/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadMigrations_SortsByVersion(t *testing.T) {
+	fsys := fstest.MapFS{
+		"000002_add_index.up.sql":      {Data: []byte("CREATE INDEX i ON t(c);")},
+		"000002_add_index.down.sql":    {Data: []byte("DROP INDEX i;")},
+		"000001_create_table.up.sql":   {Data: []byte("CREATE TABLE t (c INT);")},
+		"000010_no_down_file.up.sql":   {Data: []byte("SELECT 1;")},
+		"README.md":                    {Data: []byte("ignored")},
+		"000001_create_table.down.sql": {Data: []byte("DROP TABLE t;")},
+	}
+
+	got, err := LoadMigrations(fsys)
+
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	assert.Equal(t, uint(1), got[0].Version)
+	assert.Equal(t, "create_table", got[0].Name)
+	assert.Equal(t, "DROP TABLE t;", got[0].Down)
+	assert.Equal(t, uint(2), got[1].Version)
+	assert.Equal(t, uint(10), got[2].Version)
+	assert.Empty(t, got[2].Down)
+}
+
+func TestLoadMigrations_MissingUp_ReturnsError(t *testing.T) {
+	fsys := fstest.MapFS{
+		"000001_create_table.down.sql": {Data: []byte("DROP TABLE t;")},
+	}
+
+	_, err := LoadMigrations(fsys)
+
+	assert.ErrorContains(t, err, "no up file")
+}
+
+func TestLoadMigrations_ConflictingNames_ReturnsError(t *testing.T) {
+	fsys := fstest.MapFS{
+		"000001_create_table.up.sql": {Data: []byte("CREATE TABLE t (c INT);")},
+		"000001_other_name.up.sql":   {Data: []byte("CREATE TABLE u (c INT);")},
+	}
+
+	_, err := LoadMigrations(fsys)
+
+	assert.ErrorContains(t, err, "conflicting names")
+}
+
+func TestLoadMigrations_Embedded_IsContiguous(t *testing.T) {
+	got, err := LoadMigrations(migrations.FS)
+
+	require.NoError(t, err)
+	require.NotEmpty(t, got)
+	for i, mig := range got {
+		assert.Equal(t, uint(i+1), mig.Version, "migration versions must have no gaps")
+		assert.NotEmpty(t, mig.Down, "migration %d must have a down file", mig.Version)
+	}
+}
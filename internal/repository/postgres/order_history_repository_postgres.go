@@ -0,0 +1,303 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// orderHistoryRepositoryPostgres implements OrderHistoryRepository using PostgreSQL
+type orderHistoryRepositoryPostgres struct {
+	pool *pgxpool.Pool
+}
+
+// NewOrderHistoryRepository creates a new PostgreSQL order history repository.
+// Entries are written by the order repository in the same transaction as the
+// mutation they describe; this repository only reads them.
+func NewOrderHistoryRepository(pool *pgxpool.Pool) repository.OrderHistoryRepository {
+	return &orderHistoryRepositoryPostgres{
+		pool: pool,
+	}
+}
+
+func (r *orderHistoryRepositoryPostgres) ListByOrderID(ctx context.Context, orderID string, limit, offset int) ([]*domain.OrderHistoryEntry, int64, error) {
+	// A tenant only sees the history of its own orders
+	where := `WHERE order_id = $1 AND ($2 = '' OR EXISTS (SELECT 1 FROM orders o WHERE o.id = order_id AND o.tenant_id = $2))`
+	tenantID := domain.TenantID(ctx)
+
+	var total int64
+	err := conn(ctx, r.pool).QueryRow(ctx, `SELECT COUNT(*) FROM order_history `+where, orderID, tenantID).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := `
+		SELECT id, order_id, action, actor, actor_type, channel, old_state, new_state, created_at
+		FROM order_history
+		` + where + `
+		ORDER BY created_at DESC, id
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, orderID, tenantID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	entries, err := scanHistoryEntries(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return entries, total, nil
+}
+
+func (r *orderHistoryRepositoryPostgres) ListRange(ctx context.Context, q repository.HistoryRangeQuery) ([]*domain.OrderHistoryEntry, error) {
+	b := newQueryBuilder()
+	if q.OrderID != "" {
+		b.and("order_id = " + b.arg(q.OrderID))
+	}
+	if !q.From.IsZero() {
+		b.and("created_at >= " + b.arg(q.From))
+	}
+	if !q.To.IsZero() {
+		b.and("created_at < " + b.arg(q.To))
+	}
+	if q.After {
+		b.and("(created_at, id) > (" + b.arg(q.AfterTime) + ", " + b.arg(q.AfterID) + ")")
+	}
+
+	query := `
+		SELECT id, order_id, action, actor, actor_type, channel, old_state, new_state, created_at
+		FROM order_history` + b.where() + `
+		ORDER BY created_at, id
+		LIMIT ` + b.arg(q.Limit)
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, b.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanHistoryEntries(rows)
+}
+
+// scanHistoryEntries reads rows of id, order_id, action, actor, actor_type,
+// channel, old_state, new_state and created_at.
+func scanHistoryEntries(rows pgx.Rows) ([]*domain.OrderHistoryEntry, error) {
+	entries := []*domain.OrderHistoryEntry{}
+	for rows.Next() {
+		var entry domain.OrderHistoryEntry
+		var oldJSON, newJSON []byte
+
+		err := rows.Scan(
+			&entry.ID,
+			&entry.OrderID,
+			&entry.Action,
+			&entry.Actor,
+			&entry.ActorType,
+			&entry.Channel,
+			&oldJSON,
+			&newJSON,
+			&entry.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if entry.OldState, err = decodeSnapshot(oldJSON); err != nil {
+			return nil, err
+		}
+		if entry.NewState, err = decodeSnapshot(newJSON); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// insertHistory records a mutation inside the caller's transaction. old is nil
+// for creation and erasure; updated is always set. The actor, its type and the
+// channel are taken from the context.
+func insertHistory(ctx context.Context, tx pgx.Tx, action domain.HistoryAction, old, updated *domain.Order) error {
+	oldJSON, err := encodeSnapshot(old)
+	if err != nil {
+		return err
+	}
+	newJSON, err := encodeSnapshot(updated)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO order_history (id, order_id, action, actor, actor_type, channel, old_state, new_state, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err = tx.Exec(ctx, query,
+		uuid.New(),
+		updated.ID,
+		action,
+		domain.ActorFromContext(ctx),
+		domain.ActorTypeFromContext(ctx),
+		domain.ChannelFromContext(ctx),
+		oldJSON,
+		newJSON,
+		time.Now(),
+	)
+	return err
+}
+
+// orderSnapshot is the stored JSON form of an order. It is decoupled from
+// domain.Order so history rows stay readable if the domain type changes.
+type orderSnapshot struct {
+	ID         uuid.UUID         `json:"id"`
+	CustomerID string            `json:"customer_id"`
+	Items      []itemSnapshot    `json:"items"`
+	Status     string            `json:"status"`
+	Total      float64           `json:"total"`
+	Version    int               `json:"version"`
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+	DeletedAt  *time.Time        `json:"deleted_at,omitempty"`
+	Hold       *holdSnapshot     `json:"hold,omitempty"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+	Tags       []string          `json:"tags,omitempty"`
+	Shipping   *addressRecord    `json:"shipping_address,omitempty"`
+	Billing    *addressRecord    `json:"billing_address,omitempty"`
+	// Delivery fields are absent from snapshots taken before SLA tracking existed
+	ShippingMethod      string     `json:"shipping_method,omitempty"`
+	EstimatedDeliveryAt *time.Time `json:"estimated_delivery_at,omitempty"`
+	SLABreachedAt       *time.Time `json:"sla_breached_at,omitempty"`
+	TenantID            string     `json:"tenant_id,omitempty"`
+}
+
+type holdSnapshot struct {
+	Reason         string     `json:"reason"`
+	PreviousStatus string     `json:"previous_status"`
+	HeldAt         time.Time  `json:"held_at"`
+	ReleaseAt      *time.Time `json:"release_at,omitempty"`
+}
+
+type itemSnapshot struct {
+	ID        uuid.UUID `json:"id"`
+	ProductID string    `json:"product_id"`
+	Name      string    `json:"name"`
+	Quantity  int       `json:"quantity"`
+	Price     float64   `json:"price"`
+	Subtotal  float64   `json:"subtotal"`
+	// Status is absent from snapshots taken before item fulfillment existed
+	Status domain.ItemStatus `json:"status,omitempty"`
+}
+
+// encodeSnapshot returns nil for a nil order so the column is stored as NULL
+func encodeSnapshot(order *domain.Order) ([]byte, error) {
+	if order == nil {
+		return nil, nil
+	}
+
+	snap := orderSnapshot{
+		ID:         order.ID,
+		CustomerID: order.CustomerID,
+		Items:      make([]itemSnapshot, len(order.Items)),
+		Status:     string(order.Status),
+		Total:      order.Total,
+		Version:    order.Version,
+		CreatedAt:  order.CreatedAt,
+		UpdatedAt:  order.UpdatedAt,
+		DeletedAt:  order.DeletedAt,
+		Metadata:   order.Metadata,
+		Tags:       order.Tags,
+		Shipping:   toAddressRecord(order.ShippingAddress),
+		Billing:    toAddressRecord(order.BillingAddress),
+
+		ShippingMethod:      order.ShippingMethod,
+		EstimatedDeliveryAt: order.EstimatedDeliveryAt,
+		SLABreachedAt:       order.SLABreachedAt,
+		TenantID:            order.TenantID,
+	}
+	for i, item := range order.Items {
+		snap.Items[i] = itemSnapshot(item)
+	}
+	if order.Hold != nil {
+		snap.Hold = &holdSnapshot{
+			Reason:         order.Hold.Reason,
+			PreviousStatus: string(order.Hold.PreviousStatus),
+			HeldAt:         order.Hold.HeldAt,
+			ReleaseAt:      order.Hold.ReleaseAt,
+		}
+	}
+	return json.Marshal(snap)
+}
+
+func decodeSnapshot(data []byte) (*domain.Order, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	var snap orderSnapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, err
+	}
+
+	order := &domain.Order{
+		ID:              snap.ID,
+		CustomerID:      snap.CustomerID,
+		Items:           make([]domain.OrderItem, len(snap.Items)),
+		Status:          domain.OrderStatus(snap.Status),
+		Total:           snap.Total,
+		Version:         snap.Version,
+		CreatedAt:       snap.CreatedAt,
+		UpdatedAt:       snap.UpdatedAt,
+		DeletedAt:       snap.DeletedAt,
+		Metadata:        snap.Metadata,
+		Tags:            snap.Tags,
+		ShippingAddress: snap.Shipping.toAddress(),
+		BillingAddress:  snap.Billing.toAddress(),
+
+		ShippingMethod:      snap.ShippingMethod,
+		EstimatedDeliveryAt: snap.EstimatedDeliveryAt,
+		SLABreachedAt:       snap.SLABreachedAt,
+		TenantID:            snap.TenantID,
+	}
+	for i, item := range snap.Items {
+		order.Items[i] = domain.OrderItem(item)
+		if item.Status == "" {
+			order.Items[i].Status = domain.ItemStatusPending
+		}
+	}
+	if snap.Hold != nil {
+		order.Hold = &domain.OrderHold{
+			Reason:         snap.Hold.Reason,
+			PreviousStatus: domain.OrderStatus(snap.Hold.PreviousStatus),
+			HeldAt:         snap.Hold.HeldAt,
+			ReleaseAt:      snap.Hold.ReleaseAt,
+		}
+	}
+	return order, nil
+}
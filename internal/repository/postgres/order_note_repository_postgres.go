@@ -0,0 +1,131 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// orderNoteRepositoryPostgres implements OrderNoteRepository using PostgreSQL
+type orderNoteRepositoryPostgres struct {
+	pool *pgxpool.Pool
+}
+
+// NewOrderNoteRepository creates a new PostgreSQL order note repository
+func NewOrderNoteRepository(pool *pgxpool.Pool) repository.OrderNoteRepository {
+	return &orderNoteRepositoryPostgres{
+		pool: pool,
+	}
+}
+
+func (r *orderNoteRepositoryPostgres) Create(ctx context.Context, note *domain.OrderNote) error {
+	query := `
+		INSERT INTO order_notes (id, order_id, author, body, visibility, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, query,
+		note.ID,
+		note.OrderID,
+		note.Author,
+		note.Body,
+		note.Visibility,
+		note.CreatedAt,
+	)
+	return err
+}
+
+func (r *orderNoteRepositoryPostgres) ListByOrderID(ctx context.Context, orderID string, includeInternal bool, limit, offset int) ([]*domain.OrderNote, int64, error) {
+	var total int64
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT COUNT(*) FROM order_notes
+		WHERE order_id = $1 AND ($2 OR visibility = 'customer')
+	`, orderID, includeInternal).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT id, order_id, author, body, visibility, created_at
+		FROM order_notes
+		WHERE order_id = $1 AND ($2 OR visibility = 'customer')
+		ORDER BY created_at, id
+		LIMIT $3 OFFSET $4
+	`, orderID, includeInternal, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	notes, err := scanNotes(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return notes, total, nil
+}
+
+func (r *orderNoteRepositoryPostgres) ListByOrderIDs(ctx context.Context, orderIDs []uuid.UUID, includeInternal bool) (map[uuid.UUID][]*domain.OrderNote, error) {
+	byOrder := make(map[uuid.UUID][]*domain.OrderNote)
+	if len(orderIDs) == 0 {
+		return byOrder, nil
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT id, order_id, author, body, visibility, created_at
+		FROM order_notes
+		WHERE order_id = ANY($1) AND ($2 OR visibility = 'customer')
+		ORDER BY order_id, created_at, id
+	`, orderIDs, includeInternal)
+	if err != nil {
+		return nil, err
+	}
+
+	notes, err := scanNotes(rows)
+	if err != nil {
+		return nil, err
+	}
+	for _, note := range notes {
+		byOrder[note.OrderID] = append(byOrder[note.OrderID], note)
+	}
+	return byOrder, nil
+}
+
+// scanNotes reads every row of an order_notes query and closes rows
+func scanNotes(rows pgx.Rows) ([]*domain.OrderNote, error) {
+	defer rows.Close()
+
+	notes := []*domain.OrderNote{}
+	for rows.Next() {
+		var note domain.OrderNote
+		err := rows.Scan(
+			&note.ID,
+			&note.OrderID,
+			&note.Author,
+			&note.Body,
+			&note.Visibility,
+			&note.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		notes = append(notes, &note)
+	}
+	return notes, rows.Err()
+}
@@ -16,135 +16,216 @@ package postgres
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
 )
 
+// orderColumns are the orders columns scanned by orderRow, in order
+const orderColumns = `id, customer_id, status, total, version, created_at, updated_at, deleted_at, hold_reason, held_from_status, held_at, hold_until, metadata, tags, shipping_address, billing_address, shipping_method, estimated_delivery_at, sla_breached_at, tenant_id`
+
+// querier is satisfied by both *pgxpool.Pool and pgx.Tx
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
 // orderRepositoryPostgres implements OrderRepository using PostgreSQL
 type orderRepositoryPostgres struct {
 	pool *pgxpool.Pool
+	// replica serves FindByID, List and FindByCustomerID when set
+	replica *Replica
+	// queryTimeout bounds each call; zero leaves only the caller's deadline
+	queryTimeout time.Duration
 }
 
-// NewOrderRepository creates a new PostgreSQL order repository
-func NewOrderRepository(pool *pgxpool.Pool) repository.OrderRepository {
+// NewOrderRepository creates a new PostgreSQL order repository. replica may
+// be nil, in which case every query goes to pool. Each call is canceled after
+// queryTimeout unless it is zero.
+func NewOrderRepository(pool *pgxpool.Pool, replica *Replica, queryTimeout time.Duration) repository.OrderRepository {
 	return &orderRepositoryPostgres{
-		pool: pool,
+		pool:         pool,
+		replica:      replica,
+		queryTimeout: queryTimeout,
 	}
 }
 
 func (r *orderRepositoryPostgres) Create(ctx context.Context, order *domain.Order) error {
-	itemsJSON, err := json.Marshal(order.Items)
-	if err != nil {
-		return err
-	}
+	ctx, cancel := withQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
 
 	// Set initial version
 	order.Version = 1
 
-	query := `
-		INSERT INTO orders (id, customer_id, items, status, total, version, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-	`
-
-	_, err = r.pool.Exec(ctx, query,
-		order.ID,
-		order.CustomerID,
-		itemsJSON,
-		order.Status,
-		order.Total,
-		order.Version,
-		order.CreatedAt,
-		order.UpdatedAt,
-	)
-
-	return err
+	return pgx.BeginFunc(ctx, conn(ctx, r.pool), func(tx pgx.Tx) error {
+		return insertOrder(ctx, tx, order)
+	})
 }
 
-func (r *orderRepositoryPostgres) FindByID(ctx context.Context, id string) (*domain.Order, error) {
-	query := `
-		SELECT id, customer_id, items, status, total, version, created_at, updated_at, deleted_at
-		FROM orders
-		WHERE id = $1 AND deleted_at IS NULL
-	`
+// CreateBatch copies all orders, items and history rows in one transaction.
+// If any row is rejected the copy is rolled back and the orders are inserted
+// again one by one, each under its own savepoint, so only the failing orders
+// are left out and their errors can be reported.
+func (r *orderRepositoryPostgres) CreateBatch(ctx context.Context, orders []*domain.Order) ([]error, error) {
+	ctx, cancel := withQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
 
-	var order domain.Order
-	var itemsJSON []byte
-
-	err := r.pool.QueryRow(ctx, query, id).Scan(
-		&order.ID,
-		&order.CustomerID,
-		&itemsJSON,
-		&order.Status,
-		&order.Total,
-		&order.Version,
-		&order.CreatedAt,
-		&order.UpdatedAt,
-		&order.DeletedAt,
-	)
+	for _, order := range orders {
+		order.Version = 1
+	}
 
-	if errors.Is(err, pgx.ErrNoRows) {
-		return nil, nil
+	errs := make([]error, len(orders))
+	if len(orders) == 0 {
+		return errs, nil
 	}
-	if err != nil {
-		return nil, err
+
+	err := pgx.BeginFunc(ctx, conn(ctx, r.pool), func(tx pgx.Tx) error {
+		return copyOrders(ctx, tx, orders)
+	})
+	if err == nil || ctx.Err() != nil {
+		return errs, err
 	}
 
-	if err := json.Unmarshal(itemsJSON, &order.Items); err != nil {
+	err = pgx.BeginFunc(ctx, conn(ctx, r.pool), func(tx pgx.Tx) error {
+		for i, order := range orders {
+			// Begin on a transaction creates a savepoint
+			errs[i] = pgx.BeginFunc(ctx, tx, func(sp pgx.Tx) error {
+				return insertOrder(ctx, sp, order)
+			})
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	return errs, nil
+}
+
+func (r *orderRepositoryPostgres) FindByID(ctx context.Context, id string) (*domain.Order, error) {
+	ctx, cancel := withQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
 
-	return &order, nil
+	var order *domain.Order
+	err := r.replica.read(ctx, r.pool, func(q querier) error {
+		var err error
+		order, err = findOrder(ctx, q, id, "")
+		return err
+	})
+	return order, err
 }
 
-func (r *orderRepositoryPostgres) Update(ctx context.Context, order *domain.Order) error {
-	itemsJSON, err := json.Marshal(order.Items)
-	if err != nil {
+func (r *orderRepositoryPostgres) FindByIDIncludingDeleted(ctx context.Context, id string) (*domain.Order, error) {
+	ctx, cancel := withQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	var order *domain.Order
+	err := r.replica.read(ctx, r.pool, func(q querier) error {
+		var err error
+		order, err = findOrderWhere(ctx, q, id, "TRUE", "")
 		return err
-	}
+	})
+	return order, err
+}
+
+func (r *orderRepositoryPostgres) Update(ctx context.Context, order *domain.Order) error {
+	ctx, cancel := withQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
 
 	// Optimistic locking: only update if version matches, then increment version
 	query := `
 		UPDATE orders
 		SET customer_id = $1,
-		    items = $2,
-		    status = $3,
-		    total = $4,
+		    status = $2,
+		    total = $3,
 		    version = version + 1,
-		    updated_at = $5
-		WHERE id = $6 AND version = $7 AND deleted_at IS NULL
+		    updated_at = $4,
+		    hold_reason = $7,
+		    held_from_status = $8,
+		    held_at = $9,
+		    hold_until = $10,
+		    metadata = $11,
+		    tags = $12,
+		    shipping_address = $13,
+		    billing_address = $14,
+		    shipping_method = $15,
+		    estimated_delivery_at = $16,
+		    sla_breached_at = $17
+		WHERE id = $5 AND version = $6 AND deleted_at IS NULL AND ` + tenantMatch("$18") + `
 	`
 
-	result, err := r.pool.Exec(ctx, query,
-		order.CustomerID,
-		itemsJSON,
-		order.Status,
-		order.Total,
-		time.Now(),
-		order.ID,
-		order.Version,
-	)
-
-	if err != nil {
-		return err
-	}
+	now := time.Now()
+	err := pgx.BeginFunc(ctx, conn(ctx, r.pool), func(tx pgx.Tx) error {
+		// Lock the row and keep the prior state for the history entry
+		old, err := findOrder(ctx, tx, order.ID.String(), "FOR UPDATE")
+		if err != nil {
+			return err
+		}
 
-	rowsAffected := result.RowsAffected()
-	if rowsAffected == 0 {
-		// Check if order exists to distinguish between not found and version mismatch
-		exists, err := r.orderExists(ctx, order.ID.String())
+		holdReason, heldFrom, heldAt, holdUntil := holdColumns(order)
+		metadata, tags := labelColumns(order)
+		shipping, billing := addressColumns(order)
+		result, err := tx.Exec(ctx, query,
+			order.CustomerID,
+			order.Status,
+			order.Total,
+			now,
+			order.ID,
+			order.Version,
+			holdReason,
+			heldFrom,
+			heldAt,
+			holdUntil,
+			metadata,
+			tags,
+			shipping,
+			billing,
+			nullString(order.ShippingMethod),
+			order.EstimatedDeliveryAt,
+			order.SLABreachedAt,
+			domain.TenantID(ctx),
+		)
 		if err != nil {
 			return err
 		}
-		if !exists {
-			return domain.ErrOrderNotFound
+
+		if result.RowsAffected() == 0 {
+			// Check if order exists to distinguish between not found and version mismatch
+			exists, err := r.orderExists(ctx, order.ID.String())
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return domain.ErrOrderNotFound
+			}
+			return domain.ErrConcurrentModification
+		}
+
+		// Items are replaced wholesale; the row lock taken above serializes this
+		if _, err := tx.Exec(ctx, `DELETE FROM order_items WHERE order_id = $1`, order.ID); err != nil {
+			return err
 		}
-		return domain.ErrConcurrentModification
+		if err := insertItems(ctx, tx, order.ID, order.Items); err != nil {
+			return err
+		}
+
+		updated := *order
+		updated.Version++
+		updated.UpdatedAt = now
+		return insertHistory(ctx, tx, domain.ClassifyChange(old, &updated), old, &updated)
+	})
+	if err != nil {
+		return err
 	}
 
 	// Increment version in the order object to reflect the new state
@@ -154,6 +235,9 @@ func (r *orderRepositoryPostgres) Update(ctx context.Context, order *domain.Orde
 }
 
 func (r *orderRepositoryPostgres) Delete(ctx context.Context, id string) error {
+	ctx, cancel := withQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	// Soft delete - set deleted_at timestamp
 	query := `
 		UPDATE orders
@@ -161,166 +245,815 @@ func (r *orderRepositoryPostgres) Delete(ctx context.Context, id string) error {
 		WHERE id = $2 AND deleted_at IS NULL
 	`
 
-	result, err := r.pool.Exec(ctx, query, time.Now(), id)
-	if err != nil {
+	now := time.Now()
+	return pgx.BeginFunc(ctx, conn(ctx, r.pool), func(tx pgx.Tx) error {
+		old, err := findOrder(ctx, tx, id, "FOR UPDATE")
+		if err != nil {
+			return err
+		}
+		if old == nil {
+			return domain.ErrOrderNotFound
+		}
+
+		result, err := tx.Exec(ctx, query, now, id)
+		if err != nil {
+			return err
+		}
+
+		if result.RowsAffected() == 0 {
+			return domain.ErrOrderNotFound
+		}
+
+		deleted := *old
+		deleted.Version++
+		deleted.DeletedAt = &now
+		return insertHistory(ctx, tx, domain.HistoryActionDeleted, old, &deleted)
+	})
+}
+
+func (r *orderRepositoryPostgres) List(ctx context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+	ctx, cancel := withQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	qb := newQueryBuilder()
+	scopeTenant(ctx, qb)
+	return r.list(ctx, qb, opts)
+}
+
+func (r *orderRepositoryPostgres) FindByCustomerID(ctx context.Context, customerID string, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+	ctx, cancel := withQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	qb := newQueryBuilder()
+	scopeTenant(ctx, qb)
+	qb.and("customer_id = " + qb.arg(customerID))
+	return r.list(ctx, qb, opts)
+}
+
+// list returns a page of orders matching the conditions already in qb and
+// the optional status and product filters, with the total match count. Only
+// live orders are returned unless opts.IncludeDeleted is set.
+func (r *orderRepositoryPostgres) list(ctx context.Context, qb *queryBuilder, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+	if !opts.IncludeDeleted {
+		qb.and("deleted_at IS NULL")
+	}
+
+	if opts.Status != nil {
+		qb.and("status = " + qb.arg(*opts.Status))
+	}
+
+	if opts.ProductID != nil {
+		qb.and(productFilter(qb.arg(*opts.ProductID)))
+	}
+
+	if len(opts.Tags) > 0 {
+		// Covered by idx_orders_tags
+		qb.and("tags @> " + qb.arg(opts.Tags) + "::jsonb")
+	}
+
+	var (
+		orders []*domain.Order
+		total  int64
+	)
+	if opts.SkipTotal {
+		query := qb.page(`
+			SELECT `+orderColumns+`
+			FROM orders`+qb.where(), "created_at DESC", opts.Limit, opts.Offset)
+		err := r.replica.read(ctx, r.pool, func(q querier) error {
+			var err error
+			orders, err = queryOrders(ctx, q, query, qb.args...)
+			return err
+		})
+		return orders, 0, err
+	}
+
+	err := r.replica.read(ctx, r.pool, func(q querier) error {
+		var err error
+		orders, total, err = queryPage(ctx, q, qb, "created_at DESC", opts.Limit, opts.Offset)
 		return err
+	})
+	return orders, total, err
+}
+
+// queryPage returns one page of the orders matching qb and their total count
+// in a single round trip, reading the count from a COUNT(*) OVER () column.
+// A page past the end has no row to carry the count, so only then is it
+// queried separately.
+func queryPage(ctx context.Context, q querier, qb *queryBuilder, orderBy string, limit, offset int) ([]*domain.Order, int64, error) {
+	// Page a copy, leaving qb as it was for a retry on the primary
+	qb = &queryBuilder{conds: qb.conds, args: qb.args[:len(qb.args):len(qb.args)]}
+	where := qb.where()
+	countArgs := qb.args
+
+	query := qb.page(`
+		SELECT `+orderColumns+`, COUNT(*) OVER ()
+		FROM orders`+where, orderBy, limit, offset)
+
+	orders, totalCount, err := queryOrdersWithTotal(ctx, q, query, qb.args...)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	if result.RowsAffected() == 0 {
-		return domain.ErrOrderNotFound
+	if len(orders) == 0 && offset > 0 {
+		if err := q.QueryRow(ctx, `SELECT COUNT(*) FROM orders`+where, countArgs...).Scan(&totalCount); err != nil {
+			return nil, 0, err
+		}
 	}
 
-	return nil
+	return orders, totalCount, nil
 }
 
-func (r *orderRepositoryPostgres) List(ctx context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error) {
-	// Build query with optional status filter
+// EstimateTotal reads the planner's row estimate for orders, kept current by
+// autovacuum and ANALYZE. reltuples is -1 until the table is first analyzed.
+func (r *orderRepositoryPostgres) EstimateTotal(ctx context.Context) (int64, error) {
+	ctx, cancel := withQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	var estimate int64
+	err := r.replica.read(ctx, r.pool, func(q querier) error {
+		return q.QueryRow(ctx, `SELECT reltuples::bigint FROM pg_class WHERE oid = 'orders'::regclass`).Scan(&estimate)
+	})
+	return estimate, err
+}
+
+func (r *orderRepositoryPostgres) ListDeleted(ctx context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+	ctx, cancel := withQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	qb := newQueryBuilder()
+	scopeTenant(ctx, qb)
+	qb.and("deleted_at IS NOT NULL")
+
+	var totalCount int64
+	err := conn(ctx, r.pool).QueryRow(ctx, `SELECT COUNT(*) FROM orders`+qb.where(), qb.args...).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := qb.page(`
+		SELECT `+orderColumns+`
+		FROM orders`+qb.where(), "deleted_at DESC", opts.Limit, opts.Offset)
+	orders, err := queryOrders(ctx, conn(ctx, r.pool), query, qb.args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return orders, totalCount, nil
+}
+
+func (r *orderRepositoryPostgres) Restore(ctx context.Context, id string, expectedVersion *int) (*domain.Order, error) {
+	ctx, cancel := withQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
-		SELECT id, customer_id, items, status, total, version, created_at, updated_at, deleted_at
+		UPDATE orders
+		SET deleted_at = NULL, version = version + 1, updated_at = $1
+		WHERE id = $2 AND deleted_at IS NOT NULL
+	`
+
+	now := time.Now()
+	var restored *domain.Order
+	err := pgx.BeginFunc(ctx, conn(ctx, r.pool), func(tx pgx.Tx) error {
+		old, err := findOrderWhere(ctx, tx, id, "deleted_at IS NOT NULL", "FOR UPDATE")
+		if err != nil || old == nil {
+			return err
+		}
+		if expectedVersion != nil && *expectedVersion != old.Version {
+			return domain.ErrVersionMismatch
+		}
+
+		if _, err := tx.Exec(ctx, query, now, id); err != nil {
+			return err
+		}
+
+		updated := *old
+		updated.Version++
+		updated.UpdatedAt = now
+		updated.DeletedAt = nil
+		restored = &updated
+		return insertHistory(ctx, tx, domain.HistoryActionRestored, old, restored)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return restored, nil
+}
+
+// Purge is a maintenance job and runs without the query and statement timeouts
+func (r *orderRepositoryPostgres) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	var purged int64
+	err := withoutStatementTimeout(ctx, conn(ctx, r.pool), func(tx pgx.Tx) error {
+		return tx.QueryRow(ctx, purgeWith(`deleted_at < $1`), deletedBefore).Scan(&purged)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return purged, nil
+}
+
+// PurgeCompleted is a maintenance job and runs without the query and statement timeouts
+func (r *orderRepositoryPostgres) PurgeCompleted(ctx context.Context, statuses []domain.OrderStatus, updatedBefore time.Time) (int64, error) {
+	names := make([]string, len(statuses))
+	for i, status := range statuses {
+		names[i] = string(status)
+	}
+
+	var purged int64
+	err := withoutStatementTimeout(ctx, conn(ctx, r.pool), func(tx pgx.Tx) error {
+		return tx.QueryRow(ctx, purgeWith(`deleted_at IS NULL AND status = ANY($1) AND updated_at < $2`), names, updatedBefore).Scan(&purged)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return purged, nil
+}
+
+func (r *orderRepositoryPostgres) ListDueHolds(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	ctx, cancel := withQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		SELECT id
 		FROM orders
-		WHERE deleted_at IS NULL
+		WHERE status = 'on_hold' AND hold_until <= $1 AND deleted_at IS NULL
+		ORDER BY hold_until
+		LIMIT $2
 	`
-	countQuery := `SELECT COUNT(*) FROM orders WHERE deleted_at IS NULL`
 
-	args := []interface{}{}
-	argIndex := 1
+	rows, err := conn(ctx, r.pool).Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanIDs(rows)
+}
 
-	if opts.Status != nil {
-		query += ` AND status = $` + string(rune('0'+argIndex))
-		countQuery += ` AND status = $1`
-		args = append(args, *opts.Status)
-		argIndex++
+func (r *orderRepositoryPostgres) ListOverdueDeliveries(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	ctx, cancel := withQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	// Covered by idx_orders_sla_due
+	query := `
+		SELECT id
+		FROM orders
+		WHERE estimated_delivery_at < $1
+		  AND sla_breached_at IS NULL
+		  AND status NOT IN ('delivered', 'cancelled')
+		  AND deleted_at IS NULL
+		ORDER BY estimated_delivery_at
+		LIMIT $2
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, err
 	}
+	return scanIDs(rows)
+}
 
-	query += ` ORDER BY created_at DESC LIMIT $` + string(rune('0'+argIndex)) + ` OFFSET $` + string(rune('0'+argIndex+1))
-	args = append(args, opts.Limit, opts.Offset)
+func (r *orderRepositoryPostgres) ListStalePending(ctx context.Context, createdBefore time.Time, limit int) ([]string, error) {
+	ctx, cancel := withQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
 
-	// Get total count
-	var totalCount int64
-	countArgs := args[:len(args)-2] // exclude limit and offset
-	if len(countArgs) == 0 {
-		countArgs = nil
+	// Covered by idx_orders_status_created
+	query := `
+		SELECT id
+		FROM orders
+		WHERE status = 'pending'
+		  AND created_at < $1
+		  AND deleted_at IS NULL
+		ORDER BY created_at
+		LIMIT $2
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, createdBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanIDs(rows)
+}
+
+func (r *orderRepositoryPostgres) ListOrderAmounts(ctx context.Context, afterID string, limit int) ([]domain.OrderAmounts, error) {
+	ctx, cancel := withQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	after := uuid.Nil
+	if afterID != "" {
+		var err error
+		if after, err = uuid.Parse(afterID); err != nil {
+			return nil, err
+		}
 	}
-	err := r.pool.QueryRow(ctx, countQuery, countArgs...).Scan(&totalCount)
+
+	// Amounts are compared as numeric, so no rounding creeps in here
+	query := `
+		WITH page AS (
+			SELECT id, tenant_id, total
+			FROM orders
+			WHERE id > $1 AND ` + tenantMatch("$3") + ` AND deleted_at IS NULL
+			ORDER BY id
+			LIMIT $2
+		)
+		SELECT p.id, p.tenant_id, p.total,
+		       COALESCE(SUM(i.price * i.quantity), 0),
+		       COUNT(i.id) FILTER (WHERE i.subtotal <> i.price * i.quantity)
+		FROM page p
+		LEFT JOIN order_items i ON i.order_id = p.id
+		GROUP BY p.id, p.tenant_id, p.total
+		ORDER BY p.id
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, after, limit, domain.TenantID(ctx))
 	if err != nil {
-		return nil, 0, err
+		return nil, err
+	}
+	defer rows.Close()
+
+	var amounts []domain.OrderAmounts
+	for rows.Next() {
+		var (
+			id uuid.UUID
+			a  domain.OrderAmounts
+		)
+		if err := rows.Scan(&id, &a.TenantID, &a.Total, &a.ItemTotal, &a.BadSubtotals); err != nil {
+			return nil, err
+		}
+		a.OrderID = id.String()
+		amounts = append(amounts, a)
+	}
+	return amounts, rows.Err()
+}
+
+// scanIDs collects the order IDs of a single-column result and closes rows
+func scanIDs(rows pgx.Rows) ([]string, error) {
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id.String())
+	}
+	return ids, rows.Err()
+}
+
+// purgeWith returns a statement that hard-deletes the orders matching where,
+// with their items, history and notes, and counts them. Child rows are deleted
+// explicitly because a partitioned orders table has no ON DELETE CASCADE.
+func purgeWith(where string) string {
+	return `
+		WITH purged AS (
+			DELETE FROM orders WHERE ` + where + ` RETURNING id
+		), items AS (
+			DELETE FROM order_items WHERE order_id IN (SELECT id FROM purged)
+		), history AS (
+			DELETE FROM order_history WHERE order_id IN (SELECT id FROM purged)
+		), notes AS (
+			DELETE FROM order_notes WHERE order_id IN (SELECT id FROM purged)
+		)
+		SELECT COUNT(*) FROM purged
+	`
+}
+
+func (r *orderRepositoryPostgres) Search(ctx context.Context, query string, opts repository.SearchOptions) ([]*domain.Order, int64, error) {
+	ctx, cancel := withQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	// $1 is the raw query for trigram and full-text matching, $2 an order ID
+	// prefix pattern and $3 a substring pattern for customer IDs.
+	// Covered by idx_orders_id_prefix, idx_orders_customer_id_trgm,
+	// idx_order_items_name_fts and idx_order_items_name_trgm.
+	pattern := escapeLike(query)
+	qb := newQueryBuilder(query, strings.ToLower(pattern)+"%", "%"+pattern+"%")
+	qb.and(`deleted_at IS NULL`)
+	scopeTenant(ctx, qb)
+	qb.and(`(
+			id::text LIKE $2
+			OR customer_id ILIKE $3
+			OR customer_id % $1
+			OR EXISTS (
+				SELECT 1 FROM order_items oi
+				WHERE oi.order_id = orders.id
+				AND (to_tsvector('simple', oi.name) @@ plainto_tsquery('simple', $1) OR $1 <% oi.name)
+			)
+		)`)
+
+	if opts.Status != nil {
+		qb.and("status = " + qb.arg(*opts.Status))
+	}
+
+	if opts.CustomerID != nil {
+		qb.and("customer_id = " + qb.arg(*opts.CustomerID))
+	}
+
+	if opts.ProductID != nil {
+		qb.and(productFilter(qb.arg(*opts.ProductID)))
+	}
+
+	if opts.MinTotal != nil {
+		qb.and("total >= " + qb.arg(*opts.MinTotal))
 	}
 
-	// Get orders
-	rows, err := r.pool.Query(ctx, query, args...)
+	if opts.MaxTotal != nil {
+		qb.and("total <= " + qb.arg(*opts.MaxTotal))
+	}
+
+	// Rank by the strongest match: an ID prefix or exact customer ID scores 1,
+	// otherwise trigram similarity of the customer ID or best item name.
+	return queryPage(ctx, conn(ctx, r.pool), qb, `GREATEST(
+			CASE WHEN id::text LIKE $2 THEN 1 ELSE 0 END,
+			CASE WHEN lower(customer_id) = lower($1) THEN 1 ELSE similarity(customer_id, $1) END,
+			COALESCE((SELECT MAX(word_similarity($1, oi.name)) FROM order_items oi WHERE oi.order_id = orders.id), 0)
+		) DESC, created_at DESC`, opts.Limit, opts.Offset)
+}
+
+// escapeLike escapes the LIKE wildcards in s so it matches literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// productFilter matches orders containing at least one item for the product
+// bound to placeholder. Covered by idx_order_items_product_order.
+func productFilter(placeholder string) string {
+	return `EXISTS (SELECT 1 FROM order_items oi WHERE oi.order_id = orders.id AND oi.product_id = ` + placeholder + `)`
+}
+
+// queryOrders runs an orders SELECT and attaches each order's items
+func queryOrders(ctx context.Context, q querier, query string, args ...interface{}) ([]*domain.Order, error) {
+	return scanOrders(ctx, q, query, nil, args...)
+}
+
+// queryOrdersWithTotal runs an orders SELECT whose last column is a
+// COUNT(*) OVER () window, returning the orders and that count
+func queryOrdersWithTotal(ctx context.Context, q querier, query string, args ...interface{}) ([]*domain.Order, int64, error) {
+	var total int64
+	orders, err := scanOrders(ctx, q, query, &total, args...)
+	return orders, total, err
+}
+
+// scanOrders runs an orders SELECT and attaches each order's items. When
+// total is set, each row carries an extra last column scanned into it.
+func scanOrders(ctx context.Context, q querier, query string, total *int64, args ...interface{}) ([]*domain.Order, error) {
+	rows, err := q.Query(ctx, query, args...)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	defer rows.Close()
 
 	var orders []*domain.Order
 	for rows.Next() {
-		var order domain.Order
-		var itemsJSON []byte
+		var row orderRow
 
-		err := rows.Scan(
-			&order.ID,
-			&order.CustomerID,
-			&itemsJSON,
-			&order.Status,
-			&order.Total,
-			&order.Version,
-			&order.CreatedAt,
-			&order.UpdatedAt,
-			&order.DeletedAt,
-		)
-		if err != nil {
-			return nil, 0, err
+		dest := row.dest()
+		if total != nil {
+			dest = append(dest, total)
 		}
-
-		if err := json.Unmarshal(itemsJSON, &order.Items); err != nil {
-			return nil, 0, err
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
 		}
 
-		orders = append(orders, &order)
+		orders = append(orders, row.toOrder())
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	return orders, totalCount, nil
+	if err := loadItems(ctx, q, orders); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
 }
 
-func (r *orderRepositoryPostgres) FindByCustomerID(ctx context.Context, customerID string, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+// findOrder loads a live order and its items, or nil if it does not exist.
+// lockClause is appended to the SELECT, e.g. "FOR UPDATE" inside a transaction.
+func findOrder(ctx context.Context, q querier, id string, lockClause string) (*domain.Order, error) {
+	return findOrderWhere(ctx, q, id, "deleted_at IS NULL", lockClause)
+}
+
+// findOrderWhere loads the order with this ID if it also matches condition
+// and belongs to the tenant in ctx
+func findOrderWhere(ctx context.Context, q querier, id, condition, lockClause string) (*domain.Order, error) {
 	query := `
-		SELECT id, customer_id, items, status, total, version, created_at, updated_at, deleted_at
+		SELECT ` + orderColumns + `
 		FROM orders
-		WHERE customer_id = $1 AND deleted_at IS NULL
-	`
-	countQuery := `SELECT COUNT(*) FROM orders WHERE customer_id = $1 AND deleted_at IS NULL`
+		WHERE id = $1 AND ` + tenantMatch("$2") + ` AND ` + condition + `
+	` + lockClause
 
-	args := []interface{}{customerID}
-	argIndex := 2
+	var row orderRow
 
-	if opts.Status != nil {
-		query += ` AND status = $` + string(rune('0'+argIndex))
-		countQuery += ` AND status = $2`
-		args = append(args, *opts.Status)
-		argIndex++
+	err := q.QueryRow(ctx, query, id, domain.TenantID(ctx)).Scan(row.dest()...)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	query += ` ORDER BY created_at DESC LIMIT $` + string(rune('0'+argIndex)) + ` OFFSET $` + string(rune('0'+argIndex+1))
-	args = append(args, opts.Limit, opts.Offset)
+	order := row.toOrder()
+	if err := loadItems(ctx, q, []*domain.Order{order}); err != nil {
+		return nil, err
+	}
 
-	// Get total count
-	var totalCount int64
-	countArgs := args[:len(args)-2]
-	err := r.pool.QueryRow(ctx, countQuery, countArgs...).Scan(&totalCount)
-	if err != nil {
-		return nil, 0, err
+	return order, nil
+}
+
+// orderRow is the scan target for orderColumns. The hold columns are NULL
+// unless the order is on hold.
+type orderRow struct {
+	order           domain.Order
+	holdReason      *string
+	heldFromStatus  *string
+	heldAt          *time.Time
+	holdUntil       *time.Time
+	shippingAddress *addressRecord
+	billingAddress  *addressRecord
+	shippingMethod  *string
+}
+
+// addressRecord is the JSON form of a domain.Address in the shipping_address
+// and billing_address columns and in history snapshots
+type addressRecord struct {
+	Name       string `json:"name,omitempty"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country"`
+}
+
+// toAddressRecord returns nil for a nil address so the column is stored as NULL
+func toAddressRecord(a *domain.Address) *addressRecord {
+	if a == nil {
+		return nil
+	}
+	r := addressRecord(*a)
+	return &r
+}
+
+func (r *addressRecord) toAddress() *domain.Address {
+	if r == nil {
+		return nil
+	}
+	a := domain.Address(*r)
+	return &a
+}
+
+func (row *orderRow) dest() []interface{} {
+	return []interface{}{
+		&row.order.ID,
+		&row.order.CustomerID,
+		&row.order.Status,
+		&row.order.Total,
+		&row.order.Version,
+		&row.order.CreatedAt,
+		&row.order.UpdatedAt,
+		&row.order.DeletedAt,
+		&row.holdReason,
+		&row.heldFromStatus,
+		&row.heldAt,
+		&row.holdUntil,
+		&row.order.Metadata,
+		&row.order.Tags,
+		&row.shippingAddress,
+		&row.billingAddress,
+		&row.shippingMethod,
+		&row.order.EstimatedDeliveryAt,
+		&row.order.SLABreachedAt,
+		&row.order.TenantID,
 	}
+}
+
+func (row *orderRow) toOrder() *domain.Order {
+	order := row.order
+	order.ShippingAddress = row.shippingAddress.toAddress()
+	order.BillingAddress = row.billingAddress.toAddress()
+	if row.shippingMethod != nil {
+		order.ShippingMethod = *row.shippingMethod
+	}
+	if row.holdReason != nil && row.heldFromStatus != nil && row.heldAt != nil {
+		order.Hold = &domain.OrderHold{
+			Reason:         *row.holdReason,
+			PreviousStatus: domain.OrderStatus(*row.heldFromStatus),
+			HeldAt:         *row.heldAt,
+			ReleaseAt:      row.holdUntil,
+		}
+	}
+	return &order
+}
+
+// holdColumns returns the hold_reason, held_from_status, held_at and
+// hold_until values for order, all nil when it is not on hold
+func holdColumns(order *domain.Order) (reason, heldFrom *string, heldAt, until *time.Time) {
+	if order.Hold == nil {
+		return nil, nil, nil, nil
+	}
+	from := string(order.Hold.PreviousStatus)
+	return &order.Hold.Reason, &from, &order.Hold.HeldAt, order.Hold.ReleaseAt
+}
+
+// labelColumns returns the metadata and tags values for order, empty rather
+// than nil so they are stored as {} and [] instead of NULL
+func labelColumns(order *domain.Order) (map[string]string, []string) {
+	metadata, tags := order.Metadata, order.Tags
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	if tags == nil {
+		tags = []string{}
+	}
+	return metadata, tags
+}
 
-	// Get orders
-	rows, err := r.pool.Query(ctx, query, args...)
+// addressColumns returns the shipping_address and billing_address values for
+// order, nil for an address it does not have
+func addressColumns(order *domain.Order) (shipping, billing *addressRecord) {
+	return toAddressRecord(order.ShippingAddress), toAddressRecord(order.BillingAddress)
+}
+
+// nullString returns nil for an empty string so the column is stored as NULL
+func nullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// loadItems fetches the items of all given orders in one query
+func loadItems(ctx context.Context, q querier, orders []*domain.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(orders))
+	byID := make(map[uuid.UUID]*domain.Order, len(orders))
+	for i, order := range orders {
+		ids[i] = order.ID
+		byID[order.ID] = order
+		order.Items = []domain.OrderItem{}
+	}
+
+	query := `
+		SELECT order_id, id, product_id, name, quantity, price, subtotal, status
+		FROM order_items
+		WHERE order_id = ANY($1)
+		ORDER BY order_id, position
+	`
+
+	rows, err := q.Query(ctx, query, ids)
 	if err != nil {
-		return nil, 0, err
+		return err
 	}
 	defer rows.Close()
 
-	var orders []*domain.Order
 	for rows.Next() {
-		var order domain.Order
-		var itemsJSON []byte
+		var orderID uuid.UUID
+		var item domain.OrderItem
 
 		err := rows.Scan(
-			&order.ID,
-			&order.CustomerID,
-			&itemsJSON,
-			&order.Status,
-			&order.Total,
-			&order.Version,
-			&order.CreatedAt,
-			&order.UpdatedAt,
-			&order.DeletedAt,
+			&orderID,
+			&item.ID,
+			&item.ProductID,
+			&item.Name,
+			&item.Quantity,
+			&item.Price,
+			&item.Subtotal,
+			&item.Status,
 		)
 		if err != nil {
-			return nil, 0, err
+			return err
 		}
 
-		if err := json.Unmarshal(itemsJSON, &order.Items); err != nil {
-			return nil, 0, err
+		if order, ok := byID[orderID]; ok {
+			order.Items = append(order.Items, item)
 		}
+	}
 
-		orders = append(orders, &order)
+	return rows.Err()
+}
+
+// insertOrder writes a new order with its items and creation history entry
+func insertOrder(ctx context.Context, tx pgx.Tx, order *domain.Order) error {
+	query := `
+		INSERT INTO orders (id, customer_id, status, total, version, created_at, updated_at, metadata, tags, shipping_address, billing_address, shipping_method, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+
+	metadata, tags := labelColumns(order)
+	shipping, billing := addressColumns(order)
+	_, err := tx.Exec(ctx, query,
+		order.ID,
+		order.CustomerID,
+		order.Status,
+		order.Total,
+		order.Version,
+		order.CreatedAt,
+		order.UpdatedAt,
+		metadata,
+		tags,
+		shipping,
+		billing,
+		nullString(order.ShippingMethod),
+		order.TenantID,
+	)
+	if err != nil {
+		return err
+	}
+	if err := insertItems(ctx, tx, order.ID, order.Items); err != nil {
+		return err
 	}
+	return insertHistory(ctx, tx, domain.HistoryActionCreated, nil, order)
+}
 
-	if err := rows.Err(); err != nil {
-		return nil, 0, err
+// copyOrders writes new orders with their items and creation history entries
+// using one COPY per table
+func copyOrders(ctx context.Context, tx pgx.Tx, orders []*domain.Order) error {
+	_, err := tx.CopyFrom(ctx, pgx.Identifier{"orders"},
+		[]string{"id", "customer_id", "status", "total", "version", "created_at", "updated_at", "metadata", "tags", "shipping_address", "billing_address", "shipping_method", "tenant_id"},
+		pgx.CopyFromSlice(len(orders), func(i int) ([]any, error) {
+			o := orders[i]
+			metadata, tags := labelColumns(o)
+			shipping, billing := addressColumns(o)
+			return []any{o.ID, o.CustomerID, string(o.Status), o.Total, o.Version, o.CreatedAt, o.UpdatedAt, metadata, tags, shipping, billing, nullString(o.ShippingMethod), o.TenantID}, nil
+		}),
+	)
+	if err != nil {
+		return err
 	}
 
-	return orders, totalCount, nil
+	var items [][]any
+	for _, o := range orders {
+		for i, item := range o.Items {
+			items = append(items, []any{item.ID, o.ID, i, item.ProductID, item.Name, item.Quantity, item.Price, item.Subtotal, string(itemStatus(item))})
+		}
+	}
+	_, err = tx.CopyFrom(ctx, pgx.Identifier{"order_items"},
+		[]string{"id", "order_id", "position", "product_id", "name", "quantity", "price", "subtotal", "status"},
+		pgx.CopyFromRows(items),
+	)
+	if err != nil {
+		return err
+	}
+
+	actor, actorType, channel := domain.ActorFromContext(ctx), domain.ActorTypeFromContext(ctx), domain.ChannelFromContext(ctx)
+	now := time.Now()
+	history := make([][]any, len(orders))
+	for i, o := range orders {
+		snapshot, err := encodeSnapshot(o)
+		if err != nil {
+			return err
+		}
+		history[i] = []any{uuid.New(), o.ID, string(domain.HistoryActionCreated), actor, string(actorType), string(channel), nil, snapshot, now}
+	}
+	_, err = tx.CopyFrom(ctx, pgx.Identifier{"order_history"},
+		[]string{"id", "order_id", "action", "actor", "actor_type", "channel", "old_state", "new_state", "created_at"},
+		pgx.CopyFromRows(history),
+	)
+	return err
+}
+
+// insertItems writes an order's items in one round trip
+func insertItems(ctx context.Context, tx pgx.Tx, orderID uuid.UUID, items []domain.OrderItem) error {
+	query := `
+		INSERT INTO order_items (id, order_id, position, product_id, name, quantity, price, subtotal, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	batch := &pgx.Batch{}
+	for i, item := range items {
+		batch.Queue(query,
+			item.ID,
+			orderID,
+			i,
+			item.ProductID,
+			item.Name,
+			item.Quantity,
+			item.Price,
+			item.Subtotal,
+			itemStatus(item),
+		)
+	}
+	return tx.SendBatch(ctx, batch).Close()
+}
+
+// itemStatus is the stored status of item; an unset status is pending
+func itemStatus(item domain.OrderItem) domain.ItemStatus {
+	if item.Status == "" {
+		return domain.ItemStatusPending
+	}
+	return item.Status
 }
 
-// orderExists checks if an order exists (including deleted ones for version conflict detection)
+// orderExists checks if an order of the tenant in ctx exists (including
+// deleted ones for version conflict detection)
 func (r *orderRepositoryPostgres) orderExists(ctx context.Context, id string) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM orders WHERE id = $1)`
+	query := `SELECT EXISTS(SELECT 1 FROM orders WHERE id = $1 AND ` + tenantMatch("$2") + `)`
 	var exists bool
-	err := r.pool.QueryRow(ctx, query, id).Scan(&exists)
+	err := conn(ctx, r.pool).QueryRow(ctx, query, id, domain.TenantID(ctx)).Scan(&exists)
 	return exists, err
 }
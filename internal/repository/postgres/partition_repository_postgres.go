@@ -0,0 +1,86 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// partitionRepositoryPostgres implements PartitionRepository using the SQL
+// functions from migration 000010
+type partitionRepositoryPostgres struct {
+	pool *pgxpool.Pool
+}
+
+// NewPartitionRepository creates a new PostgreSQL partition repository
+func NewPartitionRepository(pool *pgxpool.Pool) repository.PartitionRepository {
+	return &partitionRepositoryPostgres{
+		pool: pool,
+	}
+}
+
+func (r *partitionRepositoryPostgres) IsPartitioned(ctx context.Context) (bool, error) {
+	var partitioned bool
+	err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM pg_partitioned_table WHERE partrelid = 'orders'::regclass)
+	`).Scan(&partitioned)
+	return partitioned, err
+}
+
+// PartitionOrders copies every order and rebuilds the indexes, so it runs
+// without the statement timeout. Indexes added after migration 000010 are
+// not known to partition_orders_table and are recreated here.
+func (r *partitionRepositoryPostgres) PartitionOrders(ctx context.Context, monthsAhead int) (int, error) {
+	var created int
+	err := withoutStatementTimeout(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := tx.QueryRow(ctx, `SELECT partition_orders_table($1)`, monthsAhead).Scan(&created); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, `CREATE INDEX IF NOT EXISTS idx_orders_tags ON orders USING GIN(tags jsonb_path_ops) WHERE deleted_at IS NULL`); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, `CREATE INDEX IF NOT EXISTS idx_orders_tenant_created ON orders(tenant_id, created_at DESC) WHERE deleted_at IS NULL`); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, `
+			CREATE INDEX IF NOT EXISTS idx_orders_sla_due ON orders(estimated_delivery_at)
+			WHERE sla_breached_at IS NULL AND status NOT IN ('delivered', 'cancelled') AND deleted_at IS NULL
+		`)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return created, nil
+}
+
+func (r *partitionRepositoryPostgres) EnsurePartitions(ctx context.Context, from time.Time, monthsAhead int) ([]string, error) {
+	// create_orders_partition returns NULL for partitions that already exist
+	rows, err := r.pool.Query(ctx, `
+		SELECT created
+		FROM generate_series(0, $2::int) AS m,
+		     create_orders_partition((($1::timestamptz AT TIME ZONE 'UTC') + make_interval(months => m))::date) AS created
+		WHERE created IS NOT NULL
+	`, from, monthsAhead)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
@@ -0,0 +1,40 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolCollector_ExportsPoolStats(t *testing.T) {
+	collector := NewPoolCollector(newUnreachablePool(t), "replica")
+
+	expected := `
+# HELP db_pool_acquired_connections Connections currently in use.
+# TYPE db_pool_acquired_connections gauge
+db_pool_acquired_connections{pool="replica"} 0
+# HELP db_pool_max_connections Most connections the pool may open.
+# TYPE db_pool_max_connections gauge
+db_pool_max_connections{pool="replica"} 4
+`
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected),
+		"db_pool_acquired_connections", "db_pool_max_connections"))
+	assert.Equal(t, 8, testutil.CollectAndCount(collector))
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// queryBuilder collects the AND-ed conditions of a WHERE clause and their
+// arguments. Placeholders are numbered as arguments are added, so any
+// combination of optional filters gets consecutive $n parameters.
+type queryBuilder struct {
+	conds []string
+	args  []interface{}
+}
+
+// newQueryBuilder starts a query whose first arguments are fixed, for SQL
+// that refers to them as $1, $2, ... directly.
+func newQueryBuilder(args ...interface{}) *queryBuilder {
+	return &queryBuilder{args: args}
+}
+
+// arg adds an argument and returns its placeholder
+func (b *queryBuilder) arg(v interface{}) string {
+	b.args = append(b.args, v)
+	return "$" + strconv.Itoa(len(b.args))
+}
+
+// and adds a condition, typically built with placeholders from arg
+func (b *queryBuilder) and(cond string) {
+	b.conds = append(b.conds, cond)
+}
+
+// where returns the WHERE clause, or "" when there are no conditions
+func (b *queryBuilder) where() string {
+	if len(b.conds) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(b.conds, " AND ")
+}
+
+// page appends ORDER BY, LIMIT and OFFSET to query. It consumes two
+// arguments, so build any count query sharing the WHERE clause first.
+func (b *queryBuilder) page(query, orderBy string, limit, offset int) string {
+	return query + " ORDER BY " + orderBy + " LIMIT " + b.arg(limit) + " OFFSET " + b.arg(offset)
+}
+
+// scopeTenant restricts qb to orders of the tenant in ctx, if any
+func scopeTenant(ctx context.Context, qb *queryBuilder) {
+	if tenantID, ok := domain.TenantFromContext(ctx); ok {
+		qb.and("tenant_id = " + qb.arg(tenantID))
+	}
+}
+
+// tenantMatch is a condition on the tenant bound to placeholder for queries
+// with fixed placeholders. Binding "" (no tenant in ctx) matches every order.
+func tenantMatch(placeholder string) string {
+	return "(" + placeholder + " = '' OR tenant_id = " + placeholder + ")"
+}
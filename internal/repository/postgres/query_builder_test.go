@@ -0,0 +1,63 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryBuilder_NoConditions_EmptyWhere(t *testing.T) {
+	qb := newQueryBuilder()
+
+	assert.Equal(t, "", qb.where())
+	assert.Empty(t, qb.args)
+}
+
+func TestQueryBuilder_FixedArgs_NumberingContinues(t *testing.T) {
+	qb := newQueryBuilder("q", "prefix%")
+	qb.and("name % $1")
+	qb.and("status = " + qb.arg("pending"))
+
+	query := qb.page("SELECT id FROM orders"+qb.where(), "created_at DESC", 20, 40)
+
+	assert.Equal(t, "SELECT id FROM orders WHERE name % $1 AND status = $3 ORDER BY created_at DESC LIMIT $4 OFFSET $5", query)
+	assert.Equal(t, []interface{}{"q", "prefix%", "pending", 20, 40}, qb.args)
+}
+
+func TestQueryBuilder_MoreThanNineArgs_MultiDigitPlaceholders(t *testing.T) {
+	qb := newQueryBuilder()
+	for i := 1; i <= 11; i++ {
+		qb.and(fmt.Sprintf("c%d = %s", i, qb.arg(i)))
+	}
+
+	where := qb.where()
+
+	assert.Contains(t, where, "c9 = $9 AND c10 = $10 AND c11 = $11")
+	assert.Len(t, qb.args, 11)
+}
+
+func TestQueryBuilder_Page_CountArgsUnaffected(t *testing.T) {
+	qb := newQueryBuilder()
+	qb.and("customer_id = " + qb.arg("c-1"))
+	countArgs := qb.args
+
+	qb.page("SELECT id FROM orders"+qb.where(), "created_at DESC", 10, 0)
+
+	assert.Equal(t, []interface{}{"c-1"}, countArgs)
+	assert.Equal(t, []interface{}{"c-1", 10, 0}, qb.args)
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetStatementTimeout(t *testing.T) {
+	cfg, err := pgxpool.ParseConfig("postgres://postgres@localhost:5432/ordersvc")
+	require.NoError(t, err)
+
+	SetStatementTimeout(cfg, 0)
+	assert.NotContains(t, cfg.ConnConfig.RuntimeParams, "statement_timeout")
+
+	SetStatementTimeout(cfg, 2500*time.Millisecond)
+	assert.Equal(t, "2500", cfg.ConnConfig.RuntimeParams["statement_timeout"])
+}
+
+func TestWithQueryTimeout(t *testing.T) {
+	ctx, cancel := withQueryTimeout(context.Background(), 0)
+	defer cancel()
+	_, ok := ctx.Deadline()
+	assert.False(t, ok, "zero keeps the caller's context")
+
+	ctx, cancel = withQueryTimeout(context.Background(), time.Minute)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+}
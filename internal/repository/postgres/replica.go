@@ -0,0 +1,120 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/breaker"
+)
+
+const (
+	// replicaFailureThreshold is how many consecutive connection failures
+	// send reads back to the primary
+	replicaFailureThreshold = 3
+	// replicaCooldown is how long reads stay on the primary before the
+	// replica is tried again
+	replicaCooldown = 10 * time.Second
+)
+
+// Replica is a read replica that read-only repository methods query instead
+// of the primary. When the replica cannot be reached the read is retried on
+// the primary, and after replicaFailureThreshold consecutive failures reads
+// go straight to the primary until replicaCooldown has passed.
+//
+// Replicas lag behind the primary, so a read right after a write may not
+// see it yet.
+type Replica struct {
+	pool    *pgxpool.Pool
+	breaker *breaker.Breaker
+}
+
+// NewReplica wraps the connection pool of a read replica
+func NewReplica(pool *pgxpool.Pool) *Replica {
+	return &Replica{
+		pool: pool,
+		breaker: breaker.New(breaker.Config{
+			FailureThreshold: replicaFailureThreshold,
+			Cooldown:         replicaCooldown,
+		}),
+	}
+}
+
+// read runs fn on the replica, or on primary when there is no replica, ctx
+// holds a unit of work's transaction or the replica is marked down. A
+// replica that cannot be reached is recorded and fn runs again on primary.
+func (r *Replica) read(ctx context.Context, primary *pgxpool.Pool, fn func(q querier) error) error {
+	if _, inTx := ctx.Value(txKey{}).(pgx.Tx); r == nil || inTx || !r.breaker.Allow() {
+		return fn(conn(ctx, primary))
+	}
+
+	err := fn(r.pool)
+	switch {
+	case err == nil:
+		if r.breaker.Success() {
+			slog.InfoContext(ctx, "read replica recovered")
+		}
+		return nil
+	case ctx.Err() != nil:
+		r.breaker.Abandon()
+		return err
+	case !replicaUnavailable(err):
+		// The replica answered, so the error is about the query itself
+		r.breaker.Success()
+		return err
+	}
+
+	if r.breaker.Failure() {
+		slog.WarnContext(ctx, "read replica unavailable, reading from primary",
+			slog.Duration("cooldown", replicaCooldown),
+			slog.String("error", err.Error()),
+		)
+	}
+	return fn(primary)
+}
+
+// replicaUnavailable reports whether err means the replica could not serve
+// the query at all: a connection or network error, a server shutting down or
+// refusing connections (class 57), or a query canceled by recovery (40001).
+func replicaUnavailable(err error) bool {
+	var (
+		pgErr      *pgconn.PgError
+		connectErr *pgconn.ConnectError
+		netErr     net.Error
+	)
+	switch {
+	case errors.As(err, &pgErr):
+		return strings.HasPrefix(pgErr.Code, "57") || pgErr.Code == "40001"
+	case errors.As(err, &connectErr), errors.As(err, &netErr),
+		errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return true
+	}
+	return pgconn.SafeToRetry(err)
+}
+
+// Close closes the replica's connection pool
+func (r *Replica) Close() {
+	r.pool.Close()
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/breaker"
+)
+
+func TestReplicaUnavailable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"connect error", &pgconn.ConnectError{}, true},
+		{"wrapped connect error", fmt.Errorf("find order: %w", &pgconn.ConnectError{}), true},
+		{"server shutting down", &pgconn.PgError{Code: "57P01"}, true},
+		{"cannot connect now", &pgconn.PgError{Code: "57P03"}, true},
+		{"canceled by recovery", &pgconn.PgError{Code: "40001"}, true},
+		{"undefined column", &pgconn.PgError{Code: "42703"}, false},
+		{"other error", errors.New("cannot scan"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, replicaUnavailable(tt.err))
+		})
+	}
+}
+
+func TestReplica_Read_NilReplica_UsesPrimary(t *testing.T) {
+	primary := newUnreachablePool(t)
+
+	var r *Replica
+	var got []querier
+	err := r.read(context.Background(), primary, func(q querier) error {
+		got = append(got, q)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []querier{primary}, got)
+}
+
+func TestReplica_Read_Unreachable_FallsBackThenSkipsReplica(t *testing.T) {
+	primary := newUnreachablePool(t)
+	replica := NewReplica(newUnreachablePool(t))
+
+	var got []querier
+	read := func() error {
+		return replica.read(context.Background(), primary, func(q querier) error {
+			got = append(got, q)
+			if q == querier(replica.pool) {
+				var one int
+				return q.QueryRow(context.Background(), "SELECT 1").Scan(&one)
+			}
+			return nil
+		})
+	}
+
+	for i := 0; i < replicaFailureThreshold; i++ {
+		require.NoError(t, read())
+	}
+	assert.Len(t, got, 2*replicaFailureThreshold, "each read tries the replica, then the primary")
+	assert.Equal(t, breaker.Open, replica.breaker.State())
+
+	got = nil
+	require.NoError(t, read())
+	assert.Equal(t, []querier{primary}, got, "open breaker reads from the primary only")
+}
+
+func TestReplica_Read_QueryError_NotRetried(t *testing.T) {
+	primary := newUnreachablePool(t)
+	replica := NewReplica(newUnreachablePool(t))
+	queryErr := &pgconn.PgError{Code: "42703"}
+
+	calls := 0
+	err := replica.read(context.Background(), primary, func(_ querier) error {
+		calls++
+		return queryErr
+	})
+
+	assert.ErrorIs(t, err, queryErr)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, breaker.Closed, replica.breaker.State())
+}
+
+// newUnreachablePool returns a pool whose connections are refused. Creating
+// it does not connect, so only queries on it fail.
+func newUnreachablePool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	pool, err := pgxpool.New(context.Background(), "postgres://postgres@127.0.0.1:1/ordersvc?connect_timeout=1&pool_max_conns=4")
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
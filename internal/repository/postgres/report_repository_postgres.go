@@ -0,0 +1,159 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// reportSource describes a relation report queries aggregate over
+type reportSource struct {
+	table      string
+	timeColumn string
+	where      string
+	count      string
+	revenue    string
+}
+
+var (
+	// ordersSource aggregates live orders directly
+	ordersSource = reportSource{
+		table:      "orders",
+		timeColumn: "created_at",
+		where:      "deleted_at IS NULL",
+		count:      "COUNT(*)",
+		revenue:    "COALESCE(SUM(total), 0)",
+	}
+
+	// dailyTotalsSource aggregates the order_daily_totals materialized view.
+	// It has one row per day and status, so time bounds apply at day granularity.
+	dailyTotalsSource = reportSource{
+		table:      "order_daily_totals",
+		timeColumn: "day",
+		where:      "TRUE",
+		count:      "COALESCE(SUM(order_count), 0)::BIGINT",
+		revenue:    "COALESCE(SUM(revenue), 0)",
+	}
+)
+
+// reportRepositoryPostgres implements ReportRepository using PostgreSQL
+type reportRepositoryPostgres struct {
+	pool     *pgxpool.Pool
+	useViews bool
+}
+
+// NewReportRepository creates a new PostgreSQL report repository.
+// If useViews is set, period and status reports read the order_daily_totals
+// materialized view, which is only as fresh as its last refresh. Customer
+// reports, and reports scoped to a tenant, always read the orders table.
+func NewReportRepository(pool *pgxpool.Pool, useViews bool) repository.ReportRepository {
+	return &reportRepositoryPostgres{
+		pool:     pool,
+		useViews: useViews,
+	}
+}
+
+func (r *reportRepositoryPostgres) AggregateOrders(ctx context.Context, opts repository.ReportOptions) ([]domain.OrderReportRow, error) {
+	// The view sums every tenant, so a tenant's reports read the orders table
+	_, scoped := domain.TenantFromContext(ctx)
+	src := ordersSource
+	if r.useViews && opts.GroupBy != domain.ReportGroupByCustomer && !scoped {
+		src = dailyTotalsSource
+	}
+
+	var bucket string
+	switch {
+	case opts.GroupBy.IsPeriod():
+		column := src.timeColumn
+		if src.timeColumn == "created_at" {
+			column = "created_at AT TIME ZONE 'UTC'"
+		}
+		bucket = `to_char(date_trunc('` + string(opts.GroupBy) + `', ` + column + `), 'YYYY-MM-DD')`
+	case opts.GroupBy == domain.ReportGroupByStatus:
+		bucket = "status"
+	case opts.GroupBy == domain.ReportGroupByCustomer:
+		bucket = "customer_id"
+	default:
+		return nil, domain.ErrInvalidGroupBy
+	}
+
+	qb := newQueryBuilder()
+	qb.and(src.where)
+	if src == ordersSource {
+		scopeTenant(ctx, qb)
+	}
+
+	if opts.From != nil {
+		qb.and(src.timeColumn + ` >= ` + reportTimeArg(src, qb.arg(*opts.From)))
+	}
+
+	if opts.To != nil {
+		qb.and(src.timeColumn + ` < ` + reportTimeArg(src, qb.arg(*opts.To)))
+	}
+
+	if opts.Status != nil {
+		qb.and(`status = ` + qb.arg(*opts.Status))
+	}
+
+	query := `SELECT ` + bucket + ` AS bucket, ` + src.count + `, ` + src.revenue + `
+		FROM ` + src.table + qb.where() + `
+		GROUP BY bucket`
+	if opts.GroupBy == domain.ReportGroupByCustomer {
+		query += ` ORDER BY 3 DESC, bucket LIMIT ` + qb.arg(opts.Limit)
+	} else {
+		query += ` ORDER BY bucket`
+	}
+
+	rows, err := r.pool.Query(ctx, query, qb.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []domain.OrderReportRow{}
+	for rows.Next() {
+		var row domain.OrderReportRow
+		if err := rows.Scan(&row.Key, &row.OrderCount, &row.Revenue); err != nil {
+			return nil, err
+		}
+		result = append(result, row)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *reportRepositoryPostgres) RefreshReportViews(ctx context.Context) error {
+	// CONCURRENTLY keeps the view readable during the refresh; it relies on
+	// the unique index on (day, status). A full refresh may outlast the
+	// statement timeout.
+	return withoutStatementTimeout(ctx, r.pool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `REFRESH MATERIALIZED VIEW CONCURRENTLY order_daily_totals`)
+		return err
+	})
+}
+
+// reportTimeArg returns the placeholder for a time bound, converted to a UTC
+// date when the source is bucketed by day.
+func reportTimeArg(src reportSource, placeholder string) string {
+	if src.timeColumn == "day" {
+		return `(` + placeholder + `::timestamptz AT TIME ZONE 'UTC')::date`
+	}
+	return placeholder
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SlowQueryTracer logs queries that take at least a threshold. It logs the
+// query's fingerprint rather than its arguments, which may hold customer data.
+type SlowQueryTracer struct {
+	threshold time.Duration
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+// NewSlowQueryTracer creates a tracer for pgx.ConnConfig.Tracer that logs
+// queries running threshold or longer to logger, or slog.Default if nil.
+func NewSlowQueryTracer(threshold time.Duration, logger *slog.Logger) *SlowQueryTracer {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SlowQueryTracer{threshold: threshold, logger: logger, now: time.Now}
+}
+
+// queryTraceKey is the context key of the query being traced
+type queryTraceKey struct{}
+
+type queryTrace struct {
+	sql   string
+	start time.Time
+}
+
+// TraceQueryStart implements pgx.QueryTracer
+func (t *SlowQueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryTraceKey{}, queryTrace{sql: data.SQL, start: t.now()})
+}
+
+// TraceQueryEnd implements pgx.QueryTracer. For Query it runs when the rows
+// are closed, so the duration includes reading them.
+func (t *SlowQueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	trace, ok := ctx.Value(queryTraceKey{}).(queryTrace)
+	if !ok {
+		return
+	}
+	elapsed := t.now().Sub(trace.start)
+	if elapsed < t.threshold {
+		return
+	}
+
+	attrs := []any{
+		slog.String("query", fingerprint(trace.sql)),
+		slog.Duration("duration", elapsed),
+	}
+	if data.Err != nil {
+		attrs = append(attrs, slog.String("error", data.Err.Error()))
+	}
+	t.logger.WarnContext(ctx, "slow query", attrs...)
+}
+
+var (
+	stringLiteral  = regexp.MustCompile(`'(?:[^']|'')*'`)
+	numericLiteral = regexp.MustCompile(`\$?\b\d+(?:\.\d+)?\b`)
+)
+
+// fingerprint reduces a statement to its shape: whitespace is collapsed and
+// literals are replaced with ?, so runs of the same query log the same text.
+// Placeholders such as $1 are kept.
+func fingerprint(sql string) string {
+	sql = stringLiteral.ReplaceAllString(sql, "?")
+	sql = numericLiteral.ReplaceAllStringFunc(sql, func(n string) string {
+		if strings.HasPrefix(n, "$") {
+			return n
+		}
+		return "?"
+	})
+	return strings.Join(strings.Fields(sql), " ")
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFingerprint(t *testing.T) {
+	tests := []struct {
+		name string
+		sql  string
+		want string
+	}{
+		{
+			name: "whitespace collapsed",
+			sql:  "\n\t\tSELECT id\n\t\tFROM orders\n\t\tWHERE id = $1\n\t",
+			want: "SELECT id FROM orders WHERE id = $1",
+		},
+		{
+			name: "literals replaced",
+			sql:  "SELECT id FROM orders WHERE status = 'pending' AND total > 10.5 LIMIT 20",
+			want: "SELECT id FROM orders WHERE status = ? AND total > ? LIMIT ?",
+		},
+		{
+			name: "escaped quote inside literal",
+			sql:  "SELECT 1 FROM orders WHERE customer_id = 'o''brien'",
+			want: "SELECT ? FROM orders WHERE customer_id = ?",
+		},
+		{
+			name: "placeholders and identifiers kept",
+			sql:  "SELECT COUNT(*) OVER () FROM order_items2 WHERE order_id = ANY($12)",
+			want: "SELECT COUNT(*) OVER () FROM order_items2 WHERE order_id = ANY($12)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, fingerprint(tt.sql))
+		})
+	}
+}
+
+func TestSlowQueryTracer_LogsOnlyQueriesOverThreshold(t *testing.T) {
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		err     error
+		wantLog bool
+	}{
+		{name: "fast query", elapsed: 99 * time.Millisecond},
+		{name: "at threshold", elapsed: 100 * time.Millisecond, wantLog: true},
+		{name: "slow failed query", elapsed: time.Second, err: errors.New("canceling statement"), wantLog: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			tracer := NewSlowQueryTracer(100*time.Millisecond, slog.New(slog.NewJSONHandler(&buf, nil)))
+			now := time.Now()
+			tracer.now = func() time.Time { return now }
+
+			ctx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{
+				SQL:  "SELECT id FROM orders WHERE customer_id = $1 AND status = 'pending'",
+				Args: []any{"customer-secret"},
+			})
+			now = now.Add(tt.elapsed)
+			tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{Err: tt.err})
+
+			if !tt.wantLog {
+				assert.Empty(t, buf.String())
+				return
+			}
+
+			var entry map[string]any
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+			assert.Equal(t, "slow query", entry["msg"])
+			assert.Equal(t, "SELECT id FROM orders WHERE customer_id = $1 AND status = ?", entry["query"])
+			assert.Equal(t, float64(tt.elapsed), entry["duration"])
+			assert.NotContains(t, buf.String(), "customer-secret", "arguments are not logged")
+			if tt.err != nil {
+				assert.Equal(t, tt.err.Error(), entry["error"])
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// subscriptionColumns are the subscriptions columns scanned by scanSubscription, in order
+const subscriptionColumns = `id, customer_id, items, cadence, status, next_run_at, shipping_method, shipping_address, tenant_id, version, created_at, updated_at`
+
+// subscriptionRepositoryPostgres implements SubscriptionRepository using PostgreSQL
+type subscriptionRepositoryPostgres struct {
+	pool *pgxpool.Pool
+}
+
+// NewSubscriptionRepository creates a new PostgreSQL subscription repository
+func NewSubscriptionRepository(pool *pgxpool.Pool) repository.SubscriptionRepository {
+	return &subscriptionRepositoryPostgres{
+		pool: pool,
+	}
+}
+
+// subscriptionItem is the JSON form of a template line in the items column
+type subscriptionItem struct {
+	ProductID string  `json:"product_id"`
+	Name      string  `json:"name"`
+	Quantity  int     `json:"quantity"`
+	Price     float64 `json:"price"`
+}
+
+func toSubscriptionItems(items []domain.OrderItem) []subscriptionItem {
+	out := make([]subscriptionItem, len(items))
+	for i, item := range items {
+		out[i] = subscriptionItem{
+			ProductID: item.ProductID,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			Price:     item.Price,
+		}
+	}
+	return out
+}
+
+func (r *subscriptionRepositoryPostgres) Create(ctx context.Context, sub *domain.Subscription) error {
+	query := `
+		INSERT INTO subscriptions (` + subscriptionColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, 1, $10, $11)
+	`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, query,
+		sub.ID,
+		sub.CustomerID,
+		toSubscriptionItems(sub.Items),
+		sub.Cadence,
+		sub.Status,
+		sub.NextRunAt,
+		nullString(sub.ShippingMethod),
+		toAddressRecord(sub.ShippingAddress),
+		sub.TenantID,
+		sub.CreatedAt,
+		sub.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	sub.Version = 1
+	return nil
+}
+
+func (r *subscriptionRepositoryPostgres) FindByID(ctx context.Context, id string) (*domain.Subscription, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT `+subscriptionColumns+`
+		FROM subscriptions
+		WHERE id = $1 AND `+tenantMatch("$2")+`
+	`, id, domain.TenantID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	subs, err := scanSubscriptions(rows)
+	if err != nil || len(subs) == 0 {
+		return nil, err
+	}
+	return subs[0], nil
+}
+
+func (r *subscriptionRepositoryPostgres) List(ctx context.Context, customerID string, limit, offset int) ([]*domain.Subscription, int64, error) {
+	var total int64
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT COUNT(*) FROM subscriptions
+		WHERE ($1 = '' OR customer_id = $1) AND `+tenantMatch("$2")+`
+	`, customerID, domain.TenantID(ctx)).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT `+subscriptionColumns+`
+		FROM subscriptions
+		WHERE ($1 = '' OR customer_id = $1) AND `+tenantMatch("$4")+`
+		ORDER BY created_at, id
+		LIMIT $2 OFFSET $3
+	`, customerID, limit, offset, domain.TenantID(ctx))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	subs, err := scanSubscriptions(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return subs, total, nil
+}
+
+func (r *subscriptionRepositoryPostgres) Update(ctx context.Context, sub *domain.Subscription) error {
+	query := `
+		UPDATE subscriptions
+		SET items = $1,
+		    cadence = $2,
+		    status = $3,
+		    next_run_at = $4,
+		    shipping_method = $5,
+		    shipping_address = $6,
+		    version = version + 1,
+		    updated_at = $7
+		WHERE id = $8 AND version = $9 AND ` + tenantMatch("$10") + `
+	`
+
+	result, err := conn(ctx, r.pool).Exec(ctx, query,
+		toSubscriptionItems(sub.Items),
+		sub.Cadence,
+		sub.Status,
+		sub.NextRunAt,
+		nullString(sub.ShippingMethod),
+		toAddressRecord(sub.ShippingAddress),
+		sub.UpdatedAt,
+		sub.ID,
+		sub.Version,
+		domain.TenantID(ctx),
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		var exists bool
+		err := conn(ctx, r.pool).QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM subscriptions WHERE id = $1 AND `+tenantMatch("$2")+`)`, sub.ID, domain.TenantID(ctx)).Scan(&exists)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return domain.ErrSubscriptionNotFound
+		}
+		return domain.ErrConcurrentModification
+	}
+
+	sub.Version++
+	return nil
+}
+
+func (r *subscriptionRepositoryPostgres) Delete(ctx context.Context, id string) error {
+	result, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM subscriptions WHERE id = $1 AND `+tenantMatch("$2"), id, domain.TenantID(ctx))
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrSubscriptionNotFound
+	}
+	return nil
+}
+
+func (r *subscriptionRepositoryPostgres) ListDue(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	// Covered by idx_subscriptions_due
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT id
+		FROM subscriptions
+		WHERE status = 'active' AND next_run_at <= $1 AND `+tenantMatch("$3")+`
+		ORDER BY next_run_at
+		LIMIT $2
+	`, now, limit, domain.TenantID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return scanIDs(rows)
+}
+
+// scanSubscriptions reads every row of a subscriptionColumns query and closes rows
+func scanSubscriptions(rows pgx.Rows) ([]*domain.Subscription, error) {
+	defer rows.Close()
+
+	subs := []*domain.Subscription{}
+	for rows.Next() {
+		var (
+			sub            domain.Subscription
+			items          []subscriptionItem
+			shippingMethod *string
+			shipping       *addressRecord
+		)
+		err := rows.Scan(
+			&sub.ID,
+			&sub.CustomerID,
+			&items,
+			&sub.Cadence,
+			&sub.Status,
+			&sub.NextRunAt,
+			&shippingMethod,
+			&shipping,
+			&sub.TenantID,
+			&sub.Version,
+			&sub.CreatedAt,
+			&sub.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		sub.Items = make([]domain.OrderItem, len(items))
+		for i, item := range items {
+			sub.Items[i] = domain.OrderItem{
+				ProductID: item.ProductID,
+				Name:      item.Name,
+				Quantity:  item.Quantity,
+				Price:     item.Price,
+			}
+		}
+		if shippingMethod != nil {
+			sub.ShippingMethod = *shippingMethod
+		}
+		sub.ShippingAddress = shipping.toAddress()
+		subs = append(subs, &sub)
+	}
+	return subs, rows.Err()
+}
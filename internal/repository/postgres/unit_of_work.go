@@ -0,0 +1,67 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// dbConn is what repositories run statements on: the pool, or the
+// transaction of a unit of work. Begin on a transaction creates a
+// savepoint, so repository methods that open their own transaction nest
+// inside a unit of work.
+type dbConn interface {
+	querier
+	Begin(ctx context.Context) (pgx.Tx, error)
+	CopyFrom(ctx context.Context, table pgx.Identifier, columns []string, src pgx.CopyFromSource) (int64, error)
+}
+
+var (
+	_ dbConn = (*pgxpool.Pool)(nil)
+	_ dbConn = (pgx.Tx)(nil)
+)
+
+// txKey is the context key of the unit of work's transaction
+type txKey struct{}
+
+// conn returns the transaction of the unit of work in ctx, or pool outside one
+func conn(ctx context.Context, pool *pgxpool.Pool) dbConn {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return pool
+}
+
+// unitOfWork implements UnitOfWork with a pgx transaction carried in the context
+type unitOfWork struct {
+	pool *pgxpool.Pool
+}
+
+// NewUnitOfWork creates a UnitOfWork whose transactions span every
+// repository built on pool
+func NewUnitOfWork(pool *pgxpool.Pool) repository.UnitOfWork {
+	return &unitOfWork{pool: pool}
+}
+
+func (u *unitOfWork) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return pgx.BeginFunc(ctx, conn(ctx, u.pool), func(tx pgx.Tx) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
@@ -0,0 +1,35 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repository
+
+import "context"
+
+// UnitOfWork runs several repository calls atomically
+type UnitOfWork interface {
+	// WithTx runs fn in a transaction. Repository calls made with the context
+	// passed to fn take part in it. The transaction commits if fn returns nil
+	// and rolls back if it returns an error or panics. Nested calls join the
+	// outer transaction.
+	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// NoTx is a UnitOfWork for backends without transactions. It runs fn
+// directly, so a failure part way through leaves earlier writes in place.
+type NoTx struct{}
+
+// WithTx calls fn with ctx
+func (NoTx) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package retry repeats an operation with exponential backoff until it
+// succeeds or a deadline passes. The service uses it to wait for its
+// dependencies at startup, so pods can start before the database does.
+package retry
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Policy configures Do. Delays start at InitialBackoff and double after each
+// failed attempt, capped at MaxBackoff. No attempt starts after Timeout has
+// passed; a zero Timeout makes a single attempt.
+type Policy struct {
+	Timeout        time.Duration
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Do calls fn until it returns nil. onRetry, if not nil, is called before
+// each wait with the attempt that failed, its error and the upcoming delay.
+// It returns the last error once the policy's timeout has passed, or the
+// context's error if ctx is done first.
+func Do(ctx context.Context, p Policy, fn func(ctx context.Context) error, onRetry func(attempt int, err error, delay time.Duration)) error {
+	deadline := time.Now().Add(p.Timeout)
+	delay := p.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil {
+			return nil
+		}
+		if time.Now().Add(delay).After(deadline) {
+			return fmt.Errorf("giving up after %d attempts: %w", attempt, err)
+		}
+		if onRetry != nil {
+			onRetry(attempt, err, delay)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		delay = min(delay*2, p.MaxBackoff)
+	}
+}
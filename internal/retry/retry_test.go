@@ -0,0 +1,83 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errDown = errors.New("connection refused")
+
+func TestDo_SucceedsAfterRetries(t *testing.T) {
+	var delays []time.Duration
+	calls := 0
+	p := Policy{Timeout: time.Second, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+
+	err := Do(context.Background(), p, func(context.Context) error {
+		calls++
+		if calls < 4 {
+			return errDown
+		}
+		return nil
+	}, func(_ int, _ error, delay time.Duration) {
+		delays = append(delays, delay)
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, calls)
+	assert.Equal(t, []time.Duration{time.Millisecond, 2 * time.Millisecond, 2 * time.Millisecond}, delays)
+}
+
+func TestDo_Timeout_ReturnsLastError(t *testing.T) {
+	calls := 0
+	p := Policy{Timeout: 20 * time.Millisecond, InitialBackoff: 5 * time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+
+	err := Do(context.Background(), p, func(context.Context) error {
+		calls++
+		return errDown
+	}, nil)
+
+	require.ErrorIs(t, err, errDown)
+	assert.Greater(t, calls, 1)
+}
+
+func TestDo_ZeroTimeout_SingleAttempt(t *testing.T) {
+	calls := 0
+
+	err := Do(context.Background(), Policy{InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}, func(context.Context) error {
+		calls++
+		return errDown
+	}, nil)
+
+	require.ErrorIs(t, err, errDown)
+	assert.Equal(t, 1, calls)
+}
+
+func TestDo_ContextCanceled_StopsWaiting(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := Policy{Timeout: 2 * time.Hour, InitialBackoff: time.Hour, MaxBackoff: time.Hour}
+
+	err := Do(ctx, p, func(context.Context) error {
+		return errDown
+	}, func(int, error, time.Duration) { cancel() })
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
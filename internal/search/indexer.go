@@ -0,0 +1,152 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package search keeps an external order search index in sync with order events.
+package search
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/correlation"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// reindexBatchSize is the page size used when copying all orders into the index
+const reindexBatchSize = 100
+
+// Index is an external search index of orders
+type Index interface {
+	// IndexOrder adds or replaces an order's document
+	IndexOrder(ctx context.Context, order *domain.Order) error
+	// DeleteOrder removes an order's document
+	DeleteOrder(ctx context.Context, id string) error
+	// DeleteCustomerOrders removes every document of a tenant's customer
+	DeleteCustomerOrders(ctx context.Context, tenantID, customerID string) error
+}
+
+// MessageReader abstracts kafka.Reader for testability
+type MessageReader interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// Indexer consumes order events and updates the search index.
+//
+// Events only say that an order changed, so the indexer reloads the order
+// from the repository and indexes its current state. An order that no longer
+// loads (deleted or purged) is removed from the index. On customer.data_erased
+// all documents of the customer are removed, as they hold the erased data.
+type Indexer struct {
+	reader MessageReader
+	codec  *messaging.SchemaCodec
+	orders repository.OrderRepository
+	index  Index
+}
+
+// NewIndexer creates an indexer reading events from reader. codec decodes
+// the schema registry event formats; nil decodes only JSON.
+func NewIndexer(reader MessageReader, codec *messaging.SchemaCodec, orders repository.OrderRepository, index Index) *Indexer {
+	return &Indexer{
+		reader: reader,
+		codec:  codec,
+		orders: orders,
+		index:  index,
+	}
+}
+
+// Run consumes events until ctx is cancelled, then closes the reader.
+// An event that cannot be applied is logged and skipped.
+func (x *Indexer) Run(ctx context.Context) {
+	defer func() {
+		if err := x.reader.Close(); err != nil {
+			slog.Warn("failed to close search indexer reader", slog.String("error", err.Error()))
+		}
+	}()
+
+	for {
+		msg, err := x.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			slog.Warn("search indexer failed to read event", slog.String("error", err.Error()))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+
+		evt, err := x.codec.Decode(ctx, msg.Value)
+		if err != nil {
+			slog.Warn("search indexer failed to decode event", slog.String("error", err.Error()))
+		} else if err := x.apply(ctx, evt); err != nil && ctx.Err() == nil {
+			slog.WarnContext(correlation.WithID(ctx, evt.CorrelationID), "search indexer failed to apply event",
+				slog.String("event_type", evt.EventType),
+				slog.String("key", evt.Key()),
+				slog.String("error", err.Error()),
+			)
+		}
+
+		if err := x.reader.CommitMessages(ctx, msg); err != nil && ctx.Err() == nil {
+			slog.Warn("search indexer failed to commit offset", slog.String("error", err.Error()))
+		}
+	}
+}
+
+// Reindex copies every live order into the index and returns how many were indexed
+func (x *Indexer) Reindex(ctx context.Context) (int, error) {
+	indexed := 0
+	for offset := 0; ; offset += reindexBatchSize {
+		orders, _, err := x.orders.List(ctx, repository.ListOptions{Limit: reindexBatchSize, Offset: offset})
+		if err != nil {
+			return indexed, err
+		}
+		for _, order := range orders {
+			if err := x.index.IndexOrder(ctx, order); err != nil {
+				return indexed, err
+			}
+			indexed++
+		}
+		if len(orders) < reindexBatchSize {
+			return indexed, nil
+		}
+	}
+}
+
+func (x *Indexer) apply(ctx context.Context, evt messaging.OrderEvent) error {
+	if evt.EventType == messaging.EventCustomerDataErased {
+		return x.index.DeleteCustomerOrders(ctx, evt.TenantID, evt.CustomerID)
+	}
+	if evt.OrderID == "" {
+		return nil
+	}
+
+	order, err := x.orders.FindByID(ctx, evt.OrderID)
+	if err != nil && !errors.Is(err, domain.ErrOrderNotFound) {
+		return err
+	}
+	if order == nil {
+		return x.index.DeleteOrder(ctx, evt.OrderID)
+	}
+	return x.index.IndexOrder(ctx, order)
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingIndex captures index writes for assertions
+type recordingIndex struct {
+	indexed          []string
+	deleted          []string
+	deletedCustomers []string
+}
+
+func (r *recordingIndex) IndexOrder(_ context.Context, order *domain.Order) error {
+	r.indexed = append(r.indexed, order.ID.String())
+	return nil
+}
+
+func (r *recordingIndex) DeleteOrder(_ context.Context, id string) error {
+	r.deleted = append(r.deleted, id)
+	return nil
+}
+
+func (r *recordingIndex) DeleteCustomerOrders(_ context.Context, _, customerID string) error {
+	r.deletedCustomers = append(r.deletedCustomers, customerID)
+	return nil
+}
+
+// sliceReader serves a fixed list of messages, then blocks until ctx is cancelled
+type sliceReader struct {
+	msgs      []kafka.Message
+	committed int
+	cancel    context.CancelFunc
+}
+
+func (s *sliceReader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	if len(s.msgs) == 0 {
+		s.cancel()
+		<-ctx.Done()
+		return kafka.Message{}, ctx.Err()
+	}
+	msg := s.msgs[0]
+	s.msgs = s.msgs[1:]
+	return msg, nil
+}
+
+func (s *sliceReader) CommitMessages(_ context.Context, msgs ...kafka.Message) error {
+	s.committed += len(msgs)
+	return nil
+}
+
+func (s *sliceReader) Close() error { return nil }
+
+func encode(t *testing.T, evt messaging.OrderEvent) kafka.Message {
+	t.Helper()
+	value, err := messaging.EncodeOrderEvent(evt, messaging.EventFormatCloudEvents, "")
+	require.NoError(t, err)
+	return kafka.Message{Value: value}
+}
+
+func TestIndexer_Run_AppliesEvents(t *testing.T) {
+	live := &domain.Order{ID: uuid.New(), CustomerID: "cust-1", Version: 2}
+	deletedID := uuid.New().String()
+
+	orders := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, id string) (*domain.Order, error) {
+			if id == live.ID.String() {
+				return live, nil
+			}
+			return nil, nil
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	reader := &sliceReader{
+		cancel: cancel,
+		msgs: []kafka.Message{
+			encode(t, messaging.NewOrderEvent(messaging.EventOrderUpdated, live)),
+			encode(t, messaging.OrderEvent{EventType: messaging.EventOrderUpdated, OrderID: deletedID}),
+			encode(t, messaging.OrderEvent{EventType: messaging.EventCustomerDataErased, CustomerID: "cust-9"}),
+			{Value: []byte("not an event")},
+		},
+	}
+	index := &recordingIndex{}
+
+	NewIndexer(reader, nil, orders, index).Run(ctx)
+
+	assert.Equal(t, []string{live.ID.String()}, index.indexed)
+	assert.Equal(t, []string{deletedID}, index.deleted)
+	assert.Equal(t, []string{"cust-9"}, index.deletedCustomers)
+	assert.Equal(t, 4, reader.committed, "undecodable events are skipped, not retried forever")
+}
+
+func TestIndexer_Reindex_PagesThroughOrders(t *testing.T) {
+	var offsets []int
+	orders := &mocks.OrderRepositoryMock{
+		ListFunc: func(_ context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+			offsets = append(offsets, opts.Offset)
+			n := opts.Limit
+			if opts.Offset > 0 {
+				n = 3
+			}
+			page := make([]*domain.Order, n)
+			for i := range page {
+				page[i] = &domain.Order{ID: uuid.New()}
+			}
+			return page, 0, nil
+		},
+	}
+	index := &recordingIndex{}
+
+	indexed, err := NewIndexer(nil, nil, orders, index).Reindex(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, reindexBatchSize+3, indexed)
+	assert.Equal(t, []int{0, reindexBatchSize}, offsets)
+	assert.Len(t, index.indexed, reindexBatchSize+3)
+}
@@ -0,0 +1,458 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package opensearch implements an order search index on OpenSearch (or
+// Elasticsearch) using its REST API.
+package opensearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// Config holds OpenSearch connection settings
+type Config struct {
+	// URL is the cluster endpoint, e.g. http://localhost:9200
+	URL string
+	// Index is the name of the order index
+	Index    string
+	Username string
+	Password string
+	// Timeout bounds each request; defaults to 10s
+	Timeout time.Duration
+}
+
+// Index is an OpenSearch index of orders. It implements
+// repository.OrderSearcher and search.Index.
+//
+// Documents are written with external versioning on the order version, so a
+// stale write never replaces a newer document.
+type Index struct {
+	baseURL  string
+	index    string
+	username string
+	password string
+	client   *http.Client
+}
+
+// NewIndex creates an OpenSearch order index client
+func NewIndex(cfg Config) *Index {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &Index{
+		baseURL:  strings.TrimRight(cfg.URL, "/"),
+		index:    cfg.Index,
+		username: cfg.Username,
+		password: cfg.Password,
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+// indexMapping declares the document fields. customer_id is a keyword for
+// exact filtering with a text subfield for fuzzy matching. metadata is stored
+// but not indexed, so arbitrary keys cannot grow the mapping; addresses are
+// stored only so search results carry them.
+const indexMapping = `{
+  "mappings": {
+    "properties": {
+      "id":          {"type": "keyword"},
+      "tenant_id":   {"type": "keyword"},
+      "customer_id": {"type": "keyword", "fields": {"text": {"type": "text"}}},
+      "status":      {"type": "keyword"},
+      "total":       {"type": "double"},
+      "version":     {"type": "integer"},
+      "created_at":  {"type": "date"},
+      "updated_at":  {"type": "date"},
+      "tags":        {"type": "keyword"},
+      "metadata":    {"type": "object", "enabled": false},
+      "shipping_address": {"type": "object", "enabled": false},
+      "billing_address":  {"type": "object", "enabled": false},
+      "shipping_method":       {"type": "keyword"},
+      "estimated_delivery_at": {"type": "date"},
+      "sla_breached_at":       {"type": "date"},
+      "items": {
+        "properties": {
+          "id":         {"type": "keyword"},
+          "product_id": {"type": "keyword"},
+          "name":       {"type": "text"},
+          "quantity":   {"type": "integer"},
+          "price":      {"type": "double"},
+          "subtotal":   {"type": "double"},
+          "status":     {"type": "keyword"}
+        }
+      }
+    }
+  }
+}`
+
+// EnsureIndex creates the index if it does not exist and reports whether it did
+func (i *Index) EnsureIndex(ctx context.Context) (bool, error) {
+	status, _, err := i.do(ctx, http.MethodHead, "/"+i.index, nil)
+	if err != nil {
+		return false, err
+	}
+	if status == http.StatusOK {
+		return false, nil
+	}
+
+	status, body, err := i.do(ctx, http.MethodPut, "/"+i.index, []byte(indexMapping))
+	if err != nil {
+		return false, err
+	}
+	if status != http.StatusOK {
+		return false, responseError(http.MethodPut, i.index, status, body)
+	}
+	return true, nil
+}
+
+// IndexOrder writes the order's document. A document already at the same or a
+// newer version is left unchanged.
+func (i *Index) IndexOrder(ctx context.Context, order *domain.Order) error {
+	payload, err := json.Marshal(toDocument(order))
+	if err != nil {
+		return err
+	}
+
+	path := "/" + i.index + "/_doc/" + url.PathEscape(order.ID.String()) +
+		"?version_type=external&version=" + strconv.Itoa(order.Version)
+	status, body, err := i.do(ctx, http.MethodPut, path, payload)
+	if err != nil {
+		return err
+	}
+	if status == http.StatusConflict {
+		return nil
+	}
+	if status != http.StatusOK && status != http.StatusCreated {
+		return responseError(http.MethodPut, path, status, body)
+	}
+	return nil
+}
+
+// DeleteOrder removes an order's document; a missing document is not an error
+func (i *Index) DeleteOrder(ctx context.Context, id string) error {
+	path := "/" + i.index + "/_doc/" + url.PathEscape(id)
+	status, body, err := i.do(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK && status != http.StatusNotFound {
+		return responseError(http.MethodDelete, path, status, body)
+	}
+	return nil
+}
+
+// DeleteCustomerOrders removes every document of a tenant's customer
+func (i *Index) DeleteCustomerOrders(ctx context.Context, tenantID, customerID string) error {
+	filter := []any{map[string]any{"term": map[string]any{"customer_id": customerID}}}
+	if tenantID != "" {
+		filter = append(filter, map[string]any{"term": map[string]any{"tenant_id": tenantID}})
+	}
+	payload, err := json.Marshal(map[string]any{
+		"query": map[string]any{"bool": map[string]any{"filter": filter}},
+	})
+	if err != nil {
+		return err
+	}
+
+	path := "/" + i.index + "/_delete_by_query?conflicts=proceed"
+	status, body, err := i.do(ctx, http.MethodPost, path, payload)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return responseError(http.MethodPost, path, status, body)
+	}
+	return nil
+}
+
+// Search implements repository.OrderSearcher. An order ID prefix or exact
+// customer ID ranks highest, followed by fuzzy customer ID and item name
+// matches. Only orders of the tenant in ctx, if any, are found.
+func (i *Index) Search(ctx context.Context, query string, opts repository.SearchOptions) ([]*domain.Order, int64, error) {
+	payload, err := json.Marshal(buildSearchRequest(query, domain.TenantID(ctx), opts))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	path := "/" + i.index + "/_search"
+	status, body, err := i.do(ctx, http.MethodPost, path, payload)
+	if err != nil {
+		return nil, 0, err
+	}
+	if status != http.StatusOK {
+		return nil, 0, responseError(http.MethodPost, path, status, body)
+	}
+
+	var resp searchResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, 0, fmt.Errorf("opensearch: failed to decode search response: %w", err)
+	}
+
+	orders := make([]*domain.Order, 0, len(resp.Hits.Hits))
+	for _, hit := range resp.Hits.Hits {
+		order, err := hit.Source.toOrder()
+		if err != nil {
+			return nil, 0, err
+		}
+		orders = append(orders, order)
+	}
+	return orders, resp.Hits.Total.Value, nil
+}
+
+// buildSearchRequest builds the query DSL body for Search
+func buildSearchRequest(query, tenantID string, opts repository.SearchOptions) map[string]any {
+	should := []any{
+		map[string]any{"prefix": map[string]any{"id": map[string]any{"value": strings.ToLower(query), "boost": 10}}},
+		map[string]any{"term": map[string]any{"customer_id": map[string]any{"value": query, "boost": 10}}},
+		map[string]any{"match": map[string]any{"customer_id.text": map[string]any{"query": query, "fuzziness": "AUTO"}}},
+		map[string]any{"match": map[string]any{"items.name": map[string]any{"query": query, "fuzziness": "AUTO", "boost": 2}}},
+	}
+
+	filter := []any{}
+	if tenantID != "" {
+		filter = append(filter, map[string]any{"term": map[string]any{"tenant_id": tenantID}})
+	}
+	if opts.Status != nil {
+		filter = append(filter, map[string]any{"term": map[string]any{"status": string(*opts.Status)}})
+	}
+	if opts.CustomerID != nil {
+		filter = append(filter, map[string]any{"term": map[string]any{"customer_id": *opts.CustomerID}})
+	}
+	if opts.ProductID != nil {
+		filter = append(filter, map[string]any{"term": map[string]any{"items.product_id": *opts.ProductID}})
+	}
+	if opts.MinTotal != nil || opts.MaxTotal != nil {
+		bounds := map[string]any{}
+		if opts.MinTotal != nil {
+			bounds["gte"] = *opts.MinTotal
+		}
+		if opts.MaxTotal != nil {
+			bounds["lte"] = *opts.MaxTotal
+		}
+		filter = append(filter, map[string]any{"range": map[string]any{"total": bounds}})
+	}
+
+	return map[string]any{
+		"from":             opts.Offset,
+		"size":             opts.Limit,
+		"track_total_hits": true,
+		"query": map[string]any{
+			"bool": map[string]any{
+				"should":               should,
+				"minimum_should_match": 1,
+				"filter":               filter,
+			},
+		},
+		"sort": []any{"_score", map[string]any{"created_at": "desc"}},
+	}
+}
+
+func (i *Index) do(ctx context.Context, method, path string, payload []byte) (int, []byte, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, i.baseURL+path, body)
+	if err != nil {
+		return 0, nil, err
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if i.username != "" {
+		req.SetBasicAuth(i.username, i.password)
+	}
+
+	resp, err := i.client.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("opensearch: %s %s: %w", method, path, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("opensearch: %s %s: %w", method, path, err)
+	}
+	return resp.StatusCode, respBody, nil
+}
+
+func responseError(method, path string, status int, body []byte) error {
+	const maxBody = 512
+	if len(body) > maxBody {
+		body = body[:maxBody]
+	}
+	return fmt.Errorf("opensearch: %s %s: status %d: %s", method, path, status, body)
+}
+
+// document is the indexed form of an order
+type document struct {
+	ID         string            `json:"id"`
+	TenantID   string            `json:"tenant_id,omitempty"`
+	CustomerID string            `json:"customer_id"`
+	Status     string            `json:"status"`
+	Total      float64           `json:"total"`
+	Version    int               `json:"version"`
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+	Tags       []string          `json:"tags,omitempty"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+	Shipping   *addressDocument  `json:"shipping_address,omitempty"`
+	Billing    *addressDocument  `json:"billing_address,omitempty"`
+	Items      []itemDocument    `json:"items"`
+
+	ShippingMethod      string     `json:"shipping_method,omitempty"`
+	EstimatedDeliveryAt *time.Time `json:"estimated_delivery_at,omitempty"`
+	SLABreachedAt       *time.Time `json:"sla_breached_at,omitempty"`
+}
+
+type addressDocument struct {
+	Name       string `json:"name,omitempty"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country"`
+}
+
+func toAddressDocument(a *domain.Address) *addressDocument {
+	if a == nil {
+		return nil
+	}
+	d := addressDocument(*a)
+	return &d
+}
+
+func (d *addressDocument) toAddress() *domain.Address {
+	if d == nil {
+		return nil
+	}
+	a := domain.Address(*d)
+	return &a
+}
+
+type itemDocument struct {
+	ID        string  `json:"id"`
+	ProductID string  `json:"product_id"`
+	Name      string  `json:"name"`
+	Quantity  int     `json:"quantity"`
+	Price     float64 `json:"price"`
+	Subtotal  float64 `json:"subtotal"`
+	Status    string  `json:"status,omitempty"`
+}
+
+type searchResponse struct {
+	Hits struct {
+		Total struct {
+			Value int64 `json:"value"`
+		} `json:"total"`
+		Hits []struct {
+			Source document `json:"_source"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+func toDocument(order *domain.Order) document {
+	items := make([]itemDocument, len(order.Items))
+	for i, item := range order.Items {
+		items[i] = itemDocument{
+			ID:        item.ID.String(),
+			ProductID: item.ProductID,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			Price:     item.Price,
+			Subtotal:  item.Subtotal,
+			Status:    string(item.Status),
+		}
+	}
+	return document{
+		ID:         order.ID.String(),
+		TenantID:   order.TenantID,
+		CustomerID: order.CustomerID,
+		Status:     string(order.Status),
+		Total:      order.Total,
+		Version:    order.Version,
+		CreatedAt:  order.CreatedAt,
+		UpdatedAt:  order.UpdatedAt,
+		Tags:       order.Tags,
+		Metadata:   order.Metadata,
+		Shipping:   toAddressDocument(order.ShippingAddress),
+		Billing:    toAddressDocument(order.BillingAddress),
+		Items:      items,
+
+		ShippingMethod:      order.ShippingMethod,
+		EstimatedDeliveryAt: order.EstimatedDeliveryAt,
+		SLABreachedAt:       order.SLABreachedAt,
+	}
+}
+
+func (d document) toOrder() (*domain.Order, error) {
+	id, err := uuid.Parse(d.ID)
+	if err != nil {
+		return nil, fmt.Errorf("opensearch: invalid order ID %q: %w", d.ID, err)
+	}
+
+	items := make([]domain.OrderItem, len(d.Items))
+	for i, item := range d.Items {
+		itemID, _ := uuid.Parse(item.ID)
+		items[i] = domain.OrderItem{
+			ID:        itemID,
+			ProductID: item.ProductID,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			Price:     item.Price,
+			Subtotal:  item.Subtotal,
+			Status:    domain.ItemStatus(item.Status),
+		}
+		// Documents indexed before item fulfillment existed carry no status
+		if items[i].Status == "" {
+			items[i].Status = domain.ItemStatusPending
+		}
+	}
+
+	return &domain.Order{
+		ID:              id,
+		TenantID:        d.TenantID,
+		CustomerID:      d.CustomerID,
+		Items:           items,
+		Status:          domain.OrderStatus(d.Status),
+		Total:           d.Total,
+		Version:         d.Version,
+		CreatedAt:       d.CreatedAt,
+		UpdatedAt:       d.UpdatedAt,
+		Metadata:        d.Metadata,
+		Tags:            d.Tags,
+		ShippingAddress: d.Shipping.toAddress(),
+		BillingAddress:  d.Billing.toAddress(),
+
+		ShippingMethod:      d.ShippingMethod,
+		EstimatedDeliveryAt: d.EstimatedDeliveryAt,
+		SLABreachedAt:       d.SLABreachedAt,
+	}, nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opensearch
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndex_IndexOrder_UsesExternalVersion(t *testing.T) {
+	order := &domain.Order{
+		ID:         uuid.New(),
+		CustomerID: "cust-1",
+		Items:      []domain.OrderItem{{ID: uuid.New(), ProductID: "p-1", Name: "Widget", Quantity: 1, Price: 5, Subtotal: 5}},
+		Status:     domain.OrderStatusPending,
+		Total:      5,
+		Version:    3,
+	}
+
+	var gotPath, gotQuery string
+	var gotDoc document
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath, gotQuery = r.URL.Path, r.URL.RawQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotDoc))
+		w.WriteHeader(http.StatusConflict) // a newer version is already indexed
+	}))
+	defer srv.Close()
+
+	err := NewIndex(Config{URL: srv.URL, Index: "orders"}).IndexOrder(context.Background(), order)
+
+	require.NoError(t, err, "version conflicts mean the index is already newer")
+	assert.Equal(t, "/orders/_doc/"+order.ID.String(), gotPath)
+	assert.Equal(t, "version_type=external&version=3", gotQuery)
+	assert.Equal(t, "Widget", gotDoc.Items[0].Name)
+}
+
+func TestIndex_Search_DecodesHitsAndAppliesFilters(t *testing.T) {
+	id := uuid.New()
+	created := time.Date(2026, 2, 14, 12, 0, 0, 0, time.UTC)
+	status := domain.OrderStatusShipped
+	minTotal := 10.0
+
+	var gotBody map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/orders/_search", r.URL.Path)
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "admin", user)
+		assert.Equal(t, "secret", pass)
+		raw, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(raw, &gotBody))
+
+		resp := map[string]any{"hits": map[string]any{
+			"total": map[string]any{"value": 7},
+			"hits": []any{map[string]any{"_source": document{
+				ID: id.String(), TenantID: "acme", CustomerID: "cust-1", Status: "shipped", Total: 12.5, Version: 4, CreatedAt: created,
+			}}},
+		}}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	idx := NewIndex(Config{URL: srv.URL, Index: "orders", Username: "admin", Password: "secret"})
+	orders, total, err := idx.Search(domain.WithTenant(context.Background(), "acme"), "widget", repository.SearchOptions{
+		Limit: 20, Offset: 40, Status: &status, MinTotal: &minTotal,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), total)
+	require.Len(t, orders, 1)
+	assert.Equal(t, id, orders[0].ID)
+	assert.Equal(t, domain.OrderStatusShipped, orders[0].Status)
+	assert.Equal(t, created, orders[0].CreatedAt)
+	assert.Equal(t, "acme", orders[0].TenantID)
+
+	assert.EqualValues(t, 40, gotBody["from"])
+	assert.EqualValues(t, 20, gotBody["size"])
+	filter := gotBody["query"].(map[string]any)["bool"].(map[string]any)["filter"].([]any)
+	assert.Len(t, filter, 3)
+	assert.Contains(t, filter, map[string]any{"term": map[string]any{"tenant_id": "acme"}})
+}
+
+func TestIndex_EnsureIndex_CreatesMissingIndex(t *testing.T) {
+	var methods []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	created, err := NewIndex(Config{URL: srv.URL, Index: "orders"}).EnsureIndex(context.Background())
+
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, []string{http.MethodHead, http.MethodPut}, methods)
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+)
+
+// secretGetter abstracts secretsmanager.Client for testability
+type secretGetter interface {
+	GetSecretValue(ctx context.Context, params *secretsmanager.GetSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)
+}
+
+// AWSSource reads secrets by name or ARN, e.g. "ordersvc/db", or one field of a
+// JSON secret, e.g. "ordersvc/db#password". The current version is always read,
+// so rotations by Secrets Manager are picked up on refresh.
+//
+// Credentials and region come from the default AWS chain (AWS_REGION,
+// AWS_PROFILE, instance roles, ...).
+type AWSSource struct {
+	client secretGetter
+}
+
+// NewAWSSource creates a Secrets Manager source using the default AWS config.
+// A non-empty endpoint overrides the service endpoint, e.g. for LocalStack.
+func NewAWSSource(ctx context.Context, endpoint string) (*AWSSource, error) {
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	client := secretsmanager.NewFromConfig(awsCfg, func(o *secretsmanager.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+	})
+	return &AWSSource{client: client}, nil
+}
+
+// Fetch returns the secret string, or the field after '#' of its JSON object
+func (s *AWSSource) Fetch(ctx context.Context, ref string) (string, error) {
+	id, field := SplitField(ref)
+
+	out, err := s.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{SecretId: aws.String(id)})
+	var notFound *types.ResourceNotFoundException
+	if errors.As(err, &notFound) {
+		return "", fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	if err != nil {
+		return "", fmt.Errorf("get secret %s: %w", id, err)
+	}
+
+	value := aws.ToString(out.SecretString)
+	if out.SecretString == nil {
+		value = string(out.SecretBinary)
+	}
+	if field == "" {
+		return value, nil
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(value), &fields); err != nil {
+		return "", fmt.Errorf("secret %s is not a JSON object, cannot read field %s: %w", id, field, err)
+	}
+	fieldValue, ok := fields[field].(string)
+	if !ok {
+		return "", fmt.Errorf("%w: field %s of %s", ErrNotFound, field, id)
+	}
+	return fieldValue, nil
+}
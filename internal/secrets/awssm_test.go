@@ -0,0 +1,77 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSecrets serves secret strings by ID
+type fakeSecrets map[string]string
+
+func (f fakeSecrets) GetSecretValue(_ context.Context, params *secretsmanager.GetSecretValueInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+	value, ok := f[aws.ToString(params.SecretId)]
+	if !ok {
+		return nil, &types.ResourceNotFoundException{Message: aws.String("not found")}
+	}
+	return &secretsmanager.GetSecretValueOutput{SecretString: aws.String(value)}, nil
+}
+
+func TestAWSSource_Fetch(t *testing.T) {
+	src := &AWSSource{client: fakeSecrets{
+		"ordersvc/api-key": "plain-secret",
+		"ordersvc/db":      `{"username":"orders","password":"pw-1"}`,
+	}}
+
+	tests := []struct {
+		name    string
+		ref     string
+		want    string
+		wantErr error
+	}{
+		{name: "whole secret", ref: "ordersvc/api-key", want: "plain-secret"},
+		{name: "json field", ref: "ordersvc/db#password", want: "pw-1"},
+		{name: "missing field", ref: "ordersvc/db#token", wantErr: ErrNotFound},
+		{name: "missing secret", ref: "ordersvc/nope", wantErr: ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := src.Fetch(context.Background(), tt.ref)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAWSSource_Fetch_FieldOfNonJSONSecret_ReturnsError(t *testing.T) {
+	src := &AWSSource{client: fakeSecrets{"ordersvc/api-key": "plain-secret"}}
+
+	_, err := src.Fetch(context.Background(), "ordersvc/api-key#password")
+
+	assert.ErrorContains(t, err, "is not a JSON object")
+}
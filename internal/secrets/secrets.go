@@ -0,0 +1,201 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package secrets resolves credentials kept outside the configuration.
+//
+// A secret setting such as DATABASE_PASSWORD may hold a reference instead of
+// the secret itself:
+//
+//	file:/run/secrets/db-password      file contents, e.g. a mounted Kubernetes secret
+//	vault:secret/data/ordersvc#db      field of a HashiCorp Vault KV secret
+//	awssm:ordersvc/db#password         AWS Secrets Manager secret, or a field of its JSON
+//
+// Any other value is the secret itself.
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Reference schemes
+const (
+	SchemeFile  = "file"
+	SchemeVault = "vault"
+	SchemeAWS   = "awssm"
+)
+
+var knownSchemes = []string{SchemeFile, SchemeVault, SchemeAWS}
+
+// ErrNotFound is returned when a referenced secret or field does not exist
+var ErrNotFound = errors.New("secret not found")
+
+// Source fetches the current value of a secret from one backend
+type Source interface {
+	// Fetch returns the secret named by ref, the reference without its scheme
+	Fetch(ctx context.Context, ref string) (string, error)
+}
+
+// IsReference reports whether value names a secret rather than being one
+func IsReference(value string) bool {
+	_, _, ok := parse(value)
+	return ok
+}
+
+// SplitField splits a "name#field" reference; field is empty without a '#'
+func SplitField(ref string) (name, field string) {
+	name, field, _ = strings.Cut(ref, "#")
+	return name, field
+}
+
+// UsesScheme reports whether any of values is a reference with the given scheme
+func UsesScheme(scheme string, values ...string) bool {
+	for _, v := range values {
+		if s, _, ok := parse(v); ok && s == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+func parse(value string) (scheme, ref string, ok bool) {
+	scheme, ref, found := strings.Cut(value, ":")
+	if !found || ref == "" {
+		return "", "", false
+	}
+	for _, known := range knownSchemes {
+		if scheme == known {
+			return scheme, ref, true
+		}
+	}
+	return "", "", false
+}
+
+type cachedSecret struct {
+	value     string
+	fetchedAt time.Time
+}
+
+// Manager resolves secret references through the registered sources. Values
+// are cached for the refresh interval, after which the next Get fetches the
+// secret again, so rotated credentials are picked up without a restart.
+type Manager struct {
+	refresh time.Duration
+
+	mu      sync.Mutex
+	sources map[string]Source
+	cache   map[string]cachedSecret
+}
+
+// NewManager creates a Manager that re-fetches secrets older than refresh.
+// The file source is always registered.
+func NewManager(refresh time.Duration) *Manager {
+	return &Manager{
+		refresh: refresh,
+		sources: map[string]Source{SchemeFile: FileSource{}},
+		cache:   make(map[string]cachedSecret),
+	}
+}
+
+// Register makes src resolve references with the given scheme
+func (m *Manager) Register(scheme string, src Source) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sources[scheme] = src
+}
+
+// Get returns the secret value refers to, or value itself if it is not a
+// reference. If refreshing a cached secret fails the last value is returned,
+// so a backend outage does not break new connections.
+func (m *Manager) Get(ctx context.Context, value string) (string, error) {
+	scheme, ref, ok := parse(value)
+	if !ok {
+		return value, nil
+	}
+
+	m.mu.Lock()
+	cached, hit := m.cache[value]
+	src := m.sources[scheme]
+	m.mu.Unlock()
+
+	if hit && time.Since(cached.fetchedAt) < m.refresh {
+		return cached.value, nil
+	}
+	if src == nil {
+		return "", fmt.Errorf("no secret source configured for %s references", scheme)
+	}
+
+	secret, err := src.Fetch(ctx, ref)
+	if err != nil {
+		if hit {
+			slog.Warn("failed to refresh secret, using cached value",
+				slog.String("scheme", scheme), slog.String("error", err.Error()))
+			return cached.value, nil
+		}
+		return "", fmt.Errorf("resolve %s secret %s: %w", scheme, ref, err)
+	}
+
+	m.mu.Lock()
+	m.cache[value] = cachedSecret{value: secret, fetchedAt: time.Now()}
+	m.mu.Unlock()
+	return secret, nil
+}
+
+// ResolveAll replaces every reference among values with its secret, for
+// settings that are read once at startup. All failures are reported together.
+func (m *Manager) ResolveAll(ctx context.Context, values ...*string) error {
+	var errs []error
+	for _, v := range values {
+		secret, err := m.Get(ctx, *v)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		*v = secret
+	}
+	return errors.Join(errs...)
+}
+
+// Config selects the secret backends a Manager can use
+type Config struct {
+	// Refresh is how long a fetched secret is used before it is fetched again
+	Refresh time.Duration
+	// Vault is registered when Vault.Addr is set
+	Vault VaultConfig
+	// AWSEndpoint overrides the Secrets Manager endpoint, e.g. for LocalStack
+	AWSEndpoint string
+}
+
+// Setup creates a Manager with the file source, Vault if configured, and AWS
+// Secrets Manager if any of values references it, so the AWS config is only
+// loaded when needed.
+func Setup(ctx context.Context, cfg Config, values ...string) (*Manager, error) {
+	m := NewManager(cfg.Refresh)
+	if cfg.Vault.Addr != "" {
+		m.Register(SchemeVault, NewVaultSource(cfg.Vault))
+	}
+	if UsesScheme(SchemeAWS, values...) {
+		src, err := NewAWSSource(ctx, cfg.AWSEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		m.Register(SchemeAWS, src)
+	}
+	return m, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSource returns queued values and errors, counting fetches
+type fakeSource struct {
+	values []string
+	err    error
+	calls  int
+}
+
+func (f *fakeSource) Fetch(_ context.Context, _ string) (string, error) {
+	f.calls++
+	if f.err != nil {
+		return "", f.err
+	}
+	value := f.values[0]
+	if len(f.values) > 1 {
+		f.values = f.values[1:]
+	}
+	return value, nil
+}
+
+func TestManager_Get_PlainValue_ReturnedAsIs(t *testing.T) {
+	m := NewManager(time.Minute)
+
+	for _, value := range []string{"s3cret", "p@ss:word", "", "https://example.com"} {
+		got, err := m.Get(context.Background(), value)
+
+		require.NoError(t, err)
+		assert.Equal(t, value, got)
+		assert.False(t, IsReference(value))
+	}
+}
+
+func TestManager_Get_CachesUntilRefresh(t *testing.T) {
+	src := &fakeSource{values: []string{"v1", "v2"}}
+	m := NewManager(50 * time.Millisecond)
+	m.Register(SchemeVault, src)
+	ctx := context.Background()
+
+	first, err := m.Get(ctx, "vault:secret/data/app#db")
+	require.NoError(t, err)
+	cached, err := m.Get(ctx, "vault:secret/data/app#db")
+	require.NoError(t, err)
+
+	assert.Equal(t, "v1", first)
+	assert.Equal(t, "v1", cached)
+	assert.Equal(t, 1, src.calls)
+
+	time.Sleep(60 * time.Millisecond)
+	rotated, err := m.Get(ctx, "vault:secret/data/app#db")
+
+	require.NoError(t, err)
+	assert.Equal(t, "v2", rotated, "an expired secret is fetched again")
+}
+
+func TestManager_Get_RefreshFails_ReturnsCachedValue(t *testing.T) {
+	src := &fakeSource{values: []string{"v1"}}
+	m := NewManager(time.Nanosecond)
+	m.Register(SchemeAWS, src)
+	ctx := context.Background()
+
+	_, err := m.Get(ctx, "awssm:app/db")
+	require.NoError(t, err)
+
+	src.err = errors.New("throttled")
+	got, err := m.Get(ctx, "awssm:app/db")
+
+	require.NoError(t, err)
+	assert.Equal(t, "v1", got)
+}
+
+func TestManager_Get_Errors(t *testing.T) {
+	m := NewManager(time.Minute)
+	m.Register(SchemeAWS, &fakeSource{err: errors.New("access denied")})
+
+	_, err := m.Get(context.Background(), "vault:secret/data/app#db")
+	assert.ErrorContains(t, err, "no secret source configured for vault references")
+
+	_, err = m.Get(context.Background(), "awssm:app/db")
+	assert.ErrorContains(t, err, "resolve awssm secret app/db: access denied")
+
+	_, err = m.Get(context.Background(), "file:"+filepath.Join(t.TempDir(), "missing"))
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestManager_ResolveAll_ReplacesReferencesAndReportsFailures(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "redis-password")
+	require.NoError(t, os.WriteFile(path, []byte("from-file\n"), 0o600))
+	m := NewManager(time.Minute)
+
+	redisPassword := "file:" + path
+	apiKey := "plain-key"
+	missing := "vault:secret/data/app#key"
+
+	err := m.ResolveAll(context.Background(), &redisPassword, &apiKey, &missing)
+
+	require.Error(t, err)
+	assert.Equal(t, "from-file", redisPassword, "trailing newline is dropped")
+	assert.Equal(t, "plain-key", apiKey)
+	assert.Equal(t, "vault:secret/data/app#key", missing, "unresolved values are left unchanged")
+}
+
+func TestUsesScheme(t *testing.T) {
+	assert.True(t, UsesScheme(SchemeAWS, "plain", "awssm:app/db"))
+	assert.False(t, UsesScheme(SchemeAWS, "plain", "vault:secret/app#db"))
+}
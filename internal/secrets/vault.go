@@ -0,0 +1,113 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// VaultConfig holds Vault connection settings
+type VaultConfig struct {
+	// Addr is the Vault server URL, e.g. https://vault.internal:8200
+	Addr string
+	// Token authenticates requests
+	Token string `json:"-"` // #nosec G117 -- config field, not serialized
+	// Namespace is the Vault Enterprise namespace; empty for none
+	Namespace string
+	// HTTPClient defaults to a client with a 10s timeout
+	HTTPClient *http.Client
+}
+
+// VaultSource reads fields of KV secrets, e.g. "secret/data/ordersvc#db_password"
+// for a KV v2 mount or "kv/ordersvc#db_password" for KV v1.
+type VaultSource struct {
+	addr      string
+	token     string
+	namespace string
+	client    *http.Client
+}
+
+// NewVaultSource creates a Vault secret source
+func NewVaultSource(cfg VaultConfig) *VaultSource {
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &VaultSource{
+		addr:      strings.TrimRight(cfg.Addr, "/"),
+		token:     cfg.Token,
+		namespace: cfg.Namespace,
+		client:    client,
+	}
+}
+
+// vaultKVResponse is the part of a Vault read response holding the secret. KV v2
+// nests the fields in data.data next to data.metadata.
+type vaultKVResponse struct {
+	Data map[string]any `json:"data"`
+}
+
+// Fetch reads the field named after '#' from the secret at the path before it
+func (s *VaultSource) Fetch(ctx context.Context, ref string) (string, error) {
+	path, field := SplitField(ref)
+	if field == "" {
+		return "", fmt.Errorf("vault reference %q needs a #field", ref)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.addr+"/v1/"+(&url.URL{Path: strings.TrimLeft(path, "/")}).EscapedPath(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", s.token)
+	if s.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", s.namespace)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("vault read %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return "", fmt.Errorf("%w: vault path %s", ErrNotFound, path)
+	case resp.StatusCode != http.StatusOK:
+		return "", fmt.Errorf("vault read %s: status %d", path, resp.StatusCode)
+	}
+
+	var body vaultKVResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("vault read %s: %w", path, err)
+	}
+	data := body.Data
+	if nested, ok := data["data"].(map[string]any); ok {
+		if _, v2 := data["metadata"]; v2 {
+			data = nested
+		}
+	}
+
+	value, ok := data[field].(string)
+	if !ok {
+		return "", fmt.Errorf("%w: field %s of vault path %s", ErrNotFound, field, path)
+	}
+	return value, nil
+}
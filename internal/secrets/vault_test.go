@@ -0,0 +1,80 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultSource_Fetch_KVv2(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/secret/data/ordersvc", r.URL.Path)
+		assert.Equal(t, "token-1", r.Header.Get("X-Vault-Token"))
+		assert.Equal(t, "team-a", r.Header.Get("X-Vault-Namespace"))
+		_, _ = w.Write([]byte(`{"data":{"data":{"db_password":"pw-v2"},"metadata":{"version":3}}}`))
+	}))
+	defer srv.Close()
+	src := NewVaultSource(VaultConfig{Addr: srv.URL + "/", Token: "token-1", Namespace: "team-a"})
+
+	value, err := src.Fetch(context.Background(), "secret/data/ordersvc#db_password")
+
+	require.NoError(t, err)
+	assert.Equal(t, "pw-v2", value)
+}
+
+func TestVaultSource_Fetch_KVv1(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"db_password":"pw-v1"}}`))
+	}))
+	defer srv.Close()
+
+	value, err := NewVaultSource(VaultConfig{Addr: srv.URL}).Fetch(context.Background(), "kv/ordersvc#db_password")
+
+	require.NoError(t, err)
+	assert.Equal(t, "pw-v1", value)
+}
+
+func TestVaultSource_Fetch_Errors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/secret/data/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case "/v1/secret/data/forbidden":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			_, _ = w.Write([]byte(`{"data":{"data":{"other":"x"},"metadata":{}}}`))
+		}
+	}))
+	defer srv.Close()
+	src := NewVaultSource(VaultConfig{Addr: srv.URL})
+
+	_, err := src.Fetch(context.Background(), "secret/data/missing#pw")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = src.Fetch(context.Background(), "secret/data/forbidden#pw")
+	assert.ErrorContains(t, err, "status 403")
+
+	_, err = src.Fetch(context.Background(), "secret/data/ordersvc#pw")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = src.Fetch(context.Background(), "secret/data/ordersvc")
+	assert.ErrorContains(t, err, "needs a #field")
+}
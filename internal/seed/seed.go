@@ -0,0 +1,301 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package seed generates realistic orders in bulk, for demos and for
+// performance tests that need a database of production-like size. Orders
+// are spread across customers, products, statuses and creation dates, and
+// written through the repository's batch insert.
+package seed
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// Options configures a seed run
+type Options struct {
+	// Orders is the number of orders to create
+	Orders int
+	// Customers is the number of distinct customers the orders belong to
+	Customers int
+	// Span is how far back creation dates reach; orders are spread evenly
+	// over it
+	Span time.Duration
+	// BatchSize is the number of orders per CreateBatch call
+	BatchSize int
+	// Seed makes a run reproducible; runs with the same options and seed
+	// generate the same orders
+	Seed uint64
+}
+
+// product is a catalog entry the generated items draw from
+type product struct {
+	id    string
+	name  string
+	price float64
+}
+
+var catalog = []product{
+	{"SKU-1001", "Wireless Mouse", 24.99},
+	{"SKU-1002", "Mechanical Keyboard", 89.00},
+	{"SKU-1003", "USB-C Hub", 39.50},
+	{"SKU-1004", "27\" Monitor", 279.99},
+	{"SKU-1005", "Laptop Stand", 45.00},
+	{"SKU-1006", "Webcam HD", 59.99},
+	{"SKU-1007", "Noise Cancelling Headphones", 199.00},
+	{"SKU-1008", "Desk Lamp", 32.75},
+	{"SKU-1009", "Ergonomic Chair", 349.00},
+	{"SKU-1010", "Cable Organizer", 12.49},
+	{"SKU-2001", "Coffee Beans 1kg", 21.90},
+	{"SKU-2002", "French Press", 34.00},
+	{"SKU-2003", "Ceramic Mug", 9.95},
+	{"SKU-2004", "Electric Kettle", 49.99},
+	{"SKU-2005", "Tea Sampler", 18.50},
+	{"SKU-3001", "Running Shoes", 119.00},
+	{"SKU-3002", "Yoga Mat", 29.99},
+	{"SKU-3003", "Water Bottle", 14.99},
+	{"SKU-3004", "Fitness Tracker", 99.00},
+	{"SKU-3005", "Resistance Bands", 19.99},
+	{"SKU-4001", "Paperback Novel", 12.99},
+	{"SKU-4002", "Notebook A5", 7.50},
+	{"SKU-4003", "Fountain Pen", 64.00},
+	{"SKU-4004", "Backpack", 79.95},
+	{"SKU-4005", "Phone Case", 19.00},
+}
+
+var cities = []domain.Address{
+	{City: "Seattle", Region: "WA", PostalCode: "98101", Country: "US"},
+	{City: "Austin", Region: "TX", PostalCode: "73301", Country: "US"},
+	{City: "Chicago", Region: "IL", PostalCode: "60601", Country: "US"},
+	{City: "Toronto", Region: "ON", PostalCode: "M5H 2N2", Country: "CA"},
+	{City: "London", PostalCode: "EC1A 1BB", Country: "GB"},
+	{City: "Berlin", PostalCode: "10115", Country: "DE"},
+	{City: "Amsterdam", PostalCode: "1012 JS", Country: "NL"},
+	{City: "Sydney", Region: "NSW", PostalCode: "2000", Country: "AU"},
+}
+
+var streets = []string{"Main St", "Oak Ave", "Market St", "High St", "Park Rd", "Station Rd", "Elm St", "King St"}
+
+var givenNames = []string{"Alex", "Sam", "Jordan", "Taylor", "Morgan", "Casey", "Riley", "Jamie", "Avery", "Quinn"}
+
+var familyNames = []string{"Smith", "Garcia", "Chen", "Müller", "Okafor", "Novak", "Silva", "Kumar", "Rossi", "Dubois"}
+
+// Generator builds orders. It is not safe for concurrent use.
+type Generator struct {
+	rng       *rand.Rand
+	now       time.Time
+	span      time.Duration
+	customers []customer
+}
+
+// customer keeps one address per customer, so a customer's orders ship to
+// the same place
+type customer struct {
+	id      string
+	address domain.Address
+}
+
+// NewGenerator returns a generator for opts that dates orders up to now
+func NewGenerator(opts Options, now time.Time) *Generator {
+	g := &Generator{
+		rng:  rand.New(rand.NewPCG(opts.Seed, opts.Seed^0x9e3779b97f4a7c15)),
+		now:  now,
+		span: opts.Span,
+	}
+	n := max(opts.Customers, 1)
+	g.customers = make([]customer, n)
+	for i := range g.customers {
+		g.customers[i] = customer{id: g.uuid().String(), address: g.address()}
+	}
+	return g
+}
+
+// Order returns a new valid order with its creation date in the span. Older
+// orders are further along: most orders from the last day are pending,
+// while those older than two weeks are delivered or cancelled.
+func (g *Generator) Order() *domain.Order {
+	c := g.customers[g.rng.IntN(len(g.customers))]
+	createdAt := g.now.Add(-time.Duration(g.rng.Int64N(int64(g.span) + 1))).Truncate(time.Microsecond)
+	status := g.status(g.now.Sub(createdAt))
+
+	updatedAt := createdAt
+	if status != domain.OrderStatusPending {
+		updatedAt = createdAt.Add(time.Duration(g.rng.Int64N(int64(72 * time.Hour))))
+		if updatedAt.After(g.now) {
+			updatedAt = g.now
+		}
+	}
+
+	address := c.address
+	order := &domain.Order{
+		ID:              g.uuid(),
+		CustomerID:      c.id,
+		Status:          status,
+		CreatedAt:       createdAt,
+		UpdatedAt:       updatedAt,
+		ShippingAddress: &address,
+	}
+
+	// Most orders have a few items; quantities are usually one or two
+	itemCount := 1 + g.rng.IntN(3)
+	if g.rng.IntN(10) == 0 {
+		itemCount += g.rng.IntN(8)
+	}
+	seen := make(map[int]bool, itemCount)
+	for range itemCount {
+		p := g.rng.IntN(len(catalog))
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+		item := domain.OrderItem{
+			ID:        g.uuid(),
+			ProductID: catalog[p].id,
+			Name:      catalog[p].name,
+			Quantity:  g.quantity(),
+			Price:     catalog[p].price,
+			Status:    itemStatusFor(status),
+		}
+		item.Subtotal = item.CalculateSubtotal()
+		order.Items = append(order.Items, item)
+	}
+	order.Total = order.CalculateTotal()
+	return order
+}
+
+// status picks a status for an order of the given age
+func (g *Generator) status(age time.Duration) domain.OrderStatus {
+	r := g.rng.IntN(100)
+	if r < 4 {
+		return domain.OrderStatusCancelled
+	}
+	switch {
+	case age < 24*time.Hour:
+		switch {
+		case r < 70:
+			return domain.OrderStatusPending
+		case r < 90:
+			return domain.OrderStatusConfirmed
+		default:
+			return domain.OrderStatusProcessing
+		}
+	case age < 3*24*time.Hour:
+		switch {
+		case r < 20:
+			return domain.OrderStatusConfirmed
+		case r < 50:
+			return domain.OrderStatusProcessing
+		default:
+			return domain.OrderStatusShipped
+		}
+	case age < 14*24*time.Hour:
+		if r < 40 {
+			return domain.OrderStatusShipped
+		}
+		return domain.OrderStatusDelivered
+	default:
+		return domain.OrderStatusDelivered
+	}
+}
+
+// itemStatusFor is the fulfillment status of the items of an order in status
+func itemStatusFor(status domain.OrderStatus) domain.ItemStatus {
+	switch status {
+	case domain.OrderStatusShipped:
+		return domain.ItemStatusShipped
+	case domain.OrderStatusDelivered:
+		return domain.ItemStatusDelivered
+	default:
+		return domain.ItemStatusPending
+	}
+}
+
+func (g *Generator) quantity() int {
+	switch r := g.rng.IntN(100); {
+	case r < 65:
+		return 1
+	case r < 90:
+		return 2
+	default:
+		return 3 + g.rng.IntN(8)
+	}
+}
+
+func (g *Generator) address() domain.Address {
+	a := cities[g.rng.IntN(len(cities))]
+	a.Name = givenNames[g.rng.IntN(len(givenNames))] + " " + familyNames[g.rng.IntN(len(familyNames))]
+	a.Line1 = fmt.Sprintf("%d %s", 1+g.rng.IntN(999), streets[g.rng.IntN(len(streets))])
+	return a
+}
+
+// uuid draws a random UUID from the generator's source, so seeded runs
+// repeat their IDs too
+func (g *Generator) uuid() uuid.UUID {
+	var id uuid.UUID
+	for i := 0; i < len(id); i += 8 {
+		v := g.rng.Uint64()
+		for j := range 8 {
+			id[i+j] = byte(v >> (8 * j))
+		}
+	}
+	id[6] = (id[6] & 0x0f) | 0x40 // version 4
+	id[8] = (id[8] & 0x3f) | 0x80 // RFC 4122 variant
+	return id
+}
+
+// Run generates opts.Orders orders and inserts them with repo.CreateBatch,
+// calling progress with the running total after each batch. It stops after
+// the first batch with a rejected order and returns the number inserted.
+func Run(ctx context.Context, repo repository.OrderRepository, opts Options, now time.Time, progress func(inserted int)) (int, error) {
+	if opts.Orders < 0 || opts.Customers < 1 || opts.BatchSize < 1 || opts.Span < 0 {
+		return 0, fmt.Errorf("invalid seed options: orders=%d customers=%d batch=%d span=%s",
+			opts.Orders, opts.Customers, opts.BatchSize, opts.Span)
+	}
+
+	g := NewGenerator(opts, now)
+	inserted := 0
+	for inserted < opts.Orders {
+		batch := make([]*domain.Order, min(opts.BatchSize, opts.Orders-inserted))
+		for i := range batch {
+			batch[i] = g.Order()
+		}
+		errs, err := repo.CreateBatch(ctx, batch)
+		if err != nil {
+			return inserted, err
+		}
+		// CreateBatch still inserts the orders of the batch that were not rejected
+		var rejected error
+		for i, err := range errs {
+			if err == nil {
+				inserted++
+			} else if rejected == nil {
+				rejected = fmt.Errorf("order %s: %w", batch[i].ID, err)
+			}
+		}
+		if rejected != nil {
+			return inserted, rejected
+		}
+		if progress != nil {
+			progress(inserted)
+		}
+	}
+	return inserted, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+)
+
+var now = time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+func TestGenerator_Order_Valid(t *testing.T) {
+	g := NewGenerator(Options{Customers: 10, Span: 90 * 24 * time.Hour, Seed: 1}, now)
+
+	statuses := map[domain.OrderStatus]int{}
+	for range 2000 {
+		order := g.Order()
+		require.NoError(t, order.Validate())
+		assert.False(t, order.CreatedAt.After(now))
+		assert.False(t, order.CreatedAt.Before(now.Add(-90*24*time.Hour)))
+		assert.False(t, order.UpdatedAt.Before(order.CreatedAt))
+		assert.False(t, order.UpdatedAt.After(now))
+		assert.InDelta(t, order.CalculateTotal(), order.Total, 0.001)
+		normalized, err := domain.NormalizeAddress(*order.ShippingAddress)
+		require.NoError(t, err)
+		assert.Equal(t, normalized, *order.ShippingAddress)
+		statuses[order.Status]++
+	}
+	for _, status := range []domain.OrderStatus{
+		domain.OrderStatusPending, domain.OrderStatusConfirmed, domain.OrderStatusProcessing,
+		domain.OrderStatusShipped, domain.OrderStatusDelivered, domain.OrderStatusCancelled,
+	} {
+		assert.Positive(t, statuses[status], "no %s orders", status)
+	}
+}
+
+func TestGenerator_Order_SpreadsCustomers(t *testing.T) {
+	g := NewGenerator(Options{Customers: 5, Span: 24 * time.Hour, Seed: 1}, now)
+
+	customers := map[string]bool{}
+	for range 500 {
+		customers[g.Order().CustomerID] = true
+	}
+	assert.Len(t, customers, 5)
+}
+
+func TestGenerator_Order_SameSeedSameOrders(t *testing.T) {
+	opts := Options{Customers: 20, Span: 30 * 24 * time.Hour, Seed: 42}
+	a, b := NewGenerator(opts, now), NewGenerator(opts, now)
+
+	for range 50 {
+		assert.Equal(t, a.Order(), b.Order())
+	}
+}
+
+func TestRun_InsertsInBatches(t *testing.T) {
+	var sizes []int
+	repo := &mocks.OrderRepositoryMock{
+		CreateBatchFunc: func(_ context.Context, orders []*domain.Order) ([]error, error) {
+			sizes = append(sizes, len(orders))
+			return make([]error, len(orders)), nil
+		},
+	}
+	var progress []int
+
+	n, err := Run(context.Background(), repo, Options{Orders: 25, Customers: 3, BatchSize: 10, Span: time.Hour}, now, func(inserted int) {
+		progress = append(progress, inserted)
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 25, n)
+	assert.Equal(t, []int{10, 10, 5}, sizes)
+	assert.Equal(t, []int{10, 20, 25}, progress)
+}
+
+func TestRun_RejectedOrder_Stops(t *testing.T) {
+	calls := 0
+	repo := &mocks.OrderRepositoryMock{
+		CreateBatchFunc: func(_ context.Context, orders []*domain.Order) ([]error, error) {
+			calls++
+			errs := make([]error, len(orders))
+			errs[1] = domain.ErrInvalidCustomerID
+			return errs, nil
+		},
+	}
+
+	n, err := Run(context.Background(), repo, Options{Orders: 25, Customers: 3, BatchSize: 10, Span: time.Hour}, now, nil)
+
+	require.ErrorIs(t, err, domain.ErrInvalidCustomerID)
+	assert.Equal(t, 9, n)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRun_BatchError(t *testing.T) {
+	errDown := errors.New("connection refused")
+	repo := &mocks.OrderRepositoryMock{
+		CreateBatchFunc: func(context.Context, []*domain.Order) ([]error, error) {
+			return nil, errDown
+		},
+	}
+
+	n, err := Run(context.Background(), repo, Options{Orders: 5, Customers: 1, BatchSize: 10}, now, nil)
+
+	require.ErrorIs(t, err, errDown)
+	assert.Zero(t, n)
+}
+
+func TestRun_InvalidOptions(t *testing.T) {
+	_, err := Run(context.Background(), &mocks.OrderRepositoryMock{}, Options{Orders: 5, Customers: 0, BatchSize: 10}, now, nil)
+
+	assert.Error(t, err)
+}
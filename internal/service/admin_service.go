@@ -0,0 +1,168 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/cache"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// AdminService implements operator actions that bypass normal order rules
+type AdminService interface {
+	// ListDeletedOrders returns soft-deleted orders, most recently deleted first
+	ListDeletedOrders(ctx context.Context, limit, offset int) (*OrderList, error)
+
+	// RestoreOrder undeletes a soft-deleted order.
+	// Returns domain.ErrOrderNotFound if no soft-deleted order has this ID.
+	RestoreOrder(ctx context.Context, id string) (*domain.Order, error)
+
+	// ForceOrderStatus sets an order's status without checking the transition rules
+	ForceOrderStatus(ctx context.Context, id string, newStatus domain.OrderStatus) (*domain.Order, error)
+
+	// PurgeDeletedOrders hard-deletes orders soft-deleted more than olderThan ago
+	PurgeDeletedOrders(ctx context.Context, olderThan time.Duration) (int64, error)
+}
+
+// OrderList is a page of orders with the total number of matches
+type OrderList struct {
+	Data  []*domain.Order
+	Total int64
+}
+
+// adminServiceImpl implements AdminService
+type adminServiceImpl struct {
+	repo      repository.OrderRepository
+	cache     cache.OrderCache
+	publisher EventPublisher
+}
+
+// NewAdminService creates a new AdminService
+func NewAdminService(repo repository.OrderRepository, orderCache cache.OrderCache, publisher EventPublisher) AdminService {
+	return &adminServiceImpl{
+		repo:      repo,
+		cache:     orderCache,
+		publisher: publisher,
+	}
+}
+
+func (s *adminServiceImpl) ListDeletedOrders(ctx context.Context, limit, offset int) (*OrderList, error) {
+	if limit < 1 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	orders, total, err := s.repo.ListDeleted(ctx, repository.ListOptions{Limit: limit, Offset: offset})
+	if err != nil {
+		return nil, err
+	}
+	return &OrderList{Data: orders, Total: total}, nil
+}
+
+func (s *adminServiceImpl) RestoreOrder(ctx context.Context, id string) (*domain.Order, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, domain.ErrOrderNotFound
+	}
+
+	order, err := s.repo.Restore(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return nil, domain.ErrOrderNotFound
+	}
+
+	// Publish event (warn + continue on failure)
+	if s.publisher != nil {
+		if err := s.publisher.PublishOrderRestored(ctx, order); err != nil {
+			slog.WarnContext(ctx, "failed to publish order.restored event", slog.String("order_id", order.ID.String()), slog.String("error", err.Error()))
+		}
+	}
+
+	invalidateOrder(ctx, s.cache, order.TenantID, id, order.CustomerID)
+	return order, nil
+}
+
+// forcedHoldReason is recorded when an order is forced onto on_hold
+const forcedHoldReason = "status forced by an administrator"
+
+func (s *adminServiceImpl) ForceOrderStatus(ctx context.Context, id string, newStatus domain.OrderStatus) (*domain.Order, error) {
+	if !newStatus.IsValid() {
+		return nil, domain.ErrInvalidStatus
+	}
+
+	order, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return nil, domain.ErrOrderNotFound
+	}
+
+	if order.Status == newStatus {
+		return order, nil
+	}
+
+	oldStatus := order.Status
+	order.Status = newStatus
+	order.UpdatedAt = time.Now()
+	order.Hold = nil
+	if newStatus == domain.OrderStatusOnHold {
+		// Keep a forced hold releasable back to where it was forced from
+		order.Hold = &domain.OrderHold{
+			Reason:         forcedHoldReason,
+			PreviousStatus: oldStatus,
+			HeldAt:         order.UpdatedAt,
+		}
+	}
+
+	if err := s.repo.Update(ctx, order); err != nil {
+		return nil, err
+	}
+
+	// Publish event (warn + continue on failure)
+	if s.publisher != nil {
+		if err := s.publisher.PublishOrderStatusChanged(ctx, order, oldStatus, newStatus); err != nil {
+			slog.WarnContext(ctx, "failed to publish order.status_changed event", slog.String("order_id", order.ID.String()), slog.String("error", err.Error()))
+		}
+	}
+
+	invalidateOrder(ctx, s.cache, order.TenantID, id, order.CustomerID)
+	return order, nil
+}
+
+func (s *adminServiceImpl) PurgeDeletedOrders(ctx context.Context, olderThan time.Duration) (int64, error) {
+	if olderThan < 0 {
+		return 0, domain.ErrInvalidRetention
+	}
+
+	purged, err := s.repo.Purge(ctx, time.Now().Add(-olderThan))
+	if err != nil {
+		return 0, err
+	}
+
+	slog.InfoContext(ctx, "purged soft-deleted orders", slog.Int64("count", purged), slog.Duration("older_than", olderThan))
+	return purged, nil
+}
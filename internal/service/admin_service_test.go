@@ -0,0 +1,151 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminService_ListDeletedOrders_ClampsPagination(t *testing.T) {
+	var gotOpts repository.ListOptions
+	repo := &mocks.OrderRepositoryMock{
+		ListDeletedFunc: func(_ context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+			gotOpts = opts
+			return []*domain.Order{{ID: uuid.New()}}, 1, nil
+		},
+	}
+
+	svc := NewAdminService(repo, nil, nil)
+	result, err := svc.ListDeletedOrders(context.Background(), 500, -1)
+
+	require.NoError(t, err)
+	assert.Equal(t, 100, gotOpts.Limit)
+	assert.Equal(t, 0, gotOpts.Offset)
+	assert.Len(t, result.Data, 1)
+	assert.Equal(t, int64(1), result.Total)
+}
+
+func TestAdminService_RestoreOrder_InvalidatesCache(t *testing.T) {
+	orderID := uuid.New()
+	var evicted string
+	repo := &mocks.OrderRepositoryMock{
+		RestoreFunc: func(_ context.Context, _ string, _ *int) (*domain.Order, error) {
+			return &domain.Order{ID: orderID, Status: domain.OrderStatusPending, Version: 3}, nil
+		},
+	}
+	orderCache := &mocks.OrderCacheMock{
+		DeleteFunc: func(_ context.Context, _, id string) error {
+			evicted = id
+			return nil
+		},
+	}
+
+	svc := NewAdminService(repo, orderCache, nil)
+	order, err := svc.RestoreOrder(context.Background(), orderID.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, order.Version)
+	assert.Equal(t, orderID.String(), evicted)
+}
+
+func TestAdminService_RestoreOrder_NotDeleted_ReturnsNotFound(t *testing.T) {
+	svc := NewAdminService(&mocks.OrderRepositoryMock{}, nil, nil)
+
+	_, err := svc.RestoreOrder(context.Background(), uuid.New().String())
+
+	assert.ErrorIs(t, err, domain.ErrOrderNotFound)
+}
+
+func TestAdminService_ForceOrderStatus_BypassesTransitionRules(t *testing.T) {
+	orderID := uuid.New()
+	var oldPublished, newPublished domain.OrderStatus
+	repo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+			return &domain.Order{ID: orderID, Status: domain.OrderStatusDelivered, Version: 5}, nil
+		},
+		UpdateFunc: func(_ context.Context, order *domain.Order) error {
+			order.Version++
+			return nil
+		},
+	}
+	publisher := &mocks.EventPublisherMock{
+		PublishOrderStatusChangedFunc: func(_ context.Context, _ *domain.Order, oldStatus, newStatus domain.OrderStatus) error {
+			oldPublished, newPublished = oldStatus, newStatus
+			return nil
+		},
+	}
+
+	svc := NewAdminService(repo, nil, publisher)
+	order, err := svc.ForceOrderStatus(context.Background(), orderID.String(), domain.OrderStatusPending)
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.OrderStatusPending, order.Status)
+	assert.Equal(t, 6, order.Version)
+	assert.Equal(t, domain.OrderStatusDelivered, oldPublished)
+	assert.Equal(t, domain.OrderStatusPending, newPublished)
+}
+
+func TestAdminService_ForceOrderStatus_UnknownStatus_ReturnsError(t *testing.T) {
+	svc := NewAdminService(&mocks.OrderRepositoryMock{}, nil, nil)
+
+	_, err := svc.ForceOrderStatus(context.Background(), uuid.New().String(), domain.OrderStatus("lost"))
+
+	assert.ErrorIs(t, err, domain.ErrInvalidStatus)
+}
+
+func TestAdminService_PurgeDeletedOrders(t *testing.T) {
+	tests := []struct {
+		name      string
+		olderThan time.Duration
+		wantErr   error
+	}{
+		{name: "thirty days", olderThan: 30 * 24 * time.Hour},
+		{name: "everything deleted", olderThan: 0},
+		{name: "negative", olderThan: -time.Hour, wantErr: domain.ErrInvalidRetention},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cutoff time.Time
+			repo := &mocks.OrderRepositoryMock{
+				PurgeFunc: func(_ context.Context, deletedBefore time.Time) (int64, error) {
+					cutoff = deletedBefore
+					return 4, nil
+				},
+			}
+
+			svc := NewAdminService(repo, nil, nil)
+			purged, err := svc.PurgeDeletedOrders(context.Background(), tt.olderThan)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.True(t, cutoff.IsZero(), "repository must not be called")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, int64(4), purged)
+			assert.WithinDuration(t, time.Now().Add(-tt.olderThan), cutoff, time.Second)
+		})
+	}
+}
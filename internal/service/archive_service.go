@@ -0,0 +1,213 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/objectstore"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// archivePageSize is the most orders written to one archive object
+const archivePageSize = 100
+
+// ArchiveService moves delivered and cancelled orders out of the database
+// into object storage and reads them back from there
+type ArchiveService interface {
+	// ArchiveOrders runs one archive pass. Live delivered and cancelled
+	// orders last updated longer ago than the configured age are written
+	// with their history and notes to objects of up to 100 orders, one
+	// export record per line, then deleted from the database.
+	ArchiveOrders(ctx context.Context) (*ArchiveResult, error)
+
+	// GetArchivedOrder returns an archived order of the caller's tenant, or
+	// domain.ErrOrderNotFound if there is none
+	GetArchivedOrder(ctx context.Context, id string) (*domain.Order, error)
+
+	// Run archives orders every interval until ctx is cancelled
+	Run(ctx context.Context, interval time.Duration)
+}
+
+// ArchiveResult counts the orders one archive pass moved and the objects
+// they were written to
+type ArchiveResult struct {
+	Orders  int
+	Objects int
+}
+
+// archiveServiceImpl implements ArchiveService
+type archiveServiceImpl struct {
+	repo  repository.ArchiveRepository
+	store objectstore.Store
+	after time.Duration
+	now   func() time.Time
+}
+
+// NewArchiveService creates a new ArchiveService that archives orders after
+// they have gone unchanged for after
+func NewArchiveService(repo repository.ArchiveRepository, store objectstore.Store, after time.Duration) ArchiveService {
+	return &archiveServiceImpl{
+		repo:  repo,
+		store: store,
+		after: after,
+		now:   time.Now,
+	}
+}
+
+func (s *archiveServiceImpl) ArchiveOrders(ctx context.Context) (*ArchiveResult, error) {
+	result := &ArchiveResult{}
+	now := s.now()
+	before := now.Add(-s.after)
+	for {
+		page, err := s.repo.ListArchivable(ctx, completedStatuses, before, archivePageSize)
+		if err != nil || len(page) == 0 {
+			return result, err
+		}
+
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		orders := make([]*domain.Order, len(page))
+		for i, b := range page {
+			if err := enc.Encode(newBackupRecord(b)); err != nil {
+				return result, err
+			}
+			orders[i] = b.Order
+		}
+		key := archiveObjectKey(now)
+		if err := s.store.Put(ctx, key, &buf); err != nil {
+			return result, err
+		}
+		archived, err := s.repo.MarkArchived(ctx, key, orders)
+		if err != nil {
+			return result, err
+		}
+		result.Orders += archived
+		result.Objects++
+
+		// Orders changed since they were listed stay behind; stop rather
+		// than list a page of them again
+		if len(page) < archivePageSize || archived == 0 {
+			break
+		}
+	}
+
+	if result.Orders > 0 {
+		slog.Info("archived orders",
+			slog.Int("orders", result.Orders),
+			slog.Int("objects", result.Objects),
+		)
+	}
+	return result, nil
+}
+
+// archiveObjectKey names a new archive object, grouped by the day it was
+// written
+func archiveObjectKey(now time.Time) string {
+	return now.UTC().Format("2006/01/02/") + uuid.NewString() + ".ndjson"
+}
+
+func (s *archiveServiceImpl) GetArchivedOrder(ctx context.Context, id string) (*domain.Order, error) {
+	key, err := s.repo.FindObjectKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if key == "" {
+		return nil, domain.ErrOrderNotFound
+	}
+
+	r, err := s.store.Get(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("archived order %s: %w", id, err)
+	}
+	defer r.Close()
+
+	dec := json.NewDecoder(r)
+	for {
+		var rec backupRecord
+		err := dec.Decode(&rec)
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("archived order %s is missing from %s", id, key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("archived order %s: %w: %w", id, domain.ErrInvalidBackupRecord, err)
+		}
+		if rec.Order.ID.String() != id {
+			continue
+		}
+		b, err := rec.toBackup()
+		if err != nil {
+			return nil, fmt.Errorf("archived order %s: %w", id, err)
+		}
+		return b.Order, nil
+	}
+}
+
+func (s *archiveServiceImpl) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.ArchiveOrders(ctx); err != nil && ctx.Err() == nil {
+				slog.Warn("order archive failed", slog.String("error", err.Error()))
+			}
+		}
+	}
+}
+
+// archiveReadThrough is an OrderService whose GetOrderByID falls back to the
+// archive for orders no longer in the database
+type archiveReadThrough struct {
+	OrderService
+	archive ArchiveService
+}
+
+// NewArchiveReadThrough returns orders with GetOrderByID also finding
+// archived orders. Archived orders are read-only: every other method
+// behaves as if they did not exist.
+func NewArchiveReadThrough(orders OrderService, archive ArchiveService) OrderService {
+	return &archiveReadThrough{OrderService: orders, archive: archive}
+}
+
+func (s *archiveReadThrough) GetOrderByID(ctx context.Context, id string) (*domain.Order, error) {
+	order, err := s.OrderService.GetOrderByID(ctx, id)
+	if !errors.Is(err, domain.ErrOrderNotFound) {
+		return order, err
+	}
+	archived, archiveErr := s.archive.GetArchivedOrder(ctx, id)
+	if errors.Is(archiveErr, domain.ErrOrderNotFound) {
+		return nil, err
+	}
+	if archiveErr != nil {
+		return nil, archiveErr
+	}
+	if err := domain.AuthorizeCustomer(ctx, archived.CustomerID); err != nil {
+		return nil, err
+	}
+	return archived, nil
+}
@@ -0,0 +1,203 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/objectstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveService_ArchiveOrders_WritesPagesAndMarksThem(t *testing.T) {
+	now := time.Date(2026, 10, 17, 3, 0, 0, 0, time.UTC)
+	page := make([]*domain.OrderBackup, archivePageSize)
+	for i := range page {
+		page[i] = &domain.OrderBackup{Order: &domain.Order{ID: uuid.New(), Status: domain.OrderStatusDelivered, Version: 4}}
+	}
+	pages := [][]*domain.OrderBackup{page, {newTestBackup()}}
+	var keys []string
+	repo := &mocks.ArchiveRepositoryMock{
+		ListArchivableFunc: func(_ context.Context, statuses []domain.OrderStatus, updatedBefore time.Time, limit int) ([]*domain.OrderBackup, error) {
+			assert.Equal(t, completedStatuses, statuses)
+			assert.Equal(t, now.Add(-90*24*time.Hour), updatedBefore)
+			assert.Equal(t, archivePageSize, limit)
+			next := pages[0]
+			pages = pages[1:]
+			return next, nil
+		},
+		MarkArchivedFunc: func(_ context.Context, objectKey string, orders []*domain.Order) (int, error) {
+			keys = append(keys, objectKey)
+			return len(orders), nil
+		},
+	}
+	store := objectstore.NewFileStore(t.TempDir())
+	svc := NewArchiveService(repo, store, 90*24*time.Hour).(*archiveServiceImpl)
+	svc.now = func() time.Time { return now }
+
+	result, err := svc.ArchiveOrders(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, &ArchiveResult{Orders: archivePageSize + 1, Objects: 2}, result)
+	require.Len(t, keys, 2)
+	assert.NotEqual(t, keys[0], keys[1])
+	for i, want := range []int{archivePageSize, 1} {
+		assert.True(t, strings.HasPrefix(keys[i], "2026/10/17/"), keys[i])
+		r, err := store.Get(context.Background(), keys[i])
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		r.Close()
+		require.NoError(t, err)
+		assert.Equal(t, want, strings.Count(string(data), "\n"))
+	}
+}
+
+func TestArchiveService_ArchiveOrders_StopsWhenListedOrdersChanged(t *testing.T) {
+	page := make([]*domain.OrderBackup, archivePageSize)
+	for i := range page {
+		page[i] = &domain.OrderBackup{Order: &domain.Order{ID: uuid.New(), Status: domain.OrderStatusCancelled, Version: 2}}
+	}
+	var listed int
+	repo := &mocks.ArchiveRepositoryMock{
+		ListArchivableFunc: func(_ context.Context, _ []domain.OrderStatus, _ time.Time, _ int) ([]*domain.OrderBackup, error) {
+			listed++
+			return page, nil
+		},
+		MarkArchivedFunc: func(_ context.Context, _ string, _ []*domain.Order) (int, error) {
+			return 0, nil
+		},
+	}
+
+	result, err := NewArchiveService(repo, objectstore.NewFileStore(t.TempDir()), time.Hour).ArchiveOrders(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, listed)
+	assert.Equal(t, &ArchiveResult{Objects: 1}, result)
+}
+
+func TestArchiveService_GetArchivedOrder(t *testing.T) {
+	other := &domain.OrderBackup{Order: &domain.Order{ID: uuid.New(), Status: domain.OrderStatusDelivered, Version: 1}}
+	backup := newTestBackup()
+	var archivedKey string
+	repo := &mocks.ArchiveRepositoryMock{
+		ListArchivableFunc: func(_ context.Context, _ []domain.OrderStatus, _ time.Time, _ int) ([]*domain.OrderBackup, error) {
+			if archivedKey != "" {
+				return nil, nil
+			}
+			return []*domain.OrderBackup{other, backup}, nil
+		},
+		MarkArchivedFunc: func(_ context.Context, objectKey string, orders []*domain.Order) (int, error) {
+			archivedKey = objectKey
+			return len(orders), nil
+		},
+		FindObjectKeyFunc: func(_ context.Context, id string) (string, error) {
+			if id == backup.Order.ID.String() {
+				return archivedKey, nil
+			}
+			return "", nil
+		},
+	}
+	svc := NewArchiveService(repo, objectstore.NewFileStore(t.TempDir()), time.Hour)
+	_, err := svc.ArchiveOrders(context.Background())
+	require.NoError(t, err)
+
+	t.Run("archived", func(t *testing.T) {
+		order, err := svc.GetArchivedOrder(context.Background(), backup.Order.ID.String())
+
+		require.NoError(t, err)
+		assert.Equal(t, backup.Order, order)
+	})
+
+	t.Run("not archived", func(t *testing.T) {
+		_, err := svc.GetArchivedOrder(context.Background(), uuid.NewString())
+
+		assert.ErrorIs(t, err, domain.ErrOrderNotFound)
+	})
+}
+
+func TestArchiveReadThrough_GetOrderByID(t *testing.T) {
+	live := &domain.Order{ID: uuid.New(), CustomerID: "cust-1", Status: domain.OrderStatusPending, Version: 1}
+	archived := &domain.Order{ID: uuid.New(), CustomerID: "cust-1", Status: domain.OrderStatusDelivered, Version: 5}
+	orders := NewOrderService(&mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, id string) (*domain.Order, error) {
+			if id == live.ID.String() {
+				return live, nil
+			}
+			return nil, nil
+		},
+	}, nil, nil, nil, nil, nil)
+	archive := &stubArchive{orders: map[string]*domain.Order{archived.ID.String(): archived}}
+	svc := NewArchiveReadThrough(orders, archive)
+
+	tests := []struct {
+		name      string
+		id        string
+		principal *domain.Principal
+		want      *domain.Order
+		wantErr   error
+	}{
+		{name: "live order", id: live.ID.String(), want: live},
+		{name: "archived order", id: archived.ID.String(), want: archived},
+		{name: "neither", id: uuid.NewString(), wantErr: domain.ErrOrderNotFound},
+		{
+			name:      "archived order of another customer",
+			id:        archived.ID.String(),
+			principal: &domain.Principal{Role: domain.RoleCustomer, CustomerID: "cust-2"},
+			wantErr:   domain.ErrAccessDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = domain.WithPrincipal(ctx, tt.principal)
+			}
+
+			order, err := svc.GetOrderByID(ctx, tt.id)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, order)
+		})
+	}
+	assert.NotContains(t, archive.lookups, live.ID.String(), "live orders are not looked up in the archive")
+}
+
+// stubArchive serves GetArchivedOrder from a map
+type stubArchive struct {
+	ArchiveService
+	orders  map[string]*domain.Order
+	lookups []string
+}
+
+func (s *stubArchive) GetArchivedOrder(_ context.Context, id string) (*domain.Order, error) {
+	s.lookups = append(s.lookups, id)
+	if order, ok := s.orders[id]; ok {
+		return order, nil
+	}
+	return nil, domain.ErrOrderNotFound
+}
@@ -0,0 +1,421 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+const (
+	// backupFormat versions the records ExportOrders writes; RestoreOrders
+	// reads only this version
+	backupFormat = 1
+	// backupPageSize is the number of orders read or written per round trip
+	backupPageSize = 100
+)
+
+// BackupService copies orders out of the database and back in, for backups
+// and for moving them to a fresh database
+type BackupService interface {
+	// ExportOrders writes every order of every tenant, live and soft-deleted,
+	// with its history and notes to w, one JSON record per line in ID order.
+	// The result counts what was written, also when an error stops it.
+	ExportOrders(ctx context.Context, w io.Writer) (*BackupResult, error)
+
+	// RestoreOrders inserts the orders of an export read from r as they were
+	// exported, keeping their IDs, versions and timestamps, and publishes no
+	// events. Orders whose ID is taken are skipped, so an interrupted
+	// restore can be run again. A record that cannot be read stops the
+	// restore with domain.ErrInvalidBackupRecord; the result counts what was
+	// restored before it.
+	RestoreOrders(ctx context.Context, r io.Reader) (*BackupResult, error)
+}
+
+// BackupResult counts the orders an export or restore wrote and the
+// history entries and notes that came with them
+type BackupResult struct {
+	Orders  int
+	History int
+	Notes   int
+	// Skipped counts orders a restore left alone because their ID was taken
+	Skipped int
+}
+
+// backupServiceImpl implements BackupService
+type backupServiceImpl struct {
+	repo repository.BackupRepository
+}
+
+// NewBackupService creates a new BackupService
+func NewBackupService(repo repository.BackupRepository) BackupService {
+	return &backupServiceImpl{repo: repo}
+}
+
+func (s *backupServiceImpl) ExportOrders(ctx context.Context, w io.Writer) (*BackupResult, error) {
+	enc := json.NewEncoder(w)
+	result := &BackupResult{}
+	afterID := ""
+	for {
+		page, err := s.repo.ExportOrders(ctx, afterID, backupPageSize)
+		if err != nil {
+			return result, err
+		}
+		for _, b := range page {
+			if err := enc.Encode(newBackupRecord(b)); err != nil {
+				return result, err
+			}
+			result.count(b)
+		}
+		if len(page) < backupPageSize {
+			return result, nil
+		}
+		afterID = page[len(page)-1].Order.ID.String()
+	}
+}
+
+func (s *backupServiceImpl) RestoreOrders(ctx context.Context, r io.Reader) (*BackupResult, error) {
+	result := &BackupResult{}
+	batch := make([]*domain.OrderBackup, 0, backupPageSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		inserted, err := s.repo.ImportOrders(ctx, batch)
+		if err != nil {
+			return err
+		}
+		for i, ok := range inserted {
+			if ok {
+				result.count(batch[i])
+			} else {
+				result.Skipped++
+			}
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	dec := json.NewDecoder(r)
+	for n := 1; ; n++ {
+		var rec backupRecord
+		err := dec.Decode(&rec)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return result, fmt.Errorf("record %d: %w: %w", n, domain.ErrInvalidBackupRecord, err)
+		}
+		b, err := rec.toBackup()
+		if err != nil {
+			return result, fmt.Errorf("record %d: %w", n, err)
+		}
+
+		batch = append(batch, b)
+		if len(batch) == backupPageSize {
+			if err := flush(); err != nil {
+				return result, err
+			}
+		}
+	}
+	return result, flush()
+}
+
+// count adds an order written with its history and notes
+func (r *BackupResult) count(b *domain.OrderBackup) {
+	r.Orders++
+	r.History += len(b.History)
+	r.Notes += len(b.Notes)
+}
+
+// backupRecord is one line of an export. Its JSON form is the export format:
+// renaming or removing a field needs a new backupFormat.
+type backupRecord struct {
+	Format  int                  `json:"format"`
+	Order   backupOrder          `json:"order"`
+	History []backupHistoryEntry `json:"history"`
+	Notes   []backupNote         `json:"notes"`
+}
+
+type backupOrder struct {
+	ID                  uuid.UUID         `json:"id"`
+	TenantID            string            `json:"tenant_id,omitempty"`
+	CustomerID          string            `json:"customer_id"`
+	Items               []backupItem      `json:"items"`
+	Status              string            `json:"status"`
+	Total               float64           `json:"total"`
+	Version             int               `json:"version"`
+	CreatedAt           time.Time         `json:"created_at"`
+	UpdatedAt           time.Time         `json:"updated_at"`
+	DeletedAt           *time.Time        `json:"deleted_at,omitempty"`
+	Hold                *backupHold       `json:"hold,omitempty"`
+	Metadata            map[string]string `json:"metadata,omitempty"`
+	Tags                []string          `json:"tags,omitempty"`
+	ShippingAddress     *backupAddress    `json:"shipping_address,omitempty"`
+	BillingAddress      *backupAddress    `json:"billing_address,omitempty"`
+	ShippingMethod      string            `json:"shipping_method,omitempty"`
+	EstimatedDeliveryAt *time.Time        `json:"estimated_delivery_at,omitempty"`
+	SLABreachedAt       *time.Time        `json:"sla_breached_at,omitempty"`
+}
+
+type backupItem struct {
+	ID        uuid.UUID `json:"id"`
+	ProductID string    `json:"product_id"`
+	Name      string    `json:"name"`
+	Quantity  int       `json:"quantity"`
+	Price     float64   `json:"price"`
+	Subtotal  float64   `json:"subtotal"`
+	Status    string    `json:"status"`
+}
+
+type backupHold struct {
+	Reason         string     `json:"reason"`
+	PreviousStatus string     `json:"previous_status"`
+	HeldAt         time.Time  `json:"held_at"`
+	ReleaseAt      *time.Time `json:"release_at,omitempty"`
+}
+
+type backupAddress struct {
+	Name       string `json:"name,omitempty"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country"`
+}
+
+type backupHistoryEntry struct {
+	ID        uuid.UUID    `json:"id"`
+	Action    string       `json:"action"`
+	Actor     string       `json:"actor"`
+	ActorType string       `json:"actor_type,omitempty"`
+	Channel   string       `json:"channel,omitempty"`
+	OldState  *backupOrder `json:"old_state,omitempty"`
+	NewState  *backupOrder `json:"new_state"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+type backupNote struct {
+	ID         uuid.UUID `json:"id"`
+	Author     string    `json:"author"`
+	Body       string    `json:"body"`
+	Visibility string    `json:"visibility"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func newBackupRecord(b *domain.OrderBackup) backupRecord {
+	rec := backupRecord{
+		Format:  backupFormat,
+		Order:   *newBackupOrder(b.Order),
+		History: make([]backupHistoryEntry, len(b.History)),
+		Notes:   make([]backupNote, len(b.Notes)),
+	}
+	for i, e := range b.History {
+		rec.History[i] = backupHistoryEntry{
+			ID:        e.ID,
+			Action:    string(e.Action),
+			Actor:     e.Actor,
+			ActorType: string(e.ActorType),
+			Channel:   string(e.Channel),
+			OldState:  newBackupOrder(e.OldState),
+			NewState:  newBackupOrder(e.NewState),
+			CreatedAt: e.CreatedAt,
+		}
+	}
+	for i, n := range b.Notes {
+		rec.Notes[i] = backupNote{
+			ID:         n.ID,
+			Author:     n.Author,
+			Body:       n.Body,
+			Visibility: string(n.Visibility),
+			CreatedAt:  n.CreatedAt,
+		}
+	}
+	return rec
+}
+
+// newBackupOrder returns nil for a nil order, as for the old state of a
+// creation
+func newBackupOrder(o *domain.Order) *backupOrder {
+	if o == nil {
+		return nil
+	}
+	b := &backupOrder{
+		ID:                  o.ID,
+		TenantID:            o.TenantID,
+		CustomerID:          o.CustomerID,
+		Items:               make([]backupItem, len(o.Items)),
+		Status:              string(o.Status),
+		Total:               o.Total,
+		Version:             o.Version,
+		CreatedAt:           o.CreatedAt,
+		UpdatedAt:           o.UpdatedAt,
+		DeletedAt:           o.DeletedAt,
+		Metadata:            o.Metadata,
+		Tags:                o.Tags,
+		ShippingAddress:     newBackupAddress(o.ShippingAddress),
+		BillingAddress:      newBackupAddress(o.BillingAddress),
+		ShippingMethod:      o.ShippingMethod,
+		EstimatedDeliveryAt: o.EstimatedDeliveryAt,
+		SLABreachedAt:       o.SLABreachedAt,
+	}
+	for i, item := range o.Items {
+		b.Items[i] = backupItem{
+			ID:        item.ID,
+			ProductID: item.ProductID,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			Price:     item.Price,
+			Subtotal:  item.Subtotal,
+			Status:    string(item.Status),
+		}
+	}
+	if o.Hold != nil {
+		b.Hold = &backupHold{
+			Reason:         o.Hold.Reason,
+			PreviousStatus: string(o.Hold.PreviousStatus),
+			HeldAt:         o.Hold.HeldAt,
+			ReleaseAt:      o.Hold.ReleaseAt,
+		}
+	}
+	return b
+}
+
+func newBackupAddress(a *domain.Address) *backupAddress {
+	if a == nil {
+		return nil
+	}
+	b := backupAddress(*a)
+	return &b
+}
+
+// toBackup checks the record and converts it back to what was exported
+func (rec *backupRecord) toBackup() (*domain.OrderBackup, error) {
+	if rec.Format != backupFormat {
+		return nil, fmt.Errorf("%w: format %d, want %d", domain.ErrInvalidBackupRecord, rec.Format, backupFormat)
+	}
+	order, err := rec.Order.toOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	b := &domain.OrderBackup{
+		Order:   order,
+		History: make([]*domain.OrderHistoryEntry, len(rec.History)),
+		Notes:   make([]*domain.OrderNote, len(rec.Notes)),
+	}
+	for i, e := range rec.History {
+		if e.ID == uuid.Nil || e.NewState == nil {
+			return nil, fmt.Errorf("%w: history entry %d of order %s is incomplete", domain.ErrInvalidBackupRecord, i, order.ID)
+		}
+		entry := &domain.OrderHistoryEntry{
+			ID:        e.ID,
+			OrderID:   order.ID,
+			Action:    domain.HistoryAction(e.Action),
+			Actor:     e.Actor,
+			ActorType: domain.ActorType(e.ActorType),
+			Channel:   domain.Channel(e.Channel),
+			CreatedAt: e.CreatedAt,
+		}
+		if entry.NewState, err = e.NewState.toOrder(); err != nil {
+			return nil, err
+		}
+		if e.OldState != nil {
+			if entry.OldState, err = e.OldState.toOrder(); err != nil {
+				return nil, err
+			}
+		}
+		b.History[i] = entry
+	}
+	for i, n := range rec.Notes {
+		visibility := domain.NoteVisibility(n.Visibility)
+		if n.ID == uuid.Nil || !visibility.IsValid() {
+			return nil, fmt.Errorf("%w: note %d of order %s is invalid", domain.ErrInvalidBackupRecord, i, order.ID)
+		}
+		b.Notes[i] = &domain.OrderNote{
+			ID:         n.ID,
+			OrderID:    order.ID,
+			Author:     n.Author,
+			Body:       n.Body,
+			Visibility: visibility,
+			CreatedAt:  n.CreatedAt,
+		}
+	}
+	return b, nil
+}
+
+func (b *backupOrder) toOrder() (*domain.Order, error) {
+	status := domain.OrderStatus(b.Status)
+	if b.ID == uuid.Nil || !status.IsValid() || b.Version < 1 {
+		return nil, fmt.Errorf("%w: order %s has no ID, an unknown status or no version", domain.ErrInvalidBackupRecord, b.ID)
+	}
+
+	o := &domain.Order{
+		ID:                  b.ID,
+		TenantID:            b.TenantID,
+		CustomerID:          b.CustomerID,
+		Items:               make([]domain.OrderItem, len(b.Items)),
+		Status:              status,
+		Total:               b.Total,
+		Version:             b.Version,
+		CreatedAt:           b.CreatedAt,
+		UpdatedAt:           b.UpdatedAt,
+		DeletedAt:           b.DeletedAt,
+		Metadata:            b.Metadata,
+		Tags:                b.Tags,
+		ShippingAddress:     b.ShippingAddress.toAddress(),
+		BillingAddress:      b.BillingAddress.toAddress(),
+		ShippingMethod:      b.ShippingMethod,
+		EstimatedDeliveryAt: b.EstimatedDeliveryAt,
+		SLABreachedAt:       b.SLABreachedAt,
+	}
+	for i, item := range b.Items {
+		o.Items[i] = domain.OrderItem{
+			ID:        item.ID,
+			ProductID: item.ProductID,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			Price:     item.Price,
+			Subtotal:  item.Subtotal,
+			Status:    domain.ItemStatus(item.Status),
+		}
+	}
+	if b.Hold != nil {
+		o.Hold = &domain.OrderHold{
+			Reason:         b.Hold.Reason,
+			PreviousStatus: domain.OrderStatus(b.Hold.PreviousStatus),
+			HeldAt:         b.Hold.HeldAt,
+			ReleaseAt:      b.Hold.ReleaseAt,
+		}
+	}
+	return o, nil
+}
+
+func (a *backupAddress) toAddress() *domain.Address {
+	if a == nil {
+		return nil
+	}
+	addr := domain.Address(*a)
+	return &addr
+}
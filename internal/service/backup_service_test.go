@@ -0,0 +1,198 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestBackup returns a soft-deleted order that was held, with addresses,
+// labels, two history entries and a note
+func newTestBackup() *domain.OrderBackup {
+	created := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	held := created.Add(time.Hour)
+	deleted := created.Add(2 * time.Hour)
+	releaseAt := created.Add(48 * time.Hour)
+	address := &domain.Address{Name: "Ada", Line1: "1 Main St", City: "Springfield", Country: "US"}
+
+	order := &domain.Order{
+		ID:         uuid.New(),
+		TenantID:   "acme",
+		CustomerID: uuid.NewString(),
+		Items: []domain.OrderItem{
+			{ID: uuid.New(), ProductID: "p-1", Name: "Widget", Quantity: 2, Price: 10.05, Subtotal: 20.10, Status: domain.ItemStatusPending},
+		},
+		Status:    domain.OrderStatusOnHold,
+		Total:     20.10,
+		Version:   3,
+		CreatedAt: created,
+		UpdatedAt: deleted,
+		DeletedAt: &deleted,
+		Hold: &domain.OrderHold{
+			Reason:         "fraud review",
+			PreviousStatus: domain.OrderStatusConfirmed,
+			HeldAt:         held,
+			ReleaseAt:      &releaseAt,
+		},
+		Metadata:        map[string]string{"channel": "web"},
+		Tags:            []string{"vip"},
+		ShippingAddress: address,
+		BillingAddress:  address,
+		ShippingMethod:  "express",
+	}
+	first := order.Clone()
+	first.Status, first.Version, first.Hold, first.DeletedAt, first.UpdatedAt = domain.OrderStatusConfirmed, 1, nil, nil, created
+
+	return &domain.OrderBackup{
+		Order: order,
+		History: []*domain.OrderHistoryEntry{
+			{ID: uuid.New(), OrderID: order.ID, Action: domain.HistoryActionCreated, Actor: "ada", ActorType: domain.ActorTypeUser, Channel: domain.ChannelHTTP, NewState: first, CreatedAt: created},
+			{ID: uuid.New(), OrderID: order.ID, Action: domain.HistoryActionHeld, Actor: domain.ActorSystem, OldState: first, NewState: order, CreatedAt: held},
+		},
+		Notes: []*domain.OrderNote{
+			{ID: uuid.New(), OrderID: order.ID, Author: "support", Body: "called the customer", Visibility: domain.NoteVisibilityInternal, CreatedAt: held},
+		},
+	}
+}
+
+func TestBackupService_ExportOrders_PagesByID(t *testing.T) {
+	page := make([]*domain.OrderBackup, backupPageSize)
+	for i := range page {
+		page[i] = &domain.OrderBackup{Order: &domain.Order{ID: uuid.New(), Status: domain.OrderStatusPending, Version: 1}}
+	}
+	var afterIDs []string
+	repo := &mocks.BackupRepositoryMock{
+		ExportOrdersFunc: func(_ context.Context, afterID string, limit int) ([]*domain.OrderBackup, error) {
+			afterIDs = append(afterIDs, afterID)
+			assert.Equal(t, backupPageSize, limit)
+			if afterID == "" {
+				return page, nil
+			}
+			return []*domain.OrderBackup{newTestBackup()}, nil
+		},
+	}
+	var out bytes.Buffer
+
+	result, err := NewBackupService(repo).ExportOrders(context.Background(), &out)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", page[len(page)-1].Order.ID.String()}, afterIDs)
+	assert.Equal(t, &BackupResult{Orders: backupPageSize + 1, History: 2, Notes: 1}, result)
+	assert.Equal(t, backupPageSize+1, strings.Count(out.String(), "\n"))
+}
+
+func TestBackupService_RestoreOrders_RestoresWhatWasExported(t *testing.T) {
+	backup := newTestBackup()
+	var out bytes.Buffer
+	_, err := NewBackupService(&mocks.BackupRepositoryMock{
+		ExportOrdersFunc: func(_ context.Context, _ string, _ int) ([]*domain.OrderBackup, error) {
+			return []*domain.OrderBackup{backup}, nil
+		},
+	}).ExportOrders(context.Background(), &out)
+	require.NoError(t, err)
+
+	var imported []*domain.OrderBackup
+	repo := &mocks.BackupRepositoryMock{
+		ImportOrdersFunc: func(_ context.Context, backups []*domain.OrderBackup) ([]bool, error) {
+			imported = append(imported, backups...)
+			return []bool{true}, nil
+		},
+	}
+	result, err := NewBackupService(repo).RestoreOrders(context.Background(), &out)
+
+	require.NoError(t, err)
+	assert.Equal(t, &BackupResult{Orders: 1, History: 2, Notes: 1}, result)
+	require.Len(t, imported, 1)
+	assert.Equal(t, backup, imported[0])
+}
+
+func TestBackupService_RestoreOrders_BatchesAndCountsSkipped(t *testing.T) {
+	var out bytes.Buffer
+	for range backupPageSize + 50 {
+		rec := newBackupRecord(&domain.OrderBackup{Order: &domain.Order{ID: uuid.New(), Status: domain.OrderStatusPending, Version: 1}})
+		line, err := jsonLine(rec)
+		require.NoError(t, err)
+		out.WriteString(line)
+	}
+	var batches []int
+	repo := &mocks.BackupRepositoryMock{
+		ImportOrdersFunc: func(_ context.Context, backups []*domain.OrderBackup) ([]bool, error) {
+			batches = append(batches, len(backups))
+			inserted := make([]bool, len(backups))
+			for i := range inserted {
+				// The first order of each batch is already there
+				inserted[i] = i > 0
+			}
+			return inserted, nil
+		},
+	}
+
+	result, err := NewBackupService(repo).RestoreOrders(context.Background(), &out)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{backupPageSize, 50}, batches)
+	assert.Equal(t, &BackupResult{Orders: backupPageSize + 48, Skipped: 2}, result)
+}
+
+func TestBackupService_RestoreOrders_InvalidRecords(t *testing.T) {
+	valid, err := jsonLine(newBackupRecord(newTestBackup()))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "malformed JSON", input: valid + `{"format": 1, "order": `},
+		{name: "other format", input: valid + strings.Replace(valid, `"format":1`, `"format":2`, 1)},
+		{name: "unknown status", input: valid + strings.Replace(valid, `"status":"on_hold"`, `"status":"lost"`, 1)},
+		{name: "note visibility", input: valid + strings.Replace(valid, `"visibility":"internal"`, `"visibility":"public"`, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var imported int
+			repo := &mocks.BackupRepositoryMock{
+				ImportOrdersFunc: func(_ context.Context, backups []*domain.OrderBackup) ([]bool, error) {
+					imported += len(backups)
+					return make([]bool, len(backups)), nil
+				},
+			}
+
+			_, err := NewBackupService(repo).RestoreOrders(context.Background(), strings.NewReader(tt.input))
+
+			assert.ErrorIs(t, err, domain.ErrInvalidBackupRecord)
+			assert.ErrorContains(t, err, "record 2")
+			assert.Zero(t, imported, "the batch holding the valid record is not written")
+		})
+	}
+}
+
+// jsonLine encodes v as an export line
+func jsonLine(v any) (string, error) {
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(v)
+	return buf.String(), err
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/cache"
+)
+
+// invalidateOrder evicts an order of the tenant and every cached list page of
+// its customer after the order changed. Cache errors are logged, not
+// returned: the database write has already succeeded and the entries expire
+// on their own.
+func invalidateOrder(ctx context.Context, c cache.OrderCache, tenantID, id, customerID string) {
+	if c == nil {
+		return
+	}
+	if err := c.Delete(ctx, tenantID, id); err != nil {
+		slog.WarnContext(ctx, "cache delete failed", slog.String("order_id", id), slog.String("error", err.Error()))
+	}
+	invalidateCustomerLists(ctx, c, tenantID, customerID)
+}
+
+// invalidateCustomerLists evicts every cached list page of the tenant's customer.
+func invalidateCustomerLists(ctx context.Context, c cache.OrderCache, tenantID, customerID string) {
+	if c == nil {
+		return
+	}
+	pattern := cache.CustomerListPattern(tenantID, customerID)
+	if err := c.DeletePattern(ctx, pattern); err != nil {
+		slog.WarnContext(ctx, "cache delete pattern failed", slog.String("pattern", pattern), slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// Settings are the service tunables that may change while the process runs
+type Settings struct {
+	// OrderCacheTTL is how long an order read from the database stays cached
+	OrderCacheTTL time.Duration
+	// OrderListCacheTTL is how long a page of a customer's orders stays
+	// cached; zero disables list caching
+	OrderListCacheTTL time.Duration
+	// MaxPageSize caps the page size of order lists and searches
+	MaxPageSize int
+	// EstimateListTotals estimates the total of unfiltered order lists from
+	// table statistics instead of counting every order
+	EstimateListTotals bool
+	// HoldReleaseAfter is how long an order stays on hold before it is
+	// released automatically; zero keeps holds until released explicitly
+	HoldReleaseAfter time.Duration
+	// DeliveryTransitTimes maps each accepted shipping method to the time
+	// from confirmation to its estimated delivery. With none, orders carry
+	// no shipping method or delivery estimate.
+	DeliveryTransitTimes map[string]time.Duration
+	// DefaultShippingMethod is used for orders created without one
+	DefaultShippingMethod string
+	// RequirePayment makes paying the only way a customer confirms a
+	// pending order; customer tokens may not confirm one directly
+	RequirePayment bool
+	// RequireServerPricing rejects items whose product the PricingService
+	// cannot price, instead of keeping the client-supplied price
+	RequireServerPricing bool
+	// OrderLimits caps the items, item quantities and total of an order
+	// when it is created or its items change
+	OrderLimits domain.OrderLimits
+}
+
+// DefaultSettings are used when a service is created without a ConfigProvider
+var DefaultSettings = Settings{
+	OrderCacheTTL:     5 * time.Minute,
+	OrderListCacheTTL: 30 * time.Second,
+	MaxPageSize:       100,
+}
+
+// ConfigProvider supplies the current Settings. The values may change
+// between calls, e.g. after a configuration reload, so services read them
+// per request rather than keeping a copy.
+type ConfigProvider interface {
+	Settings() Settings
+}
+
+// StaticConfig is a ConfigProvider whose Settings never change
+type StaticConfig Settings
+
+// Settings returns the fixed settings
+func (c StaticConfig) Settings() Settings {
+	return Settings(c)
+}
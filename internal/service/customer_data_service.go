@@ -0,0 +1,87 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/cache"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// CustomerDataService handles data protection requests for a customer
+type CustomerDataService interface {
+	// EraseCustomerData anonymizes all of a customer's orders (GDPR Art. 17).
+	// Returns domain.ErrCustomerNotFound if the customer has no orders.
+	EraseCustomerData(ctx context.Context, customerID string) (*domain.CustomerErasure, error)
+}
+
+// customerDataServiceImpl implements CustomerDataService
+type customerDataServiceImpl struct {
+	repo      repository.CustomerDataRepository
+	cache     cache.OrderCache
+	publisher EventPublisher
+}
+
+// NewCustomerDataService creates a new CustomerDataService
+func NewCustomerDataService(repo repository.CustomerDataRepository, orderCache cache.OrderCache, publisher EventPublisher) CustomerDataService {
+	return &customerDataServiceImpl{
+		repo:      repo,
+		cache:     orderCache,
+		publisher: publisher,
+	}
+}
+
+func (s *customerDataServiceImpl) EraseCustomerData(ctx context.Context, customerID string) (*domain.CustomerErasure, error) {
+	if customerID == "" {
+		return nil, domain.ErrInvalidCustomerID
+	}
+	if err := domain.AuthorizeCustomer(ctx, customerID); err != nil {
+		return nil, err
+	}
+
+	erasure := domain.NewCustomerErasure(customerID, domain.ActorFromContext(ctx))
+	erasure.TenantID = domain.TenantID(ctx)
+	ids, err := s.repo.EraseCustomer(ctx, erasure)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, domain.ErrCustomerNotFound
+	}
+	erasure.OrderCount = len(ids)
+
+	// Publish event (warn + continue on failure)
+	if s.publisher != nil {
+		if err := s.publisher.PublishCustomerDataErased(ctx, erasure); err != nil {
+			slog.WarnContext(ctx, "failed to publish customer.data_erased event", slog.String("erasure_id", erasure.ID.String()), slog.String("error", err.Error()))
+		}
+	}
+
+	// Cached copies still hold the original data
+	tenantID := domain.TenantID(ctx)
+	if s.cache != nil {
+		for _, id := range ids {
+			if err := s.cache.Delete(ctx, tenantID, id.String()); err != nil {
+				slog.WarnContext(ctx, "cache delete failed", slog.String("order_id", id.String()), slog.String("error", err.Error()))
+			}
+		}
+	}
+	invalidateCustomerLists(ctx, s.cache, tenantID, customerID)
+
+	return erasure, nil
+}
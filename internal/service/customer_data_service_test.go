@@ -0,0 +1,98 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomerDataService_EraseCustomerData_PublishesEventAndEvictsOrders(t *testing.T) {
+	orderIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	var gotErasure *domain.CustomerErasure
+	repo := &mocks.CustomerDataRepositoryMock{
+		EraseCustomerFunc: func(_ context.Context, erasure *domain.CustomerErasure) ([]uuid.UUID, error) {
+			gotErasure = erasure
+			return orderIDs, nil
+		},
+	}
+	var published *domain.CustomerErasure
+	publisher := &mocks.EventPublisherMock{
+		PublishCustomerDataErasedFunc: func(_ context.Context, erasure *domain.CustomerErasure) error {
+			published = erasure
+			return nil
+		},
+	}
+	var evicted []string
+	orderCache := &mocks.OrderCacheMock{
+		DeleteFunc: func(_ context.Context, _, id string) error {
+			evicted = append(evicted, id)
+			return nil
+		},
+	}
+
+	svc := NewCustomerDataService(repo, orderCache, publisher)
+	ctx := domain.WithActor(context.Background(), "dpo@example.com")
+	erasure, err := svc.EraseCustomerData(ctx, "cust-42")
+
+	require.NoError(t, err)
+	assert.Equal(t, "cust-42", gotErasure.CustomerID)
+	assert.Equal(t, "dpo@example.com", erasure.Actor)
+	assert.Equal(t, 2, erasure.OrderCount)
+	assert.Same(t, erasure, published)
+	assert.Equal(t, []string{orderIDs[0].String(), orderIDs[1].String()}, evicted)
+}
+
+func TestCustomerDataService_EraseCustomerData_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		customerID string
+		eraseErr   error
+		wantErr    error
+	}{
+		{name: "empty customer id", customerID: "", wantErr: domain.ErrInvalidCustomerID},
+		{name: "no orders", customerID: "cust-1", wantErr: domain.ErrCustomerNotFound},
+		{name: "repository error", customerID: "cust-1", eraseErr: errors.New("db down"), wantErr: errors.New("db down")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.CustomerDataRepositoryMock{
+				EraseCustomerFunc: func(_ context.Context, _ *domain.CustomerErasure) ([]uuid.UUID, error) {
+					return nil, tt.eraseErr
+				},
+			}
+			publisher := &mocks.EventPublisherMock{
+				PublishCustomerDataErasedFunc: func(_ context.Context, _ *domain.CustomerErasure) error {
+					t.Fatal("must not publish when nothing was erased")
+					return nil
+				},
+			}
+
+			svc := NewCustomerDataService(repo, nil, publisher)
+			erasure, err := svc.EraseCustomerData(context.Background(), tt.customerID)
+
+			assert.EqualError(t, err, tt.wantErr.Error())
+			assert.Nil(t, erasure)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+)
+
+// DeadLetterService exposes the event dead-letter queue to operators
+type DeadLetterService interface {
+	// ListDeadLetters returns dead-lettered events, newest first
+	ListDeadLetters(ctx context.Context, limit, offset int) (*DeadLetterList, error)
+
+	// RequeueDeadLetter schedules a dead-lettered event for immediate redelivery
+	RequeueDeadLetter(ctx context.Context, id string) error
+}
+
+// DeadLetterList is a page of dead-lettered events
+type DeadLetterList struct {
+	Data  []*messaging.DeadLetter
+	Total int64
+}
+
+// deadLetterServiceImpl implements DeadLetterService
+type deadLetterServiceImpl struct {
+	store messaging.DeadLetterStore
+}
+
+// NewDeadLetterService creates a new DeadLetterService
+func NewDeadLetterService(store messaging.DeadLetterStore) DeadLetterService {
+	return &deadLetterServiceImpl{store: store}
+}
+
+func (s *deadLetterServiceImpl) ListDeadLetters(ctx context.Context, limit, offset int) (*DeadLetterList, error) {
+	if limit < 1 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	letters, total, err := s.store.List(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return &DeadLetterList{Data: letters, Total: total}, nil
+}
+
+func (s *deadLetterServiceImpl) RequeueDeadLetter(ctx context.Context, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return messaging.ErrDeadLetterNotFound
+	}
+	return s.store.Requeue(ctx, id)
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeadLetterService_ListDeadLetters_ClampsPagination(t *testing.T) {
+	tests := []struct {
+		name       string
+		limit      int
+		offset     int
+		wantLimit  int
+		wantOffset int
+	}{
+		{name: "defaults", limit: 0, offset: -5, wantLimit: 20, wantOffset: 0},
+		{name: "max limit", limit: 500, offset: 10, wantLimit: 100, wantOffset: 10},
+		{name: "passthrough", limit: 50, offset: 50, wantLimit: 50, wantOffset: 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotLimit, gotOffset int
+			store := &mocks.DeadLetterStoreMock{
+				ListFunc: func(_ context.Context, limit, offset int) ([]*messaging.DeadLetter, int64, error) {
+					gotLimit, gotOffset = limit, offset
+					return []*messaging.DeadLetter{{ID: uuid.New()}}, 1, nil
+				},
+			}
+
+			svc := NewDeadLetterService(store)
+			result, err := svc.ListDeadLetters(context.Background(), tt.limit, tt.offset)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantLimit, gotLimit)
+			assert.Equal(t, tt.wantOffset, gotOffset)
+			assert.Len(t, result.Data, 1)
+			assert.Equal(t, int64(1), result.Total)
+		})
+	}
+}
+
+func TestDeadLetterService_RequeueDeadLetter_InvalidID_ReturnsNotFound(t *testing.T) {
+	store := &mocks.DeadLetterStoreMock{
+		RequeueFunc: func(_ context.Context, _ string) error {
+			t.Fatal("store must not be called for an invalid ID")
+			return nil
+		},
+	}
+
+	svc := NewDeadLetterService(store)
+	err := svc.RequeueDeadLetter(context.Background(), "not-a-uuid")
+
+	assert.ErrorIs(t, err, messaging.ErrDeadLetterNotFound)
+}
+
+func TestDeadLetterService_RequeueDeadLetter_PropagatesStoreError(t *testing.T) {
+	id := uuid.New().String()
+	storeErr := errors.New("db down")
+	var requeued string
+	store := &mocks.DeadLetterStoreMock{
+		RequeueFunc: func(_ context.Context, gotID string) error {
+			requeued = gotID
+			return storeErr
+		},
+	}
+
+	svc := NewDeadLetterService(store)
+	err := svc.RequeueDeadLetter(context.Background(), id)
+
+	assert.ErrorIs(t, err, storeErr)
+	assert.Equal(t, id, requeued)
+}
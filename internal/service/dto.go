@@ -15,18 +15,79 @@
 // Package service implements business logic for order operations.
 package service
 
-import "github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+import (
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
 
 // CreateOrderDTO represents data for creating an order
 type CreateOrderDTO struct {
 	CustomerID string
 	Items      []domain.OrderItem
+	Metadata   map[string]string
+	Tags       []string
+	// ShippingAddress and BillingAddress are optional
+	ShippingAddress *domain.Address
+	BillingAddress  *domain.Address
+	// ShippingMethod must be one of Settings.DeliveryTransitTimes; empty
+	// uses Settings.DefaultShippingMethod
+	ShippingMethod string
 }
 
-// UpdateOrderDTO represents data for updating an order
+// UpdateOrderDTO represents data for updating an order. Nil Metadata or Tags
+// leave them unchanged; empty ones clear them.
 type UpdateOrderDTO struct {
-	Items  []domain.OrderItem
-	Status *domain.OrderStatus
+	Items    []domain.OrderItem
+	Status   *domain.OrderStatus
+	Metadata map[string]string
+	Tags     []string
+}
+
+// PatchOrderDTO is a JSON merge patch (RFC 7386) of an order's items and
+// labels. Members left nil are unchanged.
+type PatchOrderDTO struct {
+	// Items is keyed by item. A key that is a UUID names an existing item,
+	// removed when its value is nil and otherwise changed by it; any other
+	// key adds the item its value describes.
+	Items map[string]*ItemPatch
+	// Metadata sets each entry, removing those whose value is nil.
+	// ClearMetadata removes every entry first.
+	Metadata      map[string]*string
+	ClearMetadata bool
+	// Tags replaces the tags; a pointer to a nil slice clears them
+	Tags *[]string
+}
+
+// ItemPatch is one value of PatchOrderDTO.Items. An existing item accepts
+// Name and Quantity only; a new item needs ProductID, Name and Quantity,
+// and Price unless the pricing service prices the product.
+type ItemPatch struct {
+	ProductID *string
+	Name      *string
+	Quantity  *int
+	Price     *float64
+}
+
+// MaxBulkStatusOrders caps the number of orders in one bulk status update
+const MaxBulkStatusOrders = 100
+
+// MaxBulkCreateOrders caps the number of orders in one bulk create
+const MaxBulkCreateOrders = 100
+
+// BulkCreateResult is the outcome of one order in a bulk create.
+// Exactly one of Order and Err is set.
+type BulkCreateResult struct {
+	Order *domain.Order
+	Err   error
+}
+
+// BulkStatusResult is the outcome of one order in a bulk status update.
+// Exactly one of Order and Err is set.
+type BulkStatusResult struct {
+	OrderID string
+	Order   *domain.Order
+	Err     error
 }
 
 // ListOrdersRequest represents pagination and filtering options
@@ -35,4 +96,58 @@ type ListOrdersRequest struct {
 	PageSize   int
 	Status     *domain.OrderStatus
 	CustomerID *string
+	ProductID  *string
+	// Tags restricts the list to orders carrying every one of these tags
+	Tags []string
+	// ExactTotal counts the matches even when Settings.EstimateListTotals
+	// would estimate them
+	ExactTotal bool
+	// IncludeDeleted also lists soft-deleted orders. Callers limited to one
+	// customer get domain.ErrAccessDenied.
+	IncludeDeleted bool
+	// Offset, when positive, starts the page after this many orders instead
+	// of at Page, for callers paging by limit and offset
+	Offset int
+}
+
+// MaxSearchQueryLength caps the length of a free-text search query
+const MaxSearchQueryLength = 100
+
+// SearchOrdersRequest represents a free-text order search and its filters
+type SearchOrdersRequest struct {
+	Query      string
+	Page       int
+	PageSize   int
+	Status     *domain.OrderStatus
+	CustomerID *string
+	ProductID  *string
+	MinTotal   *float64
+	MaxTotal   *float64
+	// Offset, when positive, starts the page after this many orders instead
+	// of at Page, as in ListOrdersRequest
+	Offset int
+}
+
+// CreateSubscriptionDTO represents data for creating a recurring order subscription
+type CreateSubscriptionDTO struct {
+	CustomerID string
+	Items      []domain.OrderItem
+	Cadence    domain.Cadence
+	// FirstRunAt is when the first order is placed; zero places it on the
+	// next scheduler pass
+	FirstRunAt time.Time
+	// ShippingMethod is checked as in CreateOrderDTO; empty uses the
+	// default in effect when each order is placed
+	ShippingMethod string
+	// ShippingAddress is optional
+	ShippingAddress *domain.Address
+}
+
+// UpdateSubscriptionDTO replaces the order template of a subscription. The
+// customer, status and next run are unchanged.
+type UpdateSubscriptionDTO struct {
+	Items           []domain.OrderItem
+	Cadence         domain.Cadence
+	ShippingMethod  string
+	ShippingAddress *domain.Address
 }
@@ -25,4 +25,8 @@ type EventPublisher interface {
 	PublishOrderCreated(ctx context.Context, order *domain.Order) error
 	PublishOrderUpdated(ctx context.Context, order *domain.Order) error
 	PublishOrderStatusChanged(ctx context.Context, order *domain.Order, oldStatus, newStatus domain.OrderStatus) error
+	PublishOrderRestored(ctx context.Context, order *domain.Order) error
+	PublishOrderDeleted(ctx context.Context, order *domain.Order) error
+	PublishCustomerDataErased(ctx context.Context, erasure *domain.CustomerErasure) error
+	PublishOrderSLABreached(ctx context.Context, order *domain.Order) error
 }
@@ -0,0 +1,221 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// Event replay targets
+const (
+	// ReplayTargetBroker re-publishes events through the configured message broker
+	ReplayTargetBroker = "broker"
+	// ReplayTargetWebhook posts events to a caller-supplied URL
+	ReplayTargetWebhook = "webhook"
+)
+
+const (
+	defaultReplayLimit = 500
+	maxReplayLimit     = 5000
+	replayPageSize     = 100
+)
+
+// WebhookSender posts an event to an HTTP endpoint
+type WebhookSender interface {
+	SendEvent(ctx context.Context, url string, evt messaging.OrderEvent) error
+}
+
+// EventReplayService re-sends the events recorded in order history, so
+// consumers can recover from bugs that lost or mishandled them
+type EventReplayService interface {
+	// ReplayEvents sends the events of the history entries selected by req,
+	// oldest first, and stops at the first delivery failure with
+	// domain.ErrReplayDeliveryFailed. The result is returned either way; its
+	// NextCursor continues after the last event delivered.
+	ReplayEvents(ctx context.Context, req EventReplayRequest) (*EventReplayResult, error)
+}
+
+// EventReplayRequest selects history entries to replay and where to send them
+type EventReplayRequest struct {
+	// OrderID limits the replay to one order. Without it From is required.
+	OrderID string
+	// From and To bound when the changes happened, inclusive and exclusive
+	From *time.Time
+	To   *time.Time
+	// Target is ReplayTargetBroker or ReplayTargetWebhook
+	Target     string
+	WebhookURL string
+	// Limit caps the entries read in this call; defaults to 500, at most 5000
+	Limit int
+	// Cursor continues a previous replay from its NextCursor
+	Cursor string
+}
+
+// EventReplayResult reports how far a replay got
+type EventReplayResult struct {
+	// Replayed counts the events delivered
+	Replayed int
+	// Skipped counts entries with no event to rebuild, such as erasures
+	Skipped int
+	// NextCursor continues the replay when it stopped before the end of the
+	// range; empty once every entry has been read
+	NextCursor string
+}
+
+// eventReplayServiceImpl implements EventReplayService
+type eventReplayServiceImpl struct {
+	history  repository.OrderHistoryRepository
+	broker   messaging.EventSender
+	webhooks WebhookSender
+}
+
+// NewEventReplayService creates a new EventReplayService. broker is nil when
+// no message broker is configured, which leaves only webhook replays.
+func NewEventReplayService(history repository.OrderHistoryRepository, broker messaging.EventSender, webhooks WebhookSender) EventReplayService {
+	return &eventReplayServiceImpl{
+		history:  history,
+		broker:   broker,
+		webhooks: webhooks,
+	}
+}
+
+func (s *eventReplayServiceImpl) ReplayEvents(ctx context.Context, req EventReplayRequest) (*EventReplayResult, error) {
+	send, err := s.sender(req)
+	if err != nil {
+		return nil, err
+	}
+
+	q := repository.HistoryRangeQuery{OrderID: req.OrderID}
+	if req.OrderID != "" {
+		if _, err := uuid.Parse(req.OrderID); err != nil {
+			return nil, domain.ErrOrderNotFound
+		}
+	} else if req.From == nil {
+		// Replaying all of history by accident would flood consumers
+		return nil, domain.ErrInvalidReplayRange
+	}
+	if req.From != nil {
+		q.From = *req.From
+	}
+	if req.To != nil {
+		if req.From != nil && !req.From.Before(*req.To) {
+			return nil, domain.ErrInvalidReplayRange
+		}
+		q.To = *req.To
+	}
+	if req.Cursor != "" {
+		if q.AfterTime, q.AfterID, err = decodeReplayCursor(req.Cursor); err != nil {
+			return nil, err
+		}
+		q.After = true
+	}
+
+	limit := req.Limit
+	if limit < 1 {
+		limit = defaultReplayLimit
+	}
+	if limit > maxReplayLimit {
+		limit = maxReplayLimit
+	}
+
+	result := &EventReplayResult{}
+	read := 0
+	for read < limit {
+		q.Limit = min(replayPageSize, limit-read)
+		entries, err := s.history.ListRange(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			if evt, ok := messaging.NewHistoryEvent(entry); ok {
+				if err := send(ctx, evt); err != nil {
+					return result, fmt.Errorf("%w: %w", domain.ErrReplayDeliveryFailed, err)
+				}
+				result.Replayed++
+			} else {
+				result.Skipped++
+			}
+			q.After, q.AfterTime, q.AfterID = true, entry.CreatedAt, entry.ID
+			result.NextCursor = encodeReplayCursor(entry.CreatedAt, entry.ID)
+		}
+
+		read += len(entries)
+		if len(entries) < q.Limit {
+			// End of the range
+			result.NextCursor = ""
+			break
+		}
+	}
+	return result, nil
+}
+
+// sender returns the function that delivers events to req's target
+func (s *eventReplayServiceImpl) sender(req EventReplayRequest) (func(context.Context, messaging.OrderEvent) error, error) {
+	switch req.Target {
+	case ReplayTargetBroker:
+		if s.broker == nil {
+			return nil, domain.ErrReplayTargetUnavailable
+		}
+		return s.broker.SendEvent, nil
+	case ReplayTargetWebhook:
+		u, err := url.Parse(req.WebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, domain.ErrInvalidWebhookURL
+		}
+		return func(ctx context.Context, evt messaging.OrderEvent) error {
+			return s.webhooks.SendEvent(ctx, req.WebhookURL, evt)
+		}, nil
+	default:
+		return nil, domain.ErrInvalidReplayTarget
+	}
+}
+
+// encodeReplayCursor returns an opaque cursor for the entry at createdAt with id
+func encodeReplayCursor(createdAt time.Time, id uuid.UUID) string {
+	raw := strconv.FormatInt(createdAt.UnixNano(), 10) + ":" + id.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeReplayCursor(cursor string) (time.Time, uuid.UUID, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, uuid.Nil, domain.ErrInvalidReplayCursor
+	}
+	nanosStr, idStr, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return time.Time{}, uuid.Nil, domain.ErrInvalidReplayCursor
+	}
+	nanos, err := strconv.ParseInt(nanosStr, 10, 64)
+	if err != nil {
+		return time.Time{}, uuid.Nil, domain.ErrInvalidReplayCursor
+	}
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return time.Time{}, uuid.Nil, domain.ErrInvalidReplayCursor
+	}
+	return time.Unix(0, nanos), id, nil
+}
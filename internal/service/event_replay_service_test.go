@@ -0,0 +1,171 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingSender records the events sent through it and fails with err
+// once failAfter events have been sent.
+type recordingSender struct {
+	sent      []messaging.OrderEvent
+	failAfter int
+	err       error
+}
+
+func (s *recordingSender) SendEvent(_ context.Context, evt messaging.OrderEvent) error {
+	if s.err != nil && len(s.sent) == s.failAfter {
+		return s.err
+	}
+	s.sent = append(s.sent, evt)
+	return nil
+}
+
+func historyEntry(action domain.HistoryAction, at time.Time) *domain.OrderHistoryEntry {
+	order := &domain.Order{ID: uuid.New(), CustomerID: "c-1", Status: domain.OrderStatusPending}
+	return &domain.OrderHistoryEntry{ID: uuid.New(), OrderID: order.ID, Action: action, NewState: order, CreatedAt: at}
+}
+
+func TestEventReplayService_ReplayEvents_SendsToBroker(t *testing.T) {
+	at := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	entries := []*domain.OrderHistoryEntry{
+		historyEntry(domain.HistoryActionCreated, at),
+		historyEntry(domain.HistoryActionErased, at.Add(time.Minute)),
+		historyEntry(domain.HistoryActionDeleted, at.Add(2*time.Minute)),
+	}
+	var gotQuery repository.HistoryRangeQuery
+	history := &mocks.OrderHistoryRepositoryMock{
+		ListRangeFunc: func(_ context.Context, q repository.HistoryRangeQuery) ([]*domain.OrderHistoryEntry, error) {
+			gotQuery = q
+			return entries, nil
+		},
+	}
+	broker := &recordingSender{}
+
+	svc := NewEventReplayService(history, broker, nil)
+	result, err := svc.ReplayEvents(context.Background(), EventReplayRequest{From: &at, Target: ReplayTargetBroker})
+
+	require.NoError(t, err)
+	assert.Equal(t, at, gotQuery.From)
+	assert.Equal(t, 2, result.Replayed)
+	assert.Equal(t, 1, result.Skipped, "erasures have no event to rebuild")
+	assert.Empty(t, result.NextCursor, "the range was read to the end")
+	require.Len(t, broker.sent, 2)
+	assert.Equal(t, messaging.EventOrderCreated, broker.sent[0].EventType)
+	assert.Equal(t, messaging.EventOrderDeleted, broker.sent[1].EventType)
+	assert.True(t, broker.sent[0].Replayed)
+	assert.Equal(t, at, broker.sent[0].OccurredAt)
+}
+
+func TestEventReplayService_ReplayEvents_LimitReached_ReturnsCursor(t *testing.T) {
+	at := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	var queries []repository.HistoryRangeQuery
+	history := &mocks.OrderHistoryRepositoryMock{
+		ListRangeFunc: func(_ context.Context, q repository.HistoryRangeQuery) ([]*domain.OrderHistoryEntry, error) {
+			queries = append(queries, q)
+			entries := make([]*domain.OrderHistoryEntry, q.Limit)
+			for i := range entries {
+				entries[i] = historyEntry(domain.HistoryActionUpdated, at.Add(time.Duration(i)*time.Second))
+			}
+			return entries, nil
+		},
+	}
+
+	svc := NewEventReplayService(history, &recordingSender{}, nil)
+	orderID := uuid.New().String()
+	result, err := svc.ReplayEvents(context.Background(), EventReplayRequest{OrderID: orderID, Target: ReplayTargetBroker, Limit: 150})
+
+	require.NoError(t, err)
+	assert.Equal(t, 150, result.Replayed)
+	require.Len(t, queries, 2)
+	assert.Equal(t, 100, queries[0].Limit)
+	assert.Equal(t, 50, queries[1].Limit)
+	assert.True(t, queries[1].After, "the second page continues after the first")
+	require.NotEmpty(t, result.NextCursor)
+
+	_, err = svc.ReplayEvents(context.Background(), EventReplayRequest{OrderID: orderID, Target: ReplayTargetBroker, Cursor: result.NextCursor})
+	require.NoError(t, err)
+	resumed := queries[2]
+	assert.True(t, resumed.After)
+	assert.True(t, at.Add(49*time.Second).Equal(resumed.AfterTime), "the cursor continues after the last entry read")
+}
+
+func TestEventReplayService_ReplayEvents_DeliveryFails_ReportsProgress(t *testing.T) {
+	at := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	first := historyEntry(domain.HistoryActionCreated, at)
+	history := &mocks.OrderHistoryRepositoryMock{
+		ListRangeFunc: func(_ context.Context, _ repository.HistoryRangeQuery) ([]*domain.OrderHistoryEntry, error) {
+			return []*domain.OrderHistoryEntry{first, historyEntry(domain.HistoryActionUpdated, at.Add(time.Second))}, nil
+		},
+	}
+	broker := &recordingSender{failAfter: 1, err: errors.New("broker down")}
+
+	svc := NewEventReplayService(history, broker, nil)
+	result, err := svc.ReplayEvents(context.Background(), EventReplayRequest{From: &at, Target: ReplayTargetBroker})
+
+	assert.ErrorIs(t, err, domain.ErrReplayDeliveryFailed)
+	require.NotNil(t, result)
+	assert.Equal(t, 1, result.Replayed)
+	assert.Equal(t, encodeReplayCursor(first.CreatedAt, first.ID), result.NextCursor)
+}
+
+func TestEventReplayService_ReplayEvents_InvalidRequest(t *testing.T) {
+	from := time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+
+	tests := []struct {
+		name   string
+		broker messaging.EventSender
+		req    EventReplayRequest
+		want   error
+	}{
+		{name: "unknown target", req: EventReplayRequest{From: &from, Target: "email"}, want: domain.ErrInvalidReplayTarget},
+		{name: "no broker", req: EventReplayRequest{From: &from, Target: ReplayTargetBroker}, want: domain.ErrReplayTargetUnavailable},
+		{name: "webhook without URL", req: EventReplayRequest{From: &from, Target: ReplayTargetWebhook}, want: domain.ErrInvalidWebhookURL},
+		{name: "webhook not http", req: EventReplayRequest{From: &from, Target: ReplayTargetWebhook, WebhookURL: "file:///etc/passwd"}, want: domain.ErrInvalidWebhookURL},
+		{name: "unbounded", broker: &recordingSender{}, req: EventReplayRequest{Target: ReplayTargetBroker}, want: domain.ErrInvalidReplayRange},
+		{name: "from after to", broker: &recordingSender{}, req: EventReplayRequest{From: &from, To: &to, Target: ReplayTargetBroker}, want: domain.ErrInvalidReplayRange},
+		{name: "bad order ID", broker: &recordingSender{}, req: EventReplayRequest{OrderID: "nope", Target: ReplayTargetBroker}, want: domain.ErrOrderNotFound},
+		{name: "bad cursor", broker: &recordingSender{}, req: EventReplayRequest{From: &from, Target: ReplayTargetBroker, Cursor: "!!"}, want: domain.ErrInvalidReplayCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history := &mocks.OrderHistoryRepositoryMock{
+				ListRangeFunc: func(_ context.Context, _ repository.HistoryRangeQuery) ([]*domain.OrderHistoryEntry, error) {
+					t.Fatal("history must not be read for an invalid request")
+					return nil, nil
+				},
+			}
+
+			svc := NewEventReplayService(history, tt.broker, nil)
+			_, err := svc.ReplayEvents(context.Background(), tt.req)
+
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// holdReleaseBatchSize caps the orders released in one pass
+const holdReleaseBatchSize = 100
+
+// HoldReleaseService releases held orders whose automatic release time has passed
+type HoldReleaseService interface {
+	// ReleaseDueHolds releases up to one batch of due holds and returns how
+	// many were released. Orders released or cancelled concurrently are skipped.
+	ReleaseDueHolds(ctx context.Context) (int, error)
+
+	// Run releases due holds immediately and then every interval until ctx is cancelled
+	Run(ctx context.Context, interval time.Duration)
+}
+
+// holdReleaseServiceImpl implements HoldReleaseService
+type holdReleaseServiceImpl struct {
+	repo   repository.OrderRepository
+	orders OrderService
+	now    func() time.Time
+}
+
+// NewHoldReleaseService creates a new HoldReleaseService. Orders are released
+// through orders so each release is versioned, recorded and published like
+// a release requested over the API.
+func NewHoldReleaseService(repo repository.OrderRepository, orders OrderService) HoldReleaseService {
+	return &holdReleaseServiceImpl{
+		repo:   repo,
+		orders: orders,
+		now:    time.Now,
+	}
+}
+
+func (s *holdReleaseServiceImpl) ReleaseDueHolds(ctx context.Context) (int, error) {
+	ctx = domain.WithSystemActor(ctx)
+
+	ids, err := s.repo.ListDueHolds(ctx, s.now(), holdReleaseBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	released := 0
+	for _, id := range ids {
+		_, err := s.orders.ReleaseOrder(ctx, id, nil)
+		switch {
+		case err == nil:
+			released++
+		case errors.Is(err, domain.ErrOrderNotHeld),
+			errors.Is(err, domain.ErrOrderNotFound),
+			errors.Is(err, domain.ErrConcurrentModification):
+			// Changed since it was listed; a hold still due is picked up next pass
+		default:
+			return released, err
+		}
+	}
+
+	if released > 0 {
+		slog.Info("released held orders", slog.Int("count", released))
+	}
+	return released, nil
+}
+
+func (s *holdReleaseServiceImpl) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.ReleaseDueHolds(ctx); err != nil && ctx.Err() == nil {
+			slog.Warn("order hold release failed", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
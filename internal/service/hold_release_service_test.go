@@ -0,0 +1,81 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHoldReleaseService_ReleaseDueHolds_ReleasesListedOrders(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	due := createMockOrder(domain.OrderStatusConfirmed)
+	require.NoError(t, due.PlaceOnHold("fraud review", &now, now.Add(-time.Hour)))
+	gone := createMockOrder(domain.OrderStatusCancelled)
+
+	var gotNow time.Time
+	var actors []string
+	repo := &mocks.OrderRepositoryMock{
+		ListDueHoldsFunc: func(_ context.Context, at time.Time, limit int) ([]string, error) {
+			gotNow = at
+			assert.Equal(t, holdReleaseBatchSize, limit)
+			return []string{due.ID.String(), gone.ID.String()}, nil
+		},
+		FindByIDFunc: func(_ context.Context, id string) (*domain.Order, error) {
+			if id == due.ID.String() {
+				return due, nil
+			}
+			return gone, nil
+		},
+		UpdateFunc: func(ctx context.Context, _ *domain.Order) error {
+			actors = append(actors, domain.ActorFromContext(ctx))
+			return nil
+		},
+	}
+
+	svc := &holdReleaseServiceImpl{
+		repo:   repo,
+		orders: NewOrderService(repo, nil, nil, nil, nil, nil),
+		now:    func() time.Time { return now },
+	}
+	released, err := svc.ReleaseDueHolds(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, released, "an order no longer on hold is skipped")
+	assert.True(t, now.Equal(gotNow))
+	assert.Equal(t, domain.OrderStatusConfirmed, due.Status)
+	assert.Equal(t, []string{domain.ActorSystem}, actors)
+}
+
+func TestHoldReleaseService_ReleaseDueHolds_RepositoryError_ReturnsError(t *testing.T) {
+	dbErr := errors.New("connection refused")
+	repo := &mocks.OrderRepositoryMock{
+		ListDueHoldsFunc: func(_ context.Context, _ time.Time, _ int) ([]string, error) {
+			return nil, dbErr
+		},
+	}
+
+	svc := NewHoldReleaseService(repo, NewOrderService(repo, nil, nil, nil, nil, nil))
+	_, err := svc.ReleaseDueHolds(context.Background())
+
+	assert.ErrorIs(t, err, dbErr)
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// JobService exposes the job queue to operators
+type JobService interface {
+	// ListJobs returns jobs newest first, limited to one status unless status is empty
+	ListJobs(ctx context.Context, status string, limit, offset int) (*JobList, error)
+
+	// GetJob returns a job by ID
+	GetJob(ctx context.Context, id string) (*domain.Job, error)
+}
+
+// JobList is a page of jobs
+type JobList struct {
+	Data  []*domain.Job
+	Total int64
+}
+
+// jobServiceImpl implements JobService
+type jobServiceImpl struct {
+	queue repository.JobQueue
+}
+
+// NewJobService creates a new JobService
+func NewJobService(queue repository.JobQueue) JobService {
+	return &jobServiceImpl{queue: queue}
+}
+
+func (s *jobServiceImpl) ListJobs(ctx context.Context, status string, limit, offset int) (*JobList, error) {
+	if status != "" && !domain.JobStatus(status).IsValid() {
+		return nil, domain.ErrInvalidJobStatus
+	}
+	if limit < 1 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	jobs, total, err := s.queue.List(ctx, domain.JobStatus(status), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return &JobList{Data: jobs, Total: total}, nil
+}
+
+func (s *jobServiceImpl) GetJob(ctx context.Context, id string) (*domain.Job, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, domain.ErrJobNotFound
+	}
+	job, err := s.queue.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, domain.ErrJobNotFound
+	}
+	return job, nil
+}
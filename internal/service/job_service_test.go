@@ -0,0 +1,74 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobService_ListJobs(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     string
+		limit      int
+		wantLimit  int
+		wantStatus domain.JobStatus
+		wantErr    error
+	}{
+		{name: "all statuses", status: "", limit: 20, wantLimit: 20},
+		{name: "filtered by status", status: "failed", limit: 20, wantLimit: 20, wantStatus: domain.JobStatusFailed},
+		{name: "limit capped", status: "", limit: 500, wantLimit: 100},
+		{name: "invalid status", status: "done", limit: 20, wantErr: domain.ErrInvalidJobStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := &mocks.JobQueueMock{
+				ListFunc: func(_ context.Context, status domain.JobStatus, limit, _ int) ([]*domain.Job, int64, error) {
+					assert.Equal(t, tt.wantStatus, status)
+					assert.Equal(t, tt.wantLimit, limit)
+					return []*domain.Job{{ID: uuid.New()}}, 1, nil
+				},
+			}
+
+			result, err := NewJobService(queue).ListJobs(context.Background(), tt.status, tt.limit, 0)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, result.Data, 1)
+			assert.Equal(t, int64(1), result.Total)
+		})
+	}
+}
+
+func TestJobService_GetJob_NotFound(t *testing.T) {
+	svc := NewJobService(&mocks.JobQueueMock{})
+
+	_, err := svc.GetJob(context.Background(), "not-a-uuid")
+	assert.ErrorIs(t, err, domain.ErrJobNotFound)
+
+	_, err = svc.GetJob(context.Background(), uuid.NewString())
+	assert.ErrorIs(t, err, domain.ErrJobNotFound)
+}
@@ -0,0 +1,255 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+const (
+	// jobBatchSize caps the jobs run in one pass, so periodic jobs are
+	// scheduled between batches of a long queue
+	jobBatchSize = 100
+	// maxJobRetryBackoff caps the delay before a failed job is retried
+	maxJobRetryBackoff = time.Hour
+	// finishedJobPurgeInterval is the time between purges of finished jobs
+	finishedJobPurgeInterval = time.Hour
+)
+
+// JobDefinition tells the worker how to run the jobs of one kind
+type JobDefinition struct {
+	Kind domain.JobKind
+	// Every, if positive, makes the kind periodic: the worker keeps one run
+	// of it queued, due Every after the previous run finished
+	Every time.Duration
+	// Run does the work. An error fails the attempt and the job is retried
+	// with backoff until it runs out of attempts.
+	Run func(ctx context.Context, job *domain.Job) error
+}
+
+// JobWorkerConfig holds the worker settings
+type JobWorkerConfig struct {
+	// Lease is how long a claimed job may run before another worker may
+	// claim it again; handlers are cancelled when it runs out
+	Lease time.Duration
+	// MaxAttempts is how often a job is tried before it fails for good
+	MaxAttempts int
+	// RetryBackoff is the delay before the first retry, doubled for each
+	// further attempt up to an hour
+	RetryBackoff time.Duration
+	// Retention is how long finished jobs are kept; zero keeps them
+	Retention time.Duration
+}
+
+// JobWorker runs the jobs in the job queue
+type JobWorker interface {
+	// SchedulePeriodic queues a run, due now, of every periodic kind that has
+	// none queued or running, and returns how many were queued
+	SchedulePeriodic(ctx context.Context) (int, error)
+
+	// ProcessDue claims and runs up to one batch of due jobs, one at a time,
+	// and returns how many ran
+	ProcessDue(ctx context.Context) (int, error)
+
+	// Run schedules periodic jobs and then processes due jobs every interval
+	// until ctx is cancelled
+	Run(ctx context.Context, interval time.Duration)
+}
+
+// jobWorkerImpl implements JobWorker
+type jobWorkerImpl struct {
+	queue       repository.JobQueue
+	definitions map[domain.JobKind]JobDefinition
+	config      JobWorkerConfig
+	now         func() time.Time
+}
+
+// NewJobWorker creates a new JobWorker for the given job kinds. Unless
+// config.Retention is zero, a periodic job purging old finished jobs is
+// added.
+func NewJobWorker(queue repository.JobQueue, config JobWorkerConfig, definitions ...JobDefinition) JobWorker {
+	w := &jobWorkerImpl{
+		queue:       queue,
+		definitions: make(map[domain.JobKind]JobDefinition, len(definitions)+1),
+		config:      config,
+		now:         time.Now,
+	}
+	for _, def := range definitions {
+		w.definitions[def.Kind] = def
+	}
+	if config.Retention > 0 {
+		w.definitions[domain.JobKindFinishedJobPurge] = JobDefinition{
+			Kind:  domain.JobKindFinishedJobPurge,
+			Every: finishedJobPurgeInterval,
+			Run:   w.purgeFinished,
+		}
+	}
+	return w
+}
+
+func (w *jobWorkerImpl) SchedulePeriodic(ctx context.Context) (int, error) {
+	return w.schedule(ctx, false)
+}
+
+// schedule queues a run of every periodic kind that has none queued or
+// running, due now or, if later is set, one period from now
+func (w *jobWorkerImpl) schedule(ctx context.Context, later bool) (int, error) {
+	queued := 0
+	for _, def := range w.definitions {
+		if def.Every <= 0 {
+			continue
+		}
+		runAt := w.now()
+		if later {
+			runAt = runAt.Add(def.Every)
+		}
+		added, err := w.queuePeriodic(ctx, def, runAt)
+		if err != nil {
+			return queued, err
+		}
+		if added {
+			queued++
+		}
+	}
+	return queued, nil
+}
+
+// queuePeriodic queues a run of def at runAt unless one is queued or running
+func (w *jobWorkerImpl) queuePeriodic(ctx context.Context, def JobDefinition, runAt time.Time) (bool, error) {
+	job := domain.NewJob(def.Kind, nil, runAt, w.config.MaxAttempts)
+	job.UniqueKey = string(def.Kind)
+	return w.queue.Enqueue(ctx, job)
+}
+
+func (w *jobWorkerImpl) ProcessDue(ctx context.Context) (int, error) {
+	processed := 0
+	for processed < jobBatchSize && ctx.Err() == nil {
+		now := w.now()
+		job, err := w.queue.Claim(ctx, now, now.Add(w.config.Lease))
+		if err != nil {
+			return processed, err
+		}
+		if job == nil {
+			break
+		}
+		if err := w.process(ctx, job); err != nil {
+			return processed, err
+		}
+		processed++
+	}
+	return processed, nil
+}
+
+// process runs a claimed job and records its outcome. A shutdown does not
+// cancel it: the job finishes within its lease, so it is not left claimed
+// until the lease expires, and ProcessDue then claims no more.
+func (w *jobWorkerImpl) process(ctx context.Context, job *domain.Job) error {
+	ctx = context.WithoutCancel(ctx)
+	def, ok := w.definitions[job.Kind]
+	err := domain.ErrUnknownJobKind
+	if ok {
+		runCtx, cancel := context.WithTimeout(domain.WithSystemActor(ctx), w.config.Lease)
+		err = def.Run(runCtx, job)
+		cancel()
+	}
+
+	now := w.now()
+	if err == nil {
+		job.Succeed(now)
+	} else {
+		job.Fail(err.Error(), now, now.Add(w.backoff(job.Attempts)))
+		slog.Warn("job failed",
+			slog.String("job_id", job.ID.String()),
+			slog.String("kind", string(job.Kind)),
+			slog.Int("attempt", job.Attempts),
+			slog.String("status", string(job.Status)),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	if err := w.queue.Finish(ctx, job); err != nil {
+		if errors.Is(err, domain.ErrJobLeaseLost) {
+			slog.Warn("job outlived its lease, outcome discarded",
+				slog.String("job_id", job.ID.String()),
+				slog.String("kind", string(job.Kind)),
+			)
+			return nil
+		}
+		return err
+	}
+
+	if ok && def.Every > 0 && job.Status.IsFinished() {
+		if _, err := w.queuePeriodic(ctx, def, now.Add(def.Every)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backoff doubles RetryBackoff per attempt, capped at maxJobRetryBackoff
+func (w *jobWorkerImpl) backoff(attempts int) time.Duration {
+	d := w.config.RetryBackoff
+	for i := 1; i < attempts; i++ {
+		d *= 2
+		if d >= maxJobRetryBackoff {
+			return maxJobRetryBackoff
+		}
+	}
+	return d
+}
+
+// purgeFinished deletes jobs that finished more than config.Retention ago
+func (w *jobWorkerImpl) purgeFinished(ctx context.Context, _ *domain.Job) error {
+	purged, err := w.queue.PurgeFinished(ctx, w.now().Add(-w.config.Retention))
+	if err != nil {
+		return err
+	}
+	if purged > 0 {
+		slog.Info("purged finished jobs", slog.Int64("count", purged))
+	}
+	return nil
+}
+
+func (w *jobWorkerImpl) Run(ctx context.Context, interval time.Duration) {
+	if _, err := w.SchedulePeriodic(ctx); err != nil && ctx.Err() == nil {
+		slog.Warn("scheduling periodic jobs failed", slog.String("error", err.Error()))
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := w.ProcessDue(ctx); err != nil && ctx.Err() == nil {
+			slog.Warn("processing jobs failed", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// Requeues a periodic job whose next run could not be queued when it finished
+		if _, err := w.schedule(ctx, true); err != nil && ctx.Err() == nil {
+			slog.Warn("scheduling periodic jobs failed", slog.String("error", err.Error()))
+		}
+	}
+}
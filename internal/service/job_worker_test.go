@@ -0,0 +1,302 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// claimOnce returns a ClaimFunc that hands out job once, leased from now
+func claimOnce(job *domain.Job) func(context.Context, time.Time, time.Time) (*domain.Job, error) {
+	claimed := false
+	return func(_ context.Context, _, leaseUntil time.Time) (*domain.Job, error) {
+		if claimed {
+			return nil, nil
+		}
+		claimed = true
+		job.Status = domain.JobStatusRunning
+		job.Attempts++
+		job.RunAt = leaseUntil
+		return job, nil
+	}
+}
+
+func testWorkerConfig() JobWorkerConfig {
+	return JobWorkerConfig{Lease: time.Minute, MaxAttempts: 3, RetryBackoff: 10 * time.Second}
+}
+
+func TestJobWorker_ProcessDue_SucceedsAndQueuesNextPeriodicRun(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	job := domain.NewJob(domain.JobKindSLACheck, nil, now, 3)
+
+	var finished *domain.Job
+	var enqueued []*domain.Job
+	queue := &mocks.JobQueueMock{
+		ClaimFunc: claimOnce(job),
+		FinishFunc: func(_ context.Context, j *domain.Job) error {
+			finished = j
+			return nil
+		},
+		EnqueueFunc: func(_ context.Context, j *domain.Job) (bool, error) {
+			enqueued = append(enqueued, j)
+			return true, nil
+		},
+	}
+
+	var actor string
+	w := NewJobWorker(queue, testWorkerConfig(), JobDefinition{
+		Kind:  domain.JobKindSLACheck,
+		Every: 5 * time.Minute,
+		Run: func(ctx context.Context, _ *domain.Job) error {
+			actor = domain.ActorFromContext(ctx)
+			return nil
+		},
+	}).(*jobWorkerImpl)
+	w.now = func() time.Time { return now }
+
+	processed, err := w.ProcessDue(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Equal(t, domain.ActorSystem, actor)
+	require.NotNil(t, finished)
+	assert.Equal(t, domain.JobStatusSucceeded, finished.Status)
+	require.Len(t, enqueued, 1)
+	assert.Equal(t, domain.JobKindSLACheck, enqueued[0].Kind)
+	assert.Equal(t, string(domain.JobKindSLACheck), enqueued[0].UniqueKey)
+	assert.True(t, now.Add(5*time.Minute).Equal(enqueued[0].RunAt))
+}
+
+func TestJobWorker_ProcessDue_Failure(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		kind          domain.JobKind
+		priorAttempts int
+		wantStatus    domain.JobStatus
+		wantRunAt     time.Time
+		wantErr       string
+	}{
+		{
+			name:          "first failure is retried after the base backoff",
+			kind:          domain.JobKindHoldRelease,
+			priorAttempts: 0,
+			wantStatus:    domain.JobStatusQueued,
+			wantRunAt:     now.Add(10 * time.Second),
+			wantErr:       "boom",
+		},
+		{
+			name:          "second failure doubles the backoff",
+			kind:          domain.JobKindHoldRelease,
+			priorAttempts: 1,
+			wantStatus:    domain.JobStatusQueued,
+			wantRunAt:     now.Add(20 * time.Second),
+			wantErr:       "boom",
+		},
+		{
+			name:          "last attempt fails for good",
+			kind:          domain.JobKindHoldRelease,
+			priorAttempts: 2,
+			wantStatus:    domain.JobStatusFailed,
+			wantErr:       "boom",
+		},
+		{
+			name:          "unknown kind fails the attempt",
+			kind:          "webhook_delivery",
+			priorAttempts: 0,
+			wantStatus:    domain.JobStatusQueued,
+			wantRunAt:     now.Add(10 * time.Second),
+			wantErr:       domain.ErrUnknownJobKind.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := domain.NewJob(tt.kind, nil, now, 3)
+			job.Attempts = tt.priorAttempts
+
+			var finished *domain.Job
+			var enqueued int
+			queue := &mocks.JobQueueMock{
+				ClaimFunc: claimOnce(job),
+				FinishFunc: func(_ context.Context, j *domain.Job) error {
+					finished = j
+					return nil
+				},
+				EnqueueFunc: func(_ context.Context, _ *domain.Job) (bool, error) {
+					enqueued++
+					return true, nil
+				},
+			}
+			w := NewJobWorker(queue, testWorkerConfig(), JobDefinition{
+				Kind: domain.JobKindHoldRelease,
+				Run: func(context.Context, *domain.Job) error {
+					return errors.New("boom")
+				},
+			}).(*jobWorkerImpl)
+			w.now = func() time.Time { return now }
+
+			_, err := w.ProcessDue(context.Background())
+
+			require.NoError(t, err)
+			require.NotNil(t, finished)
+			assert.Equal(t, tt.wantStatus, finished.Status)
+			assert.Equal(t, tt.wantErr, finished.LastError)
+			if tt.wantStatus == domain.JobStatusQueued {
+				assert.True(t, tt.wantRunAt.Equal(finished.RunAt), "run at %s", finished.RunAt)
+			} else {
+				assert.NotNil(t, finished.FinishedAt)
+			}
+			assert.Zero(t, enqueued, "kinds that are not periodic are not requeued")
+		})
+	}
+}
+
+func TestJobWorker_ProcessDue_LeaseLost_Continues(t *testing.T) {
+	now := time.Now()
+	job := domain.NewJob(domain.JobKindSLACheck, nil, now, 3)
+
+	queue := &mocks.JobQueueMock{
+		ClaimFunc: claimOnce(job),
+		FinishFunc: func(context.Context, *domain.Job) error {
+			return domain.ErrJobLeaseLost
+		},
+	}
+	w := NewJobWorker(queue, testWorkerConfig(), JobDefinition{
+		Kind:  domain.JobKindSLACheck,
+		Every: time.Minute,
+		Run:   func(context.Context, *domain.Job) error { return nil },
+	})
+
+	processed, err := w.ProcessDue(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+}
+
+func TestJobWorker_ProcessDue_ShutdownFinishesClaimedJob(t *testing.T) {
+	job := domain.NewJob(domain.JobKindSLACheck, nil, time.Now(), 3)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	claims := 0
+	var finished *domain.Job
+	queue := &mocks.JobQueueMock{
+		ClaimFunc: func(c context.Context, now, leaseUntil time.Time) (*domain.Job, error) {
+			claims++
+			return claimOnce(job)(c, now, leaseUntil)
+		},
+		FinishFunc: func(ctx context.Context, j *domain.Job) error {
+			require.NoError(t, ctx.Err())
+			finished = j
+			return nil
+		},
+	}
+	w := NewJobWorker(queue, testWorkerConfig(), JobDefinition{
+		Kind: domain.JobKindSLACheck,
+		Run: func(ctx context.Context, _ *domain.Job) error {
+			// The server shuts down while the job runs
+			cancel()
+			return ctx.Err()
+		},
+	})
+
+	processed, err := w.ProcessDue(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Equal(t, 1, claims, "no job is claimed after the shutdown")
+	require.NotNil(t, finished)
+	assert.Equal(t, domain.JobStatusSucceeded, finished.Status)
+}
+
+func TestJobWorker_ProcessDue_ClaimError_ReturnsError(t *testing.T) {
+	dbErr := errors.New("connection refused")
+	queue := &mocks.JobQueueMock{
+		ClaimFunc: func(context.Context, time.Time, time.Time) (*domain.Job, error) {
+			return nil, dbErr
+		},
+	}
+
+	_, err := NewJobWorker(queue, testWorkerConfig()).ProcessDue(context.Background())
+
+	assert.ErrorIs(t, err, dbErr)
+}
+
+func TestJobWorker_SchedulePeriodic_QueuesPeriodicKindsOnly(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	kinds := map[domain.JobKind]bool{}
+	queue := &mocks.JobQueueMock{
+		EnqueueFunc: func(_ context.Context, j *domain.Job) (bool, error) {
+			assert.True(t, now.Equal(j.RunAt))
+			assert.Equal(t, string(j.Kind), j.UniqueKey)
+			kinds[j.Kind] = true
+			// A run of the SLA check is already pending
+			return j.Kind != domain.JobKindSLACheck, nil
+		},
+	}
+	config := testWorkerConfig()
+	config.Retention = 24 * time.Hour
+	noop := func(context.Context, *domain.Job) error { return nil }
+	w := NewJobWorker(queue, config,
+		JobDefinition{Kind: domain.JobKindSLACheck, Every: time.Minute, Run: noop},
+		JobDefinition{Kind: domain.JobKindHoldRelease, Every: time.Minute, Run: noop},
+		JobDefinition{Kind: "webhook_delivery", Run: noop},
+	).(*jobWorkerImpl)
+	w.now = func() time.Time { return now }
+
+	queued, err := w.SchedulePeriodic(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, queued)
+	assert.Equal(t, map[domain.JobKind]bool{
+		domain.JobKindSLACheck:         true,
+		domain.JobKindHoldRelease:      true,
+		domain.JobKindFinishedJobPurge: true,
+	}, kinds)
+}
+
+func TestJobWorker_PurgeFinished_UsesRetention(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	job := domain.NewJob(domain.JobKindFinishedJobPurge, nil, now, 3)
+
+	var before time.Time
+	queue := &mocks.JobQueueMock{
+		ClaimFunc: claimOnce(job),
+		PurgeFinishedFunc: func(_ context.Context, at time.Time) (int64, error) {
+			before = at
+			return 3, nil
+		},
+	}
+	config := testWorkerConfig()
+	config.Retention = 24 * time.Hour
+	w := NewJobWorker(queue, config).(*jobWorkerImpl)
+	w.now = func() time.Time { return now }
+
+	_, err := w.ProcessDue(context.Background())
+
+	require.NoError(t, err)
+	assert.True(t, now.Add(-24*time.Hour).Equal(before))
+	assert.Equal(t, domain.JobStatusSucceeded, job.Status)
+}
@@ -0,0 +1,172 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAddress() domain.Address {
+	return domain.Address{Name: "Ada Lovelace", Line1: "12 St James's Square", City: "London", PostalCode: "SW1Y 4JH", Country: "GB"}
+}
+
+func TestOrderService_CreateOrder_WithAddresses_Normalizes(t *testing.T) {
+	mockRepo := &mocks.OrderRepositoryMock{
+		CreateFunc: func(_ context.Context, _ *domain.Order) error { return nil },
+	}
+	shipping := testAddress()
+	shipping.City = "  London "
+	shipping.Country = "gb"
+
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	order, err := svc.CreateOrder(context.Background(), CreateOrderDTO{
+		CustomerID:      "cust-1",
+		Items:           []domain.OrderItem{{ProductID: "product-1", Name: "Test Product", Quantity: 1, Price: 10.00}},
+		ShippingAddress: &shipping,
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, order.ShippingAddress)
+	assert.Equal(t, testAddress(), *order.ShippingAddress)
+	assert.Nil(t, order.BillingAddress)
+}
+
+func TestOrderService_CreateOrder_InvalidAddress_ReturnsErrInvalidAddress(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(a *domain.Address)
+	}{
+		{name: "missing line1", modify: func(a *domain.Address) { a.Line1 = " " }},
+		{name: "missing city", modify: func(a *domain.Address) { a.City = "" }},
+		{name: "three-letter country", modify: func(a *domain.Address) { a.Country = "GBR" }},
+		{name: "numeric country", modify: func(a *domain.Address) { a.Country = "12" }},
+		{name: "postal code too long", modify: func(a *domain.Address) { a.PostalCode = strings.Repeat("9", domain.MaxPostalCodeLength+1) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mocks.OrderRepositoryMock{
+				CreateFunc: func(_ context.Context, _ *domain.Order) error {
+					t.Fatal("an invalid address must not be saved")
+					return nil
+				},
+			}
+			billing := testAddress()
+			tt.modify(&billing)
+
+			svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+			order, err := svc.CreateOrder(context.Background(), CreateOrderDTO{
+				CustomerID:     "cust-1",
+				Items:          []domain.OrderItem{{ProductID: "product-1", Name: "Test Product", Quantity: 1, Price: 10.00}},
+				BillingAddress: &billing,
+			})
+
+			assert.ErrorIs(t, err, domain.ErrInvalidAddress)
+			assert.Nil(t, order)
+		})
+	}
+}
+
+func TestOrderService_UpdateAddresses_ByStatus(t *testing.T) {
+	tests := []struct {
+		status  domain.OrderStatus
+		wantErr error
+	}{
+		{status: domain.OrderStatusPending},
+		{status: domain.OrderStatusConfirmed},
+		{status: domain.OrderStatusProcessing, wantErr: domain.ErrAddressLocked},
+		{status: domain.OrderStatusOnHold, wantErr: domain.ErrAddressLocked},
+		{status: domain.OrderStatusShipped, wantErr: domain.ErrAddressLocked},
+		{status: domain.OrderStatusCancelled, wantErr: domain.ErrAddressLocked},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			order := createMockOrder(tt.status)
+			updated := false
+			mockRepo := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+				UpdateFunc: func(_ context.Context, _ *domain.Order) error {
+					updated = true
+					return nil
+				},
+			}
+			shipping := testAddress()
+
+			svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+			result, err := svc.UpdateAddresses(context.Background(), order.ID.String(), &shipping, nil, nil)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+				assert.False(t, updated)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, shipping, *result.ShippingAddress)
+			assert.True(t, updated)
+		})
+	}
+}
+
+func TestOrderService_UpdateAddresses_KeepsOmittedAddressAndPublishes(t *testing.T) {
+	order := createMockOrder(domain.OrderStatusPending)
+	billing := testAddress()
+	order.BillingAddress = &billing
+	published := false
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+		UpdateFunc:   func(_ context.Context, _ *domain.Order) error { return nil },
+	}
+	mockPublisher := &mocks.EventPublisherMock{
+		PublishOrderUpdatedFunc: func(_ context.Context, _ *domain.Order) error {
+			published = true
+			return nil
+		},
+	}
+	shipping := domain.Address{Line1: "1 Infinite Loop", City: "Cupertino", Region: "CA", PostalCode: "95014", Country: "US"}
+
+	svc := NewOrderService(mockRepo, nil, nil, mockPublisher, nil, nil)
+	result, err := svc.UpdateAddresses(context.Background(), order.ID.String(), &shipping, nil, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, shipping, *result.ShippingAddress)
+	assert.Equal(t, billing, *result.BillingAddress)
+	assert.True(t, published, "should publish order.updated event")
+}
+
+func TestOrderService_UpdateAddresses_VersionMismatch(t *testing.T) {
+	order := createMockOrderWithVersion(domain.OrderStatusPending, 3)
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+		UpdateFunc: func(_ context.Context, _ *domain.Order) error {
+			t.Fatal("a stale version must not be written")
+			return nil
+		},
+	}
+	shipping := testAddress()
+
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	_, err := svc.UpdateAddresses(context.Background(), order.ID.String(), &shipping, nil, intPtr(2))
+
+	assert.ErrorIs(t, err, domain.ErrVersionMismatch)
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/audit"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// auditPublisher is an EventPublisher that records the order versions of
+// the events passing through it for the audited call in their context
+type auditPublisher struct {
+	EventPublisher
+}
+
+// NewAuditPublisher returns next, recording each order change published
+// through it with audit.RecordOrder. Every change bumps the order version by
+// one, so the version before it is the published one less one.
+func NewAuditPublisher(next EventPublisher) EventPublisher {
+	return &auditPublisher{EventPublisher: next}
+}
+
+func (p *auditPublisher) PublishOrderCreated(ctx context.Context, order *domain.Order) error {
+	audit.RecordOrder(ctx, order.ID, 0, order.Version)
+	return p.EventPublisher.PublishOrderCreated(ctx, order)
+}
+
+func (p *auditPublisher) PublishOrderUpdated(ctx context.Context, order *domain.Order) error {
+	recordChange(ctx, order)
+	return p.EventPublisher.PublishOrderUpdated(ctx, order)
+}
+
+func (p *auditPublisher) PublishOrderStatusChanged(ctx context.Context, order *domain.Order, oldStatus, newStatus domain.OrderStatus) error {
+	recordChange(ctx, order)
+	return p.EventPublisher.PublishOrderStatusChanged(ctx, order, oldStatus, newStatus)
+}
+
+func (p *auditPublisher) PublishOrderRestored(ctx context.Context, order *domain.Order) error {
+	recordChange(ctx, order)
+	return p.EventPublisher.PublishOrderRestored(ctx, order)
+}
+
+func (p *auditPublisher) PublishOrderDeleted(ctx context.Context, order *domain.Order) error {
+	recordChange(ctx, order)
+	return p.EventPublisher.PublishOrderDeleted(ctx, order)
+}
+
+// recordChange records a change to an existing order
+func recordChange(ctx context.Context, order *domain.Order) {
+	audit.RecordOrder(ctx, order.ID, order.Version-1, order.Version)
+}
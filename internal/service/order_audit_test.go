@@ -0,0 +1,54 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/audit"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditPublisher_RecordsOrderVersions(t *testing.T) {
+	var published int
+	publisher := NewAuditPublisher(&mocks.EventPublisherMock{
+		PublishOrderCreatedFunc: func(_ context.Context, _ *domain.Order) error {
+			published++
+			return nil
+		},
+		PublishOrderStatusChangedFunc: func(_ context.Context, _ *domain.Order, _, _ domain.OrderStatus) error {
+			published++
+			return nil
+		},
+	})
+	created := &domain.Order{ID: uuid.New(), Version: 1}
+	changed := &domain.Order{ID: uuid.New(), Version: 4}
+	ctx, rec := audit.WithRecorder(context.Background())
+
+	require.NoError(t, publisher.PublishOrderCreated(ctx, created))
+	require.NoError(t, publisher.PublishOrderUpdated(ctx, changed))
+	require.NoError(t, publisher.PublishOrderStatusChanged(ctx, changed, domain.OrderStatusPending, domain.OrderStatusConfirmed))
+
+	assert.Equal(t, 2, published)
+	assert.Equal(t, []audit.OrderVersion{
+		{OrderID: created.ID, AfterVersion: 1},
+		{OrderID: changed.ID, BeforeVersion: 3, AfterVersion: 4},
+	}, rec.Orders())
+}
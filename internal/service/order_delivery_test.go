@@ -0,0 +1,158 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func deliveryConfig() StaticConfig {
+	return StaticConfig{
+		MaxPageSize:           100,
+		DeliveryTransitTimes:  map[string]time.Duration{"standard": 120 * time.Hour, "express": 48 * time.Hour},
+		DefaultShippingMethod: "standard",
+	}
+}
+
+func TestOrderService_CreateOrder_ShippingMethod(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		wantMethod string
+		wantErr    error
+	}{
+		{name: "default method", method: "", wantMethod: "standard"},
+		{name: "configured method", method: "express", wantMethod: "express"},
+		{name: "unknown method", method: "teleport", wantErr: domain.ErrInvalidShippingMethod},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mocks.OrderRepositoryMock{
+				CreateFunc: func(_ context.Context, _ *domain.Order) error { return nil },
+			}
+
+			svc := NewOrderService(mockRepo, nil, nil, nil, nil, deliveryConfig())
+			order, err := svc.CreateOrder(context.Background(), CreateOrderDTO{
+				CustomerID:     "cust-1",
+				Items:          []domain.OrderItem{{ProductID: "product-1", Name: "Test Product", Quantity: 1, Price: 10.00}},
+				ShippingMethod: tt.method,
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, order)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMethod, order.ShippingMethod)
+			assert.Nil(t, order.EstimatedDeliveryAt, "the estimate is set on confirmation")
+		})
+	}
+}
+
+func TestOrderService_UpdateOrderStatus_Confirmed_EstimatesDelivery(t *testing.T) {
+	existing := createMockOrder(domain.OrderStatusPending)
+	existing.ShippingMethod = "express"
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return existing, nil },
+		UpdateFunc:   func(_ context.Context, _ *domain.Order) error { return nil },
+	}
+
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, deliveryConfig())
+	order, err := svc.UpdateOrderStatus(context.Background(), existing.ID.String(), domain.OrderStatusConfirmed, nil)
+
+	require.NoError(t, err)
+	require.NotNil(t, order.EstimatedDeliveryAt)
+	assert.Equal(t, order.UpdatedAt.Add(48*time.Hour), *order.EstimatedDeliveryAt)
+}
+
+func TestOrderService_UpdateOrderStatus_UnconfiguredMethod_NoEstimate(t *testing.T) {
+	existing := createMockOrder(domain.OrderStatusPending)
+	existing.ShippingMethod = "freight"
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return existing, nil },
+		UpdateFunc:   func(_ context.Context, _ *domain.Order) error { return nil },
+	}
+
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, deliveryConfig())
+	order, err := svc.UpdateOrderStatus(context.Background(), existing.ID.String(), domain.OrderStatusConfirmed, nil)
+
+	require.NoError(t, err)
+	assert.Nil(t, order.EstimatedDeliveryAt)
+}
+
+func TestOrderService_MarkSLABreached(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name        string
+		status      domain.OrderStatus
+		estimate    *time.Time
+		breached    *time.Time
+		wantErr     error
+		wantPublish bool
+	}{
+		{name: "overdue shipment", status: domain.OrderStatusShipped, estimate: &past, wantPublish: true},
+		{name: "not yet due", status: domain.OrderStatusShipped, estimate: &future, wantErr: domain.ErrSLANotBreached},
+		{name: "delivered", status: domain.OrderStatusDelivered, estimate: &past, wantErr: domain.ErrSLANotBreached},
+		{name: "already flagged", status: domain.OrderStatusProcessing, estimate: &past, breached: &past, wantErr: domain.ErrSLANotBreached},
+		{name: "no estimate", status: domain.OrderStatusConfirmed, wantErr: domain.ErrSLANotBreached},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			existing := createMockOrder(tt.status)
+			existing.EstimatedDeliveryAt = tt.estimate
+			existing.SLABreachedAt = tt.breached
+
+			updated := false
+			mockRepo := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return existing, nil },
+				UpdateFunc: func(_ context.Context, _ *domain.Order) error {
+					updated = true
+					return nil
+				},
+			}
+			published := false
+			publisher := &mocks.EventPublisherMock{
+				PublishOrderSLABreachedFunc: func(_ context.Context, _ *domain.Order) error {
+					published = true
+					return nil
+				},
+			}
+
+			svc := NewOrderService(mockRepo, nil, nil, publisher, nil, nil)
+			order, err := svc.MarkSLABreached(context.Background(), existing.ID.String())
+
+			assert.Equal(t, tt.wantPublish, published)
+			assert.Equal(t, tt.wantPublish, updated)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, order.SLABreachedAt)
+		})
+	}
+}
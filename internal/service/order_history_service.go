@@ -0,0 +1,180 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// OrderHistoryService exposes the audit trail of order mutations
+type OrderHistoryService interface {
+	// GetOrderHistory returns an order's history, newest first.
+	// Returns domain.ErrOrderNotFound if the order has never existed.
+	GetOrderHistory(ctx context.Context, orderID string, limit, offset int) (*OrderHistoryList, error)
+
+	// DiffOrderVersions compares the order at fromVersion with the order at
+	// toVersion, as recorded in its history; toVersion 0 is the latest
+	// recorded version. Returns domain.ErrInvalidVersionRange unless
+	// fromVersion is at least 1 and below toVersion, and
+	// domain.ErrVersionNotInHistory if either version was not recorded, as
+	// for orders created before history or erased since.
+	DiffOrderVersions(ctx context.Context, orderID string, fromVersion, toVersion int) (*domain.OrderDiff, error)
+}
+
+// historyPageSize is how many history entries DiffOrderVersions reads at a time
+const historyPageSize = 200
+
+// OrderHistoryList is a page of order history entries
+type OrderHistoryList struct {
+	Data  []*domain.OrderHistoryEntry
+	Total int64
+}
+
+// orderHistoryServiceImpl implements OrderHistoryService
+type orderHistoryServiceImpl struct {
+	history repository.OrderHistoryRepository
+	orders  repository.OrderRepository
+}
+
+// NewOrderHistoryService creates a new OrderHistoryService
+func NewOrderHistoryService(history repository.OrderHistoryRepository, orders repository.OrderRepository) OrderHistoryService {
+	return &orderHistoryServiceImpl{
+		history: history,
+		orders:  orders,
+	}
+}
+
+func (s *orderHistoryServiceImpl) GetOrderHistory(ctx context.Context, orderID string, limit, offset int) (*OrderHistoryList, error) {
+	if _, err := uuid.Parse(orderID); err != nil {
+		return nil, domain.ErrOrderNotFound
+	}
+	if limit < 1 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	if err := s.authorize(ctx, orderID); err != nil {
+		return nil, err
+	}
+
+	entries, total, err := s.history.ListByOrderID(ctx, orderID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	// Orders created before history was recorded have no entries; only a
+	// missing order is an error. Deleted orders keep their history.
+	if total == 0 {
+		if err := s.requireOrder(ctx, orderID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &OrderHistoryList{Data: entries, Total: total}, nil
+}
+
+func (s *orderHistoryServiceImpl) DiffOrderVersions(ctx context.Context, orderID string, fromVersion, toVersion int) (*domain.OrderDiff, error) {
+	if _, err := uuid.Parse(orderID); err != nil {
+		return nil, domain.ErrOrderNotFound
+	}
+	if fromVersion < 1 || (toVersion != 0 && toVersion <= fromVersion) {
+		return nil, domain.ErrInvalidVersionRange
+	}
+	if err := s.authorize(ctx, orderID); err != nil {
+		return nil, err
+	}
+
+	// Each entry holds the order as a mutation left it, oldest first, so the
+	// versions needed are found by reading up to toVersion
+	snapshots := make(map[int]*domain.Order)
+	latest := 0
+	q := repository.HistoryRangeQuery{OrderID: orderID, Limit: historyPageSize}
+	for toVersion == 0 || snapshots[toVersion] == nil {
+		entries, err := s.history.ListRange(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if entry.NewState != nil {
+				snapshots[entry.NewState.Version] = entry.NewState
+				latest = max(latest, entry.NewState.Version)
+			}
+			q.After, q.AfterTime, q.AfterID = true, entry.CreatedAt, entry.ID
+		}
+		if len(entries) < q.Limit {
+			break
+		}
+	}
+
+	if len(snapshots) == 0 {
+		if err := s.requireOrder(ctx, orderID); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrVersionNotInHistory
+	}
+	if toVersion == 0 {
+		if latest <= fromVersion {
+			return nil, domain.ErrInvalidVersionRange
+		}
+		toVersion = latest
+	}
+
+	from, to := snapshots[fromVersion], snapshots[toVersion]
+	if from == nil || to == nil {
+		return nil, domain.ErrVersionNotInHistory
+	}
+	return domain.DiffOrders(from, to), nil
+}
+
+// authorize lets customer tokens read the history of their own live orders
+// only
+func (s *orderHistoryServiceImpl) authorize(ctx context.Context, orderID string) error {
+	p, ok := domain.PrincipalFromContext(ctx)
+	if !ok || p.Role == domain.RoleService {
+		return nil
+	}
+	order, err := s.orders.FindByID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if order == nil {
+		return domain.ErrOrderNotFound
+	}
+	if !p.CanAccess(order.CustomerID) {
+		return domain.ErrAccessDenied
+	}
+	return nil
+}
+
+// requireOrder returns domain.ErrOrderNotFound unless the order exists
+func (s *orderHistoryServiceImpl) requireOrder(ctx context.Context, orderID string) error {
+	order, err := s.orders.FindByID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if order == nil {
+		return domain.ErrOrderNotFound
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderHistoryService_GetOrderHistory_ClampsPagination(t *testing.T) {
+	tests := []struct {
+		name       string
+		limit      int
+		offset     int
+		wantLimit  int
+		wantOffset int
+	}{
+		{name: "defaults", limit: 0, offset: -1, wantLimit: 20, wantOffset: 0},
+		{name: "max limit", limit: 1000, offset: 5, wantLimit: 100, wantOffset: 5},
+		{name: "passthrough", limit: 10, offset: 30, wantLimit: 10, wantOffset: 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotLimit, gotOffset int
+			history := &mocks.OrderHistoryRepositoryMock{
+				ListByOrderIDFunc: func(_ context.Context, _ string, limit, offset int) ([]*domain.OrderHistoryEntry, int64, error) {
+					gotLimit, gotOffset = limit, offset
+					return []*domain.OrderHistoryEntry{{ID: uuid.New(), Action: domain.HistoryActionCreated}}, 1, nil
+				},
+			}
+
+			svc := NewOrderHistoryService(history, &mocks.OrderRepositoryMock{})
+			result, err := svc.GetOrderHistory(context.Background(), uuid.NewString(), tt.limit, tt.offset)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantLimit, gotLimit)
+			assert.Equal(t, tt.wantOffset, gotOffset)
+			assert.Len(t, result.Data, 1)
+			assert.Equal(t, int64(1), result.Total)
+		})
+	}
+}
+
+func TestOrderHistoryService_GetOrderHistory_InvalidID_ReturnsNotFound(t *testing.T) {
+	svc := NewOrderHistoryService(&mocks.OrderHistoryRepositoryMock{}, &mocks.OrderRepositoryMock{})
+
+	_, err := svc.GetOrderHistory(context.Background(), "not-a-uuid", 20, 0)
+
+	assert.ErrorIs(t, err, domain.ErrOrderNotFound)
+}
+
+func TestOrderHistoryService_GetOrderHistory_NoEntries(t *testing.T) {
+	tests := []struct {
+		name    string
+		order   *domain.Order
+		findErr error
+		wantErr error
+	}{
+		{name: "order missing", order: nil, wantErr: domain.ErrOrderNotFound},
+		{name: "order predates history", order: &domain.Order{ID: uuid.New()}},
+		{name: "lookup fails", findErr: errors.New("db down"), wantErr: errors.New("db down")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orders := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+					return tt.order, tt.findErr
+				},
+			}
+
+			svc := NewOrderHistoryService(&mocks.OrderHistoryRepositoryMock{}, orders)
+			result, err := svc.GetOrderHistory(context.Background(), uuid.NewString(), 20, 0)
+
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Empty(t, result.Data)
+			assert.Zero(t, result.Total)
+		})
+	}
+}
+
+// historyOf returns one entry per version of order, each with the order as
+// that version left it
+func historyOf(versions ...*domain.Order) []*domain.OrderHistoryEntry {
+	entries := make([]*domain.OrderHistoryEntry, len(versions))
+	for i, v := range versions {
+		entries[i] = &domain.OrderHistoryEntry{ID: uuid.New(), OrderID: v.ID, Action: domain.HistoryActionUpdated, NewState: v}
+	}
+	return entries
+}
+
+func TestOrderHistoryService_DiffOrderVersions(t *testing.T) {
+	orderID, kept, removed, added := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	v1 := &domain.Order{ID: orderID, Version: 1, Status: domain.OrderStatusPending, Total: 15, Items: []domain.OrderItem{
+		{ID: kept, ProductID: "prod-1", Quantity: 1, Price: 10, Subtotal: 10},
+		{ID: removed, ProductID: "prod-2", Quantity: 1, Price: 5, Subtotal: 5},
+	}}
+	v2 := &domain.Order{ID: orderID, Version: 2, Status: domain.OrderStatusConfirmed, Total: 15, Items: v1.Items}
+	v3 := &domain.Order{ID: orderID, Version: 3, Status: domain.OrderStatusConfirmed, Total: 27, Tags: []string{"gift"}, Items: []domain.OrderItem{
+		{ID: kept, ProductID: "prod-1", Quantity: 2, Price: 10, Subtotal: 20},
+		{ID: added, ProductID: "prod-3", Quantity: 1, Price: 7, Subtotal: 7},
+	}}
+	history := &mocks.OrderHistoryRepositoryMock{
+		ListRangeFunc: func(_ context.Context, _ repository.HistoryRangeQuery) ([]*domain.OrderHistoryEntry, error) {
+			return historyOf(v1, v2, v3), nil
+		},
+	}
+	svc := NewOrderHistoryService(history, &mocks.OrderRepositoryMock{})
+
+	diff, err := svc.DiffOrderVersions(context.Background(), orderID.String(), 1, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, diff.FromVersion)
+	assert.Equal(t, 3, diff.ToVersion)
+	assert.Equal(t, []domain.FieldChange{
+		{Field: "status", From: domain.OrderStatusPending, To: domain.OrderStatusConfirmed},
+		{Field: "total", From: 15.0, To: 27.0},
+		{Field: "tags", From: nil, To: []string{"gift"}},
+	}, diff.Changes)
+	require.Len(t, diff.Items, 3)
+	assert.Equal(t, domain.ItemChanged, diff.Items[0].Kind)
+	assert.Equal(t, kept, diff.Items[0].ItemID)
+	assert.Equal(t, []domain.FieldChange{
+		{Field: "quantity", From: 1, To: 2},
+		{Field: "subtotal", From: 10.0, To: 20.0},
+	}, diff.Items[0].Changes)
+	assert.Equal(t, domain.ItemRemoved, diff.Items[1].Kind)
+	assert.Equal(t, removed, diff.Items[1].ItemID)
+	assert.Nil(t, diff.Items[1].To)
+	assert.Equal(t, domain.ItemAdded, diff.Items[2].Kind)
+	assert.Equal(t, added, diff.Items[2].ItemID)
+	assert.Nil(t, diff.Items[2].From)
+}
+
+func TestOrderHistoryService_DiffOrderVersions_Errors(t *testing.T) {
+	orderID := uuid.New()
+	recorded := historyOf(&domain.Order{ID: orderID, Version: 2}, &domain.Order{ID: orderID, Version: 3})
+
+	tests := []struct {
+		name     string
+		orderID  string
+		from, to int
+		entries  []*domain.OrderHistoryEntry
+		order    *domain.Order
+		wantErr  error
+	}{
+		{name: "invalid id", orderID: "not-a-uuid", from: 1, wantErr: domain.ErrOrderNotFound},
+		{name: "from below 1", from: 0, to: 2, wantErr: domain.ErrInvalidVersionRange},
+		{name: "to not above from", from: 2, to: 2, wantErr: domain.ErrInvalidVersionRange},
+		{name: "from is latest", from: 3, entries: recorded, wantErr: domain.ErrInvalidVersionRange},
+		{name: "version not recorded", from: 1, to: 3, entries: recorded, wantErr: domain.ErrVersionNotInHistory},
+		{name: "order missing", from: 1, wantErr: domain.ErrOrderNotFound},
+		{name: "order predates history", from: 1, order: &domain.Order{ID: orderID}, wantErr: domain.ErrVersionNotInHistory},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history := &mocks.OrderHistoryRepositoryMock{
+				ListRangeFunc: func(_ context.Context, _ repository.HistoryRangeQuery) ([]*domain.OrderHistoryEntry, error) {
+					return tt.entries, nil
+				},
+			}
+			orders := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+					return tt.order, nil
+				},
+			}
+			id := tt.orderID
+			if id == "" {
+				id = orderID.String()
+			}
+
+			svc := NewOrderHistoryService(history, orders)
+			_, err := svc.DiffOrderVersions(context.Background(), id, tt.from, tt.to)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestOrderHistoryService_DiffOrderVersions_PagesUntilToVersion(t *testing.T) {
+	orderID := uuid.New()
+	versions := make([]*domain.Order, historyPageSize+10)
+	for i := range versions {
+		versions[i] = &domain.Order{ID: orderID, Version: i + 1}
+	}
+	entries := historyOf(versions...)
+
+	var calls int
+	history := &mocks.OrderHistoryRepositoryMock{
+		ListRangeFunc: func(_ context.Context, q repository.HistoryRangeQuery) ([]*domain.OrderHistoryEntry, error) {
+			calls++
+			start := 0
+			if q.After {
+				start = slices.IndexFunc(entries, func(e *domain.OrderHistoryEntry) bool { return e.ID == q.AfterID }) + 1
+			}
+			return entries[start:min(start+q.Limit, len(entries))], nil
+		},
+	}
+	svc := NewOrderHistoryService(history, &mocks.OrderRepositoryMock{})
+
+	diff, err := svc.DiffOrderVersions(context.Background(), orderID.String(), 1, historyPageSize+5)
+	require.NoError(t, err)
+	assert.Equal(t, historyPageSize+5, diff.ToVersion)
+	assert.Equal(t, 2, calls)
+
+	calls = 0
+	_, err = svc.DiffOrderVersions(context.Background(), orderID.String(), 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
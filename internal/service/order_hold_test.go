@@ -0,0 +1,287 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderService_HoldOrder_HoldsAndPublishesStatusChange(t *testing.T) {
+	tests := []struct {
+		name         string
+		releaseAfter time.Duration
+		wantRelease  bool
+	}{
+		{name: "held until released", releaseAfter: 0},
+		{name: "released automatically", releaseAfter: time.Hour, wantRelease: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := createMockOrder(domain.OrderStatusConfirmed)
+			var saved *domain.Order
+			mockRepo := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+				UpdateFunc: func(_ context.Context, o *domain.Order) error {
+					saved = o
+					return nil
+				},
+			}
+			var gotOld, gotNew domain.OrderStatus
+			mockPublisher := &mocks.EventPublisherMock{
+				PublishOrderStatusChangedFunc: func(_ context.Context, _ *domain.Order, old, new_ domain.OrderStatus) error {
+					gotOld, gotNew = old, new_
+					return nil
+				},
+			}
+			settings := DefaultSettings
+			settings.HoldReleaseAfter = tt.releaseAfter
+
+			svc := NewOrderService(mockRepo, nil, nil, mockPublisher, nil, StaticConfig(settings))
+			held, err := svc.HoldOrder(context.Background(), order.ID.String(), "address check", nil)
+
+			require.NoError(t, err)
+			require.NotNil(t, saved)
+			assert.Equal(t, domain.OrderStatusOnHold, held.Status)
+			require.NotNil(t, held.Hold)
+			assert.Equal(t, "address check", held.Hold.Reason)
+			assert.Equal(t, domain.OrderStatusConfirmed, held.Hold.PreviousStatus)
+			if tt.wantRelease {
+				require.NotNil(t, held.Hold.ReleaseAt)
+				assert.Equal(t, held.Hold.HeldAt.Add(tt.releaseAfter), *held.Hold.ReleaseAt)
+			} else {
+				assert.Nil(t, held.Hold.ReleaseAt)
+			}
+			assert.Equal(t, domain.OrderStatusConfirmed, gotOld)
+			assert.Equal(t, domain.OrderStatusOnHold, gotNew)
+		})
+	}
+}
+
+func TestOrderService_HoldOrder_PaymentHold_NotReleasedAutomatically(t *testing.T) {
+	for _, reason := range []string{"payment failed: card_declined", "payment mismatch: paid 10.00 usd, order total 12.50 usd"} {
+		t.Run(reason, func(t *testing.T) {
+			order := createMockOrder(domain.OrderStatusPending)
+			mockRepo := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+				UpdateFunc:   func(_ context.Context, _ *domain.Order) error { return nil },
+			}
+			settings := DefaultSettings
+			settings.HoldReleaseAfter = time.Hour
+
+			svc := NewOrderService(mockRepo, nil, nil, nil, nil, StaticConfig(settings))
+			held, err := svc.HoldOrder(context.Background(), order.ID.String(), reason, nil)
+
+			require.NoError(t, err)
+			require.NotNil(t, held.Hold)
+			assert.Nil(t, held.Hold.ReleaseAt)
+		})
+	}
+}
+
+func TestOrderService_HoldOrder_Errors(t *testing.T) {
+	tests := []struct {
+		name            string
+		status          domain.OrderStatus
+		reason          string
+		expectedVersion *int
+		wantErr         error
+	}{
+		{name: "shipped order", status: domain.OrderStatusShipped, reason: "late", wantErr: domain.ErrInvalidTransition},
+		{name: "already on hold", status: domain.OrderStatusOnHold, reason: "again", wantErr: domain.ErrInvalidTransition},
+		{name: "cancelled order", status: domain.OrderStatusCancelled, reason: "late", wantErr: domain.ErrInvalidTransition},
+		{name: "empty reason", status: domain.OrderStatusPending, reason: "", wantErr: domain.ErrInvalidHoldReason},
+		{name: "stale version", status: domain.OrderStatusPending, reason: "late", expectedVersion: intPtr(7), wantErr: domain.ErrVersionMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := createMockOrder(tt.status)
+			mockRepo := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+				UpdateFunc: func(_ context.Context, _ *domain.Order) error {
+					t.Fatal("a rejected hold must not be saved")
+					return nil
+				},
+			}
+
+			svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+			_, err := svc.HoldOrder(context.Background(), order.ID.String(), tt.reason, tt.expectedVersion)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestOrderService_ReleaseOrder_RestoresPreviousStatus(t *testing.T) {
+	order := createMockOrder(domain.OrderStatusProcessing)
+	require.NoError(t, order.PlaceOnHold("stock check", nil, time.Now()))
+
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+		UpdateFunc:   func(_ context.Context, _ *domain.Order) error { return nil },
+	}
+	var gotOld, gotNew domain.OrderStatus
+	mockPublisher := &mocks.EventPublisherMock{
+		PublishOrderStatusChangedFunc: func(_ context.Context, _ *domain.Order, old, new_ domain.OrderStatus) error {
+			gotOld, gotNew = old, new_
+			return nil
+		},
+	}
+
+	svc := NewOrderService(mockRepo, nil, nil, mockPublisher, nil, nil)
+	released, err := svc.ReleaseOrder(context.Background(), order.ID.String(), nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.OrderStatusProcessing, released.Status)
+	assert.Nil(t, released.Hold)
+	assert.Equal(t, domain.OrderStatusOnHold, gotOld)
+	assert.Equal(t, domain.OrderStatusProcessing, gotNew)
+}
+
+func TestOrderService_ReleaseOrder_NotOnHold_ReturnsErrOrderNotHeld(t *testing.T) {
+	order := createMockOrder(domain.OrderStatusPending)
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+	}
+
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	_, err := svc.ReleaseOrder(context.Background(), order.ID.String(), nil)
+
+	assert.ErrorIs(t, err, domain.ErrOrderNotHeld)
+}
+
+// holdTestPrincipal returns the token of order's customer, or an operator's
+func holdTestPrincipal(order *domain.Order, customer bool) *domain.Principal {
+	if customer {
+		return &domain.Principal{Subject: order.CustomerID, Role: domain.RoleCustomer, CustomerID: order.CustomerID}
+	}
+	return &domain.Principal{Subject: "ops", Role: domain.RoleService}
+}
+
+func TestOrderService_ReleaseOrder_PaymentHold_Authorization(t *testing.T) {
+	tests := []struct {
+		name     string
+		reason   string
+		customer bool
+		wantErr  error
+	}{
+		{name: "customer releases failed payment", reason: "payment failed: card_declined", customer: true},
+		{name: "customer releases mismatch", reason: "payment mismatch: paid 10.00 usd, order total 12.50 usd", customer: true, wantErr: domain.ErrAccessDenied},
+		{name: "operator releases mismatch", reason: "payment mismatch: paid 10.00 usd, order total 12.50 usd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := createMockOrder(domain.OrderStatusPending)
+			require.NoError(t, order.PlaceOnHold(tt.reason, nil, time.Now()))
+			mockRepo := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+				UpdateFunc:   func(_ context.Context, _ *domain.Order) error { return nil },
+			}
+
+			svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+			ctx := domain.WithPrincipal(context.Background(), holdTestPrincipal(order, tt.customer))
+			_, err := svc.ReleaseOrder(ctx, order.ID.String(), nil)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, domain.OrderStatusOnHold, order.Status)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, domain.OrderStatusPending, order.Status)
+		})
+	}
+}
+
+func TestOrderService_UpdateOrderStatus_RequirePayment(t *testing.T) {
+	tests := []struct {
+		name           string
+		requirePayment bool
+		customer       bool
+		newStatus      domain.OrderStatus
+		wantErr        error
+	}{
+		{name: "customer confirms", requirePayment: true, customer: true, newStatus: domain.OrderStatusConfirmed, wantErr: domain.ErrAccessDenied},
+		{name: "customer cancels", requirePayment: true, customer: true, newStatus: domain.OrderStatusCancelled},
+		{name: "operator confirms", requirePayment: true, newStatus: domain.OrderStatusConfirmed},
+		{name: "customer confirms without payments", customer: true, newStatus: domain.OrderStatusConfirmed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := createMockOrder(domain.OrderStatusPending)
+			mockRepo := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+				UpdateFunc:   func(_ context.Context, _ *domain.Order) error { return nil },
+			}
+			settings := DefaultSettings
+			settings.RequirePayment = tt.requirePayment
+
+			svc := NewOrderService(mockRepo, nil, nil, nil, nil, StaticConfig(settings))
+			ctx := domain.WithPrincipal(context.Background(), holdTestPrincipal(order, tt.customer))
+			_, err := svc.UpdateOrderStatus(ctx, order.ID.String(), tt.newStatus, nil)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, domain.OrderStatusPending, order.Status)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.newStatus, order.Status)
+		})
+	}
+}
+
+func TestOrderService_UpdateOrderStatus_OnHold(t *testing.T) {
+	tests := []struct {
+		name      string
+		newStatus domain.OrderStatus
+		wantErr   error
+	}{
+		{name: "cancel clears the hold", newStatus: domain.OrderStatusCancelled},
+		{name: "status change while held", newStatus: domain.OrderStatusShipped, wantErr: domain.ErrInvalidTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := createMockOrder(domain.OrderStatusProcessing)
+			require.NoError(t, order.PlaceOnHold("stock check", nil, time.Now()))
+			mockRepo := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+				UpdateFunc:   func(_ context.Context, _ *domain.Order) error { return nil },
+			}
+
+			svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+			updated, err := svc.UpdateOrderStatus(context.Background(), order.ID.String(), tt.newStatus, nil)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.newStatus, updated.Status)
+			assert.Nil(t, updated.Hold)
+		})
+	}
+}
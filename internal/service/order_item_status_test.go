@@ -0,0 +1,175 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createMockOrderWithItems returns an order whose items are at the given statuses
+func createMockOrderWithItems(status domain.OrderStatus, itemStatuses ...domain.ItemStatus) *domain.Order {
+	order := createMockOrder(status)
+	order.Items = make([]domain.OrderItem, len(itemStatuses))
+	for i, s := range itemStatuses {
+		order.Items[i] = domain.OrderItem{ID: uuid.New(), ProductID: "product-1", Name: "Test Product", Quantity: 1, Price: 10.00, Subtotal: 10.00, Status: s}
+	}
+	return order
+}
+
+func TestOrderService_UpdateItemStatus_DerivesOrderStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		orderStatus domain.OrderStatus
+		items       []domain.ItemStatus
+		update      int
+		newStatus   domain.ItemStatus
+		wantStatus  domain.OrderStatus
+	}{
+		{name: "first item picked", orderStatus: domain.OrderStatusConfirmed, items: []domain.ItemStatus{domain.ItemStatusPending, domain.ItemStatusPending}, update: 0, newStatus: domain.ItemStatusPicked, wantStatus: domain.OrderStatusProcessing},
+		{name: "partial shipment", orderStatus: domain.OrderStatusProcessing, items: []domain.ItemStatus{domain.ItemStatusPending, domain.ItemStatusPicked}, update: 1, newStatus: domain.ItemStatusShipped, wantStatus: domain.OrderStatusProcessing},
+		{name: "last item shipped", orderStatus: domain.OrderStatusProcessing, items: []domain.ItemStatus{domain.ItemStatusShipped, domain.ItemStatusPicked}, update: 1, newStatus: domain.ItemStatusShipped, wantStatus: domain.OrderStatusShipped},
+		{name: "delivered with a return", orderStatus: domain.OrderStatusShipped, items: []domain.ItemStatus{domain.ItemStatusReturned, domain.ItemStatusShipped}, update: 1, newStatus: domain.ItemStatusDelivered, wantStatus: domain.OrderStatusDelivered},
+		{name: "return never moves the order back", orderStatus: domain.OrderStatusDelivered, items: []domain.ItemStatus{domain.ItemStatusDelivered, domain.ItemStatusDelivered}, update: 0, newStatus: domain.ItemStatusReturned, wantStatus: domain.OrderStatusDelivered},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := createMockOrderWithItems(tt.orderStatus, tt.items...)
+			itemID := order.Items[tt.update].ID
+			mockRepo := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+				UpdateFunc:   func(_ context.Context, _ *domain.Order) error { return nil },
+			}
+			updatedEvents, statusEvents := 0, 0
+			mockPublisher := &mocks.EventPublisherMock{
+				PublishOrderUpdatedFunc: func(_ context.Context, _ *domain.Order) error {
+					updatedEvents++
+					return nil
+				},
+				PublishOrderStatusChangedFunc: func(_ context.Context, _ *domain.Order, _, _ domain.OrderStatus) error {
+					statusEvents++
+					return nil
+				},
+			}
+
+			svc := NewOrderService(mockRepo, nil, nil, mockPublisher, nil, nil)
+			updated, err := svc.UpdateItemStatus(context.Background(), order.ID.String(), []string{itemID.String()}, tt.newStatus, nil)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.newStatus, updated.Items[tt.update].Status)
+			assert.Equal(t, tt.wantStatus, updated.Status)
+			assert.Equal(t, 1, updatedEvents)
+			if tt.wantStatus != tt.orderStatus {
+				assert.Equal(t, 1, statusEvents)
+			} else {
+				assert.Zero(t, statusEvents)
+			}
+		})
+	}
+}
+
+func TestOrderService_UpdateItemStatus_Errors(t *testing.T) {
+	tests := []struct {
+		name            string
+		orderStatus     domain.OrderStatus
+		itemID          string
+		status          domain.ItemStatus
+		expectedVersion *int
+		wantErr         error
+	}{
+		{name: "unknown status", orderStatus: domain.OrderStatusConfirmed, status: "lost", wantErr: domain.ErrInvalidItemStatus},
+		{name: "pending order", orderStatus: domain.OrderStatusPending, status: domain.ItemStatusPicked, wantErr: domain.ErrInvalidTransition},
+		{name: "cancelled order", orderStatus: domain.OrderStatusCancelled, status: domain.ItemStatusPicked, wantErr: domain.ErrInvalidTransition},
+		{name: "unknown item", orderStatus: domain.OrderStatusConfirmed, itemID: uuid.NewString(), status: domain.ItemStatusPicked, wantErr: domain.ErrItemNotFound},
+		{name: "malformed item ID", orderStatus: domain.OrderStatusConfirmed, itemID: "not-a-uuid", status: domain.ItemStatusPicked, wantErr: domain.ErrItemNotFound},
+		{name: "skipping shipment", orderStatus: domain.OrderStatusConfirmed, status: domain.ItemStatusDelivered, wantErr: domain.ErrInvalidItemTransition},
+		{name: "stale version", orderStatus: domain.OrderStatusConfirmed, status: domain.ItemStatusPicked, expectedVersion: intPtr(7), wantErr: domain.ErrVersionMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := createMockOrderWithItems(tt.orderStatus, domain.ItemStatusPending)
+			itemID := tt.itemID
+			if itemID == "" {
+				itemID = order.Items[0].ID.String()
+			}
+			mockRepo := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+				UpdateFunc: func(_ context.Context, _ *domain.Order) error {
+					t.Fatal("a rejected item update must not be saved")
+					return nil
+				},
+			}
+
+			svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+			_, err := svc.UpdateItemStatus(context.Background(), order.ID.String(), []string{itemID}, tt.status, tt.expectedVersion)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestOrderService_UpdateItemStatus_AllOrNothing(t *testing.T) {
+	order := createMockOrderWithItems(domain.OrderStatusProcessing, domain.ItemStatusPicked, domain.ItemStatusPending)
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+	}
+
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	ids := []string{order.Items[0].ID.String(), order.Items[1].ID.String()}
+	_, err := svc.UpdateItemStatus(context.Background(), order.ID.String(), ids, domain.ItemStatusPicked, nil)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidItemTransition)
+	assert.Equal(t, domain.ItemStatusPending, order.Items[1].Status)
+}
+
+func TestOrderService_UpdateOrderStatus_ShipsRemainingItems(t *testing.T) {
+	order := createMockOrderWithItems(domain.OrderStatusProcessing, domain.ItemStatusShipped, domain.ItemStatusPicked)
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+		UpdateFunc:   func(_ context.Context, _ *domain.Order) error { return nil },
+	}
+
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	updated, err := svc.UpdateOrderStatus(context.Background(), order.ID.String(), domain.OrderStatusShipped, nil)
+
+	require.NoError(t, err)
+	for _, item := range updated.Items {
+		assert.Equal(t, domain.ItemStatusShipped, item.Status)
+	}
+}
+
+func TestOrderService_UpdateOrder_RejectsOnceFulfillmentStarted(t *testing.T) {
+	order := createMockOrderWithItems(domain.OrderStatusProcessing, domain.ItemStatusPicked)
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+		UpdateFunc: func(_ context.Context, _ *domain.Order) error {
+			t.Fatal("items in fulfillment must not be replaced")
+			return nil
+		},
+	}
+
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	_, err := svc.UpdateOrder(context.Background(), order.ID.String(), UpdateOrderDTO{Items: []domain.OrderItem{{ProductID: "p", Name: "n", Quantity: 1, Price: 1}}})
+
+	assert.ErrorIs(t, err, domain.ErrItemsInFulfillment)
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderService_CreateOrder_WithLabels_NormalizesTags(t *testing.T) {
+	var saved *domain.Order
+	mockRepo := &mocks.OrderRepositoryMock{
+		CreateFunc: func(_ context.Context, o *domain.Order) error {
+			saved = o
+			return nil
+		},
+	}
+
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	order, err := svc.CreateOrder(context.Background(), CreateOrderDTO{
+		CustomerID: "cust-1",
+		Items:      []domain.OrderItem{{ProductID: "product-1", Name: "Test Product", Quantity: 1, Price: 10.00}},
+		Metadata:   map[string]string{"channel": "web"},
+		Tags:       []string{" VIP ", "gift", "vip"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"channel": "web"}, order.Metadata)
+	assert.Equal(t, []string{"vip", "gift"}, order.Tags)
+	assert.Same(t, order, saved)
+}
+
+func TestOrderService_CreateOrder_InvalidLabels_ReturnsError(t *testing.T) {
+	tooManyTags := make([]string, domain.MaxTags+1)
+	for i := range tooManyTags {
+		tooManyTags[i] = "tag-" + string(rune('a'+i))
+	}
+
+	tests := []struct {
+		name     string
+		metadata map[string]string
+		tags     []string
+		wantErr  error
+	}{
+		{name: "empty metadata key", metadata: map[string]string{"": "x"}, wantErr: domain.ErrInvalidMetadata},
+		{name: "metadata value too long", metadata: map[string]string{"k": strings.Repeat("x", domain.MaxMetadataValueLength+1)}, wantErr: domain.ErrInvalidMetadata},
+		{name: "blank tag", tags: []string{"  "}, wantErr: domain.ErrInvalidTag},
+		{name: "tag too long", tags: []string{strings.Repeat("t", domain.MaxTagLength+1)}, wantErr: domain.ErrInvalidTag},
+		{name: "too many tags", tags: tooManyTags, wantErr: domain.ErrInvalidTag},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mocks.OrderRepositoryMock{
+				CreateFunc: func(_ context.Context, _ *domain.Order) error {
+					t.Fatal("invalid labels must not be saved")
+					return nil
+				},
+			}
+
+			svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+			order, err := svc.CreateOrder(context.Background(), CreateOrderDTO{
+				CustomerID: "cust-1",
+				Items:      []domain.OrderItem{{ProductID: "product-1", Name: "Test Product", Quantity: 1, Price: 10.00}},
+				Metadata:   tt.metadata,
+				Tags:       tt.tags,
+			})
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, order)
+		})
+	}
+}
+
+func TestOrderService_UpdateOrder_Labels(t *testing.T) {
+	tests := []struct {
+		name         string
+		dto          UpdateOrderDTO
+		wantMetadata map[string]string
+		wantTags     []string
+	}{
+		{
+			name:         "omitted labels unchanged",
+			dto:          UpdateOrderDTO{},
+			wantMetadata: map[string]string{"channel": "web"},
+			wantTags:     []string{"vip"},
+		},
+		{
+			name:         "tags replaced, metadata kept",
+			dto:          UpdateOrderDTO{Tags: []string{"Gift"}},
+			wantMetadata: map[string]string{"channel": "web"},
+			wantTags:     []string{"gift"},
+		},
+		{
+			name:         "empty labels cleared",
+			dto:          UpdateOrderDTO{Metadata: map[string]string{}, Tags: []string{}},
+			wantMetadata: map[string]string{},
+			wantTags:     []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := createMockOrder(domain.OrderStatusPending)
+			order.Metadata = map[string]string{"channel": "web"}
+			order.Tags = []string{"vip"}
+			mockRepo := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+				UpdateFunc:   func(_ context.Context, _ *domain.Order) error { return nil },
+			}
+
+			svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+			updated, err := svc.UpdateOrder(context.Background(), order.ID.String(), tt.dto)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMetadata, updated.Metadata)
+			assert.Equal(t, tt.wantTags, updated.Tags)
+		})
+	}
+}
+
+func TestOrderService_ListOrders_WithTags_PassesNormalizedTags(t *testing.T) {
+	var got repository.ListOptions
+	mockRepo := &mocks.OrderRepositoryMock{
+		ListFunc: func(_ context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+			got = opts
+			return createMockOrders(1), 1, nil
+		},
+		EstimateTotalFunc: func(_ context.Context) (int64, error) {
+			t.Fatal("a tag filter must not use the unfiltered estimate")
+			return 0, nil
+		},
+	}
+
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	_, err := svc.ListOrders(context.Background(), ListOrdersRequest{Page: 1, PageSize: 10, Tags: []string{"VIP", "vip", "gift"}})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vip", "gift"}, got.Tags)
+	assert.False(t, got.SkipTotal)
+}
+
+func TestOrderService_ListOrders_InvalidTag_ReturnsErrInvalidTag(t *testing.T) {
+	svc := NewOrderService(&mocks.OrderRepositoryMock{}, nil, nil, nil, nil, nil)
+
+	result, err := svc.ListOrders(context.Background(), ListOrdersRequest{Page: 1, PageSize: 10, Tags: []string{""}})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidTag)
+	assert.Nil(t, result)
+}
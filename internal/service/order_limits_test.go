@@ -0,0 +1,140 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testLimits = StaticConfig{
+	MaxPageSize: 100,
+	OrderLimits: domain.OrderLimits{MaxItems: 3, MaxItemQuantity: 10, MaxTotal: domain.MoneyFromFloat(100)},
+}
+
+func TestOrderService_CreateOrder_Limits(t *testing.T) {
+	item := func(quantity int, price float64) domain.OrderItem {
+		return domain.OrderItem{ProductID: "product-1", Name: "Widget", Quantity: quantity, Price: price}
+	}
+	tests := []struct {
+		name    string
+		items   []domain.OrderItem
+		wantErr error
+	}{
+		{
+			name:  "at every limit",
+			items: []domain.OrderItem{item(10, 5.00), item(1, 25.00), item(1, 25.00)},
+		},
+		{
+			name:    "too many items",
+			items:   []domain.OrderItem{item(1, 1.00), item(1, 1.00), item(1, 1.00), item(1, 1.00)},
+			wantErr: domain.ErrTooManyItems,
+		},
+		{
+			name:    "quantity above limit",
+			items:   []domain.OrderItem{item(11, 1.00)},
+			wantErr: domain.ErrQuantityLimitExceeded,
+		},
+		{
+			name:    "total above limit by a cent",
+			items:   []domain.OrderItem{item(10, 10.00), item(1, 0.01)},
+			wantErr: domain.ErrTotalLimitExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mocks.OrderRepositoryMock{
+				CreateFunc: func(_ context.Context, _ *domain.Order) error {
+					if tt.wantErr != nil {
+						t.Fatal("an order over a limit must not be saved")
+					}
+					return nil
+				},
+			}
+			svc := NewOrderService(mockRepo, nil, nil, nil, nil, testLimits)
+
+			_, err := svc.CreateOrder(context.Background(), CreateOrderDTO{CustomerID: "cust-1", Items: tt.items})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOrderService_CreateOrder_NoLimitsByDefault(t *testing.T) {
+	items := make([]domain.OrderItem, 500)
+	for i := range items {
+		items[i] = domain.OrderItem{ProductID: "product-1", Name: "Widget", Quantity: 100000, Price: 100.00}
+	}
+	svc := NewOrderService(&mocks.OrderRepositoryMock{}, nil, nil, nil, nil, nil)
+
+	_, err := svc.CreateOrder(context.Background(), CreateOrderDTO{CustomerID: "cust-1", Items: items})
+
+	require.NoError(t, err)
+}
+
+func TestOrderService_ItemChanges_Limits(t *testing.T) {
+	t.Run("add beyond max items", func(t *testing.T) {
+		order := patchableOrder()
+		order.Items = append(order.Items, order.Items[1])
+		svc := NewOrderService(patchRepo(order, nil), nil, nil, nil, nil, testLimits)
+
+		_, err := svc.AddItem(context.Background(), order.ID.String(), domain.OrderItem{ProductID: "product-3", Name: "Pad", Quantity: 1, Price: 1.00}, nil)
+
+		assert.ErrorIs(t, err, domain.ErrTooManyItems)
+	})
+
+	t.Run("update beyond max quantity", func(t *testing.T) {
+		order := patchableOrder()
+		svc := NewOrderService(patchRepo(order, nil), nil, nil, nil, nil, testLimits)
+
+		_, err := svc.UpdateItem(context.Background(), order.ID.String(), order.Items[1].ID.String(), ItemPatch{Quantity: ptr(11)}, nil)
+
+		assert.ErrorIs(t, err, domain.ErrQuantityLimitExceeded)
+	})
+
+	t.Run("patch beyond max total", func(t *testing.T) {
+		order := patchableOrder()
+		svc := NewOrderService(patchRepo(order, nil), nil, nil, nil, nil, testLimits)
+
+		_, err := svc.PatchOrder(context.Background(), order.ID.String(), PatchOrderDTO{
+			Items: map[string]*ItemPatch{order.Items[0].ID.String(): {Quantity: ptr(10)}},
+		}, nil)
+
+		assert.ErrorIs(t, err, domain.ErrTotalLimitExceeded)
+	})
+
+	t.Run("update replacing items beyond max items", func(t *testing.T) {
+		order := patchableOrder()
+		items := make([]domain.OrderItem, 4)
+		for i := range items {
+			items[i] = domain.OrderItem{ProductID: "product-1", Name: "Widget", Quantity: 1, Price: 1.00}
+		}
+		svc := NewOrderService(patchRepo(order, nil), nil, nil, nil, nil, testLimits)
+
+		_, err := svc.UpdateOrder(context.Background(), order.ID.String(), UpdateOrderDTO{Items: items})
+
+		assert.ErrorIs(t, err, domain.ErrTooManyItems)
+	})
+}
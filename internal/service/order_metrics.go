@@ -0,0 +1,120 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// Reasons an order was cancelled, as labelled on order_cancellations_total
+const (
+	// CancelReasonCustomer is a customer cancelling their own order
+	CancelReasonCustomer = "customer"
+	// CancelReasonOperator is any other caller of the API
+	CancelReasonOperator = "operator"
+	// CancelReasonExpired is the pending order expiry job
+	CancelReasonExpired = "expired"
+	// CancelReasonSystem is another background job
+	CancelReasonSystem = "system"
+)
+
+// OrderMetrics describes what happens to orders: how many are created and
+// for how much, how they move between statuses and why they are cancelled.
+type OrderMetrics struct {
+	created       *prometheus.CounterVec
+	value         prometheus.Histogram
+	transitions   *prometheus.CounterVec
+	cancellations *prometheus.CounterVec
+}
+
+// NewOrderMetrics registers the order business metrics with reg.
+func NewOrderMetrics(reg prometheus.Registerer) *OrderMetrics {
+	m := &OrderMetrics{
+		created: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "orders_created_total",
+			Help: "Orders created, by the status they were created in.",
+		}, []string{"status"}),
+		value: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name: "order_total",
+			Help: "Totals of created orders; order_total_sum is the revenue booked.",
+			// 5 to about 80,000 in the order's currency
+			Buckets: prometheus.ExponentialBuckets(5, 2, 15),
+		}),
+		transitions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "status_transition_total",
+			Help: "Order status changes, by the status left and the status entered.",
+		}, []string{"from", "to"}),
+		cancellations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "order_cancellations_total",
+			Help: "Orders cancelled, by who cancelled them: customer, operator, expired or system.",
+		}, []string{"reason"}),
+	}
+	reg.MustRegister(m.created, m.value, m.transitions, m.cancellations)
+	return m
+}
+
+// metricsPublisher is an EventPublisher that records OrderMetrics for the
+// events passing through it
+type metricsPublisher struct {
+	EventPublisher
+	metrics *OrderMetrics
+}
+
+// NewMetricsPublisher returns next, recording m for each order created and
+// each status change published through it. Events are published once the
+// change is committed, so they are counted whether or not publishing them
+// succeeds. Replays must not go through it.
+func NewMetricsPublisher(next EventPublisher, m *OrderMetrics) EventPublisher {
+	return &metricsPublisher{EventPublisher: next, metrics: m}
+}
+
+func (p *metricsPublisher) PublishOrderCreated(ctx context.Context, order *domain.Order) error {
+	p.metrics.created.WithLabelValues(string(order.Status)).Inc()
+	p.metrics.value.Observe(order.Total)
+	return p.EventPublisher.PublishOrderCreated(ctx, order)
+}
+
+func (p *metricsPublisher) PublishOrderStatusChanged(ctx context.Context, order *domain.Order, oldStatus, newStatus domain.OrderStatus) error {
+	p.metrics.transitions.WithLabelValues(string(oldStatus), string(newStatus)).Inc()
+	if newStatus == domain.OrderStatusCancelled {
+		p.metrics.cancellations.WithLabelValues(cancelReason(ctx)).Inc()
+	}
+	return p.EventPublisher.PublishOrderStatusChanged(ctx, order, oldStatus, newStatus)
+}
+
+type cancelReasonKey struct{}
+
+// withCancelReason returns a context whose cancellations are counted with
+// reason rather than one derived from the caller
+func withCancelReason(ctx context.Context, reason string) context.Context {
+	return context.WithValue(ctx, cancelReasonKey{}, reason)
+}
+
+// cancelReason names who cancelled an order in ctx
+func cancelReason(ctx context.Context) string {
+	if reason, ok := ctx.Value(cancelReasonKey{}).(string); ok {
+		return reason
+	}
+	if p, ok := domain.PrincipalFromContext(ctx); ok && p.Role == domain.RoleCustomer {
+		return CancelReasonCustomer
+	}
+	if domain.ActorTypeFromContext(ctx) == domain.ActorTypeSystem {
+		return CancelReasonSystem
+	}
+	return CancelReasonOperator
+}
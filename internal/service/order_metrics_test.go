@@ -0,0 +1,100 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsPublisher_PublishOrderCreated_CountsOrderAndValue(t *testing.T) {
+	publishErr := errors.New("broker down")
+	reg := prometheus.NewRegistry()
+	metrics := NewOrderMetrics(reg)
+	publisher := NewMetricsPublisher(&mocks.EventPublisherMock{
+		PublishOrderCreatedFunc: func(_ context.Context, _ *domain.Order) error {
+			return publishErr
+		},
+	}, metrics)
+
+	for _, total := range []float64{20.10, 99.90} {
+		err := publisher.PublishOrderCreated(context.Background(), &domain.Order{ID: uuid.New(), Status: domain.OrderStatusPending, Total: total})
+		assert.ErrorIs(t, err, publishErr)
+	}
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.created.WithLabelValues("pending")), "counted although publishing failed")
+	assert.InDelta(t, 120.0, histogramSum(t, reg, "order_total"), 1e-9)
+}
+
+func TestMetricsPublisher_PublishOrderStatusChanged_CountsTransitionsAndCancellations(t *testing.T) {
+	metrics := NewOrderMetrics(prometheus.NewRegistry())
+	var published int
+	publisher := NewMetricsPublisher(&mocks.EventPublisherMock{
+		PublishOrderStatusChangedFunc: func(_ context.Context, _ *domain.Order, _, _ domain.OrderStatus) error {
+			published++
+			return nil
+		},
+	}, metrics)
+	order := &domain.Order{ID: uuid.New()}
+	customer := domain.WithPrincipal(context.Background(), &domain.Principal{Role: domain.RoleCustomer, CustomerID: "cust-1"})
+	service := domain.WithPrincipal(context.Background(), &domain.Principal{Role: domain.RoleService})
+
+	changes := []struct {
+		ctx      context.Context
+		from, to domain.OrderStatus
+	}{
+		{service, domain.OrderStatusPending, domain.OrderStatusConfirmed},
+		{service, domain.OrderStatusConfirmed, domain.OrderStatusProcessing},
+		{customer, domain.OrderStatusPending, domain.OrderStatusCancelled},
+		{service, domain.OrderStatusConfirmed, domain.OrderStatusCancelled},
+		{context.Background(), domain.OrderStatusPending, domain.OrderStatusCancelled},
+		{domain.WithSystemActor(context.Background()), domain.OrderStatusOnHold, domain.OrderStatusCancelled},
+		{withCancelReason(domain.WithSystemActor(context.Background()), CancelReasonExpired), domain.OrderStatusPending, domain.OrderStatusCancelled},
+	}
+	for _, c := range changes {
+		assert.NoError(t, publisher.PublishOrderStatusChanged(c.ctx, order, c.from, c.to))
+	}
+
+	assert.Equal(t, len(changes), published)
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.transitions.WithLabelValues("pending", "confirmed")))
+	assert.Equal(t, 3.0, testutil.ToFloat64(metrics.transitions.WithLabelValues("pending", "cancelled")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.cancellations.WithLabelValues(CancelReasonCustomer)))
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.cancellations.WithLabelValues(CancelReasonOperator)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.cancellations.WithLabelValues(CancelReasonSystem)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.cancellations.WithLabelValues(CancelReasonExpired)))
+}
+
+// histogramSum returns the sum of the observations of the histogram name in reg
+func histogramSum(t *testing.T, reg *prometheus.Registry, name string) float64 {
+	t.Helper()
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() == name {
+			return family.GetMetric()[0].GetHistogram().GetSampleSum()
+		}
+	}
+	t.Fatalf("metric %s not registered", name)
+	return 0
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderService_CreateOrder_MoneyIsExact(t *testing.T) {
+	tests := []struct {
+		name          string
+		items         []domain.OrderItem
+		wantPrices    []float64
+		wantSubtotals []float64
+		wantTotal     float64
+	}{
+		{
+			name:          "tenths add up",
+			items:         []domain.OrderItem{{Quantity: 3, Price: 0.10}, {Quantity: 1, Price: 0.20}},
+			wantPrices:    []float64{0.10, 0.20},
+			wantSubtotals: []float64{0.30, 0.20},
+			wantTotal:     0.50,
+		},
+		{
+			name:          "half a cent rounds away from zero",
+			items:         []domain.OrderItem{{Quantity: 2, Price: 1.005}, {Quantity: 1, Price: 2.675}},
+			wantPrices:    []float64{1.01, 2.68},
+			wantSubtotals: []float64{2.02, 2.68},
+			wantTotal:     4.70,
+		},
+		{
+			name:          "less than half a cent rounds down",
+			items:         []domain.OrderItem{{Quantity: 7, Price: 19.994}},
+			wantPrices:    []float64{19.99},
+			wantSubtotals: []float64{139.93},
+			wantTotal:     139.93,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := range tt.items {
+				tt.items[i].ProductID = "product-1"
+				tt.items[i].Name = "Widget"
+			}
+			svc := NewOrderService(&mocks.OrderRepositoryMock{}, nil, nil, nil, nil, nil)
+
+			order, err := svc.CreateOrder(context.Background(), CreateOrderDTO{CustomerID: "cust-1", Items: tt.items})
+
+			require.NoError(t, err)
+			for i, item := range order.Items {
+				assert.Equal(t, tt.wantPrices[i], item.Price)
+				assert.Equal(t, tt.wantSubtotals[i], item.Subtotal)
+			}
+			assert.Equal(t, tt.wantTotal, order.Total)
+		})
+	}
+}
+
+func TestOrderService_CreateOrder_PriceBelowHalfACent_ReturnsErrInvalidPrice(t *testing.T) {
+	svc := NewOrderService(&mocks.OrderRepositoryMock{}, nil, nil, nil, nil, nil)
+
+	_, err := svc.CreateOrder(context.Background(), CreateOrderDTO{
+		CustomerID: "cust-1",
+		Items:      []domain.OrderItem{{ProductID: "product-1", Name: "Widget", Quantity: 1, Price: 0.004}},
+	})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidPrice)
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// OrderNoteService manages customer-visible and internal notes on orders.
+// Customer tokens only see, and may only add, customer-visible notes.
+type OrderNoteService interface {
+	// AddNote attaches a note by the context's actor to a live order. An
+	// empty visibility defaults to customer for customer tokens and to
+	// internal otherwise.
+	AddNote(ctx context.Context, orderID, body string, visibility domain.NoteVisibility) (*domain.OrderNote, error)
+
+	// ListNotes returns the notes of a live order the caller may read, oldest first
+	ListNotes(ctx context.Context, orderID string, limit, offset int) (*OrderNoteList, error)
+
+	// NotesForOrders returns the notes the caller may read on orders it has
+	// already been authorized for, keyed by order ID
+	NotesForOrders(ctx context.Context, orders []*domain.Order) (map[uuid.UUID][]*domain.OrderNote, error)
+}
+
+// OrderNoteList is a page of order notes
+type OrderNoteList struct {
+	Data  []*domain.OrderNote
+	Total int64
+}
+
+// orderNoteServiceImpl implements OrderNoteService
+type orderNoteServiceImpl struct {
+	notes  repository.OrderNoteRepository
+	orders repository.OrderRepository
+}
+
+// NewOrderNoteService creates a new OrderNoteService
+func NewOrderNoteService(notes repository.OrderNoteRepository, orders repository.OrderRepository) OrderNoteService {
+	return &orderNoteServiceImpl{
+		notes:  notes,
+		orders: orders,
+	}
+}
+
+func (s *orderNoteServiceImpl) AddNote(ctx context.Context, orderID, body string, visibility domain.NoteVisibility) (*domain.OrderNote, error) {
+	order, err := s.findOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if visibility == "" {
+		visibility = domain.NoteVisibilityInternal
+		if !canReadInternalNotes(ctx) {
+			visibility = domain.NoteVisibilityCustomer
+		}
+	}
+	if visibility == domain.NoteVisibilityInternal && !canReadInternalNotes(ctx) {
+		return nil, domain.ErrAccessDenied
+	}
+
+	note, err := domain.NewOrderNote(order.ID, domain.ActorFromContext(ctx), body, visibility, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.notes.Create(ctx, note); err != nil {
+		return nil, err
+	}
+	return note, nil
+}
+
+func (s *orderNoteServiceImpl) ListNotes(ctx context.Context, orderID string, limit, offset int) (*OrderNoteList, error) {
+	if limit < 1 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	if _, err := s.findOrder(ctx, orderID); err != nil {
+		return nil, err
+	}
+
+	notes, total, err := s.notes.ListByOrderID(ctx, orderID, canReadInternalNotes(ctx), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return &OrderNoteList{Data: notes, Total: total}, nil
+}
+
+func (s *orderNoteServiceImpl) NotesForOrders(ctx context.Context, orders []*domain.Order) (map[uuid.UUID][]*domain.OrderNote, error) {
+	ids := make([]uuid.UUID, len(orders))
+	for i, order := range orders {
+		ids[i] = order.ID
+	}
+	return s.notes.ListByOrderIDs(ctx, ids, canReadInternalNotes(ctx))
+}
+
+// findOrder returns the live order with orderID if the caller may access it
+func (s *orderNoteServiceImpl) findOrder(ctx context.Context, orderID string) (*domain.Order, error) {
+	if _, err := uuid.Parse(orderID); err != nil {
+		return nil, domain.ErrOrderNotFound
+	}
+	order, err := s.orders.FindByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return nil, domain.ErrOrderNotFound
+	}
+	if err := domain.AuthorizeCustomer(ctx, order.CustomerID); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// canReadInternalNotes reports whether the caller is not limited to one
+// customer; without a principal (authentication disabled) it is not
+func canReadInternalNotes(ctx context.Context) bool {
+	return domain.AuthorizeAllCustomers(ctx) == nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderNoteService_AddNote_Visibility(t *testing.T) {
+	order := createMockOrder(domain.OrderStatusPending)
+	customer := &domain.Principal{Subject: "cust", Role: domain.RoleCustomer, CustomerID: order.CustomerID}
+	operator := &domain.Principal{Subject: "ops", Role: domain.RoleService}
+
+	tests := []struct {
+		name       string
+		principal  *domain.Principal
+		visibility domain.NoteVisibility
+		want       domain.NoteVisibility
+		wantErr    error
+	}{
+		{name: "customer default", principal: customer, want: domain.NoteVisibilityCustomer},
+		{name: "customer internal", principal: customer, visibility: domain.NoteVisibilityInternal, wantErr: domain.ErrAccessDenied},
+		{name: "service default", principal: operator, want: domain.NoteVisibilityInternal},
+		{name: "service customer", principal: operator, visibility: domain.NoteVisibilityCustomer, want: domain.NoteVisibilityCustomer},
+		{name: "no principal", want: domain.NoteVisibilityInternal},
+		{name: "unknown visibility", visibility: "public", wantErr: domain.ErrInvalidNoteVisibility},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved *domain.OrderNote
+			notes := &mocks.OrderNoteRepositoryMock{
+				CreateFunc: func(_ context.Context, note *domain.OrderNote) error {
+					saved = note
+					return nil
+				},
+			}
+			orders := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+			}
+			ctx := domain.WithActor(context.Background(), "agent@example.com")
+			if tt.principal != nil {
+				ctx = domain.WithPrincipal(ctx, tt.principal)
+			}
+
+			svc := NewOrderNoteService(notes, orders)
+			note, err := svc.AddNote(ctx, order.ID.String(), "Left with neighbour", tt.visibility)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, saved)
+				return
+			}
+			require.NoError(t, err)
+			assert.Same(t, note, saved)
+			assert.Equal(t, tt.want, note.Visibility)
+			assert.Equal(t, order.ID, note.OrderID)
+			assert.Equal(t, "agent@example.com", note.Author)
+		})
+	}
+}
+
+func TestOrderNoteService_AddNote_Errors(t *testing.T) {
+	order := createMockOrder(domain.OrderStatusPending)
+	tests := []struct {
+		name    string
+		orderID string
+		body    string
+		order   *domain.Order
+		ctx     context.Context
+		wantErr error
+	}{
+		{name: "empty body", orderID: order.ID.String(), body: "", order: order, wantErr: domain.ErrInvalidNote},
+		{name: "body too long", orderID: order.ID.String(), body: strings.Repeat("x", domain.MaxNoteLength+1), order: order, wantErr: domain.ErrInvalidNote},
+		{name: "malformed order ID", orderID: "not-a-uuid", body: "hi", wantErr: domain.ErrOrderNotFound},
+		{name: "missing order", orderID: uuid.NewString(), body: "hi", wantErr: domain.ErrOrderNotFound},
+		{
+			name: "another customer's order", orderID: order.ID.String(), body: "hi", order: order,
+			ctx:     domain.WithPrincipal(context.Background(), &domain.Principal{Role: domain.RoleCustomer, CustomerID: "other"}),
+			wantErr: domain.ErrAccessDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notes := &mocks.OrderNoteRepositoryMock{
+				CreateFunc: func(_ context.Context, _ *domain.OrderNote) error {
+					t.Fatal("a rejected note must not be saved")
+					return nil
+				},
+			}
+			orders := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return tt.order, nil },
+			}
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			_, err := NewOrderNoteService(notes, orders).AddNote(ctx, tt.orderID, tt.body, "")
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestOrderNoteService_ListNotes_HidesInternalFromCustomers(t *testing.T) {
+	order := createMockOrder(domain.OrderStatusPending)
+	tests := []struct {
+		name         string
+		principal    *domain.Principal
+		wantInternal bool
+	}{
+		{name: "customer", principal: &domain.Principal{Role: domain.RoleCustomer, CustomerID: order.CustomerID}},
+		{name: "service", principal: &domain.Principal{Role: domain.RoleService}, wantInternal: true},
+		{name: "no principal", wantInternal: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotInternal bool
+			var gotLimit int
+			notes := &mocks.OrderNoteRepositoryMock{
+				ListByOrderIDFunc: func(_ context.Context, _ string, includeInternal bool, limit, _ int) ([]*domain.OrderNote, int64, error) {
+					gotInternal, gotLimit = includeInternal, limit
+					return []*domain.OrderNote{{ID: uuid.New()}}, 1, nil
+				},
+			}
+			orders := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+			}
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = domain.WithPrincipal(ctx, tt.principal)
+			}
+
+			result, err := NewOrderNoteService(notes, orders).ListNotes(ctx, order.ID.String(), 0, 0)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantInternal, gotInternal)
+			assert.Equal(t, 20, gotLimit)
+			assert.Len(t, result.Data, 1)
+			assert.Equal(t, int64(1), result.Total)
+		})
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func patchableOrder() *domain.Order {
+	return &domain.Order{
+		ID:         uuid.New(),
+		CustomerID: "cust-1",
+		Status:     domain.OrderStatusPending,
+		Version:    3,
+		Items: []domain.OrderItem{
+			{ID: uuid.New(), ProductID: "product-1", Name: "Mug", Quantity: 1, Price: 10.00, Subtotal: 10.00, Status: domain.ItemStatusPending},
+			{ID: uuid.New(), ProductID: "product-2", Name: "Pen", Quantity: 2, Price: 2.50, Subtotal: 5.00, Status: domain.ItemStatusPending},
+		},
+		Total:    15.00,
+		Metadata: map[string]string{"channel": "web", "gift": "yes"},
+		Tags:     []string{"vip"},
+	}
+}
+
+func patchRepo(order *domain.Order, saved **domain.Order) *mocks.OrderRepositoryMock {
+	return &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+			return order, nil
+		},
+		UpdateFunc: func(_ context.Context, o *domain.Order) error {
+			if saved != nil {
+				*saved = o
+			}
+			return nil
+		},
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestOrderService_PatchOrder_ItemsAddRemoveAndChange(t *testing.T) {
+	order := patchableOrder()
+	mug, pen := order.Items[0], order.Items[1]
+	var saved *domain.Order
+
+	svc := NewOrderService(patchRepo(order, &saved), nil, nil, nil, nil, nil)
+	got, err := svc.PatchOrder(context.Background(), order.ID.String(), PatchOrderDTO{
+		Items: map[string]*ItemPatch{
+			mug.ID.String(): {Quantity: ptr(3)},
+			pen.ID.String(): nil,
+			"new":           {ProductID: ptr("product-3"), Name: ptr("Pad"), Quantity: ptr(1), Price: ptr(4.00)},
+		},
+	}, nil)
+
+	require.NoError(t, err)
+	require.Same(t, saved, got)
+	require.Len(t, got.Items, 2)
+	assert.Equal(t, mug.ID, got.Items[0].ID, "a changed item keeps its ID")
+	assert.Equal(t, 3, got.Items[0].Quantity)
+	assert.Equal(t, 30.00, got.Items[0].Subtotal)
+	assert.Equal(t, "product-3", got.Items[1].ProductID)
+	assert.NotEqual(t, uuid.Nil, got.Items[1].ID)
+	assert.Equal(t, domain.ItemStatusPending, got.Items[1].Status)
+	assert.Equal(t, 34.00, got.Total)
+}
+
+func TestOrderService_PatchOrder_MergesMetadataAndReplacesTags(t *testing.T) {
+	order := patchableOrder()
+	tags := []string{"Priority"}
+
+	svc := NewOrderService(patchRepo(order, nil), nil, nil, nil, nil, nil)
+	got, err := svc.PatchOrder(context.Background(), order.ID.String(), PatchOrderDTO{
+		Metadata: map[string]*string{"gift": nil, "source": ptr("ads")},
+		Tags:     &tags,
+	}, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"channel": "web", "source": "ads"}, got.Metadata)
+	assert.Equal(t, []string{"priority"}, got.Tags)
+	assert.Len(t, got.Items, 2, "items are untouched")
+}
+
+func TestOrderService_PatchOrder_ClearMetadataKeepsTags(t *testing.T) {
+	order := patchableOrder()
+
+	svc := NewOrderService(patchRepo(order, nil), nil, nil, nil, nil, nil)
+	got, err := svc.PatchOrder(context.Background(), order.ID.String(), PatchOrderDTO{ClearMetadata: true}, nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, got.Metadata)
+	assert.Equal(t, []string{"vip"}, got.Tags)
+}
+
+func TestOrderService_PatchOrder_NewItemPricedFromCatalog(t *testing.T) {
+	order := patchableOrder()
+	config := StaticConfig{MaxPageSize: 100}
+
+	svc := NewOrderService(patchRepo(order, nil), nil, nil, nil, catalogPricing(map[string]float64{"product-3": 6.00}), config)
+	got, err := svc.PatchOrder(context.Background(), order.ID.String(), PatchOrderDTO{
+		Items: map[string]*ItemPatch{"new": {ProductID: ptr("product-3"), Name: ptr("Pad"), Quantity: ptr(2)}},
+	}, nil)
+
+	require.NoError(t, err)
+	require.Len(t, got.Items, 3)
+	assert.Equal(t, 6.00, got.Items[2].Price)
+	assert.Equal(t, 27.00, got.Total)
+}
+
+func TestOrderService_PatchOrder_Rejected(t *testing.T) {
+	itemID := func(o *domain.Order, i int) string { return o.Items[i].ID.String() }
+
+	tests := []struct {
+		name            string
+		prepare         func(o *domain.Order)
+		patch           func(o *domain.Order) PatchOrderDTO
+		expectedVersion *int
+		wantErr         error
+	}{
+		{
+			name: "unknown item ID",
+			patch: func(_ *domain.Order) PatchOrderDTO {
+				return PatchOrderDTO{Items: map[string]*ItemPatch{uuid.NewString(): {Quantity: ptr(1)}}}
+			},
+			wantErr: domain.ErrItemNotFound,
+		},
+		{
+			name: "removing without an item ID",
+			patch: func(_ *domain.Order) PatchOrderDTO {
+				return PatchOrderDTO{Items: map[string]*ItemPatch{"new": nil}}
+			},
+			wantErr: domain.ErrItemNotFound,
+		},
+		{
+			name: "changing the product of an item",
+			patch: func(o *domain.Order) PatchOrderDTO {
+				return PatchOrderDTO{Items: map[string]*ItemPatch{itemID(o, 0): {ProductID: ptr("product-9")}}}
+			},
+			wantErr: domain.ErrItemNotPatchable,
+		},
+		{
+			name: "removing every item",
+			patch: func(o *domain.Order) PatchOrderDTO {
+				return PatchOrderDTO{Items: map[string]*ItemPatch{itemID(o, 0): nil, itemID(o, 1): nil}}
+			},
+			wantErr: domain.ErrNoItems,
+		},
+		{
+			name: "new item without a name",
+			patch: func(_ *domain.Order) PatchOrderDTO {
+				return PatchOrderDTO{Items: map[string]*ItemPatch{"new": {ProductID: ptr("product-3"), Quantity: ptr(1), Price: ptr(1.00)}}}
+			},
+			wantErr: domain.ErrInvalidProductName,
+		},
+		{
+			name:    "fulfillment started",
+			prepare: func(o *domain.Order) { o.Items[0].Status = domain.ItemStatusShipped },
+			patch: func(o *domain.Order) PatchOrderDTO {
+				return PatchOrderDTO{Items: map[string]*ItemPatch{itemID(o, 1): nil}}
+			},
+			wantErr: domain.ErrItemsInFulfillment,
+		},
+		{
+			name: "stale version",
+			patch: func(_ *domain.Order) PatchOrderDTO {
+				return PatchOrderDTO{Metadata: map[string]*string{"gift": nil}}
+			},
+			expectedVersion: ptr(2),
+			wantErr:         domain.ErrVersionMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := patchableOrder()
+			if tt.prepare != nil {
+				tt.prepare(order)
+			}
+			mockRepo := patchRepo(order, nil)
+			mockRepo.UpdateFunc = func(_ context.Context, _ *domain.Order) error {
+				t.Fatal("a rejected patch must not be saved")
+				return nil
+			}
+
+			svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+			_, err := svc.PatchOrder(context.Background(), order.ID.String(), tt.patch(order), tt.expectedVersion)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestOrderService_AddItem_AppendsPricedItem(t *testing.T) {
+	order := patchableOrder()
+	config := StaticConfig{MaxPageSize: 100}
+
+	svc := NewOrderService(patchRepo(order, nil), nil, nil, nil, catalogPricing(map[string]float64{"product-3": 6.00}), config)
+	got, err := svc.AddItem(context.Background(), order.ID.String(), domain.OrderItem{ProductID: "product-3", Name: "Pad", Quantity: 2}, nil)
+
+	require.NoError(t, err)
+	require.Len(t, got.Items, 3)
+	assert.Equal(t, 6.00, got.Items[2].Price)
+	assert.Equal(t, 27.00, got.Total)
+}
+
+func TestOrderService_UpdateItem_ChangesQuantity(t *testing.T) {
+	order := patchableOrder()
+	pen := order.Items[1]
+
+	svc := NewOrderService(patchRepo(order, nil), nil, nil, nil, nil, nil)
+	got, err := svc.UpdateItem(context.Background(), order.ID.String(), pen.ID.String(), ItemPatch{Quantity: ptr(4)}, ptr(3))
+
+	require.NoError(t, err)
+	assert.Equal(t, pen.ID, got.Items[1].ID)
+	assert.Equal(t, 10.00, got.Items[1].Subtotal)
+	assert.Equal(t, 20.00, got.Total)
+}
+
+func TestOrderService_RemoveItem_RecalculatesTotal(t *testing.T) {
+	order := patchableOrder()
+
+	svc := NewOrderService(patchRepo(order, nil), nil, nil, nil, nil, nil)
+	got, err := svc.RemoveItem(context.Background(), order.ID.String(), order.Items[0].ID.String(), nil)
+
+	require.NoError(t, err)
+	require.Len(t, got.Items, 1)
+	assert.Equal(t, 5.00, got.Total)
+}
+
+func TestOrderService_ItemChanges_LockedPastConfirmed(t *testing.T) {
+	for _, status := range []domain.OrderStatus{domain.OrderStatusProcessing, domain.OrderStatusOnHold, domain.OrderStatusShipped, domain.OrderStatusCancelled} {
+		t.Run(string(status), func(t *testing.T) {
+			order := patchableOrder()
+			order.Status = status
+			mockRepo := patchRepo(order, nil)
+			mockRepo.UpdateFunc = func(_ context.Context, _ *domain.Order) error {
+				t.Fatal("a locked order must not be saved")
+				return nil
+			}
+			svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+			ctx, id, itemID := context.Background(), order.ID.String(), order.Items[0].ID.String()
+
+			_, err := svc.AddItem(ctx, id, domain.OrderItem{ProductID: "product-3", Name: "Pad", Quantity: 1, Price: 1.00}, nil)
+			assert.ErrorIs(t, err, domain.ErrItemsLocked)
+			_, err = svc.UpdateItem(ctx, id, itemID, ItemPatch{Quantity: ptr(2)}, nil)
+			assert.ErrorIs(t, err, domain.ErrItemsLocked)
+			_, err = svc.RemoveItem(ctx, id, itemID, nil)
+			assert.ErrorIs(t, err, domain.ErrItemsLocked)
+		})
+	}
+}
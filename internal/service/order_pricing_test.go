@@ -0,0 +1,146 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func catalogPricing(prices map[string]float64) *mocks.PricingServiceMock {
+	return &mocks.PricingServiceMock{
+		UnitPricesFunc: func(_ context.Context, _ []string) (map[string]float64, error) {
+			return prices, nil
+		},
+	}
+}
+
+func TestOrderService_CreateOrder_Pricing(t *testing.T) {
+	catalog := map[string]float64{"product-1": 12.50}
+
+	tests := []struct {
+		name      string
+		productID string
+		price     float64
+		require   bool
+		wantPrice float64
+		wantErr   error
+	}{
+		{name: "catalog price replaces client price", productID: "product-1", price: 99.00, wantPrice: 12.50},
+		{name: "catalog price without client price", productID: "product-1", wantPrice: 12.50},
+		{name: "unknown product keeps client price", productID: "product-2", price: 8.00, wantPrice: 8.00},
+		{name: "unknown product without price", productID: "product-2", wantErr: domain.ErrInvalidPrice},
+		{name: "required catalog price", productID: "product-1", price: 99.00, require: true, wantPrice: 12.50},
+		{name: "required unknown product", productID: "product-2", price: 8.00, require: true, wantErr: domain.ErrProductNotPriced},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mocks.OrderRepositoryMock{}
+			config := StaticConfig{MaxPageSize: 100, RequireServerPricing: tt.require}
+
+			svc := NewOrderService(mockRepo, nil, nil, nil, catalogPricing(catalog), config)
+			order, err := svc.CreateOrder(context.Background(), CreateOrderDTO{
+				CustomerID: "cust-1",
+				Items:      []domain.OrderItem{{ProductID: tt.productID, Name: "Widget", Quantity: 2, Price: tt.price}},
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPrice, order.Items[0].Price)
+			assert.Equal(t, 2*tt.wantPrice, order.Items[0].Subtotal)
+			assert.Equal(t, 2*tt.wantPrice, order.Total)
+		})
+	}
+}
+
+func TestOrderService_CreateOrder_PricingError_ReturnsError(t *testing.T) {
+	catalogErr := errors.New("catalog unavailable")
+	pricing := &mocks.PricingServiceMock{
+		UnitPricesFunc: func(_ context.Context, _ []string) (map[string]float64, error) {
+			return nil, catalogErr
+		},
+	}
+	created := false
+	mockRepo := &mocks.OrderRepositoryMock{
+		CreateFunc: func(_ context.Context, _ *domain.Order) error {
+			created = true
+			return nil
+		},
+	}
+
+	svc := NewOrderService(mockRepo, nil, nil, nil, pricing, nil)
+	_, err := svc.CreateOrder(context.Background(), CreateOrderDTO{
+		CustomerID: "cust-1",
+		Items:      []domain.OrderItem{{ProductID: "product-1", Name: "Widget", Quantity: 1, Price: 10.00}},
+	})
+
+	assert.ErrorIs(t, err, catalogErr)
+	assert.False(t, created)
+}
+
+func TestOrderService_BulkCreateOrders_PricesBatchInOneLookup(t *testing.T) {
+	var lookups [][]string
+	pricing := &mocks.PricingServiceMock{
+		UnitPricesFunc: func(_ context.Context, productIDs []string) (map[string]float64, error) {
+			lookups = append(lookups, productIDs)
+			return map[string]float64{"product-1": 5.00}, nil
+		},
+	}
+	mockRepo := &mocks.OrderRepositoryMock{
+		CreateBatchFunc: func(_ context.Context, orders []*domain.Order) ([]error, error) {
+			return make([]error, len(orders)), nil
+		},
+	}
+	config := StaticConfig{MaxPageSize: 100, RequireServerPricing: true}
+
+	svc := NewOrderService(mockRepo, nil, nil, nil, pricing, config)
+	results := svc.BulkCreateOrders(context.Background(), []CreateOrderDTO{
+		{CustomerID: "cust-1", Items: []domain.OrderItem{{ProductID: "product-1", Name: "Widget", Quantity: 1}}},
+		{CustomerID: "cust-2", Items: []domain.OrderItem{{ProductID: "product-1", Name: "Widget", Quantity: 3}, {ProductID: "product-2", Name: "Gadget", Quantity: 1}}},
+	})
+
+	require.Len(t, results, 2)
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, 5.00, results[0].Order.Total)
+	assert.ErrorIs(t, results[1].Err, domain.ErrProductNotPriced)
+	assert.Equal(t, [][]string{{"product-1", "product-2"}}, lookups)
+}
+
+func TestOrderService_UpdateOrder_PricesReplacedItems(t *testing.T) {
+	order := createMockOrder(domain.OrderStatusPending)
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+		UpdateFunc:   func(_ context.Context, _ *domain.Order) error { return nil },
+	}
+
+	svc := NewOrderService(mockRepo, nil, nil, nil, catalogPricing(map[string]float64{"product-9": 4.00}), nil)
+	updated, err := svc.UpdateOrder(context.Background(), order.ID.String(), UpdateOrderDTO{
+		Items: []domain.OrderItem{{ProductID: "product-9", Name: "Refill", Quantity: 2}},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 4.00, updated.Items[0].Price)
+	assert.Equal(t, 8.00, updated.Total)
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// OrderSearchService runs free-text order searches
+type OrderSearchService interface {
+	// SearchOrders returns orders matching a free-text query against order ID
+	// prefix, customer ID and item names, most relevant first
+	SearchOrders(ctx context.Context, req SearchOrdersRequest) (*domain.PaginatedOrders, error)
+}
+
+// orderSearchServiceImpl implements OrderSearchService
+type orderSearchServiceImpl struct {
+	searcher repository.OrderSearcher
+	config   ConfigProvider
+}
+
+// NewOrderSearchService creates a new OrderSearchService backed by searcher:
+// the order repository, or an external search index. A nil config uses DefaultSettings.
+func NewOrderSearchService(searcher repository.OrderSearcher, config ConfigProvider) OrderSearchService {
+	if config == nil {
+		config = StaticConfig(DefaultSettings)
+	}
+	return &orderSearchServiceImpl{
+		searcher: searcher,
+		config:   config,
+	}
+}
+
+func (s *orderSearchServiceImpl) SearchOrders(ctx context.Context, req SearchOrdersRequest) (*domain.PaginatedOrders, error) {
+	// Search spans every customer's orders
+	if err := domain.AuthorizeAllCustomers(ctx); err != nil {
+		return nil, err
+	}
+	query := strings.TrimSpace(req.Query)
+	if query == "" || utf8.RuneCountInString(query) > MaxSearchQueryLength {
+		return nil, domain.ErrInvalidSearchQuery
+	}
+	if req.MinTotal != nil && req.MaxTotal != nil && *req.MinTotal > *req.MaxTotal {
+		return nil, domain.ErrInvalidTotalRange
+	}
+	if req.Status != nil && !req.Status.IsValid() {
+		return nil, domain.ErrInvalidStatus
+	}
+
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+
+	pageSize := req.PageSize
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	if maxPageSize := s.config.Settings().MaxPageSize; pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	offset := (page - 1) * pageSize
+	if req.Offset > 0 {
+		offset = req.Offset
+		page = offset/pageSize + 1
+	}
+
+	opts := repository.SearchOptions{
+		Limit:      pageSize,
+		Offset:     offset,
+		Status:     req.Status,
+		CustomerID: req.CustomerID,
+		ProductID:  req.ProductID,
+		MinTotal:   req.MinTotal,
+		MaxTotal:   req.MaxTotal,
+	}
+
+	orders, totalCount, err := s.searcher.Search(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PaginatedOrders{
+		Data:       orders,
+		Page:       page,
+		PageSize:   pageSize,
+		Offset:     offset,
+		TotalCount: totalCount,
+		TotalPages: int(math.Ceil(float64(totalCount) / float64(pageSize))),
+	}, nil
+}
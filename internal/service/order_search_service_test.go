@@ -0,0 +1,84 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderSearchService_SearchOrders_TrimsQueryAndPaginates(t *testing.T) {
+	var gotQuery string
+	var gotOpts repository.SearchOptions
+	mockRepo := &mocks.OrderRepositoryMock{
+		SearchFunc: func(_ context.Context, query string, opts repository.SearchOptions) ([]*domain.Order, int64, error) {
+			gotQuery = query
+			gotOpts = opts
+			return createMockOrders(2), 12, nil
+		},
+	}
+	minTotal := 10.0
+
+	svc := NewOrderSearchService(mockRepo, nil)
+	result, err := svc.SearchOrders(context.Background(), SearchOrdersRequest{Query: "  widget ", Page: 2, PageSize: 10, MinTotal: &minTotal})
+
+	require.NoError(t, err)
+	assert.Equal(t, "widget", gotQuery)
+	assert.Equal(t, 10, gotOpts.Limit)
+	assert.Equal(t, 10, gotOpts.Offset)
+	assert.Equal(t, &minTotal, gotOpts.MinTotal)
+	assert.Equal(t, int64(12), result.TotalCount)
+	assert.Equal(t, 2, result.TotalPages)
+}
+
+func TestOrderSearchService_SearchOrders_InvalidRequest_ReturnsError(t *testing.T) {
+	low, high := 5.0, 50.0
+	bogus := domain.OrderStatus("bogus")
+
+	tests := []struct {
+		name    string
+		req     SearchOrdersRequest
+		wantErr error
+	}{
+		{name: "empty", req: SearchOrdersRequest{Query: ""}, wantErr: domain.ErrInvalidSearchQuery},
+		{name: "whitespace only", req: SearchOrdersRequest{Query: "   "}, wantErr: domain.ErrInvalidSearchQuery},
+		{name: "too long", req: SearchOrdersRequest{Query: strings.Repeat("a", MaxSearchQueryLength+1)}, wantErr: domain.ErrInvalidSearchQuery},
+		{name: "min above max", req: SearchOrdersRequest{Query: "widget", MinTotal: &high, MaxTotal: &low}, wantErr: domain.ErrInvalidTotalRange},
+		{name: "unknown status", req: SearchOrdersRequest{Query: "widget", Status: &bogus}, wantErr: domain.ErrInvalidStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mocks.OrderRepositoryMock{
+				SearchFunc: func(_ context.Context, _ string, _ repository.SearchOptions) ([]*domain.Order, int64, error) {
+					t.Fatal("Search should not be called for an invalid request")
+					return nil, 0, nil
+				},
+			}
+
+			svc := NewOrderSearchService(mockRepo, nil)
+			_, err := svc.SearchOrders(context.Background(), tt.req)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -28,15 +28,93 @@ type OrderService interface {
 	// GetOrderByID retrieves an order by ID, checking cache first
 	GetOrderByID(ctx context.Context, id string) (*domain.Order, error)
 
+	// GetOrderByIDIncludingDeleted is GetOrderByID that also returns a
+	// soft-deleted order, read from the database. Callers limited to one
+	// customer get domain.ErrAccessDenied.
+	GetOrderByIDIncludingDeleted(ctx context.Context, id string) (*domain.Order, error)
+
 	// UpdateOrder updates an existing order
 	UpdateOrder(ctx context.Context, id string, dto UpdateOrderDTO) (*domain.Order, error)
 
+	// PatchOrder applies a merge patch to an order's items and labels and
+	// publishes order.updated. Items cannot change once fulfillment has
+	// started (domain.ErrItemsInFulfillment), an unknown item ID returns
+	// domain.ErrItemNotFound and removing every item domain.ErrNoItems.
+	// expectedVersion is checked as in UpdateOrderStatus.
+	PatchOrder(ctx context.Context, id string, patch PatchOrderDTO, expectedVersion *int) (*domain.Order, error)
+
+	// AddItem adds one item to a pending or confirmed order, pricing it like
+	// CreateOrder, and publishes order.updated. Other statuses return
+	// domain.ErrItemsLocked. expectedVersion is checked as in UpdateOrderStatus.
+	AddItem(ctx context.Context, id string, item domain.OrderItem, expectedVersion *int) (*domain.Order, error)
+
+	// UpdateItem changes the name or quantity of one item of a pending or
+	// confirmed order, like an item of PatchOrder. Otherwise as AddItem.
+	UpdateItem(ctx context.Context, id, itemID string, patch ItemPatch, expectedVersion *int) (*domain.Order, error)
+
+	// RemoveItem removes one item of a pending or confirmed order; removing
+	// the last one returns domain.ErrNoItems. Otherwise as AddItem.
+	RemoveItem(ctx context.Context, id, itemID string, expectedVersion *int) (*domain.Order, error)
+
 	// DeleteOrder soft-deletes an order
 	DeleteOrder(ctx context.Context, id string) error
 
+	// RestoreOrder undeletes a soft-deleted order and publishes order.restored.
+	// If expectedVersion is set and differs from the deleted order's version,
+	// domain.ErrVersionMismatch is returned without restoring it.
+	RestoreOrder(ctx context.Context, id string, expectedVersion *int) (*domain.Order, error)
+
 	// ListOrders returns paginated orders with optional status filter
 	ListOrders(ctx context.Context, req ListOrdersRequest) (*domain.PaginatedOrders, error)
 
-	// UpdateOrderStatus transitions order to new status with validation
-	UpdateOrderStatus(ctx context.Context, id string, newStatus domain.OrderStatus) (*domain.Order, error)
+	// UpdateOrderStatus transitions order to new status with validation.
+	// If expectedVersion is set and differs from the stored version,
+	// domain.ErrVersionMismatch is returned without modifying the order.
+	// With Settings.RequirePayment, customer tokens may not confirm a
+	// pending order and get domain.ErrAccessDenied.
+	UpdateOrderStatus(ctx context.Context, id string, newStatus domain.OrderStatus, expectedVersion *int) (*domain.Order, error)
+
+	// HoldOrder puts a pending, confirmed or processing order on hold with a
+	// reason. The hold is released automatically after the configured
+	// HoldReleaseAfter, if any, unless the reason is a payment hold's (see
+	// domain.IsPaymentHoldReason). expectedVersion is checked as in
+	// UpdateOrderStatus.
+	HoldOrder(ctx context.Context, id string, reason string, expectedVersion *int) (*domain.Order, error)
+
+	// ReleaseOrder returns a held order to the status it was held from.
+	// Returns domain.ErrOrderNotHeld if the order is not on hold, and
+	// domain.ErrAccessDenied to customer tokens for a payment mismatch hold.
+	ReleaseOrder(ctx context.Context, id string, expectedVersion *int) (*domain.Order, error)
+
+	// UpdateItemStatus moves the given items of an order to status, e.g. to
+	// ship them as one consignment, and advances the order status to match:
+	// processing once an item is picked or shipped, shipped once every item
+	// has shipped, delivered once every item is delivered or returned.
+	// Publishes order.updated, and order.status_changed if the order status
+	// changed. expectedVersion is checked as in UpdateOrderStatus.
+	UpdateItemStatus(ctx context.Context, id string, itemIDs []string, status domain.ItemStatus, expectedVersion *int) (*domain.Order, error)
+
+	// UpdateAddresses replaces the shipping and/or billing address of a
+	// pending or confirmed order; a nil address is left unchanged. Returns
+	// domain.ErrAddressLocked once the order has moved on. Publishes
+	// order.updated. expectedVersion is checked as in UpdateOrderStatus.
+	UpdateAddresses(ctx context.Context, id string, shipping, billing *domain.Address, expectedVersion *int) (*domain.Order, error)
+
+	// MarkSLABreached flags an order whose estimated delivery time has passed
+	// and publishes order.sla_breached. Returns domain.ErrSLANotBreached if
+	// the order is not overdue or was flagged already.
+	MarkSLABreached(ctx context.Context, id string) (*domain.Order, error)
+
+	// RepairTotals recomputes an order's item subtotals and total from its
+	// prices and quantities and saves them, publishing order.updated.
+	// Returns domain.ErrTotalsConsistent if every amount was already right.
+	RepairTotals(ctx context.Context, id string) (*domain.Order, error)
+
+	// BulkUpdateOrderStatus transitions each order independently, returning one
+	// result per distinct ID in request order. A failure does not stop the batch.
+	BulkUpdateOrderStatus(ctx context.Context, ids []string, newStatus domain.OrderStatus) []BulkStatusResult
+
+	// BulkCreateOrders creates each order independently, returning one result
+	// per order in request order. Invalid orders do not stop the batch.
+	BulkCreateOrders(ctx context.Context, dtos []CreateOrderDTO) []BulkCreateResult
 }
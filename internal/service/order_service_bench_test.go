@@ -0,0 +1,117 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// The benchmarks measure the service's own work per call, with the
+// repository mocked; test/load benchmarks the PostgreSQL repository.
+
+func benchOrders(n, items int) []*domain.Order {
+	orders := make([]*domain.Order, n)
+	for i := range orders {
+		order := &domain.Order{
+			ID:         uuid.New(),
+			CustomerID: uuid.NewString(),
+			Status:     domain.OrderStatusPending,
+			Version:    1,
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+		}
+		for j := range items {
+			order.Items = append(order.Items, domain.OrderItem{
+				ID:        uuid.New(),
+				ProductID: fmt.Sprintf("product-%d", j),
+				Name:      "Widget",
+				Quantity:  j + 1,
+				Price:     9.99,
+				Subtotal:  9.99 * float64(j+1),
+				Status:    domain.ItemStatusPending,
+			})
+		}
+		order.Total = order.CalculateTotal()
+		orders[i] = order
+	}
+	return orders
+}
+
+func BenchmarkOrderService_CreateOrder(b *testing.B) {
+	for _, items := range []int{1, 10, 100} {
+		b.Run(fmt.Sprintf("items=%d", items), func(b *testing.B) {
+			dto := CreateOrderDTO{CustomerID: uuid.NewString(), Tags: []string{"web"}}
+			for i := range items {
+				dto.Items = append(dto.Items, domain.OrderItem{ProductID: fmt.Sprintf("product-%d", i), Name: "Widget", Quantity: 2, Price: 9.99})
+			}
+			svc := NewOrderService(&mocks.OrderRepositoryMock{}, nil, nil, nil, nil, nil)
+			ctx := context.Background()
+
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := svc.CreateOrder(ctx, dto); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkOrderService_ListOrders(b *testing.B) {
+	page := benchOrders(100, 3)
+	status := domain.OrderStatusPending
+	mockRepo := &mocks.OrderRepositoryMock{
+		ListFunc: func(_ context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+			return page[:opts.Limit], 10000, nil
+		},
+	}
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := svc.ListOrders(ctx, ListOrdersRequest{Page: 3, PageSize: 100, Status: &status, ExactTotal: true}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkOrderService_UpdateOrderStatus(b *testing.B) {
+	order := benchOrders(1, 3)[0]
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+			o := *order
+			return &o, nil
+		},
+	}
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+	id := order.ID.String()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := svc.UpdateOrderStatus(ctx, id, domain.OrderStatusConfirmed, nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -18,33 +18,161 @@ import (
 	"context"
 	"log/slog"
 	"math"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/cache"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+	"golang.org/x/sync/singleflight"
 )
 
-const orderCacheTTL = 5 * time.Minute
-
 // orderServiceImpl implements OrderService
 type orderServiceImpl struct {
 	repo      repository.OrderRepository
+	uow       repository.UnitOfWork
 	cache     cache.OrderCache
 	publisher EventPublisher
+	pricing   PricingService
+	config    ConfigProvider
+	// loads collapses concurrent cache misses for one order into one query
+	loads singleflight.Group
 }
 
-// NewOrderService creates a new OrderService
-func NewOrderService(repo repository.OrderRepository, orderCache cache.OrderCache, publisher EventPublisher) OrderService {
+// NewOrderService creates a new OrderService. A nil uow runs each repository
+// call on its own, a nil pricing keeps client-supplied prices and a nil
+// config uses DefaultSettings.
+func NewOrderService(repo repository.OrderRepository, uow repository.UnitOfWork, orderCache cache.OrderCache, publisher EventPublisher, pricing PricingService, config ConfigProvider) OrderService {
+	if uow == nil {
+		uow = repository.NoTx{}
+	}
+	if pricing == nil {
+		pricing = PassthroughPricing{}
+	}
+	if config == nil {
+		config = StaticConfig(DefaultSettings)
+	}
 	return &orderServiceImpl{
 		repo:      repo,
+		uow:       uow,
 		cache:     orderCache,
 		publisher: publisher,
+		pricing:   pricing,
+		config:    config,
 	}
 }
 
 func (s *orderServiceImpl) CreateOrder(ctx context.Context, dto CreateOrderDTO) (*domain.Order, error) {
+	settings := s.config.Settings()
+	prices, err := lookupPrices(ctx, s.pricing, dto.Items)
+	if err != nil {
+		return nil, err
+	}
+	if dto.Items, err = applyPrices(dto.Items, prices, settings.RequireServerPricing); err != nil {
+		return nil, err
+	}
+
+	order, err := newOrder(dto, settings)
+	if err != nil {
+		return nil, err
+	}
+	order.TenantID = domain.TenantID(ctx)
+	if err := domain.AuthorizeCustomer(ctx, order.CustomerID); err != nil {
+		return nil, err
+	}
+
+	// Save to repository
+	if err := s.repo.Create(ctx, order); err != nil {
+		return nil, err
+	}
+
+	s.publishCreated(ctx, order)
+
+	// Cached list pages of the customer no longer include every order
+	invalidateCustomerLists(ctx, s.cache, order.TenantID, order.CustomerID)
+
+	return order, nil
+}
+
+// BulkCreateOrders validates each order and inserts the valid ones in one
+// repository batch. Every inserted order is published as order.created.
+func (s *orderServiceImpl) BulkCreateOrders(ctx context.Context, dtos []CreateOrderDTO) []BulkCreateResult {
+	results := make([]BulkCreateResult, len(dtos))
+
+	settings := s.config.Settings()
+	itemLists := make([][]domain.OrderItem, len(dtos))
+	for i, dto := range dtos {
+		itemLists[i] = dto.Items
+	}
+	// One lookup for the whole batch
+	prices, err := lookupPrices(ctx, s.pricing, itemLists...)
+	if err != nil {
+		for i := range results {
+			results[i].Err = err
+		}
+		return results
+	}
+
+	orders := make([]*domain.Order, 0, len(dtos))
+	positions := make([]int, 0, len(dtos))
+	for i, dto := range dtos {
+		var order *domain.Order
+		dto.Items, err = applyPrices(dto.Items, prices, settings.RequireServerPricing)
+		if err == nil {
+			order, err = newOrder(dto, settings)
+		}
+		if err == nil {
+			order.TenantID = domain.TenantID(ctx)
+			err = domain.AuthorizeCustomer(ctx, order.CustomerID)
+		}
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		orders = append(orders, order)
+		positions = append(positions, i)
+	}
+	if len(orders) == 0 {
+		return results
+	}
+
+	rowErrs, err := s.repo.CreateBatch(ctx, orders)
+	customers := make(map[string]struct{}, len(orders))
+	for j, order := range orders {
+		i := positions[j]
+		switch {
+		case err != nil:
+			results[i].Err = err
+		case rowErrs[j] != nil:
+			results[i].Err = rowErrs[j]
+		default:
+			results[i].Order = order
+			s.publishCreated(ctx, order)
+			customers[order.CustomerID] = struct{}{}
+		}
+	}
+
+	for customerID := range customers {
+		invalidateCustomerLists(ctx, s.cache, domain.TenantID(ctx), customerID)
+	}
+
+	return results
+}
+
+// publishCreated publishes order.created (warn + continue on failure)
+func (s *orderServiceImpl) publishCreated(ctx context.Context, order *domain.Order) {
+	if s.publisher == nil {
+		return
+	}
+	if err := s.publisher.PublishOrderCreated(ctx, order); err != nil {
+		slog.WarnContext(ctx, "failed to publish order.created event", slog.String("order_id", order.ID.String()), slog.String("error", err.Error()))
+	}
+}
+
+// newOrder builds and validates a pending order from dto, checking its
+// shipping method against settings
+func newOrder(dto CreateOrderDTO, settings Settings) (*domain.Order, error) {
 	// Validate customer ID
 	if dto.CustomerID == "" {
 		return nil, domain.ErrInvalidCustomerID
@@ -68,8 +196,9 @@ func (s *orderServiceImpl) CreateOrder(ctx context.Context, dto CreateOrderDTO)
 			ProductID: item.ProductID,
 			Name:      item.Name,
 			Quantity:  item.Quantity,
-			Price:     item.Price,
+			Price:     domain.RoundMoney(item.Price),
 			Subtotal:  item.CalculateSubtotal(),
+			Status:    domain.ItemStatusPending,
 		}
 	}
 
@@ -86,21 +215,27 @@ func (s *orderServiceImpl) CreateOrder(ctx context.Context, dto CreateOrderDTO)
 	// Calculate total
 	order.Total = order.CalculateTotal()
 
-	// Validate order
-	if err := order.Validate(); err != nil {
+	if err := order.SetLabels(dto.Metadata, dto.Tags); err != nil {
 		return nil, err
 	}
-
-	// Save to repository
-	if err := s.repo.Create(ctx, order); err != nil {
+	if err := order.SetAddresses(dto.ShippingAddress, dto.BillingAddress); err != nil {
 		return nil, err
 	}
 
-	// Publish event (warn + continue on failure)
-	if s.publisher != nil {
-		if err := s.publisher.PublishOrderCreated(ctx, order); err != nil {
-			slog.Warn("failed to publish order.created event", slog.String("order_id", order.ID.String()), slog.String("error", err.Error()))
-		}
+	order.ShippingMethod = dto.ShippingMethod
+	if order.ShippingMethod == "" {
+		order.ShippingMethod = settings.DefaultShippingMethod
+	}
+	if _, ok := settings.DeliveryTransitTimes[order.ShippingMethod]; order.ShippingMethod != "" && !ok {
+		return nil, domain.ErrInvalidShippingMethod
+	}
+
+	// Validate order
+	if err := order.Validate(); err != nil {
+		return nil, err
+	}
+	if err := order.CheckLimits(settings.OrderLimits); err != nil {
+		return nil, err
 	}
 
 	return order, nil
@@ -108,15 +243,46 @@ func (s *orderServiceImpl) CreateOrder(ctx context.Context, dto CreateOrderDTO)
 
 func (s *orderServiceImpl) GetOrderByID(ctx context.Context, id string) (*domain.Order, error) {
 	// Check cache first
+	tenantID := domain.TenantID(ctx)
 	if s.cache != nil {
-		cached, err := s.cache.Get(ctx, id)
+		cached, err := s.cache.Get(ctx, tenantID, id)
 		if err != nil {
-			slog.Warn("cache get failed", slog.String("order_id", id), slog.String("error", err.Error()))
+			slog.WarnContext(ctx, "cache get failed", slog.String("order_id", id), slog.String("error", err.Error()))
 		} else if cached != nil {
+			if err := domain.AuthorizeCustomer(ctx, cached.CustomerID); err != nil {
+				return nil, err
+			}
 			return cached, nil
 		}
 	}
 
+	// Concurrent misses for the same order share one database read. The read
+	// is detached from this caller's cancellation so that one caller giving
+	// up does not fail the others; each caller still stops waiting when its
+	// own context ends. Callers of different tenants never share a read.
+	loaded := s.loads.DoChan(cache.OrderKey(tenantID, id), func() (any, error) {
+		return s.loadOrder(context.WithoutCancel(ctx), id)
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-loaded:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		order := res.Val.(*domain.Order)
+		if err := domain.AuthorizeCustomer(ctx, order.CustomerID); err != nil {
+			return nil, err
+		}
+		if res.Shared {
+			order = order.Clone()
+		}
+		return order, nil
+	}
+}
+
+// loadOrder reads an order from the database and populates the cache.
+func (s *orderServiceImpl) loadOrder(ctx context.Context, id string) (*domain.Order, error) {
 	order, err := s.repo.FindByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -128,18 +294,70 @@ func (s *orderServiceImpl) GetOrderByID(ctx context.Context, id string) (*domain
 
 	// Populate cache
 	if s.cache != nil {
-		if err := s.cache.Set(ctx, order, orderCacheTTL); err != nil {
-			slog.Warn("cache set failed", slog.String("order_id", id), slog.String("error", err.Error()))
+		if err := s.cache.Set(ctx, order, s.config.Settings().OrderCacheTTL); err != nil {
+			slog.WarnContext(ctx, "cache set failed", slog.String("order_id", id), slog.String("error", err.Error()))
 		}
 	}
 
 	return order, nil
 }
 
+func (s *orderServiceImpl) GetOrderByIDIncludingDeleted(ctx context.Context, id string) (*domain.Order, error) {
+	if err := domain.AuthorizeAllCustomers(ctx); err != nil {
+		return nil, err
+	}
+
+	// The cache holds live orders only
+	order, err := s.repo.FindByIDIncludingDeleted(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return nil, domain.ErrOrderNotFound
+	}
+	return order, nil
+}
+
 // UpdateOrder updates an existing order.
 // Uses optimistic locking - returns ErrConcurrentModification if the order
 // was modified by another process between read and write.
 func (s *orderServiceImpl) UpdateOrder(ctx context.Context, id string, dto UpdateOrderDTO) (*domain.Order, error) {
+	// Priced before the transaction so it is not held open during the lookup
+	if len(dto.Items) > 0 {
+		prices, err := lookupPrices(ctx, s.pricing, dto.Items)
+		if err != nil {
+			return nil, err
+		}
+		if dto.Items, err = applyPrices(dto.Items, prices, s.config.Settings().RequireServerPricing); err != nil {
+			return nil, err
+		}
+	}
+
+	var order *domain.Order
+	err := s.uow.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		order, err = s.updateOrder(ctx, id, dto)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Publish event (warn + continue on failure)
+	if s.publisher != nil {
+		if err := s.publisher.PublishOrderUpdated(ctx, order); err != nil {
+			slog.WarnContext(ctx, "failed to publish order.updated event", slog.String("order_id", order.ID.String()), slog.String("error", err.Error()))
+		}
+	}
+
+	// Invalidate cache
+	invalidateOrder(ctx, s.cache, order.TenantID, id, order.CustomerID)
+
+	return order, nil
+}
+
+// updateOrder reads the order, applies dto and writes it back
+func (s *orderServiceImpl) updateOrder(ctx context.Context, id string, dto UpdateOrderDTO) (*domain.Order, error) {
 	// Get existing order (includes current version for optimistic locking)
 	order, err := s.repo.FindByID(ctx, id)
 	if err != nil {
@@ -149,9 +367,17 @@ func (s *orderServiceImpl) UpdateOrder(ctx context.Context, id string, dto Updat
 	if order == nil {
 		return nil, domain.ErrOrderNotFound
 	}
+	if err := domain.AuthorizeCustomer(ctx, order.CustomerID); err != nil {
+		return nil, err
+	}
 
 	// Update items if provided
 	if len(dto.Items) > 0 {
+		// Replacing items would discard their fulfillment state
+		if order.FulfillmentStarted() {
+			return nil, domain.ErrItemsInFulfillment
+		}
+
 		items := make([]domain.OrderItem, len(dto.Items))
 		for i, item := range dto.Items {
 			if err := item.Validate(); err != nil {
@@ -163,12 +389,30 @@ func (s *orderServiceImpl) UpdateOrder(ctx context.Context, id string, dto Updat
 				ProductID: item.ProductID,
 				Name:      item.Name,
 				Quantity:  item.Quantity,
-				Price:     item.Price,
+				Price:     domain.RoundMoney(item.Price),
 				Subtotal:  item.CalculateSubtotal(),
+				Status:    domain.ItemStatusPending,
 			}
 		}
 		order.Items = items
 		order.Total = order.CalculateTotal()
+		if err := order.CheckLimits(s.config.Settings().OrderLimits); err != nil {
+			return nil, err
+		}
+	}
+
+	// Update metadata and tags if provided, each keeping the other
+	if dto.Metadata != nil || dto.Tags != nil {
+		metadata, tags := order.Metadata, order.Tags
+		if dto.Metadata != nil {
+			metadata = dto.Metadata
+		}
+		if dto.Tags != nil {
+			tags = dto.Tags
+		}
+		if err := order.SetLabels(metadata, tags); err != nil {
+			return nil, err
+		}
 	}
 
 	// Update status if provided
@@ -186,32 +430,347 @@ func (s *orderServiceImpl) UpdateOrder(ctx context.Context, id string, dto Updat
 		return nil, err
 	}
 
+	return order, nil
+}
+
+func (s *orderServiceImpl) PatchOrder(ctx context.Context, id string, patch PatchOrderDTO, expectedVersion *int) (*domain.Order, error) {
+	added, err := newPatchItems(patch.Items)
+	if err != nil {
+		return nil, err
+	}
+	if added, err = s.priceItems(ctx, added); err != nil {
+		return nil, err
+	}
+
+	return s.changeOrder(ctx, id, expectedVersion, func(order *domain.Order) error {
+		if len(patch.Items) > 0 {
+			if err := patchItems(order, patch.Items, added, s.config.Settings().OrderLimits); err != nil {
+				return err
+			}
+		}
+		if patch.Metadata != nil || patch.ClearMetadata || patch.Tags != nil {
+			return patchLabels(order, patch)
+		}
+		return nil
+	})
+}
+
+func (s *orderServiceImpl) AddItem(ctx context.Context, id string, item domain.OrderItem, expectedVersion *int) (*domain.Order, error) {
+	added, err := s.priceItems(ctx, []domain.OrderItem{item})
+	if err != nil {
+		return nil, err
+	}
+	return s.changeItems(ctx, id, expectedVersion, nil, added)
+}
+
+func (s *orderServiceImpl) UpdateItem(ctx context.Context, id, itemID string, patch ItemPatch, expectedVersion *int) (*domain.Order, error) {
+	return s.changeItems(ctx, id, expectedVersion, map[string]*ItemPatch{itemID: &patch}, nil)
+}
+
+func (s *orderServiceImpl) RemoveItem(ctx context.Context, id, itemID string, expectedVersion *int) (*domain.Order, error) {
+	return s.changeItems(ctx, id, expectedVersion, map[string]*ItemPatch{itemID: nil}, nil)
+}
+
+// changeItems applies one item change like PatchOrder, refusing it with
+// domain.ErrItemsLocked unless the order status CanChangeItems
+func (s *orderServiceImpl) changeItems(ctx context.Context, id string, expectedVersion *int, items map[string]*ItemPatch, added []domain.OrderItem) (*domain.Order, error) {
+	return s.changeOrder(ctx, id, expectedVersion, func(order *domain.Order) error {
+		if !order.Status.CanChangeItems() {
+			return domain.ErrItemsLocked
+		}
+		return patchItems(order, items, added, s.config.Settings().OrderLimits)
+	})
+}
+
+// priceItems replaces the prices of new items with catalog prices. It runs
+// before the transaction so that it is not held open during the lookup.
+func (s *orderServiceImpl) priceItems(ctx context.Context, items []domain.OrderItem) ([]domain.OrderItem, error) {
+	if len(items) == 0 {
+		return items, nil
+	}
+	prices, err := lookupPrices(ctx, s.pricing, items)
+	if err != nil {
+		return nil, err
+	}
+	return applyPrices(items, prices, s.config.Settings().RequireServerPricing)
+}
+
+// changeOrder applies a change to the order in a transaction and writes it
+// back, publishing order.updated. The version bump of the write covers every
+// part of the change.
+func (s *orderServiceImpl) changeOrder(ctx context.Context, id string, expectedVersion *int, apply func(order *domain.Order) error) (*domain.Order, error) {
+	var order *domain.Order
+	err := s.uow.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		order, err = s.repo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if order == nil {
+			return domain.ErrOrderNotFound
+		}
+		if err := domain.AuthorizeCustomer(ctx, order.CustomerID); err != nil {
+			return err
+		}
+
+		if expectedVersion != nil && *expectedVersion != order.Version {
+			return domain.ErrVersionMismatch
+		}
+
+		if err := apply(order); err != nil {
+			return err
+		}
+		order.UpdatedAt = time.Now()
+		return s.repo.Update(ctx, order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	// Publish event (warn + continue on failure)
 	if s.publisher != nil {
 		if err := s.publisher.PublishOrderUpdated(ctx, order); err != nil {
-			slog.Warn("failed to publish order.updated event", slog.String("order_id", order.ID.String()), slog.String("error", err.Error()))
+			slog.WarnContext(ctx, "failed to publish order.updated event", slog.String("order_id", order.ID.String()), slog.String("error", err.Error()))
 		}
 	}
 
+	// Invalidate cache
+	invalidateOrder(ctx, s.cache, order.TenantID, id, order.CustomerID)
+
 	return order, nil
 }
 
+// newPatchItems returns the items a merge patch adds, those under keys that
+// are not item IDs, in key order so that the result does not depend on map
+// iteration
+func newPatchItems(items map[string]*ItemPatch) ([]domain.OrderItem, error) {
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		if _, err := uuid.Parse(key); err != nil {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	added := make([]domain.OrderItem, 0, len(keys))
+	for _, key := range keys {
+		p := items[key]
+		if p == nil {
+			// Removing needs the ID of an existing item
+			return nil, domain.ErrItemNotFound
+		}
+		var item domain.OrderItem
+		if p.ProductID != nil {
+			item.ProductID = *p.ProductID
+		}
+		if p.Name != nil {
+			item.Name = *p.Name
+		}
+		if p.Quantity != nil {
+			item.Quantity = *p.Quantity
+		}
+		if p.Price != nil {
+			item.Price = *p.Price
+		}
+		added = append(added, item)
+	}
+	return added, nil
+}
+
+// patchItems removes and changes the existing items of order named in items
+// and appends added, which newPatchItems took from the same patch. The
+// resulting order must stay within limits.
+func patchItems(order *domain.Order, items map[string]*ItemPatch, added []domain.OrderItem, limits domain.OrderLimits) error {
+	// Replacing items would discard their fulfillment state
+	if order.FulfillmentStarted() {
+		return domain.ErrItemsInFulfillment
+	}
+
+	byID := make(map[uuid.UUID]*ItemPatch)
+	for key, p := range items {
+		if id, err := uuid.Parse(key); err == nil {
+			byID[id] = p
+		}
+	}
+
+	patched := make([]domain.OrderItem, 0, len(order.Items)+len(added))
+	for _, item := range order.Items {
+		p, ok := byID[item.ID]
+		if !ok {
+			patched = append(patched, item)
+			continue
+		}
+		delete(byID, item.ID)
+		if p == nil {
+			continue
+		}
+		if p.ProductID != nil || p.Price != nil {
+			return domain.ErrItemNotPatchable
+		}
+		if p.Name != nil {
+			item.Name = *p.Name
+		}
+		if p.Quantity != nil {
+			item.Quantity = *p.Quantity
+		}
+		if err := item.Validate(); err != nil {
+			return err
+		}
+		item.Subtotal = item.CalculateSubtotal()
+		patched = append(patched, item)
+	}
+	if len(byID) > 0 {
+		return domain.ErrItemNotFound
+	}
+
+	for _, item := range added {
+		if err := item.Validate(); err != nil {
+			return err
+		}
+		patched = append(patched, domain.OrderItem{
+			ID:        uuid.New(),
+			ProductID: item.ProductID,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			Price:     domain.RoundMoney(item.Price),
+			Subtotal:  item.CalculateSubtotal(),
+			Status:    domain.ItemStatusPending,
+		})
+	}
+	if len(patched) == 0 {
+		return domain.ErrNoItems
+	}
+
+	order.Items = patched
+	order.Total = order.CalculateTotal()
+	return order.CheckLimits(limits)
+}
+
+// patchLabels merges the metadata of a patch into order and replaces its
+// tags if the patch has them
+func patchLabels(order *domain.Order, patch PatchOrderDTO) error {
+	metadata := make(map[string]string, len(order.Metadata)+len(patch.Metadata))
+	if !patch.ClearMetadata {
+		for k, v := range order.Metadata {
+			metadata[k] = v
+		}
+	}
+	for k, v := range patch.Metadata {
+		if v == nil {
+			delete(metadata, k)
+		} else {
+			metadata[k] = *v
+		}
+	}
+
+	tags := order.Tags
+	if patch.Tags != nil {
+		tags = *patch.Tags
+	}
+	return order.SetLabels(metadata, tags)
+}
+
 func (s *orderServiceImpl) DeleteOrder(ctx context.Context, id string) error {
-	// Check if order exists
-	order, err := s.repo.FindByID(ctx, id)
+	var order *domain.Order
+	err := s.uow.WithTx(ctx, func(ctx context.Context) error {
+		// Check if order exists
+		var err error
+		order, err = s.repo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if order == nil {
+			return domain.ErrOrderNotFound
+		}
+		if err := domain.AuthorizeCustomer(ctx, order.CustomerID); err != nil {
+			return err
+		}
+
+		// Soft delete
+		return s.repo.Delete(ctx, id)
+	})
 	if err != nil {
 		return err
 	}
 
+	// Reflect the soft delete, which bumps the version, in the event
+	deletedAt := time.Now()
+	order.DeletedAt = &deletedAt
+	order.Version++
+
+	// Publish event (warn + continue on failure)
+	if s.publisher != nil {
+		if err := s.publisher.PublishOrderDeleted(ctx, order); err != nil {
+			slog.WarnContext(ctx, "failed to publish order.deleted event", slog.String("order_id", order.ID.String()), slog.String("error", err.Error()))
+		}
+	}
+
+	// Invalidate cache
+	invalidateOrder(ctx, s.cache, order.TenantID, id, order.CustomerID)
+
+	return nil
+}
+
+// RestoreOrder clears the soft delete on an order. The version check happens
+// under the row lock in the repository, so a concurrent restore or purge
+// cannot slip in between check and write.
+func (s *orderServiceImpl) RestoreOrder(ctx context.Context, id string, expectedVersion *int) (*domain.Order, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, domain.ErrOrderNotFound
+	}
+	// Deleted orders cannot be read to check their owner, so only service callers restore
+	if err := domain.AuthorizeAllCustomers(ctx); err != nil {
+		return nil, err
+	}
+
+	order, err := s.repo.Restore(ctx, id, expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	if order == nil {
-		return domain.ErrOrderNotFound
+		return nil, domain.ErrOrderNotFound
 	}
 
-	// Soft delete
-	return s.repo.Delete(ctx, id)
+	// Publish event (warn + continue on failure)
+	if s.publisher != nil {
+		if err := s.publisher.PublishOrderRestored(ctx, order); err != nil {
+			slog.WarnContext(ctx, "failed to publish order.restored event", slog.String("order_id", order.ID.String()), slog.String("error", err.Error()))
+		}
+	}
+
+	// Invalidate cache
+	invalidateOrder(ctx, s.cache, order.TenantID, id, order.CustomerID)
+
+	return order, nil
 }
 
 func (s *orderServiceImpl) ListOrders(ctx context.Context, req ListOrdersRequest) (*domain.PaginatedOrders, error) {
+	// An unknown status would silently match nothing
+	if req.Status != nil && !req.Status.IsValid() {
+		return nil, domain.ErrInvalidStatus
+	}
+	tags, err := domain.NormalizeTags(req.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if req.IncludeDeleted {
+		if err := domain.AuthorizeAllCustomers(ctx); err != nil {
+			return nil, err
+		}
+	}
+	// Customer tokens list their own orders; naming another customer is denied
+	if p, ok := domain.PrincipalFromContext(ctx); ok && p.Role == domain.RoleCustomer {
+		if req.CustomerID == nil || *req.CustomerID == "" {
+			req.CustomerID = &p.CustomerID
+		}
+		if err := domain.AuthorizeCustomer(ctx, *req.CustomerID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Set defaults
 	page := req.Page
 	if page < 1 {
@@ -222,24 +781,62 @@ func (s *orderServiceImpl) ListOrders(ctx context.Context, req ListOrdersRequest
 	if pageSize < 1 {
 		pageSize = 20
 	}
-	if pageSize > 100 {
-		pageSize = 100
+	if maxPageSize := s.config.Settings().MaxPageSize; pageSize > maxPageSize {
+		pageSize = maxPageSize
 	}
 
-	// Calculate offset
+	// Calculate offset; an explicit one is kept as is, since the page size
+	// may have been capped above
 	offset := (page - 1) * pageSize
+	if req.Offset > 0 {
+		offset = req.Offset
+		page = offset/pageSize + 1
+	}
 
 	// Build list options
 	opts := repository.ListOptions{
-		Limit:  pageSize,
-		Offset: offset,
-		Status: req.Status,
+		Limit:          pageSize,
+		Offset:         offset,
+		Status:         req.Status,
+		ProductID:      req.ProductID,
+		Tags:           tags,
+		IncludeDeleted: req.IncludeDeleted,
+	}
+
+	// A customer's pages are cached; any change to one of their orders
+	// evicts them all (see invalidateOrder)
+	var listKey string
+	listTTL := s.config.Settings().OrderListCacheTTL
+	// Only whole pages are cached, so an offset between pages reads through
+	pageAligned := offset == (page-1)*pageSize
+	if req.CustomerID != nil && *req.CustomerID != "" && !req.IncludeDeleted && pageAligned && s.cache != nil && listTTL > 0 {
+		listKey = cache.CustomerListKey(domain.TenantID(ctx), *req.CustomerID, req.Status, req.ProductID, tags, page, pageSize)
+		cached, err := s.cache.GetList(ctx, listKey)
+		if err != nil {
+			slog.WarnContext(ctx, "cache get list failed", slog.String("key", listKey), slog.String("error", err.Error()))
+		} else if cached != nil {
+			cached.Offset = offset
+			return cached, nil
+		}
+	}
+
+	// Counting an unfiltered list reads every order, so it may be
+	// estimated from table statistics instead. Those span every tenant.
+	estimate := int64(-1)
+	_, scoped := domain.TenantFromContext(ctx)
+	unfiltered := (req.CustomerID == nil || *req.CustomerID == "") && req.Status == nil && req.ProductID == nil && len(tags) == 0 && !scoped
+	if unfiltered && !req.ExactTotal && s.config.Settings().EstimateListTotals {
+		var err error
+		if estimate, err = s.repo.EstimateTotal(ctx); err != nil {
+			slog.WarnContext(ctx, "estimating order total failed", slog.String("error", err.Error()))
+			estimate = -1
+		}
+		opts.SkipTotal = estimate >= 0
 	}
 
 	// Get orders from repository
 	var orders []*domain.Order
 	var totalCount int64
-	var err error
 
 	if req.CustomerID != nil && *req.CustomerID != "" {
 		orders, totalCount, err = s.repo.FindByCustomerID(ctx, *req.CustomerID, opts)
@@ -250,63 +847,401 @@ func (s *orderServiceImpl) ListOrders(ctx context.Context, req ListOrdersRequest
 		return nil, err
 	}
 
+	totalEstimated := false
+	if opts.SkipTotal {
+		totalCount, totalEstimated = estimatedTotal(estimate, offset, len(orders), pageSize)
+	}
+
 	// Calculate total pages
 	totalPages := int(math.Ceil(float64(totalCount) / float64(pageSize)))
 
-	return &domain.PaginatedOrders{
-		Data:       orders,
-		Page:       page,
-		PageSize:   pageSize,
-		TotalCount: totalCount,
-		TotalPages: totalPages,
-	}, nil
+	result := &domain.PaginatedOrders{
+		Data:           orders,
+		Page:           page,
+		PageSize:       pageSize,
+		Offset:         offset,
+		TotalCount:     totalCount,
+		TotalPages:     totalPages,
+		TotalEstimated: totalEstimated,
+	}
+
+	if listKey != "" {
+		if err := s.cache.SetList(ctx, listKey, result, listTTL); err != nil {
+			slog.WarnContext(ctx, "cache set list failed", slog.String("key", listKey), slog.String("error", err.Error()))
+		}
+	}
+
+	return result, nil
+}
+
+// estimatedTotal reconciles a statistics estimate with the page read at
+// offset. A page shorter than pageSize ends the list, so the total is then
+// known exactly; otherwise it is at least the orders seen so far.
+func estimatedTotal(estimate int64, offset, count, pageSize int) (int64, bool) {
+	seen := int64(offset + count)
+	if count < pageSize && (count > 0 || offset == 0) {
+		return seen, false
+	}
+	return max(estimate, seen), true
 }
 
 // UpdateOrderStatus transitions an order to a new status.
 // Uses optimistic locking - returns ErrConcurrentModification if the order
 // was modified by another process between read and write.
 // This prevents race conditions like two concurrent status changes.
-func (s *orderServiceImpl) UpdateOrderStatus(ctx context.Context, id string, newStatus domain.OrderStatus) (*domain.Order, error) {
+// expectedVersion lets the caller pin the version it last read, so a change
+// made since then is rejected even if it happened before our FindByID.
+func (s *orderServiceImpl) UpdateOrderStatus(ctx context.Context, id string, newStatus domain.OrderStatus, expectedVersion *int) (*domain.Order, error) {
+	var (
+		order     *domain.Order
+		oldStatus domain.OrderStatus
+	)
+	err := s.uow.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		order, oldStatus, err = s.updateOrderStatus(ctx, id, newStatus, expectedVersion)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Publish event (warn + continue on failure)
+	if s.publisher != nil {
+		if err := s.publisher.PublishOrderStatusChanged(ctx, order, oldStatus, newStatus); err != nil {
+			slog.WarnContext(ctx, "failed to publish order.status_changed event", slog.String("order_id", order.ID.String()), slog.String("error", err.Error()))
+		}
+	}
+
+	// Invalidate cache
+	invalidateOrder(ctx, s.cache, order.TenantID, id, order.CustomerID)
+
+	return order, nil
+}
+
+// updateOrderStatus reads the order, checks the transition and writes the
+// new status. It returns the updated order and its previous status.
+func (s *orderServiceImpl) updateOrderStatus(ctx context.Context, id string, newStatus domain.OrderStatus, expectedVersion *int) (*domain.Order, domain.OrderStatus, error) {
+	if !newStatus.IsValid() {
+		return nil, "", domain.ErrInvalidStatus
+	}
+
 	// Get existing order (includes current version for optimistic locking)
 	order, err := s.repo.FindByID(ctx, id)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	if order == nil {
-		return nil, domain.ErrOrderNotFound
+		return nil, "", domain.ErrOrderNotFound
+	}
+	if err := domain.AuthorizeCustomer(ctx, order.CustomerID); err != nil {
+		return nil, "", err
+	}
+
+	if expectedVersion != nil && *expectedVersion != order.Version {
+		return nil, "", domain.ErrVersionMismatch
 	}
 
 	// Validate status transition
 	if !order.Status.CanTransitionTo(newStatus) {
-		return nil, domain.ErrInvalidTransition
+		return nil, "", domain.ErrInvalidTransition
+	}
+
+	// With payments enabled a customer confirms an order by paying for it
+	if order.Status == domain.OrderStatusPending && newStatus == domain.OrderStatusConfirmed && s.config.Settings().RequirePayment {
+		if err := domain.AuthorizeAllCustomers(ctx); err != nil {
+			return nil, "", err
+		}
 	}
 
 	// Capture old status before mutation
 	oldStatus := order.Status
 
-	// Update status
+	// Update status; cancelling is the only way out of on_hold besides a release
 	order.Status = newStatus
+	order.Hold = nil
+	order.ApplyStatusToItems()
 	order.UpdatedAt = time.Now()
 
+	// The delivery estimate runs from confirmation; a method that is no longer
+	// configured leaves the order without one
+	if newStatus == domain.OrderStatusConfirmed {
+		if transit, ok := s.config.Settings().DeliveryTransitTimes[order.ShippingMethod]; ok {
+			order.EstimateDelivery(order.UpdatedAt, transit)
+		}
+	}
+
 	// Save to repository
 	if err := s.repo.Update(ctx, order); err != nil {
+		return nil, "", err
+	}
+
+	return order, oldStatus, nil
+}
+
+func (s *orderServiceImpl) HoldOrder(ctx context.Context, id string, reason string, expectedVersion *int) (*domain.Order, error) {
+	return s.changeHold(ctx, id, expectedVersion, func(order *domain.Order, now time.Time) error {
+		// Payment holds wait for a payment or an operator
+		var releaseAt *time.Time
+		if after := s.config.Settings().HoldReleaseAfter; after > 0 && !domain.IsPaymentHoldReason(reason) {
+			at := now.Add(after)
+			releaseAt = &at
+		}
+		return order.PlaceOnHold(reason, releaseAt, now)
+	})
+}
+
+func (s *orderServiceImpl) ReleaseOrder(ctx context.Context, id string, expectedVersion *int) (*domain.Order, error) {
+	return s.changeHold(ctx, id, expectedVersion, func(order *domain.Order, now time.Time) error {
+		if order.HeldForPaymentMismatch() {
+			if err := domain.AuthorizeAllCustomers(ctx); err != nil {
+				return err
+			}
+		}
+		return order.Release(now)
+	})
+}
+
+// changeHold applies a hold or release to the order and writes it back like
+// UpdateOrderStatus, publishing order.status_changed
+func (s *orderServiceImpl) changeHold(ctx context.Context, id string, expectedVersion *int, apply func(order *domain.Order, now time.Time) error) (*domain.Order, error) {
+	var (
+		order     *domain.Order
+		oldStatus domain.OrderStatus
+	)
+	err := s.uow.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		order, err = s.repo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if order == nil {
+			return domain.ErrOrderNotFound
+		}
+		if err := domain.AuthorizeCustomer(ctx, order.CustomerID); err != nil {
+			return err
+		}
+
+		if expectedVersion != nil && *expectedVersion != order.Version {
+			return domain.ErrVersionMismatch
+		}
+
+		oldStatus = order.Status
+		if err := apply(order, time.Now()); err != nil {
+			return err
+		}
+		return s.repo.Update(ctx, order)
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	// Publish event (warn + continue on failure)
 	if s.publisher != nil {
-		if err := s.publisher.PublishOrderStatusChanged(ctx, order, oldStatus, newStatus); err != nil {
-			slog.Warn("failed to publish order.status_changed event", slog.String("order_id", order.ID.String()), slog.String("error", err.Error()))
+		if err := s.publisher.PublishOrderStatusChanged(ctx, order, oldStatus, order.Status); err != nil {
+			slog.WarnContext(ctx, "failed to publish order.status_changed event", slog.String("order_id", order.ID.String()), slog.String("error", err.Error()))
 		}
 	}
 
 	// Invalidate cache
-	if s.cache != nil {
-		if err := s.cache.Delete(ctx, id); err != nil {
-			slog.Warn("cache delete failed", slog.String("order_id", id), slog.String("error", err.Error()))
+	invalidateOrder(ctx, s.cache, order.TenantID, id, order.CustomerID)
+
+	return order, nil
+}
+
+func (s *orderServiceImpl) UpdateItemStatus(ctx context.Context, id string, itemIDs []string, status domain.ItemStatus, expectedVersion *int) (*domain.Order, error) {
+	if !status.IsValid() {
+		return nil, domain.ErrInvalidItemStatus
+	}
+	ids := make([]uuid.UUID, len(itemIDs))
+	for i, itemID := range itemIDs {
+		parsed, err := uuid.Parse(itemID)
+		if err != nil {
+			return nil, domain.ErrItemNotFound
+		}
+		ids[i] = parsed
+	}
+
+	var (
+		order     *domain.Order
+		oldStatus domain.OrderStatus
+	)
+	err := s.uow.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		order, err = s.repo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if order == nil {
+			return domain.ErrOrderNotFound
 		}
+		if err := domain.AuthorizeCustomer(ctx, order.CustomerID); err != nil {
+			return err
+		}
+
+		if expectedVersion != nil && *expectedVersion != order.Version {
+			return domain.ErrVersionMismatch
+		}
+
+		oldStatus = order.Status
+		if err := order.SetItemStatus(ids, status, time.Now()); err != nil {
+			return err
+		}
+		return s.repo.Update(ctx, order)
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	// Publish events (warn + continue on failure)
+	if s.publisher != nil {
+		if err := s.publisher.PublishOrderUpdated(ctx, order); err != nil {
+			slog.WarnContext(ctx, "failed to publish order.updated event", slog.String("order_id", order.ID.String()), slog.String("error", err.Error()))
+		}
+		if order.Status != oldStatus {
+			if err := s.publisher.PublishOrderStatusChanged(ctx, order, oldStatus, order.Status); err != nil {
+				slog.WarnContext(ctx, "failed to publish order.status_changed event", slog.String("order_id", order.ID.String()), slog.String("error", err.Error()))
+			}
+		}
+	}
+
+	// Invalidate cache
+	invalidateOrder(ctx, s.cache, order.TenantID, id, order.CustomerID)
+
 	return order, nil
 }
+
+func (s *orderServiceImpl) UpdateAddresses(ctx context.Context, id string, shipping, billing *domain.Address, expectedVersion *int) (*domain.Order, error) {
+	var order *domain.Order
+	err := s.uow.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		order, err = s.repo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if order == nil {
+			return domain.ErrOrderNotFound
+		}
+		if err := domain.AuthorizeCustomer(ctx, order.CustomerID); err != nil {
+			return err
+		}
+
+		if expectedVersion != nil && *expectedVersion != order.Version {
+			return domain.ErrVersionMismatch
+		}
+
+		if err := order.ChangeAddresses(shipping, billing, time.Now()); err != nil {
+			return err
+		}
+		return s.repo.Update(ctx, order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Publish event (warn + continue on failure)
+	if s.publisher != nil {
+		if err := s.publisher.PublishOrderUpdated(ctx, order); err != nil {
+			slog.WarnContext(ctx, "failed to publish order.updated event", slog.String("order_id", order.ID.String()), slog.String("error", err.Error()))
+		}
+	}
+
+	// Invalidate cache
+	invalidateOrder(ctx, s.cache, order.TenantID, id, order.CustomerID)
+
+	return order, nil
+}
+
+func (s *orderServiceImpl) MarkSLABreached(ctx context.Context, id string) (*domain.Order, error) {
+	var order *domain.Order
+	err := s.uow.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		order, err = s.repo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if order == nil {
+			return domain.ErrOrderNotFound
+		}
+
+		if err := order.MarkSLABreached(time.Now()); err != nil {
+			return err
+		}
+		return s.repo.Update(ctx, order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Publish event (warn + continue on failure)
+	if s.publisher != nil {
+		if err := s.publisher.PublishOrderSLABreached(ctx, order); err != nil {
+			slog.WarnContext(ctx, "failed to publish order.sla_breached event", slog.String("order_id", order.ID.String()), slog.String("error", err.Error()))
+		}
+	}
+
+	// Invalidate cache
+	invalidateOrder(ctx, s.cache, order.TenantID, id, order.CustomerID)
+
+	return order, nil
+}
+
+func (s *orderServiceImpl) RepairTotals(ctx context.Context, id string) (*domain.Order, error) {
+	var order *domain.Order
+	err := s.uow.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		order, err = s.repo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if order == nil {
+			return domain.ErrOrderNotFound
+		}
+
+		if !order.RecalculateAmounts() {
+			return domain.ErrTotalsConsistent
+		}
+		order.UpdatedAt = time.Now()
+		return s.repo.Update(ctx, order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Publish event (warn + continue on failure)
+	if s.publisher != nil {
+		if err := s.publisher.PublishOrderUpdated(ctx, order); err != nil {
+			slog.WarnContext(ctx, "failed to publish order.updated event", slog.String("order_id", order.ID.String()), slog.String("error", err.Error()))
+		}
+	}
+
+	// Invalidate cache
+	invalidateOrder(ctx, s.cache, order.TenantID, id, order.CustomerID)
+
+	return order, nil
+}
+
+// BulkUpdateOrderStatus applies UpdateOrderStatus to each distinct ID, so every
+// successful transition is versioned, published and evicted from cache exactly
+// as a single update would be.
+func (s *orderServiceImpl) BulkUpdateOrderStatus(ctx context.Context, ids []string, newStatus domain.OrderStatus) []BulkStatusResult {
+	seen := make(map[string]struct{}, len(ids))
+	results := make([]BulkStatusResult, 0, len(ids))
+
+	for _, id := range ids {
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		order, err := s.UpdateOrderStatus(ctx, id, newStatus, nil)
+		results = append(results, BulkStatusResult{OrderID: id, Order: order, Err: err})
+	}
+
+	return results
+}
@@ -18,14 +18,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/cache"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
 	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestOrderService_CreateOrder_ValidInput_ReturnsOrder(t *testing.T) {
@@ -80,7 +84,7 @@ func TestOrderService_CreateOrder_ValidInput_ReturnsOrder(t *testing.T) {
 				},
 			}
 
-			service := NewOrderService(mockRepo, nil, nil)
+			service := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
 			order, err := service.CreateOrder(context.Background(), tt.dto)
 
 			if tt.wantErr != nil {
@@ -179,7 +183,7 @@ func TestOrderService_CreateOrder_InvalidInput_ReturnsError(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := &mocks.OrderRepositoryMock{}
-			service := NewOrderService(mockRepo, nil, nil)
+			service := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
 
 			order, err := service.CreateOrder(context.Background(), tt.dto)
 
@@ -218,7 +222,7 @@ func TestOrderService_GetOrderByID_Found_ReturnsOrder(t *testing.T) {
 		},
 	}
 
-	service := NewOrderService(mockRepo, nil, nil)
+	service := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
 	order, err := service.GetOrderByID(context.Background(), orderID.String())
 
 	assert.NoError(t, err)
@@ -236,7 +240,7 @@ func TestOrderService_GetOrderByID_NotFound_ReturnsError(t *testing.T) {
 		},
 	}
 
-	service := NewOrderService(mockRepo, nil, nil)
+	service := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
 	order, err := service.GetOrderByID(context.Background(), orderID.String())
 
 	assert.Error(t, err)
@@ -299,7 +303,7 @@ func TestOrderService_ListOrders_WithPagination_ReturnsOrders(t *testing.T) {
 				},
 			}
 
-			service := NewOrderService(mockRepo, nil, nil)
+			service := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
 			result, err := service.ListOrders(context.Background(), tt.request)
 
 			assert.NoError(t, err)
@@ -355,7 +359,7 @@ func TestOrderService_ListOrders_WithStatusFilter_ReturnsFilteredOrders(t *testi
 				},
 			}
 
-			service := NewOrderService(mockRepo, nil, nil)
+			service := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
 			result, err := service.ListOrders(context.Background(), tt.request)
 
 			assert.NoError(t, err)
@@ -434,7 +438,7 @@ func TestOrderService_ListOrders_WithCustomerID_ReturnsFilteredOrders(t *testing
 				},
 			}
 
-			svc := NewOrderService(mockRepo, nil, nil)
+			svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
 			result, err := svc.ListOrders(context.Background(), tt.request)
 
 			assert.NoError(t, err)
@@ -445,6 +449,49 @@ func TestOrderService_ListOrders_WithCustomerID_ReturnsFilteredOrders(t *testing
 	}
 }
 
+func TestOrderService_ListOrders_WithProductID_PassesFilterToRepository(t *testing.T) {
+	productID := "prod-42"
+	customerID := "customer-a"
+
+	tests := []struct {
+		name    string
+		request ListOrdersRequest
+	}{
+		{
+			name:    "product_id only uses List",
+			request: ListOrdersRequest{Page: 1, PageSize: 10, ProductID: &productID},
+		},
+		{
+			name:    "product_id with customer_id uses FindByCustomerID",
+			request: ListOrdersRequest{Page: 1, PageSize: 10, ProductID: &productID, CustomerID: &customerID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got repository.ListOptions
+			mockRepo := &mocks.OrderRepositoryMock{
+				ListFunc: func(_ context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+					got = opts
+					return createMockOrders(1), 1, nil
+				},
+				FindByCustomerIDFunc: func(_ context.Context, _ string, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+					got = opts
+					return createMockOrders(1), 1, nil
+				},
+			}
+
+			svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+			_, err := svc.ListOrders(context.Background(), tt.request)
+
+			assert.NoError(t, err)
+			if assert.NotNil(t, got.ProductID) {
+				assert.Equal(t, productID, *got.ProductID)
+			}
+		})
+	}
+}
+
 func TestOrderService_ListOrders_WithoutCustomerID_CallsList(t *testing.T) {
 	mockRepo := &mocks.OrderRepositoryMock{
 		ListFunc: func(_ context.Context, _ repository.ListOptions) ([]*domain.Order, int64, error) {
@@ -456,7 +503,7 @@ func TestOrderService_ListOrders_WithoutCustomerID_CallsList(t *testing.T) {
 		},
 	}
 
-	svc := NewOrderService(mockRepo, nil, nil)
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
 	result, err := svc.ListOrders(context.Background(), ListOrdersRequest{
 		Page:     1,
 		PageSize: 10,
@@ -474,7 +521,7 @@ func TestOrderService_ListOrders_EmptyResults_ReturnsEmptyList(t *testing.T) {
 		},
 	}
 
-	service := NewOrderService(mockRepo, nil, nil)
+	service := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
 	result, err := service.ListOrders(context.Background(), ListOrdersRequest{
 		Page:     1,
 		PageSize: 10,
@@ -487,6 +534,349 @@ func TestOrderService_ListOrders_EmptyResults_ReturnsEmptyList(t *testing.T) {
 	assert.Equal(t, 0, result.TotalPages)
 }
 
+// reloadableConfig is a ConfigProvider whose settings a test can change
+type reloadableConfig struct {
+	settings Settings
+}
+
+func (c *reloadableConfig) Settings() Settings {
+	return c.settings
+}
+
+func TestOrderService_ListOrders_PageSizeAboveCap_ClampedToCurrentCap(t *testing.T) {
+	var gotLimits []int
+	mockRepo := &mocks.OrderRepositoryMock{
+		ListFunc: func(_ context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+			gotLimits = append(gotLimits, opts.Limit)
+			return []*domain.Order{}, 0, nil
+		},
+	}
+	config := &reloadableConfig{settings: DefaultSettings}
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, config)
+	req := ListOrdersRequest{Page: 1, PageSize: 80}
+
+	_, err := svc.ListOrders(context.Background(), req)
+	require.NoError(t, err)
+
+	config.settings.MaxPageSize = 50
+	result, err := svc.ListOrders(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{80, 50}, gotLimits, "a lowered cap applies to the next request")
+	assert.Equal(t, 50, result.PageSize)
+}
+
+func TestOrderService_ListOrders_EstimateTotals(t *testing.T) {
+	status := domain.OrderStatusPending
+	tests := []struct {
+		name          string
+		req           ListOrdersRequest
+		estimate      int64
+		pageLen       int
+		wantSkipTotal bool
+		wantTotal     int64
+		wantEstimated bool
+	}{
+		{
+			name:          "unfiltered list uses estimate",
+			req:           ListOrdersRequest{Page: 1, PageSize: 10},
+			estimate:      1000,
+			pageLen:       10,
+			wantSkipTotal: true,
+			wantTotal:     1000,
+			wantEstimated: true,
+		},
+		{
+			name:          "estimate below orders seen is raised",
+			req:           ListOrdersRequest{Page: 3, PageSize: 10},
+			estimate:      5,
+			pageLen:       10,
+			wantSkipTotal: true,
+			wantTotal:     30,
+			wantEstimated: true,
+		},
+		{
+			name:          "short page gives exact total",
+			req:           ListOrdersRequest{Page: 2, PageSize: 10},
+			estimate:      1000,
+			pageLen:       4,
+			wantSkipTotal: true,
+			wantTotal:     14,
+		},
+		{
+			name:      "exact requested",
+			req:       ListOrdersRequest{Page: 1, PageSize: 10, ExactTotal: true},
+			estimate:  1000,
+			pageLen:   10,
+			wantTotal: 42,
+		},
+		{
+			name:      "filtered list is counted",
+			req:       ListOrdersRequest{Page: 1, PageSize: 10, Status: &status},
+			estimate:  1000,
+			pageLen:   10,
+			wantTotal: 42,
+		},
+		{
+			name:      "table not analyzed yet",
+			req:       ListOrdersRequest{Page: 1, PageSize: 10},
+			estimate:  -1,
+			pageLen:   10,
+			wantTotal: 42,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotSkipTotal bool
+			mockRepo := &mocks.OrderRepositoryMock{
+				EstimateTotalFunc: func(_ context.Context) (int64, error) {
+					return tt.estimate, nil
+				},
+				ListFunc: func(_ context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+					gotSkipTotal = opts.SkipTotal
+					if opts.SkipTotal {
+						return createMockOrders(tt.pageLen), 0, nil
+					}
+					return createMockOrders(tt.pageLen), 42, nil
+				},
+			}
+			settings := DefaultSettings
+			settings.EstimateListTotals = true
+			svc := NewOrderService(mockRepo, nil, nil, nil, nil, StaticConfig(settings))
+
+			result, err := svc.ListOrders(context.Background(), tt.req)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSkipTotal, gotSkipTotal)
+			assert.Equal(t, tt.wantTotal, result.TotalCount)
+			assert.Equal(t, tt.wantEstimated, result.TotalEstimated)
+		})
+	}
+}
+
+func TestOrderService_ListOrders_EstimateTotalsDisabled_NotEstimated(t *testing.T) {
+	mockRepo := &mocks.OrderRepositoryMock{
+		EstimateTotalFunc: func(_ context.Context) (int64, error) {
+			t.Fatal("EstimateTotal must not be called")
+			return 0, nil
+		},
+		ListFunc: func(_ context.Context, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+			assert.False(t, opts.SkipTotal)
+			return createMockOrders(1), 1, nil
+		},
+	}
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+
+	result, err := svc.ListOrders(context.Background(), ListOrdersRequest{Page: 1, PageSize: 10})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), result.TotalCount)
+	assert.False(t, result.TotalEstimated)
+}
+
+func TestOrderService_ListOrders_CustomerPageCached_SkipsRepository(t *testing.T) {
+	customerID := "customer-1"
+	cachedPage := &domain.PaginatedOrders{Data: []*domain.Order{{ID: uuid.New()}}, Page: 1, PageSize: 20, TotalCount: 1, TotalPages: 1}
+	var gotKey string
+	mockCache := &mocks.OrderCacheMock{
+		GetListFunc: func(_ context.Context, key string) (*domain.PaginatedOrders, error) {
+			gotKey = key
+			return cachedPage, nil
+		},
+	}
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByCustomerIDFunc: func(_ context.Context, _ string, _ repository.ListOptions) ([]*domain.Order, int64, error) {
+			t.Fatal("repository must not be queried on a cache hit")
+			return nil, 0, nil
+		},
+	}
+
+	svc := NewOrderService(mockRepo, nil, mockCache, nil, nil, nil)
+	result, err := svc.ListOrders(context.Background(), ListOrdersRequest{Page: 1, PageSize: 20, CustomerID: &customerID})
+
+	require.NoError(t, err)
+	assert.Same(t, cachedPage, result)
+	assert.Equal(t, cache.CustomerListKey("", customerID, nil, nil, nil, 1, 20), gotKey)
+}
+
+func TestOrderService_ListOrders_CustomerPageMiss_PopulatesCache(t *testing.T) {
+	customerID := "customer-1"
+	status := domain.OrderStatusPending
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByCustomerIDFunc: func(_ context.Context, _ string, _ repository.ListOptions) ([]*domain.Order, int64, error) {
+			return []*domain.Order{{ID: uuid.New()}}, 1, nil
+		},
+	}
+	var setKey string
+	var setTTL time.Duration
+	var setPage *domain.PaginatedOrders
+	mockCache := &mocks.OrderCacheMock{
+		SetListFunc: func(_ context.Context, key string, page *domain.PaginatedOrders, ttl time.Duration) error {
+			setKey, setPage, setTTL = key, page, ttl
+			return nil
+		},
+	}
+
+	svc := NewOrderService(mockRepo, nil, mockCache, nil, nil, nil)
+	result, err := svc.ListOrders(context.Background(), ListOrdersRequest{Page: 2, PageSize: 10, CustomerID: &customerID, Status: &status})
+
+	require.NoError(t, err)
+	assert.Equal(t, cache.CustomerListKey("", customerID, &status, nil, nil, 2, 10), setKey)
+	assert.Same(t, result, setPage)
+	assert.Equal(t, DefaultSettings.OrderListCacheTTL, setTTL)
+}
+
+func TestOrderService_ListOrders_Offset(t *testing.T) {
+	customerID := "customer-1"
+	tests := []struct {
+		name       string
+		offset     int
+		wantPage   int
+		wantCached bool
+	}{
+		{name: "on a page boundary", offset: 20, wantPage: 3, wantCached: true},
+		{name: "between pages", offset: 15, wantPage: 2, wantCached: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotOpts repository.ListOptions
+			mockRepo := &mocks.OrderRepositoryMock{
+				FindByCustomerIDFunc: func(_ context.Context, _ string, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+					gotOpts = opts
+					return []*domain.Order{{ID: uuid.New()}}, 30, nil
+				},
+			}
+			cached := false
+			mockCache := &mocks.OrderCacheMock{
+				SetListFunc: func(_ context.Context, _ string, _ *domain.PaginatedOrders, _ time.Duration) error {
+					cached = true
+					return nil
+				},
+			}
+
+			svc := NewOrderService(mockRepo, nil, mockCache, nil, nil, nil)
+			result, err := svc.ListOrders(context.Background(), ListOrdersRequest{PageSize: 10, Offset: tt.offset, CustomerID: &customerID})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.offset, gotOpts.Offset)
+			assert.Equal(t, tt.offset, result.Offset)
+			assert.Equal(t, tt.wantPage, result.Page)
+			assert.Equal(t, tt.wantCached, cached)
+		})
+	}
+}
+
+func TestOrderService_ListOrders_NotCached(t *testing.T) {
+	customerID := "customer-1"
+	tests := []struct {
+		name       string
+		customerID *string
+		listTTL    time.Duration
+	}{
+		{name: "all customers", listTTL: time.Minute},
+		{name: "list caching disabled", customerID: &customerID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mocks.OrderRepositoryMock{}
+			mockCache := &mocks.OrderCacheMock{
+				GetListFunc: func(_ context.Context, _ string) (*domain.PaginatedOrders, error) {
+					t.Fatal("must not read the list cache")
+					return nil, nil
+				},
+				SetListFunc: func(_ context.Context, _ string, _ *domain.PaginatedOrders, _ time.Duration) error {
+					t.Fatal("must not write the list cache")
+					return nil
+				},
+			}
+			settings := DefaultSettings
+			settings.OrderListCacheTTL = tt.listTTL
+
+			svc := NewOrderService(mockRepo, nil, mockCache, nil, nil, StaticConfig(settings))
+			_, err := svc.ListOrders(context.Background(), ListOrdersRequest{Page: 1, PageSize: 20, CustomerID: tt.customerID})
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestOrderService_Mutations_EvictCustomerListPages(t *testing.T) {
+	orderID := uuid.New()
+	newOrder := func() *domain.Order {
+		return &domain.Order{ID: orderID, CustomerID: "customer-1", Status: domain.OrderStatusPending, Version: 1}
+	}
+	tests := []struct {
+		name   string
+		mutate func(svc OrderService) error
+	}{
+		{
+			name: "create",
+			mutate: func(svc OrderService) error {
+				_, err := svc.CreateOrder(context.Background(), CreateOrderDTO{
+					CustomerID: "customer-1",
+					Items:      []domain.OrderItem{{ProductID: "p-1", Name: "Widget", Quantity: 1, Price: 5}},
+				})
+				return err
+			},
+		},
+		{
+			name: "update",
+			mutate: func(svc OrderService) error {
+				_, err := svc.UpdateOrder(context.Background(), orderID.String(), UpdateOrderDTO{})
+				return err
+			},
+		},
+		{
+			name: "status change",
+			mutate: func(svc OrderService) error {
+				_, err := svc.UpdateOrderStatus(context.Background(), orderID.String(), domain.OrderStatusConfirmed, nil)
+				return err
+			},
+		},
+		{
+			name: "delete",
+			mutate: func(svc OrderService) error {
+				return svc.DeleteOrder(context.Background(), orderID.String())
+			},
+		},
+		{
+			name: "restore",
+			mutate: func(svc OrderService) error {
+				_, err := svc.RestoreOrder(context.Background(), orderID.String(), nil)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+					return newOrder(), nil
+				},
+				RestoreFunc: func(_ context.Context, _ string, _ *int) (*domain.Order, error) {
+					return newOrder(), nil
+				},
+			}
+			var patterns []string
+			mockCache := &mocks.OrderCacheMock{
+				DeletePatternFunc: func(_ context.Context, pattern string) error {
+					patterns = append(patterns, pattern)
+					return nil
+				},
+			}
+
+			svc := NewOrderService(mockRepo, nil, mockCache, nil, nil, nil)
+			require.NoError(t, tt.mutate(svc))
+
+			assert.Equal(t, []string{cache.CustomerListPattern("", "customer-1")}, patterns)
+		})
+	}
+}
+
 func TestOrderService_UpdateOrderStatus_ValidTransitions_Success(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -562,8 +952,8 @@ func TestOrderService_UpdateOrderStatus_ValidTransitions_Success(t *testing.T) {
 				},
 			}
 
-			service := NewOrderService(mockRepo, nil, nil)
-			updatedOrder, err := service.UpdateOrderStatus(context.Background(), orderID.String(), tt.newStatus)
+			service := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+			updatedOrder, err := service.UpdateOrderStatus(context.Background(), orderID.String(), tt.newStatus, nil)
 
 			assert.NoError(t, err)
 			assert.NotNil(t, updatedOrder)
@@ -643,8 +1033,8 @@ func TestOrderService_UpdateOrderStatus_InvalidTransitions_ReturnsError(t *testi
 				},
 			}
 
-			service := NewOrderService(mockRepo, nil, nil)
-			updatedOrder, err := service.UpdateOrderStatus(context.Background(), orderID.String(), tt.newStatus)
+			service := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+			updatedOrder, err := service.UpdateOrderStatus(context.Background(), orderID.String(), tt.newStatus, nil)
 
 			assert.Error(t, err)
 			assert.Equal(t, domain.ErrInvalidTransition, err)
@@ -662,14 +1052,45 @@ func TestOrderService_UpdateOrderStatus_OrderNotFound_ReturnsError(t *testing.T)
 		},
 	}
 
-	service := NewOrderService(mockRepo, nil, nil)
-	updatedOrder, err := service.UpdateOrderStatus(context.Background(), orderID.String(), domain.OrderStatusConfirmed)
+	service := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	updatedOrder, err := service.UpdateOrderStatus(context.Background(), orderID.String(), domain.OrderStatusConfirmed, nil)
 
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrOrderNotFound, err)
 	assert.Nil(t, updatedOrder)
 }
 
+func TestOrderService_UpdateOrderStatus_UnknownStatus_ReturnsErrInvalidStatus(t *testing.T) {
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+			t.Fatal("FindByID should not be called for an unknown status")
+			return nil, nil
+		},
+	}
+
+	service := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	updatedOrder, err := service.UpdateOrderStatus(context.Background(), uuid.New().String(), "shipped-ish", nil)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidStatus)
+	assert.Nil(t, updatedOrder)
+}
+
+func TestOrderService_ListOrders_UnknownStatus_ReturnsErrInvalidStatus(t *testing.T) {
+	mockRepo := &mocks.OrderRepositoryMock{
+		ListFunc: func(_ context.Context, _ repository.ListOptions) ([]*domain.Order, int64, error) {
+			t.Fatal("List should not be called for an unknown status")
+			return nil, 0, nil
+		},
+	}
+	bogus := domain.OrderStatus("bogus")
+
+	service := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	result, err := service.ListOrders(context.Background(), ListOrdersRequest{Status: &bogus})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidStatus)
+	assert.Nil(t, result)
+}
+
 // Helper functions
 
 func createMockOrders(count int) []*domain.Order {
@@ -680,48 +1101,219 @@ func createMockOrders(count int) []*domain.Order {
 	return orders
 }
 
-func createMockOrder(status domain.OrderStatus) *domain.Order {
-	return createMockOrderWithVersion(status, 1)
-}
+func TestOrderService_UpdateOrderStatus_ExpectedVersion(t *testing.T) {
+	tests := []struct {
+		name            string
+		storedVersion   int
+		expectedVersion *int
+		wantErr         error
+	}{
+		{name: "no expected version", storedVersion: 3, expectedVersion: nil},
+		{name: "matching version", storedVersion: 3, expectedVersion: intPtr(3)},
+		{name: "stale version", storedVersion: 4, expectedVersion: intPtr(3), wantErr: domain.ErrVersionMismatch},
+	}
 
-func createMockOrdersForCustomer(customerID string, count int) []*domain.Order {
-	orders := make([]*domain.Order, count)
-	for i := 0; i < count; i++ {
-		o := createMockOrder(domain.OrderStatusPending)
-		o.CustomerID = customerID
-		orders[i] = o
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := createMockOrderWithVersion(domain.OrderStatusPending, tt.storedVersion)
+			updateCalled := false
+
+			mockRepo := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+					return order, nil
+				},
+				UpdateFunc: func(_ context.Context, _ *domain.Order) error {
+					updateCalled = true
+					return nil
+				},
+			}
+
+			svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+			_, err := svc.UpdateOrderStatus(context.Background(), order.ID.String(), domain.OrderStatusConfirmed, tt.expectedVersion)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, updateCalled, "stale version must not reach the repository")
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, updateCalled)
+		})
 	}
-	return orders
 }
 
-func createMockOrderWithVersion(status domain.OrderStatus, version int) *domain.Order {
-	return &domain.Order{
-		ID:         uuid.New(),
-		CustomerID: uuid.New().String(),
-		Items: []domain.OrderItem{
-			{
-				ID:        uuid.New(),
-				ProductID: "product-1",
-				Name:      "Test Product",
-				Quantity:  1,
-				Price:     10.00,
-				Subtotal:  10.00,
-			},
-		},
-		Status:    status,
-		Total:     10.00,
-		Version:   version,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-	}
+func intPtr(v int) *int {
+	return &v
 }
 
-// =============================================================================
-// Optimistic Locking Tests
-// =============================================================================
+func TestOrderService_BulkCreateOrders_MixedResults_InsertsValidOrdersInOneBatch(t *testing.T) {
+	validItems := []domain.OrderItem{{ProductID: "p-1", Name: "Widget", Quantity: 1, Price: 5}}
+	dtos := []CreateOrderDTO{
+		{CustomerID: "customer-1", Items: validItems},
+		{CustomerID: "", Items: validItems},
+		{CustomerID: "customer-2", Items: validItems},
+		{CustomerID: "customer-3", Items: validItems},
+	}
+	var batches [][]*domain.Order
+	mockRepo := &mocks.OrderRepositoryMock{
+		CreateBatchFunc: func(_ context.Context, orders []*domain.Order) ([]error, error) {
+			batches = append(batches, orders)
+			// The third valid order is rejected by the database
+			return []error{nil, nil, errors.New("duplicate key")}, nil
+		},
+	}
+	var published []string
+	mockPublisher := &mocks.EventPublisherMock{
+		PublishOrderCreatedFunc: func(_ context.Context, order *domain.Order) error {
+			published = append(published, order.CustomerID)
+			return nil
+		},
+	}
 
-func TestOrderService_UpdateOrderStatus_ConcurrentModification_ReturnsError(t *testing.T) {
-	orderID := uuid.New()
+	svc := NewOrderService(mockRepo, nil, nil, mockPublisher, nil, nil)
+	results := svc.BulkCreateOrders(context.Background(), dtos)
+
+	require.Len(t, batches, 1, "valid orders should be inserted in a single batch")
+	assert.Len(t, batches[0], 3)
+	require.Len(t, results, 4)
+	assert.Equal(t, "customer-1", results[0].Order.CustomerID)
+	assert.Equal(t, 5.0, results[0].Order.Total)
+	assert.ErrorIs(t, results[1].Err, domain.ErrInvalidCustomerID)
+	assert.Equal(t, "customer-2", results[2].Order.CustomerID)
+	assert.EqualError(t, results[3].Err, "duplicate key")
+	assert.Equal(t, []string{"customer-1", "customer-2"}, published, "only inserted orders are published")
+}
+
+func TestOrderService_BulkCreateOrders_BatchError_FailsEveryValidOrder(t *testing.T) {
+	validItems := []domain.OrderItem{{ProductID: "p-1", Name: "Widget", Quantity: 1, Price: 5}}
+	mockRepo := &mocks.OrderRepositoryMock{
+		CreateBatchFunc: func(_ context.Context, _ []*domain.Order) ([]error, error) {
+			return nil, errors.New("connection reset")
+		},
+	}
+	mockPublisher := &mocks.EventPublisherMock{
+		PublishOrderCreatedFunc: func(_ context.Context, _ *domain.Order) error {
+			t.Fatal("must not publish when nothing was inserted")
+			return nil
+		},
+	}
+
+	svc := NewOrderService(mockRepo, nil, nil, mockPublisher, nil, nil)
+	results := svc.BulkCreateOrders(context.Background(), []CreateOrderDTO{
+		{CustomerID: "customer-1", Items: validItems},
+		{CustomerID: "customer-2"},
+	})
+
+	require.Len(t, results, 2)
+	assert.EqualError(t, results[0].Err, "connection reset")
+	assert.ErrorIs(t, results[1].Err, domain.ErrNoItems)
+}
+
+func TestOrderService_BulkCreateOrders_NoValidOrders_SkipsRepository(t *testing.T) {
+	mockRepo := &mocks.OrderRepositoryMock{
+		CreateBatchFunc: func(_ context.Context, _ []*domain.Order) ([]error, error) {
+			t.Fatal("repository must not be called without valid orders")
+			return nil, nil
+		},
+	}
+
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	results := svc.BulkCreateOrders(context.Background(), []CreateOrderDTO{{CustomerID: "customer-1"}})
+
+	require.Len(t, results, 1)
+	assert.ErrorIs(t, results[0].Err, domain.ErrNoItems)
+}
+
+func TestOrderService_BulkUpdateOrderStatus_MixedResults_ContinuesAndPublishesPerTransition(t *testing.T) {
+	pending := createMockOrder(domain.OrderStatusPending)
+	shipped := createMockOrder(domain.OrderStatusShipped)
+	missingID := uuid.New().String()
+
+	orders := map[string]*domain.Order{
+		pending.ID.String(): pending,
+		shipped.ID.String(): shipped,
+	}
+
+	var published []string
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, id string) (*domain.Order, error) {
+			return orders[id], nil
+		},
+		UpdateFunc: func(_ context.Context, order *domain.Order) error {
+			order.Version++
+			return nil
+		},
+	}
+	mockPublisher := &mocks.EventPublisherMock{
+		PublishOrderStatusChangedFunc: func(_ context.Context, order *domain.Order, _, _ domain.OrderStatus) error {
+			published = append(published, order.ID.String())
+			return nil
+		},
+	}
+
+	svc := NewOrderService(mockRepo, nil, nil, mockPublisher, nil, nil)
+	results := svc.BulkUpdateOrderStatus(context.Background(),
+		[]string{pending.ID.String(), shipped.ID.String(), missingID, pending.ID.String()},
+		domain.OrderStatusCancelled)
+
+	require.Len(t, results, 3, "duplicate IDs are processed once")
+
+	assert.Equal(t, pending.ID.String(), results[0].OrderID)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, domain.OrderStatusCancelled, results[0].Order.Status)
+
+	assert.Equal(t, shipped.ID.String(), results[1].OrderID)
+	assert.ErrorIs(t, results[1].Err, domain.ErrInvalidTransition)
+	assert.Nil(t, results[1].Order)
+
+	assert.Equal(t, missingID, results[2].OrderID)
+	assert.ErrorIs(t, results[2].Err, domain.ErrOrderNotFound)
+
+	assert.Equal(t, []string{pending.ID.String()}, published, "one event per successful transition")
+}
+
+func createMockOrder(status domain.OrderStatus) *domain.Order {
+	return createMockOrderWithVersion(status, 1)
+}
+
+func createMockOrdersForCustomer(customerID string, count int) []*domain.Order {
+	orders := make([]*domain.Order, count)
+	for i := 0; i < count; i++ {
+		o := createMockOrder(domain.OrderStatusPending)
+		o.CustomerID = customerID
+		orders[i] = o
+	}
+	return orders
+}
+
+func createMockOrderWithVersion(status domain.OrderStatus, version int) *domain.Order {
+	return &domain.Order{
+		ID:         uuid.New(),
+		CustomerID: uuid.New().String(),
+		Items: []domain.OrderItem{
+			{
+				ID:        uuid.New(),
+				ProductID: "product-1",
+				Name:      "Test Product",
+				Quantity:  1,
+				Price:     10.00,
+				Subtotal:  10.00,
+			},
+		},
+		Status:    status,
+		Total:     10.00,
+		Version:   version,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// =============================================================================
+// Optimistic Locking Tests
+// =============================================================================
+
+func TestOrderService_UpdateOrderStatus_ConcurrentModification_ReturnsError(t *testing.T) {
+	orderID := uuid.New()
 
 	// Order with version 1
 	currentOrder := &domain.Order{
@@ -755,8 +1347,8 @@ func TestOrderService_UpdateOrderStatus_ConcurrentModification_ReturnsError(t *t
 		},
 	}
 
-	service := NewOrderService(mockRepo, nil, nil)
-	updatedOrder, err := service.UpdateOrderStatus(context.Background(), orderID.String(), domain.OrderStatusConfirmed)
+	service := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	updatedOrder, err := service.UpdateOrderStatus(context.Background(), orderID.String(), domain.OrderStatusConfirmed, nil)
 
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrConcurrentModification, err)
@@ -796,7 +1388,7 @@ func TestOrderService_UpdateOrder_ConcurrentModification_ReturnsError(t *testing
 		},
 	}
 
-	service := NewOrderService(mockRepo, nil, nil)
+	service := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
 
 	dto := UpdateOrderDTO{
 		Items: []domain.OrderItem{
@@ -850,8 +1442,8 @@ func TestOrderService_UpdateOrderStatus_VersionIncrementsOnSuccess(t *testing.T)
 		},
 	}
 
-	service := NewOrderService(mockRepo, nil, nil)
-	updatedOrder, err := service.UpdateOrderStatus(context.Background(), orderID.String(), domain.OrderStatusConfirmed)
+	service := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	updatedOrder, err := service.UpdateOrderStatus(context.Background(), orderID.String(), domain.OrderStatusConfirmed, nil)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, updatedOrder)
@@ -868,7 +1460,7 @@ func TestOrderService_CreateOrder_SetsInitialVersion(t *testing.T) {
 		},
 	}
 
-	service := NewOrderService(mockRepo, nil, nil)
+	service := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
 
 	dto := CreateOrderDTO{
 		CustomerID: uuid.New().String(),
@@ -926,8 +1518,8 @@ func TestOrderService_UpdateOrderStatus_PreservesVersionFromRead(t *testing.T) {
 		},
 	}
 
-	service := NewOrderService(mockRepo, nil, nil)
-	updatedOrder, err := service.UpdateOrderStatus(context.Background(), orderID.String(), domain.OrderStatusShipped)
+	service := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	updatedOrder, err := service.UpdateOrderStatus(context.Background(), orderID.String(), domain.OrderStatusShipped, nil)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, updatedOrder)
@@ -968,13 +1560,13 @@ func TestOrderService_GetOrderByID_CacheHit_ReturnsCachedOrder(t *testing.T) {
 		},
 	}
 	mockCache := &mocks.OrderCacheMock{
-		GetFunc: func(_ context.Context, id string) (*domain.Order, error) {
+		GetFunc: func(_ context.Context, _, id string) (*domain.Order, error) {
 			assert.Equal(t, orderID.String(), id)
 			return cachedOrder, nil
 		},
 	}
 
-	svc := NewOrderService(mockRepo, mockCache, nil)
+	svc := NewOrderService(mockRepo, nil, mockCache, nil, nil, nil)
 	order, err := svc.GetOrderByID(context.Background(), orderID.String())
 
 	assert.NoError(t, err)
@@ -1011,7 +1603,7 @@ func TestOrderService_GetOrderByID_CacheMiss_FetchesFromRepoAndPopulatesCache(t
 		},
 	}
 	mockCache := &mocks.OrderCacheMock{
-		GetFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+		GetFunc: func(_ context.Context, _, _ string) (*domain.Order, error) {
 			return nil, nil // cache miss
 		},
 		SetFunc: func(_ context.Context, order *domain.Order, ttl time.Duration) error {
@@ -1021,7 +1613,7 @@ func TestOrderService_GetOrderByID_CacheMiss_FetchesFromRepoAndPopulatesCache(t
 		},
 	}
 
-	svc := NewOrderService(mockRepo, mockCache, nil)
+	svc := NewOrderService(mockRepo, nil, mockCache, nil, nil, nil)
 	order, err := svc.GetOrderByID(context.Background(), orderID.String())
 
 	assert.NoError(t, err)
@@ -1030,6 +1622,32 @@ func TestOrderService_GetOrderByID_CacheMiss_FetchesFromRepoAndPopulatesCache(t
 	assert.Equal(t, 5*time.Minute, cachedTTL, "cache TTL should be 5 minutes")
 }
 
+func TestOrderService_GetOrderByID_CacheMiss_UsesConfiguredTTL(t *testing.T) {
+	repoOrder := &domain.Order{ID: uuid.New(), CustomerID: "customer-1", Status: domain.OrderStatusPending}
+
+	var cachedTTL time.Duration
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+			return repoOrder, nil
+		},
+	}
+	mockCache := &mocks.OrderCacheMock{
+		GetFunc: func(_ context.Context, _, _ string) (*domain.Order, error) {
+			return nil, nil
+		},
+		SetFunc: func(_ context.Context, _ *domain.Order, ttl time.Duration) error {
+			cachedTTL = ttl
+			return nil
+		},
+	}
+
+	svc := NewOrderService(mockRepo, nil, mockCache, nil, nil, StaticConfig{OrderCacheTTL: 30 * time.Second, MaxPageSize: 100})
+	_, err := svc.GetOrderByID(context.Background(), repoOrder.ID.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, cachedTTL)
+}
+
 func TestOrderService_GetOrderByID_CacheError_FallsThrough(t *testing.T) {
 	orderID := uuid.New()
 	repoOrder := &domain.Order{
@@ -1057,7 +1675,7 @@ func TestOrderService_GetOrderByID_CacheError_FallsThrough(t *testing.T) {
 		},
 	}
 	mockCache := &mocks.OrderCacheMock{
-		GetFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+		GetFunc: func(_ context.Context, _, _ string) (*domain.Order, error) {
 			return nil, fmt.Errorf("redis connection refused")
 		},
 		SetFunc: func(_ context.Context, _ *domain.Order, _ time.Duration) error {
@@ -1065,13 +1683,72 @@ func TestOrderService_GetOrderByID_CacheError_FallsThrough(t *testing.T) {
 		},
 	}
 
-	svc := NewOrderService(mockRepo, mockCache, nil)
+	svc := NewOrderService(mockRepo, nil, mockCache, nil, nil, nil)
 	order, err := svc.GetOrderByID(context.Background(), orderID.String())
 
 	assert.NoError(t, err)
 	assert.Equal(t, repoOrder, order, "should fall through to repo on cache error")
 }
 
+func TestOrderService_GetOrderByID_ConcurrentMisses_QueryOnce(t *testing.T) {
+	const callers = 10
+	orderID := uuid.New()
+	var queries, lookups atomic.Int32
+	release := make(chan struct{})
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+			queries.Add(1)
+			<-release
+			return &domain.Order{ID: orderID, Items: []domain.OrderItem{{Name: "Widget"}}}, nil
+		},
+	}
+	mockCache := &mocks.OrderCacheMock{
+		GetFunc: func(_ context.Context, _, _ string) (*domain.Order, error) {
+			lookups.Add(1)
+			return nil, nil
+		},
+	}
+	svc := NewOrderService(mockRepo, nil, mockCache, nil, nil, nil)
+
+	orders := make([]*domain.Order, callers)
+	var wg sync.WaitGroup
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			order, err := svc.GetOrderByID(context.Background(), orderID.String())
+			assert.NoError(t, err)
+			orders[i] = order
+		}()
+	}
+	// Let every caller miss the cache and join the in-flight load
+	require.Eventually(t, func() bool { return lookups.Load() == callers }, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), queries.Load(), "concurrent misses should share one query")
+	orders[0].Items[0].Name = "changed"
+	assert.Equal(t, "Widget", orders[1].Items[0].Name, "callers must not share the loaded order")
+}
+
+func TestOrderService_GetOrderByID_CallerCanceled_StopsWaiting(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+			<-release
+			return &domain.Order{}, nil
+		},
+	}
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := svc.GetOrderByID(ctx, uuid.New().String())
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
 func TestOrderService_UpdateOrderStatus_InvalidatesCache(t *testing.T) {
 	orderID := uuid.New()
 	currentOrder := &domain.Order{
@@ -1103,14 +1780,14 @@ func TestOrderService_UpdateOrderStatus_InvalidatesCache(t *testing.T) {
 		},
 	}
 	mockCache := &mocks.OrderCacheMock{
-		DeleteFunc: func(_ context.Context, id string) error {
+		DeleteFunc: func(_ context.Context, _, id string) error {
 			deletedID = id
 			return nil
 		},
 	}
 
-	svc := NewOrderService(mockRepo, mockCache, nil)
-	_, err := svc.UpdateOrderStatus(context.Background(), orderID.String(), domain.OrderStatusConfirmed)
+	svc := NewOrderService(mockRepo, nil, mockCache, nil, nil, nil)
+	_, err := svc.UpdateOrderStatus(context.Background(), orderID.String(), domain.OrderStatusConfirmed, nil)
 
 	assert.NoError(t, err)
 	assert.Equal(t, orderID.String(), deletedID, "cache should be invalidated after status update")
@@ -1146,13 +1823,13 @@ func TestOrderService_UpdateOrderStatus_CacheDeleteError_NonFatal(t *testing.T)
 		},
 	}
 	mockCache := &mocks.OrderCacheMock{
-		DeleteFunc: func(_ context.Context, _ string) error {
+		DeleteFunc: func(_ context.Context, _, _ string) error {
 			return errors.New("redis timeout")
 		},
 	}
 
-	svc := NewOrderService(mockRepo, mockCache, nil)
-	order, err := svc.UpdateOrderStatus(context.Background(), orderID.String(), domain.OrderStatusConfirmed)
+	svc := NewOrderService(mockRepo, nil, mockCache, nil, nil, nil)
+	order, err := svc.UpdateOrderStatus(context.Background(), orderID.String(), domain.OrderStatusConfirmed, nil)
 
 	assert.NoError(t, err, "cache delete error should not fail the update")
 	assert.NotNil(t, order)
@@ -1175,7 +1852,7 @@ func TestOrderService_CreateOrder_PublishesCreatedEvent(t *testing.T) {
 		},
 	}
 
-	svc := NewOrderService(mockRepo, nil, mockPublisher)
+	svc := NewOrderService(mockRepo, nil, nil, mockPublisher, nil, nil)
 	order, err := svc.CreateOrder(context.Background(), CreateOrderDTO{
 		CustomerID: uuid.New().String(),
 		Items: []domain.OrderItem{
@@ -1198,7 +1875,7 @@ func TestOrderService_CreateOrder_PublishError_NonFatal(t *testing.T) {
 		},
 	}
 
-	svc := NewOrderService(mockRepo, nil, mockPublisher)
+	svc := NewOrderService(mockRepo, nil, nil, mockPublisher, nil, nil)
 	order, err := svc.CreateOrder(context.Background(), CreateOrderDTO{
 		CustomerID: uuid.New().String(),
 		Items: []domain.OrderItem{
@@ -1237,8 +1914,8 @@ func TestOrderService_UpdateOrderStatus_PublishesStatusChangedEvent(t *testing.T
 		},
 	}
 
-	svc := NewOrderService(mockRepo, nil, mockPublisher)
-	_, err := svc.UpdateOrderStatus(context.Background(), orderID.String(), domain.OrderStatusConfirmed)
+	svc := NewOrderService(mockRepo, nil, nil, mockPublisher, nil, nil)
+	_, err := svc.UpdateOrderStatus(context.Background(), orderID.String(), domain.OrderStatusConfirmed, nil)
 
 	assert.NoError(t, err)
 	assert.Equal(t, domain.OrderStatusPending, capturedOld)
@@ -1271,7 +1948,7 @@ func TestOrderService_UpdateOrder_PublishesUpdatedEvent(t *testing.T) {
 		},
 	}
 
-	svc := NewOrderService(mockRepo, nil, mockPublisher)
+	svc := NewOrderService(mockRepo, nil, nil, mockPublisher, nil, nil)
 	_, err := svc.UpdateOrder(context.Background(), orderID.String(), UpdateOrderDTO{
 		Items: []domain.OrderItem{
 			{ProductID: "p-2", Name: "New Product", Quantity: 2, Price: 20.00},
@@ -1287,7 +1964,7 @@ func TestOrderService_CreateOrder_NilPublisher_NoError(t *testing.T) {
 		CreateFunc: func(_ context.Context, _ *domain.Order) error { return nil },
 	}
 
-	svc := NewOrderService(mockRepo, nil, nil)
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
 	order, err := svc.CreateOrder(context.Background(), CreateOrderDTO{
 		CustomerID: uuid.New().String(),
 		Items: []domain.OrderItem{
@@ -1298,3 +1975,595 @@ func TestOrderService_CreateOrder_NilPublisher_NoError(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, order)
 }
+
+// =============================================================================
+// Delete Tests
+// =============================================================================
+
+func TestOrderService_DeleteOrder_PublishesDeletedEventAndInvalidatesCache(t *testing.T) {
+	orderID := uuid.New()
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+			return &domain.Order{ID: orderID, Status: domain.OrderStatusPending, Version: 2}, nil
+		},
+		DeleteFunc: func(_ context.Context, _ string) error {
+			return nil
+		},
+	}
+	var published *domain.Order
+	mockPublisher := &mocks.EventPublisherMock{
+		PublishOrderDeletedFunc: func(_ context.Context, order *domain.Order) error {
+			published = order
+			return nil
+		},
+	}
+	var evicted string
+	mockCache := &mocks.OrderCacheMock{
+		DeleteFunc: func(_ context.Context, _, id string) error {
+			evicted = id
+			return nil
+		},
+	}
+
+	svc := NewOrderService(mockRepo, nil, mockCache, mockPublisher, nil, nil)
+	err := svc.DeleteOrder(context.Background(), orderID.String())
+
+	require.NoError(t, err)
+	require.NotNil(t, published, "should publish order.deleted event")
+	assert.Equal(t, orderID, published.ID)
+	assert.Equal(t, 3, published.Version, "event should carry the post-delete version")
+	assert.NotNil(t, published.DeletedAt)
+	assert.Equal(t, orderID.String(), evicted)
+}
+
+func TestOrderService_DeleteOrder_PublishError_NonFatal(t *testing.T) {
+	orderID := uuid.New()
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+			return &domain.Order{ID: orderID, Status: domain.OrderStatusPending, Version: 1}, nil
+		},
+		DeleteFunc: func(_ context.Context, _ string) error {
+			return nil
+		},
+	}
+	mockPublisher := &mocks.EventPublisherMock{
+		PublishOrderDeletedFunc: func(_ context.Context, _ *domain.Order) error {
+			return errors.New("broker down")
+		},
+	}
+	var evicted string
+	mockCache := &mocks.OrderCacheMock{
+		DeleteFunc: func(_ context.Context, _, id string) error {
+			evicted = id
+			return nil
+		},
+	}
+
+	svc := NewOrderService(mockRepo, nil, mockCache, mockPublisher, nil, nil)
+	err := svc.DeleteOrder(context.Background(), orderID.String())
+
+	assert.NoError(t, err, "publish failure must not fail the delete")
+	assert.Equal(t, orderID.String(), evicted, "cache should still be invalidated")
+}
+
+func TestOrderService_DeleteOrder_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		order     *domain.Order
+		deleteErr error
+		wantErr   error
+	}{
+		{name: "not found", wantErr: domain.ErrOrderNotFound},
+		{name: "repository delete fails", order: &domain.Order{ID: uuid.New()}, deleteErr: errors.New("db down")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+					return tt.order, nil
+				},
+				DeleteFunc: func(_ context.Context, _ string) error {
+					return tt.deleteErr
+				},
+			}
+			mockPublisher := &mocks.EventPublisherMock{
+				PublishOrderDeletedFunc: func(_ context.Context, _ *domain.Order) error {
+					t.Fatal("must not publish when nothing was deleted")
+					return nil
+				},
+			}
+
+			svc := NewOrderService(mockRepo, nil, nil, mockPublisher, nil, nil)
+			err := svc.DeleteOrder(context.Background(), uuid.New().String())
+
+			require.Error(t, err)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.ErrorIs(t, err, tt.deleteErr)
+			}
+		})
+	}
+}
+
+// =============================================================================
+// Unit of Work Tests
+// =============================================================================
+
+// txKey marks contexts handed out by txRecorder
+type txKey struct{}
+
+// txRecorder returns a UnitOfWork mock that tags the context passed to fn,
+// so repository mocks can tell whether they run inside the transaction, and
+// that fails with commitErr after fn succeeds.
+func txRecorder(commitErr error) *mocks.UnitOfWorkMock {
+	return &mocks.UnitOfWorkMock{
+		WithTxFunc: func(ctx context.Context, fn func(ctx context.Context) error) error {
+			if err := fn(context.WithValue(ctx, txKey{}, true)); err != nil {
+				return err
+			}
+			return commitErr
+		},
+	}
+}
+
+func inTx(ctx context.Context) bool {
+	v, _ := ctx.Value(txKey{}).(bool)
+	return v
+}
+
+// countingPublisher counts the update, status and delete events published
+func countingPublisher(published *int) *mocks.EventPublisherMock {
+	count := func(_ context.Context, _ *domain.Order) error {
+		*published++
+		return nil
+	}
+	return &mocks.EventPublisherMock{
+		PublishOrderUpdatedFunc: count,
+		PublishOrderStatusChangedFunc: func(ctx context.Context, order *domain.Order, _, _ domain.OrderStatus) error {
+			return count(ctx, order)
+		},
+		PublishOrderDeletedFunc: count,
+	}
+}
+
+func TestOrderService_Mutations_RunReadAndWriteInTx(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(svc OrderService, id string) error
+	}{
+		{
+			name: "update order",
+			call: func(svc OrderService, id string) error {
+				status := domain.OrderStatusConfirmed
+				_, err := svc.UpdateOrder(context.Background(), id, UpdateOrderDTO{Status: &status})
+				return err
+			},
+		},
+		{
+			name: "update order status",
+			call: func(svc OrderService, id string) error {
+				_, err := svc.UpdateOrderStatus(context.Background(), id, domain.OrderStatusConfirmed, nil)
+				return err
+			},
+		},
+		{
+			name: "delete order",
+			call: func(svc OrderService, id string) error {
+				return svc.DeleteOrder(context.Background(), id)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := createMockOrder(domain.OrderStatusPending)
+			var calls []string
+			record := func(ctx context.Context, op string) {
+				if !inTx(ctx) {
+					op += " outside tx"
+				}
+				calls = append(calls, op)
+			}
+
+			mockRepo := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(ctx context.Context, _ string) (*domain.Order, error) {
+					record(ctx, "find")
+					return order, nil
+				},
+				UpdateFunc: func(ctx context.Context, _ *domain.Order) error {
+					record(ctx, "write")
+					return nil
+				},
+				DeleteFunc: func(ctx context.Context, _ string) error {
+					record(ctx, "write")
+					return nil
+				},
+			}
+			published := 0
+
+			svc := NewOrderService(mockRepo, txRecorder(nil), nil, countingPublisher(&published), nil, nil)
+			require.NoError(t, tt.call(svc, order.ID.String()))
+
+			assert.Equal(t, []string{"find", "write"}, calls)
+			assert.Equal(t, 1, published)
+		})
+	}
+}
+
+func TestOrderService_Mutations_CommitError_NotPublished(t *testing.T) {
+	commitErr := errors.New("commit failed")
+	order := createMockOrder(domain.OrderStatusPending)
+
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+			return order, nil
+		},
+	}
+	evicted := 0
+	mockCache := &mocks.OrderCacheMock{
+		DeleteFunc: func(_ context.Context, _, _ string) error {
+			evicted++
+			return nil
+		},
+	}
+	published := 0
+
+	svc := NewOrderService(mockRepo, txRecorder(commitErr), mockCache, countingPublisher(&published), nil, nil)
+
+	_, err := svc.UpdateOrderStatus(context.Background(), order.ID.String(), domain.OrderStatusConfirmed, nil)
+	assert.ErrorIs(t, err, commitErr)
+
+	err = svc.DeleteOrder(context.Background(), order.ID.String())
+	assert.ErrorIs(t, err, commitErr)
+
+	assert.Zero(t, published)
+	assert.Zero(t, evicted)
+}
+
+// =============================================================================
+// Authorization Tests
+// =============================================================================
+
+func customerCtx(customerID string) context.Context {
+	return domain.WithPrincipal(context.Background(), &domain.Principal{Subject: "user-1", Role: domain.RoleCustomer, CustomerID: customerID})
+}
+
+func TestOrderService_CustomerPrincipal_OrderOwnership(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(svc OrderService, ctx context.Context, id string) error
+	}{
+		{
+			name: "get order",
+			call: func(svc OrderService, ctx context.Context, id string) error {
+				_, err := svc.GetOrderByID(ctx, id)
+				return err
+			},
+		},
+		{
+			name: "update order",
+			call: func(svc OrderService, ctx context.Context, id string) error {
+				status := domain.OrderStatusConfirmed
+				_, err := svc.UpdateOrder(ctx, id, UpdateOrderDTO{Status: &status})
+				return err
+			},
+		},
+		{
+			name: "update order status",
+			call: func(svc OrderService, ctx context.Context, id string) error {
+				_, err := svc.UpdateOrderStatus(ctx, id, domain.OrderStatusConfirmed, nil)
+				return err
+			},
+		},
+		{
+			name: "delete order",
+			call: func(svc OrderService, ctx context.Context, id string) error {
+				return svc.DeleteOrder(ctx, id)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := createMockOrder(domain.OrderStatusPending)
+			writes := 0
+			mockRepo := &mocks.OrderRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+					return order.Clone(), nil
+				},
+				UpdateFunc: func(_ context.Context, _ *domain.Order) error {
+					writes++
+					return nil
+				},
+				DeleteFunc: func(_ context.Context, _ string) error {
+					writes++
+					return nil
+				},
+			}
+			svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+
+			err := tt.call(svc, customerCtx("someone-else"), order.ID.String())
+			assert.ErrorIs(t, err, domain.ErrAccessDenied)
+			assert.Zero(t, writes, "a denied call must not write")
+
+			assert.NoError(t, tt.call(svc, customerCtx(order.CustomerID), order.ID.String()))
+			assert.NoError(t, tt.call(svc, context.Background(), order.ID.String()), "calls without a principal are not restricted")
+		})
+	}
+}
+
+func TestOrderService_CreateOrder_CustomerPrincipal_OwnCustomerOnly(t *testing.T) {
+	created := 0
+	mockRepo := &mocks.OrderRepositoryMock{
+		CreateFunc: func(_ context.Context, _ *domain.Order) error {
+			created++
+			return nil
+		},
+	}
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	dto := CreateOrderDTO{
+		CustomerID: "cust-1",
+		Items:      []domain.OrderItem{{ProductID: "product-1", Name: "Test Product", Quantity: 1, Price: 10}},
+	}
+
+	_, err := svc.CreateOrder(customerCtx("cust-2"), dto)
+	assert.ErrorIs(t, err, domain.ErrAccessDenied)
+
+	_, err = svc.CreateOrder(customerCtx("cust-1"), dto)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, created)
+}
+
+func TestOrderService_ListOrders_CustomerPrincipal_ScopedToOwnOrders(t *testing.T) {
+	var gotCustomer string
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByCustomerIDFunc: func(_ context.Context, customerID string, _ repository.ListOptions) ([]*domain.Order, int64, error) {
+			gotCustomer = customerID
+			return createMockOrdersForCustomer(customerID, 1), 1, nil
+		},
+		ListFunc: func(_ context.Context, _ repository.ListOptions) ([]*domain.Order, int64, error) {
+			t.Fatal("a customer principal must not list every order")
+			return nil, 0, nil
+		},
+	}
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+
+	result, err := svc.ListOrders(customerCtx("cust-1"), ListOrdersRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "cust-1", gotCustomer)
+	assert.Len(t, result.Data, 1)
+
+	other := "cust-2"
+	_, err = svc.ListOrders(customerCtx("cust-1"), ListOrdersRequest{CustomerID: &other})
+	assert.ErrorIs(t, err, domain.ErrAccessDenied)
+}
+
+// =============================================================================
+// Restore Tests
+// =============================================================================
+
+func TestOrderService_RestoreOrder_PublishesRestoredEventAndInvalidatesCache(t *testing.T) {
+	orderID := uuid.New()
+	var gotVersion *int
+	mockRepo := &mocks.OrderRepositoryMock{
+		RestoreFunc: func(_ context.Context, _ string, expectedVersion *int) (*domain.Order, error) {
+			gotVersion = expectedVersion
+			return &domain.Order{ID: orderID, Status: domain.OrderStatusPending, Version: 3}, nil
+		},
+	}
+	var published *domain.Order
+	mockPublisher := &mocks.EventPublisherMock{
+		PublishOrderRestoredFunc: func(_ context.Context, order *domain.Order) error {
+			published = order
+			return nil
+		},
+	}
+	var evicted string
+	mockCache := &mocks.OrderCacheMock{
+		DeleteFunc: func(_ context.Context, _, id string) error {
+			evicted = id
+			return nil
+		},
+	}
+
+	svc := NewOrderService(mockRepo, nil, mockCache, mockPublisher, nil, nil)
+	order, err := svc.RestoreOrder(context.Background(), orderID.String(), intPtr(2))
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, order.Version)
+	assert.Equal(t, intPtr(2), gotVersion, "expected version must reach the repository")
+	assert.Equal(t, order, published, "should publish order.restored event")
+	assert.Equal(t, orderID.String(), evicted)
+}
+
+func TestOrderService_RestoreOrder_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		restoreErr error
+		wantErr    error
+	}{
+		{name: "invalid id", id: "not-a-uuid", wantErr: domain.ErrOrderNotFound},
+		{name: "not deleted or missing", id: uuid.New().String(), wantErr: domain.ErrOrderNotFound},
+		{name: "version mismatch", id: uuid.New().String(), restoreErr: domain.ErrVersionMismatch, wantErr: domain.ErrVersionMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mocks.OrderRepositoryMock{
+				RestoreFunc: func(_ context.Context, _ string, _ *int) (*domain.Order, error) {
+					return nil, tt.restoreErr
+				},
+			}
+			mockPublisher := &mocks.EventPublisherMock{
+				PublishOrderRestoredFunc: func(_ context.Context, _ *domain.Order) error {
+					t.Fatal("must not publish when nothing was restored")
+					return nil
+				},
+			}
+
+			svc := NewOrderService(mockRepo, nil, nil, mockPublisher, nil, nil)
+			order, err := svc.RestoreOrder(context.Background(), tt.id, intPtr(1))
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, order)
+		})
+	}
+}
+
+func TestOrderService_CreateOrder_Tenant_StampsOrderAndScopesCache(t *testing.T) {
+	var stored *domain.Order
+	mockRepo := &mocks.OrderRepositoryMock{
+		CreateFunc: func(_ context.Context, order *domain.Order) error {
+			stored = order
+			return nil
+		},
+	}
+	var patterns []string
+	mockCache := &mocks.OrderCacheMock{
+		DeletePatternFunc: func(_ context.Context, pattern string) error {
+			patterns = append(patterns, pattern)
+			return nil
+		},
+	}
+	svc := NewOrderService(mockRepo, nil, mockCache, nil, nil, nil)
+	dto := CreateOrderDTO{
+		CustomerID: "cust-1",
+		Items:      []domain.OrderItem{{ProductID: "product-1", Name: "Test Product", Quantity: 1, Price: 10}},
+	}
+
+	order, err := svc.CreateOrder(domain.WithTenant(context.Background(), "acme"), dto)
+
+	require.NoError(t, err)
+	assert.Equal(t, "acme", order.TenantID)
+	assert.Equal(t, "acme", stored.TenantID)
+	assert.Equal(t, []string{cache.CustomerListPattern("acme", "cust-1")}, patterns)
+}
+
+func TestOrderService_GetOrderByID_Tenant_ReadsOnlyTenantCache(t *testing.T) {
+	orderID := uuid.New().String()
+	entries := map[string]*domain.Order{
+		cache.OrderKey("globex", orderID): {ID: uuid.MustParse(orderID), TenantID: "globex", CustomerID: "customer-1"},
+	}
+	mockCache := &mocks.OrderCacheMock{
+		GetFunc: func(_ context.Context, tenantID, id string) (*domain.Order, error) {
+			return entries[cache.OrderKey(tenantID, id)], nil
+		},
+	}
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+			// The repository filters by the tenant in ctx
+			return nil, nil
+		},
+	}
+	svc := NewOrderService(mockRepo, nil, mockCache, nil, nil, nil)
+
+	_, err := svc.GetOrderByID(domain.WithTenant(context.Background(), "acme"), orderID)
+	assert.ErrorIs(t, err, domain.ErrOrderNotFound)
+
+	order, err := svc.GetOrderByID(domain.WithTenant(context.Background(), "globex"), orderID)
+	require.NoError(t, err)
+	assert.Equal(t, "globex", order.TenantID)
+}
+
+func TestOrderService_DeleteOrder_Tenant_EvictsTenantEntry(t *testing.T) {
+	orderID := uuid.New()
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+			return &domain.Order{ID: orderID, TenantID: "acme", Status: domain.OrderStatusPending, Version: 1}, nil
+		},
+	}
+	var evictedTenant string
+	mockCache := &mocks.OrderCacheMock{
+		DeleteFunc: func(_ context.Context, tenantID, _ string) error {
+			evictedTenant = tenantID
+			return nil
+		},
+	}
+	svc := NewOrderService(mockRepo, nil, mockCache, nil, nil, nil)
+
+	err := svc.DeleteOrder(domain.WithTenant(context.Background(), "acme"), orderID.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, "acme", evictedTenant)
+}
+
+// =============================================================================
+// Include Deleted Tests
+// =============================================================================
+
+func TestOrderService_GetOrderByIDIncludingDeleted_ReturnsDeletedOrderBypassingCache(t *testing.T) {
+	deletedAt := time.Now()
+	deleted := &domain.Order{ID: uuid.New(), CustomerID: "customer-1", DeletedAt: &deletedAt}
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDIncludingDeletedFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+			return deleted, nil
+		},
+	}
+	mockCache := &mocks.OrderCacheMock{
+		GetFunc: func(_ context.Context, _, _ string) (*domain.Order, error) {
+			t.Fatal("cache must not be read for deleted orders")
+			return nil, nil
+		},
+	}
+
+	svc := NewOrderService(mockRepo, nil, mockCache, nil, nil, nil)
+	order, err := svc.GetOrderByIDIncludingDeleted(context.Background(), deleted.ID.String())
+
+	require.NoError(t, err)
+	assert.Same(t, deleted, order)
+}
+
+func TestOrderService_GetOrderByIDIncludingDeleted_Missing_ReturnsErrOrderNotFound(t *testing.T) {
+	svc := NewOrderService(&mocks.OrderRepositoryMock{}, nil, nil, nil, nil, nil)
+
+	_, err := svc.GetOrderByIDIncludingDeleted(context.Background(), uuid.NewString())
+
+	assert.ErrorIs(t, err, domain.ErrOrderNotFound)
+}
+
+func TestOrderService_IncludeDeleted_CustomerPrincipal_ReturnsErrAccessDenied(t *testing.T) {
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDIncludingDeletedFunc: func(_ context.Context, _ string) (*domain.Order, error) {
+			t.Fatal("repository must not be queried for a customer principal")
+			return nil, nil
+		},
+		FindByCustomerIDFunc: func(_ context.Context, _ string, _ repository.ListOptions) ([]*domain.Order, int64, error) {
+			t.Fatal("repository must not be queried for a customer principal")
+			return nil, 0, nil
+		},
+	}
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	ctx := customerCtx("customer-1")
+
+	_, err := svc.GetOrderByIDIncludingDeleted(ctx, uuid.NewString())
+	assert.ErrorIs(t, err, domain.ErrAccessDenied)
+
+	_, err = svc.ListOrders(ctx, ListOrdersRequest{Page: 1, PageSize: 20, IncludeDeleted: true})
+	assert.ErrorIs(t, err, domain.ErrAccessDenied)
+}
+
+func TestOrderService_ListOrders_IncludeDeleted_PassedToRepositoryAndNotCached(t *testing.T) {
+	customerID := "customer-1"
+	var got repository.ListOptions
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByCustomerIDFunc: func(_ context.Context, _ string, opts repository.ListOptions) ([]*domain.Order, int64, error) {
+			got = opts
+			return createMockOrders(1), 1, nil
+		},
+	}
+	mockCache := &mocks.OrderCacheMock{
+		GetListFunc: func(_ context.Context, _ string) (*domain.PaginatedOrders, error) {
+			t.Fatal("customer pages including deleted orders must not be cached")
+			return nil, nil
+		},
+	}
+
+	svc := NewOrderService(mockRepo, nil, mockCache, nil, nil, nil)
+	_, err := svc.ListOrders(context.Background(), ListOrdersRequest{Page: 1, PageSize: 20, CustomerID: &customerID, IncludeDeleted: true})
+
+	require.NoError(t, err)
+	assert.True(t, got.IncludeDeleted)
+}
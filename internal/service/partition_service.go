@@ -0,0 +1,85 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// PartitionService keeps the monthly partitions of a partitioned orders table
+// created ahead of the orders written into them
+type PartitionService interface {
+	// MaintainPartitions creates the missing partitions for the current month
+	// and the configured number of months ahead, returning their names. It
+	// does nothing while the orders table is not partitioned.
+	MaintainPartitions(ctx context.Context) ([]string, error)
+
+	// Run maintains partitions immediately and then every interval until ctx is cancelled
+	Run(ctx context.Context, interval time.Duration)
+}
+
+// partitionServiceImpl implements PartitionService
+type partitionServiceImpl struct {
+	repo        repository.PartitionRepository
+	monthsAhead int
+	now         func() time.Time
+}
+
+// NewPartitionService creates a new PartitionService
+func NewPartitionService(repo repository.PartitionRepository, monthsAhead int) PartitionService {
+	return &partitionServiceImpl{
+		repo:        repo,
+		monthsAhead: monthsAhead,
+		now:         time.Now,
+	}
+}
+
+func (s *partitionServiceImpl) MaintainPartitions(ctx context.Context) ([]string, error) {
+	partitioned, err := s.repo.IsPartitioned(ctx)
+	if err != nil || !partitioned {
+		return nil, err
+	}
+
+	created, err := s.repo.EnsurePartitions(ctx, s.now().UTC(), s.monthsAhead)
+	if err != nil {
+		return nil, err
+	}
+	if len(created) > 0 {
+		slog.Info("created order partitions", slog.Any("partitions", created))
+	}
+	return created, nil
+}
+
+func (s *partitionServiceImpl) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// A missing partition rejects inserts for its month, so don't wait a full interval after startup
+	for {
+		if _, err := s.MaintainPartitions(ctx); err != nil && ctx.Err() == nil {
+			slog.Warn("order partition maintenance failed", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionService_MaintainPartitions_EnsuresMonthsAhead(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+	var gotFrom time.Time
+	var gotMonths int
+	repo := &mocks.PartitionRepositoryMock{
+		IsPartitionedFunc: func(_ context.Context) (bool, error) {
+			return true, nil
+		},
+		EnsurePartitionsFunc: func(_ context.Context, from time.Time, monthsAhead int) ([]string, error) {
+			gotFrom, gotMonths = from, monthsAhead
+			return []string{"orders_p202701"}, nil
+		},
+	}
+
+	svc := &partitionServiceImpl{
+		repo:        repo,
+		monthsAhead: 3,
+		now:         func() time.Time { return now },
+	}
+	created, err := svc.MaintainPartitions(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"orders_p202701"}, created)
+	assert.Equal(t, 3, gotMonths)
+	assert.Equal(t, time.UTC, gotFrom.Location())
+	assert.True(t, now.Equal(gotFrom))
+}
+
+func TestPartitionService_MaintainPartitions_Unpartitioned_DoesNothing(t *testing.T) {
+	repo := &mocks.PartitionRepositoryMock{
+		IsPartitionedFunc: func(_ context.Context) (bool, error) {
+			return false, nil
+		},
+		EnsurePartitionsFunc: func(_ context.Context, _ time.Time, _ int) ([]string, error) {
+			t.Fatal("partitions must not be created for an unpartitioned table")
+			return nil, nil
+		},
+	}
+
+	created, err := NewPartitionService(repo, 3).MaintainPartitions(context.Background())
+
+	require.NoError(t, err)
+	assert.Empty(t, created)
+}
+
+func TestPartitionService_MaintainPartitions_RepositoryError_ReturnsError(t *testing.T) {
+	dbErr := errors.New("connection refused")
+	tests := []struct {
+		name string
+		repo *mocks.PartitionRepositoryMock
+	}{
+		{
+			name: "partitioning check fails",
+			repo: &mocks.PartitionRepositoryMock{
+				IsPartitionedFunc: func(_ context.Context) (bool, error) { return false, dbErr },
+			},
+		},
+		{
+			name: "partition creation fails",
+			repo: &mocks.PartitionRepositoryMock{
+				IsPartitionedFunc: func(_ context.Context) (bool, error) { return true, nil },
+				EnsurePartitionsFunc: func(_ context.Context, _ time.Time, _ int) ([]string, error) {
+					return nil, dbErr
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewPartitionService(tt.repo, 3).MaintainPartitions(context.Background())
+
+			assert.ErrorIs(t, err, dbErr)
+		})
+	}
+}
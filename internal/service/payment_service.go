@@ -0,0 +1,228 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// PaymentProvider collects payments through an external payment service
+type PaymentProvider interface {
+	// Name identifies the provider, e.g. "stripe"
+	Name() string
+
+	// Charge asks the provider to collect req.Amount. A declined payment is
+	// a payment with domain.PaymentFailed, not an error; an error means the
+	// outcome is unknown, and wraps domain.ErrPaymentProviderUnavailable
+	// when the provider could not be reached. Charges with the same
+	// IdempotencyKey are collected once.
+	Charge(ctx context.Context, req ChargeRequest) (*domain.Payment, error)
+
+	// SignatureHeader names the request header the provider signs its
+	// webhooks in
+	SignatureHeader() string
+
+	// ParseWebhook checks that payload is signed by the provider and returns
+	// the payment it reports, or nil for a webhook about anything else.
+	// Webhooks that are not signed or cannot be read return
+	// domain.ErrInvalidPaymentWebhook.
+	ParseWebhook(payload []byte, signature string) (*domain.Payment, error)
+}
+
+// ChargeRequest describes a payment to collect
+type ChargeRequest struct {
+	OrderID  string
+	TenantID string
+	Amount   domain.Money
+	Currency string
+	// PaymentMethod is the provider's token of the customer's card or
+	// account, e.g. a Stripe PaymentMethod ID
+	PaymentMethod  string
+	IdempotencyKey string
+}
+
+// PaymentResult is a payment and the order after it was applied
+type PaymentResult struct {
+	Payment *domain.Payment
+	Order   *domain.Order
+}
+
+// PaymentService pays orders through the configured PaymentProvider and
+// moves them along as payments succeed or fail. A successful payment
+// confirms a pending order; a failed one puts it on hold with the reason
+// from domain.Payment.HoldReason, until the customer pays again or an
+// operator releases it.
+type PaymentService interface {
+	// PayOrder charges the total of a pending order, or of one held after a
+	// failed payment, to paymentMethod and applies the outcome. A pending
+	// payment leaves the order pending until the provider's webhook reports
+	// the outcome. Returns domain.ErrPaymentsDisabled without a provider,
+	// domain.ErrOrderNotPayable for orders in any other status and
+	// domain.ErrPaymentProviderUnavailable when the provider cannot be
+	// reached, which leaves the order as it was.
+	PayOrder(ctx context.Context, id string, paymentMethod string) (*PaymentResult, error)
+
+	// HandleWebhook applies the payment reported by a provider webhook.
+	// Providers deliver webhooks at least once and not always in order, so
+	// a payment that no longer fits the order's status changes nothing.
+	HandleWebhook(ctx context.Context, payload []byte, signature string) error
+
+	// WebhookSignatureHeader names the request header webhooks are signed
+	// in, or "" without a provider
+	WebhookSignatureHeader() string
+}
+
+// paymentServiceImpl implements PaymentService
+type paymentServiceImpl struct {
+	provider PaymentProvider
+	orders   OrderService
+	currency string
+}
+
+// NewPaymentService creates a new PaymentService charging in currency. A nil
+// provider disables payments. Orders change through orders, so each change
+// is versioned, recorded and published like one requested over the API.
+func NewPaymentService(provider PaymentProvider, orders OrderService, currency string) PaymentService {
+	return &paymentServiceImpl{
+		provider: provider,
+		orders:   orders,
+		currency: currency,
+	}
+}
+
+func (s *paymentServiceImpl) PayOrder(ctx context.Context, id string, paymentMethod string) (*PaymentResult, error) {
+	if s.provider == nil {
+		return nil, domain.ErrPaymentsDisabled
+	}
+	if strings.TrimSpace(paymentMethod) == "" {
+		return nil, domain.ErrInvalidPaymentMethod
+	}
+
+	order, err := s.orders.GetOrderByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != domain.OrderStatusPending && !order.HeldForPayment() {
+		return nil, domain.ErrOrderNotPayable
+	}
+
+	// Every hold or release bumps the version, so paying again after a
+	// failure is a new charge while a retried request is not
+	payment, err := s.provider.Charge(ctx, ChargeRequest{
+		OrderID:        order.ID.String(),
+		TenantID:       order.TenantID,
+		Amount:         domain.MoneyFromFloat(order.Total),
+		Currency:       s.currency,
+		PaymentMethod:  paymentMethod,
+		IdempotencyKey: fmt.Sprintf("%s-v%d", order.ID, order.Version),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The caller may pay for the order, which was checked when it was read,
+	// but only the payment's outcome confirms it, as from a webhook
+	ctx = domain.WithPrincipal(ctx, &domain.Principal{Subject: "payment:" + s.provider.Name(), Role: domain.RoleService})
+
+	order, err = s.applyPayment(ctx, payment)
+	if err != nil {
+		return nil, err
+	}
+	return &PaymentResult{Payment: payment, Order: order}, nil
+}
+
+func (s *paymentServiceImpl) HandleWebhook(ctx context.Context, payload []byte, signature string) error {
+	if s.provider == nil {
+		return domain.ErrPaymentsDisabled
+	}
+
+	payment, err := s.provider.ParseWebhook(payload, signature)
+	if err != nil || payment == nil {
+		return err
+	}
+
+	// The webhook names no caller; the provider changes the order, within
+	// the tenant it was told about when charged
+	ctx = domain.WithTenant(ctx, payment.TenantID)
+	ctx = domain.WithActor(ctx, "payment:"+s.provider.Name())
+	ctx = domain.WithActorType(ctx, domain.ActorTypeSystem)
+
+	_, err = s.applyPayment(ctx, payment)
+	if errors.Is(err, domain.ErrOrderNotFound) {
+		// Redelivering the webhook would not bring the order back
+		slog.WarnContext(ctx, "payment webhook for unknown order",
+			slog.String("provider", payment.Provider),
+			slog.String("payment", payment.Reference),
+			slog.String("order_id", payment.OrderID))
+		return nil
+	}
+	return err
+}
+
+func (s *paymentServiceImpl) WebhookSignatureHeader() string {
+	if s.provider == nil {
+		return ""
+	}
+	return s.provider.SignatureHeader()
+}
+
+// applyPayment moves the order of payment along: success confirms a pending
+// order, releasing it first if a failed payment held it, and failure holds
+// a pending order. A success that does not pay the order's current total,
+// as when its items changed while the payment was pending, holds it for an
+// operator instead of confirming it. Anything else leaves the order as it is.
+func (s *paymentServiceImpl) applyPayment(ctx context.Context, payment *domain.Payment) (*domain.Order, error) {
+	order, err := s.orders.GetOrderByID(ctx, payment.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch payment.Status {
+	case domain.PaymentSucceeded:
+		if order.HeldForPayment() {
+			if order, err = s.orders.ReleaseOrder(ctx, payment.OrderID, &order.Version); err != nil {
+				return nil, err
+			}
+		}
+		switch order.Status {
+		case domain.OrderStatusPending:
+			if reason := payment.Mismatch(domain.MoneyFromFloat(order.Total), s.currency); reason != "" {
+				slog.WarnContext(ctx, "payment does not match the order total; holding the order",
+					slog.String("provider", payment.Provider),
+					slog.String("payment", payment.Reference),
+					slog.String("order_id", payment.OrderID),
+					slog.String("reason", reason))
+				return s.orders.HoldOrder(ctx, payment.OrderID, reason, &order.Version)
+			}
+			return s.orders.UpdateOrderStatus(ctx, payment.OrderID, domain.OrderStatusConfirmed, &order.Version)
+		case domain.OrderStatusCancelled:
+			slog.WarnContext(ctx, "payment succeeded for a cancelled order; refund it with the provider",
+				slog.String("provider", payment.Provider),
+				slog.String("payment", payment.Reference),
+				slog.String("order_id", payment.OrderID))
+		}
+	case domain.PaymentFailed:
+		if order.Status == domain.OrderStatusPending {
+			return s.orders.HoldOrder(ctx, payment.OrderID, payment.HoldReason(), &order.Version)
+		}
+	}
+	return order, nil
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePaymentProvider charges with a fixed outcome and reports webhook as
+// the payment of every webhook
+type fakePaymentProvider struct {
+	status  domain.PaymentStatus
+	err     error
+	webhook *domain.Payment
+	charges []ChargeRequest
+}
+
+func (p *fakePaymentProvider) Name() string            { return "fake" }
+func (p *fakePaymentProvider) SignatureHeader() string { return "Fake-Signature" }
+
+func (p *fakePaymentProvider) Charge(_ context.Context, req ChargeRequest) (*domain.Payment, error) {
+	p.charges = append(p.charges, req)
+	if p.err != nil {
+		return nil, p.err
+	}
+	payment := &domain.Payment{Provider: "fake", Reference: "pay_1", OrderID: req.OrderID, Amount: req.Amount, Currency: req.Currency, Status: p.status}
+	if p.status == domain.PaymentFailed {
+		payment.FailureCode = "card_declined"
+	}
+	return payment, nil
+}
+
+func (p *fakePaymentProvider) ParseWebhook(_ []byte, signature string) (*domain.Payment, error) {
+	if signature != "valid" {
+		return nil, domain.ErrInvalidPaymentWebhook
+	}
+	return p.webhook, nil
+}
+
+func paymentTestService(order *domain.Order, provider PaymentProvider) (PaymentService, *[]string) {
+	var actors []string
+	repo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, id string) (*domain.Order, error) {
+			if id != order.ID.String() {
+				return nil, domain.ErrOrderNotFound
+			}
+			return order, nil
+		},
+		UpdateFunc: func(ctx context.Context, o *domain.Order) error {
+			// As the repository does
+			o.Version++
+			actors = append(actors, domain.ActorFromContext(ctx))
+			return nil
+		},
+	}
+	// As configured with a payment provider
+	settings := DefaultSettings
+	settings.RequirePayment = true
+	settings.HoldReleaseAfter = time.Hour
+	return NewPaymentService(provider, NewOrderService(repo, nil, nil, nil, nil, StaticConfig(settings)), "usd"), &actors
+}
+
+// asOrderCustomer returns a context carrying the token of order's customer
+func asOrderCustomer(order *domain.Order) context.Context {
+	return domain.WithPrincipal(context.Background(), &domain.Principal{Subject: order.CustomerID, Role: domain.RoleCustomer, CustomerID: order.CustomerID})
+}
+
+func TestPaymentService_PayOrder_Outcomes(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     domain.PaymentStatus
+		wantStatus domain.OrderStatus
+	}{
+		{name: "succeeded confirms", status: domain.PaymentSucceeded, wantStatus: domain.OrderStatusConfirmed},
+		{name: "pending waits for webhook", status: domain.PaymentPending, wantStatus: domain.OrderStatusPending},
+		{name: "failed holds", status: domain.PaymentFailed, wantStatus: domain.OrderStatusOnHold},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := createMockOrder(domain.OrderStatusPending)
+			provider := &fakePaymentProvider{status: tt.status}
+			svc, _ := paymentTestService(order, provider)
+
+			result, err := svc.PayOrder(asOrderCustomer(order), order.ID.String(), "pm_card_visa")
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.status, result.Payment.Status)
+			assert.Equal(t, tt.wantStatus, result.Order.Status)
+			if result.Order.Hold != nil {
+				assert.Nil(t, result.Order.Hold.ReleaseAt, "a payment hold waits for a payment or an operator")
+			}
+			require.Len(t, provider.charges, 1)
+			assert.Equal(t, domain.Money(1000), provider.charges[0].Amount)
+			assert.Equal(t, "usd", provider.charges[0].Currency)
+			assert.Equal(t, fmt.Sprintf("%s-v1", order.ID), provider.charges[0].IdempotencyKey)
+		})
+	}
+}
+
+func TestPaymentService_PayOrder_AfterFailure_ReleasesAndConfirms(t *testing.T) {
+	order := createMockOrder(domain.OrderStatusPending)
+	provider := &fakePaymentProvider{status: domain.PaymentFailed}
+	svc, _ := paymentTestService(order, provider)
+
+	_, err := svc.PayOrder(context.Background(), order.ID.String(), "pm_card_chargeDeclined")
+	require.NoError(t, err)
+	require.True(t, order.HeldForPayment())
+	assert.Equal(t, "payment failed: card_declined", order.Hold.Reason)
+
+	provider.status = domain.PaymentSucceeded
+	result, err := svc.PayOrder(context.Background(), order.ID.String(), "pm_card_visa")
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.OrderStatusConfirmed, result.Order.Status)
+	assert.NotEqual(t, provider.charges[0].IdempotencyKey, provider.charges[1].IdempotencyKey,
+		"paying again after a failure is a new charge")
+}
+
+func TestPaymentService_PayOrder_Rejected(t *testing.T) {
+	manualHold := createMockOrder(domain.OrderStatusPending)
+	require.NoError(t, manualHold.PlaceOnHold("fraud review", nil, manualHold.CreatedAt))
+
+	tests := []struct {
+		name     string
+		order    *domain.Order
+		provider PaymentProvider
+		method   string
+		wantErr  error
+	}{
+		{name: "payments disabled", order: createMockOrder(domain.OrderStatusPending), method: "pm_card_visa", wantErr: domain.ErrPaymentsDisabled},
+		{name: "blank payment method", order: createMockOrder(domain.OrderStatusPending), provider: &fakePaymentProvider{}, method: " ", wantErr: domain.ErrInvalidPaymentMethod},
+		{name: "confirmed order", order: createMockOrder(domain.OrderStatusConfirmed), provider: &fakePaymentProvider{}, method: "pm_card_visa", wantErr: domain.ErrOrderNotPayable},
+		{name: "held by an operator", order: manualHold, provider: &fakePaymentProvider{}, method: "pm_card_visa", wantErr: domain.ErrOrderNotPayable},
+		{name: "provider unavailable", order: createMockOrder(domain.OrderStatusPending), provider: &fakePaymentProvider{err: domain.ErrPaymentProviderUnavailable}, method: "pm_card_visa", wantErr: domain.ErrPaymentProviderUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := tt.order.Status
+			svc, actors := paymentTestService(tt.order, tt.provider)
+
+			_, err := svc.PayOrder(context.Background(), tt.order.ID.String(), tt.method)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, status, tt.order.Status)
+			assert.Empty(t, *actors, "the order is not changed")
+		})
+	}
+}
+
+func TestPaymentService_HandleWebhook_ConfirmsPendingOrder(t *testing.T) {
+	order := createMockOrder(domain.OrderStatusPending)
+	provider := &fakePaymentProvider{webhook: &domain.Payment{Provider: "fake", OrderID: order.ID.String(), Amount: 1000, Currency: "usd", Status: domain.PaymentSucceeded}}
+	svc, actors := paymentTestService(order, provider)
+
+	require.NoError(t, svc.HandleWebhook(context.Background(), []byte(`{}`), "valid"))
+	assert.Equal(t, domain.OrderStatusConfirmed, order.Status)
+	assert.Equal(t, []string{"payment:fake"}, *actors)
+
+	// A redelivered webhook no longer fits the order and changes nothing
+	require.NoError(t, svc.HandleWebhook(context.Background(), []byte(`{}`), "valid"))
+	assert.Len(t, *actors, 1)
+}
+
+func TestPaymentService_HandleWebhook_OrderChangedWhilePending_Holds(t *testing.T) {
+	order := createMockOrder(domain.OrderStatusPending)
+	provider := &fakePaymentProvider{status: domain.PaymentPending}
+	svc, _ := paymentTestService(order, provider)
+
+	result, err := svc.PayOrder(context.Background(), order.ID.String(), "pm_card_async")
+	require.NoError(t, err)
+	require.Equal(t, domain.OrderStatusPending, result.Order.Status)
+
+	// An item is added while the customer completes the payment
+	order.Total = 12.50
+	provider.webhook = &domain.Payment{Provider: "fake", OrderID: order.ID.String(), Amount: provider.charges[0].Amount, Currency: "usd", Status: domain.PaymentSucceeded}
+	require.NoError(t, svc.HandleWebhook(context.Background(), []byte(`{}`), "valid"))
+
+	assert.Equal(t, domain.OrderStatusOnHold, order.Status)
+	assert.Equal(t, "payment mismatch: paid 10.00 usd, order total 12.50 usd", order.Hold.Reason)
+	assert.Nil(t, order.Hold.ReleaseAt, "an operator settles the difference")
+	assert.False(t, order.HeldForPayment(), "paying again would charge twice")
+
+	_, err = svc.PayOrder(context.Background(), order.ID.String(), "pm_card_visa")
+	assert.ErrorIs(t, err, domain.ErrOrderNotPayable)
+}
+
+func TestPaymentService_HandleWebhook_CurrencyMismatch_Holds(t *testing.T) {
+	order := createMockOrder(domain.OrderStatusPending)
+	provider := &fakePaymentProvider{webhook: &domain.Payment{Provider: "fake", OrderID: order.ID.String(), Amount: 1000, Currency: "eur", Status: domain.PaymentSucceeded}}
+	svc, _ := paymentTestService(order, provider)
+
+	require.NoError(t, svc.HandleWebhook(context.Background(), []byte(`{}`), "valid"))
+
+	assert.Equal(t, domain.OrderStatusOnHold, order.Status)
+	assert.Equal(t, "payment mismatch: paid 10.00 eur, order total 10.00 usd", order.Hold.Reason)
+}
+
+func TestPaymentService_HandleWebhook_Errors(t *testing.T) {
+	order := createMockOrder(domain.OrderStatusPending)
+	unknown := &domain.Payment{Provider: "fake", OrderID: "0b9c2a4e-7d41-4c55-9a3b-8f8c7c7c7c7c", Status: domain.PaymentSucceeded}
+
+	svc, _ := paymentTestService(order, &fakePaymentProvider{webhook: unknown})
+	assert.ErrorIs(t, svc.HandleWebhook(context.Background(), []byte(`{}`), "forged"), domain.ErrInvalidPaymentWebhook)
+	assert.NoError(t, svc.HandleWebhook(context.Background(), []byte(`{}`), "valid"), "webhooks for unknown orders are acknowledged")
+
+	other, _ := paymentTestService(order, &fakePaymentProvider{})
+	assert.NoError(t, other.HandleWebhook(context.Background(), []byte(`{}`), "valid"), "webhooks about anything else are acknowledged")
+
+	disabled, _ := paymentTestService(order, nil)
+	assert.ErrorIs(t, disabled.HandleWebhook(context.Background(), []byte(`{}`), "valid"), domain.ErrPaymentsDisabled)
+	assert.Empty(t, disabled.WebhookSignatureHeader())
+	assert.Equal(t, domain.OrderStatusPending, order.Status)
+}
@@ -0,0 +1,168 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// pendingExpiryBatchSize caps the orders cancelled in one pass
+const pendingExpiryBatchSize = 100
+
+// PendingExpiryMetrics counts the orders cancelled by the pending order expiry job.
+type PendingExpiryMetrics struct {
+	expired  prometheus.Counter
+	failures prometheus.Counter
+}
+
+// NewPendingExpiryMetrics registers the pending order expiry metrics with reg.
+func NewPendingExpiryMetrics(reg prometheus.Registerer) *PendingExpiryMetrics {
+	m := &PendingExpiryMetrics{
+		expired: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "order_pending_expired_total",
+			Help: "Pending orders cancelled because they were not confirmed within the expiry age.",
+		}),
+		failures: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "order_pending_expiry_failures_total",
+			Help: "Pending order expiry passes that stopped on an error.",
+		}),
+	}
+	reg.MustRegister(m.expired, m.failures)
+	return m
+}
+
+func (m *PendingExpiryMetrics) record(expired int, err error) {
+	if m == nil {
+		return
+	}
+	m.expired.Add(float64(expired))
+	if err != nil {
+		m.failures.Inc()
+	}
+}
+
+// PendingExpiryService cancels pending orders that were not confirmed in time
+type PendingExpiryService interface {
+	// ExpireStaleOrders cancels up to one batch of pending orders older than
+	// the expiry age, publishing order.status_changed for each, and returns
+	// how many were cancelled. Orders confirmed, cancelled or changed
+	// concurrently are skipped.
+	ExpireStaleOrders(ctx context.Context) (int, error)
+
+	// Run expires stale orders immediately and then every interval until ctx is cancelled
+	Run(ctx context.Context, interval time.Duration)
+}
+
+// pendingExpiryServiceImpl implements PendingExpiryService
+type pendingExpiryServiceImpl struct {
+	repo    repository.OrderRepository
+	orders  OrderService
+	maxAge  time.Duration
+	metrics *PendingExpiryMetrics
+	now     func() time.Time
+}
+
+// NewPendingExpiryService creates a new PendingExpiryService that cancels
+// pending orders older than maxAge. Orders are cancelled through orders so
+// the state machine is enforced and each cancellation is versioned, recorded
+// and published like any other status change. metrics may be nil.
+func NewPendingExpiryService(repo repository.OrderRepository, orders OrderService, maxAge time.Duration, metrics *PendingExpiryMetrics) PendingExpiryService {
+	return &pendingExpiryServiceImpl{
+		repo:    repo,
+		orders:  orders,
+		maxAge:  maxAge,
+		metrics: metrics,
+		now:     time.Now,
+	}
+}
+
+func (s *pendingExpiryServiceImpl) ExpireStaleOrders(ctx context.Context) (int, error) {
+	expired, err := s.expireStaleOrders(domain.WithSystemActor(ctx))
+	s.metrics.record(expired, err)
+	if expired > 0 {
+		slog.Info("cancelled stale pending orders", slog.Int("count", expired))
+	}
+	return expired, err
+}
+
+func (s *pendingExpiryServiceImpl) expireStaleOrders(ctx context.Context) (int, error) {
+	ids, err := s.repo.ListStalePending(ctx, s.now().Add(-s.maxAge), pendingExpiryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, id := range ids {
+		err := s.expire(ctx, id)
+		switch {
+		case err == nil:
+			expired++
+		case errors.Is(err, errOrderNotStale),
+			errors.Is(err, domain.ErrOrderNotFound),
+			errors.Is(err, domain.ErrVersionMismatch),
+			errors.Is(err, domain.ErrConcurrentModification):
+			// Changed since it was listed; an order still pending is picked up next pass
+		default:
+			return expired, err
+		}
+	}
+	return expired, nil
+}
+
+// errOrderNotStale reports a listed order that is no longer a stale pending order
+var errOrderNotStale = errors.New("order is no longer stale and pending")
+
+// expire cancels the order if it is still pending, pinning the version read
+// so an order confirmed in between is not cancelled from its new status.
+func (s *pendingExpiryServiceImpl) expire(ctx context.Context, id string) error {
+	order, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if order == nil {
+		return domain.ErrOrderNotFound
+	}
+	if order.Status != domain.OrderStatusPending || order.CreatedAt.After(s.now().Add(-s.maxAge)) {
+		return errOrderNotStale
+	}
+
+	version := order.Version
+	_, err = s.orders.UpdateOrderStatus(withCancelReason(ctx, CancelReasonExpired), id, domain.OrderStatusCancelled, &version)
+	return err
+}
+
+func (s *pendingExpiryServiceImpl) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.ExpireStaleOrders(ctx); err != nil && ctx.Err() == nil {
+			slog.Warn("pending order expiry failed", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingExpiryService_ExpireStaleOrders_CancelsStalePendingOrders(t *testing.T) {
+	now := time.Now()
+	stale := createMockOrder(domain.OrderStatusPending)
+	stale.CreatedAt = now.Add(-48 * time.Hour)
+	confirmed := createMockOrder(domain.OrderStatusConfirmed)
+	confirmed.CreatedAt = now.Add(-48 * time.Hour)
+	orders := map[string]*domain.Order{
+		stale.ID.String():     stale,
+		confirmed.ID.String(): confirmed,
+	}
+
+	var gotBefore time.Time
+	var actors []string
+	repo := &mocks.OrderRepositoryMock{
+		ListStalePendingFunc: func(_ context.Context, createdBefore time.Time, limit int) ([]string, error) {
+			gotBefore = createdBefore
+			assert.Equal(t, pendingExpiryBatchSize, limit)
+			return []string{stale.ID.String(), confirmed.ID.String()}, nil
+		},
+		FindByIDFunc: func(_ context.Context, id string) (*domain.Order, error) {
+			return orders[id], nil
+		},
+		UpdateFunc: func(ctx context.Context, _ *domain.Order) error {
+			actors = append(actors, domain.ActorFromContext(ctx))
+			return nil
+		},
+	}
+	var cancelled []string
+	publisher := &mocks.EventPublisherMock{
+		PublishOrderStatusChangedFunc: func(ctx context.Context, order *domain.Order, from, to domain.OrderStatus) error {
+			assert.Equal(t, domain.OrderStatusPending, from)
+			assert.Equal(t, domain.OrderStatusCancelled, to)
+			assert.Equal(t, CancelReasonExpired, cancelReason(ctx))
+			cancelled = append(cancelled, order.ID.String())
+			return nil
+		},
+	}
+	reg := prometheus.NewRegistry()
+	metrics := NewPendingExpiryMetrics(reg)
+
+	svc := &pendingExpiryServiceImpl{
+		repo:    repo,
+		orders:  NewOrderService(repo, nil, nil, publisher, nil, nil),
+		maxAge:  24 * time.Hour,
+		metrics: metrics,
+		now:     func() time.Time { return now },
+	}
+	expired, err := svc.ExpireStaleOrders(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired, "an order confirmed since it was listed is skipped")
+	assert.True(t, now.Add(-24*time.Hour).Equal(gotBefore))
+	assert.Equal(t, domain.OrderStatusCancelled, stale.Status)
+	assert.Equal(t, domain.OrderStatusConfirmed, confirmed.Status)
+	assert.Equal(t, []string{stale.ID.String()}, cancelled)
+	assert.Equal(t, []string{domain.ActorSystem}, actors)
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.expired))
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.failures))
+}
+
+func TestPendingExpiryService_ExpireStaleOrders_RepositoryError_ReturnsError(t *testing.T) {
+	dbErr := errors.New("connection refused")
+	repo := &mocks.OrderRepositoryMock{
+		ListStalePendingFunc: func(_ context.Context, _ time.Time, _ int) ([]string, error) {
+			return nil, dbErr
+		},
+	}
+	metrics := NewPendingExpiryMetrics(prometheus.NewRegistry())
+
+	svc := NewPendingExpiryService(repo, NewOrderService(repo, nil, nil, nil, nil, nil), 24*time.Hour, metrics)
+	_, err := svc.ExpireStaleOrders(context.Background())
+
+	assert.ErrorIs(t, err, dbErr)
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.failures))
+}
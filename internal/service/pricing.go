@@ -0,0 +1,80 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// PricingService supplies catalog unit prices, so orders need not be priced
+// by the client. It is consulted whenever order items are created or replaced.
+type PricingService interface {
+	// UnitPrices returns the unit price of each of productIDs the catalog
+	// knows, keyed by product ID. Unknown products are left out.
+	UnitPrices(ctx context.Context, productIDs []string) (map[string]float64, error)
+}
+
+// PassthroughPricing is a PricingService that knows no products, so every
+// item keeps the price the client supplied
+type PassthroughPricing struct{}
+
+// UnitPrices returns no prices
+func (PassthroughPricing) UnitPrices(_ context.Context, _ []string) (map[string]float64, error) {
+	return nil, nil
+}
+
+// lookupPrices asks pricing for the unit prices of the distinct products in
+// each item list
+func lookupPrices(ctx context.Context, pricing PricingService, itemLists ...[]domain.OrderItem) (map[string]float64, error) {
+	seen := make(map[string]struct{})
+	var productIDs []string
+	for _, items := range itemLists {
+		for _, item := range items {
+			if _, ok := seen[item.ProductID]; ok || item.ProductID == "" {
+				continue
+			}
+			seen[item.ProductID] = struct{}{}
+			productIDs = append(productIDs, item.ProductID)
+		}
+	}
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+
+	prices, err := pricing.UnitPrices(ctx, productIDs)
+	if err != nil {
+		return nil, fmt.Errorf("look up unit prices: %w", err)
+	}
+	return prices, nil
+}
+
+// applyPrices returns a copy of items with catalog prices replacing the
+// client's. Unknown products keep the client's price unless requireCatalog
+// is set, when domain.ErrProductNotPriced is returned instead.
+func applyPrices(items []domain.OrderItem, prices map[string]float64, requireCatalog bool) ([]domain.OrderItem, error) {
+	priced := make([]domain.OrderItem, len(items))
+	for i, item := range items {
+		if price, ok := prices[item.ProductID]; ok {
+			item.Price = price
+		} else if requireCatalog && item.ProductID != "" {
+			return nil, domain.ErrProductNotPriced
+		}
+		priced[i] = item
+	}
+	return priced, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/ratelimit"
+)
+
+// QuotaService reports how much of their request limits callers have used
+type QuotaService interface {
+	// Usage returns the buckets of a client IP, or of the tenant or customer
+	// rule matching subject; domain.ErrQuotaNotFound when no limit applies
+	Usage(ctx context.Context, subject QuotaSubject) (*QuotaUsage, error)
+}
+
+// QuotaSubject names whose usage to report: a client IP, or a tenant and
+// customer as the caller rules match them
+type QuotaSubject struct {
+	ClientIP   string
+	Tenant     string
+	CustomerID string
+}
+
+// QuotaUsage is the limit applying to a subject and how full its buckets are
+type QuotaUsage struct {
+	// Subject is the key the buckets are counted under, e.g. tenant:acme
+	Subject                string
+	RequestsPerMinute      int
+	WriteRequestsPerMinute int
+	Burst                  int
+	Buckets                []QuotaBucket
+}
+
+// QuotaBucket is one counted window
+type QuotaBucket struct {
+	// Name is read, read_burst, write or write_burst
+	Name   string
+	Used   int
+	Limit  int
+	Window time.Duration
+}
+
+// quotaServiceImpl implements QuotaService
+type quotaServiceImpl struct {
+	limiter *ratelimit.Limiter
+}
+
+// NewQuotaService creates a QuotaService reading the buckets of limiter
+func NewQuotaService(limiter *ratelimit.Limiter) QuotaService {
+	return &quotaServiceImpl{limiter: limiter}
+}
+
+func (s *quotaServiceImpl) Usage(ctx context.Context, subject QuotaSubject) (*QuotaUsage, error) {
+	var (
+		usage *ratelimit.Usage
+		err   error
+	)
+	if subject.ClientIP != "" {
+		usage, err = s.limiter.ClientUsage(ctx, subject.ClientIP)
+	} else {
+		usage, err = s.limiter.CallerUsage(ctx, subject.Tenant, subject.CustomerID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &QuotaUsage{
+		Subject:                usage.Subject,
+		RequestsPerMinute:      usage.Policy.RequestsPerMinute,
+		WriteRequestsPerMinute: usage.Policy.WriteRequestsPerMinute,
+		Burst:                  usage.Policy.Burst,
+	}
+	for _, b := range usage.Buckets {
+		result.Buckets = append(result.Buckets, QuotaBucket{Name: b.Name, Used: b.Used, Limit: b.Limit, Window: b.Period})
+	}
+	return result, nil
+}
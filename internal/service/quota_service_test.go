@@ -0,0 +1,80 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestQuotaService(store *mocks.RateLimiterMock) QuotaService {
+	policies := ratelimit.Policies{
+		Client: ratelimit.Policy{RequestsPerMinute: 1000, Burst: 50},
+		Rules: []ratelimit.Rule{
+			{Tenant: "acme", Policy: ratelimit.Policy{RequestsPerMinute: 600, WriteRequestsPerMinute: 100, Burst: 20}},
+		},
+	}
+	return NewQuotaService(ratelimit.NewLimiter(store, func() ratelimit.Policies { return policies }))
+}
+
+func TestQuotaService_Usage_Tenant(t *testing.T) {
+	counts := map[string]int{"tenant:acme": 42, "tenant:acme:write": 7}
+	store := &mocks.RateLimiterMock{
+		CountFunc: func(_ context.Context, key string, _ time.Duration) (int, error) {
+			return counts[key], nil
+		},
+	}
+
+	usage, err := newTestQuotaService(store).Usage(context.Background(), QuotaSubject{Tenant: "acme"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "tenant:acme", usage.Subject)
+	assert.Equal(t, 600, usage.RequestsPerMinute)
+	assert.Equal(t, 100, usage.WriteRequestsPerMinute)
+	assert.Equal(t, []QuotaBucket{
+		{Name: "read_burst", Used: 0, Limit: 20, Window: time.Second},
+		{Name: "read", Used: 42, Limit: 600, Window: time.Minute},
+		{Name: "write_burst", Used: 0, Limit: 20, Window: time.Second},
+		{Name: "write", Used: 7, Limit: 100, Window: time.Minute},
+	}, usage.Buckets)
+}
+
+func TestQuotaService_Usage_ClientIP(t *testing.T) {
+	store := &mocks.RateLimiterMock{
+		CountFunc: func(_ context.Context, key string, _ time.Duration) (int, error) {
+			assert.Contains(t, []string{"ip:10.0.0.1", "ip:10.0.0.1:burst"}, key)
+			return 3, nil
+		},
+	}
+
+	usage, err := newTestQuotaService(store).Usage(context.Background(), QuotaSubject{ClientIP: "10.0.0.1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ip:10.0.0.1", usage.Subject)
+	assert.Len(t, usage.Buckets, 2)
+}
+
+func TestQuotaService_Usage_NoRule_ReturnsErrQuotaNotFound(t *testing.T) {
+	_, err := newTestQuotaService(&mocks.RateLimiterMock{}).Usage(context.Background(), QuotaSubject{Tenant: "globex"})
+
+	assert.ErrorIs(t, err, domain.ErrQuotaNotFound)
+}
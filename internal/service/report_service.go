@@ -0,0 +1,119 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// ReportQuery represents a request for an order report
+type ReportQuery struct {
+	GroupBy domain.ReportGroupBy
+	// From and To bound order creation time: From inclusive, To exclusive
+	From   *time.Time
+	To     *time.Time
+	Status *domain.OrderStatus
+	// Limit caps customer reports; defaults to 20, max 100
+	Limit int
+}
+
+// ReportService produces aggregate reports over orders
+type ReportService interface {
+	// GetOrderReport returns order counts and revenue grouped by query.GroupBy
+	GetOrderReport(ctx context.Context, query ReportQuery) (*domain.OrderReport, error)
+
+	// Run refreshes the report materialized views every interval until ctx is cancelled
+	Run(ctx context.Context, interval time.Duration)
+}
+
+// reportServiceImpl implements ReportService
+type reportServiceImpl struct {
+	repo repository.ReportRepository
+}
+
+// NewReportService creates a new ReportService
+func NewReportService(repo repository.ReportRepository) ReportService {
+	return &reportServiceImpl{
+		repo: repo,
+	}
+}
+
+func (s *reportServiceImpl) GetOrderReport(ctx context.Context, query ReportQuery) (*domain.OrderReport, error) {
+	// Reports aggregate every customer's orders
+	if err := domain.AuthorizeAllCustomers(ctx); err != nil {
+		return nil, err
+	}
+	if !query.GroupBy.IsValid() {
+		return nil, domain.ErrInvalidGroupBy
+	}
+	if query.Status != nil && !query.Status.IsValid() {
+		return nil, domain.ErrInvalidStatus
+	}
+	if query.From != nil && query.To != nil && !query.From.Before(*query.To) {
+		return nil, domain.ErrInvalidReportRange
+	}
+	if query.Limit < 1 {
+		query.Limit = 20
+	}
+	if query.Limit > 100 {
+		query.Limit = 100
+	}
+
+	rows, err := s.repo.AggregateOrders(ctx, repository.ReportOptions{
+		GroupBy: query.GroupBy,
+		From:    query.From,
+		To:      query.To,
+		Status:  query.Status,
+		Limit:   query.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.OrderReport{
+		GroupBy: query.GroupBy,
+		From:    query.From,
+		To:      query.To,
+		Rows:    rows,
+	}
+	var revenue domain.Money
+	for _, row := range rows {
+		report.TotalOrders += row.OrderCount
+		revenue += domain.MoneyFromFloat(row.Revenue)
+	}
+	report.TotalRevenue = revenue.Float64()
+	return report, nil
+}
+
+func (s *reportServiceImpl) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.repo.RefreshReportViews(ctx); err != nil && ctx.Err() == nil {
+				slog.Warn("report view refresh failed", slog.String("error", err.Error()))
+			}
+		}
+	}
+}
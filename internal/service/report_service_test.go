@@ -0,0 +1,110 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportService_GetOrderReport_SumsTotals(t *testing.T) {
+	var gotOpts repository.ReportOptions
+	repo := &mocks.ReportRepositoryMock{
+		AggregateOrdersFunc: func(_ context.Context, opts repository.ReportOptions) ([]domain.OrderReportRow, error) {
+			gotOpts = opts
+			return []domain.OrderReportRow{
+				{Key: "2026-02-01", OrderCount: 3, Revenue: 120.50},
+				{Key: "2026-03-01", OrderCount: 2, Revenue: 79.50},
+			}, nil
+		},
+	}
+
+	svc := NewReportService(repo)
+	report, err := svc.GetOrderReport(context.Background(), ReportQuery{GroupBy: domain.ReportGroupByMonth})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.ReportGroupByMonth, gotOpts.GroupBy)
+	assert.Equal(t, 20, gotOpts.Limit)
+	assert.Len(t, report.Rows, 2)
+	assert.Equal(t, int64(5), report.TotalOrders)
+	assert.InDelta(t, 200.00, report.TotalRevenue, 0.001)
+}
+
+func TestReportService_GetOrderReport_InvalidQuery_ReturnsError(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, -1, 0)
+	bogus := domain.OrderStatus("bogus")
+
+	tests := []struct {
+		name    string
+		query   ReportQuery
+		wantErr error
+	}{
+		{name: "unknown grouping", query: ReportQuery{GroupBy: "year"}, wantErr: domain.ErrInvalidGroupBy},
+		{name: "unknown status", query: ReportQuery{GroupBy: domain.ReportGroupByDay, Status: &bogus}, wantErr: domain.ErrInvalidStatus},
+		{name: "from after to", query: ReportQuery{GroupBy: domain.ReportGroupByDay, From: &from, To: &to}, wantErr: domain.ErrInvalidReportRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.ReportRepositoryMock{
+				AggregateOrdersFunc: func(_ context.Context, _ repository.ReportOptions) ([]domain.OrderReportRow, error) {
+					t.Fatal("repository must not be queried for an invalid report")
+					return nil, nil
+				},
+			}
+
+			_, err := NewReportService(repo).GetOrderReport(context.Background(), tt.query)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestReportService_GetOrderReport_LimitClamped(t *testing.T) {
+	var gotLimit int
+	repo := &mocks.ReportRepositoryMock{
+		AggregateOrdersFunc: func(_ context.Context, opts repository.ReportOptions) ([]domain.OrderReportRow, error) {
+			gotLimit = opts.Limit
+			return nil, nil
+		},
+	}
+
+	_, err := NewReportService(repo).GetOrderReport(context.Background(), ReportQuery{GroupBy: domain.ReportGroupByCustomer, Limit: 500})
+
+	require.NoError(t, err)
+	assert.Equal(t, 100, gotLimit)
+}
+
+func TestReportService_GetOrderReport_CustomerPrincipal_AccessDenied(t *testing.T) {
+	repo := &mocks.ReportRepositoryMock{
+		AggregateOrdersFunc: func(_ context.Context, _ repository.ReportOptions) ([]domain.OrderReportRow, error) {
+			t.Fatal("repository must not be queried for a customer principal")
+			return nil, nil
+		},
+	}
+	ctx := domain.WithPrincipal(context.Background(), &domain.Principal{Role: domain.RoleCustomer, CustomerID: "cust-1"})
+
+	_, err := NewReportService(repo).GetOrderReport(ctx, ReportQuery{GroupBy: domain.ReportGroupByDay})
+
+	assert.ErrorIs(t, err, domain.ErrAccessDenied)
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// RetentionPolicy sets how long orders are kept before they are hard-deleted.
+// A zero duration disables that rule.
+type RetentionPolicy struct {
+	// DeletedOrders is measured from the soft delete
+	DeletedOrders time.Duration
+	// CompletedOrders applies to delivered and cancelled orders, measured from their last update
+	CompletedOrders time.Duration
+}
+
+// Enabled reports whether any retention rule is set
+func (p RetentionPolicy) Enabled() bool {
+	return p.DeletedOrders > 0 || p.CompletedOrders > 0
+}
+
+// RetentionResult counts the orders removed by one retention pass
+type RetentionResult struct {
+	DeletedPurged   int64
+	CompletedPurged int64
+}
+
+// completedStatuses are terminal statuses subject to RetentionPolicy.CompletedOrders
+var completedStatuses = []domain.OrderStatus{domain.OrderStatusDelivered, domain.OrderStatusCancelled}
+
+// RetentionService hard-deletes orders that are past their retention period
+type RetentionService interface {
+	// ApplyRetention runs one retention pass with the configured policy
+	ApplyRetention(ctx context.Context) (*RetentionResult, error)
+
+	// Run applies retention every interval until ctx is cancelled
+	Run(ctx context.Context, interval time.Duration)
+}
+
+// retentionServiceImpl implements RetentionService
+type retentionServiceImpl struct {
+	repo   repository.OrderRepository
+	policy RetentionPolicy
+	now    func() time.Time
+}
+
+// NewRetentionService creates a new RetentionService
+func NewRetentionService(repo repository.OrderRepository, policy RetentionPolicy) RetentionService {
+	return &retentionServiceImpl{
+		repo:   repo,
+		policy: policy,
+		now:    time.Now,
+	}
+}
+
+func (s *retentionServiceImpl) ApplyRetention(ctx context.Context) (*RetentionResult, error) {
+	result := &RetentionResult{}
+	now := s.now()
+
+	if s.policy.DeletedOrders > 0 {
+		purged, err := s.repo.Purge(ctx, now.Add(-s.policy.DeletedOrders))
+		if err != nil {
+			return result, err
+		}
+		result.DeletedPurged = purged
+	}
+
+	if s.policy.CompletedOrders > 0 {
+		purged, err := s.repo.PurgeCompleted(ctx, completedStatuses, now.Add(-s.policy.CompletedOrders))
+		if err != nil {
+			return result, err
+		}
+		result.CompletedPurged = purged
+	}
+
+	if result.DeletedPurged > 0 || result.CompletedPurged > 0 {
+		slog.Info("retention purge removed orders",
+			slog.Int64("deleted", result.DeletedPurged),
+			slog.Int64("completed", result.CompletedPurged),
+		)
+	}
+	return result, nil
+}
+
+func (s *retentionServiceImpl) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.ApplyRetention(ctx); err != nil && ctx.Err() == nil {
+				slog.Warn("retention purge failed", slog.String("error", err.Error()))
+			}
+		}
+	}
+}
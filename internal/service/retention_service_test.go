@@ -0,0 +1,93 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetentionService_ApplyRetention_UsesPolicyCutoffs(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	var deletedCutoff, completedCutoff time.Time
+	var gotStatuses []domain.OrderStatus
+	repo := &mocks.OrderRepositoryMock{
+		PurgeFunc: func(_ context.Context, deletedBefore time.Time) (int64, error) {
+			deletedCutoff = deletedBefore
+			return 2, nil
+		},
+		PurgeCompletedFunc: func(_ context.Context, statuses []domain.OrderStatus, updatedBefore time.Time) (int64, error) {
+			gotStatuses, completedCutoff = statuses, updatedBefore
+			return 5, nil
+		},
+	}
+
+	svc := &retentionServiceImpl{
+		repo:   repo,
+		policy: RetentionPolicy{DeletedOrders: 30 * 24 * time.Hour, CompletedOrders: 365 * 24 * time.Hour},
+		now:    func() time.Time { return now },
+	}
+	result, err := svc.ApplyRetention(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), result.DeletedPurged)
+	assert.Equal(t, int64(5), result.CompletedPurged)
+	assert.Equal(t, now.Add(-30*24*time.Hour), deletedCutoff)
+	assert.Equal(t, now.Add(-365*24*time.Hour), completedCutoff)
+	assert.ElementsMatch(t, []domain.OrderStatus{domain.OrderStatusDelivered, domain.OrderStatusCancelled}, gotStatuses)
+}
+
+func TestRetentionService_ApplyRetention_ZeroDurationsDisableRules(t *testing.T) {
+	repo := &mocks.OrderRepositoryMock{
+		PurgeFunc: func(_ context.Context, _ time.Time) (int64, error) {
+			t.Fatal("deleted-order rule is disabled")
+			return 0, nil
+		},
+		PurgeCompletedFunc: func(_ context.Context, _ []domain.OrderStatus, _ time.Time) (int64, error) {
+			t.Fatal("completed-order rule is disabled")
+			return 0, nil
+		},
+	}
+
+	svc := NewRetentionService(repo, RetentionPolicy{})
+	result, err := svc.ApplyRetention(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, &RetentionResult{}, result)
+}
+
+func TestRetentionService_ApplyRetention_RepositoryError_ReturnsPartialResult(t *testing.T) {
+	repo := &mocks.OrderRepositoryMock{
+		PurgeFunc: func(_ context.Context, _ time.Time) (int64, error) {
+			return 3, nil
+		},
+		PurgeCompletedFunc: func(_ context.Context, _ []domain.OrderStatus, _ time.Time) (int64, error) {
+			return 0, errors.New("statement timeout")
+		},
+	}
+
+	svc := NewRetentionService(repo, RetentionPolicy{DeletedOrders: time.Hour, CompletedOrders: time.Hour})
+	result, err := svc.ApplyRetention(context.Background())
+
+	assert.EqualError(t, err, "statement timeout")
+	assert.Equal(t, int64(3), result.DeletedPurged)
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// slaCheckBatchSize caps the orders flagged in one pass
+const slaCheckBatchSize = 100
+
+// SLAService flags orders that were not delivered by their estimated delivery time
+type SLAService interface {
+	// FlagOverdueOrders flags up to one batch of overdue orders, publishing
+	// order.sla_breached for each, and returns how many were flagged. Orders
+	// delivered, cancelled or flagged concurrently are skipped.
+	FlagOverdueOrders(ctx context.Context) (int, error)
+
+	// Run flags overdue orders immediately and then every interval until ctx is cancelled
+	Run(ctx context.Context, interval time.Duration)
+}
+
+// slaServiceImpl implements SLAService
+type slaServiceImpl struct {
+	repo   repository.OrderRepository
+	orders OrderService
+	now    func() time.Time
+}
+
+// NewSLAService creates a new SLAService. Orders are flagged through orders
+// so each breach is versioned, recorded and published like any other change.
+func NewSLAService(repo repository.OrderRepository, orders OrderService) SLAService {
+	return &slaServiceImpl{
+		repo:   repo,
+		orders: orders,
+		now:    time.Now,
+	}
+}
+
+func (s *slaServiceImpl) FlagOverdueOrders(ctx context.Context) (int, error) {
+	ctx = domain.WithSystemActor(ctx)
+
+	ids, err := s.repo.ListOverdueDeliveries(ctx, s.now(), slaCheckBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	flagged := 0
+	for _, id := range ids {
+		_, err := s.orders.MarkSLABreached(ctx, id)
+		switch {
+		case err == nil:
+			flagged++
+		case errors.Is(err, domain.ErrSLANotBreached),
+			errors.Is(err, domain.ErrOrderNotFound),
+			errors.Is(err, domain.ErrConcurrentModification):
+			// Changed since it was listed; an order still overdue is picked up next pass
+		default:
+			return flagged, err
+		}
+	}
+
+	if flagged > 0 {
+		slog.Info("flagged overdue orders", slog.Int("count", flagged))
+	}
+	return flagged, nil
+}
+
+func (s *slaServiceImpl) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.FlagOverdueOrders(ctx); err != nil && ctx.Err() == nil {
+			slog.Warn("order SLA check failed", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
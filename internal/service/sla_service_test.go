@@ -0,0 +1,91 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSLAService_FlagOverdueOrders_FlagsListedOrders(t *testing.T) {
+	now := time.Now()
+	estimate := now.Add(-time.Hour)
+	overdue := createMockOrder(domain.OrderStatusShipped)
+	overdue.EstimatedDeliveryAt = &estimate
+	delivered := createMockOrder(domain.OrderStatusDelivered)
+	delivered.EstimatedDeliveryAt = &estimate
+
+	var gotNow time.Time
+	var actors []string
+	repo := &mocks.OrderRepositoryMock{
+		ListOverdueDeliveriesFunc: func(_ context.Context, at time.Time, limit int) ([]string, error) {
+			gotNow = at
+			assert.Equal(t, slaCheckBatchSize, limit)
+			return []string{overdue.ID.String(), delivered.ID.String()}, nil
+		},
+		FindByIDFunc: func(_ context.Context, id string) (*domain.Order, error) {
+			if id == overdue.ID.String() {
+				return overdue, nil
+			}
+			return delivered, nil
+		},
+		UpdateFunc: func(ctx context.Context, _ *domain.Order) error {
+			actors = append(actors, domain.ActorFromContext(ctx))
+			return nil
+		},
+	}
+	var breached []string
+	publisher := &mocks.EventPublisherMock{
+		PublishOrderSLABreachedFunc: func(_ context.Context, order *domain.Order) error {
+			breached = append(breached, order.ID.String())
+			return nil
+		},
+	}
+
+	svc := &slaServiceImpl{
+		repo:   repo,
+		orders: NewOrderService(repo, nil, nil, publisher, nil, nil),
+		now:    func() time.Time { return now },
+	}
+	flagged, err := svc.FlagOverdueOrders(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, flagged, "an order delivered since it was listed is skipped")
+	assert.True(t, now.Equal(gotNow))
+	assert.NotNil(t, overdue.SLABreachedAt)
+	assert.Equal(t, []string{overdue.ID.String()}, breached)
+	assert.Equal(t, []string{domain.ActorSystem}, actors)
+}
+
+func TestSLAService_FlagOverdueOrders_RepositoryError_ReturnsError(t *testing.T) {
+	dbErr := errors.New("connection refused")
+	repo := &mocks.OrderRepositoryMock{
+		ListOverdueDeliveriesFunc: func(_ context.Context, _ time.Time, _ int) ([]string, error) {
+			return nil, dbErr
+		},
+	}
+
+	svc := NewSLAService(repo, NewOrderService(repo, nil, nil, nil, nil, nil))
+	_, err := svc.FlagOverdueOrders(context.Background())
+
+	assert.ErrorIs(t, err, dbErr)
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+	"golang.org/x/sync/singleflight"
+)
+
+// StatsService summarizes current order activity for dashboards
+type StatsService interface {
+	// GetStats returns live order counts by status, the number and revenue
+	// of orders created today (UTC, cancelled orders left out of revenue)
+	// and the order cache hit rate. Stats are computed at most once per TTL
+	// for each tenant. Callers limited to one customer get
+	// domain.ErrAccessDenied.
+	GetStats(ctx context.Context) (*domain.OrderStats, error)
+}
+
+// statsEntry is a tenant's stats and when they stop being served
+type statsEntry struct {
+	stats   *domain.OrderStats
+	expires time.Time
+}
+
+// statsServiceImpl implements StatsService
+type statsServiceImpl struct {
+	repo       repository.ReportRepository
+	cacheStats func() domain.CacheStats
+	ttl        time.Duration
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]statsEntry
+	// loads collapses concurrent computations for one tenant into one
+	loads singleflight.Group
+}
+
+// NewStatsService creates a new StatsService that serves stats for ttl
+// after computing them. cacheStats reports the order cache lookups; nil
+// leaves the hit rate out.
+func NewStatsService(repo repository.ReportRepository, cacheStats func() domain.CacheStats, ttl time.Duration) StatsService {
+	if cacheStats == nil {
+		cacheStats = func() domain.CacheStats { return domain.CacheStats{} }
+	}
+	return &statsServiceImpl{
+		repo:       repo,
+		cacheStats: cacheStats,
+		ttl:        ttl,
+		now:        time.Now,
+		entries:    make(map[string]statsEntry),
+	}
+}
+
+func (s *statsServiceImpl) GetStats(ctx context.Context) (*domain.OrderStats, error) {
+	// Stats aggregate every customer's orders
+	if err := domain.AuthorizeAllCustomers(ctx); err != nil {
+		return nil, err
+	}
+
+	tenantID := domain.TenantID(ctx)
+	s.mu.Lock()
+	entry, ok := s.entries[tenantID]
+	s.mu.Unlock()
+	if ok && s.now().Before(entry.expires) {
+		return entry.stats, nil
+	}
+
+	// Detached like GetOrderByID's shared reads, so one caller giving up
+	// does not fail the others
+	loaded, err, _ := s.loads.Do(tenantID, func() (any, error) {
+		stats, err := s.computeStats(context.WithoutCancel(ctx))
+		if err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		s.entries[tenantID] = statsEntry{stats: stats, expires: stats.GeneratedAt.Add(s.ttl)}
+		s.mu.Unlock()
+		return stats, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return loaded.(*domain.OrderStats), nil
+}
+
+// computeStats reads the stats of the tenant in ctx from the database
+func (s *statsServiceImpl) computeStats(ctx context.Context) (*domain.OrderStats, error) {
+	now := s.now().UTC()
+	today := now.Truncate(24 * time.Hour)
+
+	stats := &domain.OrderStats{
+		GeneratedAt: now,
+		ByStatus:    make(map[domain.OrderStatus]int64),
+		Today:       today,
+		Cache:       s.cacheStats(),
+	}
+	for _, status := range domain.ValidStatuses() {
+		stats.ByStatus[status] = 0
+	}
+
+	rows, err := s.repo.AggregateOrders(ctx, repository.ReportOptions{GroupBy: domain.ReportGroupByStatus})
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		stats.ByStatus[domain.OrderStatus(row.Key)] = row.OrderCount
+		stats.TotalOrders += row.OrderCount
+	}
+
+	rows, err = s.repo.AggregateOrders(ctx, repository.ReportOptions{GroupBy: domain.ReportGroupByStatus, From: &today})
+	if err != nil {
+		return nil, err
+	}
+	var revenue domain.Money
+	for _, row := range rows {
+		stats.TodayOrders += row.OrderCount
+		if domain.OrderStatus(row.Key) != domain.OrderStatusCancelled {
+			revenue += domain.MoneyFromFloat(row.Revenue)
+		}
+	}
+	stats.TodayRevenue = revenue.Float64()
+	return stats, nil
+}
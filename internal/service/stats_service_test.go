@@ -0,0 +1,106 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsService_GetStats_CountsByStatusAndToday(t *testing.T) {
+	now := time.Date(2026, 10, 17, 15, 4, 5, 0, time.UTC)
+	today := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	repo := &mocks.ReportRepositoryMock{
+		AggregateOrdersFunc: func(_ context.Context, opts repository.ReportOptions) ([]domain.OrderReportRow, error) {
+			assert.Equal(t, domain.ReportGroupByStatus, opts.GroupBy)
+			if opts.From == nil {
+				return []domain.OrderReportRow{
+					{Key: "cancelled", OrderCount: 2, Revenue: 150},
+					{Key: "delivered", OrderCount: 5, Revenue: 500},
+					{Key: "pending", OrderCount: 3, Revenue: 45.30},
+				}, nil
+			}
+			assert.True(t, today.Equal(*opts.From))
+			assert.Nil(t, opts.To)
+			return []domain.OrderReportRow{
+				{Key: "cancelled", OrderCount: 1, Revenue: 99},
+				{Key: "pending", OrderCount: 2, Revenue: 30.20},
+			}, nil
+		},
+	}
+	cacheStats := func() domain.CacheStats { return domain.CacheStats{Hits: 9, Misses: 1} }
+	svc := NewStatsService(repo, cacheStats, 10*time.Second).(*statsServiceImpl)
+	svc.now = func() time.Time { return now }
+
+	stats, err := svc.GetStats(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, now, stats.GeneratedAt)
+	assert.Len(t, stats.ByStatus, len(domain.ValidStatuses()), "every status is listed")
+	assert.Equal(t, int64(3), stats.ByStatus[domain.OrderStatusPending])
+	assert.Equal(t, int64(0), stats.ByStatus[domain.OrderStatusConfirmed])
+	assert.Equal(t, int64(10), stats.TotalOrders)
+	assert.Equal(t, today, stats.Today)
+	assert.Equal(t, int64(3), stats.TodayOrders)
+	assert.Equal(t, 30.20, stats.TodayRevenue, "cancelled orders are not revenue")
+	assert.Equal(t, domain.CacheStats{Hits: 9, Misses: 1}, stats.Cache)
+}
+
+func TestStatsService_GetStats_CachedPerTenantForTTL(t *testing.T) {
+	now := time.Date(2026, 10, 17, 15, 0, 0, 0, time.UTC)
+	var queries int
+	repo := &mocks.ReportRepositoryMock{
+		AggregateOrdersFunc: func(_ context.Context, _ repository.ReportOptions) ([]domain.OrderReportRow, error) {
+			queries++
+			return nil, nil
+		},
+	}
+	svc := NewStatsService(repo, nil, 10*time.Second).(*statsServiceImpl)
+	svc.now = func() time.Time { return now }
+	acme := domain.WithTenant(context.Background(), "acme")
+
+	first, err := svc.GetStats(acme)
+	require.NoError(t, err)
+	now = now.Add(9 * time.Second)
+	second, err := svc.GetStats(acme)
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+	assert.Equal(t, 2, queries, "one computation within the TTL")
+
+	_, err = svc.GetStats(domain.WithTenant(context.Background(), "globex"))
+	require.NoError(t, err)
+	assert.Equal(t, 4, queries, "tenants are computed separately")
+
+	now = now.Add(time.Second)
+	third, err := svc.GetStats(acme)
+	require.NoError(t, err)
+	assert.NotSame(t, first, third)
+	assert.Equal(t, 6, queries)
+}
+
+func TestStatsService_GetStats_CustomerCaller_AccessDenied(t *testing.T) {
+	ctx := domain.WithPrincipal(context.Background(), &domain.Principal{Role: domain.RoleCustomer, CustomerID: "cust-1"})
+
+	_, err := NewStatsService(&mocks.ReportRepositoryMock{}, nil, time.Second).GetStats(ctx)
+
+	assert.ErrorIs(t, err, domain.ErrAccessDenied)
+}
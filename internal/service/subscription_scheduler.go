@@ -0,0 +1,156 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// subscriptionBatchSize caps the subscriptions run in one pass
+const subscriptionBatchSize = 100
+
+// Orders placed by a subscription carry its ID in this metadata key and are
+// tagged SubscriptionOrderTag.
+const (
+	SubscriptionMetadataKey = "subscription_id"
+	SubscriptionOrderTag    = "subscription"
+)
+
+// SubscriptionScheduler places the orders of due subscriptions
+type SubscriptionScheduler interface {
+	// PlaceDueOrders places an order for up to one batch of due
+	// subscriptions, moving each to its next run, and returns how many
+	// orders were placed. Subscriptions changed concurrently, e.g. run by
+	// another replica, are skipped.
+	PlaceDueOrders(ctx context.Context) (int, error)
+
+	// Run places due orders immediately and then every interval until ctx is cancelled
+	Run(ctx context.Context, interval time.Duration)
+}
+
+// subscriptionSchedulerImpl implements SubscriptionScheduler
+type subscriptionSchedulerImpl struct {
+	subs   repository.SubscriptionRepository
+	orders OrderService
+	now    func() time.Time
+}
+
+// NewSubscriptionScheduler creates a new SubscriptionScheduler. Orders are
+// created through orders so they are validated and published like any other.
+func NewSubscriptionScheduler(subs repository.SubscriptionRepository, orders OrderService) SubscriptionScheduler {
+	return &subscriptionSchedulerImpl{
+		subs:   subs,
+		orders: orders,
+		now:    time.Now,
+	}
+}
+
+func (s *subscriptionSchedulerImpl) PlaceDueOrders(ctx context.Context) (int, error) {
+	ctx = domain.WithSystemActor(ctx)
+
+	ids, err := s.subs.ListDue(ctx, s.now(), subscriptionBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	placed := 0
+	for _, id := range ids {
+		sub, err := s.claim(ctx, id)
+		switch {
+		case err == nil:
+		case errors.Is(err, domain.ErrSubscriptionNotDue),
+			errors.Is(err, domain.ErrSubscriptionNotFound),
+			errors.Is(err, domain.ErrConcurrentModification):
+			// Run, paused or deleted since it was listed
+			continue
+		default:
+			return placed, err
+		}
+
+		// The run is claimed before the order is created so replicas never
+		// place it twice; an order that fails here is not retried. The job
+		// sees every tenant; the order belongs to the subscription's.
+		order, err := s.orders.CreateOrder(domain.WithTenant(ctx, sub.TenantID), CreateOrderDTO{
+			CustomerID:      sub.CustomerID,
+			Items:           sub.Items,
+			Metadata:        map[string]string{SubscriptionMetadataKey: sub.ID.String()},
+			Tags:            []string{SubscriptionOrderTag},
+			ShippingAddress: sub.ShippingAddress,
+			ShippingMethod:  sub.ShippingMethod,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return placed, ctx.Err()
+			}
+			slog.Warn("subscription order failed",
+				slog.String("subscription_id", id),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		slog.Debug("placed subscription order",
+			slog.String("subscription_id", id),
+			slog.String("order_id", order.ID.String()),
+		)
+		placed++
+	}
+
+	if placed > 0 {
+		slog.Info("placed subscription orders", slog.Int("count", placed))
+	}
+	return placed, nil
+}
+
+// claim moves a due subscription to its next run, so that only the caller
+// whose version check succeeds places the order
+func (s *subscriptionSchedulerImpl) claim(ctx context.Context, id string) (*domain.Subscription, error) {
+	sub, err := s.subs.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if sub == nil {
+		return nil, domain.ErrSubscriptionNotFound
+	}
+	if err := sub.Advance(s.now()); err != nil {
+		return nil, err
+	}
+	if err := s.subs.Update(ctx, sub); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+func (s *subscriptionSchedulerImpl) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.PlaceDueOrders(ctx); err != nil && ctx.Err() == nil {
+			slog.Warn("subscription run failed", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
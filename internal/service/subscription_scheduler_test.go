@@ -0,0 +1,168 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionScheduler_PlaceDueOrders_PlacesAndAdvances(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	due := createMockSubscription("cust-1", now.Add(-time.Minute))
+	claimed := createMockSubscription("cust-2", now.Add(-time.Minute))
+	paused := createMockSubscription("cust-3", now.Add(-time.Minute))
+	paused.Status = domain.SubscriptionStatusPaused
+	subs := map[string]*domain.Subscription{
+		due.ID.String():     due,
+		claimed.ID.String(): claimed,
+		paused.ID.String():  paused,
+	}
+
+	repo := &mocks.SubscriptionRepositoryMock{
+		ListDueFunc: func(_ context.Context, at time.Time, limit int) ([]string, error) {
+			assert.True(t, now.Equal(at))
+			assert.Equal(t, subscriptionBatchSize, limit)
+			return []string{due.ID.String(), claimed.ID.String(), paused.ID.String()}, nil
+		},
+		FindByIDFunc: func(_ context.Context, id string) (*domain.Subscription, error) {
+			return subs[id], nil
+		},
+		UpdateFunc: func(_ context.Context, sub *domain.Subscription) error {
+			if sub.ID == claimed.ID {
+				return domain.ErrConcurrentModification
+			}
+			return nil
+		},
+	}
+	var created []*domain.Order
+	var actors []string
+	orderRepo := &mocks.OrderRepositoryMock{
+		CreateFunc: func(ctx context.Context, order *domain.Order) error {
+			created = append(created, order)
+			actors = append(actors, domain.ActorFromContext(ctx))
+			return nil
+		},
+	}
+
+	svc := &subscriptionSchedulerImpl{
+		subs:   repo,
+		orders: NewOrderService(orderRepo, nil, nil, nil, nil, deliveryConfig()),
+		now:    func() time.Time { return now },
+	}
+	placed, err := svc.PlaceDueOrders(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, placed, "claimed and paused subscriptions are skipped")
+	require.Len(t, created, 1)
+	order := created[0]
+	assert.Equal(t, "cust-1", order.CustomerID)
+	assert.Equal(t, due.ID.String(), order.Metadata[SubscriptionMetadataKey])
+	assert.Equal(t, []string{SubscriptionOrderTag}, order.Tags)
+	assert.Equal(t, "standard", order.ShippingMethod)
+	assert.Equal(t, 37.0, order.Total)
+	assert.Equal(t, []string{domain.ActorSystem}, actors)
+	assert.True(t, now.Add(-time.Minute).AddDate(0, 0, 7).Equal(due.NextRunAt), "next run %s", due.NextRunAt)
+}
+
+func TestSubscriptionScheduler_PlaceDueOrders_PlacesInSubscriptionTenant(t *testing.T) {
+	now := time.Now()
+	acme := createMockSubscription("cust-1", now)
+	acme.TenantID = "acme"
+	globex := createMockSubscription("cust-1", now)
+	globex.TenantID = "globex"
+	subs := map[string]*domain.Subscription{acme.ID.String(): acme, globex.ID.String(): globex}
+
+	repo := &mocks.SubscriptionRepositoryMock{
+		ListDueFunc: func(ctx context.Context, _ time.Time, _ int) ([]string, error) {
+			assert.Empty(t, domain.TenantID(ctx), "due subscriptions of every tenant are listed")
+			return []string{acme.ID.String(), globex.ID.String()}, nil
+		},
+		FindByIDFunc: func(_ context.Context, id string) (*domain.Subscription, error) {
+			return subs[id], nil
+		},
+	}
+	var tenants []string
+	orderRepo := &mocks.OrderRepositoryMock{
+		CreateFunc: func(ctx context.Context, order *domain.Order) error {
+			tenants = append(tenants, domain.TenantID(ctx))
+			assert.Equal(t, domain.TenantID(ctx), order.TenantID)
+			return nil
+		},
+	}
+
+	svc := &subscriptionSchedulerImpl{
+		subs:   repo,
+		orders: NewOrderService(orderRepo, nil, nil, nil, nil, deliveryConfig()),
+		now:    func() time.Time { return now },
+	}
+	placed, err := svc.PlaceDueOrders(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, placed)
+	assert.Equal(t, []string{"acme", "globex"}, tenants)
+}
+
+func TestSubscriptionScheduler_PlaceDueOrders_OrderFails_ContinuesBatch(t *testing.T) {
+	now := time.Now()
+	failing := createMockSubscription("cust-1", now)
+	failing.ShippingMethod = "retired"
+	ok := createMockSubscription("cust-2", now)
+
+	repo := &mocks.SubscriptionRepositoryMock{
+		ListDueFunc: func(_ context.Context, _ time.Time, _ int) ([]string, error) {
+			return []string{failing.ID.String(), ok.ID.String()}, nil
+		},
+		FindByIDFunc: func(_ context.Context, id string) (*domain.Subscription, error) {
+			if id == failing.ID.String() {
+				return failing, nil
+			}
+			return ok, nil
+		},
+	}
+	orderRepo := &mocks.OrderRepositoryMock{}
+
+	svc := &subscriptionSchedulerImpl{
+		subs:   repo,
+		orders: NewOrderService(orderRepo, nil, nil, nil, nil, deliveryConfig()),
+		now:    func() time.Time { return now },
+	}
+	placed, err := svc.PlaceDueOrders(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, placed)
+	assert.True(t, failing.NextRunAt.After(now), "a failed run is not retried")
+}
+
+func TestSubscriptionScheduler_PlaceDueOrders_RepositoryError_ReturnsError(t *testing.T) {
+	dbErr := errors.New("connection refused")
+	repo := &mocks.SubscriptionRepositoryMock{
+		ListDueFunc: func(_ context.Context, _ time.Time, _ int) ([]string, error) {
+			return nil, dbErr
+		},
+	}
+
+	svc := NewSubscriptionScheduler(repo, NewOrderService(&mocks.OrderRepositoryMock{}, nil, nil, nil, nil, nil))
+	_, err := svc.PlaceDueOrders(context.Background())
+
+	assert.ErrorIs(t, err, dbErr)
+}
@@ -0,0 +1,246 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+)
+
+// SubscriptionService manages recurring order subscriptions. Customer
+// tokens may only manage subscriptions of their own customer ID.
+type SubscriptionService interface {
+	// CreateSubscription validates and prices the order template as
+	// CreateOrder would and registers an active subscription. Each order
+	// placed from it is priced again when it is placed.
+	CreateSubscription(ctx context.Context, dto CreateSubscriptionDTO) (*domain.Subscription, error)
+
+	// GetSubscription returns a subscription the caller may access
+	GetSubscription(ctx context.Context, id string) (*domain.Subscription, error)
+
+	// ListSubscriptions returns subscriptions oldest first. An empty
+	// customerID lists every customer's, or the caller's own for customer tokens.
+	ListSubscriptions(ctx context.Context, customerID string, limit, offset int) (*SubscriptionList, error)
+
+	// UpdateSubscription replaces the order template. Orders already placed
+	// are unchanged. If expectedVersion is set and differs from the stored
+	// version, domain.ErrVersionMismatch is returned without modifying it.
+	UpdateSubscription(ctx context.Context, id string, dto UpdateSubscriptionDTO, expectedVersion *int) (*domain.Subscription, error)
+
+	// DeleteSubscription stops and removes a subscription. Orders it placed are kept.
+	DeleteSubscription(ctx context.Context, id string) error
+
+	// PauseSubscription stops an active subscription from placing orders.
+	// expectedVersion is checked as in UpdateSubscription.
+	PauseSubscription(ctx context.Context, id string, expectedVersion *int) (*domain.Subscription, error)
+
+	// ResumeSubscription reactivates a paused subscription; runs missed while
+	// it was paused are skipped. expectedVersion is checked as in UpdateSubscription.
+	ResumeSubscription(ctx context.Context, id string, expectedVersion *int) (*domain.Subscription, error)
+
+	// SkipNextRun moves the next run on by one cadence without placing an
+	// order. expectedVersion is checked as in UpdateSubscription.
+	SkipNextRun(ctx context.Context, id string, expectedVersion *int) (*domain.Subscription, error)
+}
+
+// SubscriptionList is a page of subscriptions
+type SubscriptionList struct {
+	Data  []*domain.Subscription
+	Total int64
+}
+
+// subscriptionServiceImpl implements SubscriptionService
+type subscriptionServiceImpl struct {
+	subs    repository.SubscriptionRepository
+	pricing PricingService
+	config  ConfigProvider
+	now     func() time.Time
+}
+
+// NewSubscriptionService creates a new SubscriptionService. Templates are
+// priced and validated as orders would be; a nil pricing keeps
+// client-supplied prices and a nil config uses DefaultSettings.
+func NewSubscriptionService(subs repository.SubscriptionRepository, pricing PricingService, config ConfigProvider) SubscriptionService {
+	if pricing == nil {
+		pricing = PassthroughPricing{}
+	}
+	if config == nil {
+		config = StaticConfig(DefaultSettings)
+	}
+	return &subscriptionServiceImpl{
+		subs:    subs,
+		pricing: pricing,
+		config:  config,
+		now:     time.Now,
+	}
+}
+
+func (s *subscriptionServiceImpl) CreateSubscription(ctx context.Context, dto CreateSubscriptionDTO) (*domain.Subscription, error) {
+	items, shipping, err := s.validateTemplate(ctx, dto.CustomerID, dto.Items, dto.ShippingMethod, dto.ShippingAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := domain.AuthorizeCustomer(ctx, dto.CustomerID); err != nil {
+		return nil, err
+	}
+
+	sub, err := domain.NewSubscription(dto.CustomerID, items, dto.Cadence, dto.FirstRunAt, s.now())
+	if err != nil {
+		return nil, err
+	}
+	sub.ShippingMethod = dto.ShippingMethod
+	sub.ShippingAddress = shipping
+	sub.TenantID = domain.TenantID(ctx)
+
+	if err := s.subs.Create(ctx, sub); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+func (s *subscriptionServiceImpl) GetSubscription(ctx context.Context, id string) (*domain.Subscription, error) {
+	return s.findSubscription(ctx, id)
+}
+
+func (s *subscriptionServiceImpl) ListSubscriptions(ctx context.Context, customerID string, limit, offset int) (*SubscriptionList, error) {
+	if limit < 1 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	if p, ok := domain.PrincipalFromContext(ctx); ok && p.Role == domain.RoleCustomer && customerID == "" {
+		customerID = p.CustomerID
+	}
+	if customerID == "" {
+		if err := domain.AuthorizeAllCustomers(ctx); err != nil {
+			return nil, err
+		}
+	} else if err := domain.AuthorizeCustomer(ctx, customerID); err != nil {
+		return nil, err
+	}
+
+	subs, total, err := s.subs.List(ctx, customerID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return &SubscriptionList{Data: subs, Total: total}, nil
+}
+
+func (s *subscriptionServiceImpl) UpdateSubscription(ctx context.Context, id string, dto UpdateSubscriptionDTO, expectedVersion *int) (*domain.Subscription, error) {
+	return s.modify(ctx, id, expectedVersion, func(sub *domain.Subscription, now time.Time) error {
+		items, shipping, err := s.validateTemplate(ctx, sub.CustomerID, dto.Items, dto.ShippingMethod, dto.ShippingAddress)
+		if err != nil {
+			return err
+		}
+		if err := sub.SetTemplate(items, dto.Cadence); err != nil {
+			return err
+		}
+		sub.ShippingMethod = dto.ShippingMethod
+		sub.ShippingAddress = shipping
+		sub.UpdatedAt = now
+		return nil
+	})
+}
+
+func (s *subscriptionServiceImpl) DeleteSubscription(ctx context.Context, id string) error {
+	if _, err := s.findSubscription(ctx, id); err != nil {
+		return err
+	}
+	return s.subs.Delete(ctx, id)
+}
+
+func (s *subscriptionServiceImpl) PauseSubscription(ctx context.Context, id string, expectedVersion *int) (*domain.Subscription, error) {
+	return s.modify(ctx, id, expectedVersion, (*domain.Subscription).Pause)
+}
+
+func (s *subscriptionServiceImpl) ResumeSubscription(ctx context.Context, id string, expectedVersion *int) (*domain.Subscription, error) {
+	return s.modify(ctx, id, expectedVersion, (*domain.Subscription).Resume)
+}
+
+func (s *subscriptionServiceImpl) SkipNextRun(ctx context.Context, id string, expectedVersion *int) (*domain.Subscription, error) {
+	return s.modify(ctx, id, expectedVersion, func(sub *domain.Subscription, now time.Time) error {
+		sub.Skip(now)
+		return nil
+	})
+}
+
+// modify applies change to a subscription the caller may access and stores it
+func (s *subscriptionServiceImpl) modify(ctx context.Context, id string, expectedVersion *int, change func(*domain.Subscription, time.Time) error) (*domain.Subscription, error) {
+	sub, err := s.findSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if expectedVersion != nil && *expectedVersion != sub.Version {
+		return nil, domain.ErrVersionMismatch
+	}
+	if err := change(sub, s.now()); err != nil {
+		return nil, err
+	}
+	if err := s.subs.Update(ctx, sub); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// validateTemplate checks that an order could be created from the template
+// and returns the priced items and the normalized shipping address
+func (s *subscriptionServiceImpl) validateTemplate(ctx context.Context, customerID string, items []domain.OrderItem, shippingMethod string, shipping *domain.Address) ([]domain.OrderItem, *domain.Address, error) {
+	settings := s.config.Settings()
+	prices, err := lookupPrices(ctx, s.pricing, items)
+	if err != nil {
+		return nil, nil, err
+	}
+	if items, err = applyPrices(items, prices, settings.RequireServerPricing); err != nil {
+		return nil, nil, err
+	}
+
+	order, err := newOrder(CreateOrderDTO{
+		CustomerID:      customerID,
+		Items:           items,
+		ShippingAddress: shipping,
+		ShippingMethod:  shippingMethod,
+	}, settings)
+	if err != nil {
+		return nil, nil, err
+	}
+	return items, order.ShippingAddress, nil
+}
+
+// findSubscription returns the subscription with id if the caller may access it
+func (s *subscriptionServiceImpl) findSubscription(ctx context.Context, id string) (*domain.Subscription, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, domain.ErrSubscriptionNotFound
+	}
+	sub, err := s.subs.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if sub == nil {
+		return nil, domain.ErrSubscriptionNotFound
+	}
+	if err := domain.AuthorizeCustomer(ctx, sub.CustomerID); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
@@ -0,0 +1,227 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func subscriptionItems() []domain.OrderItem {
+	return []domain.OrderItem{{ProductID: "coffee-1kg", Name: "Coffee beans", Quantity: 2, Price: 18.50}}
+}
+
+func createMockSubscription(customerID string, now time.Time) *domain.Subscription {
+	sub, err := domain.NewSubscription(customerID, subscriptionItems(), domain.CadenceWeekly, now, now)
+	if err != nil {
+		panic(err)
+	}
+	sub.Version = 1
+	return sub
+}
+
+func TestSubscriptionService_CreateSubscription(t *testing.T) {
+	otherCustomer := &domain.Principal{Subject: "cust", Role: domain.RoleCustomer, CustomerID: "cust-2"}
+
+	tests := []struct {
+		name      string
+		dto       CreateSubscriptionDTO
+		principal *domain.Principal
+		wantErr   error
+	}{
+		{name: "valid", dto: CreateSubscriptionDTO{CustomerID: "cust-1", Items: subscriptionItems(), Cadence: domain.CadenceWeekly}},
+		{name: "express", dto: CreateSubscriptionDTO{CustomerID: "cust-1", Items: subscriptionItems(), Cadence: domain.CadenceMonthly, ShippingMethod: "express"}},
+		{name: "unknown cadence", dto: CreateSubscriptionDTO{CustomerID: "cust-1", Items: subscriptionItems(), Cadence: "hourly"}, wantErr: domain.ErrInvalidCadence},
+		{name: "no items", dto: CreateSubscriptionDTO{CustomerID: "cust-1", Cadence: domain.CadenceWeekly}, wantErr: domain.ErrNoItems},
+		{name: "unknown shipping method", dto: CreateSubscriptionDTO{CustomerID: "cust-1", Items: subscriptionItems(), Cadence: domain.CadenceWeekly, ShippingMethod: "drone"}, wantErr: domain.ErrInvalidShippingMethod},
+		{name: "other customer", dto: CreateSubscriptionDTO{CustomerID: "cust-1", Items: subscriptionItems(), Cadence: domain.CadenceWeekly}, principal: otherCustomer, wantErr: domain.ErrAccessDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved *domain.Subscription
+			repo := &mocks.SubscriptionRepositoryMock{
+				CreateFunc: func(_ context.Context, sub *domain.Subscription) error {
+					saved = sub
+					return nil
+				},
+			}
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = domain.WithPrincipal(ctx, tt.principal)
+			}
+
+			svc := NewSubscriptionService(repo, nil, deliveryConfig())
+			sub, err := svc.CreateSubscription(ctx, tt.dto)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, saved)
+				return
+			}
+			require.NoError(t, err)
+			assert.Same(t, sub, saved)
+			assert.Equal(t, domain.SubscriptionStatusActive, sub.Status)
+			assert.Equal(t, tt.dto.Cadence, sub.Cadence)
+			assert.Equal(t, tt.dto.ShippingMethod, sub.ShippingMethod)
+			assert.False(t, sub.NextRunAt.IsZero(), "a zero first run is placed on the next pass")
+		})
+	}
+}
+
+func TestSubscriptionService_CreateSubscription_NormalizesAddress(t *testing.T) {
+	repo := &mocks.SubscriptionRepositoryMock{}
+	shipping := testAddress()
+	shipping.Country = "gb"
+
+	svc := NewSubscriptionService(repo, nil, deliveryConfig())
+	sub, err := svc.CreateSubscription(context.Background(), CreateSubscriptionDTO{
+		CustomerID:      "cust-1",
+		Items:           subscriptionItems(),
+		Cadence:         domain.CadenceDaily,
+		ShippingAddress: &shipping,
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, sub.ShippingAddress)
+	assert.Equal(t, "GB", sub.ShippingAddress.Country)
+}
+
+func TestSubscriptionService_CreateSubscription_Tenant(t *testing.T) {
+	repo := &mocks.SubscriptionRepositoryMock{}
+
+	svc := NewSubscriptionService(repo, nil, deliveryConfig())
+	sub, err := svc.CreateSubscription(domain.WithTenant(context.Background(), "acme"), CreateSubscriptionDTO{
+		CustomerID: "cust-1",
+		Items:      subscriptionItems(),
+		Cadence:    domain.CadenceWeekly,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "acme", sub.TenantID)
+}
+
+func TestSubscriptionService_ListSubscriptions_CustomerScope(t *testing.T) {
+	customer := &domain.Principal{Subject: "cust", Role: domain.RoleCustomer, CustomerID: "cust-1"}
+	operator := &domain.Principal{Subject: "ops", Role: domain.RoleService}
+
+	tests := []struct {
+		name         string
+		principal    *domain.Principal
+		customerID   string
+		wantCustomer string
+		wantErr      error
+	}{
+		{name: "customer defaults to own", principal: customer, wantCustomer: "cust-1"},
+		{name: "customer names own", principal: customer, customerID: "cust-1", wantCustomer: "cust-1"},
+		{name: "customer names other", principal: customer, customerID: "cust-2", wantErr: domain.ErrAccessDenied},
+		{name: "service lists all", principal: operator},
+		{name: "service filters", principal: operator, customerID: "cust-2", wantCustomer: "cust-2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listed := "unset"
+			repo := &mocks.SubscriptionRepositoryMock{
+				ListFunc: func(_ context.Context, customerID string, limit, _ int) ([]*domain.Subscription, int64, error) {
+					listed = customerID
+					assert.Equal(t, 20, limit)
+					return nil, 0, nil
+				},
+			}
+			ctx := domain.WithPrincipal(context.Background(), tt.principal)
+
+			svc := NewSubscriptionService(repo, nil, nil)
+			_, err := svc.ListSubscriptions(ctx, tt.customerID, 0, 0)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, "unset", listed)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCustomer, listed)
+		})
+	}
+}
+
+func TestSubscriptionService_Controls(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	version := func(v int) *int { return &v }
+
+	tests := []struct {
+		name            string
+		paused          bool
+		expectedVersion *int
+		control         func(SubscriptionService, context.Context, string, *int) (*domain.Subscription, error)
+		wantStatus      domain.SubscriptionStatus
+		wantNextRun     time.Time
+		wantErr         error
+	}{
+		{name: "pause", control: SubscriptionService.PauseSubscription, wantStatus: domain.SubscriptionStatusPaused, wantNextRun: now},
+		{name: "pause paused", paused: true, control: SubscriptionService.PauseSubscription, wantErr: domain.ErrInvalidSubscriptionTransition},
+		{name: "resume", paused: true, control: SubscriptionService.ResumeSubscription, wantStatus: domain.SubscriptionStatusActive, wantNextRun: now},
+		{name: "resume active", control: SubscriptionService.ResumeSubscription, wantErr: domain.ErrInvalidSubscriptionTransition},
+		{name: "skip", control: SubscriptionService.SkipNextRun, wantStatus: domain.SubscriptionStatusActive, wantNextRun: now.AddDate(0, 0, 7)},
+		{name: "version matches", expectedVersion: version(1), control: SubscriptionService.SkipNextRun, wantStatus: domain.SubscriptionStatusActive, wantNextRun: now.AddDate(0, 0, 7)},
+		{name: "version mismatch", expectedVersion: version(2), control: SubscriptionService.SkipNextRun, wantErr: domain.ErrVersionMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored := createMockSubscription("cust-1", now)
+			if tt.paused {
+				stored.Status = domain.SubscriptionStatusPaused
+			}
+			updated := false
+			repo := &mocks.SubscriptionRepositoryMock{
+				FindByIDFunc: func(_ context.Context, _ string) (*domain.Subscription, error) { return stored, nil },
+				UpdateFunc: func(_ context.Context, _ *domain.Subscription) error {
+					updated = true
+					return nil
+				},
+			}
+
+			svc := &subscriptionServiceImpl{subs: repo, config: deliveryConfig(), now: func() time.Time { return now }}
+			sub, err := tt.control(svc, context.Background(), stored.ID.String(), tt.expectedVersion)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, updated)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, updated)
+			assert.Equal(t, tt.wantStatus, sub.Status)
+			assert.True(t, tt.wantNextRun.Equal(sub.NextRunAt), "next run %s", sub.NextRunAt)
+		})
+	}
+}
+
+func TestSubscriptionService_GetSubscription_NotFound(t *testing.T) {
+	repo := &mocks.SubscriptionRepositoryMock{}
+	svc := NewSubscriptionService(repo, nil, nil)
+
+	for _, id := range []string{"not-a-uuid", "6f1c8a52-1d1e-4b8a-9d43-3c2b8f0e9a11"} {
+		_, err := svc.GetSubscription(context.Background(), id)
+		assert.ErrorIs(t, err, domain.ErrSubscriptionNotFound, id)
+	}
+}
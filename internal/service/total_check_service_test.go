@@ -0,0 +1,155 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// driftedOrder is an order whose total was summed in floats: 3 × 0.10 stored as 0.30000000000000004
+// and a second item whose subtotal was never updated after its quantity changed
+func driftedOrder() *domain.Order {
+	order := createMockOrder(domain.OrderStatusConfirmed)
+	order.Items = []domain.OrderItem{
+		{ID: uuid.New(), ProductID: "product-1", Name: "Clip", Quantity: 3, Price: 0.10, Subtotal: 0.30000000000000004},
+		{ID: uuid.New(), ProductID: "product-2", Name: "Pad", Quantity: 2, Price: 4.00, Subtotal: 4.00},
+	}
+	order.Total = 4.30000000000000004
+	return order
+}
+
+func TestOrderService_RepairTotals_RecomputesAmounts(t *testing.T) {
+	order := driftedOrder()
+	var published bool
+	mockRepo := &mocks.OrderRepositoryMock{
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+	}
+	publisher := &mocks.EventPublisherMock{
+		PublishOrderUpdatedFunc: func(_ context.Context, _ *domain.Order) error {
+			published = true
+			return nil
+		},
+	}
+
+	svc := NewOrderService(mockRepo, nil, nil, publisher, nil, nil)
+	got, err := svc.RepairTotals(context.Background(), order.ID.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, 0.30, got.Items[0].Subtotal)
+	assert.Equal(t, 8.00, got.Items[1].Subtotal)
+	assert.Equal(t, 8.30, got.Total)
+	assert.True(t, published)
+}
+
+func TestOrderService_RepairTotals_Consistent_ReturnsErrTotalsConsistent(t *testing.T) {
+	order := patchableOrder()
+	mockRepo := patchRepo(order, nil)
+	mockRepo.UpdateFunc = func(_ context.Context, _ *domain.Order) error {
+		t.Fatal("a consistent order must not be saved")
+		return nil
+	}
+
+	svc := NewOrderService(mockRepo, nil, nil, nil, nil, nil)
+	_, err := svc.RepairTotals(context.Background(), order.ID.String())
+
+	assert.ErrorIs(t, err, domain.ErrTotalsConsistent)
+}
+
+func TestTotalCheckService_CheckTotals_PagesAndReportsMismatches(t *testing.T) {
+	var afterIDs []string
+	all := make([]domain.OrderAmounts, totalCheckBatchSize+2)
+	for i := range all {
+		all[i] = domain.OrderAmounts{OrderID: fmt.Sprintf("order-%04d", i), Total: 10.00, ItemTotal: 10.00}
+	}
+	all[3].Total = 10.000000000000002 // float drift within a cent is not a mismatch
+	all[7].ItemTotal = 12.00
+	all[totalCheckBatchSize+1].BadSubtotals = 1
+	repo := &mocks.OrderRepositoryMock{
+		ListOrderAmountsFunc: func(_ context.Context, afterID string, limit int) ([]domain.OrderAmounts, error) {
+			afterIDs = append(afterIDs, afterID)
+			if afterID == "" {
+				return all[:limit], nil
+			}
+			return all[limit:], nil
+		},
+	}
+	metrics := NewTotalCheckMetrics(prometheus.NewRegistry())
+
+	svc := NewTotalCheckService(repo, NewOrderService(repo, nil, nil, nil, nil, nil), metrics)
+	report, err := svc.CheckTotals(context.Background(), false)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", all[totalCheckBatchSize-1].OrderID}, afterIDs)
+	assert.Equal(t, len(all), report.Checked)
+	assert.Equal(t, 2, report.MismatchCount)
+	assert.Equal(t, []domain.OrderAmounts{all[7], all[totalCheckBatchSize+1]}, report.Mismatches)
+	assert.Zero(t, report.Repaired)
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.mismatches))
+	assert.Equal(t, float64(len(all)), testutil.ToFloat64(metrics.checked))
+}
+
+func TestTotalCheckService_CheckTotals_Repair_CorrectsMismatches(t *testing.T) {
+	order := driftedOrder()
+	var saved *domain.Order
+	repo := &mocks.OrderRepositoryMock{
+		ListOrderAmountsFunc: func(_ context.Context, _ string, _ int) ([]domain.OrderAmounts, error) {
+			return []domain.OrderAmounts{{OrderID: order.ID.String(), Total: order.Total, ItemTotal: 8.30, BadSubtotals: 1}}, nil
+		},
+		FindByIDFunc: func(_ context.Context, _ string) (*domain.Order, error) { return order, nil },
+		UpdateFunc: func(ctx context.Context, o *domain.Order) error {
+			assert.Equal(t, domain.ActorSystem, domain.ActorFromContext(ctx))
+			saved = o
+			return nil
+		},
+	}
+	metrics := NewTotalCheckMetrics(prometheus.NewRegistry())
+
+	svc := NewTotalCheckService(repo, NewOrderService(repo, nil, nil, nil, nil, nil), metrics)
+	report, err := svc.CheckTotals(context.Background(), true)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Repaired)
+	require.NotNil(t, saved)
+	assert.Equal(t, 8.30, saved.Total)
+	assert.Zero(t, testutil.ToFloat64(metrics.mismatches))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.repaired))
+}
+
+func TestTotalCheckService_CheckTotals_RepositoryError_ReturnsError(t *testing.T) {
+	dbErr := errors.New("connection refused")
+	repo := &mocks.OrderRepositoryMock{
+		ListOrderAmountsFunc: func(_ context.Context, _ string, _ int) ([]domain.OrderAmounts, error) {
+			return nil, dbErr
+		},
+	}
+	metrics := NewTotalCheckMetrics(prometheus.NewRegistry())
+
+	svc := NewTotalCheckService(repo, NewOrderService(repo, nil, nil, nil, nil, nil), metrics)
+	_, err := svc.CheckTotals(context.Background(), false)
+
+	assert.ErrorIs(t, err, dbErr)
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.failures))
+}
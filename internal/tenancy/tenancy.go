@@ -0,0 +1,116 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenancy
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+// Resolution modes, matching config.TenancyConfig.Mode
+const (
+	ModeNone      = "none"
+	ModeHeader    = "header"
+	ModeSubdomain = "subdomain"
+	ModeClaim     = "claim"
+)
+
+// DefaultHeader carries the tenant ID in header mode unless configured otherwise
+const DefaultHeader = "X-Tenant-ID"
+
+// Resolver works out which tenant a request belongs to. A nil Resolver, or one
+// in ModeNone, resolves every request to no tenant.
+type Resolver struct {
+	mode       string
+	header     string
+	baseDomain string
+}
+
+// NewResolver returns a resolver for mode. header names the header read in
+// ModeHeader (DefaultHeader if empty); baseDomain is the host suffix whose
+// leftmost extra label names the tenant in ModeSubdomain.
+func NewResolver(mode, header, baseDomain string) *Resolver {
+	if header == "" {
+		header = DefaultHeader
+	}
+	return &Resolver{
+		mode:       mode,
+		header:     header,
+		baseDomain: strings.ToLower(strings.TrimPrefix(baseDomain, ".")),
+	}
+}
+
+// Enabled reports whether requests must name a tenant
+func (r *Resolver) Enabled() bool {
+	return r != nil && r.mode != "" && r.mode != ModeNone
+}
+
+// Header returns the header read in ModeHeader
+func (r *Resolver) Header() string {
+	return r.header
+}
+
+// Resolve returns the tenant of a request given its tenant header value and
+// host. In ModeClaim the tenant comes from the principal in ctx, so
+// authentication must run first. It returns "" when tenancy is disabled,
+// domain.ErrTenantRequired when the request names no tenant and
+// domain.ErrInvalidTenantID when the name is malformed. A caller whose token
+// is bound to another tenant gets domain.ErrAccessDenied.
+func (r *Resolver) Resolve(ctx context.Context, header, host string) (string, error) {
+	if !r.Enabled() {
+		return "", nil
+	}
+
+	var tenantID string
+	switch r.mode {
+	case ModeHeader:
+		tenantID = strings.TrimSpace(header)
+	case ModeSubdomain:
+		tenantID = r.subdomain(host)
+	case ModeClaim:
+		if p, ok := domain.PrincipalFromContext(ctx); ok {
+			tenantID = p.TenantID
+		}
+	}
+
+	if tenantID == "" {
+		return "", domain.ErrTenantRequired
+	}
+	if !domain.ValidTenantID(tenantID) {
+		return "", domain.ErrInvalidTenantID
+	}
+	if p, ok := domain.PrincipalFromContext(ctx); ok && p.TenantID != "" && p.TenantID != tenantID {
+		return "", domain.ErrAccessDenied
+	}
+	return tenantID, nil
+}
+
+// subdomain returns the label in front of the base domain, so
+// "acme.orders.example.com" names tenant "acme" under "orders.example.com".
+// Hosts outside the base domain, or nested deeper than one label, name none.
+func (r *Resolver) subdomain(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	label, ok := strings.CutSuffix(host, "."+r.baseDomain)
+	if !ok || strings.Contains(label, ".") {
+		return ""
+	}
+	return label
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenancy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+)
+
+func TestResolver_Resolve_Modes(t *testing.T) {
+	withClaim := func(tenantID string) context.Context {
+		return domain.WithPrincipal(context.Background(), &domain.Principal{Role: domain.RoleService, TenantID: tenantID})
+	}
+
+	tests := []struct {
+		name     string
+		resolver *Resolver
+		ctx      context.Context
+		header   string
+		host     string
+		want     string
+		wantErr  error
+	}{
+		{name: "nil resolver", resolver: nil, header: "acme"},
+		{name: "mode none ignores header", resolver: NewResolver(ModeNone, "", ""), header: "acme"},
+		{name: "header", resolver: NewResolver(ModeHeader, "", ""), header: " acme ", want: "acme"},
+		{name: "header missing", resolver: NewResolver(ModeHeader, "", ""), wantErr: domain.ErrTenantRequired},
+		{name: "header malformed", resolver: NewResolver(ModeHeader, "", ""), header: "ACME_1", wantErr: domain.ErrInvalidTenantID},
+		{name: "subdomain", resolver: NewResolver(ModeSubdomain, "", "orders.example.com"), host: "acme.orders.example.com:8080", want: "acme"},
+		{name: "subdomain case-insensitive", resolver: NewResolver(ModeSubdomain, "", ".Orders.Example.com"), host: "Acme.orders.example.com", want: "acme"},
+		{name: "bare base domain", resolver: NewResolver(ModeSubdomain, "", "orders.example.com"), host: "orders.example.com", wantErr: domain.ErrTenantRequired},
+		{name: "nested subdomain", resolver: NewResolver(ModeSubdomain, "", "orders.example.com"), host: "a.b.orders.example.com", wantErr: domain.ErrTenantRequired},
+		{name: "foreign host", resolver: NewResolver(ModeSubdomain, "", "orders.example.com"), host: "acme.evil.com", wantErr: domain.ErrTenantRequired},
+		{name: "claim", resolver: NewResolver(ModeClaim, "", ""), ctx: withClaim("acme"), want: "acme"},
+		{name: "claim missing", resolver: NewResolver(ModeClaim, "", ""), ctx: withClaim(""), wantErr: domain.ErrTenantRequired},
+		{name: "claim without principal", resolver: NewResolver(ModeClaim, "", ""), wantErr: domain.ErrTenantRequired},
+		{name: "header matches claim", resolver: NewResolver(ModeHeader, "", ""), ctx: withClaim("acme"), header: "acme", want: "acme"},
+		{name: "header differs from claim", resolver: NewResolver(ModeHeader, "", ""), ctx: withClaim("globex"), header: "acme", wantErr: domain.ErrAccessDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			got, err := tt.resolver.Resolve(ctx, tt.header, tt.host)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
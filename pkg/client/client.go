@@ -0,0 +1,384 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package client is a Go client for the ordersvc HTTP API.
+//
+// Reads are retried on transient failures. Mutations are retried only when
+// they carry an Idempotency-Key, which the client generates automatically, so
+// a retried create or status change is applied at most once by the server.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header the server deduplicates mutations by.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// RequestIDHeader carries the request ID through to the server logs.
+	RequestIDHeader = "X-Request-ID"
+	// ActorHeader identifies the caller in the order history.
+	ActorHeader = "X-Actor"
+	// TenantHeader names the caller's tenant when the service resolves tenants by header.
+	TenantHeader = "X-Tenant-ID"
+
+	defaultUserAgent = "ordersvc-go-client"
+)
+
+// Client calls the ordersvc HTTP API. It is safe for concurrent use.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	userAgent  string
+	actor      string
+	tenant     string
+	token      string
+	retry      RetryPolicy
+}
+
+// RetryPolicy controls how transient failures are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first; 1 disables retries
+	MaxAttempts int
+	// BaseDelay is the backoff before the second attempt; it doubles on each retry
+	BaseDelay time.Duration
+	// MaxDelay caps a single backoff, including one requested by Retry-After
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy is used unless WithRetry is given.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   100 * time.Millisecond,
+	MaxDelay:    5 * time.Second,
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the underlying HTTP client; http.DefaultClient is used otherwise.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithRetry overrides DefaultRetryPolicy.
+func WithRetry(p RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = p
+	}
+}
+
+// WithActor sends an X-Actor header on every request, recorded in order history.
+func WithActor(actor string) Option {
+	return func(c *Client) {
+		c.actor = actor
+	}
+}
+
+// WithTenant sends an X-Tenant-ID header on every request, scoping it to the
+// tenant on services that resolve tenants by header.
+func WithTenant(tenantID string) Option {
+	return func(c *Client) {
+		c.tenant = tenantID
+	}
+}
+
+// WithBearerToken sends "Authorization: Bearer <token>" on every request,
+// for services that authenticate callers.
+func WithBearerToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithUserAgent sets the User-Agent header.
+func WithUserAgent(ua string) Option {
+	return func(c *Client) {
+		c.userAgent = ua
+	}
+}
+
+// New creates a client for the service at baseURL, e.g. "http://localhost:8080".
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: http.DefaultClient,
+		userAgent:  defaultUserAgent,
+		retry:      DefaultRetryPolicy,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.retry.MaxAttempts < 1 {
+		c.retry.MaxAttempts = 1
+	}
+	return c
+}
+
+// APIError is returned for any non-2xx response.
+type APIError struct {
+	StatusCode int
+	// Code is the machine-readable error code, e.g. "ORDER_NOT_FOUND"
+	Code    string
+	Message string
+	// Fields lists each failing field when Code is "VALIDATION_FAILED"
+	Fields []FieldError
+
+	// retryAfter is the wait the server asked for in Retry-After, if any
+	retryAfter time.Duration
+}
+
+// FieldError describes one request field the server rejected.
+type FieldError struct {
+	// Field is the JSON path of the field, e.g. "items[0].quantity"
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("ordersvc: HTTP %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("ordersvc: HTTP %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// IsNotFound reports whether err is an APIError with status 404.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an APIError with status 409, e.g. a version mismatch.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsForbidden reports whether err is an APIError with status 403, e.g. a
+// customer token used on another customer's orders.
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
+
+type requestIDKey struct{}
+
+// ContextWithRequestID returns a context whose requests carry id in X-Request-ID.
+// Without one, every attempt of a call shares a generated request ID.
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+func requestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// request describes one API call, which may be sent several times.
+type request struct {
+	method string
+	path   string
+	body   any
+	header http.Header
+}
+
+// retries reports whether the call is safe to send more than once: reads are,
+// and so are mutations the server deduplicates by idempotency key.
+func (r request) retries() bool {
+	return r.method == http.MethodGet || r.header.Get(IdempotencyKeyHeader) != ""
+}
+
+// do sends req, retrying transient failures, and decodes a 2xx body into out.
+func (c *Client) do(ctx context.Context, req request, out any) error {
+	var payload []byte
+	if req.body != nil {
+		b, err := json.Marshal(req.body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		payload = b
+	}
+
+	requestID := requestIDFromContext(ctx)
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+
+	attempts := 1
+	if req.retries() {
+		attempts = c.retry.MaxAttempts
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			if err := sleep(ctx, c.backoff(attempt, lastErr)); err != nil {
+				return err
+			}
+		}
+
+		resp, err := c.send(ctx, req, payload, requestID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			lastErr = err
+			continue
+		}
+
+		lastErr = decodeResponse(resp, out)
+		if lastErr == nil || !retryable(lastErr) {
+			return lastErr
+		}
+	}
+	return lastErr
+}
+
+func (c *Client) send(ctx context.Context, req request, payload []byte, requestID string) (*http.Response, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, req.method, c.baseURL+req.path, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	for k, v := range req.header {
+		httpReq.Header[k] = v
+	}
+	if payload != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	httpReq.Header.Set("Accept", "application/json")
+	httpReq.Header.Set("User-Agent", c.userAgent)
+	httpReq.Header.Set(RequestIDHeader, requestID)
+	if c.actor != "" {
+		httpReq.Header.Set(ActorHeader, c.actor)
+	}
+	if c.tenant != "" {
+		httpReq.Header.Set(TenantHeader, c.tenant)
+	}
+	if c.token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	return c.httpClient.Do(httpReq)
+}
+
+func decodeResponse(resp *http.Response, out any) error {
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if out == nil || resp.StatusCode == http.StatusNoContent {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			return nil
+		}
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		return nil
+	}
+
+	apiErr := &APIError{StatusCode: resp.StatusCode, Message: http.StatusText(resp.StatusCode)}
+	var body struct {
+		Error  string       `json:"error"`
+		Code   string       `json:"code"`
+		Errors []FieldError `json:"errors"`
+		// Detail carries the message when the server sends problem+json
+		Detail string `json:"detail"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&body); err == nil {
+		apiErr.Code = body.Code
+		apiErr.Fields = body.Errors
+		switch {
+		case body.Error != "":
+			apiErr.Message = body.Error
+		case body.Detail != "":
+			apiErr.Message = body.Detail
+		}
+	}
+
+	if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && secs > 0 {
+		apiErr.retryAfter = time.Duration(secs) * time.Second
+	}
+	return apiErr
+}
+
+// retryable reports whether a failed response may succeed if sent again.
+// Transport errors are always retried by the caller.
+func retryable(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	case http.StatusConflict:
+		// The same idempotency key is still being processed by another attempt.
+		return apiErr.Code == "IDEMPOTENCY_KEY_IN_USE"
+	}
+	return false
+}
+
+// backoff returns the delay before attempt (1-based retries) using exponential
+// backoff with full jitter, or the server's Retry-After if it gave one.
+func (c *Client) backoff(attempt int, lastErr error) time.Duration {
+	var apiErr *APIError
+	if errors.As(lastErr, &apiErr) && apiErr.retryAfter > 0 {
+		return min(apiErr.retryAfter, c.retry.MaxDelay)
+	}
+
+	d := c.retry.BaseDelay << (attempt - 1)
+	if d <= 0 || d > c.retry.MaxDelay {
+		d = c.retry.MaxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + rand.N(d/2+1) // #nosec G404 -- jitter, not security sensitive
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
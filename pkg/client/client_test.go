@@ -0,0 +1,413 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var fastRetry = WithRetry(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond})
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestClient_CreateOrder_RetriesWithSameIdempotencyKey(t *testing.T) {
+	var attempts atomic.Int32
+	var keys, requestIDs []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		requestIDs = append(requestIDs, r.Header.Get(RequestIDHeader))
+		if attempts.Add(1) == 1 {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "unavailable"})
+			return
+		}
+		var req CreateOrderRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		writeJSON(w, http.StatusCreated, Order{ID: "o-1", CustomerID: req.CustomerID, Status: StatusPending, Version: 1})
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL, fastRetry)
+	order, err := c.CreateOrder(context.Background(), CreateOrderRequest{
+		CustomerID: "cust-1",
+		Items:      []ItemInput{{ProductID: "p-1", Name: "Widget", Quantity: 1, Price: 5}},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "o-1", order.ID)
+	assert.Equal(t, "cust-1", order.CustomerID)
+	require.Len(t, keys, 2)
+	assert.NotEmpty(t, keys[0])
+	assert.Equal(t, keys[0], keys[1], "retries must reuse the idempotency key")
+	assert.Equal(t, requestIDs[0], requestIDs[1])
+}
+
+func TestClient_UpdateStatus_SendsVersionAndCallerKey(t *testing.T) {
+	var gotPath, gotKey, gotActor string
+	var gotBody map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath, gotKey, gotActor = r.URL.Path, r.Header.Get(IdempotencyKeyHeader), r.Header.Get(ActorHeader)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+		writeJSON(w, http.StatusOK, Order{ID: "o-1", Status: StatusConfirmed, Version: 4})
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL, WithActor("ops@example.com"))
+	order, err := c.UpdateStatus(context.Background(), "o-1", StatusConfirmed,
+		WithExpectedVersion(3), WithIdempotencyKey("key-1"))
+
+	require.NoError(t, err)
+	assert.Equal(t, StatusConfirmed, order.Status)
+	assert.Equal(t, "/api/v1/orders/o-1/status", gotPath)
+	assert.Equal(t, "key-1", gotKey)
+	assert.Equal(t, "ops@example.com", gotActor)
+	assert.Equal(t, map[string]any{"status": "confirmed", "version": float64(3)}, gotBody)
+}
+
+func TestClient_HoldOrder_SendsReasonAndDecodesHold(t *testing.T) {
+	var gotPath string
+	var gotBody map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+		writeJSON(w, http.StatusOK, map[string]any{
+			"id":      "o-1",
+			"status":  "on_hold",
+			"version": 2,
+			"hold":    map[string]any{"reason": "fraud review", "previous_status": "confirmed", "held_at": "2026-10-17T09:00:00Z"},
+		})
+	}))
+	defer srv.Close()
+
+	order, err := New(srv.URL).HoldOrder(context.Background(), "o-1", "fraud review", WithExpectedVersion(1))
+
+	require.NoError(t, err)
+	assert.Equal(t, StatusOnHold, order.Status)
+	require.NotNil(t, order.Hold)
+	assert.Equal(t, StatusConfirmed, order.Hold.PreviousStatus)
+	assert.Nil(t, order.Hold.ReleaseAt)
+	assert.Equal(t, "/api/v1/orders/o-1/hold", gotPath)
+	assert.Equal(t, map[string]any{"reason": "fraud review", "version": float64(1)}, gotBody)
+}
+
+func TestClient_UpdateItemStatus_SendsItemIDs(t *testing.T) {
+	var gotPath string
+	var gotBody map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+		writeJSON(w, http.StatusOK, map[string]any{
+			"id":      "o-1",
+			"status":  "processing",
+			"version": 4,
+			"items": []map[string]any{
+				{"id": "i-1", "status": "shipped"},
+				{"id": "i-2", "status": "pending"},
+			},
+		})
+	}))
+	defer srv.Close()
+
+	order, err := New(srv.URL).UpdateItemStatus(context.Background(), "o-1", []string{"i-1"}, ItemShipped)
+
+	require.NoError(t, err)
+	assert.Equal(t, StatusProcessing, order.Status)
+	require.Len(t, order.Items, 2)
+	assert.Equal(t, ItemShipped, order.Items[0].Status)
+	assert.Equal(t, "/api/v1/orders/o-1/items/status", gotPath)
+	assert.Equal(t, map[string]any{"item_ids": []any{"i-1"}, "status": "shipped"}, gotBody)
+}
+
+func TestClient_UpdateAddresses_SendsOnlyGivenAddress(t *testing.T) {
+	var gotPath string
+	var gotBody map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+		writeJSON(w, http.StatusOK, Order{ID: "o-1", Version: 3, ShippingAddress: &Address{Line1: "1 Main St", City: "Springfield", Country: "US"}})
+	}))
+	defer srv.Close()
+
+	shipping := &Address{Line1: "1 Main St", City: "Springfield", Country: "US"}
+	order, err := New(srv.URL).UpdateAddresses(context.Background(), "o-1", shipping, nil, WithExpectedVersion(2))
+
+	require.NoError(t, err)
+	assert.Equal(t, shipping, order.ShippingAddress)
+	assert.Equal(t, "/api/v1/orders/o-1/addresses", gotPath)
+	assert.Equal(t, map[string]any{
+		"shipping_address": map[string]any{"line1": "1 Main St", "city": "Springfield", "country": "US"},
+		"version":          float64(2),
+	}, gotBody)
+}
+
+func TestClient_AddNote_PostsBodyAndVisibility(t *testing.T) {
+	var gotPath string
+	var gotBody map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+		writeJSON(w, http.StatusCreated, map[string]any{
+			"id":         "n-1",
+			"order_id":   "o-1",
+			"author":     "ops@example.com",
+			"body":       "Fragile",
+			"visibility": "internal",
+			"created_at": "2026-10-17T09:00:00Z",
+		})
+	}))
+	defer srv.Close()
+
+	note, err := New(srv.URL).AddNote(context.Background(), "o-1", "Fragile", NoteInternal)
+
+	require.NoError(t, err)
+	assert.Equal(t, NoteInternal, note.Visibility)
+	assert.Equal(t, "ops@example.com", note.Author)
+	assert.Equal(t, "/api/v1/orders/o-1/notes", gotPath)
+	assert.Equal(t, map[string]any{"body": "Fragile", "visibility": "internal"}, gotBody)
+}
+
+func TestClient_ListOrders_SendsEveryTag(t *testing.T) {
+	var gotTags []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTags = r.URL.Query()["tag"]
+		writeJSON(w, http.StatusOK, OrderPage{
+			Orders: []Order{{ID: "o-1", Metadata: map[string]string{"channel": "web"}, Tags: []string{"gift", "vip"}}},
+			Total:  1,
+			Limit:  20,
+		})
+	}))
+	defer srv.Close()
+
+	page, err := New(srv.URL).ListOrders(context.Background(), ListOrdersOptions{Tags: []string{"gift", "vip"}})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"gift", "vip"}, gotTags)
+	require.Len(t, page.Orders, 1)
+	assert.Equal(t, "web", page.Orders[0].Metadata["channel"])
+	assert.Equal(t, []string{"gift", "vip"}, page.Orders[0].Tags)
+}
+
+func TestClient_GetOrder_BearerToken_Forbidden(t *testing.T) {
+	var gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		writeJSON(w, http.StatusForbidden, map[string]string{"error": "access to another customer's orders denied", "code": "ORDER_ACCESS_DENIED"})
+	}))
+	defer srv.Close()
+
+	_, err := New(srv.URL, fastRetry, WithBearerToken("tok")).GetOrder(context.Background(), "o-1")
+
+	assert.True(t, IsForbidden(err))
+	assert.Equal(t, "Bearer tok", gotAuth)
+}
+
+func TestClient_GetOrder_Tenant_SendsHeader(t *testing.T) {
+	var gotTenant string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTenant = r.Header.Get(TenantHeader)
+		writeJSON(w, http.StatusOK, map[string]any{"id": "o-1", "tenant_id": "acme", "status": "pending"})
+	}))
+	defer srv.Close()
+
+	order, err := New(srv.URL, WithTenant("acme")).GetOrder(context.Background(), "o-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "acme", gotTenant)
+	assert.Equal(t, "acme", order.TenantID)
+}
+
+func TestClient_GetOrder_NotFound_ReturnsAPIError(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "order not found", "code": "ORDER_NOT_FOUND"})
+	}))
+	defer srv.Close()
+
+	_, err := New(srv.URL, fastRetry).GetOrder(context.Background(), "missing")
+
+	require.Error(t, err)
+	assert.True(t, IsNotFound(err))
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "ORDER_NOT_FOUND", apiErr.Code)
+	assert.Equal(t, int32(1), attempts.Load(), "client errors must not be retried")
+}
+
+func TestClient_CreateOrder_ValidationFailed_ReturnsFieldErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusBadRequest, map[string]any{
+			"error": "request validation failed",
+			"code":  "VALIDATION_FAILED",
+			"errors": []map[string]string{
+				{"field": "customer_id", "code": "REQUIRED", "message": "is required"},
+			},
+		})
+	}))
+	defer srv.Close()
+
+	_, err := New(srv.URL, fastRetry).CreateOrder(context.Background(), CreateOrderRequest{})
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "VALIDATION_FAILED", apiErr.Code)
+	assert.Equal(t, []FieldError{{Field: "customer_id", Code: "REQUIRED", Message: "is required"}}, apiErr.Fields)
+}
+
+func TestClient_GetOrder_ProblemJSON_ReturnsAPIError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"type":"urn:ordersvc:problem:order-not-found","title":"Not Found","status":404,"detail":"order not found","code":"ORDER_NOT_FOUND"}`))
+	}))
+	defer srv.Close()
+
+	_, err := New(srv.URL, fastRetry).GetOrder(context.Background(), "missing")
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "ORDER_NOT_FOUND", apiErr.Code)
+	assert.Equal(t, "order not found", apiErr.Message)
+}
+
+func TestClient_GetOrder_RetriesExhausted_ReturnsLastError(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.Header().Set("Retry-After", "1")
+		writeJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "down"})
+	}))
+	defer srv.Close()
+
+	start := time.Now()
+	_, err := New(srv.URL, fastRetry).GetOrder(context.Background(), "o-1")
+
+	assert.True(t, hasStatus(err, http.StatusServiceUnavailable))
+	assert.Equal(t, int32(3), attempts.Load())
+	assert.Less(t, time.Since(start), time.Second, "Retry-After is capped by MaxDelay")
+}
+
+func TestClient_Do_PropagatesRequestIDAndCancellation(t *testing.T) {
+	var gotRequestID string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRequestID = r.Header.Get(RequestIDHeader)
+		writeJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "down"})
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(ContextWithRequestID(context.Background(), "req-42"))
+	c := New(srv.URL, WithRetry(RetryPolicy{MaxAttempts: 5, BaseDelay: time.Hour, MaxDelay: time.Hour}))
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+
+	_, err := c.GetOrder(ctx, "o-1")
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, "req-42", gotRequestID)
+}
+
+func TestClient_Orders_IteratesAllPages(t *testing.T) {
+	const total = 5
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "pending", r.URL.Query().Get("status"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		page := OrderPage{Total: total, Limit: limit, Offset: offset}
+		for i := offset; i < min(offset+limit, total); i++ {
+			page.Orders = append(page.Orders, Order{ID: strconv.Itoa(i)})
+		}
+		writeJSON(w, http.StatusOK, page)
+	}))
+	defer srv.Close()
+
+	var ids []string
+	for order, err := range New(srv.URL).Orders(context.Background(), ListOrdersOptions{Status: StatusPending, Limit: 2}) {
+		require.NoError(t, err)
+		ids = append(ids, order.ID)
+	}
+
+	assert.Equal(t, []string{"0", "1", "2", "3", "4"}, ids)
+}
+
+func TestClient_Orders_EstimatedTotal_StopsAtShortPage(t *testing.T) {
+	const count = 5
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		// The estimate is below the real count
+		page := OrderPage{Total: 3, TotalEstimated: true, Limit: limit, Offset: offset}
+		for i := offset; i < min(offset+limit, count); i++ {
+			page.Orders = append(page.Orders, Order{ID: strconv.Itoa(i)})
+		}
+		writeJSON(w, http.StatusOK, page)
+	}))
+	defer srv.Close()
+
+	var ids []string
+	for order, err := range New(srv.URL).Orders(context.Background(), ListOrdersOptions{Limit: 2}) {
+		require.NoError(t, err)
+		ids = append(ids, order.ID)
+	}
+
+	assert.Equal(t, []string{"0", "1", "2", "3", "4"}, ids)
+}
+
+func TestClient_Orders_StopsOnError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "bad", "code": "INVALID_REQUEST"})
+	}))
+	defer srv.Close()
+
+	var errs int
+	for order, err := range New(srv.URL).Orders(context.Background(), ListOrdersOptions{}) {
+		assert.Nil(t, order)
+		require.Error(t, err)
+		errs++
+	}
+
+	assert.Equal(t, 1, errs)
+}
+
+func TestClient_Ready_NotReady_ReturnsChecksAndError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/readyz", r.URL.Path)
+		writeJSON(w, http.StatusServiceUnavailable, Health{
+			Status: "unhealthy",
+			Checks: map[string]string{"database": "ok", "schema": "unhealthy: behind"},
+		})
+	}))
+	defer srv.Close()
+
+	health, err := New(srv.URL).Ready(context.Background())
+
+	assert.True(t, hasStatus(err, http.StatusServiceUnavailable))
+	require.NotNil(t, health)
+	assert.Equal(t, "unhealthy: behind", health.Checks["schema"])
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// Health is the body of the service's /readyz probe.
+type Health struct {
+	Status  string            `json:"status"`
+	Checks  map[string]string `json:"checks"`
+	Version string            `json:"version"`
+}
+
+// Ready calls the readiness probe. When the service is not ready it returns
+// both the reported Health, naming the failing checks, and an *APIError.
+func (c *Client) Ready(ctx context.Context) (*Health, error) {
+	resp, err := c.send(ctx, request{method: http.MethodGet, path: "/readyz"}, nil, uuid.NewString())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var health Health
+	if err := json.NewDecoder(resp.Body).Decode(&health); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &health, &APIError{StatusCode: resp.StatusCode, Message: "service is " + health.Status}
+	}
+	return &health, nil
+}
@@ -0,0 +1,480 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrderStatus is the lifecycle state of an order.
+type OrderStatus string
+
+// Order statuses accepted by UpdateStatus and ListOrdersOptions.Status.
+const (
+	StatusPending    OrderStatus = "pending"
+	StatusConfirmed  OrderStatus = "confirmed"
+	StatusProcessing OrderStatus = "processing"
+	StatusOnHold     OrderStatus = "on_hold"
+	StatusShipped    OrderStatus = "shipped"
+	StatusDelivered  OrderStatus = "delivered"
+	StatusCancelled  OrderStatus = "cancelled"
+)
+
+// ItemStatus is the fulfillment state of an order item.
+type ItemStatus string
+
+// Item statuses accepted by UpdateItemStatus.
+const (
+	ItemPending   ItemStatus = "pending"
+	ItemPicked    ItemStatus = "picked"
+	ItemShipped   ItemStatus = "shipped"
+	ItemDelivered ItemStatus = "delivered"
+	ItemReturned  ItemStatus = "returned"
+)
+
+// Order is an order as returned by the API.
+type Order struct {
+	ID         string      `json:"id"`
+	TenantID   string      `json:"tenant_id,omitempty"`
+	CustomerID string      `json:"customer_id"`
+	Items      []OrderItem `json:"items"`
+	Status     OrderStatus `json:"status"`
+	Total      float64     `json:"total"`
+	Version    int         `json:"version"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+	DeletedAt  *time.Time  `json:"deleted_at,omitempty"`
+	// Hold is set while Status is StatusOnHold
+	Hold *OrderHold `json:"hold,omitempty"`
+	// Metadata is free-form key/value data; Tags are lowercased labels
+	Metadata map[string]string `json:"metadata"`
+	Tags     []string          `json:"tags"`
+	// ShippingAddress and BillingAddress are nil when not set
+	ShippingAddress *Address `json:"shipping_address,omitempty"`
+	BillingAddress  *Address `json:"billing_address,omitempty"`
+	// ShippingMethod is empty for orders created before shipping methods
+	// existed. EstimatedDeliveryAt is set once the order is confirmed, and
+	// SLABreachedAt once it is overdue.
+	ShippingMethod      string     `json:"shipping_method,omitempty"`
+	EstimatedDeliveryAt *time.Time `json:"estimated_delivery_at,omitempty"`
+	SLABreachedAt       *time.Time `json:"sla_breached_at,omitempty"`
+	// Notes is only filled in when requested with ListOrdersOptions.IncludeNotes
+	Notes []Note `json:"notes,omitempty"`
+}
+
+// NoteVisibility decides who may read a Note.
+type NoteVisibility string
+
+// Note visibilities accepted by AddNote.
+const (
+	NoteCustomer NoteVisibility = "customer"
+	NoteInternal NoteVisibility = "internal"
+)
+
+// Note is a comment on an order.
+type Note struct {
+	ID         string         `json:"id"`
+	OrderID    string         `json:"order_id"`
+	Author     string         `json:"author"`
+	Body       string         `json:"body"`
+	Visibility NoteVisibility `json:"visibility"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+// NotePage is one page of ListNotes, oldest note first.
+type NotePage struct {
+	Notes  []Note `json:"notes"`
+	Total  int64  `json:"total"`
+	Limit  int    `json:"limit"`
+	Offset int    `json:"offset"`
+}
+
+// Address is a postal address. Line1, City and Country, an ISO 3166-1
+// alpha-2 code, are required.
+type Address struct {
+	Name       string `json:"name,omitempty"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country"`
+}
+
+// OrderHold describes why an order is on hold and what it returns to.
+type OrderHold struct {
+	Reason         string      `json:"reason"`
+	PreviousStatus OrderStatus `json:"previous_status"`
+	HeldAt         time.Time   `json:"held_at"`
+	// ReleaseAt is when the server releases the hold on its own, if ever
+	ReleaseAt *time.Time `json:"release_at,omitempty"`
+}
+
+// OrderItem is a line item of an Order.
+type OrderItem struct {
+	ID        string     `json:"id"`
+	ProductID string     `json:"product_id"`
+	Name      string     `json:"name"`
+	Quantity  int        `json:"quantity"`
+	Price     float64    `json:"price"`
+	Subtotal  float64    `json:"subtotal"`
+	Status    ItemStatus `json:"status"`
+}
+
+// ItemInput is a line item of a CreateOrderRequest.
+type ItemInput struct {
+	ProductID string  `json:"product_id"`
+	Name      string  `json:"name"`
+	Quantity  int     `json:"quantity"`
+	Price     float64 `json:"price"`
+}
+
+// CreateOrderRequest is the body of CreateOrder.
+type CreateOrderRequest struct {
+	CustomerID string            `json:"customer_id"`
+	Items      []ItemInput       `json:"items"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+	Tags       []string          `json:"tags,omitempty"`
+	// ShippingAddress and BillingAddress are optional
+	ShippingAddress *Address `json:"shipping_address,omitempty"`
+	BillingAddress  *Address `json:"billing_address,omitempty"`
+	// ShippingMethod defaults to the service's default method
+	ShippingMethod string `json:"shipping_method,omitempty"`
+}
+
+// ListOrdersOptions filters and pages ListOrders. Zero values are omitted.
+type ListOrdersOptions struct {
+	Status     OrderStatus
+	CustomerID string
+	ProductID  string
+	// Tags matches orders carrying every one of these tags
+	Tags []string
+	// Limit is the page size; the server defaults to 20 and caps it at 100
+	Limit  int
+	Offset int
+	// IncludeNotes returns the notes the caller may read with each order
+	IncludeNotes bool
+}
+
+// OrderPage is one page of ListOrders.
+type OrderPage struct {
+	Orders []Order `json:"orders"`
+	Total  int64   `json:"total"`
+	// TotalEstimated is set when the server estimated Total rather than
+	// counting the orders, so it may be off in either direction.
+	TotalEstimated bool `json:"total_estimated"`
+	Limit          int  `json:"limit"`
+	Offset         int  `json:"offset"`
+}
+
+// CallOption customizes a single mutating call.
+type CallOption func(*callOptions)
+
+type callOptions struct {
+	idempotencyKey string
+	version        *int
+}
+
+// WithIdempotencyKey sets the Idempotency-Key instead of generating one.
+// Reuse a key to safely resend a call across process restarts.
+func WithIdempotencyKey(key string) CallOption {
+	return func(o *callOptions) {
+		o.idempotencyKey = key
+	}
+}
+
+// WithExpectedVersion makes UpdateStatus, HoldOrder and ReleaseOrder fail
+// with 409 unless the order is at version v.
+func WithExpectedVersion(v int) CallOption {
+	return func(o *callOptions) {
+		o.version = &v
+	}
+}
+
+func newCallOptions(opts []CallOption) callOptions {
+	var o callOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.idempotencyKey == "" {
+		o.idempotencyKey = uuid.NewString()
+	}
+	return o
+}
+
+// CreateOrder creates an order. It is retried under a single idempotency key,
+// so at most one order is created however many attempts are made.
+func (c *Client) CreateOrder(ctx context.Context, req CreateOrderRequest, opts ...CallOption) (*Order, error) {
+	o := newCallOptions(opts)
+	var order Order
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/api/v1/orders",
+		body:   req,
+		header: http.Header{IdempotencyKeyHeader: {o.idempotencyKey}},
+	}, &order)
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// GetOrder returns the order with id. Use IsNotFound to detect a missing order.
+func (c *Client) GetOrder(ctx context.Context, id string) (*Order, error) {
+	var order Order
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/orders/" + url.PathEscape(id),
+	}, &order)
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// ListOrders returns a single page of orders.
+func (c *Client) ListOrders(ctx context.Context, opts ListOrdersOptions) (*OrderPage, error) {
+	q := url.Values{}
+	if opts.Status != "" {
+		q.Set("status", string(opts.Status))
+	}
+	if opts.CustomerID != "" {
+		q.Set("customer_id", opts.CustomerID)
+	}
+	if opts.ProductID != "" {
+		q.Set("product_id", opts.ProductID)
+	}
+	for _, tag := range opts.Tags {
+		q.Add("tag", tag)
+	}
+	if opts.Limit > 0 {
+		q.Set("limit", strconv.Itoa(opts.Limit))
+	}
+	if opts.Offset > 0 {
+		q.Set("offset", strconv.Itoa(opts.Offset))
+	}
+	if opts.IncludeNotes {
+		q.Set("include", "notes")
+	}
+
+	path := "/api/v1/orders"
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+
+	var page OrderPage
+	if err := c.do(ctx, request{method: http.MethodGet, path: path}, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// Orders iterates over every order matching opts, fetching pages as needed and
+// starting at opts.Offset. Iteration stops after yielding the first error.
+//
+//	for order, err := range c.Orders(ctx, client.ListOrdersOptions{Status: client.StatusPending}) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+func (c *Client) Orders(ctx context.Context, opts ListOrdersOptions) iter.Seq2[*Order, error] {
+	return func(yield func(*Order, error) bool) {
+		for {
+			page, err := c.ListOrders(ctx, opts)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for i := range page.Orders {
+				if !yield(&page.Orders[i], nil) {
+					return
+				}
+			}
+
+			// An estimated total cannot tell where the list ends; a short page can
+			opts.Offset = page.Offset + len(page.Orders)
+			if len(page.Orders) == 0 {
+				return
+			}
+			if page.TotalEstimated && len(page.Orders) < page.Limit {
+				return
+			}
+			if !page.TotalEstimated && int64(opts.Offset) >= page.Total {
+				return
+			}
+		}
+	}
+}
+
+// UpdateStatus transitions an order to status. Invalid transitions fail with
+// a 400 APIError; a stale WithExpectedVersion fails with 409.
+func (c *Client) UpdateStatus(ctx context.Context, id string, status OrderStatus, opts ...CallOption) (*Order, error) {
+	o := newCallOptions(opts)
+	body := struct {
+		Status  OrderStatus `json:"status"`
+		Version *int        `json:"version,omitempty"`
+	}{Status: status, Version: o.version}
+
+	var order Order
+	err := c.do(ctx, request{
+		method: http.MethodPatch,
+		path:   "/api/v1/orders/" + url.PathEscape(id) + "/status",
+		body:   body,
+		header: http.Header{IdempotencyKeyHeader: {o.idempotencyKey}},
+	}, &order)
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// HoldOrder puts a pending, confirmed or processing order on hold. Other
+// orders fail with a 400 APIError; a stale WithExpectedVersion fails with 409.
+func (c *Client) HoldOrder(ctx context.Context, id, reason string, opts ...CallOption) (*Order, error) {
+	o := newCallOptions(opts)
+	body := struct {
+		Reason  string `json:"reason"`
+		Version *int   `json:"version,omitempty"`
+	}{Reason: reason, Version: o.version}
+	return c.changeHold(ctx, id, "/hold", body, o)
+}
+
+// ReleaseOrder returns a held order to the status it was held from. An order
+// that is not on hold fails with a 409 APIError.
+func (c *Client) ReleaseOrder(ctx context.Context, id string, opts ...CallOption) (*Order, error) {
+	o := newCallOptions(opts)
+	body := struct {
+		Version *int `json:"version,omitempty"`
+	}{Version: o.version}
+	return c.changeHold(ctx, id, "/release", body, o)
+}
+
+func (c *Client) changeHold(ctx context.Context, id, action string, body any, o callOptions) (*Order, error) {
+	var order Order
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/api/v1/orders/" + url.PathEscape(id) + action,
+		body:   body,
+		header: http.Header{IdempotencyKeyHeader: {o.idempotencyKey}},
+	}, &order)
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// UpdateItemStatus moves the given items of an order to status in one step;
+// either every item changes or none does. The order status follows its items,
+// so shipping the first item of a processing order leaves it processing until
+// every item has shipped. Invalid transitions fail with a 400 APIError and
+// unknown items with 404.
+func (c *Client) UpdateItemStatus(ctx context.Context, id string, itemIDs []string, status ItemStatus, opts ...CallOption) (*Order, error) {
+	o := newCallOptions(opts)
+	body := struct {
+		ItemIDs []string   `json:"item_ids"`
+		Status  ItemStatus `json:"status"`
+		Version *int       `json:"version,omitempty"`
+	}{ItemIDs: itemIDs, Status: status, Version: o.version}
+
+	var order Order
+	err := c.do(ctx, request{
+		method: http.MethodPatch,
+		path:   "/api/v1/orders/" + url.PathEscape(id) + "/items/status",
+		body:   body,
+		header: http.Header{IdempotencyKeyHeader: {o.idempotencyKey}},
+	}, &order)
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// UpdateAddresses replaces the shipping and/or billing address of an order; a
+// nil address is left unchanged. Orders past confirmed fail with a 409
+// APIError.
+func (c *Client) UpdateAddresses(ctx context.Context, id string, shipping, billing *Address, opts ...CallOption) (*Order, error) {
+	o := newCallOptions(opts)
+	body := struct {
+		ShippingAddress *Address `json:"shipping_address,omitempty"`
+		BillingAddress  *Address `json:"billing_address,omitempty"`
+		Version         *int     `json:"version,omitempty"`
+	}{ShippingAddress: shipping, BillingAddress: billing, Version: o.version}
+
+	var order Order
+	err := c.do(ctx, request{
+		method: http.MethodPatch,
+		path:   "/api/v1/orders/" + url.PathEscape(id) + "/addresses",
+		body:   body,
+		header: http.Header{IdempotencyKeyHeader: {o.idempotencyKey}},
+	}, &order)
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// AddNote attaches a note to an order. An empty visibility lets the server
+// choose: customer for customer tokens, internal otherwise. Customer tokens
+// adding an internal note fail with a 403 APIError.
+func (c *Client) AddNote(ctx context.Context, id, body string, visibility NoteVisibility, opts ...CallOption) (*Note, error) {
+	o := newCallOptions(opts)
+	req := struct {
+		Body       string         `json:"body"`
+		Visibility NoteVisibility `json:"visibility,omitempty"`
+	}{Body: body, Visibility: visibility}
+
+	var note Note
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/api/v1/orders/" + url.PathEscape(id) + "/notes",
+		body:   req,
+		header: http.Header{IdempotencyKeyHeader: {o.idempotencyKey}},
+	}, &note)
+	if err != nil {
+		return nil, err
+	}
+	return &note, nil
+}
+
+// ListNotes returns a page of an order's notes, oldest first. Customer tokens
+// only see customer-visible notes. A limit of 0 uses the server default.
+func (c *Client) ListNotes(ctx context.Context, id string, limit, offset int) (*NotePage, error) {
+	q := url.Values{}
+	if limit > 0 {
+		q.Set("limit", strconv.Itoa(limit))
+	}
+	if offset > 0 {
+		q.Set("offset", strconv.Itoa(offset))
+	}
+
+	path := "/api/v1/orders/" + url.PathEscape(id) + "/notes"
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+
+	var page NotePage
+	if err := c.do(ctx, request{method: http.MethodGet, path: path}, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ordersvctest runs the ordersvc HTTP API in-process for tests of
+// programs that call it, without Docker.
+//
+// The server uses the real router, handlers and order service. Orders,
+// notes and the order cache are kept in memory, and published events are
+// recorded instead of sent to a broker:
+//
+//	srv := ordersvctest.NewServer(t)
+//	c := srv.APIClient()
+//	order, err := c.CreateOrder(ctx, client.CreateOrderRequest{...})
+//	evt, err := srv.Events.WaitForEvent(ctx, memory.ForOrder(order.ID))
+//
+// The order, note and search routes are served, with health checks and the
+// OpenAPI spec. Admin, history, report, subscription and streaming routes
+// are not, and there is no rate limiting or idempotency-key deduplication.
+// Order history is not recorded and no background jobs run.
+package ordersvctest
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/api/openapi"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/auth"
+	cachememory "github.com/sridharn-code-sandbox/go-ordersvc/internal/cache/memory"
+	httpHandler "github.com/sridharn-code-sandbox/go-ordersvc/internal/handler/http"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging/memory"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/middleware"
+	repomemory "github.com/sridharn-code-sandbox/go-ordersvc/internal/repository/memory"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/service"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/tenancy"
+	"github.com/sridharn-code-sandbox/go-ordersvc/pkg/client"
+)
+
+// Server is an ordersvc HTTP API served by an httptest.Server
+type Server struct {
+	*httptest.Server
+
+	// Events records the events the service publishes, in publish order
+	Events *memory.Publisher
+}
+
+// options are the settings changed by Option
+type options struct {
+	jwtSecret   string
+	jwtIssuer   string
+	tenants     bool
+	problemJSON bool
+	logger      *slog.Logger
+}
+
+// Option configures a Server
+type Option func(*options)
+
+// WithAuth makes the order API require bearer tokens signed with secret
+// (HS256), carrying issuer as their iss claim if it is set, as with
+// AUTH_JWT_SECRET and AUTH_JWT_ISSUER.
+func WithAuth(secret, issuer string) Option {
+	return func(o *options) {
+		o.jwtSecret = secret
+		o.jwtIssuer = issuer
+	}
+}
+
+// WithTenantHeader makes requests name their tenant in the X-Tenant-ID
+// header (see client.WithTenant), as with TENANCY_MODE=header.
+func WithTenantHeader() Option {
+	return func(o *options) {
+		o.tenants = true
+	}
+}
+
+// WithProblemJSON returns errors as RFC 7807 problem+json, as with
+// SERVER_PROBLEM_JSON.
+func WithProblemJSON() Option {
+	return func(o *options) {
+		o.problemJSON = true
+	}
+}
+
+// WithLogger logs requests to logger; they are discarded otherwise.
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// NewServer starts a server with no orders and closes it when the test
+// ends.
+func NewServer(t testing.TB, opts ...Option) *Server {
+	t.Helper()
+
+	o := options{logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	events := memory.NewPublisher()
+	srv := &Server{
+		Server: httptest.NewServer(newHandler(o, events)),
+		Events: events,
+	}
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// APIClient returns a client for the server. Retries are disabled, so a
+// failing call fails the test at once.
+func (s *Server) APIClient(opts ...client.Option) *client.Client {
+	defaults := []client.Option{
+		client.WithHTTPClient(s.Client()),
+		client.WithRetry(client.RetryPolicy{MaxAttempts: 1}),
+	}
+	return client.New(s.URL, append(defaults, opts...)...)
+}
+
+// newHandler wires the router as app.NewServer does, with in-memory
+// dependencies in place of PostgreSQL, Redis and the broker
+func newHandler(o options, events *memory.Publisher) http.Handler {
+	orders := repomemory.NewOrderRepository()
+	settings := service.StaticConfig(service.DefaultSettings)
+	orderService := service.NewOrderService(orders, nil, cachememory.NewOrderCache(), events, nil, settings)
+	noteService := service.NewOrderNoteService(repomemory.NewOrderNoteRepository(), orders)
+	searchService := service.NewOrderSearchService(orders, settings)
+
+	var verifier *auth.Verifier
+	if o.jwtSecret != "" {
+		verifier = auth.NewVerifier(o.jwtSecret, o.jwtIssuer)
+	}
+	tenantMode := tenancy.ModeNone
+	if o.tenants {
+		tenantMode = tenancy.ModeHeader
+	}
+	authenticate, scopeTenant := middleware.Authenticate(verifier), middleware.Tenant(tenancy.NewResolver(tenantMode, "", ""))
+	orderRoutes := httpHandler.NewAuthenticatedRoutes(func(next http.Handler) http.Handler { return authenticate(scopeTenant(next)) },
+		httpHandler.NewOrderHandler(orderService, noteService, 0),
+		httpHandler.NewOrderNoteHandler(noteService),
+		httpHandler.NewOrderSearchHandler(searchService))
+
+	var mw []func(http.Handler) http.Handler
+	if o.problemJSON {
+		mw = append(mw, middleware.ProblemJSON())
+	}
+	healthHandler := httpHandler.NewHealthHandler("ordersvctest", nil, nil)
+	return httpHandler.NewRouter(orderRoutes, healthHandler, o.logger, mw, httpHandler.NewOpenAPIHandler(openapi.Spec))
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ordersvctest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging/memory"
+	"github.com/sridharn-code-sandbox/go-ordersvc/pkg/client"
+)
+
+const customerID = "5a6f1c2e-8d3b-4f7a-9c1e-2b4d6f8a0c13"
+
+func newOrderRequest() client.CreateOrderRequest {
+	return client.CreateOrderRequest{
+		CustomerID: customerID,
+		Items:      []client.ItemInput{{ProductID: "prod-1", Name: "Widget", Quantity: 2, Price: 4.5}},
+	}
+}
+
+func TestServer_OrderLifecycle(t *testing.T) {
+	srv := NewServer(t)
+	c := srv.APIClient()
+	ctx := context.Background()
+
+	created, err := c.CreateOrder(ctx, newOrderRequest())
+	require.NoError(t, err)
+	assert.Equal(t, client.StatusPending, created.Status)
+	assert.Equal(t, 9.0, created.Total)
+
+	confirmed, err := c.UpdateStatus(ctx, created.ID, client.StatusConfirmed)
+	require.NoError(t, err)
+	assert.Equal(t, 2, confirmed.Version)
+
+	fetched, err := c.GetOrder(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, client.StatusConfirmed, fetched.Status)
+
+	page, err := c.ListOrders(ctx, client.ListOrdersOptions{CustomerID: customerID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), page.Total)
+
+	assert.Equal(t, []string{messaging.EventOrderCreated, messaging.EventOrderStatusChanged},
+		srv.Events.EventTypesForOrder(created.ID))
+	evt, err := srv.Events.WaitForEvent(ctx, memory.All(memory.ForOrder(created.ID), memory.OfType(messaging.EventOrderStatusChanged)))
+	require.NoError(t, err)
+	assert.Equal(t, "confirmed", evt.NewStatus)
+}
+
+func TestServer_NotFound(t *testing.T) {
+	c := NewServer(t).APIClient()
+
+	_, err := c.GetOrder(context.Background(), "00000000-0000-0000-0000-000000000001")
+
+	var apiErr *client.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+}
+
+func TestServer_ServersAreIsolated(t *testing.T) {
+	a, b := NewServer(t), NewServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := a.APIClient().CreateOrder(ctx, newOrderRequest())
+	require.NoError(t, err)
+
+	page, err := b.APIClient().ListOrders(ctx, client.ListOrdersOptions{})
+	require.NoError(t, err)
+	assert.Zero(t, page.Total)
+	assert.Empty(t, b.Events.Events())
+}
+
+func TestServer_WithTenantHeader(t *testing.T) {
+	srv := NewServer(t, WithTenantHeader())
+	ctx := context.Background()
+
+	created, err := srv.APIClient(client.WithTenant("acme")).CreateOrder(ctx, newOrderRequest())
+	require.NoError(t, err)
+	assert.Equal(t, "acme", created.TenantID)
+
+	_, err = srv.APIClient(client.WithTenant("globex")).GetOrder(ctx, created.ID)
+	var apiErr *client.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+
+	_, err = srv.APIClient().GetOrder(ctx, created.ID)
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "TENANT_REQUIRED", apiErr.Code)
+}
+
+func TestServer_WithAuth(t *testing.T) {
+	srv := NewServer(t, WithAuth("test-secret", ""))
+
+	_, err := srv.APIClient().ListOrders(context.Background(), client.ListOrdersOptions{})
+
+	var apiErr *client.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+}
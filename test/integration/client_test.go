@@ -0,0 +1,90 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateOrder_SameIdempotencyKey_CreatesOnce(t *testing.T) {
+	ctx := context.Background()
+	c := client.New(baseURL)
+	key := uuid.NewString()
+	req := client.CreateOrderRequest{
+		CustomerID: uuid.NewString(),
+		Items:      []client.ItemInput{{ProductID: "p-1", Name: "Widget", Quantity: 1, Price: 5}},
+	}
+
+	first, err := c.CreateOrder(ctx, req, client.WithIdempotencyKey(key))
+	require.NoError(t, err)
+	second, err := c.CreateOrder(ctx, req, client.WithIdempotencyKey(key))
+	require.NoError(t, err)
+
+	assert.Equal(t, first.ID, second.ID, "a replayed create must return the original order")
+
+	var count int
+	for order, err := range c.Orders(ctx, client.ListOrdersOptions{CustomerID: req.CustomerID}) {
+		require.NoError(t, err)
+		assert.Equal(t, first.ID, order.ID)
+		count++
+	}
+	assert.Equal(t, 1, count)
+}
+
+func TestClient_CreateOrder_ReusedKeyWithDifferentBody_Returns422(t *testing.T) {
+	ctx := context.Background()
+	c := client.New(baseURL)
+	key := uuid.NewString()
+	req := client.CreateOrderRequest{
+		CustomerID: uuid.NewString(),
+		Items:      []client.ItemInput{{ProductID: "p-1", Name: "Widget", Quantity: 1, Price: 5}},
+	}
+
+	_, err := c.CreateOrder(ctx, req, client.WithIdempotencyKey(key))
+	require.NoError(t, err)
+
+	req.Items[0].Quantity = 2
+	_, err = c.CreateOrder(ctx, req, client.WithIdempotencyKey(key))
+
+	var apiErr *client.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 422, apiErr.StatusCode)
+	assert.Equal(t, "IDEMPOTENCY_KEY_REUSED", apiErr.Code)
+}
+
+func TestClient_UpdateStatus_StaleVersion_ReturnsConflict(t *testing.T) {
+	ctx := context.Background()
+	c := client.New(baseURL)
+	order, err := c.CreateOrder(ctx, client.CreateOrderRequest{
+		CustomerID: uuid.NewString(),
+		Items:      []client.ItemInput{{ProductID: "p-1", Name: "Widget", Quantity: 1, Price: 5}},
+	})
+	require.NoError(t, err)
+
+	updated, err := c.UpdateStatus(ctx, order.ID, client.StatusConfirmed, client.WithExpectedVersion(order.Version))
+	require.NoError(t, err)
+	assert.Equal(t, client.StatusConfirmed, updated.Status)
+
+	_, err = c.UpdateStatus(ctx, order.ID, client.StatusProcessing, client.WithExpectedVersion(order.Version))
+	assert.True(t, client.IsConflict(err))
+}
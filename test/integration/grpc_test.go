@@ -0,0 +1,323 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	orderv1 "github.com/sridharn-code-sandbox/go-ordersvc/api/proto/order/v1"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/messaging"
+)
+
+// grpcTarget returns the address of the gRPC API: ORDERSVC_GRPC_ADDR, or
+// GRPC_PORT on localhost, which the harness sets for the in-process service
+func grpcTarget() string {
+	if addr := os.Getenv("ORDERSVC_GRPC_ADDR"); addr != "" {
+		return addr
+	}
+	port := os.Getenv("GRPC_PORT")
+	if port == "" {
+		port = "9090"
+	}
+	return net.JoinHostPort("localhost", port)
+}
+
+func grpcClient(t *testing.T) orderv1.OrderServiceClient {
+	t.Helper()
+	conn, err := grpc.NewClient(grpcTarget(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return orderv1.NewOrderServiceClient(conn)
+}
+
+// createOrder creates an order through the HTTP API
+func createOrder(t *testing.T, customerID string, items ...OrderItem) OrderResponse {
+	t.Helper()
+	resp, body := post(t, "/api/v1/orders", CreateOrderRequest{CustomerID: customerID, Items: items})
+	require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+	var order OrderResponse
+	require.NoError(t, json.Unmarshal(body, &order))
+	return order
+}
+
+// requireGRPCError asserts that err has the gRPC code and, when reason is
+// set, the ErrorInfo reason of the errcode registry
+func requireGRPCError(t *testing.T, err error, code codes.Code, reason string) {
+	t.Helper()
+	st, ok := status.FromError(err)
+	require.True(t, ok, "not a gRPC status: %v", err)
+	require.Equal(t, code, st.Code(), st.Message())
+	if reason == "" {
+		return
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			assert.Equal(t, reason, info.GetReason())
+			assert.Equal(t, "ordersvc", info.GetDomain())
+			return
+		}
+	}
+	t.Fatalf("status %s has no ErrorInfo detail", st.Code())
+}
+
+// GetOrder
+
+func TestGRPC_GetOrder_MatchesHTTP(t *testing.T) {
+	created := createOrder(t, uuid.New().String(), OrderItem{ProductID: "grpc-1", Name: "gRPC Product", Quantity: 3, Price: 2.50})
+
+	resp, err := grpcClient(t).GetOrder(context.Background(), &orderv1.GetOrderRequest{OrderId: created.ID})
+
+	require.NoError(t, err)
+	order := resp.GetOrder()
+	assert.Equal(t, created.ID, order.GetId())
+	assert.Equal(t, created.CustomerID, order.GetCustomerId())
+	assert.Equal(t, created.Status, order.GetStatus())
+	assert.Equal(t, created.Total, order.GetTotal())
+	assert.Equal(t, int32(created.Version), order.GetVersion())
+	require.Len(t, order.GetItems(), 1)
+	assert.Equal(t, created.Items[0].ID, order.GetItems()[0].GetId())
+	assert.Equal(t, "grpc-1", order.GetItems()[0].GetProductId())
+	assert.Equal(t, int32(3), order.GetItems()[0].GetQuantity())
+	assert.Equal(t, 7.50, order.GetItems()[0].GetSubtotal())
+	assert.NotNil(t, order.GetCreatedAt())
+}
+
+func TestGRPC_GetOrder_NonExistent_ReturnsNotFound(t *testing.T) {
+	_, err := grpcClient(t).GetOrder(context.Background(), &orderv1.GetOrderRequest{OrderId: uuid.New().String()})
+
+	requireGRPCError(t, err, codes.NotFound, "ORDER_NOT_FOUND")
+}
+
+func TestGRPC_GetOrder_MalformedID_ReturnsInvalidArgument(t *testing.T) {
+	_, err := grpcClient(t).GetOrder(context.Background(), &orderv1.GetOrderRequest{OrderId: "not-a-uuid"})
+
+	requireGRPCError(t, err, codes.InvalidArgument, "")
+}
+
+func TestGRPC_GetOrder_DeletedOrder_ReturnsNotFound(t *testing.T) {
+	created := createOrder(t, uuid.New().String(), OrderItem{ProductID: "grpc-1", Name: "gRPC Product", Quantity: 1, Price: 1.00})
+	resp, _ := delete(t, "/api/v1/orders/"+created.ID)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	_, err := grpcClient(t).GetOrder(context.Background(), &orderv1.GetOrderRequest{OrderId: created.ID})
+
+	requireGRPCError(t, err, codes.NotFound, "ORDER_NOT_FOUND")
+}
+
+// ListOrders
+
+func TestGRPC_ListOrders_CustomerAndStatusFilters(t *testing.T) {
+	customerID := uuid.New().String()
+	item := OrderItem{ProductID: "grpc-list", Name: "gRPC List", Quantity: 1, Price: 4.00}
+	first := createOrder(t, customerID, item)
+	createOrder(t, customerID, item)
+	resp, _ := patch(t, "/api/v1/orders/"+first.ID+"/status", UpdateStatusRequest{Status: "confirmed"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	client := grpcClient(t)
+	ctx := context.Background()
+
+	all, err := client.ListOrders(ctx, &orderv1.ListOrdersRequest{CustomerId: customerID, PageSize: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), all.GetTotalCount())
+	assert.Equal(t, int32(1), all.GetTotalPages())
+	assert.Len(t, all.GetOrders(), 2)
+
+	confirmed, err := client.ListOrders(ctx, &orderv1.ListOrdersRequest{CustomerId: customerID, Status: "confirmed"})
+	require.NoError(t, err)
+	require.Len(t, confirmed.GetOrders(), 1)
+	assert.Equal(t, first.ID, confirmed.GetOrders()[0].GetId())
+	assert.Equal(t, int64(1), confirmed.GetTotalCount())
+}
+
+func TestGRPC_ListOrders_Pagination(t *testing.T) {
+	customerID := uuid.New().String()
+	for range 3 {
+		createOrder(t, customerID, OrderItem{ProductID: "grpc-page", Name: "gRPC Page", Quantity: 1, Price: 1.00})
+	}
+	client := grpcClient(t)
+
+	page, err := client.ListOrders(context.Background(), &orderv1.ListOrdersRequest{CustomerId: customerID, Page: 2, PageSize: 2})
+
+	require.NoError(t, err)
+	assert.Len(t, page.GetOrders(), 1)
+	assert.Equal(t, int32(2), page.GetPage())
+	assert.Equal(t, int32(2), page.GetPageSize())
+	assert.Equal(t, int64(3), page.GetTotalCount())
+	assert.Equal(t, int32(2), page.GetTotalPages())
+}
+
+func TestGRPC_ListOrders_ProductFilter(t *testing.T) {
+	customerID := uuid.New().String()
+	productID := "grpc-product-" + uuid.NewString()[:8]
+	match := createOrder(t, customerID, OrderItem{ProductID: productID, Name: "Match", Quantity: 1, Price: 1.00})
+	createOrder(t, customerID, OrderItem{ProductID: "grpc-other", Name: "Other", Quantity: 1, Price: 1.00})
+
+	resp, err := grpcClient(t).ListOrders(context.Background(), &orderv1.ListOrdersRequest{CustomerId: customerID, ProductId: productID})
+
+	require.NoError(t, err)
+	require.Len(t, resp.GetOrders(), 1)
+	assert.Equal(t, match.ID, resp.GetOrders()[0].GetId())
+}
+
+func TestGRPC_ListOrders_InvalidFilters(t *testing.T) {
+	client := grpcClient(t)
+	ctx := context.Background()
+
+	_, err := client.ListOrders(ctx, &orderv1.ListOrdersRequest{Status: "bogus"})
+	requireGRPCError(t, err, codes.InvalidArgument, "INVALID_STATUS")
+
+	_, err = client.ListOrders(ctx, &orderv1.ListOrdersRequest{CustomerId: "not-a-uuid"})
+	requireGRPCError(t, err, codes.InvalidArgument, "")
+}
+
+// ImportOrders
+
+func TestGRPC_ImportOrders_ReportsRejectedOrders(t *testing.T) {
+	customerID := uuid.New().String()
+	productID := "grpc-import-" + uuid.NewString()[:8]
+	stream, err := grpcClient(t).ImportOrders(context.Background())
+	require.NoError(t, err)
+
+	for _, cid := range []string{customerID, "not-a-uuid", customerID} {
+		require.NoError(t, stream.Send(&orderv1.CreateOrderRequest{
+			CustomerId: cid,
+			Items:      []*orderv1.CreateOrderItem{{ProductId: productID, Name: "Imported", Quantity: 2, Price: 5.00}},
+		}))
+	}
+	resp, err := stream.CloseAndRecv()
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), resp.GetReceived())
+	assert.Equal(t, int32(2), resp.GetImported())
+	assert.Equal(t, int32(1), resp.GetFailed())
+	require.Len(t, resp.GetErrors(), 1)
+	assert.Equal(t, int32(1), resp.GetErrors()[0].GetIndex())
+	assert.Equal(t, "INVALID_CUSTOMER_ID", resp.GetErrors()[0].GetCode())
+
+	list, err := grpcClient(t).ListOrders(context.Background(), &orderv1.ListOrdersRequest{CustomerId: customerID, ProductId: productID})
+	require.NoError(t, err)
+	assert.Len(t, list.GetOrders(), 2)
+}
+
+// WatchOrders
+
+// watchOrders opens a WatchOrders stream and gives the server a moment to
+// subscribe it to the event feed, which only delivers later events
+func watchOrders(ctx context.Context, t *testing.T, client orderv1.OrderServiceClient, req *orderv1.WatchOrdersRequest) grpc.ServerStreamingClient[orderv1.OrderEvent] {
+	t.Helper()
+	stream, err := client.WatchOrders(ctx, req)
+	require.NoError(t, err)
+	time.Sleep(500 * time.Millisecond)
+	return stream
+}
+
+func TestGRPC_WatchOrders_FiltersByStatusAndEventType(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+	stream := watchOrders(ctx, t, grpcClient(t), &orderv1.WatchOrdersRequest{
+		Statuses:   []string{"confirmed"},
+		EventTypes: []string{messaging.EventOrderStatusChanged},
+	})
+
+	item := OrderItem{ProductID: "grpc-watch", Name: "gRPC Watch", Quantity: 1, Price: 3.00}
+	skipped := createOrder(t, uuid.New().String(), item)
+	watched := createOrder(t, uuid.New().String(), item)
+	resp, _ := patch(t, "/api/v1/orders/"+watched.ID+"/status", UpdateStatusRequest{Status: "confirmed"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// Every event up to the watched one must pass the filter
+	for {
+		evt, err := stream.Recv()
+		require.NoError(t, err, "stream ended before the watched order's event")
+		assert.Equal(t, messaging.EventOrderStatusChanged, evt.GetEventType())
+		assert.Equal(t, "confirmed", evt.GetStatus())
+		assert.NotEqual(t, skipped.ID, evt.GetOrderId(), "pending orders are filtered out")
+		if evt.GetOrderId() != watched.ID {
+			continue
+		}
+		assert.Equal(t, "pending", evt.GetOldStatus())
+		assert.Equal(t, "confirmed", evt.GetNewStatus())
+		assert.Equal(t, watched.CustomerID, evt.GetCustomerId())
+		assert.NotEmpty(t, evt.GetEventId())
+		assert.NotEmpty(t, evt.GetResumeToken())
+		return
+	}
+}
+
+func TestGRPC_WatchOrders_UnknownEventType_ReturnsInvalidArgument(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream, err := grpcClient(t).WatchOrders(ctx, &orderv1.WatchOrdersRequest{EventTypes: []string{"order.exploded"}})
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+
+	requireGRPCError(t, err, codes.InvalidArgument, "")
+}
+
+func TestGRPC_WatchOrders_InvalidResumeToken_ReturnsInvalidArgument(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream, err := grpcClient(t).WatchOrders(ctx, &orderv1.WatchOrdersRequest{ResumeToken: "not-a-token"})
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+
+	requireGRPCError(t, err, codes.InvalidArgument, "")
+}
+
+func TestGRPC_WatchOrders_ClientCancel_EndsStream(t *testing.T) {
+	client := grpcClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := watchOrders(ctx, t, client, &orderv1.WatchOrdersRequest{})
+
+	cancel()
+	_, err := stream.Recv()
+
+	requireGRPCError(t, err, codes.Canceled, "")
+
+	// Only the stream ended; the connection still serves other calls
+	created := createOrder(t, uuid.New().String(), OrderItem{ProductID: "grpc-cancel", Name: "gRPC Cancel", Quantity: 1, Price: 1.00})
+	_, err = client.GetOrder(context.Background(), &orderv1.GetOrderRequest{OrderId: created.ID})
+	require.NoError(t, err)
+}
+
+func TestGRPC_WatchOrders_Deadline_EndsStream(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	stream, err := grpcClient(t).WatchOrders(ctx, &orderv1.WatchOrdersRequest{EventTypes: []string{messaging.EventOrderDeleted}, Statuses: []string{"nonexistent"}})
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+
+	requireGRPCError(t, err, codes.DeadlineExceeded, "")
+}
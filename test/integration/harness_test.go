@@ -0,0 +1,195 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/kafka"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/modules/redis"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/app"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/config"
+)
+
+// Images of the dependencies the harness starts; the same versions as
+// deploy/docker/docker-compose.yml where the image is the same
+const (
+	postgresImage = "postgres:16-alpine"
+	redisImage    = "redis:7-alpine"
+	kafkaImage    = "confluentinc/confluent-local:7.5.0"
+)
+
+// harnessShutdownTimeout bounds the in-process server's graceful shutdown
+const harnessShutdownTimeout = 10 * time.Second
+
+// startService starts PostgreSQL, Redis and Kafka in containers and the
+// service in-process against them, configured through the environment like
+// the docker-compose stack. It returns the base URL of the HTTP API and a
+// function that stops the service and removes the containers.
+func startService(ctx context.Context) (string, func(), error) {
+	var containers []testcontainers.Container
+	stopContainers := func() {
+		for _, c := range containers {
+			if err := testcontainers.TerminateContainer(c); err != nil {
+				fmt.Printf("Failed to remove container: %v\n", err)
+			}
+		}
+	}
+
+	pg, err := postgres.Run(ctx, postgresImage,
+		postgres.WithDatabase("ordersvc"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		postgres.BasicWaitStrategies(),
+	)
+	containers = append(containers, pg)
+	if err != nil {
+		stopContainers()
+		return "", nil, fmt.Errorf("start postgres: %w", err)
+	}
+	rd, err := redis.Run(ctx, redisImage)
+	containers = append(containers, rd)
+	if err != nil {
+		stopContainers()
+		return "", nil, fmt.Errorf("start redis: %w", err)
+	}
+	kf, err := kafka.Run(ctx, kafkaImage, kafka.WithClusterID("ordersvc-integration"))
+	containers = append(containers, kf)
+	if err != nil {
+		stopContainers()
+		return "", nil, fmt.Errorf("start kafka: %w", err)
+	}
+
+	env, err := serviceEnv(ctx, pg, rd, kf)
+	if err != nil {
+		stopContainers()
+		return "", nil, err
+	}
+	// The tests read KAFKA_BROKERS too, so they consume from the same broker
+	for key, value := range env {
+		if err := os.Setenv(key, value); err != nil {
+			stopContainers()
+			return "", nil, err
+		}
+	}
+
+	cfg, err := config.Load("")
+	if err == nil {
+		err = cfg.Validate()
+	}
+	if err != nil {
+		stopContainers()
+		return "", nil, fmt.Errorf("load config: %w", err)
+	}
+
+	server := app.NewServer(config.NewProvider("", cfg), app.ModeServe)
+	server.StartJobs()
+	go func() {
+		if err := server.Start(); err != nil {
+			fmt.Printf("Server failed: %v\n", err)
+		}
+	}()
+
+	stop := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), harnessShutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(ctx); err != nil {
+			fmt.Printf("Failed to shut down server: %v\n", err)
+		}
+		stopContainers()
+	}
+	return "http://" + net.JoinHostPort("localhost", env["HTTP_PORT"]), stop, nil
+}
+
+// serviceEnv returns the environment that points the service at the
+// containers and at free local ports
+func serviceEnv(ctx context.Context, pg *postgres.PostgresContainer, rd *redis.RedisContainer, kf *kafka.KafkaContainer) (map[string]string, error) {
+	pgHost, err := pg.Host(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pgPort, err := pg.MappedPort(ctx, "5432/tcp")
+	if err != nil {
+		return nil, err
+	}
+	redisHost, err := rd.Host(ctx)
+	if err != nil {
+		return nil, err
+	}
+	redisPort, err := rd.MappedPort(ctx, "6379/tcp")
+	if err != nil {
+		return nil, err
+	}
+	brokers, err := kf.Brokers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	httpPort, err := freePort()
+	if err != nil {
+		return nil, err
+	}
+	grpcPort, err := freePort()
+	if err != nil {
+		return nil, err
+	}
+
+	env := map[string]string{
+		"APP_ENVIRONMENT":       "integration",
+		"HTTP_PORT":             strconv.Itoa(httpPort),
+		"GRPC_PORT":             strconv.Itoa(grpcPort),
+		"DATABASE_HOST":         pgHost,
+		"DATABASE_PORT":         pgPort.Port(),
+		"DATABASE_USER":         "postgres",
+		"DATABASE_PASSWORD":     "postgres",
+		"DATABASE_NAME":         "ordersvc",
+		"DATABASE_SSL_MODE":     "disable",
+		"DATABASE_AUTO_MIGRATE": "true",
+		"REDIS_HOST":            redisHost,
+		"REDIS_PORT":            redisPort.Port(),
+		"KAFKA_BROKERS":         strings.Join(brokers, ","),
+		"ADMIN_API_KEY":         "dev-admin-key",
+	}
+	// The tenancy tests need a service that resolves tenants from a header
+	if os.Getenv("ORDERSVC_TENANCY") == "header" {
+		env["TENANCY_MODE"] = "header"
+	}
+	return env, nil
+}
+
+// freePort returns a local TCP port that was free when it was checked
+func freePort() (int, error) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return 0, err
+	}
+	defer lis.Close()
+	addr, ok := lis.Addr().(*net.TCPAddr)
+	if !ok {
+		return 0, errors.New("listener has no TCP address")
+	}
+	return addr.Port, nil
+}
@@ -53,8 +53,8 @@ func findEvent(t *testing.T, timeout time.Duration, match func(messaging.OrderEv
 			t.Fatalf("failed to read Kafka message: %v", err)
 		}
 
-		var evt messaging.OrderEvent
-		if err := json.Unmarshal(msg.Value, &evt); err != nil {
+		evt, err := messaging.DecodeOrderEvent(msg.Value)
+		if err != nil {
 			continue
 		}
 		if match(evt) {
@@ -88,8 +88,8 @@ func findEvents(t *testing.T, timeout time.Duration, match func(messaging.OrderE
 			return events
 		}
 
-		var evt messaging.OrderEvent
-		if err := json.Unmarshal(msg.Value, &evt); err != nil {
+		evt, err := messaging.DecodeOrderEvent(msg.Value)
+		if err != nil {
 			continue
 		}
 		if match(evt) {
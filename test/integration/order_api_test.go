@@ -18,9 +18,11 @@ package integration
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -30,8 +32,10 @@ import (
 // Request/Response types for integration tests
 
 type CreateOrderRequest struct {
-	CustomerID string      `json:"customer_id"`
-	Items      []OrderItem `json:"items"`
+	CustomerID string            `json:"customer_id"`
+	Items      []OrderItem       `json:"items"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+	Tags       []string          `json:"tags,omitempty"`
 }
 
 type OrderItem struct {
@@ -51,12 +55,16 @@ type OrderResponse struct {
 		Quantity  int     `json:"quantity"`
 		Price     float64 `json:"price"`
 		Subtotal  float64 `json:"subtotal"`
+		Status    string  `json:"status"`
 	} `json:"items"`
-	Status    string  `json:"status"`
-	Total     float64 `json:"total"`
-	Version   int     `json:"version"`
-	CreatedAt string  `json:"created_at"`
-	UpdatedAt string  `json:"updated_at"`
+	Status    string            `json:"status"`
+	Total     float64           `json:"total"`
+	Version   int               `json:"version"`
+	Metadata  map[string]string `json:"metadata"`
+	Tags      []string          `json:"tags"`
+	CreatedAt string            `json:"created_at"`
+	UpdatedAt string            `json:"updated_at"`
+	DeletedAt *string           `json:"deleted_at"`
 }
 
 type ListOrdersResponse struct {
@@ -70,9 +78,32 @@ type UpdateStatusRequest struct {
 	Status string `json:"status"`
 }
 
+type OrderHistoryEntryResponse struct {
+	ID        string         `json:"id"`
+	OrderID   string         `json:"order_id"`
+	Action    string         `json:"action"`
+	Actor     string         `json:"actor"`
+	ActorType string         `json:"actor_type"`
+	Channel   string         `json:"channel"`
+	OldState  *OrderResponse `json:"old_state"`
+	NewState  *OrderResponse `json:"new_state"`
+}
+
+type ListOrderHistoryResponse struct {
+	Entries []OrderHistoryEntryResponse `json:"entries"`
+	Total   int64                       `json:"total"`
+}
+
 type ErrorResponse struct {
-	Error string `json:"error"`
-	Code  string `json:"code"`
+	Error  string       `json:"error"`
+	Code   string       `json:"code"`
+	Errors []FieldError `json:"errors"`
+}
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
 // Health check tests
@@ -146,7 +177,10 @@ func TestCreateOrder_MissingCustomerID_Returns400(t *testing.T) {
 	var errResp ErrorResponse
 	err := json.Unmarshal(body, &errResp)
 	require.NoError(t, err)
-	assert.Equal(t, "MISSING_CUSTOMER_ID", errResp.Code)
+	assert.Equal(t, "VALIDATION_FAILED", errResp.Code)
+	require.Len(t, errResp.Errors, 1)
+	assert.Equal(t, "customer_id", errResp.Errors[0].Field)
+	assert.Equal(t, "REQUIRED", errResp.Errors[0].Code)
 }
 
 func TestCreateOrder_EmptyItems_Returns400(t *testing.T) {
@@ -162,12 +196,54 @@ func TestCreateOrder_EmptyItems_Returns400(t *testing.T) {
 	var errResp ErrorResponse
 	err := json.Unmarshal(body, &errResp)
 	require.NoError(t, err)
-	assert.Equal(t, "MISSING_ITEMS", errResp.Code)
+	assert.Equal(t, "VALIDATION_FAILED", errResp.Code)
+	require.Len(t, errResp.Errors, 1)
+	assert.Equal(t, "items", errResp.Errors[0].Field)
+	assert.Equal(t, "TOO_FEW", errResp.Errors[0].Code)
 }
 
 // GET /api/v1/orders/:id tests
 // ADR-0002 CONSTRAINT: GET by ID Must Return 404 for Missing Orders
 
+func TestBulkCreateOrders_MixedBatch_CreatesValidOrders(t *testing.T) {
+	customerID := uuid.New().String()
+	item := []OrderItem{{ProductID: "prod-bulk", Name: "Bulk", Quantity: 2, Price: 4.00}}
+	req := map[string]interface{}{
+		"orders": []CreateOrderRequest{
+			{CustomerID: customerID, Items: item},
+			{CustomerID: customerID},
+			{CustomerID: customerID, Items: item},
+		},
+	}
+
+	resp, body := post(t, "/api/v1/orders/bulk", req)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+
+	var bulkResp struct {
+		Results []struct {
+			Index   int            `json:"index"`
+			Success bool           `json:"success"`
+			Order   *OrderResponse `json:"order"`
+			Error   *ErrorResponse `json:"error"`
+		} `json:"results"`
+		Succeeded int `json:"succeeded"`
+		Failed    int `json:"failed"`
+	}
+	require.NoError(t, json.Unmarshal(body, &bulkResp))
+
+	assert.Equal(t, 2, bulkResp.Succeeded)
+	assert.Equal(t, 1, bulkResp.Failed)
+	require.Len(t, bulkResp.Results, 3)
+	assert.False(t, bulkResp.Results[1].Success)
+	assert.Equal(t, "NO_ITEMS", bulkResp.Results[1].Error.Code)
+
+	for _, i := range []int{0, 2} {
+		require.True(t, bulkResp.Results[i].Success)
+		getResp, _ := get(t, "/api/v1/orders/"+bulkResp.Results[i].Order.ID)
+		assert.Equal(t, http.StatusOK, getResp.StatusCode, "bulk-created order should be readable")
+	}
+}
+
 func TestGetOrder_ExistingOrder_Returns200(t *testing.T) {
 	// First create an order
 	createReq := CreateOrderRequest{
@@ -209,6 +285,17 @@ func TestGetOrder_NonExistent_Returns404(t *testing.T) {
 	assert.Equal(t, "ORDER_NOT_FOUND", errResp.Code)
 }
 
+func TestGetOrder_MalformedID_Returns400(t *testing.T) {
+	resp, body := get(t, "/api/v1/orders/not-a-uuid")
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	var errResp ErrorResponse
+	err := json.Unmarshal(body, &errResp)
+	require.NoError(t, err)
+	assert.Equal(t, "INVALID_ID", errResp.Code)
+}
+
 // GET /api/v1/orders tests (pagination)
 
 func TestListOrders_Pagination_ReturnsCorrectFormat(t *testing.T) {
@@ -239,6 +326,41 @@ func TestListOrders_Pagination_ReturnsCorrectFormat(t *testing.T) {
 	assert.GreaterOrEqual(t, listResp.Total, int64(3), "Total should be at least 3")
 }
 
+func TestListOrders_CustomerPages_TotalOnEveryPage(t *testing.T) {
+	customerID := uuid.New().String()
+	for i := 0; i < 3; i++ {
+		req := CreateOrderRequest{
+			CustomerID: customerID,
+			Items:      []OrderItem{{ProductID: "prod-page", Name: "Paged", Quantity: 1, Price: 10.00}},
+		}
+		resp, _ := post(t, "/api/v1/orders", req)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	tests := []struct {
+		name      string
+		offset    int
+		wantCount int
+	}{
+		{name: "full page", offset: 0, wantCount: 2},
+		{name: "last page", offset: 2, wantCount: 1},
+		{name: "past the end", offset: 4, wantCount: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, body := get(t, fmt.Sprintf("/api/v1/orders?customer_id=%s&limit=2&offset=%d", customerID, tt.offset))
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			var listResp ListOrdersResponse
+			require.NoError(t, json.Unmarshal(body, &listResp))
+
+			assert.Len(t, listResp.Orders, tt.wantCount)
+			assert.Equal(t, int64(3), listResp.Total, "total must not depend on the page")
+		})
+	}
+}
+
 func TestListOrders_StatusFilter_FiltersCorrectly(t *testing.T) {
 	// Create an order
 	req := CreateOrderRequest{
@@ -460,6 +582,18 @@ func TestListOrders_CustomerIDWithStatusFilter_ReturnsCombinedFilter(t *testing.
 	}
 }
 
+func TestListOrders_UnknownStatus_Returns400(t *testing.T) {
+	resp, body := get(t, "/api/v1/orders?status=shipping")
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	var errResp ErrorResponse
+	err := json.Unmarshal(body, &errResp)
+	require.NoError(t, err)
+	assert.Equal(t, "INVALID_STATUS", errResp.Code)
+	assert.Contains(t, errResp.Error, "pending")
+}
+
 func TestListOrders_CustomerIDWithNoOrders_ReturnsEmptyList(t *testing.T) {
 	nonExistentCustomer := uuid.New().String()
 
@@ -474,6 +608,850 @@ func TestListOrders_CustomerIDWithNoOrders_ReturnsEmptyList(t *testing.T) {
 	assert.Empty(t, listResp.Orders)
 }
 
+func TestListOrders_ProductIDFilter_ReturnsOrdersContainingProduct(t *testing.T) {
+	customerID := uuid.New().String()
+	productID := "prod-" + uuid.New().String()
+
+	// One order containing the product among other items, one without it
+	withProduct := CreateOrderRequest{
+		CustomerID: customerID,
+		Items: []OrderItem{
+			{ProductID: "prod-other", Name: "Other", Quantity: 1, Price: 5.00},
+			{ProductID: productID, Name: "Target", Quantity: 2, Price: 15.00},
+		},
+	}
+	resp, _ := post(t, "/api/v1/orders", withProduct)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	withoutProduct := CreateOrderRequest{
+		CustomerID: customerID,
+		Items:      []OrderItem{{ProductID: "prod-other", Name: "Other", Quantity: 1, Price: 5.00}},
+	}
+	resp, _ = post(t, "/api/v1/orders", withoutProduct)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, body := get(t, "/api/v1/orders?product_id="+productID)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var listResp ListOrdersResponse
+	err := json.Unmarshal(body, &listResp)
+	require.NoError(t, err)
+
+	require.Equal(t, int64(1), listResp.Total)
+	require.Len(t, listResp.Orders, 1)
+	assert.Len(t, listResp.Orders[0].Items, 2, "all items of a matching order are returned")
+
+	// Combined with customer_id
+	resp, body = get(t, "/api/v1/orders?customer_id="+customerID+"&product_id="+productID)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	err = json.Unmarshal(body, &listResp)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), listResp.Total)
+}
+
+func TestRestoreOrder_DeletedOrder_Returns200(t *testing.T) {
+	createReq := CreateOrderRequest{
+		CustomerID: uuid.New().String(),
+		Items:      []OrderItem{{ProductID: "prod-1", Name: "Test", Quantity: 1, Price: 10.00}},
+	}
+	resp, body := post(t, "/api/v1/orders", createReq)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var order OrderResponse
+	require.NoError(t, json.Unmarshal(body, &order))
+
+	resp, _ = delete(t, "/api/v1/orders/"+order.ID)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	// Delete bumped the version to 2; a stale version is rejected
+	resp, _ = post(t, "/api/v1/orders/"+order.ID+"/restore", map[string]int{"version": 1})
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	resp, body = post(t, "/api/v1/orders/"+order.ID+"/restore", map[string]int{"version": 2})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var restored OrderResponse
+	require.NoError(t, json.Unmarshal(body, &restored))
+	assert.Equal(t, 3, restored.Version)
+
+	resp, _ = get(t, "/api/v1/orders/"+order.ID)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// A live order cannot be restored
+	resp, _ = post(t, "/api/v1/orders/"+order.ID+"/restore", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestPatchOrder_MergesItemsAndMetadata(t *testing.T) {
+	createReq := CreateOrderRequest{
+		CustomerID: uuid.New().String(),
+		Items: []OrderItem{
+			{ProductID: "prod-1", Name: "Mug", Quantity: 1, Price: 10.00},
+			{ProductID: "prod-2", Name: "Pen", Quantity: 2, Price: 2.50},
+		},
+		Metadata: map[string]string{"channel": "web"},
+	}
+	resp, body := post(t, "/api/v1/orders", createReq)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var order OrderResponse
+	require.NoError(t, json.Unmarshal(body, &order))
+
+	resp, body = patch(t, "/api/v1/orders/"+order.ID, map[string]any{
+		"items": map[string]any{
+			order.Items[0].ID: map[string]int{"quantity": 3},
+			order.Items[1].ID: nil,
+			"new":             OrderItem{ProductID: "prod-3", Name: "Pad", Quantity: 1, Price: 4.00},
+		},
+		"metadata": map[string]string{"gift": "yes"},
+		"version":  order.Version,
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var patched OrderResponse
+	require.NoError(t, json.Unmarshal(body, &patched))
+	require.Len(t, patched.Items, 2)
+	assert.Equal(t, order.Items[0].ID, patched.Items[0].ID)
+	assert.Equal(t, 3, patched.Items[0].Quantity)
+	assert.Equal(t, "prod-3", patched.Items[1].ProductID)
+	assert.Equal(t, 34.00, patched.Total)
+	assert.Equal(t, map[string]string{"channel": "web", "gift": "yes"}, patched.Metadata)
+
+	// The version moved on, and status is not patchable
+	resp, _ = patch(t, "/api/v1/orders/"+order.ID, map[string]any{"tags": []string{"late"}, "version": order.Version})
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	resp, body = patch(t, "/api/v1/orders/"+order.ID, map[string]string{"status": "shipped"})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	var errResp ErrorResponse
+	require.NoError(t, json.Unmarshal(body, &errResp))
+	assert.Equal(t, "INVALID_PATCH", errResp.Code)
+}
+
+func TestOrderItems_AddUpdateRemove(t *testing.T) {
+	createReq := CreateOrderRequest{
+		CustomerID: uuid.New().String(),
+		Items:      []OrderItem{{ProductID: "prod-1", Name: "Mug", Quantity: 1, Price: 10.00}},
+	}
+	resp, body := post(t, "/api/v1/orders", createReq)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var order OrderResponse
+	require.NoError(t, json.Unmarshal(body, &order))
+
+	resp, body = post(t, "/api/v1/orders/"+order.ID+"/items", OrderItem{ProductID: "prod-2", Name: "Pen", Quantity: 2, Price: 2.50})
+	require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+	require.NoError(t, json.Unmarshal(body, &order))
+	require.Len(t, order.Items, 2)
+	assert.Equal(t, 15.00, order.Total)
+
+	resp, body = patch(t, "/api/v1/orders/"+order.ID+"/items/"+order.Items[1].ID, map[string]int{"quantity": 4, "version": order.Version})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	require.NoError(t, json.Unmarshal(body, &order))
+	assert.Equal(t, 20.00, order.Total)
+
+	resp, body = delete(t, "/api/v1/orders/"+order.ID+"/items/"+order.Items[0].ID)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	require.NoError(t, json.Unmarshal(body, &order))
+	require.Len(t, order.Items, 1)
+	assert.Equal(t, 10.00, order.Total)
+
+	// Items are locked once the order moves past confirmed
+	for _, status := range []string{"confirmed", "processing"} {
+		resp, _ = patch(t, "/api/v1/orders/"+order.ID+"/status", UpdateStatusRequest{Status: status})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	resp, body = post(t, "/api/v1/orders/"+order.ID+"/items", OrderItem{ProductID: "prod-3", Name: "Pad", Quantity: 1, Price: 4.00})
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	var errResp ErrorResponse
+	require.NoError(t, json.Unmarshal(body, &errResp))
+	assert.Equal(t, "ITEMS_LOCKED", errResp.Code)
+}
+
+func TestFields_ReturnsOnlySelectedFields(t *testing.T) {
+	createReq := CreateOrderRequest{
+		CustomerID: uuid.New().String(),
+		Items:      []OrderItem{{ProductID: "prod-1", Name: "Test", Quantity: 2, Price: 5.00}},
+	}
+	resp, body := post(t, "/api/v1/orders", createReq)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var order OrderResponse
+	require.NoError(t, json.Unmarshal(body, &order))
+
+	resp, body = get(t, "/api/v1/orders/"+order.ID+"?fields=id,status,total")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var sparse map[string]any
+	require.NoError(t, json.Unmarshal(body, &sparse))
+	assert.Equal(t, map[string]any{"id": order.ID, "status": "pending", "total": 10.00}, sparse)
+
+	resp, body = get(t, "/api/v1/orders?customer_id="+createReq.CustomerID+"&fields=id")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var list struct {
+		Orders []map[string]any `json:"orders"`
+		Total  int64            `json:"total"`
+	}
+	require.NoError(t, json.Unmarshal(body, &list))
+	assert.Equal(t, []map[string]any{{"id": order.ID}}, list.Orders)
+	assert.Equal(t, int64(1), list.Total)
+
+	resp, body = get(t, "/api/v1/orders/"+order.ID+"?fields=id,price")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	var errResp ErrorResponse
+	require.NoError(t, json.Unmarshal(body, &errResp))
+	assert.Equal(t, "INVALID_FIELDS", errResp.Code)
+}
+
+func TestGetOrder_ConditionalGet(t *testing.T) {
+	createReq := CreateOrderRequest{
+		CustomerID: uuid.New().String(),
+		Items:      []OrderItem{{ProductID: "prod-1", Name: "Test", Quantity: 1, Price: 10.00}},
+	}
+	resp, body := post(t, "/api/v1/orders", createReq)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var order OrderResponse
+	require.NoError(t, json.Unmarshal(body, &order))
+
+	resp, _ = get(t, "/api/v1/orders/"+order.ID)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	etag := resp.Header.Get("ETag")
+	assert.Equal(t, `W/"1"`, etag)
+	assert.Equal(t, "private, no-cache", resp.Header.Get("Cache-Control"))
+	lastModified := resp.Header.Get("Last-Modified")
+	require.NotEmpty(t, lastModified)
+
+	conditionalGet := func(header, value string) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, baseURL+"/api/v1/orders/"+order.ID, nil)
+		require.NoError(t, err)
+		req.Header.Set(header, value)
+		resp, err := (&http.Client{Timeout: 10 * time.Second}).Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp
+	}
+	assert.Equal(t, http.StatusNotModified, conditionalGet("If-None-Match", etag).StatusCode)
+	assert.Equal(t, http.StatusNotModified, conditionalGet("If-Modified-Since", lastModified).StatusCode)
+
+	// A write moves the version on, so the old tag no longer matches
+	resp, _ = patch(t, "/api/v1/orders/"+order.ID+"/status", UpdateStatusRequest{Status: "confirmed"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, http.StatusOK, conditionalGet("If-None-Match", etag).StatusCode)
+}
+
+func TestListOrders_PaginationLinks(t *testing.T) {
+	customerID := uuid.New().String()
+	for i := 0; i < 3; i++ {
+		resp, _ := post(t, "/api/v1/orders", CreateOrderRequest{
+			CustomerID: customerID,
+			Items:      []OrderItem{{ProductID: "prod-1", Name: "Test", Quantity: 1, Price: 10.00}},
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	type page struct {
+		Orders []OrderResponse   `json:"orders"`
+		Links  map[string]string `json:"links"`
+	}
+	resp, body := get(t, "/api/v1/orders?customer_id="+customerID+"&limit=2")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var first page
+	require.NoError(t, json.Unmarshal(body, &first))
+	require.Len(t, first.Orders, 2)
+	assert.NotContains(t, first.Links, "prev")
+	assert.Contains(t, resp.Header.Get("Link"), `rel="next"`)
+
+	// Following next keeps the customer filter and reaches the last page
+	resp, body = get(t, first.Links["next"])
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var second page
+	require.NoError(t, json.Unmarshal(body, &second))
+	require.Len(t, second.Orders, 1)
+	assert.Equal(t, first.Links["next"], second.Links["last"])
+	assert.Equal(t, first.Links["first"], second.Links["prev"])
+	assert.NotContains(t, second.Links, "next")
+}
+
+func TestCreateOrder_OverLimits_Rejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []OrderItem
+		wantCode string
+	}{
+		{"quantity", []OrderItem{{ProductID: "prod-1", Name: "Test", Quantity: 10001, Price: 1.00}}, "QUANTITY_LIMIT_EXCEEDED"},
+		{"total", []OrderItem{{ProductID: "prod-1", Name: "Test", Quantity: 2, Price: 500000.01}}, "TOTAL_LIMIT_EXCEEDED"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, body := post(t, "/api/v1/orders", CreateOrderRequest{CustomerID: uuid.New().String(), Items: tt.items})
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			var errResp ErrorResponse
+			require.NoError(t, json.Unmarshal(body, &errResp))
+			assert.Equal(t, tt.wantCode, errResp.Code)
+		})
+	}
+}
+
+func TestIncludeDeleted_GetAndListDeletedOrder(t *testing.T) {
+	customerID := uuid.New().String()
+	createReq := CreateOrderRequest{
+		CustomerID: customerID,
+		Items:      []OrderItem{{ProductID: "prod-1", Name: "Test", Quantity: 1, Price: 10.00}},
+	}
+	resp, body := post(t, "/api/v1/orders", createReq)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var order OrderResponse
+	require.NoError(t, json.Unmarshal(body, &order))
+
+	resp, _ = delete(t, "/api/v1/orders/"+order.ID)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, body = get(t, "/api/v1/orders/"+order.ID+"?include_deleted=true")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var deleted OrderResponse
+	require.NoError(t, json.Unmarshal(body, &deleted))
+	assert.NotNil(t, deleted.DeletedAt)
+
+	resp, body = get(t, "/api/v1/orders?customer_id="+customerID)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var live ListOrdersResponse
+	require.NoError(t, json.Unmarshal(body, &live))
+	assert.Empty(t, live.Orders)
+
+	resp, body = get(t, "/api/v1/orders?customer_id="+customerID+"&include_deleted=true")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var all ListOrdersResponse
+	require.NoError(t, json.Unmarshal(body, &all))
+	require.Len(t, all.Orders, 1)
+	assert.Equal(t, order.ID, all.Orders[0].ID)
+	assert.NotNil(t, all.Orders[0].DeletedAt)
+}
+
+func TestHoldOrder_HoldAndRelease_RestoresStatus(t *testing.T) {
+	createReq := CreateOrderRequest{
+		CustomerID: uuid.New().String(),
+		Items:      []OrderItem{{ProductID: "prod-1", Name: "Test", Quantity: 1, Price: 10.00}},
+	}
+	resp, body := post(t, "/api/v1/orders", createReq)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var order OrderResponse
+	require.NoError(t, json.Unmarshal(body, &order))
+
+	resp, _ = patch(t, "/api/v1/orders/"+order.ID+"/status", UpdateStatusRequest{Status: "confirmed"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, body = post(t, "/api/v1/orders/"+order.ID+"/hold", map[string]string{"reason": "Address verification"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var held struct {
+		Status string `json:"status"`
+		Hold   *struct {
+			Reason         string `json:"reason"`
+			PreviousStatus string `json:"previous_status"`
+		} `json:"hold"`
+	}
+	require.NoError(t, json.Unmarshal(body, &held))
+	assert.Equal(t, "on_hold", held.Status)
+	require.NotNil(t, held.Hold)
+	assert.Equal(t, "Address verification", held.Hold.Reason)
+	assert.Equal(t, "confirmed", held.Hold.PreviousStatus)
+
+	// A held order cannot move on until it is released
+	resp, _ = patch(t, "/api/v1/orders/"+order.ID+"/status", UpdateStatusRequest{Status: "processing"})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, body = post(t, "/api/v1/orders/"+order.ID+"/release", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var released OrderResponse
+	require.NoError(t, json.Unmarshal(body, &released))
+	assert.Equal(t, "confirmed", released.Status)
+
+	resp, _ = post(t, "/api/v1/orders/"+order.ID+"/release", nil)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+}
+
+func TestUpdateItemStatus_PartialShipment(t *testing.T) {
+	createReq := CreateOrderRequest{
+		CustomerID: uuid.New().String(),
+		Items: []OrderItem{
+			{ProductID: "prod-1", Name: "Desk", Quantity: 1, Price: 200.00},
+			{ProductID: "prod-2", Name: "Chair", Quantity: 2, Price: 80.00},
+		},
+	}
+	resp, body := post(t, "/api/v1/orders", createReq)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var order OrderResponse
+	require.NoError(t, json.Unmarshal(body, &order))
+	require.Len(t, order.Items, 2)
+	assert.Equal(t, "pending", order.Items[0].Status)
+
+	resp, _ = patch(t, "/api/v1/orders/"+order.ID+"/status", UpdateStatusRequest{Status: "confirmed"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	first, second := order.Items[0].ID, order.Items[1].ID
+	resp, body = patch(t, "/api/v1/orders/"+order.ID+"/items/"+first+"/status", map[string]string{"status": "shipped"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var partial OrderResponse
+	require.NoError(t, json.Unmarshal(body, &partial))
+	assert.Equal(t, "processing", partial.Status)
+
+	// Items cannot be replaced once part of the order has shipped
+	resp, _ = doRequest(t, http.MethodPut, "/api/v1/orders/"+order.ID, map[string]any{"items": createReq.Items})
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	resp, body = patch(t, "/api/v1/orders/"+order.ID+"/items/status", map[string]any{"item_ids": []string{second}, "status": "shipped"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var shipped OrderResponse
+	require.NoError(t, json.Unmarshal(body, &shipped))
+	assert.Equal(t, "shipped", shipped.Status)
+
+	resp, _ = patch(t, "/api/v1/orders/"+order.ID+"/items/"+uuid.NewString()+"/status", map[string]string{"status": "delivered"})
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestOrderNotes_AddListAndInclude(t *testing.T) {
+	createReq := CreateOrderRequest{
+		CustomerID: uuid.New().String(),
+		Items:      []OrderItem{{ProductID: "prod-1", Name: "Test", Quantity: 1, Price: 10.00}},
+	}
+	resp, body := post(t, "/api/v1/orders", createReq)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var order OrderResponse
+	require.NoError(t, json.Unmarshal(body, &order))
+
+	resp, _ = post(t, "/api/v1/orders/"+order.ID+"/notes", map[string]string{"body": "Please ring twice", "visibility": "customer"})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	resp, body = post(t, "/api/v1/orders/"+order.ID+"/notes", map[string]string{"body": "Repeat fraud check"})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var internal struct {
+		Visibility string `json:"visibility"`
+	}
+	require.NoError(t, json.Unmarshal(body, &internal))
+	assert.Equal(t, "internal", internal.Visibility)
+
+	resp, body = get(t, "/api/v1/orders/"+order.ID+"/notes")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var list struct {
+		Notes []struct {
+			Body string `json:"body"`
+		} `json:"notes"`
+		Total int64 `json:"total"`
+	}
+	require.NoError(t, json.Unmarshal(body, &list))
+	assert.Equal(t, int64(2), list.Total)
+	require.Len(t, list.Notes, 2)
+	assert.Equal(t, "Please ring twice", list.Notes[0].Body)
+
+	// Notes do not change the order itself
+	resp, body = get(t, "/api/v1/orders/"+order.ID+"?include=notes")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var withNotes struct {
+		Version int `json:"version"`
+		Notes   []struct {
+			Body string `json:"body"`
+		} `json:"notes"`
+	}
+	require.NoError(t, json.Unmarshal(body, &withNotes))
+	assert.Equal(t, order.Version, withNotes.Version)
+	assert.Len(t, withNotes.Notes, 2)
+
+	resp, _ = get(t, "/api/v1/orders/"+order.ID+"?include=everything")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, _ = post(t, "/api/v1/orders/"+order.ID+"/notes", map[string]string{"body": ""})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestOrderLabels_CreateUpdateAndFilterByTag(t *testing.T) {
+	customerID := uuid.New().String()
+	items := []OrderItem{{ProductID: "prod-1", Name: "Test", Quantity: 1, Price: 10.00}}
+	resp, body := post(t, "/api/v1/orders", CreateOrderRequest{
+		CustomerID: customerID,
+		Items:      items,
+		Metadata:   map[string]string{"channel": "web"},
+		Tags:       []string{"VIP", "gift"},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var tagged OrderResponse
+	require.NoError(t, json.Unmarshal(body, &tagged))
+	assert.Equal(t, map[string]string{"channel": "web"}, tagged.Metadata)
+	assert.Equal(t, []string{"vip", "gift"}, tagged.Tags)
+
+	resp, body = post(t, "/api/v1/orders", CreateOrderRequest{CustomerID: customerID, Items: items, Tags: []string{"vip"}})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var vipOnly OrderResponse
+	require.NoError(t, json.Unmarshal(body, &vipOnly))
+
+	// Every tag must match
+	resp, body = get(t, "/api/v1/orders?customer_id="+customerID+"&tag=vip&tag=gift")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var list ListOrdersResponse
+	require.NoError(t, json.Unmarshal(body, &list))
+	require.Len(t, list.Orders, 1)
+	assert.Equal(t, tagged.ID, list.Orders[0].ID)
+
+	// Omitted metadata is kept; tags are replaced
+	resp, body = doRequest(t, http.MethodPut, "/api/v1/orders/"+tagged.ID, map[string]any{"items": items, "tags": []string{"returned"}})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var updated OrderResponse
+	require.NoError(t, json.Unmarshal(body, &updated))
+	assert.Equal(t, map[string]string{"channel": "web"}, updated.Metadata)
+	assert.Equal(t, []string{"returned"}, updated.Tags)
+
+	resp, body = get(t, "/api/v1/orders?customer_id="+customerID+"&tag=vip")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, json.Unmarshal(body, &list))
+	require.Len(t, list.Orders, 1)
+	assert.Equal(t, vipOnly.ID, list.Orders[0].ID)
+
+	resp, _ = get(t, "/api/v1/orders?tag=")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, _ = post(t, "/api/v1/orders", CreateOrderRequest{CustomerID: customerID, Items: items, Metadata: map[string]string{"": "x"}})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestOrderAddresses_CreateUpdateAndLock(t *testing.T) {
+	shipping := map[string]string{"line1": "12 St James's Square", "city": "London", "postal_code": "SW1Y 4JH", "country": "gb"}
+	resp, body := post(t, "/api/v1/orders", map[string]any{
+		"customer_id":      uuid.New().String(),
+		"items":            []OrderItem{{ProductID: "prod-1", Name: "Test", Quantity: 1, Price: 10.00}},
+		"shipping_address": shipping,
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var order struct {
+		ID              string            `json:"id"`
+		Version         int               `json:"version"`
+		ShippingAddress map[string]string `json:"shipping_address"`
+		BillingAddress  map[string]string `json:"billing_address"`
+	}
+	require.NoError(t, json.Unmarshal(body, &order))
+	assert.Equal(t, "GB", order.ShippingAddress["country"])
+	assert.Nil(t, order.BillingAddress)
+
+	billing := map[string]string{"line1": "1 Infinite Loop", "city": "Cupertino", "country": "US"}
+	resp, body = patch(t, "/api/v1/orders/"+order.ID+"/addresses", map[string]any{"billing_address": billing, "version": order.Version})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, json.Unmarshal(body, &order))
+	assert.Equal(t, "Cupertino", order.BillingAddress["city"])
+	assert.Equal(t, "London", order.ShippingAddress["city"], "an omitted address is unchanged")
+
+	resp, _ = patch(t, "/api/v1/orders/"+order.ID+"/addresses", map[string]any{})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, body = patch(t, "/api/v1/orders/"+order.ID+"/addresses", map[string]any{"shipping_address": map[string]string{"line1": "x", "city": "y", "country": "GBR"}})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	var errResp ErrorResponse
+	require.NoError(t, json.Unmarshal(body, &errResp))
+	assert.Equal(t, "INVALID_ADDRESS", errResp.Code)
+
+	for _, status := range []string{"confirmed", "processing"} {
+		resp, _ = patch(t, "/api/v1/orders/"+order.ID+"/status", UpdateStatusRequest{Status: status})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	resp, body = patch(t, "/api/v1/orders/"+order.ID+"/addresses", map[string]any{"shipping_address": billing})
+	require.Equal(t, http.StatusConflict, resp.StatusCode)
+	require.NoError(t, json.Unmarshal(body, &errResp))
+	assert.Equal(t, "ADDRESS_LOCKED", errResp.Code)
+}
+
+func TestOrderDelivery_EstimateSetOnConfirmation(t *testing.T) {
+	resp, body := post(t, "/api/v1/orders", map[string]any{
+		"customer_id":     uuid.New().String(),
+		"items":           []OrderItem{{ProductID: "prod-1", Name: "Test", Quantity: 1, Price: 10.00}},
+		"shipping_method": "express",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var order struct {
+		ID                  string     `json:"id"`
+		ShippingMethod      string     `json:"shipping_method"`
+		UpdatedAt           time.Time  `json:"updated_at"`
+		EstimatedDeliveryAt *time.Time `json:"estimated_delivery_at"`
+	}
+	require.NoError(t, json.Unmarshal(body, &order))
+	assert.Equal(t, "express", order.ShippingMethod)
+	assert.Nil(t, order.EstimatedDeliveryAt, "pending orders have no estimate")
+
+	resp, body = patch(t, "/api/v1/orders/"+order.ID+"/status", UpdateStatusRequest{Status: "confirmed"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, json.Unmarshal(body, &order))
+	require.NotNil(t, order.EstimatedDeliveryAt)
+	assert.True(t, order.EstimatedDeliveryAt.After(order.UpdatedAt))
+
+	resp, body = post(t, "/api/v1/orders", map[string]any{
+		"customer_id":     uuid.New().String(),
+		"items":           []OrderItem{{ProductID: "prod-1", Name: "Test", Quantity: 1, Price: 10.00}},
+		"shipping_method": "teleport",
+	})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	var errResp ErrorResponse
+	require.NoError(t, json.Unmarshal(body, &errResp))
+	assert.Equal(t, "INVALID_SHIPPING_METHOD", errResp.Code)
+}
+
+func TestSubscriptions_CreateControlAndDelete(t *testing.T) {
+	customerID := uuid.New().String()
+	firstRun := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	resp, body := post(t, "/api/v1/subscriptions", map[string]any{
+		"customer_id":  customerID,
+		"items":        []OrderItem{{ProductID: "prod-1", Name: "Test", Quantity: 2, Price: 10.00}},
+		"cadence":      "weekly",
+		"first_run_at": firstRun,
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var sub struct {
+		ID        string    `json:"id"`
+		Cadence   string    `json:"cadence"`
+		Status    string    `json:"status"`
+		NextRunAt time.Time `json:"next_run_at"`
+		Version   int       `json:"version"`
+	}
+	require.NoError(t, json.Unmarshal(body, &sub))
+	assert.Equal(t, "active", sub.Status)
+	assert.True(t, firstRun.Equal(sub.NextRunAt))
+	path := "/api/v1/subscriptions/" + sub.ID
+
+	resp, body = get(t, "/api/v1/subscriptions?customer_id="+customerID)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var list struct {
+		Total int64 `json:"total"`
+	}
+	require.NoError(t, json.Unmarshal(body, &list))
+	assert.Equal(t, int64(1), list.Total)
+
+	resp, body = post(t, path+"/skip", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, json.Unmarshal(body, &sub))
+	assert.True(t, firstRun.AddDate(0, 0, 7).Equal(sub.NextRunAt))
+
+	resp, body = post(t, path+"/pause", map[string]any{"version": sub.Version})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, json.Unmarshal(body, &sub))
+	assert.Equal(t, "paused", sub.Status)
+
+	resp, body = post(t, path+"/pause", nil)
+	require.Equal(t, http.StatusConflict, resp.StatusCode)
+	var errResp ErrorResponse
+	require.NoError(t, json.Unmarshal(body, &errResp))
+	assert.Equal(t, "INVALID_SUBSCRIPTION_TRANSITION", errResp.Code)
+
+	resp, body = post(t, path+"/resume", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, json.Unmarshal(body, &sub))
+	assert.Equal(t, "active", sub.Status)
+
+	resp, body = doRequest(t, http.MethodPut, path, map[string]any{
+		"items":   []OrderItem{{ProductID: "prod-2", Name: "Refill", Quantity: 1, Price: 5.00}},
+		"cadence": "fortnightly",
+	})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.NoError(t, json.Unmarshal(body, &errResp))
+	assert.Equal(t, "INVALID_CADENCE", errResp.Code)
+
+	resp, body = doRequest(t, http.MethodPut, path, map[string]any{
+		"items":   []OrderItem{{ProductID: "prod-2", Name: "Refill", Quantity: 1, Price: 5.00}},
+		"cadence": "monthly",
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, json.Unmarshal(body, &sub))
+	assert.Equal(t, "monthly", sub.Cadence)
+
+	resp, _ = delete(t, path)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, body = get(t, path)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	require.NoError(t, json.Unmarshal(body, &errResp))
+	assert.Equal(t, "SUBSCRIPTION_NOT_FOUND", errResp.Code)
+}
+
+func TestGetOrderHistory_RecordsEveryMutation(t *testing.T) {
+	createReq := CreateOrderRequest{
+		CustomerID: uuid.New().String(),
+		Items:      []OrderItem{{ProductID: "prod-1", Name: "Test", Quantity: 1, Price: 10.00}},
+	}
+	resp, body := post(t, "/api/v1/orders", createReq)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var order OrderResponse
+	require.NoError(t, json.Unmarshal(body, &order))
+
+	resp, _ = patch(t, "/api/v1/orders/"+order.ID+"/status", UpdateStatusRequest{Status: "confirmed"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, _ = delete(t, "/api/v1/orders/"+order.ID)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, body = get(t, "/api/v1/orders/"+order.ID+"/history")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var history ListOrderHistoryResponse
+	require.NoError(t, json.Unmarshal(body, &history))
+	require.Equal(t, int64(3), history.Total)
+	require.Len(t, history.Entries, 3)
+
+	// Newest first
+	assert.Equal(t, "deleted", history.Entries[0].Action)
+	assert.Equal(t, "status_changed", history.Entries[1].Action)
+	assert.Equal(t, "created", history.Entries[2].Action)
+
+	changed := history.Entries[1]
+	require.NotNil(t, changed.OldState)
+	require.NotNil(t, changed.NewState)
+	assert.Equal(t, "pending", changed.OldState.Status)
+	assert.Equal(t, "confirmed", changed.NewState.Status)
+	assert.Nil(t, history.Entries[2].OldState)
+	assert.Equal(t, "anonymous", history.Entries[2].Actor)
+	assert.Equal(t, "anonymous", history.Entries[2].ActorType)
+	assert.Equal(t, "http", history.Entries[2].Channel)
+}
+
+func TestGetOrderHistory_NonExistent_Returns404(t *testing.T) {
+	resp, _ := get(t, "/api/v1/orders/"+uuid.New().String()+"/history")
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestGetOrderDiff_ComparesRecordedVersions(t *testing.T) {
+	createReq := CreateOrderRequest{
+		CustomerID: uuid.New().String(),
+		Items:      []OrderItem{{ProductID: "prod-1", Name: "Test", Quantity: 1, Price: 10.00}},
+	}
+	resp, body := post(t, "/api/v1/orders", createReq)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var order OrderResponse
+	require.NoError(t, json.Unmarshal(body, &order))
+
+	resp, _ = patch(t, "/api/v1/orders/"+order.ID+"/status", UpdateStatusRequest{Status: "confirmed"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, body = get(t, "/api/v1/orders/"+order.ID+"/diff?from_version=1")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var diff struct {
+		FromVersion int `json:"from_version"`
+		ToVersion   int `json:"to_version"`
+		Changes     []struct {
+			Field string `json:"field"`
+			From  any    `json:"from"`
+			To    any    `json:"to"`
+		} `json:"changes"`
+		Items []json.RawMessage `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal(body, &diff))
+	assert.Equal(t, 1, diff.FromVersion)
+	assert.Equal(t, 2, diff.ToVersion)
+	require.Len(t, diff.Changes, 1)
+	assert.Equal(t, "status", diff.Changes[0].Field)
+	assert.Equal(t, "pending", diff.Changes[0].From)
+	assert.Equal(t, "confirmed", diff.Changes[0].To)
+	assert.Empty(t, diff.Items)
+
+	resp, _ = get(t, "/api/v1/orders/"+order.ID+"/diff?from_version=1&to_version=5")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, _ = get(t, "/api/v1/orders/"+order.ID+"/diff")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestEraseCustomerData_AnonymizesOrders(t *testing.T) {
+	customerID := uuid.New().String()
+	createReq := CreateOrderRequest{
+		CustomerID: customerID,
+		Items:      []OrderItem{{ProductID: "prod-1", Name: "Personal Gift", Quantity: 1, Price: 10.00}},
+	}
+	resp, body := post(t, "/api/v1/orders", createReq)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var order OrderResponse
+	require.NoError(t, json.Unmarshal(body, &order))
+
+	resp, body = delete(t, "/api/v1/customers/"+customerID+"/data")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var erasure struct {
+		ErasureID    string `json:"erasure_id"`
+		OrdersErased int    `json:"orders_erased"`
+	}
+	require.NoError(t, json.Unmarshal(body, &erasure))
+	assert.Equal(t, 1, erasure.OrdersErased)
+
+	resp, body = get(t, "/api/v1/orders/"+order.ID)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var erased OrderResponse
+	require.NoError(t, json.Unmarshal(body, &erased))
+	assert.Equal(t, "erased-"+erasure.ErasureID, erased.CustomerID)
+	assert.Equal(t, "[erased]", erased.Items[0].Name)
+
+	resp, body = get(t, "/api/v1/orders/"+order.ID+"/history")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var history ListOrderHistoryResponse
+	require.NoError(t, json.Unmarshal(body, &history))
+	require.Len(t, history.Entries, 1)
+	assert.Equal(t, "erased", history.Entries[0].Action)
+
+	// A second request finds nothing left to erase
+	resp, _ = delete(t, "/api/v1/customers/"+customerID+"/data")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestSearchOrders_MatchesItemNameAndIDPrefix(t *testing.T) {
+	product := "Zanzibar" + uuid.New().String()[:8]
+	createReq := CreateOrderRequest{
+		CustomerID: uuid.New().String(),
+		Items:      []OrderItem{{ProductID: "prod-1", Name: product + " Lamp", Quantity: 1, Price: 10.00}},
+	}
+	resp, body := post(t, "/api/v1/orders", createReq)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var order OrderResponse
+	require.NoError(t, json.Unmarshal(body, &order))
+
+	for _, q := range []string{product, order.ID[:13]} {
+		resp, body = get(t, "/api/v1/orders/search?q="+q)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result ListOrdersResponse
+		require.NoError(t, json.Unmarshal(body, &result))
+		require.NotEmpty(t, result.Orders, "query %q", q)
+		assert.Equal(t, order.ID, result.Orders[0].ID, "query %q", q)
+	}
+}
+
+func TestSearchOrders_MissingQuery_Returns400(t *testing.T) {
+	resp, _ := get(t, "/api/v1/orders/search")
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestOrderReport_GroupByCustomer_SumsRevenue(t *testing.T) {
+	customerID := uuid.New().String()
+	for _, price := range []float64{10.00, 15.50} {
+		createReq := CreateOrderRequest{
+			CustomerID: customerID,
+			Items:      []OrderItem{{ProductID: "prod-1", Name: "Test", Quantity: 2, Price: price}},
+		}
+		resp, _ := post(t, "/api/v1/orders", createReq)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	resp, body := get(t, "/api/v1/reports/orders?group_by=customer&limit=100")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var report struct {
+		GroupBy string `json:"group_by"`
+		Rows    []struct {
+			Key        string  `json:"key"`
+			OrderCount int64   `json:"order_count"`
+			Revenue    float64 `json:"revenue"`
+		} `json:"rows"`
+	}
+	require.NoError(t, json.Unmarshal(body, &report))
+	assert.Equal(t, "customer", report.GroupBy)
+
+	for _, row := range report.Rows {
+		if row.Key == customerID {
+			assert.Equal(t, int64(2), row.OrderCount)
+			assert.InDelta(t, 51.00, row.Revenue, 0.001)
+			return
+		}
+	}
+	t.Fatalf("customer %s missing from report", customerID)
+}
+
+func TestOrderReport_InvalidGroupBy_Returns400(t *testing.T) {
+	resp, _ := get(t, "/api/v1/reports/orders?group_by=year")
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
 // Full lifecycle test
 
 func TestOrderLifecycle_FullFlow(t *testing.T) {
@@ -549,3 +1527,21 @@ func TestOrderLifecycle_FullFlow(t *testing.T) {
 	verifyResp, _ := get(t, "/api/v1/orders/"+order.ID)
 	assert.Equal(t, http.StatusNotFound, verifyResp.StatusCode)
 }
+
+func TestOpenAPI_ServesSpecAndDocs(t *testing.T) {
+	resp, body := get(t, "/api/v1/openapi.json")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+	var spec struct {
+		OpenAPI string                     `json:"openapi"`
+		Paths   map[string]json.RawMessage `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(body, &spec))
+	assert.Equal(t, "3.0.3", spec.OpenAPI)
+	assert.Contains(t, spec.Paths, "/api/v1/orders/{id}")
+
+	resp, body = get(t, "/docs")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "/api/v1/openapi.json")
+}
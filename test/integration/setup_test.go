@@ -18,6 +18,7 @@ package integration
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -28,28 +29,37 @@ import (
 )
 
 const (
-	defaultBaseURL = "http://localhost:8080"
-	maxRetries     = 30
-	retryInterval  = 1 * time.Second
+	maxRetries    = 30
+	retryInterval = 1 * time.Second
 )
 
 var baseURL string
 
 func TestMain(m *testing.M) {
-	// Get base URL from environment or use default
+	// Test a running service when ORDERSVC_URL names one, e.g. the
+	// docker-compose stack at http://localhost:8080; otherwise start one
+	// in-process on containerized dependencies
 	baseURL = os.Getenv("ORDERSVC_URL")
+	stop := func() {}
 	if baseURL == "" {
-		baseURL = defaultBaseURL
+		var err error
+		baseURL, stop, err = startService(context.Background())
+		if err != nil {
+			fmt.Printf("Failed to start service: %v\n", err)
+			os.Exit(1)
+		}
 	}
 
 	// Wait for service to be ready
 	if err := waitForService(); err != nil {
 		fmt.Printf("Service not ready: %v\n", err)
+		stop()
 		os.Exit(1)
 	}
 
 	// Run tests
 	code := m.Run()
+	stop()
 	os.Exit(code)
 }
 
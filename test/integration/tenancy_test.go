@@ -0,0 +1,141 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sridharn-code-sandbox/go-ordersvc/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// requireHeaderTenancy skips unless the service under test was started with
+// TENANCY_MODE=header, which ORDERSVC_TENANCY=header signals. The rest of the
+// suite sends no tenant, so the two cannot run against the same service.
+func requireHeaderTenancy(t *testing.T) {
+	t.Helper()
+	if os.Getenv("ORDERSVC_TENANCY") != "header" {
+		t.Skip("ORDERSVC_TENANCY is not header; the service does not resolve tenants")
+	}
+}
+
+func TestTenancy_OrderInvisibleToOtherTenant(t *testing.T) {
+	requireHeaderTenancy(t)
+	ctx := context.Background()
+	suffix := uuid.NewString()[:8]
+	acme := client.New(baseURL, client.WithTenant("acme-"+suffix))
+	globex := client.New(baseURL, client.WithTenant("globex-"+suffix))
+	customerID := uuid.NewString()
+
+	order, err := acme.CreateOrder(ctx, client.CreateOrderRequest{
+		CustomerID: customerID,
+		Items:      []client.ItemInput{{ProductID: "p-1", Name: "Widget", Quantity: 1, Price: 5}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "acme-"+suffix, order.TenantID)
+
+	_, err = globex.GetOrder(ctx, order.ID)
+	assert.True(t, client.IsNotFound(err), "another tenant must not read the order")
+
+	_, err = globex.UpdateStatus(ctx, order.ID, client.StatusConfirmed)
+	assert.True(t, client.IsNotFound(err), "another tenant must not change the order")
+
+	for o, err := range globex.Orders(ctx, client.ListOrdersOptions{CustomerID: customerID}) {
+		require.NoError(t, err)
+		t.Errorf("another tenant listed order %s", o.ID)
+	}
+
+	got, err := acme.GetOrder(ctx, order.ID)
+	require.NoError(t, err)
+	assert.Equal(t, client.StatusPending, got.Status)
+}
+
+// tenantRequest sends a JSON request as tenant and returns the response and its body
+func tenantRequest(t *testing.T, tenant, method, path string, body any) (*http.Response, []byte) {
+	t.Helper()
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		require.NoError(t, err)
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, baseURL+path, reqBody)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(client.TenantHeader, tenant)
+
+	resp, err := (&http.Client{Timeout: 10 * time.Second}).Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, respBody
+}
+
+func TestTenancy_SubscriptionInvisibleToOtherTenant(t *testing.T) {
+	requireHeaderTenancy(t)
+	suffix := uuid.NewString()[:8]
+	acme, globex := "acme-"+suffix, "globex-"+suffix
+	customerID := uuid.NewString()
+
+	resp, body := tenantRequest(t, acme, http.MethodPost, "/api/v1/subscriptions", map[string]any{
+		"customer_id":  customerID,
+		"items":        []OrderItem{{ProductID: "prod-1", Name: "Test", Quantity: 1, Price: 5}},
+		"cadence":      "weekly",
+		"first_run_at": time.Now().Add(24 * time.Hour).UTC(),
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+	var sub struct {
+		ID string `json:"id"`
+	}
+	require.NoError(t, json.Unmarshal(body, &sub))
+	path := "/api/v1/subscriptions/" + sub.ID
+
+	resp, _ = tenantRequest(t, globex, http.MethodGet, path, nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode, "another tenant must not read the subscription")
+
+	resp, body = tenantRequest(t, globex, http.MethodGet, "/api/v1/subscriptions?customer_id="+customerID, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var list struct {
+		Total int64 `json:"total"`
+	}
+	require.NoError(t, json.Unmarshal(body, &list))
+	assert.Zero(t, list.Total, "another tenant must not list the subscription")
+
+	resp, _ = tenantRequest(t, globex, http.MethodDelete, path, nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode, "another tenant must not delete the subscription")
+
+	resp, _ = tenantRequest(t, acme, http.MethodGet, path, nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestTenancy_MissingTenant_Returns400(t *testing.T) {
+	requireHeaderTenancy(t)
+
+	resp, _ := get(t, "/api/v1/orders")
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
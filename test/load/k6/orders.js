@@ -0,0 +1,105 @@
+// Load test of the order API. The thresholds are the service's latency and
+// error SLOs (see docs/ARCHITECTURE.md); k6 exits non-zero when a run
+// misses one.
+//
+//   k6 run -e BASE_URL=http://localhost:8080 test/load/k6/orders.js
+//
+// RATE scales the request rates (default 1) and DURATION sets the length of
+// each scenario (default 1m). Run it against a service with RATE_LIMIT_RPM=0,
+// since all requests come from one IP.
+
+import http from "k6/http";
+import { check } from "k6";
+import { uuidv4 } from "https://jslib.k6.io/k6-utils/1.4.0/index.js";
+
+const BASE_URL = __ENV.BASE_URL || "http://localhost:8080";
+const RATE = Number(__ENV.RATE || 1);
+const DURATION = __ENV.DURATION || "1m";
+
+// A small pool of customers so customer lists grow to several pages
+const CUSTOMERS = Array.from({ length: 50 }, () => uuidv4());
+
+function scenario(exec, rate) {
+  return {
+    executor: "constant-arrival-rate",
+    exec,
+    rate: Math.max(1, Math.round(rate * RATE)),
+    timeUnit: "1s",
+    duration: DURATION,
+    preAllocatedVUs: 20,
+    maxVUs: 200,
+  };
+}
+
+export const options = {
+  scenarios: {
+    create: scenario("createOrder", 50),
+    get: scenario("getOrder", 100),
+    list: scenario("listOrders", 100),
+    customer: scenario("listCustomerOrders", 50),
+  },
+  thresholds: {
+    "http_req_failed": ["rate<0.001"],
+    "http_req_duration{scenario:create}": ["p(95)<100", "p(99)<250"],
+    "http_req_duration{scenario:get}": ["p(95)<25", "p(99)<75"],
+    "http_req_duration{scenario:list}": ["p(95)<75", "p(99)<200"],
+    "http_req_duration{scenario:customer}": ["p(95)<50", "p(99)<150"],
+  },
+};
+
+const JSON_HEADERS = { headers: { "Content-Type": "application/json" } };
+
+function customer() {
+  return CUSTOMERS[Math.floor(Math.random() * CUSTOMERS.length)];
+}
+
+function newOrder() {
+  return JSON.stringify({
+    customer_id: customer(),
+    items: [
+      { product_id: "load-1", name: "Load Widget", quantity: 2, price: 9.99 },
+      { product_id: "load-2", name: "Load Gadget", quantity: 1, price: 24.5 },
+    ],
+  });
+}
+
+// setup creates orders for getOrder to read
+export function setup() {
+  const ids = [];
+  for (let i = 0; i < 100; i++) {
+    const res = http.post(`${BASE_URL}/api/v1/orders`, newOrder(), JSON_HEADERS);
+    if (res.status === 201) {
+      ids.push(res.json("id"));
+    }
+  }
+  if (ids.length === 0) {
+    throw new Error(`cannot create orders at ${BASE_URL}`);
+  }
+  return { ids };
+}
+
+export function createOrder() {
+  const res = http.post(`${BASE_URL}/api/v1/orders`, newOrder(), JSON_HEADERS);
+  check(res, { "created": (r) => r.status === 201 });
+}
+
+export function getOrder(data) {
+  const id = data.ids[Math.floor(Math.random() * data.ids.length)];
+  const res = http.get(`${BASE_URL}/api/v1/orders/${id}`, { tags: { name: "GET /api/v1/orders/{id}" } });
+  check(res, { "found": (r) => r.status === 200 });
+}
+
+export function listOrders() {
+  const offset = Math.floor(Math.random() * 5) * 20;
+  const res = http.get(`${BASE_URL}/api/v1/orders?status=pending&limit=20&offset=${offset}`, {
+    tags: { name: "GET /api/v1/orders" },
+  });
+  check(res, { "listed": (r) => r.status === 200 });
+}
+
+export function listCustomerOrders() {
+  const res = http.get(`${BASE_URL}/api/v1/orders?customer_id=${customer()}&limit=20`, {
+    tags: { name: "GET /api/v1/orders?customer_id" },
+  });
+  check(res, { "listed": (r) => r.status === 200 });
+}
@@ -0,0 +1,234 @@
+// Copyright 2026 go-ordersvc Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build load
+
+// Package load holds the performance tests: Go benchmarks of the PostgreSQL
+// order repository and, in k6/, an HTTP load test whose thresholds are the
+// service's latency SLOs. See docs/ARCHITECTURE.md.
+package load
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/testcontainers/testcontainers-go"
+	tcpostgres "github.com/testcontainers/testcontainers-go/modules/postgres"
+
+	"github.com/sridharn-code-sandbox/go-ordersvc/db/migrations"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/domain"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository"
+	"github.com/sridharn-code-sandbox/go-ordersvc/internal/repository/postgres"
+)
+
+const (
+	// seedOrders is the table size the read benchmarks run against
+	seedOrders = 20000
+	// seedCustomers spreads the seeded orders, so each has about 200
+	seedCustomers = 100
+	seedBatch     = 500
+	// benchQueryTimeout is the repository's per-query limit; generous so a
+	// slow query shows in ns/op rather than as a failure
+	benchQueryTimeout = 30 * time.Second
+)
+
+var (
+	repo      repository.OrderRepository
+	customers []string
+	seededIDs []string
+)
+
+func TestMain(m *testing.M) {
+	// Benchmark the database LOAD_DATABASE_URL names, or a fresh container
+	ctx := context.Background()
+	dsn := os.Getenv("LOAD_DATABASE_URL")
+	stop := func() {}
+	if dsn == "" {
+		container, err := tcpostgres.Run(ctx, "postgres:16-alpine",
+			tcpostgres.WithDatabase("ordersvc"),
+			tcpostgres.WithUsername("postgres"),
+			tcpostgres.WithPassword("postgres"),
+			tcpostgres.BasicWaitStrategies(),
+		)
+		stop = func() { _ = testcontainers.TerminateContainer(container) }
+		if err == nil {
+			dsn, err = container.ConnectionString(ctx, "sslmode=disable")
+		}
+		if err != nil {
+			fmt.Printf("Failed to start postgres: %v\n", err)
+			stop()
+			os.Exit(1)
+		}
+	}
+
+	pool, err := setup(ctx, dsn)
+	if err != nil {
+		fmt.Printf("Failed to set up database: %v\n", err)
+		stop()
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	pool.Close()
+	stop()
+	os.Exit(code)
+}
+
+// setup migrates the database and seeds it with seedOrders orders
+func setup(ctx context.Context, dsn string) (*pgxpool.Pool, error) {
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+	migrator, err := postgres.NewMigrator(pool, migrations.FS)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := migrator.Up(ctx); err != nil {
+		return nil, err
+	}
+	repo = postgres.NewOrderRepository(pool, nil, benchQueryTimeout)
+
+	customers = make([]string, seedCustomers)
+	for i := range customers {
+		customers[i] = uuid.NewString()
+	}
+	for n := 0; n < seedOrders; n += seedBatch {
+		batch := make([]*domain.Order, seedBatch)
+		for i := range batch {
+			batch[i] = newOrder(customers[(n+i)%seedCustomers], n+i)
+		}
+		rowErrs, err := repo.CreateBatch(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+		for i, rowErr := range rowErrs {
+			if rowErr != nil {
+				return nil, rowErr
+			}
+			seededIDs = append(seededIDs, batch[i].ID.String())
+		}
+	}
+	// Plan the reads with statistics of the seeded table
+	if _, err := pool.Exec(ctx, "ANALYZE"); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+// newOrder returns an order of three items for customerID; n varies its
+// status and products
+func newOrder(customerID string, n int) *domain.Order {
+	statuses := []domain.OrderStatus{domain.OrderStatusPending, domain.OrderStatusConfirmed, domain.OrderStatusShipped, domain.OrderStatusDelivered}
+	now := time.Now()
+	order := &domain.Order{
+		ID:         uuid.New(),
+		CustomerID: customerID,
+		Status:     statuses[n%len(statuses)],
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	for i := range 3 {
+		item := domain.OrderItem{
+			ID:        uuid.New(),
+			ProductID: fmt.Sprintf("product-%d", (n+i)%50),
+			Name:      "Widget",
+			Quantity:  i + 1,
+			Price:     19.99,
+			Status:    domain.ItemStatusPending,
+		}
+		item.Subtotal = item.CalculateSubtotal()
+		order.Items = append(order.Items, item)
+	}
+	order.Total = order.CalculateTotal()
+	return order
+}
+
+func BenchmarkOrderRepository_Create(b *testing.B) {
+	ctx := context.Background()
+	n := 0
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := repo.Create(ctx, newOrder(customers[n%seedCustomers], n)); err != nil {
+			b.Fatal(err)
+		}
+		n++
+	}
+}
+
+func BenchmarkOrderRepository_FindByID(b *testing.B) {
+	ctx := context.Background()
+	n := 0
+	b.ReportAllocs()
+	for b.Loop() {
+		order, err := repo.FindByID(ctx, seededIDs[n%len(seededIDs)])
+		if err != nil || order == nil {
+			b.Fatalf("order not found: %v", err)
+		}
+		n++
+	}
+}
+
+func BenchmarkOrderRepository_List(b *testing.B) {
+	confirmed := domain.OrderStatusConfirmed
+	product := "product-7"
+	cases := []struct {
+		name string
+		opts repository.ListOptions
+	}{
+		{"first page", repository.ListOptions{Limit: 20}},
+		{"deep offset", repository.ListOptions{Limit: 20, Offset: seedOrders / 2}},
+		{"status", repository.ListOptions{Limit: 20, Status: &confirmed}},
+		{"product", repository.ListOptions{Limit: 20, ProductID: &product}},
+		{"without total", repository.ListOptions{Limit: 20, SkipTotal: true}},
+	}
+	for _, c := range cases {
+		b.Run(c.name, func(b *testing.B) {
+			ctx := context.Background()
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, _, err := repo.List(ctx, c.opts); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkOrderRepository_FindByCustomerID(b *testing.B) {
+	ctx := context.Background()
+	n := 0
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, _, err := repo.FindByCustomerID(ctx, customers[n%seedCustomers], repository.ListOptions{Limit: 20}); err != nil {
+			b.Fatal(err)
+		}
+		n++
+	}
+}
+
+func BenchmarkOrderRepository_Search(b *testing.B) {
+	ctx := context.Background()
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, _, err := repo.Search(ctx, "widget", repository.SearchOptions{Limit: 20}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}